@@ -153,6 +153,10 @@ import (
 	"github.com/crescent-network/crescent/v5/x/mint"
 	mintkeeper "github.com/crescent-network/crescent/v5/x/mint/keeper"
 	minttypes "github.com/crescent-network/crescent/v5/x/mint/types"
+	"github.com/crescent-network/crescent/v5/x/stableswap"
+	stableswapclient "github.com/crescent-network/crescent/v5/x/stableswap/client"
+	stableswapkeeper "github.com/crescent-network/crescent/v5/x/stableswap/keeper"
+	stableswaptypes "github.com/crescent-network/crescent/v5/x/stableswap/types"
 
 	// unnamed import of statik for swagger UI support
 	_ "github.com/crescent-network/crescent/v5/client/docs/statik"
@@ -183,10 +187,12 @@ var (
 			marketmakerclient.ProposalHandler,
 			lpfarmclient.ProposalHandler,
 			exchangeclient.MarketParameterChangeProposalHandler,
+			exchangeclient.MarketStatusChangeProposalHandler,
 			ammclient.PoolParameterChangeProposalHandler,
 			ammclient.PublicFarmingPlanProposalHandler,
 			liquidammclient.PublicPositionCreateProposalHandler,
 			liquidammclient.PublicPositionParameterChangeProposalHandler,
+			stableswapclient.PoolParameterChangeProposalHandler,
 		),
 		params.AppModuleBasic{},
 		crisis.AppModuleBasic{},
@@ -211,6 +217,7 @@ var (
 		marker.AppModuleBasic{},
 		exchange.AppModuleBasic{},
 		amm.AppModuleBasic{},
+		stableswap.AppModuleBasic{},
 	)
 
 	// module account permissions
@@ -233,6 +240,7 @@ var (
 		lpfarmtypes.ModuleName:         nil,
 		exchangetypes.ModuleName:       nil,
 		ammtypes.ModuleName:            {authtypes.Minter, authtypes.Burner},
+		stableswaptypes.ModuleName:     {authtypes.Minter, authtypes.Burner},
 		icatypes.ModuleName:            nil,
 	}
 )
@@ -294,6 +302,7 @@ type App struct {
 	MarkerKeeper        markerkeeper.Keeper
 	ExchangeKeeper      exchangekeeper.Keeper
 	AMMKeeper           ammkeeper.Keeper
+	StableSwapKeeper    stableswapkeeper.Keeper
 
 	// scoped keepers
 	ScopedIBCKeeper      capabilitykeeper.ScopedKeeper
@@ -375,6 +384,7 @@ func NewApp(
 		markertypes.StoreKey,
 		exchangetypes.StoreKey,
 		ammtypes.StoreKey,
+		stableswaptypes.StoreKey,
 	)
 	tkeys := sdk.NewTransientStoreKeys(
 		paramstypes.TStoreKey,
@@ -538,6 +548,12 @@ func NewApp(
 		app.GetSubspace(exchangetypes.ModuleName),
 		app.BankKeeper,
 	)
+	// register the exchange hooks
+	// NOTE: the exchange keeper is copied into other modules below, so the hooks
+	// must be set before that
+	app.ExchangeKeeper.SetHooks(
+	// insert exchange hooks receivers here
+	)
 	app.MarkerKeeper = markerkeeper.NewKeeper(
 		appCodec,
 		keys[markertypes.StoreKey],
@@ -549,11 +565,20 @@ func NewApp(
 		app.GetSubspace(ammtypes.ModuleName),
 		app.AccountKeeper,
 		app.BankKeeper,
-		app.ExchangeKeeper,
+		&app.ExchangeKeeper, // order sources are set after the amm keeper is created
 		app.MarkerKeeper,
 	)
+	app.StableSwapKeeper = stableswapkeeper.NewKeeper(
+		appCodec,
+		keys[stableswaptypes.StoreKey],
+		app.GetSubspace(stableswaptypes.ModuleName),
+		app.AccountKeeper,
+		app.BankKeeper,
+		&app.ExchangeKeeper, // order sources are set after the stableswap keeper is created
+	)
 	app.ExchangeKeeper.SetOrderSources(
 		ammkeeper.NewOrderSource(app.AMMKeeper),
+		stableswapkeeper.NewOrderSource(app.StableSwapKeeper),
 	)
 	app.LiquidFarmingKeeper = liquidfarmingkeeper.NewKeeper(
 		appCodec,
@@ -599,6 +624,7 @@ func NewApp(
 		AddRoute(lpfarmtypes.RouterKey, lpfarm.NewFarmingPlanProposalHandler(app.LPFarmKeeper)).
 		AddRoute(exchangetypes.RouterKey, exchange.NewProposalHandler(app.ExchangeKeeper)).
 		AddRoute(ammtypes.RouterKey, amm.NewProposalHandler(app.AMMKeeper)).
+		AddRoute(stableswaptypes.RouterKey, stableswap.NewProposalHandler(app.StableSwapKeeper)).
 		AddRoute(liquidammtypes.RouterKey, liquidamm.NewProposalHandler(app.LiquidAMMKeeper))
 
 	app.GovKeeper = govkeeper.NewKeeper(
@@ -708,6 +734,7 @@ func NewApp(
 		marker.NewAppModule(appCodec, app.MarkerKeeper),
 		exchange.NewAppModule(appCodec, app.ExchangeKeeper, app.AccountKeeper, app.BankKeeper),
 		amm.NewAppModule(appCodec, app.AMMKeeper, app.AccountKeeper, app.BankKeeper, app.ExchangeKeeper),
+		stableswap.NewAppModule(appCodec, app.StableSwapKeeper, app.AccountKeeper, app.BankKeeper, app.ExchangeKeeper),
 		app.transferModule,
 		app.icaModule,
 	)
@@ -750,6 +777,7 @@ func NewApp(
 		icatypes.ModuleName,
 		markertypes.ModuleName,
 		exchangetypes.ModuleName,
+		stableswaptypes.ModuleName,
 	)
 
 	app.mm.SetOrderMidBlockers(
@@ -788,6 +816,7 @@ func NewApp(
 		icatypes.ModuleName,
 		exchangetypes.ModuleName,
 		ammtypes.ModuleName,
+		stableswaptypes.ModuleName,
 		liquidammtypes.ModuleName,
 		liquidfarmingtypes.ModuleName,
 
@@ -826,6 +855,7 @@ func NewApp(
 		markertypes.ModuleName,
 		exchangetypes.ModuleName,
 		ammtypes.ModuleName,
+		stableswaptypes.ModuleName,
 
 		// empty logic modules
 		paramstypes.ModuleName,
@@ -869,6 +899,7 @@ func NewApp(
 		marker.NewAppModule(appCodec, app.MarkerKeeper),
 		exchange.NewAppModule(appCodec, app.ExchangeKeeper, app.AccountKeeper, app.BankKeeper),
 		amm.NewAppModule(appCodec, app.AMMKeeper, app.AccountKeeper, app.BankKeeper, app.ExchangeKeeper),
+		stableswap.NewAppModule(appCodec, app.StableSwapKeeper, app.AccountKeeper, app.BankKeeper, app.ExchangeKeeper),
 		ibc.NewAppModule(app.IBCKeeper),
 		app.transferModule,
 
@@ -1092,6 +1123,7 @@ func initParamsKeeper(appCodec codec.BinaryCodec, legacyAmino *codec.LegacyAmino
 	paramsKeeper.Subspace(markertypes.ModuleName)
 	paramsKeeper.Subspace(exchangetypes.ModuleName)
 	paramsKeeper.Subspace(ammtypes.ModuleName)
+	paramsKeeper.Subspace(stableswaptypes.ModuleName)
 	paramsKeeper.Subspace(icahosttypes.SubModuleName)
 
 	return paramsKeeper
@@ -1149,6 +1181,6 @@ func (app *App) SetUpgradeHandlers(mm *module.Manager, configurator module.Confi
 	app.UpgradeKeeper.SetUpgradeHandler(
 		v5.UpgradeName, v5.UpgradeHandler(
 			mm, configurator, app.AccountKeeper, app.BankKeeper, app.DistrKeeper, app.LiquidityKeeper,
-			app.LPFarmKeeper, app.ExchangeKeeper, app.AMMKeeper, app.MarkerKeeper, app.FarmingKeeper,
-			app.ClaimKeeper, enableMigrationEventEmit))
+			app.LPFarmKeeper, app.ExchangeKeeper, app.AMMKeeper, app.StableSwapKeeper, app.MarkerKeeper,
+			app.FarmingKeeper, app.ClaimKeeper, enableMigrationEventEmit))
 }
@@ -47,6 +47,7 @@ import (
 	"github.com/crescent-network/crescent/v5/x/lpfarm"
 	"github.com/crescent-network/crescent/v5/x/marketmaker"
 	"github.com/crescent-network/crescent/v5/x/mint"
+	"github.com/crescent-network/crescent/v5/x/stableswap"
 )
 
 func TestSimAppExportAndBlockedAddrs(t *testing.T) {
@@ -204,6 +205,7 @@ func TestRunMigrations(t *testing.T) {
 					"lpfarm":             lpfarm.AppModule{}.ConsensusVersion(),
 					"exchange":           exchange.AppModule{}.ConsensusVersion(),
 					"amm":                amm.AppModule{}.ConsensusVersion(),
+					"stableswap":         stableswap.AppModule{}.ConsensusVersion(),
 					"ibc":                ibc.AppModule{}.ConsensusVersion(),
 					"transfer":           transfer.AppModule{}.ConsensusVersion(),
 					"interchainaccounts": ica.AppModule{}.ConsensusVersion(),
@@ -270,6 +272,7 @@ func TestInitGenesisOnMigration(t *testing.T) {
 			"lpfarm":        lpfarm.AppModule{}.ConsensusVersion(),
 			"exchange":      exchange.AppModule{}.ConsensusVersion(),
 			"amm":           amm.AppModule{}.ConsensusVersion(),
+			"stableswap":    stableswap.AppModule{}.ConsensusVersion(),
 			"ibc":           ibc.AppModule{}.ConsensusVersion(),
 			"transfer":      transfer.AppModule{}.ConsensusVersion(),
 		},
@@ -55,7 +55,8 @@ func IsMidBlockTx(tx sdk.Tx) bool {
 		switch msg.(type) {
 		case *exchangetypes.MsgPlaceBatchLimitOrder,
 			*exchangetypes.MsgPlaceMMBatchLimitOrder,
-			*exchangetypes.MsgCancelOrder:
+			*exchangetypes.MsgCancelOrder,
+			*exchangetypes.MsgReplaceMMOrders:
 		default:
 			return false
 		}
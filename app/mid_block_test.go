@@ -27,7 +27,9 @@ func TestSplitMidBlockTxs(t *testing.T) {
 	singleNormalTx := getTx(&banktypes.MsgSend{})
 	multipleNormalTx := getTx(&liquidstakingtypes.MsgLiquidStake{}, &banktypes.MsgSend{})
 	singleMidBlockTx := getTx(&exchangetypes.MsgPlaceBatchLimitOrder{})
-	multipleMidBlockTx := getTx(&exchangetypes.MsgPlaceBatchLimitOrder{}, &exchangetypes.MsgPlaceMMBatchLimitOrder{})
+	multipleMidBlockTx := getTx(
+		&exchangetypes.MsgPlaceBatchLimitOrder{}, &exchangetypes.MsgPlaceMMBatchLimitOrder{},
+		&exchangetypes.MsgReplaceMMOrders{})
 	normalWithMidBlockTx := getTx(&banktypes.MsgSend{}, &exchangetypes.MsgPlaceBatchLimitOrder{})
 	midBlockWithNormalTx := getTx(&exchangetypes.MsgPlaceBatchLimitOrder{}, &banktypes.MsgSend{})
 
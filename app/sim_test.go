@@ -44,6 +44,7 @@ import (
 	lpfarmtypes "github.com/crescent-network/crescent/v5/x/lpfarm/types"
 	marketmakertypes "github.com/crescent-network/crescent/v5/x/marketmaker/types"
 	minttypes "github.com/crescent-network/crescent/v5/x/mint/types"
+	stableswaptypes "github.com/crescent-network/crescent/v5/x/stableswap/types"
 )
 
 // Get flags every time the simulator is run
@@ -202,6 +203,7 @@ func TestAppImportExport(t *testing.T) {
 		{app.keys[lpfarmtypes.StoreKey], newApp.keys[lpfarmtypes.StoreKey], [][]byte{}},
 		{app.keys[exchangetypes.StoreKey], newApp.keys[exchangetypes.StoreKey], [][]byte{}},
 		{app.keys[ammtypes.StoreKey], newApp.keys[ammtypes.StoreKey], [][]byte{}},
+		{app.keys[stableswaptypes.StoreKey], newApp.keys[stableswaptypes.StoreKey], [][]byte{}},
 		{app.keys[liquidammtypes.StoreKey], newApp.keys[liquidammtypes.StoreKey], [][]byte{}},
 		{app.keys[ibchost.StoreKey], newApp.keys[ibchost.StoreKey], [][]byte{}},
 		{app.keys[ibctransfertypes.StoreKey], newApp.keys[ibctransfertypes.StoreKey], [][]byte{}},
@@ -10,13 +10,18 @@ import (
 )
 
 func (s *TestSuite) CreatePool(marketId uint64, price sdk.Dec) ammtypes.Pool {
+	s.T().Helper()
+	return s.CreatePoolWithTickSpacing(marketId, price, 0)
+}
+
+func (s *TestSuite) CreatePoolWithTickSpacing(marketId uint64, price sdk.Dec, tickSpacing uint32) ammtypes.Pool {
 	s.T().Helper()
 	creatorAddr := utils.TestAddress(1000001)
 	creationFee := s.App.AMMKeeper.GetPoolCreationFee(s.Ctx)
 	if !s.GetAllBalances(creatorAddr).IsAllGTE(creationFee) {
 		s.FundAccount(creatorAddr, creationFee)
 	}
-	pool, err := s.App.AMMKeeper.CreatePool(s.Ctx, creatorAddr, marketId, price)
+	pool, err := s.App.AMMKeeper.CreatePool(s.Ctx, creatorAddr, marketId, price, tickSpacing)
 	s.Require().NoError(err)
 	return pool
 }
@@ -56,6 +61,24 @@ func (s *TestSuite) Collect(ownerAddr sdk.AccAddress, positionId uint64, amt sdk
 	s.Require().NoError(s.App.AMMKeeper.Collect(s.Ctx, ownerAddr, ownerAddr, positionId, amt))
 }
 
+func (s *TestSuite) TransferPosition(ownerAddr sdk.AccAddress, positionId uint64, recipientAddr sdk.AccAddress) {
+	s.T().Helper()
+	s.Require().NoError(s.App.AMMKeeper.TransferPosition(s.Ctx, ownerAddr, positionId, recipientAddr))
+}
+
+func (s *TestSuite) Compound(ownerAddr sdk.AccAddress, positionId uint64, maxSlippage sdk.Dec) (position ammtypes.Position, liquidity sdk.Int, amt sdk.Coins) {
+	s.T().Helper()
+	var err error
+	position, liquidity, amt, err = s.App.AMMKeeper.Compound(s.Ctx, ownerAddr, positionId, maxSlippage)
+	s.Require().NoError(err)
+	return
+}
+
+func (s *TestSuite) SetAutoCompound(ownerAddr sdk.AccAddress, positionId uint64, enabled bool) {
+	s.T().Helper()
+	s.Require().NoError(s.App.AMMKeeper.SetAutoCompound(s.Ctx, ownerAddr, positionId, enabled))
+}
+
 func (s *TestSuite) CreatePrivateFarmingPlan(creatorAddr sdk.AccAddress, description string, termAddr sdk.AccAddress, rewardAllocs []ammtypes.FarmingRewardAllocation, startTime, endTime time.Time, initialFunds sdk.Coins, fundFee bool) (plan ammtypes.FarmingPlan) {
 	s.T().Helper()
 	if fundFee {
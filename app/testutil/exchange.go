@@ -16,7 +16,8 @@ func (s *TestSuite) CreateMarket(baseDenom, quoteDenom string) exchangetypes.Mar
 	if !s.GetAllBalances(creatorAddr).IsAllGTE(creationFee) {
 		s.FundAccount(creatorAddr, creationFee)
 	}
-	market, err := s.App.ExchangeKeeper.CreateMarket(s.Ctx, creatorAddr, baseDenom, quoteDenom)
+	market, err := s.App.ExchangeKeeper.CreateMarket(
+		s.Ctx, creatorAddr, baseDenom, quoteDenom, utils.ZeroDec, utils.ZeroDec, utils.ZeroDec)
 	s.Require().NoError(err)
 	return market
 }
@@ -25,7 +26,20 @@ func (s *TestSuite) PlaceLimitOrder(
 	marketId uint64, ordererAddr sdk.AccAddress, isBuy bool, price, qty sdk.Dec, lifespan time.Duration) (orderId uint64, order exchangetypes.Order, res exchangetypes.ExecuteOrderResult) {
 	s.T().Helper()
 	var err error
-	orderId, order, res, err = s.App.ExchangeKeeper.PlaceLimitOrder(s.Ctx, marketId, ordererAddr, isBuy, price, qty, lifespan)
+	orderId, order, res, _, err = s.App.ExchangeKeeper.PlaceLimitOrder(
+		s.Ctx, marketId, ordererAddr, isBuy, price, qty, nil, lifespan, exchangetypes.TimeInForceGoodTilTime,
+		exchangetypes.SelfTradePreventionUnspecified, nil)
+	s.Require().NoError(err)
+	return
+}
+
+func (s *TestSuite) PlaceIcebergOrder(
+	marketId uint64, ordererAddr sdk.AccAddress, isBuy bool, price, qty, displayQty sdk.Dec, lifespan time.Duration) (orderId uint64, order exchangetypes.Order, res exchangetypes.ExecuteOrderResult) {
+	s.T().Helper()
+	var err error
+	orderId, order, res, _, err = s.App.ExchangeKeeper.PlaceLimitOrder(
+		s.Ctx, marketId, ordererAddr, isBuy, price, qty, &displayQty, lifespan, exchangetypes.TimeInForceGoodTilTime,
+		exchangetypes.SelfTradePreventionUnspecified, nil)
 	s.Require().NoError(err)
 	return
 }
@@ -34,7 +48,9 @@ func (s *TestSuite) PlaceBatchLimitOrder(
 	marketId uint64, ordererAddr sdk.AccAddress, isBuy bool, price, qty sdk.Dec, lifespan time.Duration) (order exchangetypes.Order) {
 	s.T().Helper()
 	var err error
-	order, err = s.App.ExchangeKeeper.PlaceBatchLimitOrder(s.Ctx, marketId, ordererAddr, isBuy, price, qty, lifespan)
+	order, _, err = s.App.ExchangeKeeper.PlaceBatchLimitOrder(
+		s.Ctx, marketId, ordererAddr, isBuy, price, qty, lifespan, exchangetypes.TimeInForceGoodTilTime,
+		exchangetypes.SelfTradePreventionUnspecified)
 	s.Require().NoError(err)
 	return
 }
@@ -43,7 +59,9 @@ func (s *TestSuite) PlaceMMLimitOrder(
 	marketId uint64, ordererAddr sdk.AccAddress, isBuy bool, price, qty sdk.Dec, lifespan time.Duration) (orderId uint64, order exchangetypes.Order, res exchangetypes.ExecuteOrderResult) {
 	s.T().Helper()
 	var err error
-	orderId, order, res, err = s.App.ExchangeKeeper.PlaceMMLimitOrder(s.Ctx, marketId, ordererAddr, isBuy, price, qty, lifespan)
+	orderId, order, res, _, err = s.App.ExchangeKeeper.PlaceMMLimitOrder(
+		s.Ctx, marketId, ordererAddr, isBuy, price, qty, lifespan, exchangetypes.TimeInForceGoodTilTime,
+		exchangetypes.SelfTradePreventionUnspecified)
 	s.Require().NoError(err)
 	return
 }
@@ -52,7 +70,9 @@ func (s *TestSuite) PlaceMMBatchLimitOrder(
 	marketId uint64, ordererAddr sdk.AccAddress, isBuy bool, price, qty sdk.Dec, lifespan time.Duration) (order exchangetypes.Order) {
 	s.T().Helper()
 	var err error
-	order, err = s.App.ExchangeKeeper.PlaceMMBatchLimitOrder(s.Ctx, marketId, ordererAddr, isBuy, price, qty, lifespan)
+	order, _, err = s.App.ExchangeKeeper.PlaceMMBatchLimitOrder(
+		s.Ctx, marketId, ordererAddr, isBuy, price, qty, lifespan, exchangetypes.TimeInForceGoodTilTime,
+		exchangetypes.SelfTradePreventionUnspecified)
 	s.Require().NoError(err)
 	return
 }
@@ -61,7 +81,18 @@ func (s *TestSuite) PlaceMarketOrder(
 	marketId uint64, ordererAddr sdk.AccAddress, isBuy bool, qty sdk.Dec) (orderId uint64, res exchangetypes.ExecuteOrderResult) {
 	s.T().Helper()
 	var err error
-	orderId, res, err = s.App.ExchangeKeeper.PlaceMarketOrder(s.Ctx, marketId, ordererAddr, isBuy, qty)
+	orderId, res, err = s.App.ExchangeKeeper.PlaceMarketOrder(s.Ctx, marketId, ordererAddr, isBuy, qty, nil)
+	s.Require().NoError(err)
+	return
+}
+
+func (s *TestSuite) PlaceTriggerOrder(
+	marketId uint64, ordererAddr sdk.AccAddress, isBuy bool, condition exchangetypes.TriggerCondition,
+	triggerPrice sdk.Dec, price *sdk.Dec, qty sdk.Dec, lifespan time.Duration) (order exchangetypes.TriggerOrder) {
+	s.T().Helper()
+	var err error
+	order, err = s.App.ExchangeKeeper.PlaceTriggerOrder(
+		s.Ctx, marketId, ordererAddr, isBuy, condition, triggerPrice, price, qty, lifespan)
 	s.Require().NoError(err)
 	return
 }
@@ -74,6 +105,15 @@ func (s *TestSuite) CancelOrder(ordererAddr sdk.AccAddress, orderId uint64) (ord
 	return
 }
 
+func (s *TestSuite) AmendOrder(
+	ordererAddr sdk.AccAddress, orderId uint64, price, qty *sdk.Dec, lifespan *time.Duration) (order exchangetypes.Order) {
+	s.T().Helper()
+	var err error
+	order, err = s.App.ExchangeKeeper.AmendOrder(s.Ctx, ordererAddr, orderId, price, qty, lifespan)
+	s.Require().NoError(err)
+	return
+}
+
 func (s *TestSuite) CancelAllOrders(ordererAddr sdk.AccAddress, marketId uint64) (orders []exchangetypes.Order) {
 	s.T().Helper()
 	var err error
@@ -82,11 +122,25 @@ func (s *TestSuite) CancelAllOrders(ordererAddr sdk.AccAddress, marketId uint64)
 	return
 }
 
+func (s *TestSuite) SetCancelAfter(ordererAddr sdk.AccAddress, marketIds []uint64, cancelAfter *time.Time) {
+	s.T().Helper()
+	s.Require().NoError(s.App.ExchangeKeeper.SetCancelAfter(s.Ctx, ordererAddr, marketIds, cancelAfter))
+}
+
 func (s *TestSuite) SwapExactAmountIn(
 	ordererAddr sdk.AccAddress, routes []uint64, input, minOutput sdk.DecCoin, simulate bool) (output sdk.DecCoin, results []exchangetypes.SwapRouteResult) {
 	s.T().Helper()
 	var err error
-	output, results, err = s.App.ExchangeKeeper.SwapExactAmountIn(s.Ctx, ordererAddr, routes, input, minOutput, simulate)
+	output, results, err = s.App.ExchangeKeeper.SwapExactAmountIn(s.Ctx, ordererAddr, routes, input, minOutput, nil, simulate)
+	s.Require().NoError(err)
+	return
+}
+
+func (s *TestSuite) SwapExactAmountOut(
+	ordererAddr sdk.AccAddress, routes []uint64, output, maxInput sdk.DecCoin, simulate bool) (input sdk.DecCoin, results []exchangetypes.SwapRouteResult) {
+	s.T().Helper()
+	var err error
+	input, results, err = s.App.ExchangeKeeper.SwapExactAmountOut(s.Ctx, ordererAddr, routes, output, maxInput, simulate)
 	s.Require().NoError(err)
 	return
 }
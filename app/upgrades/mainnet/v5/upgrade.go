@@ -33,6 +33,8 @@ import (
 	lpfarmtypes "github.com/crescent-network/crescent/v5/x/lpfarm/types"
 	markerkeeper "github.com/crescent-network/crescent/v5/x/marker/keeper"
 	markertypes "github.com/crescent-network/crescent/v5/x/marker/types"
+	stableswapkeeper "github.com/crescent-network/crescent/v5/x/stableswap/keeper"
+	stableswaptypes "github.com/crescent-network/crescent/v5/x/stableswap/types"
 )
 
 const UpgradeName = "v5"
@@ -43,6 +45,7 @@ var StoreUpgrades = store.StoreUpgrades{
 		exchangetypes.StoreKey,
 		ammtypes.StoreKey,
 		liquidammtypes.StoreKey,
+		stableswaptypes.StoreKey,
 	},
 }
 
@@ -50,7 +53,7 @@ func UpgradeHandler(
 	mm *module.Manager, configurator module.Configurator, accountKeeper authkeeper.AccountKeeper,
 	bankKeeper bankkeeper.Keeper, distrKeeper distrkeeper.Keeper, liquidityKeeper liquiditykeeper.Keeper,
 	lpFarmKeeper lpfarmkeeper.Keeper, exchangeKeeper exchangekeeper.Keeper, ammKeeper ammkeeper.Keeper,
-	markerKeeper markerkeeper.Keeper, farmingKeeper farmingkeeper.Keeper, claimKeeper claimkeeper.Keeper,
+	stableSwapKeeper stableswapkeeper.Keeper, markerKeeper markerkeeper.Keeper, farmingKeeper farmingkeeper.Keeper, claimKeeper claimkeeper.Keeper,
 	disableUpgradeEvents bool) upgradetypes.UpgradeHandler {
 	return func(ctx sdk.Context, plan upgradetypes.Plan, fromVM module.VersionMap) (module.VersionMap, error) {
 		vm, err := mm.RunMigrations(ctx, configurator, fromVM)
@@ -77,6 +80,11 @@ func UpgradeHandler(
 		ammParams.DefaultMinOrderQuote = sdk.NewDec(10000)
 		ammParams.PrivateFarmingPlanCreationFee = sdk.NewCoins(sdk.NewInt64Coin("ucre", 1000_000000))
 		ammKeeper.SetParams(ctx, ammParams)
+		stableSwapParams := stableswaptypes.DefaultParams()
+		stableSwapParams.PoolCreationFee = sdk.NewCoins(sdk.NewInt64Coin("ucre", 1000_000000))
+		stableSwapParams.DefaultMinOrderQuantity = sdk.NewDec(10000)
+		stableSwapParams.DefaultMinOrderQuote = sdk.NewDec(10000)
+		stableSwapKeeper.SetParams(ctx, stableSwapParams)
 
 		// Migrate farming plans and staked coins to the new amm module.
 
@@ -193,6 +201,7 @@ func UpgradeHandler(
 				defaultMakerFeeRate, defaultTakerFeeRate, defaultOrderSourceFeeRatio)
 			exchangeKeeper.SetMarket(ctx, market)
 			exchangeKeeper.SetMarketByDenomsIndex(ctx, market)
+			exchangeKeeper.SetActiveMarketsByDenomIndex(ctx, market)
 			exchangeKeeper.SetMarketState(ctx, market.Id, exchangetypes.NewMarketState(pair.LastPrice))
 			lastMarketId = pair.Id
 			return false, nil
@@ -255,11 +264,12 @@ func UpgradeHandler(
 					defaultMinOrderQty, defaultMinOrderQuote)
 				ammKeeper.SetPool(ctx, newPool)
 				// Set corresponding indexes.
-				ammKeeper.SetPoolByMarketIndex(ctx, newPool)
+				ammKeeper.SetPoolsByMarketIndex(ctx, newPool)
 				ammKeeper.SetPoolByReserveAddressIndex(ctx, newPool)
 				// Set initial pool state with the pool price we've calculated.
 				newPoolState := ammtypes.NewPoolState(exchangetypes.TickAtPrice(newPoolPrice), newPoolPrice)
 				ammKeeper.SetPoolState(ctx, newPoolId, newPoolState)
+				ammKeeper.SetObservation(ctx, newPoolId, 0, ammtypes.NewObservation(ctx.BlockTime()))
 				newPoolIdByPairId[pairId] = newPoolId
 			}
 		}
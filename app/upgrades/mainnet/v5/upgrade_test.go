@@ -15,8 +15,10 @@ import (
 	"github.com/crescent-network/crescent/v5/app/testutil"
 	v5 "github.com/crescent-network/crescent/v5/app/upgrades/mainnet/v5"
 	utils "github.com/crescent-network/crescent/v5/types"
+	ammtypes "github.com/crescent-network/crescent/v5/x/amm/types"
 	liquiditytypes "github.com/crescent-network/crescent/v5/x/liquidity/types"
 	lpfarmtypes "github.com/crescent-network/crescent/v5/x/lpfarm/types"
+	stableswaptypes "github.com/crescent-network/crescent/v5/x/stableswap/types"
 )
 
 type UpgradeTestSuite struct {
@@ -76,6 +78,9 @@ func (s *UpgradeTestSuite) TestUpgradeV5() {
 	s.AssertEqual(sdk.NewInt(2341640785), poolState.TotalLiquidity)
 	s.AssertEqual(sdk.NewInt(2341640785), poolState.CurrentLiquidity)
 
+	// Migrated markets are included in the swap routing graph.
+	s.Require().Equal([][]uint64{{1}}, s.App.ExchangeKeeper.FindAllRoutes(s.Ctx, "ucre", "uusd", 3))
+
 	// Check if creating new market overwrites existing markets.
 	s.Require().Equal(uint64(1), s.App.ExchangeKeeper.GetLastMarketId(s.Ctx))
 	market := s.CreateMarket("uusd", "ucre")
@@ -83,6 +88,14 @@ func (s *UpgradeTestSuite) TestUpgradeV5() {
 	market, _ = s.App.ExchangeKeeper.GetMarket(s.Ctx, 1)
 	s.Require().Equal("ucre", market.BaseDenom)
 	s.Require().Equal("uusd", market.QuoteDenom)
+
+	// The stableswap module's store is added and its parameters are set.
+	s.Require().Contains(v5.StoreUpgrades.Added, stableswaptypes.StoreKey)
+	stableSwapParams := s.App.StableSwapKeeper.GetParams(s.Ctx)
+	s.Require().Equal(utils.ParseCoins("1000_000000ucre"), stableSwapParams.PoolCreationFee)
+	s.AssertEqual(sdk.NewDec(10000), stableSwapParams.DefaultMinOrderQuantity)
+	s.AssertEqual(sdk.NewDec(10000), stableSwapParams.DefaultMinOrderQuote)
+	s.Require().Equal(uint64(0), s.App.StableSwapKeeper.GetLastPoolId(s.Ctx))
 }
 
 func (s *UpgradeTestSuite) TestUpgradeV5Params() {
@@ -141,8 +154,13 @@ func (s *UpgradeTestSuite) TestUpgradeV5Params() {
 		} else {
 			s.AssertEqual(sdk.NewDecWithPrec(2, 3), market.TakerFeeRate) // default
 		}
-		pool, found := s.App.AMMKeeper.GetPoolByMarket(s.Ctx, pairId)
-		s.Require().True(found)
+		var pools []ammtypes.Pool
+		s.App.AMMKeeper.IteratePoolsByMarket(s.Ctx, pairId, func(pool ammtypes.Pool) (stop bool) {
+			pools = append(pools, pool)
+			return false
+		})
+		s.Require().Len(pools, 1)
+		pool := pools[0]
 		if change.TickSpacing != nil {
 			s.Require().EqualValues(*change.TickSpacing, pool.TickSpacing)
 		} else {
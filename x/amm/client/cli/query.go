@@ -36,6 +36,7 @@ func GetQueryCmd() *cobra.Command {
 		NewQueryCollectibleCoinsCmd(),
 		NewQueryAllTickInfosCmd(),
 		NewQueryTickInfoCmd(),
+		NewQueryObserveCmd(),
 		NewQueryAllFarmingPlansCmd(),
 		NewQueryFarmingPlanCmd(),
 	)
@@ -518,6 +519,53 @@ $ %s query %s tick-info 1 1000
 	return cmd
 }
 
+func NewQueryObserveCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "observe [pool-id] [seconds-agos...]",
+		Args:  cobra.MinimumNArgs(2),
+		Short: "Query the pool's cumulative tick and seconds per liquidity",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the pool's tick cumulatives and seconds per liquidity cumulatives
+as of each of the given seconds ago.
+
+Example:
+$ %s query %s observe 1 3600 0
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			poolId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid pool id: %w", err)
+			}
+			var secondsAgos []uint32
+			for _, arg := range args[1:] {
+				secondsAgo, err := strconv.ParseUint(arg, 10, 32)
+				if err != nil {
+					return fmt.Errorf("invalid seconds ago: %w", err)
+				}
+				secondsAgos = append(secondsAgos, uint32(secondsAgo))
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.Observe(cmd.Context(), &types.QueryObserveRequest{
+				PoolId:      poolId,
+				SecondsAgos: secondsAgos,
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
 func NewQueryAllFarmingPlansCmd() *cobra.Command {
 	const (
 		flagIsPrivate    = "is-private"
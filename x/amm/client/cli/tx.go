@@ -34,7 +34,12 @@ func GetTxCmd() *cobra.Command {
 		NewCreatePoolCmd(),
 		NewAddLiquidityCmd(),
 		NewRemoveLiquidityCmd(),
+		NewIncreaseLiquidityCmd(),
+		NewDecreaseLiquidityCmd(),
 		NewCollectCmd(),
+		NewTransferPositionCmd(),
+		NewCompoundCmd(),
+		NewSetAutoCompoundCmd(),
 		NewCreatePrivateFarmingPlanCmd(),
 		NewTerminatePrivateFarmingPlanCmd(),
 	)
@@ -43,6 +48,7 @@ func GetTxCmd() *cobra.Command {
 }
 
 func NewCreatePoolCmd() *cobra.Command {
+	const flagTickSpacing = "tick-spacing"
 	cmd := &cobra.Command{
 		Use:   "create-pool [market-id] [price]",
 		Args:  cobra.ExactArgs(2),
@@ -52,8 +58,10 @@ func NewCreatePoolCmd() *cobra.Command {
 
 Example:
 $ %s tx %s create-pool 1 10 --from mykey
+$ %s tx %s create-pool 1 10 --tick-spacing 10 --from mykey
 `,
 				version.AppName, types.ModuleName,
+				version.AppName, types.ModuleName,
 			),
 		),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -69,10 +77,15 @@ $ %s tx %s create-pool 1 10 --from mykey
 			if err != nil {
 				return fmt.Errorf("invalid price: %w", err)
 			}
-			msg := types.NewMsgCreatePool(clientCtx.GetFromAddress(), marketId, price)
+			tickSpacing, err := cmd.Flags().GetUint32(flagTickSpacing)
+			if err != nil {
+				return err
+			}
+			msg := types.NewMsgCreatePool(clientCtx.GetFromAddress(), marketId, price, tickSpacing)
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
+	cmd.Flags().Uint32(flagTickSpacing, 0, "tick spacing of the pool; the default tick spacing is used if not specified")
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
@@ -157,6 +170,121 @@ $ %s tx %s remove-liquidity 1 10000000000000 --from mykey
 	return cmd
 }
 
+const (
+	flagMinAmount = "min-amount"
+	flagDeadline  = "deadline"
+)
+
+func NewIncreaseLiquidityCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "increase-liquidity [position-id] [desired-amount]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Add liquidity to an existing position",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Add liquidity to an existing position.
+
+Example:
+$ %s tx %s increase-liquidity 1 1000000ucre,10000000uusd --from mykey
+$ %s tx %s increase-liquidity 1 1000000ucre,10000000uusd --min-amount 990000ucre,9900000uusd --deadline 2023-06-01T00:00:00Z --from mykey
+`,
+				version.AppName, types.ModuleName,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			positionId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid position id: %w", err)
+			}
+			desiredAmt, err := sdk.ParseCoinsNormalized(args[1])
+			if err != nil {
+				return fmt.Errorf("invalid desired amount: %w", err)
+			}
+			minAmt, deadline, err := readSlippageFlags(cmd)
+			if err != nil {
+				return err
+			}
+			msg := types.NewMsgIncreaseLiquidity(
+				clientCtx.GetFromAddress(), positionId, desiredAmt, minAmt, deadline)
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	addSlippageFlags(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func NewDecreaseLiquidityCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "decrease-liquidity [position-id] [liquidity]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Remove liquidity from an existing position",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Remove liquidity from an existing position.
+
+Example:
+$ %s tx %s decrease-liquidity 1 10000000000000 --from mykey
+$ %s tx %s decrease-liquidity 1 10000000000000 --min-amount 990000ucre,9900000uusd --deadline 2023-06-01T00:00:00Z --from mykey
+`,
+				version.AppName, types.ModuleName,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			positionId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid position id: %w", err)
+			}
+			liquidity, ok := sdk.NewIntFromString(args[1])
+			if !ok {
+				return fmt.Errorf("invalid liquidity: %s", args[1])
+			}
+			minAmt, deadline, err := readSlippageFlags(cmd)
+			if err != nil {
+				return err
+			}
+			msg := types.NewMsgDecreaseLiquidity(
+				clientCtx.GetFromAddress(), positionId, liquidity, minAmt, deadline)
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	addSlippageFlags(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func addSlippageFlags(cmd *cobra.Command) {
+	cmd.Flags().String(flagMinAmount, "", "minimum amount of coins to be moved")
+	cmd.Flags().String(flagDeadline, "", "time after which the transaction is rejected, in RFC3339 format")
+}
+
+func readSlippageFlags(cmd *cobra.Command) (minAmt sdk.Coins, deadline *time.Time, err error) {
+	minAmtStr, _ := cmd.Flags().GetString(flagMinAmount)
+	if minAmtStr != "" {
+		minAmt, err = sdk.ParseCoinsNormalized(minAmtStr)
+		if err != nil {
+			return nil, nil, fmt.Errorf("invalid min amount: %w", err)
+		}
+	}
+	deadlineStr, _ := cmd.Flags().GetString(flagDeadline)
+	if deadlineStr != "" {
+		t, err := time.Parse(time.RFC3339, deadlineStr)
+		if err != nil {
+			return nil, nil, fmt.Errorf("invalid deadline: %w", err)
+		}
+		deadline = &t
+	}
+	return minAmt, deadline, nil
+}
+
 func NewCollectCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "collect [position-id] [amount]",
@@ -193,6 +321,116 @@ $ %s tx %s collect 1 100000ucre,1000000uusd --from mykey
 	return cmd
 }
 
+func NewTransferPositionCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "transfer-position [position-id] [recipient]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Transfer the ownership of a position",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Transfer the ownership of a position to the recipient.
+The liquidity, fees and farming rewards accrued in the position are transferred altogether.
+
+Example:
+$ %s tx %s transfer-position 1 cre1mzgucqnfr2l8cj5apvdpllhzt4zeuh2c5l33n3 --from mykey
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			positionId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid position id: %w", err)
+			}
+			recipientAddr, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return fmt.Errorf("invalid recipient address: %w", err)
+			}
+			msg := types.NewMsgTransferPosition(
+				clientCtx.GetFromAddress(), positionId, recipientAddr)
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func NewCompoundCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "compound [position-id] [max-slippage]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Compound fees and farming rewards of a position",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Collect fees and farming rewards of a position and add them back to the position as liquidity.
+The excess coin is swapped through the pool's market first, and the swap fails
+if its output deviates from the pool's current price by more than max-slippage.
+
+Example:
+$ %s tx %s compound 1 0.01 --from mykey
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			positionId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid position id: %w", err)
+			}
+			maxSlippage, err := sdk.NewDecFromStr(args[1])
+			if err != nil {
+				return fmt.Errorf("invalid max slippage: %w", err)
+			}
+			msg := types.NewMsgCompound(clientCtx.GetFromAddress(), positionId, maxSlippage)
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func NewSetAutoCompoundCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-auto-compound [position-id] [enabled]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Enable or disable auto-compounding of a position",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Enable or disable auto-compounding of a position.
+Fees and farming rewards of auto-compounding positions are compounded at the beginning of blocks.
+
+Example:
+$ %s tx %s set-auto-compound 1 true --from mykey
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			positionId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid position id: %w", err)
+			}
+			enabled, err := strconv.ParseBool(args[1])
+			if err != nil {
+				return fmt.Errorf("invalid enabled flag: %w", err)
+			}
+			msg := types.NewMsgSetAutoCompound(clientCtx.GetFromAddress(), positionId, enabled)
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
 func NewCreatePrivateFarmingPlanCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "create-private-farming-plan [description] [termination-address] [start-time] [end-time] [reward-allocations...]",
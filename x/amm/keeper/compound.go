@@ -0,0 +1,200 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	utils "github.com/crescent-network/crescent/v5/types"
+	"github.com/crescent-network/crescent/v5/x/amm/types"
+)
+
+// Compound collects the position's fee and farming rewards and adds them back
+// to the position as liquidity.
+// The excess coin is swapped through the pool's market first so that the
+// collected coins match the ratio of the position at the pool's current price.
+// Since the pool's current price can be manipulated within a block, the TWAP of
+// the pool's market over CompoundTWAPWindow is used as the reference price.
+// Compounding fails if the pool's current price deviates from the reference
+// price by more than maxSlippage, or if the swap output is smaller than the
+// output expected at the reference price by more than maxSlippage.
+func (k Keeper) Compound(
+	ctx sdk.Context, ownerAddr sdk.AccAddress, positionId uint64,
+	maxSlippage sdk.Dec) (position types.Position, liquidity sdk.Int, amt sdk.Coins, err error) {
+	position, found := k.GetPosition(ctx, positionId)
+	if !found {
+		err = sdkerrors.Wrap(sdkerrors.ErrNotFound, "position not found")
+		return
+	}
+	if ownerAddr.String() != position.Owner {
+		err = sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "position is not owned by the user")
+		return
+	}
+	pool := k.MustGetPool(ctx, position.PoolId)
+
+	fee, farmingRewards, err := k.CollectibleCoins(ctx, positionId)
+	if err != nil {
+		return
+	}
+	collected := fee.Add(farmingRewards...)
+	amt0, amt1 := collected.AmountOf(pool.Denom0), collected.AmountOf(pool.Denom1)
+	if !amt0.IsPositive() && !amt1.IsPositive() {
+		err = sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "nothing to compound")
+		return
+	}
+	poolState := k.MustGetPoolState(ctx, pool.Id)
+	refPrice, err := k.exchangeKeeper.TWAP(ctx, pool.MarketId, types.CompoundTWAPWindow)
+	if err != nil {
+		return
+	}
+	if deviation := poolState.CurrentPrice.Sub(refPrice).Abs().Quo(refPrice); deviation.GT(maxSlippage) {
+		err = sdkerrors.Wrapf(
+			types.ErrPriceDeviated, "pool price %s, reference price %s", poolState.CurrentPrice, refPrice)
+		return
+	}
+
+	if err = k.Collect(ctx, ownerAddr, ownerAddr, positionId, collected); err != nil {
+		return
+	}
+
+	swapInput := k.compoundSwapInput(pool, position, poolState, amt0, amt1)
+	swapOutput := sdk.NewDecCoinFromDec(pool.Denom1, utils.ZeroDec)
+	if swapInput.Denom == pool.Denom1 {
+		swapOutput.Denom = pool.Denom0
+	}
+	if swapInput.IsPositive() {
+		var minOutput sdk.DecCoin
+		if swapInput.Denom == pool.Denom0 {
+			minOutput = sdk.NewDecCoinFromDec(
+				pool.Denom1, swapInput.Amount.MulTruncate(refPrice))
+		} else {
+			minOutput = sdk.NewDecCoinFromDec(
+				pool.Denom0, swapInput.Amount.QuoTruncate(refPrice))
+		}
+		minOutput.Amount = minOutput.Amount.MulTruncate(utils.OneDec.Sub(maxSlippage))
+		swapOutput, _, err = k.exchangeKeeper.SwapExactAmountIn(
+			ctx, ownerAddr, []uint64{pool.MarketId}, swapInput, minOutput, nil, false)
+		if err != nil {
+			return
+		}
+		if swapInput.Denom == pool.Denom0 {
+			amt0 = amt0.Sub(swapInput.Amount.TruncateInt())
+			amt1 = amt1.Add(swapOutput.Amount.TruncateInt())
+		} else {
+			amt0 = amt0.Add(swapOutput.Amount.TruncateInt())
+			amt1 = amt1.Sub(swapInput.Amount.TruncateInt())
+		}
+	}
+
+	desiredAmt := sdk.NewCoins(sdk.NewCoin(pool.Denom0, amt0), sdk.NewCoin(pool.Denom1, amt1))
+	position, liquidity, amt, err = k.addLiquidity(
+		ctx, pool, ownerAddr, ownerAddr, position.LowerTick, position.UpperTick, desiredAmt, nil)
+	if err != nil {
+		return
+	}
+
+	if err = ctx.EventManager().EmitTypedEvent(&types.EventCompound{
+		Owner:      ownerAddr.String(),
+		PositionId: positionId,
+		Collected:  collected,
+		SwapInput:  swapInput,
+		SwapOutput: swapOutput,
+		Liquidity:  liquidity,
+		Amount:     amt,
+	}); err != nil {
+		return
+	}
+	return
+}
+
+// compoundSwapInput returns the coin to be swapped so that amt0 and amt1 match
+// the ratio of the position's assets at the pool's current price.
+func (k Keeper) compoundSwapInput(
+	pool types.Pool, position types.Position, poolState types.PoolState, amt0, amt1 sdk.Int) sdk.DecCoin {
+	price := poolState.CurrentPrice
+	// Value of the coins in the pool's quote denom(denom1).
+	value0, value1 := amt0.ToDec().MulTruncate(price), amt1.ToDec()
+	var targetValue0 sdk.Dec
+	if poolState.CurrentTick < position.LowerTick {
+		targetValue0 = value0.Add(value1)
+	} else if poolState.CurrentTick < position.UpperTick {
+		sqrtPriceA := types.SqrtPriceAtTick(position.LowerTick)
+		sqrtPriceB := types.SqrtPriceAtTick(position.UpperTick)
+		currentSqrtPrice := utils.DecApproxSqrt(price)
+		// Value of the position's assets per unit liquidity.
+		unitValue0 := currentSqrtPrice.Sub(price.QuoTruncate(sqrtPriceB))
+		unitValue1 := currentSqrtPrice.Sub(sqrtPriceA)
+		targetValue0 = value0.Add(value1).MulTruncate(unitValue0).QuoTruncate(unitValue0.Add(unitValue1))
+	} else {
+		targetValue0 = utils.ZeroDec
+	}
+	if value0.GT(targetValue0) {
+		return sdk.NewDecCoin(pool.Denom0, value0.Sub(targetValue0).QuoTruncate(price).TruncateInt())
+	}
+	return sdk.NewDecCoin(pool.Denom1, targetValue0.Sub(value0).TruncateInt())
+}
+
+// SetAutoCompound enables or disables the position's auto-compounding.
+func (k Keeper) SetAutoCompound(ctx sdk.Context, ownerAddr sdk.AccAddress, positionId uint64, enabled bool) error {
+	position, found := k.GetPosition(ctx, positionId)
+	if !found {
+		return sdkerrors.Wrap(sdkerrors.ErrNotFound, "position not found")
+	}
+	if ownerAddr.String() != position.Owner {
+		return sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "position is not owned by the user")
+	}
+	position.AutoCompound = enabled
+	k.SetPosition(ctx, position)
+	if enabled {
+		k.SetAutoCompoundPositionIndex(ctx, position)
+	} else {
+		k.DeleteAutoCompoundPositionIndex(ctx, position)
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventSetAutoCompound{
+		Owner:      ownerAddr.String(),
+		PositionId: positionId,
+		Enabled:    enabled,
+	}); err != nil {
+		return err
+	}
+	return nil
+}
+
+// AutoCompoundPositions compounds the auto-compounding positions in batches.
+// At most MaxNumAutoCompoundsPerBlock positions are processed in a block,
+// continuing from where the last batch ended.
+// Positions which failed to compound, e.g. due to the slippage limit, are
+// skipped until the next round.
+func (k Keeper) AutoCompoundPositions(ctx sdk.Context) {
+	maxNum := int(k.GetMaxNumAutoCompoundsPerBlock(ctx))
+	if maxNum == 0 {
+		return
+	}
+	maxSlippage := k.GetAutoCompoundMaxSlippage(ctx)
+
+	var positions []types.Position
+	k.IterateAutoCompoundPositions(ctx, k.GetLastAutoCompoundPositionId(ctx), func(position types.Position) (stop bool) {
+		positions = append(positions, position)
+		return len(positions) > maxNum
+	})
+	lastPositionId := uint64(0) // Start over from the first position in the next block.
+	if len(positions) > maxNum {
+		positions = positions[:maxNum]
+		lastPositionId = positions[maxNum-1].Id
+	}
+
+	for _, position := range positions {
+		if !position.Liquidity.IsPositive() {
+			continue
+		}
+		cacheCtx, writeCache := ctx.CacheContext()
+		if _, _, _, err := k.Compound(
+			cacheCtx, position.MustGetOwnerAddress(), position.Id, maxSlippage); err != nil {
+			k.Logger(ctx).Debug("failed to auto-compound position", "position_id", position.Id, "error", err)
+			continue
+		}
+		writeCache()
+		ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+	}
+	k.SetLastAutoCompoundPositionId(ctx, lastPositionId)
+}
@@ -0,0 +1,134 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	utils "github.com/crescent-network/crescent/v5/types"
+	"github.com/crescent-network/crescent/v5/x/amm/types"
+	exchangetypes "github.com/crescent-network/crescent/v5/x/exchange/types"
+)
+
+func (s *KeeperTestSuite) TestCompound() {
+	market, pool := s.CreateMarketAndPool("ucre", "uusd", utils.ParseDec("5"))
+	lpAddr := s.FundedAccount(1, enoughCoins)
+	position, _, _ := s.AddLiquidity(
+		lpAddr, pool.Id, utils.ParseDec("4.5"), utils.ParseDec("5.5"),
+		utils.ParseCoins("100_000000ucre,500_000000uusd"))
+
+	_, _, _, err := s.keeper.Compound(s.Ctx, lpAddr, position.Id, utils.ParseDec("0.01"))
+	s.Require().EqualError(err, "nothing to compound: invalid request")
+
+	// Accrue fees.
+	ordererAddr := s.FundedAccount(2, enoughCoins)
+	s.PlaceLimitOrder(market.Id, ordererAddr, true, utils.ParseDec("6"), sdk.NewDec(10_000000), 0)
+	s.PlaceMarketOrder(market.Id, ordererAddr, false, sdk.NewDec(5_000000))
+
+	// The market's TWAP is not available yet.
+	_, _, _, err = s.keeper.Compound(s.Ctx, lpAddr, position.Id, utils.ParseDec("0.01"))
+	s.Require().ErrorIs(err, exchangetypes.ErrNotEnoughPriceHistory)
+
+	s.EndBlock()
+	s.BeginBlock(types.CompoundTWAPWindow)
+	fee, farmingRewards := s.CollectibleCoins(position.Id)
+	s.AssertEqual(utils.ParseCoins("17433ucre,63317uusd"), fee)
+	s.Require().True(farmingRewards.IsZero())
+
+	_, _, _, err = s.keeper.Compound(s.Ctx, utils.TestAddress(3), position.Id, utils.ParseDec("0.01"))
+	s.Require().EqualError(err, "position is not owned by the user: unauthorized")
+
+	// The pool price slightly deviates from the market's TWAP.
+	cacheCtx, _ := s.Ctx.CacheContext()
+	_, _, _, err = s.keeper.Compound(cacheCtx, lpAddr, position.Id, utils.ZeroDec)
+	s.Require().EqualError(err, "pool price 5.025770039166966098, reference price 5.025000000000000000: pool price deviates from the reference price")
+	// The swap cannot be done with too small slippage.
+	cacheCtx, _ = s.Ctx.CacheContext()
+	_, _, _, err = s.keeper.Compound(cacheCtx, lpAddr, position.Id, utils.ParseDec("0.0002"))
+	s.Require().ErrorIs(err, exchangetypes.ErrSwapNotEnoughOutput)
+
+	// Compounding fails when the pool price is manipulated within the block.
+	cacheCtx, _ = s.Ctx.CacheContext()
+	_, _, err = s.App.ExchangeKeeper.PlaceMarketOrder(cacheCtx, market.Id, ordererAddr, true, sdk.NewDec(50_000000), nil)
+	s.Require().NoError(err)
+	_, _, _, err = s.keeper.Compound(cacheCtx, lpAddr, position.Id, utils.ParseDec("0.01"))
+	s.Require().ErrorIs(err, types.ErrPriceDeviated)
+
+	balancesBefore := s.GetAllBalances(lpAddr)
+	s.Ctx = s.Ctx.WithEventManager(sdk.NewEventManager())
+	position2, liquidity, amt := s.Compound(lpAddr, position.Id, utils.ParseDec("0.01"))
+	s.AssertEqual(sdk.NewInt(687645), liquidity)
+	s.AssertEqual(utils.ParseCoins("13523ucre,82860uusd"), amt)
+	s.Require().Equal(position.Liquidity.Add(liquidity), position2.Liquidity)
+	s.CheckEvent(&types.EventCompound{}, map[string][]byte{
+		"owner":       []byte(`"` + lpAddr.String() + `"`),
+		"position_id": []byte(`"1"`),
+		"collected":   []byte(`[{"denom":"ucre","amount":"17433"},{"denom":"uusd","amount":"63317"}]`),
+		"liquidity":   []byte(`"687645"`),
+	})
+	// Only the dust is left to the owner.
+	s.AssertEqual(utils.ParseCoins("9ucre"), s.GetAllBalances(lpAddr).Sub(balancesBefore))
+
+	// The position earned fee from its own swap.
+	fee, _ = s.CollectibleCoins(position.Id)
+	s.AssertEqual(utils.ParseCoins("28uusd"), fee)
+}
+
+func (s *KeeperTestSuite) TestAutoCompound() {
+	market, pool := s.CreateMarketAndPool("ucre", "uusd", utils.ParseDec("5"))
+	lpAddr1 := s.FundedAccount(1, enoughCoins)
+	lpAddr2 := s.FundedAccount(2, enoughCoins)
+	lpAddr3 := s.FundedAccount(3, enoughCoins)
+	var positions []types.Position
+	for _, lpAddr := range []sdk.AccAddress{lpAddr1, lpAddr2, lpAddr3} {
+		position, _, _ := s.AddLiquidity(
+			lpAddr, pool.Id, utils.ParseDec("4.5"), utils.ParseDec("5.5"),
+			utils.ParseCoins("100_000000ucre,500_000000uusd"))
+		positions = append(positions, position)
+	}
+	s.SetAutoCompound(lpAddr1, positions[0].Id, true)
+	s.SetAutoCompound(lpAddr2, positions[1].Id, true)
+	s.Require().True(s.keeper.MustGetPosition(s.Ctx, positions[0].Id).AutoCompound)
+	s.Require().False(s.keeper.MustGetPosition(s.Ctx, positions[2].Id).AutoCompound)
+
+	err := s.keeper.SetAutoCompound(s.Ctx, lpAddr1, positions[1].Id, true)
+	s.Require().EqualError(err, "position is not owned by the user: unauthorized")
+
+	s.keeper.SetMaxNumAutoCompoundsPerBlock(s.Ctx, 1)
+
+	// Accrue fees.
+	ordererAddr := s.FundedAccount(4, enoughCoins)
+	s.PlaceLimitOrder(market.Id, ordererAddr, true, utils.ParseDec("6"), sdk.NewDec(10_000000), 0)
+	s.PlaceMarketOrder(market.Id, ordererAddr, false, sdk.NewDec(5_000000))
+
+	liquidity := func(positionId uint64) sdk.Int {
+		return s.keeper.MustGetPosition(s.Ctx, positionId).Liquidity
+	}
+
+	// Only the first position is compounded in this block, once the market's
+	// TWAP is available.
+	s.EndBlock()
+	s.BeginBlock(types.CompoundTWAPWindow)
+	s.Require().True(liquidity(positions[0].Id).GT(positions[0].Liquidity))
+	s.AssertEqual(positions[1].Liquidity, liquidity(positions[1].Id))
+	s.Require().EqualValues(positions[0].Id, s.keeper.GetLastAutoCompoundPositionId(s.Ctx))
+
+	// Then the second position.
+	s.NextBlock()
+	s.Require().True(liquidity(positions[1].Id).GT(positions[1].Liquidity))
+	s.Require().EqualValues(0, s.keeper.GetLastAutoCompoundPositionId(s.Ctx))
+
+	// Positions without auto-compounding are left as is.
+	s.AssertEqual(positions[2].Liquidity, liquidity(positions[2].Id))
+	fee, _ := s.CollectibleCoins(positions[2].Id)
+	s.Require().True(fee.IsAllPositive())
+
+	// Auto-compounding is disabled when transferring the position.
+	s.TransferPosition(lpAddr2, positions[1].Id, utils.TestAddress(5))
+	s.Require().False(s.keeper.MustGetPosition(s.Ctx, positions[1].Id).AutoCompound)
+	s.SetAutoCompound(lpAddr1, positions[0].Id, false)
+	var autoCompoundPositionIds []uint64
+	s.keeper.IterateAutoCompoundPositions(s.Ctx, 0, func(position types.Position) (stop bool) {
+		autoCompoundPositionIds = append(autoCompoundPositionIds, position.Id)
+		return false
+	})
+	s.Require().Empty(autoCompoundPositionIds)
+}
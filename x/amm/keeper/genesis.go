@@ -18,13 +18,19 @@ func (k Keeper) InitGenesis(ctx sdk.Context, genState types.GenesisState) {
 	for _, poolRecord := range genState.PoolRecords {
 		k.SetPool(ctx, poolRecord.Pool)
 		k.SetPoolByReserveAddressIndex(ctx, poolRecord.Pool)
-		k.SetPoolByMarketIndex(ctx, poolRecord.Pool)
+		k.SetPoolsByMarketIndex(ctx, poolRecord.Pool)
 		k.SetPoolState(ctx, poolRecord.Pool.Id, poolRecord.State)
+		for i, observation := range poolRecord.Observations {
+			k.SetObservation(ctx, poolRecord.Pool.Id, uint32(i), observation)
+		}
 	}
 	for _, position := range genState.Positions {
 		k.SetPosition(ctx, position)
 		k.SetPositionByParamsIndex(ctx, position)
 		k.SetPositionsByPoolIndex(ctx, position)
+		if position.AutoCompound {
+			k.SetAutoCompoundPositionIndex(ctx, position)
+		}
 	}
 	for _, tickInfoRecord := range genState.TickInfoRecords {
 		k.SetTickInfo(ctx, tickInfoRecord.PoolId, tickInfoRecord.Tick, tickInfoRecord.TickInfo)
@@ -38,15 +44,24 @@ func (k Keeper) InitGenesis(ctx sdk.Context, genState types.GenesisState) {
 	for _, plan := range genState.FarmingPlans {
 		k.SetFarmingPlan(ctx, plan)
 	}
+	if genState.LastAutoCompoundPositionId > 0 {
+		k.SetLastAutoCompoundPositionId(ctx, genState.LastAutoCompoundPositionId)
+	}
 }
 
 // ExportGenesis returns the module's exported genesis.
 func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 	poolRecords := []types.PoolRecord{}
 	k.IterateAllPools(ctx, func(pool types.Pool) (stop bool) {
+		observations := []types.Observation{}
+		k.IterateObservationsByPool(ctx, pool.Id, func(observation types.Observation) (stop bool) {
+			observations = append(observations, observation)
+			return false
+		})
 		poolRecords = append(poolRecords, types.PoolRecord{
-			Pool:  pool,
-			State: k.MustGetPoolState(ctx, pool.Id),
+			Pool:         pool,
+			State:        k.MustGetPoolState(ctx, pool.Id),
+			Observations: observations,
 		})
 		return false
 	})
@@ -78,5 +93,6 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 		tickInfoRecords,
 		k.GetLastFarmingPlanId(ctx),
 		k.GetNumPrivateFarmingPlans(ctx),
-		farmingPlans)
+		farmingPlans,
+		k.GetLastAutoCompoundPositionId(ctx))
 }
@@ -8,9 +8,10 @@ import (
 func (s *KeeperTestSuite) TestImportExportGenesis() {
 	s.CreateMarketAndPool("ucre", "uusd", utils.ParseDec("0.005"))
 	lpAddr := s.FundedAccount(1, enoughCoins)
-	s.AddLiquidity(
+	position, _, _ := s.AddLiquidity(
 		lpAddr, 1, utils.ParseDec("0.003"), utils.ParseDec("0.007"),
 		utils.ParseCoins("1000_000000ucre,1000_000000uusd"))
+	s.SetAutoCompound(lpAddr, position.Id, true)
 	s.CreatePrivateFarmingPlan(
 		utils.TestAddress(1), "Farming plan", utils.TestAddress(2), []types.FarmingRewardAllocation{
 			types.NewFarmingRewardAllocation(1, utils.ParseCoins("10_000000uatom")),
@@ -19,6 +20,7 @@ func (s *KeeperTestSuite) TestImportExportGenesis() {
 
 	s.NextBlock()
 	s.NextBlock()
+	s.keeper.SetLastAutoCompoundPositionId(s.Ctx, position.Id)
 
 	genState := s.keeper.ExportGenesis(s.Ctx)
 	bz := s.App.AppCodec().MustMarshalJSON(genState)
@@ -29,6 +31,14 @@ func (s *KeeperTestSuite) TestImportExportGenesis() {
 	s.keeper.InitGenesis(s.Ctx, genState2)
 	genState3 := s.keeper.ExportGenesis(s.Ctx)
 	s.Require().Equal(*genState, *genState3)
+
+	var autoCompoundPositionIds []uint64
+	s.keeper.IterateAutoCompoundPositions(s.Ctx, 0, func(position types.Position) (stop bool) {
+		autoCompoundPositionIds = append(autoCompoundPositionIds, position.Id)
+		return false
+	})
+	s.Require().Equal([]uint64{position.Id}, autoCompoundPositionIds)
+	s.Require().Equal(position.Id, s.keeper.GetLastAutoCompoundPositionId(s.Ctx))
 }
 
 func (s *KeeperTestSuite) TestImportExportGenesisEmpty() {
@@ -47,9 +47,9 @@ func (k Querier) AllPools(c context.Context, req *types.QueryAllPoolsRequest) (*
 		if found := k.exchangeKeeper.LookupMarket(ctx, req.MarketId); !found {
 			return nil, status.Error(codes.NotFound, "market not found")
 		}
-		keyPrefix = types.GetPoolByMarketIndexKey(req.MarketId)
-		poolGetter = func(_, value []byte) types.Pool {
-			return k.MustGetPool(ctx, sdk.BigEndianToUint64(value))
+		keyPrefix = types.GetPoolsByMarketIteratorPrefix(req.MarketId)
+		poolGetter = func(key, _ []byte) types.Pool {
+			return k.MustGetPool(ctx, sdk.BigEndianToUint64(key))
 		}
 	} else {
 		keyPrefix = types.PoolKeyPrefix
@@ -364,6 +364,30 @@ func (k Querier) TickInfo(c context.Context, req *types.QueryTickInfoRequest) (*
 	}, nil
 }
 
+func (k Querier) Observe(c context.Context, req *types.QueryObserveRequest) (*types.QueryObserveResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if req.PoolId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "pool id must not be 0")
+	}
+	if len(req.SecondsAgos) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "seconds agos must not be empty")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	if found := k.LookupPool(ctx, req.PoolId); !found {
+		return nil, status.Error(codes.NotFound, "pool not found")
+	}
+	tickCumulatives, secondsPerLiquidityCumulatives, err := k.Keeper.Observe(ctx, req.PoolId, req.SecondsAgos)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	return &types.QueryObserveResponse{
+		TickCumulatives:                tickCumulatives,
+		SecondsPerLiquidityCumulatives: secondsPerLiquidityCumulatives,
+	}, nil
+}
+
 func (k Querier) AllFarmingPlans(c context.Context, req *types.QueryAllFarmingPlansRequest) (*types.QueryAllFarmingPlansResponse, error) {
 	if req == nil {
 		return nil, status.Error(codes.InvalidArgument, "empty request")
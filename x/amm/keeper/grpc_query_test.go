@@ -770,6 +770,76 @@ func (s *KeeperTestSuite) TestQueryTickInfo() {
 	}
 }
 
+func (s *KeeperTestSuite) TestQueryObserve() {
+	s.SetupSampleScenario()
+	s.NextBlock()
+
+	for _, tc := range []struct {
+		name        string
+		req         *types.QueryObserveRequest
+		expectedErr string
+		postRun     func(resp *types.QueryObserveResponse)
+	}{
+		{
+			"happy case",
+			&types.QueryObserveRequest{
+				PoolId:      1,
+				SecondsAgos: []uint32{5, 0},
+			},
+			"",
+			func(resp *types.QueryObserveResponse) {
+				s.Require().Equal([]int64{1350000, 1800985}, resp.TickCumulatives)
+				s.Require().Len(resp.SecondsPerLiquidityCumulatives, 2)
+			},
+		},
+		{
+			"empty seconds agos",
+			&types.QueryObserveRequest{
+				PoolId: 1,
+			},
+			"rpc error: code = InvalidArgument desc = seconds agos must not be empty",
+			nil,
+		},
+		{
+			"too old",
+			&types.QueryObserveRequest{
+				PoolId:      1,
+				SecondsAgos: []uint32{10},
+			},
+			"rpc error: code = InvalidArgument desc = target time 2023-01-01 00:00:10 +0000 UTC is older than the oldest observation 2023-01-01 00:00:15 +0000 UTC: invalid request",
+			nil,
+		},
+		{
+			"pool id 0",
+			&types.QueryObserveRequest{
+				PoolId:      0,
+				SecondsAgos: []uint32{0},
+			},
+			"rpc error: code = InvalidArgument desc = pool id must not be 0",
+			nil,
+		},
+		{
+			"not found",
+			&types.QueryObserveRequest{
+				PoolId:      4,
+				SecondsAgos: []uint32{0},
+			},
+			"rpc error: code = NotFound desc = pool not found",
+			nil,
+		},
+	} {
+		s.Run(tc.name, func() {
+			resp, err := s.querier.Observe(sdk.WrapSDKContext(s.Ctx), tc.req)
+			if tc.expectedErr == "" {
+				s.Require().NoError(err)
+				tc.postRun(resp)
+			} else {
+				s.Require().EqualError(err, tc.expectedErr)
+			}
+		})
+	}
+}
+
 func (s *KeeperTestSuite) TestQueryAllFarmingPlans() {
 	s.SetupSampleScenario()
 
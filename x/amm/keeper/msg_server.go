@@ -23,7 +23,7 @@ func NewMsgServerImpl(keeper Keeper) types.MsgServer {
 
 func (k msgServer) CreatePool(goCtx context.Context, msg *types.MsgCreatePool) (*types.MsgCreatePoolResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
-	pool, err := k.Keeper.CreatePool(ctx, sdk.MustAccAddressFromBech32(msg.Sender), msg.MarketId, msg.Price)
+	pool, err := k.Keeper.CreatePool(ctx, sdk.MustAccAddressFromBech32(msg.Sender), msg.MarketId, msg.Price, msg.TickSpacing)
 	if err != nil {
 		return nil, err
 	}
@@ -57,6 +57,39 @@ func (k msgServer) RemoveLiquidity(goCtx context.Context, msg *types.MsgRemoveLi
 	}, nil
 }
 
+func (k msgServer) IncreaseLiquidity(goCtx context.Context, msg *types.MsgIncreaseLiquidity) (*types.MsgIncreaseLiquidityResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if msg.Deadline != nil && ctx.BlockTime().After(*msg.Deadline) {
+		return nil, sdkerrors.Wrapf(types.ErrDeadlinePassed, "deadline %s", msg.Deadline.UTC())
+	}
+	senderAddr := sdk.MustAccAddressFromBech32(msg.Sender)
+	_, liquidity, amt, err := k.Keeper.IncreaseLiquidity(
+		ctx, senderAddr, senderAddr, msg.PositionId, msg.DesiredAmount, msg.MinAmount)
+	if err != nil {
+		return nil, err
+	}
+	return &types.MsgIncreaseLiquidityResponse{
+		Liquidity: liquidity,
+		Amount:    amt,
+	}, nil
+}
+
+func (k msgServer) DecreaseLiquidity(goCtx context.Context, msg *types.MsgDecreaseLiquidity) (*types.MsgDecreaseLiquidityResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if msg.Deadline != nil && ctx.BlockTime().After(*msg.Deadline) {
+		return nil, sdkerrors.Wrapf(types.ErrDeadlinePassed, "deadline %s", msg.Deadline.UTC())
+	}
+	senderAddr := sdk.MustAccAddressFromBech32(msg.Sender)
+	_, amt, err := k.Keeper.DecreaseLiquidity(
+		ctx, senderAddr, senderAddr, msg.PositionId, msg.Liquidity, msg.MinAmount)
+	if err != nil {
+		return nil, err
+	}
+	return &types.MsgDecreaseLiquidityResponse{
+		Amount: amt,
+	}, nil
+}
+
 func (k msgServer) Collect(goCtx context.Context, msg *types.MsgCollect) (*types.MsgCollectResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 	senderAddr := sdk.MustAccAddressFromBech32(msg.Sender)
@@ -67,6 +100,38 @@ func (k msgServer) Collect(goCtx context.Context, msg *types.MsgCollect) (*types
 	return &types.MsgCollectResponse{}, nil
 }
 
+func (k msgServer) TransferPosition(goCtx context.Context, msg *types.MsgTransferPosition) (*types.MsgTransferPositionResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.Keeper.TransferPosition(
+		ctx, sdk.MustAccAddressFromBech32(msg.Sender), msg.PositionId,
+		sdk.MustAccAddressFromBech32(msg.Recipient)); err != nil {
+		return nil, err
+	}
+	return &types.MsgTransferPositionResponse{}, nil
+}
+
+func (k msgServer) Compound(goCtx context.Context, msg *types.MsgCompound) (*types.MsgCompoundResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	_, liquidity, amt, err := k.Keeper.Compound(
+		ctx, sdk.MustAccAddressFromBech32(msg.Sender), msg.PositionId, msg.MaxSlippage)
+	if err != nil {
+		return nil, err
+	}
+	return &types.MsgCompoundResponse{
+		Liquidity: liquidity,
+		Amount:    amt,
+	}, nil
+}
+
+func (k msgServer) SetAutoCompound(goCtx context.Context, msg *types.MsgSetAutoCompound) (*types.MsgSetAutoCompoundResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.Keeper.SetAutoCompound(
+		ctx, sdk.MustAccAddressFromBech32(msg.Sender), msg.PositionId, msg.Enabled); err != nil {
+		return nil, err
+	}
+	return &types.MsgSetAutoCompoundResponse{}, nil
+}
+
 func (k msgServer) CreatePrivateFarmingPlan(goCtx context.Context, msg *types.MsgCreatePrivateFarmingPlan) (*types.MsgCreatePrivateFarmingPlanResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 	plan, err := k.Keeper.CreatePrivateFarmingPlan(
@@ -1,6 +1,8 @@
 package keeper_test
 
 import (
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	utils "github.com/crescent-network/crescent/v5/types"
@@ -73,3 +75,152 @@ func (s *KeeperTestSuite) TestMsgServer_TerminatePrivateFarmingPlan() {
 		})
 	}
 }
+
+func (s *KeeperTestSuite) TestMsgServer_IncreaseLiquidity() {
+	_, pool := s.CreateMarketAndPool("ucre", "uusd", utils.ParseDec("5"))
+	lpAddr := s.FundedAccount(1, enoughCoins)
+	position, _, _ := s.AddLiquidity(
+		lpAddr, pool.Id, utils.ParseDec("4.5"), utils.ParseDec("5.5"),
+		utils.ParseCoins("100_000000ucre,500_000000uusd"))
+	otherAddr := s.FundedAccount(2, enoughCoins)
+	desiredAmt := utils.ParseCoins("10_000000ucre,50_000000uusd")
+	deadline := s.Ctx.BlockTime().Add(time.Minute)
+	passedDeadline := s.Ctx.BlockTime().Add(-time.Second)
+	var balancesBefore sdk.Coins
+
+	for _, tc := range []struct {
+		name        string
+		msg         *types.MsgIncreaseLiquidity
+		expectedErr string
+		postRun     func(resp *types.MsgIncreaseLiquidityResponse)
+	}{
+		{
+			"happy case",
+			types.NewMsgIncreaseLiquidity(
+				lpAddr, position.Id, desiredAmt, utils.ParseCoins("9_000000ucre,45_000000uusd"), &deadline),
+			"",
+			func(resp *types.MsgIncreaseLiquidityResponse) {
+				s.Require().True(resp.Liquidity.IsPositive())
+				s.Require().True(desiredAmt.IsAllGTE(resp.Amount))
+				s.AssertEqual(balancesBefore.Sub(resp.Amount), s.GetAllBalances(lpAddr))
+				position2 := s.keeper.MustGetPosition(s.Ctx, position.Id)
+				s.AssertEqual(position.Liquidity.Add(resp.Liquidity), position2.Liquidity)
+			},
+		},
+		{
+			"no deadline and min amount",
+			types.NewMsgIncreaseLiquidity(lpAddr, position.Id, desiredAmt, nil, nil),
+			"",
+			func(resp *types.MsgIncreaseLiquidityResponse) {
+				s.Require().True(resp.Amount.IsAllPositive())
+			},
+		},
+		{
+			"deadline passed",
+			types.NewMsgIncreaseLiquidity(lpAddr, position.Id, desiredAmt, nil, &passedDeadline),
+			"deadline 2022-12-31 23:59:59 +0000 UTC: deadline passed",
+			nil,
+		},
+		{
+			"slippage exceeded",
+			types.NewMsgIncreaseLiquidity(
+				lpAddr, position.Id, desiredAmt, utils.ParseCoins("10_000000ucre,50_000000uusd"), nil),
+			"added amount 9068668ucre,50000000uusd is smaller than the min amount 10000000ucre,50000000uusd: amount is smaller than the min amount",
+			nil,
+		},
+		{
+			"not owner",
+			types.NewMsgIncreaseLiquidity(otherAddr, position.Id, desiredAmt, nil, nil),
+			"position is not owned by the user: unauthorized",
+			nil,
+		},
+		{
+			"position not found",
+			types.NewMsgIncreaseLiquidity(lpAddr, 10, desiredAmt, nil, nil),
+			"position not found: not found",
+			nil,
+		},
+	} {
+		s.Run(tc.name, func() {
+			oldCtx := s.Ctx
+			s.Ctx, _ = s.Ctx.CacheContext()
+			s.Require().NoError(tc.msg.ValidateBasic())
+			balancesBefore = s.GetAllBalances(lpAddr)
+			resp, err := s.msgServer.IncreaseLiquidity(sdk.WrapSDKContext(s.Ctx), tc.msg)
+			if tc.expectedErr == "" {
+				s.Require().NoError(err)
+				tc.postRun(resp)
+			} else {
+				s.Require().EqualError(err, tc.expectedErr)
+			}
+			s.Ctx = oldCtx
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestMsgServer_DecreaseLiquidity() {
+	_, pool := s.CreateMarketAndPool("ucre", "uusd", utils.ParseDec("5"))
+	lpAddr := s.FundedAccount(1, enoughCoins)
+	position, _, _ := s.AddLiquidity(
+		lpAddr, pool.Id, utils.ParseDec("4.5"), utils.ParseDec("5.5"),
+		utils.ParseCoins("100_000000ucre,500_000000uusd"))
+	otherAddr := s.FundedAccount(2, enoughCoins)
+	liquidity := position.Liquidity.QuoRaw(2)
+	deadline := s.Ctx.BlockTime().Add(time.Minute)
+	passedDeadline := s.Ctx.BlockTime().Add(-time.Second)
+	var balancesBefore sdk.Coins
+
+	for _, tc := range []struct {
+		name        string
+		msg         *types.MsgDecreaseLiquidity
+		expectedErr string
+		postRun     func(resp *types.MsgDecreaseLiquidityResponse)
+	}{
+		{
+			"happy case",
+			types.NewMsgDecreaseLiquidity(
+				lpAddr, position.Id, liquidity, utils.ParseCoins("40_000000ucre,200_000000uusd"), &deadline),
+			"",
+			func(resp *types.MsgDecreaseLiquidityResponse) {
+				s.Require().True(resp.Amount.IsAllPositive())
+				s.AssertEqual(balancesBefore.Add(resp.Amount...), s.GetAllBalances(lpAddr))
+				position2 := s.keeper.MustGetPosition(s.Ctx, position.Id)
+				s.AssertEqual(position.Liquidity.Sub(liquidity), position2.Liquidity)
+			},
+		},
+		{
+			"deadline passed",
+			types.NewMsgDecreaseLiquidity(lpAddr, position.Id, liquidity, nil, &passedDeadline),
+			"deadline 2022-12-31 23:59:59 +0000 UTC: deadline passed",
+			nil,
+		},
+		{
+			"slippage exceeded",
+			types.NewMsgDecreaseLiquidity(
+				lpAddr, position.Id, liquidity, utils.ParseCoins("50_000000ucre"), nil),
+			"withdrawn amount 45343337ucre,249999999uusd is smaller than the min amount 50000000ucre: amount is smaller than the min amount",
+			nil,
+		},
+		{
+			"not owner",
+			types.NewMsgDecreaseLiquidity(otherAddr, position.Id, liquidity, nil, nil),
+			"position is not owned by the user: unauthorized",
+			nil,
+		},
+	} {
+		s.Run(tc.name, func() {
+			oldCtx := s.Ctx
+			s.Ctx, _ = s.Ctx.CacheContext()
+			s.Require().NoError(tc.msg.ValidateBasic())
+			balancesBefore = s.GetAllBalances(lpAddr)
+			resp, err := s.msgServer.DecreaseLiquidity(sdk.WrapSDKContext(s.Ctx), tc.msg)
+			if tc.expectedErr == "" {
+				s.Require().NoError(err)
+				tc.postRun(resp)
+			} else {
+				s.Require().EqualError(err, tc.expectedErr)
+			}
+			s.Ctx = oldCtx
+		})
+	}
+}
@@ -0,0 +1,161 @@
+package keeper
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/crescent-network/crescent/v5/x/amm/types"
+)
+
+// writeObservation writes a new observation to the pool's observations array
+// with the tick and liquidity before the change.
+// At most one observation is written per block, and the observations array
+// grows to the pool's next observation cardinality when it wraps around.
+// poolState is updated in place and the caller is responsible for saving it.
+func (k Keeper) writeObservation(
+	ctx sdk.Context, poolId uint64, poolState *types.PoolState, tick int32, liquidity sdk.Int) {
+	last := k.MustGetObservation(ctx, poolId, poolState.ObservationIndex)
+	blockTime := ctx.BlockTime()
+	if last.BlockTime.Unix() == blockTime.Unix() {
+		return
+	}
+	cardinality := poolState.ObservationCardinality
+	if poolState.ObservationCardinalityNext > cardinality &&
+		poolState.ObservationIndex == cardinality-1 {
+		cardinality = poolState.ObservationCardinalityNext
+	}
+	poolState.ObservationIndex = (poolState.ObservationIndex + 1) % cardinality
+	poolState.ObservationCardinality = cardinality
+	k.SetObservation(ctx, poolId, poolState.ObservationIndex, last.Transform(blockTime, tick, liquidity))
+}
+
+// GrowObservationCardinality sets the number of observations to be stored for
+// the pool.
+// The cardinality cannot be decreased.
+func (k Keeper) GrowObservationCardinality(ctx sdk.Context, poolId uint64, cardinality uint32) error {
+	if cardinality > types.MaxObservationCardinality {
+		return sdkerrors.Wrapf(
+			sdkerrors.ErrInvalidRequest, "observation cardinality must not be greater than %d: %d",
+			types.MaxObservationCardinality, cardinality)
+	}
+	poolState := k.MustGetPoolState(ctx, poolId)
+	if cardinality <= poolState.ObservationCardinalityNext {
+		return sdkerrors.Wrapf(
+			sdkerrors.ErrInvalidRequest, "observation cardinality must be greater than %d: %d",
+			poolState.ObservationCardinalityNext, cardinality)
+	}
+	poolState.ObservationCardinalityNext = cardinality
+	k.SetPoolState(ctx, poolId, poolState)
+	return nil
+}
+
+// Observe returns the pool's tick cumulatives and seconds per liquidity
+// cumulatives as of each of secondsAgos seconds ago from the current block
+// time.
+// The time-weighted mean tick between two points of time can be calculated
+// with types.MeanTick.
+func (k Keeper) Observe(
+	ctx sdk.Context, poolId uint64,
+	secondsAgos []uint32) (tickCumulatives []int64, secondsPerLiquidityCumulatives []sdk.Dec, err error) {
+	poolState, found := k.GetPoolState(ctx, poolId)
+	if !found {
+		return nil, nil, sdkerrors.Wrap(sdkerrors.ErrNotFound, "pool not found")
+	}
+	tickCumulatives = make([]int64, len(secondsAgos))
+	secondsPerLiquidityCumulatives = make([]sdk.Dec, len(secondsAgos))
+	for i, secondsAgo := range secondsAgos {
+		observation, err := k.observeSingle(ctx, poolId, poolState, secondsAgo)
+		if err != nil {
+			return nil, nil, err
+		}
+		tickCumulatives[i] = observation.TickCumulative
+		secondsPerLiquidityCumulatives[i] = observation.SecondsPerLiquidityCumulative
+	}
+	return tickCumulatives, secondsPerLiquidityCumulatives, nil
+}
+
+func (k Keeper) observeSingle(
+	ctx sdk.Context, poolId uint64, poolState types.PoolState, secondsAgo uint32) (types.Observation, error) {
+	blockTime := ctx.BlockTime()
+	if secondsAgo == 0 {
+		last := k.MustGetObservation(ctx, poolId, poolState.ObservationIndex)
+		if last.BlockTime.Unix() != blockTime.Unix() {
+			last = last.Transform(blockTime, poolState.CurrentTick, poolState.CurrentLiquidity)
+		}
+		return last, nil
+	}
+
+	target := blockTime.Unix() - int64(secondsAgo)
+	beforeOrAt, atOrAfter, err := k.surroundingObservations(ctx, poolId, poolState, target)
+	if err != nil {
+		return types.Observation{}, err
+	}
+	if target == beforeOrAt.BlockTime.Unix() {
+		return beforeOrAt, nil
+	}
+	if target == atOrAfter.BlockTime.Unix() {
+		return atOrAfter, nil
+	}
+	// Interpolate between the two observations.
+	observationTimeDelta := atOrAfter.BlockTime.Unix() - beforeOrAt.BlockTime.Unix()
+	targetDelta := target - beforeOrAt.BlockTime.Unix()
+	return types.Observation{
+		BlockTime: time.Unix(target, 0).UTC(),
+		TickCumulative: beforeOrAt.TickCumulative +
+			(atOrAfter.TickCumulative-beforeOrAt.TickCumulative)/observationTimeDelta*targetDelta,
+		SecondsPerLiquidityCumulative: beforeOrAt.SecondsPerLiquidityCumulative.Add(
+			atOrAfter.SecondsPerLiquidityCumulative.Sub(beforeOrAt.SecondsPerLiquidityCumulative).
+				MulInt64(targetDelta).QuoInt64(observationTimeDelta)),
+	}, nil
+}
+
+// surroundingObservations returns the observations right before or at and
+// right at or after the target time.
+func (k Keeper) surroundingObservations(
+	ctx sdk.Context, poolId uint64, poolState types.PoolState,
+	target int64) (beforeOrAt, atOrAfter types.Observation, err error) {
+	beforeOrAt = k.MustGetObservation(ctx, poolId, poolState.ObservationIndex)
+	if beforeOrAt.BlockTime.Unix() <= target {
+		if beforeOrAt.BlockTime.Unix() == target {
+			return beforeOrAt, beforeOrAt, nil
+		}
+		return beforeOrAt, beforeOrAt.Transform(
+			time.Unix(target, 0).UTC(), poolState.CurrentTick, poolState.CurrentLiquidity), nil
+	}
+
+	oldest, found := k.GetObservation(
+		ctx, poolId, (poolState.ObservationIndex+1)%poolState.ObservationCardinality)
+	if !found { // The observations array hasn't wrapped around yet.
+		oldest = k.MustGetObservation(ctx, poolId, 0)
+	}
+	if target < oldest.BlockTime.Unix() {
+		return beforeOrAt, atOrAfter, sdkerrors.Wrapf(
+			sdkerrors.ErrInvalidRequest, "target time %s is older than the oldest observation %s",
+			time.Unix(target, 0).UTC(), oldest.BlockTime.UTC())
+	}
+
+	// Binary search through the observations array, from the oldest to the
+	// newest observation.
+	cardinality := int64(poolState.ObservationCardinality)
+	l := int64(poolState.ObservationIndex) + 1
+	r := l + cardinality - 1
+	for {
+		i := (l + r) / 2
+		beforeOrAt, found = k.GetObservation(ctx, poolId, uint32(i%cardinality))
+		if !found {
+			l = i + 1
+			continue
+		}
+		if beforeOrAt.BlockTime.Unix() > target {
+			r = i - 1
+			continue
+		}
+		atOrAfter = k.MustGetObservation(ctx, poolId, uint32((i+1)%cardinality))
+		if target <= atOrAfter.BlockTime.Unix() {
+			return beforeOrAt, atOrAfter, nil
+		}
+		l = i + 1
+	}
+}
@@ -0,0 +1,96 @@
+package keeper_test
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	utils "github.com/crescent-network/crescent/v5/types"
+	"github.com/crescent-network/crescent/v5/x/amm/types"
+)
+
+func (s *KeeperTestSuite) TestObserve() {
+	market, pool := s.CreateMarketAndPool("ucre", "uusd", utils.ParseDec("5"))
+	lpAddr := s.FundedAccount(1, enoughCoins)
+	s.AddLiquidity(
+		lpAddr, pool.Id, utils.ParseDec("4.5"), utils.ParseDec("5.5"),
+		utils.ParseCoins("100_000000ucre,500_000000uusd"))
+	ordererAddr := s.FundedAccount(2, enoughCoins)
+
+	poolState := s.keeper.MustGetPoolState(s.Ctx, pool.Id)
+	tick0, liquidity0 := poolState.CurrentTick, poolState.CurrentLiquidity
+
+	// Only the initial observation exists.
+	tickCumulatives, secondsPerLiquidityCumulatives, err := s.keeper.Observe(s.Ctx, pool.Id, []uint32{0})
+	s.Require().NoError(err)
+	s.Require().Equal([]int64{0}, tickCumulatives)
+	s.AssertEqual(utils.ZeroDec, secondsPerLiquidityCumulatives[0])
+
+	s.NextBlock()
+	// Observations are accumulated virtually even without any observation
+	// written in this block.
+	tickCumulatives, _, err = s.keeper.Observe(s.Ctx, pool.Id, []uint32{0, 5})
+	s.Require().NoError(err)
+	s.Require().Equal([]int64{int64(tick0) * 5, 0}, tickCumulatives)
+
+	s.PlaceLimitOrder(market.Id, ordererAddr, true, utils.ParseDec("5.1"), sdk.NewDec(1_000000), 0)
+	poolState = s.keeper.MustGetPoolState(s.Ctx, pool.Id)
+	tick1 := poolState.CurrentTick
+	s.Require().Greater(tick1, tick0)
+	s.Require().EqualValues(0, poolState.ObservationIndex)
+	s.Require().EqualValues(1, poolState.ObservationCardinality)
+	observation := s.keeper.MustGetObservation(s.Ctx, pool.Id, 0)
+	s.Require().Equal(s.Ctx.BlockTime(), observation.BlockTime)
+	s.Require().Equal(int64(tick0)*5, observation.TickCumulative)
+	s.AssertEqual(
+		sdk.NewDec(5).MulTruncate(types.DecMulFactor).QuoTruncate(liquidity0.ToDec()),
+		observation.SecondsPerLiquidityCumulative)
+
+	// The observation written in the last block has been overwritten since
+	// the cardinality is 1.
+	_, _, err = s.keeper.Observe(s.Ctx, pool.Id, []uint32{5})
+	s.Require().EqualError(
+		err, fmt.Sprintf(
+			"target time %s is older than the oldest observation %s: invalid request",
+			s.Ctx.BlockTime().Add(-5e9).UTC(), s.Ctx.BlockTime().UTC()))
+
+	s.Require().NoError(s.keeper.GrowObservationCardinality(s.Ctx, pool.Id, 10))
+
+	// The observations array grows when the next observation is written.
+	s.NextBlock()
+	s.PlaceMarketOrder(market.Id, ordererAddr, false, sdk.NewDec(2_000000))
+	poolState = s.keeper.MustGetPoolState(s.Ctx, pool.Id)
+	tick2 := poolState.CurrentTick
+	s.Require().Less(tick2, tick1)
+	s.Require().EqualValues(1, poolState.ObservationIndex)
+	s.Require().EqualValues(10, poolState.ObservationCardinality)
+
+	s.NextBlock()
+	s.NextBlock()
+	tickCumulatives, _, err = s.keeper.Observe(s.Ctx, pool.Id, []uint32{15, 12, 10, 0})
+	s.Require().NoError(err)
+	s.Require().Equal([]int64{
+		int64(tick0) * 5,
+		int64(tick0)*5 + int64(tick1)*3, // Interpolated.
+		int64(tick0)*5 + int64(tick1)*5,
+		int64(tick0)*5 + int64(tick1)*5 + int64(tick2)*10,
+	}, tickCumulatives)
+	s.Require().Equal(tick2, types.MeanTick(tickCumulatives[2], tickCumulatives[3], 10, 0))
+
+	_, _, err = s.keeper.Observe(s.Ctx, pool.Id, []uint32{16})
+	s.Require().Error(err)
+}
+
+func (s *KeeperTestSuite) TestGrowObservationCardinality() {
+	_, pool := s.CreateMarketAndPool("ucre", "uusd", utils.ParseDec("5"))
+
+	s.Require().NoError(s.keeper.GrowObservationCardinality(s.Ctx, pool.Id, 10))
+	poolState := s.keeper.MustGetPoolState(s.Ctx, pool.Id)
+	s.Require().EqualValues(1, poolState.ObservationCardinality)
+	s.Require().EqualValues(10, poolState.ObservationCardinalityNext)
+
+	err := s.keeper.GrowObservationCardinality(s.Ctx, pool.Id, 10)
+	s.Require().EqualError(err, "observation cardinality must be greater than 10: 10: invalid request")
+	err = s.keeper.GrowObservationCardinality(s.Ctx, pool.Id, 65536)
+	s.Require().EqualError(err, "observation cardinality must not be greater than 65535: 65536: invalid request")
+}
@@ -27,10 +27,11 @@ func BenchmarkPlaceMarketOrder(b *testing.B) {
 	// Create market.
 	creatorAddr := utils.TestAddress(1)
 	require.NoError(b, chain.FundAccount(app.BankKeeper, ctx, creatorAddr, enoughCoins))
-	market, err := app.ExchangeKeeper.CreateMarket(ctx, creatorAddr, "ucre", "uusd")
+	market, err := app.ExchangeKeeper.CreateMarket(
+		ctx, creatorAddr, "ucre", "uusd", utils.ZeroDec, utils.ZeroDec, utils.ZeroDec)
 	require.NoError(b, err)
 	// Create pool and add liquidity
-	pool, err := app.AMMKeeper.CreatePool(ctx, creatorAddr, market.Id, utils.ParseDec("5"))
+	pool, err := app.AMMKeeper.CreatePool(ctx, creatorAddr, market.Id, utils.ParseDec("5"), 0)
 	require.NoError(b, err)
 	_, _, _, err = app.AMMKeeper.AddLiquidity(
 		ctx, creatorAddr, creatorAddr, pool.Id, types.MinPrice, types.MaxPrice,
@@ -51,7 +52,7 @@ func BenchmarkPlaceMarketOrder(b *testing.B) {
 		ctx = app.NewContext(false, hdr)
 
 		_, _, err := app.ExchangeKeeper.PlaceMarketOrder(
-			ctx, market.Id, ordererAddr, isBuy, sdk.NewDec(10_000000))
+			ctx, market.Id, ordererAddr, isBuy, sdk.NewDec(10_000000), nil)
 		require.NoError(b, err)
 		isBuy = !isBuy
 
@@ -70,7 +71,7 @@ func BenchmarkPlaceMarketOrder(b *testing.B) {
 		b.StartTimer()
 
 		_, _, err := app.ExchangeKeeper.PlaceMarketOrder(
-			ctx, market.Id, ordererAddr, isBuy, sdk.NewDec(10_000000))
+			ctx, market.Id, ordererAddr, isBuy, sdk.NewDec(10_000000), nil)
 		require.NoError(b, err)
 		isBuy = !isBuy
 
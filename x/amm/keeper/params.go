@@ -81,3 +81,21 @@ func (k Keeper) GetMaxFarmingBlockTime(ctx sdk.Context) (blockTime time.Duration
 func (k Keeper) SetMaxFarmingBlockTime(ctx sdk.Context, blockTime time.Duration) {
 	k.paramSpace.Set(ctx, types.KeyMaxFarmingBlockTime, blockTime)
 }
+
+func (k Keeper) GetAutoCompoundMaxSlippage(ctx sdk.Context) (maxSlippage sdk.Dec) {
+	k.paramSpace.Get(ctx, types.KeyAutoCompoundMaxSlippage, &maxSlippage)
+	return
+}
+
+func (k Keeper) SetAutoCompoundMaxSlippage(ctx sdk.Context, maxSlippage sdk.Dec) {
+	k.paramSpace.Set(ctx, types.KeyAutoCompoundMaxSlippage, maxSlippage)
+}
+
+func (k Keeper) GetMaxNumAutoCompoundsPerBlock(ctx sdk.Context) (max uint32) {
+	k.paramSpace.Get(ctx, types.KeyMaxNumAutoCompoundsPerBlock, &max)
+	return
+}
+
+func (k Keeper) SetMaxNumAutoCompoundsPerBlock(ctx sdk.Context, max uint32) {
+	k.paramSpace.Set(ctx, types.KeyMaxNumAutoCompoundsPerBlock, max)
+}
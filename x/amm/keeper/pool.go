@@ -9,14 +9,27 @@ import (
 	exchangetypes "github.com/crescent-network/crescent/v5/x/exchange/types"
 )
 
-func (k Keeper) CreatePool(ctx sdk.Context, creatorAddr sdk.AccAddress, marketId uint64, price sdk.Dec) (pool types.Pool, err error) {
+// CreatePool creates a new pool in the market with the tick spacing.
+// If tickSpacing is 0, the default tick spacing param is used instead.
+// A market can have multiple pools as long as their tick spacings differ.
+func (k Keeper) CreatePool(
+	ctx sdk.Context, creatorAddr sdk.AccAddress, marketId uint64, price sdk.Dec, tickSpacing uint32) (pool types.Pool, err error) {
 	market, found := k.exchangeKeeper.GetMarket(ctx, marketId)
 	if !found {
 		err = sdkerrors.Wrap(sdkerrors.ErrNotFound, "market not found")
 		return
 	}
-	if found := k.LookupPoolByMarket(ctx, market.Id); found {
-		err = sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "cannot create more than one pool per market")
+	if tickSpacing == 0 {
+		tickSpacing = k.GetDefaultTickSpacing(ctx)
+	}
+	if !types.IsAllowedTickSpacing(tickSpacing) {
+		err = sdkerrors.Wrapf(
+			sdkerrors.ErrInvalidRequest, "tick spacing must be one of %v: %d", types.AllowedTickSpacings, tickSpacing)
+		return
+	}
+	if _, found := k.GetPoolByMarketAndTickSpacing(ctx, market.Id, tickSpacing); found {
+		err = sdkerrors.Wrapf(
+			sdkerrors.ErrInvalidRequest, "pool with tick spacing %d already exists in the market", tickSpacing)
 		return
 	}
 
@@ -30,25 +43,26 @@ func (k Keeper) CreatePool(ctx sdk.Context, creatorAddr sdk.AccAddress, marketId
 
 	// Create a new pool
 	poolId := k.GetNextPoolIdWithUpdate(ctx)
-	defaultTickSpacing := k.GetDefaultTickSpacing(ctx)
 	defaultMinOrderQty := k.GetDefaultMinOrderQuantity(ctx)
 	defaultMinOrderQuote := k.GetDefaultMinOrderQuote(ctx)
 	pool = types.NewPool(
-		poolId, marketId, market.BaseDenom, market.QuoteDenom, defaultTickSpacing,
+		poolId, marketId, market.BaseDenom, market.QuoteDenom, tickSpacing,
 		defaultMinOrderQty, defaultMinOrderQuote)
 	k.SetPool(ctx, pool)
-	k.SetPoolByMarketIndex(ctx, pool)
+	k.SetPoolsByMarketIndex(ctx, pool)
 	k.SetPoolByReserveAddressIndex(ctx, pool)
 
 	// Set initial pool state
 	state := types.NewPoolState(exchangetypes.TickAtPrice(price), price)
 	k.SetPoolState(ctx, pool.Id, state)
+	k.SetObservation(ctx, pool.Id, 0, types.NewObservation(ctx.BlockTime()))
 
 	if err = ctx.EventManager().EmitTypedEvent(&types.EventCreatePool{
-		Creator:  creatorAddr.String(),
-		MarketId: marketId,
-		Price:    price,
-		PoolId:   poolId,
+		Creator:     creatorAddr.String(),
+		MarketId:    marketId,
+		Price:       price,
+		PoolId:      poolId,
+		TickSpacing: tickSpacing,
 	}); err != nil {
 		return
 	}
@@ -56,6 +70,20 @@ func (k Keeper) CreatePool(ctx sdk.Context, creatorAddr sdk.AccAddress, marketId
 	return pool, nil
 }
 
+// GetPoolByMarketAndTickSpacing returns the pool in the market which has
+// the tick spacing.
+func (k Keeper) GetPoolByMarketAndTickSpacing(ctx sdk.Context, marketId uint64, tickSpacing uint32) (pool types.Pool, found bool) {
+	k.IteratePoolsByMarket(ctx, marketId, func(p types.Pool) (stop bool) {
+		if p.TickSpacing == tickSpacing {
+			pool = p
+			found = true
+			return true
+		}
+		return false
+	})
+	return
+}
+
 func (k Keeper) IteratePoolOrders(ctx sdk.Context, pool types.Pool, isBuy bool, cb func(price, qty, openQty sdk.Dec) (stop bool)) {
 	poolState := k.MustGetPoolState(ctx, pool.Id)
 	reserveBalance := k.bankKeeper.SpendableCoins(ctx, pool.MustGetReserveAddress()).
@@ -23,10 +23,11 @@ func BenchmarkPoolOrders(b *testing.B) {
 	require.NoError(
 		b, chain.FundAccount(app.BankKeeper, ctx, creatorAddr, enoughCoins))
 
-	market, err := app.ExchangeKeeper.CreateMarket(ctx, creatorAddr, "ucre", "uusd")
+	market, err := app.ExchangeKeeper.CreateMarket(
+		ctx, creatorAddr, "ucre", "uusd", utils.ZeroDec, utils.ZeroDec, utils.ZeroDec)
 	require.NoError(b, err)
 
-	pool, err := app.AMMKeeper.CreatePool(ctx, creatorAddr, market.Id, utils.ParseDec("5"))
+	pool, err := app.AMMKeeper.CreatePool(ctx, creatorAddr, market.Id, utils.ParseDec("5"), 0)
 	require.NoError(b, err)
 
 	lpAddr := utils.TestAddress(1)
@@ -37,8 +38,8 @@ func BenchmarkPoolOrders(b *testing.B) {
 		utils.ParseCoins("10_000000ucre,50_000000uusd"))
 	require.NoError(b, err)
 
-	_, _, _, err = app.ExchangeKeeper.PlaceLimitOrder(
-		ctx, market.Id, lpAddr, true, utils.ParseDec("5.01"), sdk.NewDec(10000), 0)
+	_, _, _, _, err = app.ExchangeKeeper.PlaceLimitOrder(
+		ctx, market.Id, lpAddr, true, utils.ParseDec("5.01"), sdk.NewDec(10000), nil, 0, exchangetypes.TimeInForceGoodTilTime, exchangetypes.SelfTradePreventionUnspecified, nil)
 	require.NoError(b, err)
 
 	ordererAddr := utils.TestAddress(2)
@@ -48,14 +49,14 @@ func BenchmarkPoolOrders(b *testing.B) {
 	b.Run("buy", func(b *testing.B) {
 		for i := 0; i < b.N; i++ {
 			cacheCtx, _ := ctx.CacheContext()
-			_, _, err := app.ExchangeKeeper.PlaceMarketOrder(cacheCtx, market.Id, ordererAddr, true, sdk.NewDec(5_000000))
+			_, _, err := app.ExchangeKeeper.PlaceMarketOrder(cacheCtx, market.Id, ordererAddr, true, sdk.NewDec(5_000000), nil)
 			require.NoError(b, err)
 		}
 	})
 	b.Run("sell", func(b *testing.B) {
 		for i := 0; i < b.N; i++ {
 			cacheCtx, _ := ctx.CacheContext()
-			_, _, err := app.ExchangeKeeper.PlaceMarketOrder(cacheCtx, market.Id, ordererAddr, false, sdk.NewDec(5_000000))
+			_, _, err := app.ExchangeKeeper.PlaceMarketOrder(cacheCtx, market.Id, ordererAddr, false, sdk.NewDec(5_000000), nil)
 			require.NoError(b, err)
 		}
 	})
@@ -70,10 +71,11 @@ func BenchmarkPlaceBuyMarketOrder(b *testing.B) {
 	require.NoError(
 		b, chain.FundAccount(app.BankKeeper, ctx, creatorAddr, enoughCoins))
 
-	market, err := app.ExchangeKeeper.CreateMarket(ctx, creatorAddr, "ucre", "uusd")
+	market, err := app.ExchangeKeeper.CreateMarket(
+		ctx, creatorAddr, "ucre", "uusd", utils.ZeroDec, utils.ZeroDec, utils.ZeroDec)
 	require.NoError(b, err)
 
-	pool, err := app.AMMKeeper.CreatePool(ctx, creatorAddr, market.Id, utils.ParseDec("5"))
+	pool, err := app.AMMKeeper.CreatePool(ctx, creatorAddr, market.Id, utils.ParseDec("5"), 0)
 	require.NoError(b, err)
 
 	lpAddr := utils.TestAddress(1)
@@ -84,8 +86,8 @@ func BenchmarkPlaceBuyMarketOrder(b *testing.B) {
 		utils.ParseCoins("10_000000ucre,50_000000uusd"))
 	require.NoError(b, err)
 
-	_, _, _, err = app.ExchangeKeeper.PlaceLimitOrder(
-		ctx, market.Id, lpAddr, true, utils.ParseDec("5.01"), sdk.NewDec(10000), 0)
+	_, _, _, _, err = app.ExchangeKeeper.PlaceLimitOrder(
+		ctx, market.Id, lpAddr, true, utils.ParseDec("5.01"), sdk.NewDec(10000), nil, 0, exchangetypes.TimeInForceGoodTilTime, exchangetypes.SelfTradePreventionUnspecified, nil)
 	require.NoError(b, err)
 
 	ordererAddr := utils.TestAddress(2)
@@ -94,7 +96,7 @@ func BenchmarkPlaceBuyMarketOrder(b *testing.B) {
 
 	for i := 0; i < b.N; i++ {
 		cacheCtx, _ := ctx.CacheContext()
-		_, _, err := app.ExchangeKeeper.PlaceMarketOrder(cacheCtx, market.Id, ordererAddr, true, sdk.NewDec(100_000000))
+		_, _, err := app.ExchangeKeeper.PlaceMarketOrder(cacheCtx, market.Id, ordererAddr, true, sdk.NewDec(100_000000), nil)
 		require.NoError(b, err)
 	}
 }
@@ -107,10 +109,11 @@ func BenchmarkPoolOrdersSkewedPrice(b *testing.B) {
 	require.NoError(
 		b, chain.FundAccount(app.BankKeeper, ctx, creatorAddr, enoughCoins))
 
-	market, err := app.ExchangeKeeper.CreateMarket(ctx, creatorAddr, "ucre", "uusd")
+	market, err := app.ExchangeKeeper.CreateMarket(
+		ctx, creatorAddr, "ucre", "uusd", utils.ZeroDec, utils.ZeroDec, utils.ZeroDec)
 	require.NoError(b, err)
 
-	pool, err := app.AMMKeeper.CreatePool(ctx, creatorAddr, market.Id, utils.ParseDec("500"))
+	pool, err := app.AMMKeeper.CreatePool(ctx, creatorAddr, market.Id, utils.ParseDec("500"), 0)
 	require.NoError(b, err)
 
 	lpAddr := utils.TestAddress(1)
@@ -121,8 +124,8 @@ func BenchmarkPoolOrdersSkewedPrice(b *testing.B) {
 		utils.ParseCoins("10_000000ucre,50_000000uusd"))
 	require.NoError(b, err)
 
-	_, _, _, err = app.ExchangeKeeper.PlaceLimitOrder(
-		ctx, market.Id, lpAddr, true, utils.ParseDec("501"), sdk.NewDec(10000), 0)
+	_, _, _, _, err = app.ExchangeKeeper.PlaceLimitOrder(
+		ctx, market.Id, lpAddr, true, utils.ParseDec("501"), sdk.NewDec(10000), nil, 0, exchangetypes.TimeInForceGoodTilTime, exchangetypes.SelfTradePreventionUnspecified, nil)
 	require.NoError(b, err)
 
 	ordererAddr := utils.TestAddress(2)
@@ -131,7 +134,7 @@ func BenchmarkPoolOrdersSkewedPrice(b *testing.B) {
 
 	for i := 0; i < b.N; i++ {
 		cacheCtx, _ := ctx.CacheContext()
-		_, _, err := app.ExchangeKeeper.PlaceMarketOrder(cacheCtx, market.Id, ordererAddr, true, sdk.NewDec(100_000000))
+		_, _, err := app.ExchangeKeeper.PlaceMarketOrder(cacheCtx, market.Id, ordererAddr, true, sdk.NewDec(100_000000), nil)
 		require.NoError(b, err)
 	}
 }
@@ -144,10 +147,11 @@ func BenchmarkQueryOrderBook(b *testing.B) {
 	require.NoError(
 		b, chain.FundAccount(app.BankKeeper, ctx, creatorAddr, enoughCoins))
 
-	market, err := app.ExchangeKeeper.CreateMarket(ctx, creatorAddr, "ucre", "uusd")
+	market, err := app.ExchangeKeeper.CreateMarket(
+		ctx, creatorAddr, "ucre", "uusd", utils.ZeroDec, utils.ZeroDec, utils.ZeroDec)
 	require.NoError(b, err)
 
-	pool, err := app.AMMKeeper.CreatePool(ctx, creatorAddr, market.Id, utils.ParseDec("5"))
+	pool, err := app.AMMKeeper.CreatePool(ctx, creatorAddr, market.Id, utils.ParseDec("5"), 0)
 	require.NoError(b, err)
 
 	lpAddr := utils.TestAddress(1)
@@ -158,8 +162,8 @@ func BenchmarkQueryOrderBook(b *testing.B) {
 		utils.ParseCoins("10_000000ucre,50_000000uusd"))
 	require.NoError(b, err)
 
-	_, _, _, err = app.ExchangeKeeper.PlaceLimitOrder(
-		ctx, market.Id, lpAddr, true, utils.ParseDec("5.01"), sdk.NewDec(10000), 0)
+	_, _, _, _, err = app.ExchangeKeeper.PlaceLimitOrder(
+		ctx, market.Id, lpAddr, true, utils.ParseDec("5.01"), sdk.NewDec(10000), nil, 0, exchangetypes.TimeInForceGoodTilTime, exchangetypes.SelfTradePreventionUnspecified, nil)
 	require.NoError(b, err)
 
 	querier := exchangekeeper.Querier{Keeper: app.ExchangeKeeper}
@@ -12,28 +12,91 @@ import (
 
 func (s *KeeperTestSuite) TestCreatePool_WithoutMarket() {
 	creatorAddr := s.FundedAccount(1, enoughCoins)
-	_, err := s.keeper.CreatePool(s.Ctx, creatorAddr, 1, utils.ParseDec("1.2"))
+	_, err := s.keeper.CreatePool(s.Ctx, creatorAddr, 1, utils.ParseDec("1.2"), 0)
 	s.Require().EqualError(err, "market not found: not found")
 }
 
 func (s *KeeperTestSuite) TestCreatePool_MultiplePoolsPerMarket() {
 	creatorAddr := s.FundedAccount(1, enoughCoins)
-	market, _ := s.CreateMarketAndPool("ucre", "uusd", utils.ParseDec("1.2"))
-	_, err := s.keeper.CreatePool(s.Ctx, creatorAddr, market.Id, utils.ParseDec("2.5"))
-	s.Require().EqualError(err, "cannot create more than one pool per market: invalid request")
+	market, pool := s.CreateMarketAndPool("ucre", "uusd", utils.ParseDec("1.2"))
+	s.Require().EqualValues(50, pool.TickSpacing) // default
+
+	// The default tick spacing is already taken.
+	_, err := s.keeper.CreatePool(s.Ctx, creatorAddr, market.Id, utils.ParseDec("2.5"), 0)
+	s.Require().EqualError(err, "pool with tick spacing 50 already exists in the market: invalid request")
+	_, err = s.keeper.CreatePool(s.Ctx, creatorAddr, market.Id, utils.ParseDec("2.5"), 50)
+	s.Require().EqualError(err, "pool with tick spacing 50 already exists in the market: invalid request")
+	_, err = s.keeper.CreatePool(s.Ctx, creatorAddr, market.Id, utils.ParseDec("2.5"), 3)
+	s.Require().EqualError(err, "tick spacing must be one of [1 5 10 50]: 3: invalid request")
+
+	s.Ctx = s.Ctx.WithEventManager(sdk.NewEventManager())
+	pool2, err := s.keeper.CreatePool(s.Ctx, creatorAddr, market.Id, utils.ParseDec("2.5"), 1)
+	s.Require().NoError(err)
+	s.Require().EqualValues(1, pool2.TickSpacing)
+	s.CheckEvent(&types.EventCreatePool{}, map[string][]byte{
+		"pool_id":      []byte(`"2"`),
+		"tick_spacing": []byte("1"),
+	})
+
+	var pools []types.Pool
+	s.keeper.IteratePoolsByMarket(s.Ctx, market.Id, func(pool types.Pool) (stop bool) {
+		pools = append(pools, pool)
+		return false
+	})
+	s.Require().Equal([]types.Pool{pool, pool2}, pools)
+}
+
+func (s *KeeperTestSuite) TestMultiplePoolsOrders() {
+	market := s.CreateMarket("ucre", "uusd")
+	pool1 := s.CreatePoolWithTickSpacing(market.Id, utils.ParseDec("5"), 50)
+	pool2 := s.CreatePoolWithTickSpacing(market.Id, utils.ParseDec("5"), 1)
+
+	lpAddr := s.FundedAccount(1, enoughCoins)
+	s.MakeLastPrice(market.Id, lpAddr, utils.ParseDec("5"))
+	s.AddLiquidity(
+		lpAddr, pool1.Id, utils.ParseDec("4"), utils.ParseDec("6"),
+		utils.ParseCoins("100_000000ucre,500_000000uusd"))
+	s.AddLiquidity(
+		lpAddr, pool2.Id, utils.ParseDec("4"), utils.ParseDec("6"),
+		utils.ParseCoins("100_000000ucre,500_000000uusd"))
+
+	// Orders from both pools are merged into the order book.
+	ordererAddrs := map[string]bool{}
+	obs := s.App.ExchangeKeeper.ConstructMemOrderBookSide(s.Ctx, market, exchangetypes.MemOrderBookSideOptions{
+		IsBuy:      false,
+		PriceLimit: utils.ParseDecP("5.5"),
+	}, nil)
+	for _, level := range obs.Levels() {
+		for _, order := range level.Orders() {
+			ordererAddrs[order.OrdererAddress().String()] = true
+		}
+	}
+	s.Require().True(ordererAddrs[pool1.ReserveAddress])
+	s.Require().True(ordererAddrs[pool2.ReserveAddress])
+
+	ordererAddr := s.FundedAccount(2, enoughCoins)
+	s.PlaceMarketOrder(market.Id, ordererAddr, true, sdk.NewDec(10_000000))
+
+	// Both pools are settled.
+	poolState1 := s.keeper.MustGetPoolState(s.Ctx, pool1.Id)
+	poolState2 := s.keeper.MustGetPoolState(s.Ctx, pool2.Id)
+	s.Require().True(poolState1.CurrentPrice.GT(utils.ParseDec("5")))
+	s.Require().True(poolState2.CurrentPrice.GT(utils.ParseDec("5")))
+	s.Require().True(s.GetBalance(pool1.MustGetReserveAddress(), "ucre").Amount.LT(sdk.NewInt(100_000000)))
+	s.Require().True(s.GetBalance(pool2.MustGetReserveAddress(), "ucre").Amount.LT(sdk.NewInt(100_000000)))
 }
 
 func (s *KeeperTestSuite) TestCreatePool_InsufficientFee() {
 	s.keeper.SetPoolCreationFee(s.Ctx, utils.ParseCoins("100_000000ucre"))
 	market := s.CreateMarket("ucre", "uusd")
 	creatorAddr := utils.TestAddress(1)
-	_, err := s.keeper.CreatePool(s.Ctx, creatorAddr, market.Id, utils.ParseDec("5"))
+	_, err := s.keeper.CreatePool(s.Ctx, creatorAddr, market.Id, utils.ParseDec("5"), 0)
 	s.Require().EqualError(err, "insufficient pool creation fee: 0ucre is smaller than 100000000ucre: insufficient funds")
 }
 
 func (s *KeeperTestSuite) TestCreatePool() {
 	market, pool := s.CreateMarketAndPool("ucre", "uusd", utils.ParseDec("5"))
-	pool2, found := s.keeper.GetPoolByMarket(s.Ctx, market.Id)
+	pool2, found := s.keeper.GetPoolByMarketAndTickSpacing(s.Ctx, market.Id, pool.TickSpacing)
 	s.Require().True(found)
 	s.Require().Equal(pool, pool2)
 	pool2, found = s.keeper.GetPoolByReserveAddress(s.Ctx, pool.MustGetReserveAddress())
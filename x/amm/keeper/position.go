@@ -28,6 +28,31 @@ func (k Keeper) AddLiquidity(
 		err = sdkerrors.Wrap(sdkerrors.ErrNotFound, "pool not found")
 		return
 	}
+	return k.addLiquidity(ctx, pool, ownerAddr, fromAddr, lowerTick, upperTick, desiredAmt, nil)
+}
+
+// IncreaseLiquidity adds liquidity to the existing position.
+// The amount added is returned and it must not be smaller than minAmt.
+func (k Keeper) IncreaseLiquidity(
+	ctx sdk.Context, ownerAddr, fromAddr sdk.AccAddress, positionId uint64,
+	desiredAmt, minAmt sdk.Coins) (position types.Position, liquidity sdk.Int, amt sdk.Coins, err error) {
+	position, found := k.GetPosition(ctx, positionId)
+	if !found {
+		err = sdkerrors.Wrap(sdkerrors.ErrNotFound, "position not found")
+		return
+	}
+	if ownerAddr.String() != position.Owner {
+		err = sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "position is not owned by the user")
+		return
+	}
+	pool := k.MustGetPool(ctx, position.PoolId)
+	return k.addLiquidity(
+		ctx, pool, ownerAddr, fromAddr, position.LowerTick, position.UpperTick, desiredAmt, minAmt)
+}
+
+func (k Keeper) addLiquidity(
+	ctx sdk.Context, pool types.Pool, ownerAddr, fromAddr sdk.AccAddress, lowerTick, upperTick int32,
+	desiredAmt, minAmt sdk.Coins) (position types.Position, liquidity sdk.Int, amt sdk.Coins, err error) {
 	for _, coin := range desiredAmt {
 		if coin.Denom != pool.Denom0 && coin.Denom != pool.Denom1 {
 			err = sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "pool has no %s in its reserve", coin.Denom)
@@ -43,7 +68,7 @@ func (k Keeper) AddLiquidity(
 		err = sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "upper tick must be multiple of tick spacing")
 		return
 	}
-	poolState := k.MustGetPoolState(ctx, poolId)
+	poolState := k.MustGetPoolState(ctx, pool.Id)
 
 	sqrtPriceA := types.SqrtPriceAtTick(lowerTick)
 	sqrtPriceB := types.SqrtPriceAtTick(upperTick)
@@ -59,6 +84,10 @@ func (k Keeper) AddLiquidity(
 		ctx, pool, ownerAddr, lowerTick, upperTick, liquidity)
 
 	amt = sdk.NewCoins(sdk.NewCoin(pool.Denom0, amt0), sdk.NewCoin(pool.Denom1, amt1))
+	if !amt.IsAllGTE(minAmt) {
+		err = sdkerrors.Wrapf(types.ErrSlippageExceeded, "added amount %s is smaller than the min amount %s", amt, minAmt)
+		return
+	}
 	if amt.IsAllPositive() {
 		if err = k.bankKeeper.SendCoins(
 			ctx, fromAddr, pool.MustGetReserveAddress(), amt); err != nil {
@@ -68,9 +97,9 @@ func (k Keeper) AddLiquidity(
 
 	if err = ctx.EventManager().EmitTypedEvent(&types.EventAddLiquidity{
 		Owner:      ownerAddr.String(),
-		PoolId:     poolId,
-		LowerPrice: lowerPrice,
-		UpperPrice: upperPrice,
+		PoolId:     pool.Id,
+		LowerPrice: exchangetypes.PriceAtTick(lowerTick),
+		UpperPrice: exchangetypes.PriceAtTick(upperTick),
 		PositionId: position.Id,
 		Liquidity:  liquidity,
 		Amount:     amt,
@@ -143,6 +172,23 @@ func (k Keeper) RemoveLiquidity(
 	return
 }
 
+// DecreaseLiquidity removes liquidity from the position.
+// The amount withdrawn is returned and it must not be smaller than minAmt.
+func (k Keeper) DecreaseLiquidity(
+	ctx sdk.Context, ownerAddr, toAddr sdk.AccAddress, positionId uint64,
+	liquidity sdk.Int, minAmt sdk.Coins) (position types.Position, amt sdk.Coins, err error) {
+	position, amt, err = k.RemoveLiquidity(ctx, ownerAddr, toAddr, positionId, liquidity)
+	if err != nil {
+		return
+	}
+	if !amt.IsAllGTE(minAmt) {
+		err = sdkerrors.Wrapf(
+			types.ErrSlippageExceeded, "withdrawn amount %s is smaller than the min amount %s", amt, minAmt)
+		return
+	}
+	return
+}
+
 func (k Keeper) Collect(
 	ctx sdk.Context, ownerAddr, toAddr sdk.AccAddress, positionId uint64, amt sdk.Coins) error {
 	position, found := k.GetPosition(ctx, positionId)
@@ -192,6 +238,47 @@ func (k Keeper) Collect(
 	return nil
 }
 
+// TransferPosition transfers the ownership of the position to the recipient.
+// The position's liquidity and its owed fee and farming rewards are
+// transferred altogether, while auto-compounding is disabled.
+func (k Keeper) TransferPosition(
+	ctx sdk.Context, ownerAddr sdk.AccAddress, positionId uint64, recipientAddr sdk.AccAddress) error {
+	position, found := k.GetPosition(ctx, positionId)
+	if !found {
+		return sdkerrors.Wrap(sdkerrors.ErrNotFound, "position not found")
+	}
+	if ownerAddr.String() != position.Owner {
+		return sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "position is not owned by the user")
+	}
+	if recipientAddr.Equals(ownerAddr) {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "sender and recipient must not be the same")
+	}
+	if _, found := k.GetPositionByParams(
+		ctx, recipientAddr, position.PoolId, position.LowerTick, position.UpperTick); found {
+		return sdkerrors.Wrap(
+			sdkerrors.ErrInvalidRequest, "recipient already has a position with the same price range in the pool")
+	}
+
+	k.DeletePositionByParamsIndex(ctx, position)
+	position.Owner = recipientAddr.String()
+	// The recipient has to opt in to auto-compounding by itself.
+	if position.AutoCompound {
+		position.AutoCompound = false
+		k.DeleteAutoCompoundPositionIndex(ctx, position)
+	}
+	k.SetPosition(ctx, position)
+	k.SetPositionByParamsIndex(ctx, position)
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventTransferPosition{
+		Sender:     ownerAddr.String(),
+		PositionId: positionId,
+		Recipient:  recipientAddr.String(),
+	}); err != nil {
+		return err
+	}
+	return nil
+}
+
 func (k Keeper) PositionAssets(ctx sdk.Context, positionId uint64) (coin0, coin1 sdk.Coin, err error) {
 	position, found := k.GetPosition(ctx, positionId)
 	if !found {
@@ -305,6 +392,8 @@ func (k Keeper) modifyPosition(
 			currentSqrtPrice := utils.DecApproxSqrt(poolState.CurrentPrice)
 			amt0 = types.Amount0Delta(currentSqrtPrice, sqrtPriceB, liquidityDelta)
 			amt1 = types.Amount1Delta(sqrtPriceA, currentSqrtPrice, liquidityDelta)
+			// Write an observation before the pool's current liquidity changes.
+			k.writeObservation(ctx, pool.Id, &poolState, poolState.CurrentTick, poolState.CurrentLiquidity)
 			poolState.CurrentLiquidity = poolState.CurrentLiquidity.Add(liquidityDelta)
 		} else {
 			amt1 = types.Amount1Delta(sqrtPriceA, sqrtPriceB, liquidityDelta)
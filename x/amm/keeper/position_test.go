@@ -2,6 +2,7 @@ package keeper_test
 
 import (
 	"fmt"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
@@ -198,3 +199,94 @@ func (s *KeeperTestSuite) TestRemoveSmallLiquidity() {
 		}
 	}
 }
+
+func (s *KeeperTestSuite) TestTransferPosition() {
+	market, pool := s.CreateMarketAndPool("ucre", "uusd", utils.ParseDec("5"))
+	lpAddr := s.FundedAccount(1, enoughCoins)
+	position, _, _ := s.AddLiquidity(
+		lpAddr, pool.Id, utils.ParseDec("4.5"), utils.ParseDec("5.5"),
+		utils.ParseCoins("100_000000ucre,500_000000uusd"))
+
+	// Accrue fees.
+	ordererAddr := s.FundedAccount(2, enoughCoins)
+	s.PlaceLimitOrder(market.Id, ordererAddr, true, utils.ParseDec("6"), sdk.NewDec(10_000000), 0)
+	s.PlaceMarketOrder(market.Id, ordererAddr, false, sdk.NewDec(10_000000))
+	fee, farmingRewards := s.CollectibleCoins(position.Id)
+	s.Require().True(fee.IsAllPositive())
+
+	recipientAddr := utils.TestAddress(3)
+	s.Ctx = s.Ctx.WithEventManager(sdk.NewEventManager())
+	s.TransferPosition(lpAddr, position.Id, recipientAddr)
+	s.CheckEvent(&types.EventTransferPosition{}, map[string][]byte{
+		"sender":      []byte(`"` + lpAddr.String() + `"`),
+		"position_id": []byte(`"1"`),
+		"recipient":   []byte(`"` + recipientAddr.String() + `"`),
+	})
+
+	position = s.keeper.MustGetPosition(s.Ctx, position.Id)
+	s.Require().Equal(recipientAddr.String(), position.Owner)
+	_, found := s.keeper.GetPositionByParams(s.Ctx, lpAddr, pool.Id, position.LowerTick, position.UpperTick)
+	s.Require().False(found)
+	position2, found := s.keeper.GetPositionByParams(s.Ctx, recipientAddr, pool.Id, position.LowerTick, position.UpperTick)
+	s.Require().True(found)
+	s.Require().Equal(position, position2)
+
+	// Accrued fee and farming rewards are carried over.
+	fee2, farmingRewards2 := s.CollectibleCoins(position.Id)
+	s.AssertEqual(fee, fee2)
+	s.AssertEqual(farmingRewards, farmingRewards2)
+
+	// The previous owner cannot manage the position anymore.
+	err := s.keeper.Collect(s.Ctx, lpAddr, lpAddr, position.Id, fee)
+	s.Require().EqualError(err, "position is not owned by the user: unauthorized")
+	err = s.keeper.TransferPosition(s.Ctx, lpAddr, position.Id, lpAddr)
+	s.Require().EqualError(err, "position is not owned by the user: unauthorized")
+
+	s.Collect(recipientAddr, position.Id, fee)
+	s.AssertEqual(fee, s.GetAllBalances(recipientAddr))
+	_, amt := s.RemoveLiquidity(recipientAddr, position.Id, position.Liquidity)
+	s.Require().True(amt.IsAllPositive())
+
+	// The recipient already has a position with the same parameters.
+	position3, _, _ := s.AddLiquidity(
+		lpAddr, pool.Id, utils.ParseDec("4.5"), utils.ParseDec("5.5"),
+		utils.ParseCoins("100_000000ucre,500_000000uusd"))
+	s.Require().NotEqual(position.Id, position3.Id)
+	err = s.keeper.TransferPosition(s.Ctx, lpAddr, position3.Id, recipientAddr)
+	s.Require().EqualError(
+		err, "recipient already has a position with the same price range in the pool: invalid request")
+}
+
+func (s *KeeperTestSuite) TestTransferPosition_Authz() {
+	_, pool := s.CreateMarketAndPool("ucre", "uusd", utils.ParseDec("5"))
+	lpAddr := s.FundedAccount(1, enoughCoins)
+	position1, _, _ := s.AddLiquidity(
+		lpAddr, pool.Id, utils.ParseDec("4.5"), utils.ParseDec("5.5"),
+		utils.ParseCoins("100_000000ucre,500_000000uusd"))
+	position2, _, _ := s.AddLiquidity(
+		lpAddr, pool.Id, utils.ParseDec("4"), utils.ParseDec("6"),
+		utils.ParseCoins("100_000000ucre,500_000000uusd"))
+
+	granteeAddr := utils.TestAddress(2)
+	recipientAddr := utils.TestAddress(3)
+	s.Require().NoError(s.App.AuthzKeeper.SaveGrant(
+		s.Ctx, granteeAddr, lpAddr, types.NewTransferPositionAuthorization([]uint64{position1.Id}),
+		s.Ctx.BlockTime().Add(time.Hour)))
+
+	_, err := s.App.AuthzKeeper.DispatchActions(s.Ctx, granteeAddr, []sdk.Msg{
+		types.NewMsgTransferPosition(lpAddr, position2.Id, recipientAddr),
+	})
+	s.Require().EqualError(err, "transfer of position 2 is not authorized: unauthorized")
+
+	_, err = s.App.AuthzKeeper.DispatchActions(s.Ctx, granteeAddr, []sdk.Msg{
+		types.NewMsgTransferPosition(lpAddr, position1.Id, recipientAddr),
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(recipientAddr.String(), s.keeper.MustGetPosition(s.Ctx, position1.Id).Owner)
+	s.Require().Equal(lpAddr.String(), s.keeper.MustGetPosition(s.Ctx, position2.Id).Owner)
+
+	// The grant has been used up.
+	auth, _ := s.App.AuthzKeeper.GetCleanAuthorization(
+		s.Ctx, granteeAddr, lpAddr, sdk.MsgTypeURL(&types.MsgTransferPosition{}))
+	s.Require().Nil(auth)
+}
@@ -20,6 +20,10 @@ func HandlePoolParameterChangeProposal(ctx sdk.Context, k Keeper, p *types.PoolP
 			if err := types.ValidateTickSpacing(pool.TickSpacing, change.TickSpacing); err != nil {
 				return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
 			}
+			if _, found := k.GetPoolByMarketAndTickSpacing(ctx, pool.MarketId, change.TickSpacing); found {
+				return sdkerrors.Wrapf(
+					sdkerrors.ErrInvalidRequest, "pool with tick spacing %d already exists in the market", change.TickSpacing)
+			}
 			pool.TickSpacing = change.TickSpacing
 		}
 		if change.MinOrderQuantity != nil {
@@ -29,11 +33,17 @@ func HandlePoolParameterChangeProposal(ctx sdk.Context, k Keeper, p *types.PoolP
 			pool.MinOrderQuote = *change.MinOrderQuote
 		}
 		k.SetPool(ctx, pool)
+		if change.ObservationCardinality != 0 {
+			if err := k.GrowObservationCardinality(ctx, pool.Id, change.ObservationCardinality); err != nil {
+				return err
+			}
+		}
 		if err := ctx.EventManager().EmitTypedEvent(&types.EventPoolParameterChanged{
-			PoolId:           change.PoolId,
-			TickSpacing:      change.TickSpacing,
-			MinOrderQuantity: change.MinOrderQuantity,
-			MinOrderQuote:    change.MinOrderQuote,
+			PoolId:                 change.PoolId,
+			TickSpacing:            change.TickSpacing,
+			MinOrderQuantity:       change.MinOrderQuantity,
+			MinOrderQuote:          change.MinOrderQuote,
+			ObservationCardinality: change.ObservationCardinality,
 		}); err != nil {
 			return err
 		}
@@ -13,7 +13,7 @@ func (s *KeeperTestSuite) TestPoolParameterChangeProposal() {
 	// Change tick spacing only
 	proposal := types.NewPoolParameterChangeProposal(
 		"Title", "Description", []types.PoolParameterChange{
-			types.NewPoolParameterChange(pool.Id, 10, nil, nil),
+			types.NewPoolParameterChange(pool.Id, 10, nil, nil, 0),
 		})
 	s.Require().NoError(proposal.ValidateBasic())
 	s.Require().NoError(handler(s.Ctx, proposal))
@@ -24,7 +24,7 @@ func (s *KeeperTestSuite) TestPoolParameterChangeProposal() {
 	// Change min order qty only
 	proposal = types.NewPoolParameterChangeProposal(
 		"Title", "Description", []types.PoolParameterChange{
-			types.NewPoolParameterChange(pool.Id, 0, utils.ParseDecP("10000"), nil),
+			types.NewPoolParameterChange(pool.Id, 0, utils.ParseDecP("10000"), nil, 0),
 		})
 	s.Require().NoError(proposal.ValidateBasic())
 	s.Require().NoError(handler(s.Ctx, proposal))
@@ -35,7 +35,7 @@ func (s *KeeperTestSuite) TestPoolParameterChangeProposal() {
 	// Change min order quote only
 	proposal = types.NewPoolParameterChangeProposal(
 		"Title", "Description", []types.PoolParameterChange{
-			types.NewPoolParameterChange(pool.Id, 0, nil, utils.ParseDecP("1000")),
+			types.NewPoolParameterChange(pool.Id, 0, nil, utils.ParseDecP("1000"), 0),
 		})
 	s.Require().NoError(proposal.ValidateBasic())
 	s.Require().NoError(handler(s.Ctx, proposal))
@@ -46,7 +46,7 @@ func (s *KeeperTestSuite) TestPoolParameterChangeProposal() {
 	// Change altogether
 	proposal = types.NewPoolParameterChangeProposal(
 		"Title", "Description", []types.PoolParameterChange{
-			types.NewPoolParameterChange(pool.Id, 5, utils.ParseDecP("1000000"), utils.ParseDecP("10000")),
+			types.NewPoolParameterChange(pool.Id, 5, utils.ParseDecP("1000000"), utils.ParseDecP("10000"), 0),
 		})
 	s.Require().NoError(proposal.ValidateBasic())
 	s.Require().NoError(handler(s.Ctx, proposal))
@@ -56,14 +56,46 @@ func (s *KeeperTestSuite) TestPoolParameterChangeProposal() {
 	s.AssertEqual(utils.ParseDec("1000000"), pool.MinOrderQuantity)
 	s.AssertEqual(utils.ParseDec("10000"), pool.MinOrderQuote)
 
+	// Grow observation cardinality
+	proposal = types.NewPoolParameterChangeProposal(
+		"Title", "Description", []types.PoolParameterChange{
+			types.NewPoolParameterChange(pool.Id, 0, nil, nil, 100),
+		})
+	s.Require().NoError(proposal.ValidateBasic())
+	s.Require().NoError(handler(s.Ctx, proposal))
+
+	poolState := s.keeper.MustGetPoolState(s.Ctx, pool.Id)
+	s.Require().EqualValues(1, poolState.ObservationCardinality)
+	s.Require().EqualValues(100, poolState.ObservationCardinalityNext)
+
 	// Failing cases
 	proposal = types.NewPoolParameterChangeProposal(
 		"Title", "Description", []types.PoolParameterChange{
-			types.NewPoolParameterChange(pool.Id, 5, nil, nil),
+			types.NewPoolParameterChange(pool.Id, 5, nil, nil, 0),
 		})
 	s.Require().NoError(proposal.ValidateBasic())
 	// Same tick spacing
 	s.Require().EqualError(handler(s.Ctx, proposal), "tick spacing is not changed: 5: invalid request")
+
+	// Observation cardinality cannot be decreased
+	proposal = types.NewPoolParameterChangeProposal(
+		"Title", "Description", []types.PoolParameterChange{
+			types.NewPoolParameterChange(pool.Id, 0, nil, nil, 50),
+		})
+	s.Require().NoError(proposal.ValidateBasic())
+	s.Require().EqualError(
+		handler(s.Ctx, proposal), "observation cardinality must be greater than 100: 50: invalid request")
+
+	// Tick spacing used by another pool in the market
+	pool2 := s.CreatePoolWithTickSpacing(pool.MarketId, utils.ParseDec("5"), 1)
+	s.Require().NotEqual(pool.Id, pool2.Id)
+	proposal = types.NewPoolParameterChangeProposal(
+		"Title", "Description", []types.PoolParameterChange{
+			types.NewPoolParameterChange(pool.Id, 1, nil, nil, 0),
+		})
+	s.Require().NoError(proposal.ValidateBasic())
+	s.Require().EqualError(
+		handler(s.Ctx, proposal), "pool with tick spacing 1 already exists in the market: invalid request")
 }
 
 func (s *KeeperTestSuite) TestPublicFarmingPlanProposal() {
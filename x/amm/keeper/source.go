@@ -29,11 +29,23 @@ func (k OrderSource) ConstructMemOrderBookSide(
 	ctx sdk.Context, market exchangetypes.Market,
 	createOrder exchangetypes.CreateOrderFunc,
 	opts exchangetypes.MemOrderBookSideOptions) error {
-	pool, found := k.GetPoolByMarket(ctx, market.Id)
-	if !found {
-		return nil // no pool found
+	if !market.IsActive() {
+		return nil // pools don't provide liquidity to inactive markets
 	}
 	maxPriceRatio := k.exchangeKeeper.GetMaxOrderPriceRatio(ctx)
+	// Orders from all pools in the market are merged into the order book side.
+	// Limits in opts are applied to each pool separately.
+	k.IteratePoolsByMarket(ctx, market.Id, func(pool types.Pool) (stop bool) {
+		k.constructPoolMemOrderBookSide(ctx, pool, maxPriceRatio, createOrder, opts)
+		return false
+	})
+	return nil
+}
+
+func (k OrderSource) constructPoolMemOrderBookSide(
+	ctx sdk.Context, pool types.Pool, maxPriceRatio sdk.Dec,
+	createOrder exchangetypes.CreateOrderFunc,
+	opts exchangetypes.MemOrderBookSideOptions) {
 	poolState := k.MustGetPoolState(ctx, pool.Id)
 	minPrice, maxPrice := exchangetypes.OrderPriceLimit(poolState.CurrentPrice, maxPriceRatio)
 
@@ -55,9 +67,10 @@ func (k OrderSource) ConstructMemOrderBookSide(
 		numPriceLevels++
 		return false
 	})
-	return nil
 }
 
+// AfterOrdersExecuted settles executed orders of the pool whose reserve address
+// is ordererAddr.
 func (k OrderSource) AfterOrdersExecuted(ctx sdk.Context, _ exchangetypes.Market, ordererAddr sdk.AccAddress, results []*exchangetypes.MemOrder) error {
 	pool := k.MustGetPoolByReserveAddress(ctx, ordererAddr)
 	return k.AfterPoolOrdersExecuted(ctx, pool, results)
@@ -66,6 +79,7 @@ func (k OrderSource) AfterOrdersExecuted(ctx sdk.Context, _ exchangetypes.Market
 func (k Keeper) AfterPoolOrdersExecuted(ctx sdk.Context, pool types.Pool, results []*exchangetypes.MemOrder) error {
 	reserveAddr := pool.MustGetReserveAddress()
 	poolState := k.MustGetPoolState(ctx, pool.Id)
+	tickBefore, liquidityBefore := poolState.CurrentTick, poolState.CurrentLiquidity
 	accruedRewards := sdk.NewCoins()
 
 	// TODO: check if results are sorted?
@@ -224,6 +238,9 @@ func (k Keeper) AfterPoolOrdersExecuted(ctx sdk.Context, pool types.Pool, result
 		poolState.CurrentTick = nextTick
 	}
 	accrueFees()
+	if poolState.CurrentTick != tickBefore {
+		k.writeObservation(ctx, pool.Id, &poolState, tickBefore, liquidityBefore)
+	}
 	k.SetPoolState(ctx, pool.Id, poolState)
 
 	if accruedRewards.IsAllPositive() {
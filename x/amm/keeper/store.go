@@ -88,23 +88,23 @@ func (k Keeper) SetPool(ctx sdk.Context, pool types.Pool) {
 	store.Set(types.GetPoolKey(pool.Id), bz)
 }
 
-func (k Keeper) GetPoolByMarket(ctx sdk.Context, marketId uint64) (pool types.Pool, found bool) {
+// IteratePoolsByMarket iterates through all pools in the market, ordered by
+// their ids.
+func (k Keeper) IteratePoolsByMarket(ctx sdk.Context, marketId uint64, cb func(pool types.Pool) (stop bool)) {
 	store := ctx.KVStore(k.storeKey)
-	bz := store.Get(types.GetPoolByMarketIndexKey(marketId))
-	if bz == nil {
-		return
+	iter := sdk.KVStorePrefixIterator(store, types.GetPoolsByMarketIteratorPrefix(marketId))
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		_, poolId := types.ParsePoolsByMarketIndexKey(iter.Key())
+		if cb(k.MustGetPool(ctx, poolId)) {
+			break
+		}
 	}
-	return k.GetPool(ctx, sdk.BigEndianToUint64(bz))
-}
-
-func (k Keeper) LookupPoolByMarket(ctx sdk.Context, marketId uint64) (found bool) {
-	store := ctx.KVStore(k.storeKey)
-	return store.Has(types.GetPoolByMarketIndexKey(marketId))
 }
 
-func (k Keeper) SetPoolByMarketIndex(ctx sdk.Context, pool types.Pool) {
+func (k Keeper) SetPoolsByMarketIndex(ctx sdk.Context, pool types.Pool) {
 	store := ctx.KVStore(k.storeKey)
-	store.Set(types.GetPoolByMarketIndexKey(pool.MarketId), sdk.Uint64ToBigEndian(pool.Id))
+	store.Set(types.GetPoolsByMarketIndexKey(pool.MarketId, pool.Id), []byte{})
 }
 
 func (k Keeper) GetPoolByReserveAddress(ctx sdk.Context, reserveAddr sdk.AccAddress) (pool types.Pool, found bool) {
@@ -225,6 +225,13 @@ func (k Keeper) SetPositionByParamsIndex(ctx sdk.Context, position types.Positio
 		sdk.Uint64ToBigEndian(position.Id))
 }
 
+func (k Keeper) DeletePositionByParamsIndex(ctx sdk.Context, position types.Position) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetPositionByParamsIndexKey(
+		position.MustGetOwnerAddress(), position.PoolId,
+		position.LowerTick, position.UpperTick))
+}
+
 func (k Keeper) SetPositionsByPoolIndex(ctx sdk.Context, position types.Position) {
 	store := ctx.KVStore(k.storeKey)
 	store.Set(types.GetPositionsByPoolIndexKey(position.PoolId, position.Id), []byte{})
@@ -287,6 +294,84 @@ func (k Keeper) DeletePosition(ctx sdk.Context, position types.Position) {
 	store.Delete(types.GetPositionKey(position.Id))
 }
 
+func (k Keeper) SetAutoCompoundPositionIndex(ctx sdk.Context, position types.Position) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetAutoCompoundPositionsIndexKey(position.Id), []byte{})
+}
+
+func (k Keeper) DeleteAutoCompoundPositionIndex(ctx sdk.Context, position types.Position) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetAutoCompoundPositionsIndexKey(position.Id))
+}
+
+// IterateAutoCompoundPositions iterates through all auto-compounding positions
+// whose id is greater than afterPositionId.
+func (k Keeper) IterateAutoCompoundPositions(ctx sdk.Context, afterPositionId uint64, cb func(position types.Position) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := store.Iterator(
+		types.GetAutoCompoundPositionsIndexKey(afterPositionId+1),
+		sdk.PrefixEndBytes(types.AutoCompoundPositionsIndexKeyPrefix))
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		positionId := types.ParseAutoCompoundPositionsIndexKey(iter.Key())
+		position := k.MustGetPosition(ctx, positionId)
+		if cb(position) {
+			break
+		}
+	}
+}
+
+func (k Keeper) GetLastAutoCompoundPositionId(ctx sdk.Context) (positionId uint64) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.LastAutoCompoundPositionIdKey)
+	if bz == nil {
+		return 0
+	}
+	return sdk.BigEndianToUint64(bz)
+}
+
+func (k Keeper) SetLastAutoCompoundPositionId(ctx sdk.Context, positionId uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.LastAutoCompoundPositionIdKey, sdk.Uint64ToBigEndian(positionId))
+}
+
+func (k Keeper) GetObservation(ctx sdk.Context, poolId uint64, index uint32) (observation types.Observation, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetObservationKey(poolId, index))
+	if bz == nil {
+		return
+	}
+	k.cdc.MustUnmarshal(bz, &observation)
+	return observation, true
+}
+
+func (k Keeper) MustGetObservation(ctx sdk.Context, poolId uint64, index uint32) (observation types.Observation) {
+	observation, found := k.GetObservation(ctx, poolId, index)
+	if !found {
+		panic("observation not found")
+	}
+	return observation
+}
+
+func (k Keeper) SetObservation(ctx sdk.Context, poolId uint64, index uint32, observation types.Observation) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&observation)
+	store.Set(types.GetObservationKey(poolId, index), bz)
+}
+
+func (k Keeper) IterateObservationsByPool(ctx sdk.Context, poolId uint64, cb func(observation types.Observation) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.GetObservationsByPoolIteratorPrefix(poolId))
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var observation types.Observation
+		k.cdc.MustUnmarshal(iter.Value(), &observation)
+		if cb(observation) {
+			break
+		}
+	}
+}
+
 func (k Keeper) GetTickInfo(ctx sdk.Context, poolId uint64, tick int32) (tickInfo types.TickInfo, found bool) {
 	store := ctx.KVStore(k.storeKey)
 	bz := store.Get(types.GetTickInfoKey(poolId, tick))
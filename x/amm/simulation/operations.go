@@ -18,15 +18,17 @@ import (
 
 // Simulation operation weights constants.
 const (
-	OpWeightMsgCreatePool      = "op_weight_msg_create_pool"
-	OpWeightMsgAddLiquidity    = "op_weight_msg_add_liquidity"
-	OpWeightMsgRemoveLiquidity = "op_weight_msg_remove_liquidity"
-	OpWeightMsgCollect         = "op_weight_msg_collect"
+	OpWeightMsgCreatePool       = "op_weight_msg_create_pool"
+	OpWeightMsgAddLiquidity     = "op_weight_msg_add_liquidity"
+	OpWeightMsgRemoveLiquidity  = "op_weight_msg_remove_liquidity"
+	OpWeightMsgCollect          = "op_weight_msg_collect"
+	OpWeightMsgTransferPosition = "op_weight_msg_transfer_position"
 
-	DefaultWeightMsgCreatePool      = 5
-	DefaultWeightMsgAddLiquidity    = 70
-	DefaultWeightMsgRemoveLiquidity = 50
-	DefaultWeightMsgCollect         = 50
+	DefaultWeightMsgCreatePool       = 5
+	DefaultWeightMsgAddLiquidity     = 70
+	DefaultWeightMsgRemoveLiquidity  = 50
+	DefaultWeightMsgCollect          = 50
+	DefaultWeightMsgTransferPosition = 10
 )
 
 var (
@@ -40,10 +42,11 @@ func WeightedOperations(
 	ak types.AccountKeeper, bk types.BankKeeper, ek types.ExchangeKeeper, k keeper.Keeper,
 ) simulation.WeightedOperations {
 	var (
-		weightMsgCreatePool      int
-		weightMsgAddLiquidity    int
-		weightMsgRemoveLiquidity int
-		weightMsgCollect         int
+		weightMsgCreatePool       int
+		weightMsgAddLiquidity     int
+		weightMsgRemoveLiquidity  int
+		weightMsgCollect          int
+		weightMsgTransferPosition int
 	)
 	appParams.GetOrGenerate(cdc, OpWeightMsgCreatePool, &weightMsgCreatePool, nil, func(_ *rand.Rand) {
 		weightMsgCreatePool = DefaultWeightMsgCreatePool
@@ -57,6 +60,9 @@ func WeightedOperations(
 	appParams.GetOrGenerate(cdc, OpWeightMsgCollect, &weightMsgCollect, nil, func(_ *rand.Rand) {
 		weightMsgCollect = DefaultWeightMsgCollect
 	})
+	appParams.GetOrGenerate(cdc, OpWeightMsgTransferPosition, &weightMsgTransferPosition, nil, func(_ *rand.Rand) {
+		weightMsgTransferPosition = DefaultWeightMsgTransferPosition
+	})
 
 	return simulation.WeightedOperations{
 		simulation.NewWeightedOperation(
@@ -75,6 +81,10 @@ func WeightedOperations(
 			weightMsgCollect,
 			SimulateMsgCollect(ak, bk, k),
 		),
+		simulation.NewWeightedOperation(
+			weightMsgTransferPosition,
+			SimulateMsgTransferPosition(ak, bk, k),
+		),
 	}
 }
 
@@ -195,6 +205,35 @@ func SimulateMsgCollect(
 	}
 }
 
+func SimulateMsgTransferPosition(
+	ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper,
+) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context,
+		accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		simAccount, msg, found := findMsgTransferPositionParams(r, accs, k, ctx)
+		if !found {
+			return simtypes.NoOpMsg(
+				types.ModuleName, types.TypeMsgTransferPosition, "unable to transfer position"), nil, nil
+		}
+		txCtx := simulation.OperationInput{
+			R:               r,
+			App:             app,
+			TxGen:           appparams.MakeTestEncodingConfig().TxConfig,
+			Msg:             msg,
+			MsgType:         msg.Type(),
+			Context:         ctx,
+			SimAccount:      simAccount,
+			AccountKeeper:   ak,
+			Bankkeeper:      bk,
+			ModuleName:      types.ModuleName,
+			CoinsSpentInMsg: bk.SpendableCoins(ctx, simAccount.Address),
+		}
+		return utils.GenAndDeliverTxWithFees(txCtx, gas, fees)
+	}
+}
+
 func findMsgCreatePoolParams(r *rand.Rand, accs []simtypes.Account,
 	bk types.BankKeeper, ek types.ExchangeKeeper, k keeper.Keeper, ctx sdk.Context) (acc simtypes.Account, msg *types.MsgCreatePool, found bool) {
 	var markets []exchangetypes.Market
@@ -204,7 +243,18 @@ func findMsgCreatePoolParams(r *rand.Rand, accs []simtypes.Account,
 	})
 	utils.Shuffle(r, markets)
 	for _, market := range markets {
-		if found := k.LookupPoolByMarket(ctx, market.Id); !found {
+		usedTickSpacings := map[uint32]struct{}{}
+		k.IteratePoolsByMarket(ctx, market.Id, func(pool types.Pool) (stop bool) {
+			usedTickSpacings[pool.TickSpacing] = struct{}{}
+			return false
+		})
+		var tickSpacings []uint32
+		for _, tickSpacing := range types.AllowedTickSpacings {
+			if _, used := usedTickSpacings[tickSpacing]; !used {
+				tickSpacings = append(tickSpacings, tickSpacing)
+			}
+		}
+		if len(tickSpacings) > 0 {
 			acc, _ = simtypes.RandomAcc(r, accs)
 			spendable := bk.SpendableCoins(ctx, acc.Address)
 			if !spendable.IsAllGTE(k.GetPoolCreationFee(ctx)) {
@@ -217,7 +267,8 @@ func findMsgCreatePoolParams(r *rand.Rand, accs []simtypes.Account,
 			} else {
 				price = utils.RandomDec(r, utils.ParseDec("0.1"), utils.ParseDec("10"))
 			}
-			msg = types.NewMsgCreatePool(acc.Address, market.Id, price)
+			tickSpacing := tickSpacings[r.Intn(len(tickSpacings))]
+			msg = types.NewMsgCreatePool(acc.Address, market.Id, price, tickSpacing)
 			return acc, msg, true
 		}
 	}
@@ -342,3 +393,32 @@ func findMsgCollectParams(
 	}
 	return acc, nil, false
 }
+
+func findMsgTransferPositionParams(
+	r *rand.Rand, accs []simtypes.Account,
+	k keeper.Keeper, ctx sdk.Context) (acc simtypes.Account, msg *types.MsgTransferPosition, found bool) {
+	accs = utils.ShuffleSimAccounts(r, accs)
+	for _, acc = range accs {
+		var positions []types.Position
+		k.IteratePositionsByOwner(ctx, acc.Address, func(position types.Position) (stop bool) {
+			positions = append(positions, position)
+			return false
+		})
+		if len(positions) == 0 {
+			continue
+		}
+		position := positions[r.Intn(len(positions))]
+		for _, recipient := range accs {
+			if recipient.Address.Equals(acc.Address) {
+				continue
+			}
+			if _, found := k.GetPositionByParams(
+				ctx, recipient.Address, position.PoolId, position.LowerTick, position.UpperTick); found {
+				continue
+			}
+			msg = types.NewMsgTransferPosition(acc.Address, position.Id, recipient.Address)
+			return acc, msg, true
+		}
+	}
+	return acc, nil, false
+}
@@ -157,3 +157,39 @@ func (s *SimTestSuite) TestSimulateMsgRemoveLiquidity() {
 	s.Require().EqualValues(1, msg.PositionId)
 	s.Require().Equal(sdk.NewInt(261585745), msg.Liquidity)
 }
+
+func (s *SimTestSuite) TestSimulateMsgTransferPosition() {
+	r := rand.New(rand.NewSource(0))
+	accs := s.getTestingAccounts(r, 50)
+
+	var denoms []string
+	s.App.BankKeeper.IterateTotalSupply(s.Ctx, func(coin sdk.Coin) bool {
+		denoms = append(denoms, coin.Denom)
+		return false
+	})
+	market := s.CreateMarket(denoms[0], denoms[1])
+	pool := s.CreatePool(market.Id, utils.ParseDec("12.345"))
+	s.AddLiquidity(
+		accs[0].Address, pool.Id,
+		utils.ParseDec("10"), utils.ParseDec("15"),
+		sdk.NewCoins(sdk.NewInt64Coin(denoms[0], 100_000000), sdk.NewInt64Coin(denoms[1], 100_000000)))
+
+	op := simulation.SimulateMsgTransferPosition(
+		s.App.AccountKeeper, s.App.BankKeeper, s.keeper)
+	opMsg, futureOps, err := op(r, s.App.BaseApp, s.Ctx, accs, "")
+	s.Require().NoError(err)
+	s.Require().True(opMsg.OK)
+	s.Require().Len(futureOps, 0)
+
+	var msg types.MsgTransferPosition
+	types.ModuleCdc.MustUnmarshalJSON(opMsg.Msg, &msg)
+
+	s.Require().Equal(types.TypeMsgTransferPosition, msg.Type())
+	s.Require().Equal(types.ModuleName, msg.Route())
+	s.Require().Equal(accs[0].Address.String(), msg.Sender)
+	s.Require().EqualValues(1, msg.PositionId)
+	s.Require().NotEqual(msg.Sender, msg.Recipient)
+
+	position := s.keeper.MustGetPosition(s.Ctx, 1)
+	s.Require().Equal(msg.Recipient, position.Owner)
+}
@@ -52,7 +52,7 @@ func SimulatePoolParameterChangeProposal(k keeper.Keeper) simtypes.ContentSimula
 				minOrderQty := utils.RandomDec(r, utils.ParseDec("1"), utils.ParseDec("1000000"))
 				minOrderQuote := utils.RandomDec(r, utils.ParseDec("1"), utils.ParseDec("1000000"))
 				changes = append(changes,
-					types.NewPoolParameterChange(pool.Id, tickSpacing, &minOrderQty, &minOrderQuote))
+					types.NewPoolParameterChange(pool.Id, tickSpacing, &minOrderQty, &minOrderQuote, 0))
 			}
 			return false
 		})
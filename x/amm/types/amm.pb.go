@@ -9,15 +9,19 @@ import (
 	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -77,6 +81,13 @@ type PoolState struct {
 	TotalLiquidity             github_com_cosmos_cosmos_sdk_types.Int      `protobuf:"bytes,4,opt,name=total_liquidity,json=totalLiquidity,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"total_liquidity"`
 	FeeGrowthGlobal            github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,5,rep,name=fee_growth_global,json=feeGrowthGlobal,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"fee_growth_global"`
 	FarmingRewardsGrowthGlobal github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,6,rep,name=farming_rewards_growth_global,json=farmingRewardsGrowthGlobal,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"farming_rewards_growth_global"`
+	// observation_index is the index of the most recently written observation.
+	ObservationIndex uint32 `protobuf:"varint,7,opt,name=observation_index,json=observationIndex,proto3" json:"observation_index,omitempty"`
+	// observation_cardinality is the number of observations currently stored.
+	ObservationCardinality uint32 `protobuf:"varint,8,opt,name=observation_cardinality,json=observationCardinality,proto3" json:"observation_cardinality,omitempty"`
+	// observation_cardinality_next is the number of observations to be stored,
+	// which takes effect when the observations array wraps around.
+	ObservationCardinalityNext uint32 `protobuf:"varint,9,opt,name=observation_cardinality_next,json=observationCardinalityNext,proto3" json:"observation_cardinality_next,omitempty"`
 }
 
 func (m *PoolState) Reset()         { *m = PoolState{} }
@@ -123,6 +134,9 @@ type Position struct {
 	OwedFee                        github_com_cosmos_cosmos_sdk_types.Coins    `protobuf:"bytes,8,rep,name=owed_fee,json=owedFee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"owed_fee"`
 	LastFarmingRewardsGrowthInside github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,9,rep,name=last_farming_rewards_growth_inside,json=lastFarmingRewardsGrowthInside,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"last_farming_rewards_growth_inside"`
 	OwedFarmingRewards             github_com_cosmos_cosmos_sdk_types.Coins    `protobuf:"bytes,10,rep,name=owed_farming_rewards,json=owedFarmingRewards,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"owed_farming_rewards"`
+	// auto_compound indicates whether the position's fee and farming rewards
+	// are compounded automatically at the beginning of blocks.
+	AutoCompound bool `protobuf:"varint,11,opt,name=auto_compound,json=autoCompound,proto3" json:"auto_compound,omitempty"`
 }
 
 func (m *Position) Reset()         { *m = Position{} }
@@ -158,6 +172,51 @@ func (m *Position) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Position proto.InternalMessageInfo
 
+// Observation is a snapshot of the pool's cumulative values, which is used as
+// the pool's price oracle.
+type Observation struct {
+	BlockTime time.Time `protobuf:"bytes,1,opt,name=block_time,json=blockTime,proto3,stdtime" json:"block_time"`
+	// tick_cumulative is the sum of the pool's current tick multiplied by the
+	// seconds elapsed.
+	TickCumulative int64 `protobuf:"varint,2,opt,name=tick_cumulative,json=tickCumulative,proto3" json:"tick_cumulative,omitempty"`
+	// seconds_per_liquidity_cumulative is the sum of the seconds elapsed divided
+	// by the pool's current liquidity, multiplied by DecMulFactor.
+	SecondsPerLiquidityCumulative github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=seconds_per_liquidity_cumulative,json=secondsPerLiquidityCumulative,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"seconds_per_liquidity_cumulative"`
+}
+
+func (m *Observation) Reset()         { *m = Observation{} }
+func (m *Observation) String() string { return proto.CompactTextString(m) }
+func (*Observation) ProtoMessage()    {}
+func (*Observation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1dfef6a2c44f2449, []int{3}
+}
+func (m *Observation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Observation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Observation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Observation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Observation.Merge(m, src)
+}
+func (m *Observation) XXX_Size() int {
+	return m.Size()
+}
+func (m *Observation) XXX_DiscardUnknown() {
+	xxx_messageInfo_Observation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Observation proto.InternalMessageInfo
+
 type TickInfo struct {
 	GrossLiquidity              github_com_cosmos_cosmos_sdk_types.Int      `protobuf:"bytes,1,opt,name=gross_liquidity,json=grossLiquidity,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"gross_liquidity"`
 	NetLiquidity                github_com_cosmos_cosmos_sdk_types.Int      `protobuf:"bytes,2,opt,name=net_liquidity,json=netLiquidity,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"net_liquidity"`
@@ -169,7 +228,7 @@ func (m *TickInfo) Reset()         { *m = TickInfo{} }
 func (m *TickInfo) String() string { return proto.CompactTextString(m) }
 func (*TickInfo) ProtoMessage()    {}
 func (*TickInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1dfef6a2c44f2449, []int{3}
+	return fileDescriptor_1dfef6a2c44f2449, []int{4}
 }
 func (m *TickInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -202,66 +261,79 @@ func init() {
 	proto.RegisterType((*Pool)(nil), "crescent.amm.v1beta1.Pool")
 	proto.RegisterType((*PoolState)(nil), "crescent.amm.v1beta1.PoolState")
 	proto.RegisterType((*Position)(nil), "crescent.amm.v1beta1.Position")
+	proto.RegisterType((*Observation)(nil), "crescent.amm.v1beta1.Observation")
 	proto.RegisterType((*TickInfo)(nil), "crescent.amm.v1beta1.TickInfo")
 }
 
 func init() { proto.RegisterFile("crescent/amm/v1beta1/amm.proto", fileDescriptor_1dfef6a2c44f2449) }
 
 var fileDescriptor_1dfef6a2c44f2449 = []byte{
-	// 835 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0x4d, 0x6f, 0x1b, 0x45,
-	0x18, 0xf6, 0xc6, 0x1f, 0xb1, 0xdf, 0x7c, 0x76, 0x88, 0xca, 0x92, 0x92, 0x4d, 0xea, 0x03, 0x58,
-	0x42, 0xf5, 0x36, 0x44, 0xfc, 0x00, 0x02, 0x6a, 0x65, 0xa9, 0x52, 0xd3, 0x35, 0x02, 0x09, 0x90,
-	0x56, 0xeb, 0xdd, 0xd7, 0xdb, 0x91, 0x77, 0x67, 0xdc, 0x99, 0x71, 0x4c, 0x0f, 0x15, 0x37, 0xc4,
-	0x91, 0x03, 0xe2, 0xc6, 0x1f, 0xe0, 0x97, 0xe4, 0x46, 0xc5, 0x09, 0x71, 0x28, 0x90, 0x88, 0xff,
-	0x81, 0x66, 0x76, 0xd7, 0xde, 0x08, 0x23, 0x35, 0x56, 0x7d, 0xf2, 0xce, 0xf3, 0x8e, 0x9f, 0xe7,
-	0xfd, 0xd6, 0x80, 0x13, 0x0a, 0x94, 0x21, 0x32, 0xe5, 0x06, 0x69, 0xea, 0x9e, 0x1f, 0x0f, 0x50,
-	0x05, 0xc7, 0xfa, 0xbb, 0x3b, 0x16, 0x5c, 0x71, 0xb2, 0x57, 0xd8, 0xbb, 0x1a, 0xcb, 0xed, 0xfb,
-	0x7b, 0x31, 0x8f, 0xb9, 0xb9, 0xe0, 0xea, 0xaf, 0xec, 0xee, 0xbe, 0x13, 0x72, 0x99, 0x72, 0xe9,
-	0x0e, 0x02, 0x89, 0x33, 0xaa, 0x90, 0x53, 0x96, 0xd9, 0xdb, 0xdf, 0x57, 0xa1, 0x76, 0xc6, 0x79,
-	0x42, 0xb6, 0x61, 0x8d, 0x46, 0xb6, 0x75, 0x64, 0x75, 0x6a, 0xde, 0x1a, 0x8d, 0xc8, 0x1d, 0x68,
-	0xa5, 0x81, 0x18, 0xa1, 0xf2, 0x69, 0x64, 0xaf, 0x19, 0xb8, 0x99, 0x01, 0xbd, 0x88, 0xdc, 0x86,
-	0x46, 0x84, 0x8c, 0xa7, 0xf7, 0xed, 0xea, 0x91, 0xd5, 0x69, 0x79, 0xf9, 0x69, 0x86, 0x1f, 0xdb,
-	0xb5, 0x12, 0x7e, 0x4c, 0xde, 0x87, 0x1d, 0x81, 0x12, 0xc5, 0x39, 0xfa, 0x41, 0x14, 0x09, 0x94,
-	0xd2, 0xae, 0x9b, 0x0b, 0xdb, 0x39, 0xfc, 0x71, 0x86, 0x92, 0xbb, 0xb0, 0x29, 0x70, 0x1a, 0x88,
-	0x48, 0xfa, 0x63, 0xce, 0x13, 0xbb, 0x61, 0x6e, 0x6d, 0xe4, 0x98, 0x71, 0xf4, 0x2e, 0x6c, 0x2a,
-	0x1a, 0x8e, 0x7c, 0x39, 0x0e, 0x42, 0xca, 0x62, 0x7b, 0xfd, 0xc8, 0xea, 0x6c, 0x79, 0x1b, 0x1a,
-	0xeb, 0x67, 0x10, 0xf9, 0x1a, 0x48, 0x4a, 0x99, 0xcf, 0x45, 0x84, 0xc2, 0x7f, 0x36, 0x09, 0x98,
-	0xa2, 0xea, 0xb9, 0xdd, 0xd4, 0x5c, 0xa7, 0xdd, 0x8b, 0x57, 0x87, 0x95, 0x3f, 0x5e, 0x1d, 0xbe,
-	0x17, 0x53, 0xf5, 0x74, 0x32, 0xe8, 0x86, 0x3c, 0x75, 0xf3, 0x1c, 0x65, 0x3f, 0xf7, 0x64, 0x34,
-	0x72, 0xd5, 0xf3, 0x31, 0xca, 0xee, 0xa7, 0x18, 0x7a, 0xbb, 0x29, 0x65, 0x8f, 0x35, 0xd1, 0x93,
-	0x9c, 0x87, 0x7c, 0x0e, 0x3b, 0x65, 0x76, 0xae, 0xd0, 0x6e, 0x2d, 0x45, 0xbd, 0x35, 0xa7, 0xe6,
-	0x0a, 0xdb, 0xbf, 0xd6, 0xa0, 0xa5, 0x23, 0xec, 0xab, 0x40, 0xa1, 0x0e, 0x33, 0x9c, 0x08, 0x81,
-	0x4c, 0xf9, 0x3a, 0x34, 0x53, 0x99, 0xba, 0xb7, 0x91, 0x63, 0x9f, 0xd1, 0x70, 0x44, 0xfa, 0xb0,
-	0x55, 0x5c, 0x19, 0x0b, 0x1a, 0xa2, 0x29, 0xd3, 0xcd, 0xdd, 0x28, 0x74, 0xce, 0x34, 0x07, 0xf9,
-	0x0a, 0x6e, 0x15, 0xa4, 0x09, 0x7d, 0x36, 0xa1, 0x91, 0x4e, 0x5d, 0xf5, 0xc6, 0xc4, 0x3d, 0xa6,
-	0xbc, 0xdd, 0x9c, 0xe8, 0x51, 0xc1, 0x43, 0xbe, 0x80, 0x1d, 0xc5, 0x55, 0x90, 0x94, 0xa8, 0x6b,
-	0x4b, 0x51, 0x6f, 0x1b, 0x9a, 0x39, 0xf1, 0x0b, 0xb8, 0x35, 0x44, 0xf4, 0x63, 0xc1, 0xa7, 0xea,
-	0xa9, 0x1f, 0x27, 0x7c, 0x10, 0x24, 0x76, 0xfd, 0xa8, 0xda, 0xd9, 0xf8, 0xf0, 0xdd, 0x6e, 0xc6,
-	0xd0, 0xd5, 0x23, 0x50, 0x4c, 0x8b, 0x0e, 0xfc, 0x13, 0x4e, 0xd9, 0xe9, 0x89, 0x16, 0xfe, 0xe5,
-	0xcf, 0xc3, 0x0f, 0x5e, 0x2f, 0x59, 0xfa, 0x3f, 0xd2, 0xdb, 0x19, 0x22, 0x3e, 0x34, 0x52, 0x0f,
-	0x8d, 0x12, 0xf9, 0xd1, 0x82, 0x83, 0x61, 0x20, 0x52, 0xca, 0x62, 0xbf, 0xe8, 0xdf, 0xeb, 0xbe,
-	0x34, 0x56, 0xe5, 0xcb, 0x7e, 0xae, 0xeb, 0x65, 0xb2, 0x65, 0xb7, 0xda, 0xff, 0xd4, 0xa1, 0x79,
-	0xc6, 0x25, 0x55, 0x94, 0xb3, 0xff, 0x0c, 0xf8, 0xdb, 0xb0, 0xae, 0x47, 0x6c, 0x3e, 0xde, 0x0d,
-	0x7d, 0xec, 0x45, 0x64, 0x0f, 0xea, 0x7c, 0xca, 0x50, 0xe4, 0xb3, 0x9d, 0x1d, 0xc8, 0x01, 0x40,
-	0xc2, 0xa7, 0x28, 0xb2, 0x6e, 0xac, 0x99, 0x6e, 0x6c, 0x19, 0xc4, 0xf4, 0xe2, 0x01, 0xc0, 0x64,
-	0x3c, 0x2e, 0xcc, 0xf5, 0xcc, 0x6c, 0x10, 0x63, 0x7e, 0x04, 0xad, 0x79, 0xc9, 0x1b, 0x4b, 0x95,
-	0x7c, 0x4e, 0x40, 0xbe, 0xb3, 0xe0, 0x76, 0x12, 0x48, 0xe5, 0x97, 0x6a, 0x4e, 0x99, 0xa4, 0x11,
-	0xda, 0xeb, 0xab, 0xca, 0xf3, 0x5b, 0x5a, 0xf0, 0x41, 0x51, 0xf7, 0x9e, 0x51, 0x23, 0x43, 0x68,
-	0xf2, 0x29, 0x46, 0xda, 0x0f, 0xbb, 0x69, 0x94, 0xdf, 0x59, 0xa8, 0x6c, 0x64, 0xef, 0xe7, 0xb2,
-	0x9d, 0xd7, 0x90, 0xcd, 0x34, 0xd7, 0x35, 0xf9, 0x03, 0x44, 0xf2, 0xb3, 0x05, 0xed, 0x2c, 0xe0,
-	0xc5, 0x4d, 0x96, 0x07, 0xdf, 0x5a, 0x55, 0xf0, 0x8e, 0x09, 0x7e, 0x41, 0xa3, 0xe5, 0x79, 0x78,
-	0x01, 0x7b, 0x59, 0x1e, 0xae, 0xbb, 0x67, 0xc3, 0x9b, 0xcf, 0x09, 0x31, 0x39, 0xb9, 0xe6, 0x4a,
-	0xfb, 0xb7, 0x2a, 0x34, 0x75, 0x9b, 0xf5, 0xd8, 0x90, 0xeb, 0x1d, 0x13, 0x0b, 0x2e, 0x65, 0x69,
-	0xc7, 0x58, 0xcb, 0xed, 0x18, 0x43, 0x33, 0xdf, 0x31, 0x7d, 0xd8, 0x62, 0x58, 0xde, 0x8a, 0x6b,
-	0x4b, 0xd1, 0x6e, 0x32, 0x2c, 0x6d, 0xc4, 0x6f, 0x81, 0x94, 0x9a, 0x98, 0x4f, 0x94, 0x29, 0x64,
-	0x75, 0x55, 0x85, 0xdc, 0x9d, 0x6d, 0xae, 0xc7, 0x99, 0x14, 0xf9, 0xc9, 0x02, 0xe7, 0x7f, 0xba,
-	0xaa, 0xf0, 0xa6, 0xb6, 0x2a, 0x6f, 0xee, 0x2c, 0xda, 0x5d, 0xb9, 0x63, 0xa7, 0x4f, 0x2e, 0xfe,
-	0x76, 0x2a, 0x17, 0x97, 0x8e, 0xf5, 0xf2, 0xd2, 0xb1, 0xfe, 0xba, 0x74, 0xac, 0x1f, 0xae, 0x9c,
-	0xca, 0xcb, 0x2b, 0xa7, 0xf2, 0xfb, 0x95, 0x53, 0xf9, 0xf2, 0xa4, 0xac, 0x93, 0x3f, 0x87, 0xee,
-	0x31, 0x54, 0x53, 0x2e, 0x46, 0x33, 0xc0, 0x3d, 0xff, 0xc8, 0xfd, 0xc6, 0x3c, 0xa2, 0x8c, 0xf0,
-	0xa0, 0x61, 0xde, 0x3c, 0x27, 0xff, 0x06, 0x00, 0x00, 0xff, 0xff, 0xcb, 0x16, 0x71, 0x3e, 0x61,
-	0x09, 0x00, 0x00,
+	// 1038 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0x4f, 0x6f, 0xdb, 0xc6,
+	0x13, 0x35, 0xad, 0x3f, 0x96, 0x46, 0x96, 0xff, 0xec, 0xcf, 0x70, 0xf8, 0x73, 0x62, 0x5a, 0x51,
+	0x81, 0x56, 0x40, 0x10, 0x29, 0x8e, 0x51, 0xf4, 0xda, 0xda, 0x45, 0x02, 0x01, 0x41, 0xed, 0xd0,
+	0x41, 0x0b, 0xb4, 0x05, 0x08, 0x8a, 0x1c, 0x29, 0x0b, 0x91, 0xbb, 0xca, 0x72, 0x69, 0x39, 0x87,
+	0xa0, 0xb7, 0xa2, 0xe8, 0x29, 0x87, 0xa2, 0xb7, 0x7e, 0x81, 0x7e, 0x12, 0x1f, 0x83, 0x9e, 0x8a,
+	0x1e, 0x92, 0xd6, 0xfe, 0x0a, 0x05, 0x7a, 0x2d, 0x76, 0x49, 0x8a, 0x34, 0xea, 0x00, 0xa9, 0x50,
+	0x9f, 0xac, 0x7d, 0xb3, 0xfb, 0xde, 0xcc, 0x7a, 0x66, 0x1f, 0xc1, 0xf2, 0x04, 0x46, 0x1e, 0x32,
+	0xd9, 0x73, 0xc3, 0xb0, 0x77, 0xb2, 0x3b, 0x40, 0xe9, 0xee, 0xaa, 0xdf, 0xdd, 0x89, 0xe0, 0x92,
+	0x93, 0x8d, 0x2c, 0xde, 0x55, 0x58, 0x1a, 0xdf, 0xda, 0x18, 0xf1, 0x11, 0xd7, 0x1b, 0x7a, 0xea,
+	0x57, 0xb2, 0x77, 0xcb, 0xf2, 0x78, 0x14, 0xf2, 0xa8, 0x37, 0x70, 0x23, 0x9c, 0x51, 0x79, 0x9c,
+	0xb2, 0x34, 0xbe, 0x33, 0xe2, 0x7c, 0x14, 0x60, 0x4f, 0xaf, 0x06, 0xf1, 0xb0, 0x27, 0x69, 0x88,
+	0x91, 0x74, 0xc3, 0x49, 0xb2, 0xa1, 0xfd, 0x5d, 0x09, 0xca, 0x47, 0x9c, 0x07, 0x64, 0x05, 0x16,
+	0xa9, 0x6f, 0x1a, 0x2d, 0xa3, 0x53, 0xb6, 0x17, 0xa9, 0x4f, 0x6e, 0x42, 0x3d, 0x74, 0xc5, 0x18,
+	0xa5, 0x43, 0x7d, 0x73, 0x51, 0xc3, 0xb5, 0x04, 0xe8, 0xfb, 0x64, 0x13, 0xaa, 0x3e, 0x32, 0x1e,
+	0xde, 0x33, 0x4b, 0x2d, 0xa3, 0x53, 0xb7, 0xd3, 0xd5, 0x0c, 0xdf, 0x35, 0xcb, 0x05, 0x7c, 0x97,
+	0x7c, 0x00, 0xab, 0x02, 0x23, 0x14, 0x27, 0xe8, 0xb8, 0xbe, 0x2f, 0x30, 0x8a, 0xcc, 0x8a, 0xde,
+	0xb0, 0x92, 0xc2, 0x9f, 0x24, 0x28, 0xb9, 0x0d, 0xcb, 0x02, 0xa7, 0xae, 0xf0, 0x23, 0x67, 0xc2,
+	0x79, 0x60, 0x56, 0xf5, 0xae, 0x46, 0x8a, 0xe9, 0x44, 0x6f, 0xc3, 0xb2, 0xa4, 0xde, 0xd8, 0x89,
+	0x26, 0xae, 0x47, 0xd9, 0xc8, 0x5c, 0x6a, 0x19, 0x9d, 0xa6, 0xdd, 0x50, 0xd8, 0x71, 0x02, 0x91,
+	0xaf, 0x81, 0x84, 0x94, 0x39, 0x5c, 0xf8, 0x28, 0x9c, 0x67, 0xb1, 0xcb, 0x24, 0x95, 0xcf, 0xcd,
+	0x9a, 0xe2, 0xda, 0xef, 0x9e, 0xbd, 0xde, 0x59, 0xf8, 0xed, 0xf5, 0xce, 0xfb, 0x23, 0x2a, 0x9f,
+	0xc6, 0x83, 0xae, 0xc7, 0xc3, 0x5e, 0x7a, 0x89, 0xc9, 0x9f, 0xbb, 0x91, 0x3f, 0xee, 0xc9, 0xe7,
+	0x13, 0x8c, 0xba, 0x9f, 0xa2, 0x67, 0xaf, 0x85, 0x94, 0x1d, 0x2a, 0xa2, 0xc7, 0x29, 0x0f, 0xf9,
+	0x1c, 0x56, 0x8b, 0xec, 0x5c, 0xa2, 0x59, 0x9f, 0x8b, 0xba, 0x99, 0x53, 0x73, 0x89, 0xed, 0xbf,
+	0x2a, 0x50, 0x57, 0x15, 0x1e, 0x4b, 0x57, 0xa2, 0x2a, 0xd3, 0x8b, 0x85, 0x40, 0x26, 0x1d, 0x55,
+	0x9a, 0xfe, 0xcf, 0x54, 0xec, 0x46, 0x8a, 0x3d, 0xa1, 0xde, 0x98, 0x1c, 0x43, 0x33, 0xdb, 0x32,
+	0x11, 0xd4, 0x43, 0x73, 0x71, 0xae, 0x34, 0x32, 0x9d, 0x23, 0xc5, 0x41, 0xbe, 0x82, 0xf5, 0x8c,
+	0x34, 0xa0, 0xcf, 0x62, 0xea, 0xab, 0xab, 0x2b, 0xfd, 0x6b, 0xe2, 0x3e, 0x93, 0xf6, 0x5a, 0x4a,
+	0xf4, 0x28, 0xe3, 0x21, 0x5f, 0xc0, 0xaa, 0xe4, 0xd2, 0x0d, 0x0a, 0xd4, 0xe5, 0xb9, 0xa8, 0x57,
+	0x34, 0x4d, 0x4e, 0xfc, 0x02, 0xd6, 0x87, 0x88, 0xce, 0x48, 0xf0, 0xa9, 0x7c, 0xea, 0x8c, 0x02,
+	0x3e, 0x70, 0x03, 0xb3, 0xd2, 0x2a, 0x75, 0x1a, 0xf7, 0x6f, 0x75, 0x13, 0x86, 0xae, 0x9a, 0x91,
+	0x6c, 0x9c, 0x54, 0xe1, 0x07, 0x9c, 0xb2, 0xfd, 0x3d, 0x25, 0xfc, 0xf3, 0x9b, 0x9d, 0x3b, 0xef,
+	0x76, 0x59, 0xea, 0x4c, 0x64, 0xaf, 0x0e, 0x11, 0x1f, 0x6a, 0xa9, 0x87, 0x5a, 0x89, 0xfc, 0x60,
+	0xc0, 0xf6, 0xd0, 0x15, 0x21, 0x65, 0x23, 0x27, 0xeb, 0xdf, 0xcb, 0xb9, 0x54, 0xaf, 0x2b, 0x97,
+	0xad, 0x54, 0xd7, 0x4e, 0x64, 0x2f, 0xa5, 0x75, 0x07, 0xd6, 0xf9, 0x40, 0xcd, 0x97, 0x2b, 0x29,
+	0x67, 0x0e, 0x65, 0x3e, 0x9e, 0xa6, 0xf3, 0xb2, 0x56, 0x08, 0xf4, 0x15, 0x4e, 0x3e, 0x82, 0x1b,
+	0xc5, 0xcd, 0x9e, 0x2b, 0x7c, 0xca, 0xdc, 0x20, 0x9b, 0x9c, 0xa6, 0xbd, 0x59, 0x08, 0x1f, 0xe4,
+	0x51, 0xf2, 0x31, 0xdc, 0x7a, 0xcb, 0x41, 0x87, 0xe1, 0xa9, 0xd4, 0xc3, 0xd1, 0xb4, 0xb7, 0xae,
+	0x3e, 0xfd, 0x19, 0x9e, 0xca, 0xf6, 0xf7, 0x55, 0xa8, 0x1d, 0xf1, 0x88, 0xaa, 0xd8, 0x3f, 0x1e,
+	0xa2, 0x1b, 0xb0, 0xa4, 0x9e, 0x82, 0xfc, 0x19, 0xaa, 0xaa, 0x65, 0xdf, 0x27, 0x1b, 0x50, 0xe1,
+	0x53, 0x86, 0x22, 0x7d, 0x83, 0x92, 0x05, 0xd9, 0x06, 0x08, 0xf8, 0x14, 0x45, 0x32, 0x35, 0x65,
+	0x3d, 0x35, 0x75, 0x8d, 0xe8, 0x99, 0xd9, 0x06, 0x88, 0x27, 0x93, 0x2c, 0x5c, 0x49, 0xc2, 0x1a,
+	0xd1, 0xe1, 0x47, 0x50, 0xcf, 0x5b, 0xb3, 0x3a, 0x57, 0x6b, 0xe6, 0x04, 0xe4, 0x5b, 0x03, 0x36,
+	0x03, 0x37, 0x92, 0x4e, 0xa1, 0x37, 0x29, 0x8b, 0xa8, 0x8f, 0xe6, 0xd2, 0x75, 0xf5, 0xc3, 0xff,
+	0x94, 0xe0, 0x83, 0xac, 0x3f, 0xfb, 0x5a, 0x8d, 0x0c, 0xa1, 0xc6, 0xa7, 0xe8, 0xab, 0x3c, 0xcc,
+	0x9a, 0x56, 0xfe, 0xff, 0x95, 0xca, 0x5a, 0xf6, 0x5e, 0x2a, 0xdb, 0x79, 0x07, 0xd9, 0x44, 0x73,
+	0x49, 0x91, 0x3f, 0x40, 0x24, 0x3f, 0x19, 0xd0, 0x4e, 0x0a, 0xbe, 0x7a, 0x18, 0xd2, 0xe2, 0xeb,
+	0xd7, 0x55, 0xbc, 0xa5, 0x8b, 0xbf, 0x62, 0x20, 0xd2, 0x7b, 0x78, 0x01, 0x1b, 0xc9, 0x3d, 0x5c,
+	0x4e, 0xcf, 0x84, 0xff, 0xfe, 0x4e, 0x88, 0xbe, 0x93, 0x4b, 0xa9, 0x90, 0xf7, 0xa0, 0xe9, 0xc6,
+	0x92, 0x3b, 0x1e, 0x0f, 0x27, 0x3c, 0x66, 0xbe, 0xd9, 0x68, 0x19, 0x9d, 0x9a, 0xbd, 0xac, 0xc0,
+	0x83, 0x14, 0x6b, 0xff, 0x69, 0x40, 0xe3, 0x30, 0x9f, 0x15, 0x72, 0x00, 0x30, 0x08, 0xb8, 0x37,
+	0x76, 0x94, 0x75, 0xeb, 0xb9, 0x68, 0xdc, 0xdf, 0xea, 0x26, 0xbe, 0xde, 0xcd, 0x7c, 0xbd, 0xfb,
+	0x24, 0xf3, 0xf5, 0xfd, 0x9a, 0x4a, 0xf5, 0xe5, 0x9b, 0x1d, 0xc3, 0xae, 0xeb, 0x73, 0x2a, 0xa2,
+	0x0c, 0x58, 0x9b, 0xa6, 0x17, 0x87, 0x71, 0xe0, 0x4a, 0x7a, 0x92, 0x98, 0x45, 0xc9, 0x5e, 0x51,
+	0xf0, 0xc1, 0x0c, 0x25, 0x53, 0x68, 0x45, 0xe8, 0x71, 0xa6, 0x0c, 0x18, 0x45, 0xfe, 0x4e, 0x17,
+	0x4f, 0x96, 0xe6, 0xb2, 0x99, 0xed, 0x94, 0xf7, 0x08, 0xc5, 0xec, 0xdd, 0xce, 0x85, 0xdb, 0xbf,
+	0x94, 0xa0, 0xa6, 0x46, 0xb0, 0xcf, 0x86, 0x5c, 0xf9, 0xc4, 0x48, 0xf0, 0x28, 0x2a, 0xf8, 0x84,
+	0x31, 0x9f, 0x4f, 0x68, 0x9a, 0xdc, 0x27, 0x8e, 0xa1, 0xc9, 0xb0, 0xe8, 0x6c, 0x8b, 0x73, 0xd1,
+	0x2e, 0x33, 0x2c, 0xb8, 0xda, 0x37, 0x40, 0x0a, 0x03, 0xce, 0x63, 0xa9, 0x9b, 0xbc, 0x74, 0x5d,
+	0x4d, 0xbe, 0x36, 0x73, 0x9f, 0xc3, 0x44, 0x8a, 0xfc, 0x68, 0x80, 0xf5, 0x96, 0x89, 0xcb, 0xb2,
+	0x29, 0x5f, 0x57, 0x36, 0x37, 0xaf, 0xf2, 0x9f, 0x34, 0xb1, 0xfd, 0xc7, 0x67, 0x7f, 0x58, 0x0b,
+	0x67, 0xe7, 0x96, 0xf1, 0xea, 0xdc, 0x32, 0x7e, 0x3f, 0xb7, 0x8c, 0x97, 0x17, 0xd6, 0xc2, 0xab,
+	0x0b, 0x6b, 0xe1, 0xd7, 0x0b, 0x6b, 0xe1, 0xcb, 0xbd, 0xa2, 0x4e, 0xfa, 0xcd, 0x7b, 0x97, 0xa1,
+	0x9c, 0x72, 0x31, 0x9e, 0x01, 0xbd, 0x93, 0x0f, 0x7b, 0xa7, 0xfa, 0x4b, 0x59, 0x0b, 0x0f, 0xaa,
+	0xba, 0xe5, 0xf7, 0xfe, 0x1e, 0x00, 0x1e, 0x2f, 0xb0, 0x5b, 0x46, 0x0b, 0x00, 0x00,
 }
 
 func (m *Pool) Marshal() (dAtA []byte, err error) {
@@ -370,6 +442,21 @@ func (m *PoolState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ObservationCardinalityNext != 0 {
+		i = encodeVarintAmm(dAtA, i, uint64(m.ObservationCardinalityNext))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.ObservationCardinality != 0 {
+		i = encodeVarintAmm(dAtA, i, uint64(m.ObservationCardinality))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.ObservationIndex != 0 {
+		i = encodeVarintAmm(dAtA, i, uint64(m.ObservationIndex))
+		i--
+		dAtA[i] = 0x38
+	}
 	if len(m.FarmingRewardsGrowthGlobal) > 0 {
 		for iNdEx := len(m.FarmingRewardsGrowthGlobal) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -456,6 +543,16 @@ func (m *Position) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.AutoCompound {
+		i--
+		if m.AutoCompound {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x58
+	}
 	if len(m.OwedFarmingRewards) > 0 {
 		for iNdEx := len(m.OwedFarmingRewards) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -552,6 +649,52 @@ func (m *Position) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *Observation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Observation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Observation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.SecondsPerLiquidityCumulative.Size()
+		i -= size
+		if _, err := m.SecondsPerLiquidityCumulative.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintAmm(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.TickCumulative != 0 {
+		i = encodeVarintAmm(dAtA, i, uint64(m.TickCumulative))
+		i--
+		dAtA[i] = 0x10
+	}
+	n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.BlockTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.BlockTime):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintAmm(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *TickInfo) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -699,6 +842,15 @@ func (m *PoolState) Size() (n int) {
 			n += 1 + l + sovAmm(uint64(l))
 		}
 	}
+	if m.ObservationIndex != 0 {
+		n += 1 + sovAmm(uint64(m.ObservationIndex))
+	}
+	if m.ObservationCardinality != 0 {
+		n += 1 + sovAmm(uint64(m.ObservationCardinality))
+	}
+	if m.ObservationCardinalityNext != 0 {
+		n += 1 + sovAmm(uint64(m.ObservationCardinalityNext))
+	}
 	return n
 }
 
@@ -750,6 +902,25 @@ func (m *Position) Size() (n int) {
 			n += 1 + l + sovAmm(uint64(l))
 		}
 	}
+	if m.AutoCompound {
+		n += 2
+	}
+	return n
+}
+
+func (m *Observation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.BlockTime)
+	n += 1 + l + sovAmm(uint64(l))
+	if m.TickCumulative != 0 {
+		n += 1 + sovAmm(uint64(m.TickCumulative))
+	}
+	l = m.SecondsPerLiquidityCumulative.Size()
+	n += 1 + l + sovAmm(uint64(l))
 	return n
 }
 
@@ -1305,6 +1476,63 @@ func (m *PoolState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObservationIndex", wireType)
+			}
+			m.ObservationIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAmm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ObservationIndex |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObservationCardinality", wireType)
+			}
+			m.ObservationCardinality = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAmm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ObservationCardinality |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObservationCardinalityNext", wireType)
+			}
+			m.ObservationCardinalityNext = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAmm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ObservationCardinalityNext |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAmm(dAtA[iNdEx:])
@@ -1633,6 +1861,162 @@ func (m *Position) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoCompound", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAmm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AutoCompound = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAmm(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAmm
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Observation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAmm
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Observation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Observation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAmm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAmm
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAmm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.BlockTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TickCumulative", wireType)
+			}
+			m.TickCumulative = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAmm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TickCumulative |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SecondsPerLiquidityCumulative", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAmm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAmm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAmm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SecondsPerLiquidityCumulative.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAmm(dAtA[iNdEx:])
@@ -0,0 +1,69 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+)
+
+var _ authz.Authorization = &TransferPositionAuthorization{}
+
+// NewTransferPositionAuthorization creates a new TransferPositionAuthorization.
+func NewTransferPositionAuthorization(positionIds []uint64) *TransferPositionAuthorization {
+	return &TransferPositionAuthorization{
+		PositionIds: positionIds,
+	}
+}
+
+// MsgTypeURL implements Authorization.MsgTypeURL.
+func (a TransferPositionAuthorization) MsgTypeURL() string {
+	return sdk.MsgTypeURL(&MsgTransferPosition{})
+}
+
+// Accept implements Authorization.Accept.
+// The transferred position is removed from the authorization and the
+// authorization is deleted when there's no position left.
+func (a TransferPositionAuthorization) Accept(_ sdk.Context, msg sdk.Msg) (authz.AcceptResponse, error) {
+	msgTransfer, ok := msg.(*MsgTransferPosition)
+	if !ok {
+		return authz.AcceptResponse{}, sdkerrors.ErrInvalidType.Wrap("type mismatch")
+	}
+	var positionIdsLeft []uint64
+	allowed := false
+	for _, positionId := range a.PositionIds {
+		if positionId == msgTransfer.PositionId {
+			allowed = true
+		} else {
+			positionIdsLeft = append(positionIdsLeft, positionId)
+		}
+	}
+	if !allowed {
+		return authz.AcceptResponse{}, sdkerrors.ErrUnauthorized.Wrapf(
+			"transfer of position %d is not authorized", msgTransfer.PositionId)
+	}
+	if len(positionIdsLeft) == 0 {
+		return authz.AcceptResponse{Accept: true, Delete: true}, nil
+	}
+	return authz.AcceptResponse{
+		Accept:  true,
+		Updated: NewTransferPositionAuthorization(positionIdsLeft),
+	}, nil
+}
+
+// ValidateBasic implements Authorization.ValidateBasic.
+func (a TransferPositionAuthorization) ValidateBasic() error {
+	if len(a.PositionIds) == 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("position ids must not be empty")
+	}
+	seen := map[uint64]struct{}{}
+	for _, positionId := range a.PositionIds {
+		if positionId == 0 {
+			return sdkerrors.ErrInvalidRequest.Wrap("position id must not be 0")
+		}
+		if _, ok := seen[positionId]; ok {
+			return sdkerrors.ErrInvalidRequest.Wrapf("duplicate position id: %d", positionId)
+		}
+		seen[positionId] = struct{}{}
+	}
+	return nil
+}
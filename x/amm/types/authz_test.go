@@ -0,0 +1,59 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	utils "github.com/crescent-network/crescent/v5/types"
+	"github.com/crescent-network/crescent/v5/x/amm/types"
+)
+
+func TestTransferPositionAuthorization_ValidateBasic(t *testing.T) {
+	for _, tc := range []struct {
+		name        string
+		positionIds []uint64
+		expectedErr string
+	}{
+		{"valid", []uint64{1, 2, 3}, ""},
+		{"empty position ids", nil, "position ids must not be empty: invalid request"},
+		{"zero position id", []uint64{1, 0}, "position id must not be 0: invalid request"},
+		{"duplicate position id", []uint64{1, 2, 1}, "duplicate position id: 1: invalid request"},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			auth := types.NewTransferPositionAuthorization(tc.positionIds)
+			err := auth.ValidateBasic()
+			if tc.expectedErr == "" {
+				require.NoError(t, err)
+			} else {
+				require.EqualError(t, err, tc.expectedErr)
+			}
+		})
+	}
+}
+
+func TestTransferPositionAuthorization_Accept(t *testing.T) {
+	ctx := sdk.Context{}
+	granterAddr, recipientAddr := utils.TestAddress(1), utils.TestAddress(2)
+	auth := types.NewTransferPositionAuthorization([]uint64{1, 2})
+	require.Equal(t, "/crescent.amm.v1beta1.MsgTransferPosition", auth.MsgTypeURL())
+
+	_, err := auth.Accept(ctx, types.NewMsgCollect(granterAddr, 1, utils.ParseCoins("1000ucre")))
+	require.EqualError(t, err, "type mismatch: invalid type")
+
+	_, err = auth.Accept(ctx, types.NewMsgTransferPosition(granterAddr, 3, recipientAddr))
+	require.EqualError(t, err, "transfer of position 3 is not authorized: unauthorized")
+
+	resp, err := auth.Accept(ctx, types.NewMsgTransferPosition(granterAddr, 1, recipientAddr))
+	require.NoError(t, err)
+	require.True(t, resp.Accept)
+	require.False(t, resp.Delete)
+	require.Equal(t, types.NewTransferPositionAuthorization([]uint64{2}), resp.Updated)
+
+	resp, err = resp.Updated.Accept(ctx, types.NewMsgTransferPosition(granterAddr, 2, recipientAddr))
+	require.NoError(t, err)
+	require.True(t, resp.Accept)
+	require.True(t, resp.Delete)
+}
@@ -6,6 +6,7 @@ import (
 	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/msgservice"
+	"github.com/cosmos/cosmos-sdk/x/authz"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 )
 
@@ -15,11 +16,17 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgCreatePool{}, "amm/MsgCreatePool", nil)
 	cdc.RegisterConcrete(&MsgAddLiquidity{}, "amm/MsgAddLiquidity", nil)
 	cdc.RegisterConcrete(&MsgRemoveLiquidity{}, "amm/MsgRemoveLiquidity", nil)
+	cdc.RegisterConcrete(&MsgIncreaseLiquidity{}, "amm/MsgIncreaseLiquidity", nil)
+	cdc.RegisterConcrete(&MsgDecreaseLiquidity{}, "amm/MsgDecreaseLiquidity", nil)
 	cdc.RegisterConcrete(&MsgCollect{}, "amm/MsgCollect", nil)
+	cdc.RegisterConcrete(&MsgTransferPosition{}, "amm/MsgTransferPosition", nil)
+	cdc.RegisterConcrete(&MsgCompound{}, "amm/MsgCompound", nil)
+	cdc.RegisterConcrete(&MsgSetAutoCompound{}, "amm/MsgSetAutoCompound", nil)
 	cdc.RegisterConcrete(&MsgCreatePrivateFarmingPlan{}, "amm/MsgCreatePrivateFarmingPlan", nil)
 	cdc.RegisterConcrete(&MsgTerminatePrivateFarmingPlan{}, "amm/MsgTerminatePrivateFarmingPlan", nil)
 	cdc.RegisterConcrete(&PoolParameterChangeProposal{}, "amm/PoolParameterChangeProposal", nil)
 	cdc.RegisterConcrete(&PublicFarmingPlanProposal{}, "amm/PublicFarmingPlanProposal", nil)
+	cdc.RegisterConcrete(&TransferPositionAuthorization{}, "amm/TransferPositionAuthorization", nil)
 }
 
 // RegisterInterfaces registers the x/amm interfaces types with the
@@ -30,7 +37,12 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 		&MsgCreatePool{},
 		&MsgAddLiquidity{},
 		&MsgRemoveLiquidity{},
+		&MsgIncreaseLiquidity{},
+		&MsgDecreaseLiquidity{},
 		&MsgCollect{},
+		&MsgTransferPosition{},
+		&MsgCompound{},
+		&MsgSetAutoCompound{},
 		&MsgCreatePrivateFarmingPlan{},
 		&MsgTerminatePrivateFarmingPlan{},
 	)
@@ -39,6 +51,10 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 		&PoolParameterChangeProposal{},
 		&PublicFarmingPlanProposal{},
 	)
+	registry.RegisterImplementations(
+		(*authz.Authorization)(nil),
+		&TransferPositionAuthorization{},
+	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
 }
@@ -1,3 +1,11 @@
 package types
 
-var ()
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var (
+	ErrSlippageExceeded = sdkerrors.Register(ModuleName, 2, "amount is smaller than the min amount")
+	ErrDeadlinePassed   = sdkerrors.Register(ModuleName, 3, "deadline passed")
+	ErrPriceDeviated    = sdkerrors.Register(ModuleName, 4, "pool price deviates from the reference price")
+)
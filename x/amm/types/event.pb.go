@@ -30,10 +30,11 @@ var _ = time.Kitchen
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 type EventCreatePool struct {
-	Creator  string                                 `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
-	MarketId uint64                                 `protobuf:"varint,2,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
-	Price    github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=price,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"price"`
-	PoolId   uint64                                 `protobuf:"varint,4,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	Creator     string                                 `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	MarketId    uint64                                 `protobuf:"varint,2,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	Price       github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=price,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"price"`
+	PoolId      uint64                                 `protobuf:"varint,4,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	TickSpacing uint32                                 `protobuf:"varint,5,opt,name=tick_spacing,json=tickSpacing,proto3" json:"tick_spacing,omitempty"`
 }
 
 func (m *EventCreatePool) Reset()         { *m = EventCreatePool{} }
@@ -191,6 +192,127 @@ func (m *EventCollect) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_EventCollect proto.InternalMessageInfo
 
+type EventTransferPosition struct {
+	Sender     string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	PositionId uint64 `protobuf:"varint,2,opt,name=position_id,json=positionId,proto3" json:"position_id,omitempty"`
+	Recipient  string `protobuf:"bytes,3,opt,name=recipient,proto3" json:"recipient,omitempty"`
+}
+
+func (m *EventTransferPosition) Reset()         { *m = EventTransferPosition{} }
+func (m *EventTransferPosition) String() string { return proto.CompactTextString(m) }
+func (*EventTransferPosition) ProtoMessage()    {}
+func (*EventTransferPosition) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8285ef069ec17c48, []int{4}
+}
+func (m *EventTransferPosition) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventTransferPosition) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventTransferPosition.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventTransferPosition) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventTransferPosition.Merge(m, src)
+}
+func (m *EventTransferPosition) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventTransferPosition) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventTransferPosition.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventTransferPosition proto.InternalMessageInfo
+
+type EventCompound struct {
+	Owner      string                                   `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	PositionId uint64                                   `protobuf:"varint,2,opt,name=position_id,json=positionId,proto3" json:"position_id,omitempty"`
+	Collected  github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=collected,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"collected"`
+	SwapInput  types.DecCoin                            `protobuf:"bytes,4,opt,name=swap_input,json=swapInput,proto3" json:"swap_input"`
+	SwapOutput types.DecCoin                            `protobuf:"bytes,5,opt,name=swap_output,json=swapOutput,proto3" json:"swap_output"`
+	Liquidity  github_com_cosmos_cosmos_sdk_types.Int   `protobuf:"bytes,6,opt,name=liquidity,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"liquidity"`
+	Amount     github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,7,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+}
+
+func (m *EventCompound) Reset()         { *m = EventCompound{} }
+func (m *EventCompound) String() string { return proto.CompactTextString(m) }
+func (*EventCompound) ProtoMessage()    {}
+func (*EventCompound) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8285ef069ec17c48, []int{5}
+}
+func (m *EventCompound) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventCompound) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventCompound.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventCompound) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventCompound.Merge(m, src)
+}
+func (m *EventCompound) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventCompound) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventCompound.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventCompound proto.InternalMessageInfo
+
+type EventSetAutoCompound struct {
+	Owner      string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	PositionId uint64 `protobuf:"varint,2,opt,name=position_id,json=positionId,proto3" json:"position_id,omitempty"`
+	Enabled    bool   `protobuf:"varint,3,opt,name=enabled,proto3" json:"enabled,omitempty"`
+}
+
+func (m *EventSetAutoCompound) Reset()         { *m = EventSetAutoCompound{} }
+func (m *EventSetAutoCompound) String() string { return proto.CompactTextString(m) }
+func (*EventSetAutoCompound) ProtoMessage()    {}
+func (*EventSetAutoCompound) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8285ef069ec17c48, []int{6}
+}
+func (m *EventSetAutoCompound) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventSetAutoCompound) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventSetAutoCompound.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventSetAutoCompound) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventSetAutoCompound.Merge(m, src)
+}
+func (m *EventSetAutoCompound) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventSetAutoCompound) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventSetAutoCompound.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventSetAutoCompound proto.InternalMessageInfo
+
 type EventCreatePrivateFarmingPlan struct {
 	Creator            string                    `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
 	Description        string                    `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
@@ -206,7 +328,7 @@ func (m *EventCreatePrivateFarmingPlan) Reset()         { *m = EventCreatePrivat
 func (m *EventCreatePrivateFarmingPlan) String() string { return proto.CompactTextString(m) }
 func (*EventCreatePrivateFarmingPlan) ProtoMessage()    {}
 func (*EventCreatePrivateFarmingPlan) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8285ef069ec17c48, []int{4}
+	return fileDescriptor_8285ef069ec17c48, []int{7}
 }
 func (m *EventCreatePrivateFarmingPlan) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -249,7 +371,7 @@ func (m *EventCreatePublicFarmingPlan) Reset()         { *m = EventCreatePublicF
 func (m *EventCreatePublicFarmingPlan) String() string { return proto.CompactTextString(m) }
 func (*EventCreatePublicFarmingPlan) ProtoMessage()    {}
 func (*EventCreatePublicFarmingPlan) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8285ef069ec17c48, []int{5}
+	return fileDescriptor_8285ef069ec17c48, []int{8}
 }
 func (m *EventCreatePublicFarmingPlan) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -286,7 +408,7 @@ func (m *EventFarmingPlanTerminated) Reset()         { *m = EventFarmingPlanTerm
 func (m *EventFarmingPlanTerminated) String() string { return proto.CompactTextString(m) }
 func (*EventFarmingPlanTerminated) ProtoMessage()    {}
 func (*EventFarmingPlanTerminated) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8285ef069ec17c48, []int{6}
+	return fileDescriptor_8285ef069ec17c48, []int{9}
 }
 func (m *EventFarmingPlanTerminated) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -316,17 +438,18 @@ func (m *EventFarmingPlanTerminated) XXX_DiscardUnknown() {
 var xxx_messageInfo_EventFarmingPlanTerminated proto.InternalMessageInfo
 
 type EventPoolParameterChanged struct {
-	PoolId           uint64                                  `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
-	TickSpacing      uint32                                  `protobuf:"varint,2,opt,name=tick_spacing,json=tickSpacing,proto3" json:"tick_spacing,omitempty"`
-	MinOrderQuantity *github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=min_order_quantity,json=minOrderQuantity,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"min_order_quantity,omitempty"`
-	MinOrderQuote    *github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=min_order_quote,json=minOrderQuote,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"min_order_quote,omitempty"`
+	PoolId                 uint64                                  `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	TickSpacing            uint32                                  `protobuf:"varint,2,opt,name=tick_spacing,json=tickSpacing,proto3" json:"tick_spacing,omitempty"`
+	MinOrderQuantity       *github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=min_order_quantity,json=minOrderQuantity,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"min_order_quantity,omitempty"`
+	MinOrderQuote          *github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=min_order_quote,json=minOrderQuote,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"min_order_quote,omitempty"`
+	ObservationCardinality uint32                                  `protobuf:"varint,5,opt,name=observation_cardinality,json=observationCardinality,proto3" json:"observation_cardinality,omitempty"`
 }
 
 func (m *EventPoolParameterChanged) Reset()         { *m = EventPoolParameterChanged{} }
 func (m *EventPoolParameterChanged) String() string { return proto.CompactTextString(m) }
 func (*EventPoolParameterChanged) ProtoMessage()    {}
 func (*EventPoolParameterChanged) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8285ef069ec17c48, []int{7}
+	return fileDescriptor_8285ef069ec17c48, []int{10}
 }
 func (m *EventPoolParameterChanged) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -360,6 +483,9 @@ func init() {
 	proto.RegisterType((*EventAddLiquidity)(nil), "crescent.amm.v1beta1.EventAddLiquidity")
 	proto.RegisterType((*EventRemoveLiquidity)(nil), "crescent.amm.v1beta1.EventRemoveLiquidity")
 	proto.RegisterType((*EventCollect)(nil), "crescent.amm.v1beta1.EventCollect")
+	proto.RegisterType((*EventTransferPosition)(nil), "crescent.amm.v1beta1.EventTransferPosition")
+	proto.RegisterType((*EventCompound)(nil), "crescent.amm.v1beta1.EventCompound")
+	proto.RegisterType((*EventSetAutoCompound)(nil), "crescent.amm.v1beta1.EventSetAutoCompound")
 	proto.RegisterType((*EventCreatePrivateFarmingPlan)(nil), "crescent.amm.v1beta1.EventCreatePrivateFarmingPlan")
 	proto.RegisterType((*EventCreatePublicFarmingPlan)(nil), "crescent.amm.v1beta1.EventCreatePublicFarmingPlan")
 	proto.RegisterType((*EventFarmingPlanTerminated)(nil), "crescent.amm.v1beta1.EventFarmingPlanTerminated")
@@ -369,61 +495,71 @@ func init() {
 func init() { proto.RegisterFile("crescent/amm/v1beta1/event.proto", fileDescriptor_8285ef069ec17c48) }
 
 var fileDescriptor_8285ef069ec17c48 = []byte{
-	// 856 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x56, 0xcf, 0x6f, 0x1b, 0x45,
-	0x14, 0xf6, 0xda, 0x8e, 0x1d, 0x3f, 0x37, 0x0a, 0x1d, 0x2c, 0xe1, 0x06, 0xb0, 0x8d, 0x0f, 0x55,
-	0x84, 0x94, 0xdd, 0xfe, 0x10, 0x67, 0x14, 0x27, 0x20, 0x59, 0xaa, 0x94, 0x74, 0xe9, 0x01, 0x71,
-	0xb1, 0xc6, 0x3b, 0x2f, 0xee, 0x28, 0xbb, 0x33, 0xdb, 0xd9, 0xb1, 0x43, 0x6f, 0x5c, 0xb8, 0xf7,
-	0xbf, 0x40, 0xea, 0x1d, 0x89, 0x3f, 0x21, 0xc7, 0x1c, 0x11, 0x87, 0x16, 0x92, 0x7f, 0x04, 0xcd,
-	0xcc, 0xae, 0xbd, 0x84, 0xb4, 0x90, 0x96, 0xdc, 0x38, 0xc5, 0xf3, 0xe6, 0xbd, 0xef, 0x7d, 0xf3,
-	0xbe, 0xd9, 0x6f, 0x02, 0x83, 0x48, 0x61, 0x16, 0xa1, 0xd0, 0x01, 0x4d, 0x92, 0x60, 0x71, 0x7f,
-	0x8a, 0x9a, 0xde, 0x0f, 0x70, 0x81, 0x42, 0xfb, 0xa9, 0x92, 0x5a, 0x92, 0x4e, 0x91, 0xe1, 0xd3,
-	0x24, 0xf1, 0xf3, 0x8c, 0xad, 0xfe, 0x4c, 0xca, 0x59, 0x8c, 0x81, 0xcd, 0x99, 0xce, 0x8f, 0x02,
-	0xcd, 0x13, 0xcc, 0x34, 0x4d, 0x52, 0x57, 0xb6, 0xd5, 0x99, 0xc9, 0x99, 0xb4, 0x3f, 0x03, 0xf3,
-	0x2b, 0x8f, 0xf6, 0x22, 0x99, 0x25, 0x32, 0x0b, 0xa6, 0x34, 0xc3, 0x65, 0xb7, 0x48, 0x72, 0x91,
-	0xef, 0x0f, 0xaf, 0xa4, 0x73, 0x44, 0x55, 0xc2, 0xc5, 0xcc, 0xe5, 0x0c, 0x7f, 0xf2, 0x60, 0xf3,
-	0x2b, 0x43, 0x70, 0x4f, 0x21, 0xd5, 0x78, 0x28, 0x65, 0x4c, 0xba, 0xd0, 0x8c, 0xcc, 0x4a, 0xaa,
-	0xae, 0x37, 0xf0, 0xb6, 0x5b, 0x61, 0xb1, 0x24, 0x1f, 0x43, 0x2b, 0xa1, 0xea, 0x18, 0xf5, 0x84,
-	0xb3, 0x6e, 0x75, 0xe0, 0x6d, 0xd7, 0xc3, 0x75, 0x17, 0x18, 0x33, 0xb2, 0x0f, 0x6b, 0xa9, 0xe2,
-	0x11, 0x76, 0x6b, 0xa6, 0x68, 0xe4, 0x9f, 0xbe, 0xea, 0x57, 0x7e, 0x7b, 0xd5, 0xbf, 0x3b, 0xe3,
-	0xfa, 0xe9, 0x7c, 0xea, 0x47, 0x32, 0x09, 0x72, 0xc2, 0xee, 0xcf, 0x4e, 0xc6, 0x8e, 0x03, 0xfd,
-	0x3c, 0xc5, 0xcc, 0xdf, 0xc7, 0x28, 0x74, 0xc5, 0xe4, 0x23, 0x68, 0xa6, 0x52, 0xc6, 0xa6, 0x41,
-	0xdd, 0x36, 0x68, 0x98, 0xe5, 0x98, 0x0d, 0x7f, 0xa9, 0xc1, 0x6d, 0xcb, 0x74, 0x97, 0xb1, 0x47,
-	0xfc, 0xd9, 0x9c, 0x33, 0xae, 0x9f, 0x93, 0x0e, 0xac, 0xc9, 0x13, 0x81, 0x05, 0x53, 0xb7, 0x28,
-	0x83, 0x54, 0xcb, 0x20, 0xe4, 0x00, 0xda, 0xb1, 0x3c, 0x41, 0x35, 0x79, 0x1f, 0xa6, 0x60, 0x21,
-	0x0e, 0x2d, 0xdd, 0x03, 0x68, 0xcf, 0xd3, 0x74, 0x09, 0x58, 0x7f, 0x37, 0x40, 0x0b, 0xe1, 0x00,
-	0xfb, 0xd0, 0x4e, 0x65, 0xc6, 0x35, 0x97, 0xc2, 0xd0, 0x5f, 0xb3, 0xf4, 0xa1, 0x08, 0x8d, 0x19,
-	0x79, 0x04, 0xad, 0xb8, 0x38, 0x7e, 0xb7, 0x71, 0xed, 0x7e, 0x63, 0xa1, 0xc3, 0x15, 0x00, 0x89,
-	0xa0, 0x41, 0x13, 0x39, 0x17, 0xba, 0xdb, 0x1c, 0xd4, 0xb6, 0xdb, 0x0f, 0xee, 0xf8, 0xae, 0xc2,
-	0x37, 0x97, 0xaa, 0xb8, 0xa0, 0xfe, 0x9e, 0xe4, 0x62, 0x74, 0xcf, 0x74, 0x79, 0xf9, 0xba, 0xbf,
-	0xfd, 0x2f, 0xba, 0x98, 0x82, 0x2c, 0xcc, 0xa1, 0x87, 0x3f, 0x54, 0xa1, 0x63, 0xa5, 0x0b, 0x31,
-	0x91, 0x0b, 0xfc, 0x27, 0xf5, 0x2e, 0x8d, 0xa0, 0xfa, 0xf6, 0x11, 0xd4, 0xfe, 0xbb, 0x11, 0xd4,
-	0x6f, 0x6e, 0x04, 0x2f, 0x3d, 0xb8, 0xe5, 0xbe, 0x33, 0x19, 0xc7, 0x18, 0xe9, 0x77, 0x3d, 0xfa,
-	0x8a, 0x6c, 0xed, 0xe6, 0xc8, 0x9e, 0xd5, 0xe0, 0xd3, 0xb2, 0x29, 0x28, 0xbe, 0xa0, 0x1a, 0xbf,
-	0x76, 0xc6, 0x71, 0x18, 0x53, 0xf1, 0x16, 0x8b, 0x18, 0x40, 0x9b, 0x61, 0x16, 0x29, 0x9e, 0x1a,
-	0xc6, 0xf6, 0x04, 0xad, 0xb0, 0x1c, 0x22, 0x01, 0x7c, 0xa8, 0xd1, 0x40, 0x51, 0x7b, 0x4c, 0xca,
-	0x98, 0xc2, 0x2c, 0x73, 0x3a, 0x86, 0xa4, 0xb4, 0xb5, 0xeb, 0x76, 0xc8, 0x14, 0x88, 0xc2, 0x13,
-	0xaa, 0xd8, 0x84, 0xc6, 0xb1, 0x8c, 0xec, 0x5e, 0x96, 0x8b, 0xb5, 0xe3, 0x5f, 0xe5, 0xa8, 0x7e,
-	0xce, 0x35, 0xb4, 0x65, 0xbb, 0xcb, 0xaa, 0x51, 0xdd, 0xcc, 0x24, 0xbc, 0xad, 0x2e, 0xc5, 0x33,
-	0xb2, 0x07, 0x90, 0x69, 0xaa, 0xf4, 0xc4, 0x58, 0xaf, 0xfd, 0xea, 0xda, 0x0f, 0xb6, 0x7c, 0xe7,
-	0xcb, 0x7e, 0xe1, 0xcb, 0xfe, 0x93, 0xc2, 0x97, 0x47, 0xeb, 0x06, 0xe8, 0xc5, 0xeb, 0xbe, 0x17,
-	0xb6, 0x6c, 0x9d, 0xd9, 0x21, 0x5f, 0xc2, 0x3a, 0x0a, 0xe6, 0x20, 0x1a, 0xd7, 0x80, 0x68, 0xa2,
-	0x60, 0x16, 0xe0, 0x2e, 0x6c, 0xe6, 0xf6, 0x3c, 0x49, 0x63, 0x6a, 0xaf, 0x40, 0xd3, 0x5e, 0x81,
-	0x8d, 0xa3, 0xd5, 0xf0, 0xc7, 0x8c, 0xdc, 0x83, 0xce, 0x32, 0xcf, 0xf8, 0x5c, 0x31, 0xc3, 0x75,
-	0x37, 0xc3, 0x22, 0x59, 0xca, 0x38, 0x9f, 0xe1, 0xf0, 0xe7, 0x1a, 0x7c, 0x52, 0x96, 0x74, 0x3e,
-	0x8d, 0x79, 0x54, 0x56, 0xf4, 0x92, 0x6e, 0xde, 0xdf, 0x75, 0x7b, 0x53, 0xd3, 0xea, 0x9b, 0x9a,
-	0xfe, 0xaf, 0xf4, 0x7b, 0x2b, 0x3d, 0xdc, 0x87, 0x2d, 0x2b, 0x5b, 0x49, 0xaa, 0x27, 0xf9, 0xdc,
-	0x90, 0x5d, 0x85, 0xe2, 0x5d, 0x85, 0xf2, 0x63, 0x15, 0xee, 0x58, 0x18, 0xa3, 0xce, 0x21, 0x55,
-	0x34, 0x41, 0x8d, 0x6a, 0xef, 0x29, 0x15, 0x33, 0x64, 0xe5, 0xd7, 0xd2, 0xfb, 0xcb, 0x6b, 0xf9,
-	0x19, 0xdc, 0xd2, 0x3c, 0x3a, 0x9e, 0x64, 0x29, 0x8d, 0xb8, 0x98, 0x59, 0xa5, 0x37, 0xc2, 0xb6,
-	0x89, 0x7d, 0xe3, 0x42, 0xe4, 0x5b, 0x20, 0x09, 0x17, 0x13, 0xa9, 0x18, 0xaa, 0xc9, 0xb3, 0x39,
-	0x15, 0x7a, 0xe5, 0xc9, 0x9f, 0x5f, 0xe3, 0x09, 0xfc, 0x20, 0xe1, 0xe2, 0xc0, 0x80, 0x3c, 0xce,
-	0x31, 0x48, 0x08, 0x9b, 0x65, 0x64, 0xa9, 0x8b, 0xd7, 0xf5, 0x3a, 0xb0, 0x1b, 0x2b, 0x58, 0xa9,
-	0x71, 0xf4, 0xf8, 0xf4, 0x8f, 0x5e, 0xe5, 0xf4, 0xbc, 0xe7, 0x9d, 0x9d, 0xf7, 0xbc, 0xdf, 0xcf,
-	0x7b, 0xde, 0x8b, 0x8b, 0x5e, 0xe5, 0xec, 0xa2, 0x57, 0xf9, 0xf5, 0xa2, 0x57, 0xf9, 0xee, 0x61,
-	0x19, 0x34, 0xbf, 0x6b, 0x3b, 0x02, 0xf5, 0x89, 0x54, 0xc7, 0xcb, 0x40, 0xb0, 0xf8, 0x22, 0xf8,
-	0xde, 0xfe, 0x43, 0x65, 0xbb, 0x4c, 0x1b, 0x56, 0xef, 0x87, 0x7f, 0x06, 0x00, 0x00, 0xff, 0xff,
-	0xbf, 0xf3, 0x9f, 0x92, 0xfc, 0x09, 0x00, 0x00,
+	// 1023 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x57, 0x4d, 0x6f, 0xdb, 0x46,
+	0x10, 0x35, 0x25, 0x5b, 0xb2, 0x46, 0x31, 0xd2, 0x6c, 0xdd, 0x44, 0x71, 0x53, 0x49, 0xd5, 0x21,
+	0x10, 0x0a, 0x84, 0xcc, 0x07, 0x8a, 0x1e, 0x0b, 0x5b, 0x6e, 0x01, 0x01, 0x01, 0xec, 0x30, 0x3e,
+	0x14, 0xbd, 0x08, 0x2b, 0xee, 0x58, 0x59, 0x98, 0xdc, 0x65, 0x96, 0x4b, 0xb9, 0xb9, 0xf5, 0x27,
+	0xe4, 0x77, 0xe4, 0x5e, 0xa0, 0xa7, 0x9e, 0x8d, 0x9e, 0x7c, 0x2c, 0x7a, 0x48, 0x5a, 0xfb, 0xda,
+	0x1f, 0x51, 0xec, 0x92, 0x94, 0x58, 0xc7, 0x71, 0x62, 0xbb, 0x3e, 0x14, 0xe8, 0xc9, 0xda, 0xd9,
+	0x9d, 0xf7, 0xde, 0xce, 0x1b, 0x92, 0x63, 0xe8, 0x06, 0x0a, 0x93, 0x00, 0x85, 0xf6, 0x68, 0x14,
+	0x79, 0xd3, 0x07, 0x63, 0xd4, 0xf4, 0x81, 0x87, 0x53, 0x14, 0xda, 0x8d, 0x95, 0xd4, 0x92, 0xac,
+	0x16, 0x27, 0x5c, 0x1a, 0x45, 0x6e, 0x7e, 0x62, 0xad, 0x33, 0x91, 0x72, 0x12, 0xa2, 0x67, 0xcf,
+	0x8c, 0xd3, 0x5d, 0x4f, 0xf3, 0x08, 0x13, 0x4d, 0xa3, 0x38, 0x4b, 0x5b, 0x5b, 0x9d, 0xc8, 0x89,
+	0xb4, 0x3f, 0x3d, 0xf3, 0x2b, 0x8f, 0xb6, 0x03, 0x99, 0x44, 0x32, 0xf1, 0xc6, 0x34, 0xc1, 0x19,
+	0x5b, 0x20, 0xb9, 0xc8, 0xf7, 0x7b, 0xa7, 0xca, 0xd9, 0xa5, 0x2a, 0xe2, 0x62, 0x92, 0x9d, 0xe9,
+	0xfd, 0xea, 0xc0, 0xf5, 0x6f, 0x8c, 0xc0, 0x81, 0x42, 0xaa, 0x71, 0x5b, 0xca, 0x90, 0xb4, 0xa0,
+	0x1e, 0x98, 0x95, 0x54, 0x2d, 0xa7, 0xeb, 0xf4, 0x1b, 0x7e, 0xb1, 0x24, 0x9f, 0x42, 0x23, 0xa2,
+	0x6a, 0x0f, 0xf5, 0x88, 0xb3, 0x56, 0xa5, 0xeb, 0xf4, 0x17, 0xfd, 0xe5, 0x2c, 0x30, 0x64, 0x64,
+	0x13, 0x96, 0x62, 0xc5, 0x03, 0x6c, 0x55, 0x4d, 0xd2, 0x86, 0x7b, 0xf0, 0xba, 0xb3, 0xf0, 0xfb,
+	0xeb, 0xce, 0xdd, 0x09, 0xd7, 0xcf, 0xd2, 0xb1, 0x1b, 0xc8, 0xc8, 0xcb, 0x05, 0x67, 0x7f, 0xee,
+	0x25, 0x6c, 0xcf, 0xd3, 0x2f, 0x62, 0x4c, 0xdc, 0x4d, 0x0c, 0xfc, 0x2c, 0x99, 0xdc, 0x82, 0x7a,
+	0x2c, 0x65, 0x68, 0x08, 0x16, 0x2d, 0x41, 0xcd, 0x2c, 0x87, 0x8c, 0x7c, 0x0e, 0xd7, 0x34, 0x0f,
+	0xf6, 0x46, 0x49, 0x4c, 0x03, 0x2e, 0x26, 0xad, 0xa5, 0xae, 0xd3, 0x5f, 0xf1, 0x9b, 0x26, 0xf6,
+	0x34, 0x0b, 0xf5, 0x7e, 0xae, 0xc2, 0x0d, 0x7b, 0x99, 0x75, 0xc6, 0x1e, 0xf3, 0xe7, 0x29, 0x67,
+	0x5c, 0xbf, 0x20, 0xab, 0xb0, 0x24, 0xf7, 0x05, 0x16, 0x97, 0xc9, 0x16, 0x65, 0x9e, 0xca, 0x3f,
+	0x78, 0xb6, 0xa0, 0x19, 0xca, 0x7d, 0x54, 0xa3, 0xcb, 0x5c, 0x06, 0x2c, 0xc4, 0xb6, 0xbd, 0xd1,
+	0x16, 0x34, 0xd3, 0x38, 0x9e, 0x01, 0x2e, 0x5e, 0x0c, 0xd0, 0x42, 0x64, 0x80, 0x1d, 0x68, 0xc6,
+	0x32, 0xe1, 0x9a, 0x4b, 0x61, 0xe4, 0x2f, 0x59, 0xf9, 0x50, 0x84, 0x86, 0x8c, 0x3c, 0x86, 0x46,
+	0x58, 0x5c, 0xbf, 0x55, 0x3b, 0x37, 0xdf, 0x50, 0x68, 0x7f, 0x0e, 0x40, 0x02, 0xa8, 0xd1, 0x48,
+	0xa6, 0x42, 0xb7, 0xea, 0xdd, 0x6a, 0xbf, 0xf9, 0xf0, 0xb6, 0x9b, 0x65, 0xb8, 0xa6, 0xef, 0x8a,
+	0x1e, 0x76, 0x07, 0x92, 0x8b, 0x8d, 0xfb, 0x86, 0xe5, 0xd5, 0x9b, 0x4e, 0xff, 0x03, 0x58, 0x4c,
+	0x42, 0xe2, 0xe7, 0xd0, 0xbd, 0x1f, 0x2b, 0xb0, 0x6a, 0xad, 0xf3, 0x31, 0x92, 0x53, 0x7c, 0x9f,
+	0x7b, 0x27, 0x4a, 0x50, 0x39, 0xbb, 0x04, 0xd5, 0x7f, 0xaf, 0x04, 0x8b, 0x57, 0x57, 0x82, 0x57,
+	0x0e, 0x5c, 0xcb, 0x1e, 0x45, 0x19, 0x86, 0x18, 0xe8, 0x8b, 0x5e, 0x7d, 0x2e, 0xb6, 0x7a, 0x75,
+	0x62, 0x05, 0x7c, 0x62, 0xb5, 0xee, 0x28, 0x2a, 0x92, 0x5d, 0x54, 0xdb, 0x39, 0x3f, 0xb9, 0x09,
+	0xb5, 0x04, 0x05, 0x9b, 0xa9, 0xce, 0x57, 0xef, 0x97, 0x7d, 0x07, 0x1a, 0x0a, 0x03, 0x1e, 0x73,
+	0xb4, 0xca, 0x4d, 0xee, 0x3c, 0xd0, 0xfb, 0xab, 0x0a, 0x2b, 0x79, 0x71, 0xa2, 0x58, 0xa6, 0x82,
+	0x5d, 0xb4, 0x3a, 0x1c, 0x1a, 0x41, 0x56, 0x5f, 0x64, 0x57, 0x51, 0xa0, 0x39, 0x3a, 0x59, 0x07,
+	0x48, 0xf6, 0x69, 0x3c, 0xe2, 0x22, 0x4e, 0xb5, 0x7d, 0xee, 0x9b, 0x0f, 0xef, 0x9c, 0xca, 0xb5,
+	0x89, 0x81, 0xa5, 0x5b, 0x34, 0x74, 0x7e, 0xc3, 0x64, 0x0d, 0x4d, 0x12, 0x19, 0x40, 0xd3, 0x42,
+	0xc8, 0x54, 0x1b, 0x8c, 0xa5, 0x0f, 0xc6, 0xb0, 0xcc, 0x5b, 0x36, 0xeb, 0xbf, 0xf8, 0x3a, 0x98,
+	0xe4, 0x6f, 0x83, 0xa7, 0xa8, 0xd7, 0x53, 0x2d, 0x2f, 0x6b, 0x7a, 0x0b, 0xea, 0x28, 0xe8, 0x38,
+	0xb4, 0x96, 0x3b, 0xfd, 0x65, 0xbf, 0x58, 0xf6, 0x0e, 0xab, 0xf0, 0x59, 0xf9, 0xfb, 0xa7, 0xf8,
+	0x94, 0x6a, 0xfc, 0x36, 0xfb, 0x46, 0x6e, 0x87, 0x54, 0x9c, 0xf1, 0x35, 0xec, 0x42, 0x93, 0x61,
+	0x12, 0x28, 0x1e, 0x1b, 0x1a, 0x4b, 0xdb, 0xf0, 0xcb, 0x21, 0xe2, 0xc1, 0xc7, 0x1a, 0x0d, 0x14,
+	0xb5, 0xda, 0x28, 0x63, 0x0a, 0x93, 0x24, 0xef, 0x6e, 0x52, 0xda, 0x5a, 0xcf, 0x76, 0xc8, 0x18,
+	0x88, 0xc2, 0x7d, 0xaa, 0xd8, 0x88, 0x86, 0xa1, 0x0c, 0xec, 0x5e, 0x92, 0xbf, 0x74, 0xee, 0xb9,
+	0xa7, 0x0d, 0x0f, 0x6e, 0xae, 0xd5, 0xb7, 0x69, 0xeb, 0xb3, 0xac, 0xbc, 0x0f, 0x6e, 0xa8, 0x13,
+	0xf1, 0x84, 0x0c, 0x00, 0x12, 0x4d, 0x95, 0x1e, 0x69, 0x1e, 0x61, 0xde, 0x52, 0x6b, 0x6e, 0x36,
+	0x82, 0xb8, 0xc5, 0x08, 0xe2, 0xee, 0x14, 0x23, 0xc8, 0xc6, 0xb2, 0x01, 0x7a, 0xf9, 0xa6, 0xe3,
+	0xf8, 0x0d, 0x9b, 0x67, 0x76, 0xc8, 0xd7, 0xb0, 0x8c, 0x82, 0x65, 0x10, 0xb5, 0x73, 0x40, 0xd4,
+	0x51, 0x30, 0x0b, 0x70, 0x17, 0xae, 0xe7, 0x93, 0xc8, 0x28, 0x0e, 0xa9, 0xf5, 0xad, 0x6e, 0x7d,
+	0x5b, 0xd9, 0x9d, 0x17, 0x7f, 0xc8, 0xc8, 0x7d, 0x58, 0x9d, 0x9d, 0x33, 0xdf, 0xeb, 0xa2, 0x86,
+	0xcb, 0x59, 0x0d, 0x8b, 0xc3, 0x52, 0x86, 0x79, 0x0d, 0x7b, 0x3f, 0x55, 0xe1, 0x4e, 0xd9, 0xd2,
+	0x74, 0x1c, 0xf2, 0xa0, 0xec, 0xe8, 0x09, 0xdf, 0x9c, 0xb7, 0x7d, 0x7b, 0x17, 0x69, 0xe5, 0x5d,
+	0xa4, 0xff, 0x3b, 0x7d, 0x69, 0xa7, 0x7b, 0x9b, 0xb0, 0x66, 0x6d, 0x2b, 0x59, 0xb5, 0x93, 0xd7,
+	0x0d, 0xd9, 0x69, 0x28, 0xce, 0x69, 0x28, 0xbf, 0x54, 0xe0, 0xb6, 0x85, 0x31, 0xee, 0x6c, 0x53,
+	0x45, 0x23, 0xd4, 0xa8, 0x06, 0xcf, 0xa8, 0x98, 0x20, 0x2b, 0x4f, 0x7d, 0xce, 0x99, 0xd3, 0x65,
+	0xe5, 0xad, 0xe9, 0x92, 0x7c, 0x07, 0x24, 0xe2, 0x62, 0x24, 0x15, 0x43, 0x35, 0x7a, 0x9e, 0x52,
+	0xa1, 0xe7, 0xb3, 0xc5, 0x17, 0xe7, 0x18, 0xe5, 0x3e, 0x8a, 0xb8, 0xd8, 0x32, 0x20, 0x4f, 0x72,
+	0x0c, 0xe2, 0xc3, 0xf5, 0x32, 0xb2, 0xd4, 0xc5, 0x94, 0x78, 0x1e, 0xd8, 0x95, 0x39, 0xac, 0xd4,
+	0x48, 0xbe, 0x82, 0x5b, 0x72, 0x9c, 0xa0, 0x9a, 0x66, 0x0d, 0x19, 0x50, 0xc5, 0xb8, 0xa0, 0xa1,
+	0x91, 0x9c, 0x4d, 0xce, 0x37, 0x4b, 0xdb, 0x83, 0xf9, 0xee, 0xc6, 0x93, 0x83, 0x3f, 0xdb, 0x0b,
+	0x07, 0x47, 0x6d, 0xe7, 0xf0, 0xa8, 0xed, 0xfc, 0x71, 0xd4, 0x76, 0x5e, 0x1e, 0xb7, 0x17, 0x0e,
+	0x8f, 0xdb, 0x0b, 0xbf, 0x1d, 0xb7, 0x17, 0xbe, 0x7f, 0x54, 0x56, 0x93, 0x37, 0xe9, 0x3d, 0x81,
+	0x7a, 0x5f, 0xaa, 0xbd, 0x59, 0xc0, 0x9b, 0x7e, 0xe9, 0xfd, 0x60, 0xff, 0xe9, 0xb0, 0xf2, 0xc6,
+	0x35, 0xdb, 0x28, 0x8f, 0xfe, 0x1e, 0x00, 0x3f, 0x76, 0x6f, 0xa6, 0x20, 0x0d, 0x00, 0x00,
 }
 
 func (m *EventCreatePool) Marshal() (dAtA []byte, err error) {
@@ -446,6 +582,11 @@ func (m *EventCreatePool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.TickSpacing != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.TickSpacing))
+		i--
+		dAtA[i] = 0x28
+	}
 	if m.PoolId != 0 {
 		i = encodeVarintEvent(dAtA, i, uint64(m.PoolId))
 		i--
@@ -668,6 +809,186 @@ func (m *EventCollect) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *EventTransferPosition) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventTransferPosition) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventTransferPosition) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.PositionId != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.PositionId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventCompound) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventCompound) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventCompound) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvent(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	{
+		size := m.Liquidity.Size()
+		i -= size
+		if _, err := m.Liquidity.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size, err := m.SwapOutput.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size, err := m.SwapInput.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Collected) > 0 {
+		for iNdEx := len(m.Collected) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Collected[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvent(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.PositionId != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.PositionId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventSetAutoCompound) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventSetAutoCompound) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventSetAutoCompound) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Enabled {
+		i--
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.PositionId != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.PositionId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *EventCreatePrivateFarmingPlan) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -700,20 +1021,20 @@ func (m *EventCreatePrivateFarmingPlan) MarshalToSizedBuffer(dAtA []byte) (int,
 		i--
 		dAtA[i] = 0x38
 	}
-	n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.EndTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.EndTime):])
-	if err1 != nil {
-		return 0, err1
+	n3, err3 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.EndTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.EndTime):])
+	if err3 != nil {
+		return 0, err3
 	}
-	i -= n1
-	i = encodeVarintEvent(dAtA, i, uint64(n1))
+	i -= n3
+	i = encodeVarintEvent(dAtA, i, uint64(n3))
 	i--
 	dAtA[i] = 0x32
-	n2, err2 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime):])
-	if err2 != nil {
-		return 0, err2
+	n4, err4 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime):])
+	if err4 != nil {
+		return 0, err4
 	}
-	i -= n2
-	i = encodeVarintEvent(dAtA, i, uint64(n2))
+	i -= n4
+	i = encodeVarintEvent(dAtA, i, uint64(n4))
 	i--
 	dAtA[i] = 0x2a
 	if len(m.RewardAllocations) > 0 {
@@ -779,20 +1100,20 @@ func (m *EventCreatePublicFarmingPlan) MarshalToSizedBuffer(dAtA []byte) (int, e
 		i--
 		dAtA[i] = 0x38
 	}
-	n3, err3 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.EndTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.EndTime):])
-	if err3 != nil {
-		return 0, err3
+	n5, err5 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.EndTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.EndTime):])
+	if err5 != nil {
+		return 0, err5
 	}
-	i -= n3
-	i = encodeVarintEvent(dAtA, i, uint64(n3))
+	i -= n5
+	i = encodeVarintEvent(dAtA, i, uint64(n5))
 	i--
 	dAtA[i] = 0x32
-	n4, err4 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime):])
-	if err4 != nil {
-		return 0, err4
+	n6, err6 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime):])
+	if err6 != nil {
+		return 0, err6
 	}
-	i -= n4
-	i = encodeVarintEvent(dAtA, i, uint64(n4))
+	i -= n6
+	i = encodeVarintEvent(dAtA, i, uint64(n6))
 	i--
 	dAtA[i] = 0x2a
 	if len(m.RewardAllocations) > 0 {
@@ -881,6 +1202,11 @@ func (m *EventPoolParameterChanged) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	_ = i
 	var l int
 	_ = l
+	if m.ObservationCardinality != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.ObservationCardinality))
+		i--
+		dAtA[i] = 0x28
+	}
 	if m.MinOrderQuote != nil {
 		{
 			size := m.MinOrderQuote.Size()
@@ -947,7 +1273,10 @@ func (m *EventCreatePool) Size() (n int) {
 	if m.PoolId != 0 {
 		n += 1 + sovEvent(uint64(m.PoolId))
 	}
-	return n
+	if m.TickSpacing != 0 {
+		n += 1 + sovEvent(uint64(m.TickSpacing))
+	}
+	return n
 }
 
 func (m *EventAddLiquidity) Size() (n int) {
@@ -1027,6 +1356,79 @@ func (m *EventCollect) Size() (n int) {
 	return n
 }
 
+func (m *EventTransferPosition) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.PositionId != 0 {
+		n += 1 + sovEvent(uint64(m.PositionId))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	return n
+}
+
+func (m *EventCompound) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.PositionId != 0 {
+		n += 1 + sovEvent(uint64(m.PositionId))
+	}
+	if len(m.Collected) > 0 {
+		for _, e := range m.Collected {
+			l = e.Size()
+			n += 1 + l + sovEvent(uint64(l))
+		}
+	}
+	l = m.SwapInput.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = m.SwapOutput.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = m.Liquidity.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovEvent(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EventSetAutoCompound) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.PositionId != 0 {
+		n += 1 + sovEvent(uint64(m.PositionId))
+	}
+	if m.Enabled {
+		n += 2
+	}
+	return n
+}
+
 func (m *EventCreatePrivateFarmingPlan) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1131,6 +1533,9 @@ func (m *EventPoolParameterChanged) Size() (n int) {
 		l = m.MinOrderQuote.Size()
 		n += 1 + l + sovEvent(uint64(l))
 	}
+	if m.ObservationCardinality != 0 {
+		n += 1 + sovEvent(uint64(m.ObservationCardinality))
+	}
 	return n
 }
 
@@ -1199,13 +1604,611 @@ func (m *EventCreatePool) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+			}
+			m.MarketId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MarketId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TickSpacing", wireType)
+			}
+			m.TickSpacing = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TickSpacing |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventAddLiquidity) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventAddLiquidity: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventAddLiquidity: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LowerPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LowerPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpperPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.UpperPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PositionId", wireType)
+			}
+			m.PositionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PositionId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Liquidity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Liquidity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventRemoveLiquidity) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventRemoveLiquidity: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventRemoveLiquidity: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PositionId", wireType)
+			}
+			m.PositionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PositionId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Liquidity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Liquidity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventCollect) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventCollect: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventCollect: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PositionId", wireType)
 			}
-			m.MarketId = 0
+			m.PositionId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -1215,16 +2218,16 @@ func (m *EventCreatePool) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MarketId |= uint64(b&0x7F) << shift
+				m.PositionId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -1234,45 +2237,26 @@ func (m *EventCreatePool) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthEvent
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvent
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
-			}
-			m.PoolId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvent
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.PoolId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvent(dAtA[iNdEx:])
@@ -1294,7 +2278,7 @@ func (m *EventCreatePool) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventAddLiquidity) Unmarshal(dAtA []byte) error {
+func (m *EventTransferPosition) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1317,15 +2301,15 @@ func (m *EventAddLiquidity) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventAddLiquidity: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventTransferPosition: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventAddLiquidity: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventTransferPosition: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1353,13 +2337,13 @@ func (m *EventAddLiquidity) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Owner = string(dAtA[iNdEx:postIndex])
+			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PositionId", wireType)
 			}
-			m.PoolId = 0
+			m.PositionId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -1369,14 +2353,14 @@ func (m *EventAddLiquidity) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PoolId |= uint64(b&0x7F) << shift
+				m.PositionId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LowerPrice", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1404,13 +2388,61 @@ func (m *EventAddLiquidity) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.LowerPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 4:
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventCompound) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventCompound: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventCompound: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UpperPrice", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1438,11 +2470,9 @@ func (m *EventAddLiquidity) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.UpperPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 2:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field PositionId", wireType)
 			}
@@ -1461,11 +2491,11 @@ func (m *EventAddLiquidity) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 6:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Liquidity", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Collected", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -1475,29 +2505,29 @@ func (m *EventAddLiquidity) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthEvent
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvent
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Liquidity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Collected = append(m.Collected, types.Coin{})
+			if err := m.Collected[len(m.Collected)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SwapInput", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1524,66 +2554,15 @@ func (m *EventAddLiquidity) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Amount = append(m.Amount, types.Coin{})
-			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.SwapInput.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipEvent(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthEvent
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *EventRemoveLiquidity) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowEvent
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: EventRemoveLiquidity: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventRemoveLiquidity: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SwapOutput", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -1593,44 +2572,26 @@ func (m *EventRemoveLiquidity) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthEvent
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvent
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Owner = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PositionId", wireType)
-			}
-			m.PositionId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvent
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.PositionId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if err := m.SwapOutput.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		case 3:
+			iNdEx = postIndex
+		case 6:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Liquidity", wireType)
 			}
@@ -1664,7 +2625,7 @@ func (m *EventRemoveLiquidity) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 7:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
@@ -1719,7 +2680,7 @@ func (m *EventRemoveLiquidity) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventCollect) Unmarshal(dAtA []byte) error {
+func (m *EventSetAutoCompound) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1742,10 +2703,10 @@ func (m *EventCollect) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventCollect: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventSetAutoCompound: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventCollect: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventSetAutoCompound: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1800,10 +2761,10 @@ func (m *EventCollect) Unmarshal(dAtA []byte) error {
 				}
 			}
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -1813,26 +2774,12 @@ func (m *EventCollect) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthEvent
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvent
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Amount = append(m.Amount, types.Coin{})
-			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+			m.Enabled = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvent(dAtA[iNdEx:])
@@ -2624,6 +3571,25 @@ func (m *EventPoolParameterChanged) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObservationCardinality", wireType)
+			}
+			m.ObservationCardinality = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ObservationCardinality |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvent(dAtA[iNdEx:])
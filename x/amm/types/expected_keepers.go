@@ -34,6 +34,10 @@ type ExchangeKeeper interface {
 	LookupMarket(ctx sdk.Context, marketId uint64) (found bool)
 	IterateAllMarkets(ctx sdk.Context, cb func(market exchangetypes.Market) (stop bool))
 	MustGetMarketState(ctx sdk.Context, marketId uint64) (marketState exchangetypes.MarketState)
+	TWAP(ctx sdk.Context, marketId uint64, window time.Duration) (twap sdk.Dec, err error)
+	SwapExactAmountIn(
+		ctx sdk.Context, ordererAddr sdk.AccAddress, routes []uint64, input, minOutput sdk.DecCoin,
+		referrerAddr sdk.AccAddress, simulate bool) (output sdk.DecCoin, results []exchangetypes.SwapRouteResult, err error)
 }
 
 type MarkerKeeper interface {
@@ -7,23 +7,25 @@ import (
 func NewGenesisState(
 	params Params, lastPoolId, lastPositionId uint64,
 	poolRecords []PoolRecord, positions []Position, tickInfoRecords []TickInfoRecord,
-	lastFarmingPlanId uint64, numPrivateFarmingPlans uint32, farmingPlans []FarmingPlan) *GenesisState {
+	lastFarmingPlanId uint64, numPrivateFarmingPlans uint32, farmingPlans []FarmingPlan,
+	lastAutoCompoundPositionId uint64) *GenesisState {
 	return &GenesisState{
-		Params:                 params,
-		LastPoolId:             lastPoolId,
-		LastPositionId:         lastPositionId,
-		PoolRecords:            poolRecords,
-		Positions:              positions,
-		TickInfoRecords:        tickInfoRecords,
-		LastFarmingPlanId:      lastFarmingPlanId,
-		NumPrivateFarmingPlans: numPrivateFarmingPlans,
-		FarmingPlans:           farmingPlans,
+		Params:                     params,
+		LastPoolId:                 lastPoolId,
+		LastPositionId:             lastPositionId,
+		PoolRecords:                poolRecords,
+		Positions:                  positions,
+		TickInfoRecords:            tickInfoRecords,
+		LastFarmingPlanId:          lastFarmingPlanId,
+		NumPrivateFarmingPlans:     numPrivateFarmingPlans,
+		FarmingPlans:               farmingPlans,
+		LastAutoCompoundPositionId: lastAutoCompoundPositionId,
 	}
 }
 
 // DefaultGenesis returns the default genesis state for the module.
 func DefaultGenesis() *GenesisState {
-	return NewGenesisState(DefaultParams(), 0, 0, nil, nil, nil, 0, 0, nil)
+	return NewGenesisState(DefaultParams(), 0, 0, nil, nil, nil, 0, 0, nil, 0)
 }
 
 func (genState GenesisState) Validate() error {
@@ -50,6 +52,11 @@ func (genState GenesisState) Validate() error {
 			return fmt.Errorf("invalid farming plan: %w", err)
 		}
 	}
+	if genState.LastAutoCompoundPositionId > genState.LastPositionId {
+		return fmt.Errorf(
+			"last auto-compound position id must not be greater than the last position id: %d > %d",
+			genState.LastAutoCompoundPositionId, genState.LastPositionId)
+	}
 	return nil
 }
 
@@ -60,6 +67,19 @@ func (record PoolRecord) Validate() error {
 	if err := record.State.Validate(); err != nil {
 		return fmt.Errorf("invalid pool state: %w", err)
 	}
+	if len(record.Observations) == 0 {
+		return fmt.Errorf("observations must not be empty")
+	}
+	if len(record.Observations) > int(record.State.ObservationCardinality) {
+		return fmt.Errorf(
+			"number of observations must not be greater than observation cardinality %d: %d",
+			record.State.ObservationCardinality, len(record.Observations))
+	}
+	for _, observation := range record.Observations {
+		if err := observation.Validate(); err != nil {
+			return fmt.Errorf("invalid observation: %w", err)
+		}
+	}
 	return nil
 }
 
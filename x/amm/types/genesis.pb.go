@@ -24,15 +24,16 @@ var _ = math.Inf
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 type GenesisState struct {
-	Params                 Params           `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
-	LastPoolId             uint64           `protobuf:"varint,2,opt,name=last_pool_id,json=lastPoolId,proto3" json:"last_pool_id,omitempty"`
-	LastPositionId         uint64           `protobuf:"varint,3,opt,name=last_position_id,json=lastPositionId,proto3" json:"last_position_id,omitempty"`
-	PoolRecords            []PoolRecord     `protobuf:"bytes,4,rep,name=pool_records,json=poolRecords,proto3" json:"pool_records"`
-	Positions              []Position       `protobuf:"bytes,5,rep,name=positions,proto3" json:"positions"`
-	TickInfoRecords        []TickInfoRecord `protobuf:"bytes,6,rep,name=tick_info_records,json=tickInfoRecords,proto3" json:"tick_info_records"`
-	LastFarmingPlanId      uint64           `protobuf:"varint,7,opt,name=last_farming_plan_id,json=lastFarmingPlanId,proto3" json:"last_farming_plan_id,omitempty"`
-	NumPrivateFarmingPlans uint32           `protobuf:"varint,8,opt,name=num_private_farming_plans,json=numPrivateFarmingPlans,proto3" json:"num_private_farming_plans,omitempty"`
-	FarmingPlans           []FarmingPlan    `protobuf:"bytes,9,rep,name=farming_plans,json=farmingPlans,proto3" json:"farming_plans"`
+	Params                     Params           `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	LastPoolId                 uint64           `protobuf:"varint,2,opt,name=last_pool_id,json=lastPoolId,proto3" json:"last_pool_id,omitempty"`
+	LastPositionId             uint64           `protobuf:"varint,3,opt,name=last_position_id,json=lastPositionId,proto3" json:"last_position_id,omitempty"`
+	PoolRecords                []PoolRecord     `protobuf:"bytes,4,rep,name=pool_records,json=poolRecords,proto3" json:"pool_records"`
+	Positions                  []Position       `protobuf:"bytes,5,rep,name=positions,proto3" json:"positions"`
+	TickInfoRecords            []TickInfoRecord `protobuf:"bytes,6,rep,name=tick_info_records,json=tickInfoRecords,proto3" json:"tick_info_records"`
+	LastFarmingPlanId          uint64           `protobuf:"varint,7,opt,name=last_farming_plan_id,json=lastFarmingPlanId,proto3" json:"last_farming_plan_id,omitempty"`
+	NumPrivateFarmingPlans     uint32           `protobuf:"varint,8,opt,name=num_private_farming_plans,json=numPrivateFarmingPlans,proto3" json:"num_private_farming_plans,omitempty"`
+	FarmingPlans               []FarmingPlan    `protobuf:"bytes,9,rep,name=farming_plans,json=farmingPlans,proto3" json:"farming_plans"`
+	LastAutoCompoundPositionId uint64           `protobuf:"varint,10,opt,name=last_auto_compound_position_id,json=lastAutoCompoundPositionId,proto3" json:"last_auto_compound_position_id,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -71,6 +72,8 @@ var xxx_messageInfo_GenesisState proto.InternalMessageInfo
 type PoolRecord struct {
 	Pool  Pool      `protobuf:"bytes,1,opt,name=pool,proto3" json:"pool"`
 	State PoolState `protobuf:"bytes,2,opt,name=state,proto3" json:"state"`
+	// observations are the pool's observations ordered by their indexes.
+	Observations []Observation `protobuf:"bytes,3,rep,name=observations,proto3" json:"observations"`
 }
 
 func (m *PoolRecord) Reset()         { *m = PoolRecord{} }
@@ -156,41 +159,44 @@ func init() {
 }
 
 var fileDescriptor_ecb88d9e54329161 = []byte{
-	// 531 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x53, 0x41, 0x8f, 0xd2, 0x40,
-	0x18, 0xa5, 0x6e, 0x61, 0x97, 0x0f, 0x76, 0x75, 0x27, 0x44, 0x91, 0x98, 0x6e, 0x97, 0x78, 0xe0,
-	0x62, 0x9b, 0x65, 0xf5, 0xa0, 0x9e, 0xe4, 0xa0, 0x21, 0xf1, 0x80, 0x68, 0x3c, 0x78, 0x21, 0x43,
-	0x19, 0x70, 0x42, 0xdb, 0x69, 0x3a, 0x03, 0xea, 0x49, 0x7f, 0x82, 0xff, 0xc6, 0xbf, 0xc0, 0x71,
-	0x8f, 0x9e, 0x8c, 0xc2, 0x1f, 0x31, 0xfd, 0x66, 0xca, 0x42, 0x52, 0x6e, 0xcd, 0x37, 0xef, 0xbd,
-	0xef, 0xe5, 0x7d, 0xaf, 0xd0, 0x0e, 0x52, 0x26, 0x03, 0x16, 0x2b, 0x9f, 0x46, 0x91, 0xbf, 0xbc,
-	0x1a, 0x33, 0x45, 0xaf, 0xfc, 0x19, 0x8b, 0x99, 0xe4, 0xd2, 0x4b, 0x52, 0xa1, 0x04, 0x69, 0xe4,
-	0x18, 0x8f, 0x46, 0x91, 0x67, 0x30, 0xad, 0xc6, 0x4c, 0xcc, 0x04, 0x02, 0xfc, 0xec, 0x4b, 0x63,
-	0x5b, 0x4e, 0xa1, 0x5e, 0xc6, 0xd3, 0xef, 0xc5, 0xfb, 0xa6, 0x34, 0x8d, 0x78, 0x3c, 0x33, 0x98,
-	0xcb, 0x42, 0x4c, 0x42, 0x53, 0x1a, 0x19, 0x4b, 0xed, 0x5f, 0x36, 0xd4, 0xdf, 0x68, 0x93, 0xef,
-	0x15, 0x55, 0x8c, 0xbc, 0x80, 0x8a, 0x06, 0x34, 0x2d, 0xd7, 0xea, 0xd4, 0xba, 0x8f, 0xbc, 0x22,
-	0xd3, 0xde, 0x00, 0x31, 0x3d, 0x7b, 0xf5, 0xe7, 0xa2, 0x34, 0x34, 0x0c, 0xe2, 0x42, 0x3d, 0xa4,
-	0x52, 0x8d, 0x12, 0x21, 0xc2, 0x11, 0x9f, 0x34, 0xef, 0xb8, 0x56, 0xc7, 0x1e, 0x42, 0x36, 0x1b,
-	0x08, 0x11, 0xf6, 0x27, 0xa4, 0x03, 0xf7, 0x0c, 0x42, 0x72, 0xc5, 0x45, 0x9c, 0xa1, 0x8e, 0x10,
-	0x75, 0xa6, 0x51, 0x7a, 0xdc, 0x9f, 0x90, 0x3e, 0xd4, 0x51, 0x26, 0x65, 0x81, 0x48, 0x27, 0xb2,
-	0x69, 0xbb, 0x47, 0x9d, 0x5a, 0xd7, 0x3d, 0xe0, 0x46, 0x88, 0x70, 0x88, 0x40, 0xe3, 0xa8, 0x96,
-	0x6c, 0x27, 0x92, 0xf4, 0xa0, 0x9a, 0xef, 0x93, 0xcd, 0x32, 0xea, 0x38, 0x87, 0x74, 0x34, 0xcc,
-	0xa8, 0xdc, 0xd2, 0xc8, 0x47, 0x38, 0x57, 0x3c, 0x98, 0x8f, 0x78, 0x3c, 0x15, 0x5b, 0x4f, 0x15,
-	0xd4, 0x7a, 0x5c, 0xac, 0xf5, 0x81, 0x07, 0xf3, 0x7e, 0x3c, 0x15, 0x7b, 0xbe, 0xee, 0xaa, 0xbd,
-	0xa9, 0x24, 0x3e, 0x34, 0x30, 0x10, 0x73, 0xb8, 0x51, 0x12, 0x52, 0x0c, 0xe5, 0x18, 0x43, 0x39,
-	0xcf, 0xde, 0x5e, 0xeb, 0xa7, 0x41, 0x48, 0xb3, 0x5c, 0x9e, 0xc3, 0xc3, 0x78, 0x11, 0x8d, 0x92,
-	0x94, 0x2f, 0xa9, 0x62, 0x7b, 0x3c, 0xd9, 0x3c, 0x71, 0xad, 0xce, 0xe9, 0xf0, 0x7e, 0xbc, 0x88,
-	0x06, 0xfa, 0x7d, 0x87, 0x2b, 0xc9, 0x5b, 0x38, 0xdd, 0x87, 0x57, 0xd1, 0xff, 0x65, 0xb1, 0xff,
-	0x1d, 0xaa, 0x31, 0x5f, 0x9f, 0xee, 0xa8, 0xb5, 0xbf, 0x03, 0xdc, 0xc6, 0x4e, 0x9e, 0x82, 0x9d,
-	0x45, 0x6e, 0x4a, 0xd3, 0x3a, 0x7c, 0x26, 0xa3, 0x85, 0x68, 0xf2, 0x12, 0xca, 0x32, 0x6b, 0x1d,
-	0x36, 0xa5, 0xd6, 0xbd, 0x38, 0x4c, 0xc3, 0x72, 0x1a, 0xae, 0xe6, 0xb4, 0x7f, 0x58, 0x70, 0xb6,
-	0x1f, 0x32, 0x79, 0x00, 0xc7, 0x79, 0xf7, 0x2c, 0x0c, 0xb0, 0x92, 0xe8, 0xde, 0x11, 0xb0, 0xb3,
-	0xe4, 0x71, 0x4f, 0x79, 0x88, 0xdf, 0xe4, 0x15, 0x54, 0xb7, 0x27, 0xc5, 0x12, 0x1e, 0xac, 0x45,
-	0xbe, 0xc5, 0xec, 0x3f, 0xc9, 0x8f, 0xd8, 0x7b, 0xb7, 0xfa, 0xe7, 0x94, 0x56, 0x6b, 0xc7, 0xba,
-	0x59, 0x3b, 0xd6, 0xdf, 0xb5, 0x63, 0xfd, 0xdc, 0x38, 0xa5, 0x9b, 0x8d, 0x53, 0xfa, 0xbd, 0x71,
-	0x4a, 0x9f, 0xae, 0x67, 0x5c, 0x7d, 0x5e, 0x8c, 0xbd, 0x40, 0x44, 0x7e, 0xae, 0xfb, 0x24, 0x66,
-	0xea, 0x8b, 0x48, 0xe7, 0xdb, 0x81, 0xbf, 0x7c, 0xe6, 0x7f, 0xc5, 0xff, 0x53, 0x7d, 0x4b, 0x98,
-	0x1c, 0x57, 0xf0, 0xbf, 0xbc, 0xfe, 0x1f, 0x00, 0x00, 0xff, 0xff, 0xf0, 0x91, 0x79, 0x42, 0x50,
-	0x04, 0x00, 0x00,
+	// 585 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x94, 0xc1, 0x8f, 0xd2, 0x4e,
+	0x14, 0xc7, 0xe9, 0x6f, 0xbb, 0xec, 0xf2, 0x60, 0xf7, 0xe7, 0x4e, 0x88, 0x22, 0x31, 0xdd, 0x2e,
+	0xf1, 0xc0, 0xc5, 0x36, 0xcb, 0xea, 0x41, 0x3d, 0x2d, 0x26, 0x1a, 0xa2, 0x89, 0x88, 0xc6, 0x83,
+	0x97, 0x66, 0x28, 0x05, 0x1b, 0xda, 0x4e, 0xd3, 0x99, 0xa2, 0xde, 0xfc, 0x13, 0xfc, 0xb3, 0x38,
+	0x78, 0xd8, 0xa3, 0x27, 0xa3, 0xf0, 0x67, 0x78, 0x31, 0x7d, 0x33, 0x05, 0x9a, 0x14, 0x6f, 0xe4,
+	0xcd, 0xe7, 0xfb, 0xe6, 0xcb, 0x7b, 0xdf, 0x29, 0x74, 0xdc, 0xc4, 0xe3, 0xae, 0x17, 0x09, 0x9b,
+	0x86, 0xa1, 0xbd, 0xb8, 0x1c, 0x7b, 0x82, 0x5e, 0xda, 0x33, 0x2f, 0xf2, 0xb8, 0xcf, 0xad, 0x38,
+	0x61, 0x82, 0x91, 0x66, 0xce, 0x58, 0x34, 0x0c, 0x2d, 0xc5, 0xb4, 0x9b, 0x33, 0x36, 0x63, 0x08,
+	0xd8, 0xd9, 0x2f, 0xc9, 0xb6, 0x8d, 0xd2, 0x7e, 0x99, 0x4e, 0x9e, 0x97, 0xdf, 0x37, 0xa5, 0x49,
+	0xe8, 0x47, 0x33, 0xc5, 0x5c, 0x94, 0x32, 0x31, 0x4d, 0x68, 0xa8, 0x2c, 0x75, 0xfe, 0xe8, 0xd0,
+	0x78, 0x21, 0x4d, 0xbe, 0x15, 0x54, 0x78, 0xe4, 0x09, 0x54, 0x25, 0xd0, 0xd2, 0x4c, 0xad, 0x5b,
+	0xef, 0xdd, 0xb3, 0xca, 0x4c, 0x5b, 0x43, 0x64, 0xfa, 0xfa, 0xf2, 0xe7, 0x79, 0x65, 0xa4, 0x14,
+	0xc4, 0x84, 0x46, 0x40, 0xb9, 0x70, 0x62, 0xc6, 0x02, 0xc7, 0x9f, 0xb4, 0xfe, 0x33, 0xb5, 0xae,
+	0x3e, 0x82, 0xac, 0x36, 0x64, 0x2c, 0x18, 0x4c, 0x48, 0x17, 0x6e, 0x29, 0x82, 0xfb, 0xc2, 0x67,
+	0x51, 0x46, 0x1d, 0x20, 0x75, 0x2a, 0x29, 0x59, 0x1e, 0x4c, 0xc8, 0x00, 0x1a, 0xd8, 0x26, 0xf1,
+	0x5c, 0x96, 0x4c, 0x78, 0x4b, 0x37, 0x0f, 0xba, 0xf5, 0x9e, 0xb9, 0xc7, 0x0d, 0x63, 0xc1, 0x08,
+	0x41, 0xe5, 0xa8, 0x1e, 0x6f, 0x2a, 0x9c, 0xf4, 0xa1, 0x96, 0xdf, 0xc7, 0x5b, 0x87, 0xd8, 0xc7,
+	0xd8, 0xd7, 0x47, 0x62, 0xaa, 0xcb, 0x56, 0x46, 0xde, 0xc3, 0x99, 0xf0, 0xdd, 0xb9, 0xe3, 0x47,
+	0x53, 0xb6, 0xf1, 0x54, 0xc5, 0x5e, 0xf7, 0xcb, 0x7b, 0xbd, 0xf3, 0xdd, 0xf9, 0x20, 0x9a, 0xb2,
+	0x82, 0xaf, 0xff, 0x45, 0xa1, 0xca, 0x89, 0x0d, 0x4d, 0x1c, 0x88, 0x5a, 0x9c, 0x13, 0x07, 0x14,
+	0x87, 0x72, 0x84, 0x43, 0x39, 0xcb, 0xce, 0x9e, 0xcb, 0xa3, 0x61, 0x40, 0xb3, 0xb9, 0x3c, 0x86,
+	0xbb, 0x51, 0x1a, 0x3a, 0x71, 0xe2, 0x2f, 0xa8, 0xf0, 0x0a, 0x3a, 0xde, 0x3a, 0x36, 0xb5, 0xee,
+	0xc9, 0xe8, 0x76, 0x94, 0x86, 0x43, 0x79, 0xbe, 0xa3, 0xe5, 0xe4, 0x15, 0x9c, 0x14, 0xf1, 0x1a,
+	0xfa, 0xbf, 0x28, 0xf7, 0xbf, 0x23, 0x55, 0xe6, 0x1b, 0xd3, 0xdd, 0x6e, 0x7d, 0x30, 0xd0, 0x39,
+	0x4d, 0x05, 0x73, 0x5c, 0x16, 0xc6, 0x2c, 0x8d, 0x26, 0x85, 0xc5, 0x02, 0xfe, 0x87, 0x76, 0x46,
+	0x5d, 0xa7, 0x82, 0x3d, 0x53, 0xcc, 0x76, 0xc9, 0x9d, 0xef, 0x1a, 0xc0, 0x76, 0x77, 0xe4, 0x21,
+	0xe8, 0xd9, 0xde, 0x54, 0xf2, 0xda, 0xfb, 0x77, 0xad, 0x0c, 0x21, 0x4d, 0x9e, 0xc2, 0x21, 0xcf,
+	0xa2, 0x8b, 0x71, 0xab, 0xf7, 0xce, 0xf7, 0xcb, 0x30, 0xe1, 0x4a, 0x2b, 0x35, 0xe4, 0x25, 0x34,
+	0xd8, 0x98, 0x7b, 0xc9, 0x82, 0xca, 0x78, 0x1c, 0xfc, 0x6b, 0x24, 0xaf, 0xb7, 0x64, 0x3e, 0x92,
+	0x5d, 0x71, 0xe7, 0xab, 0x06, 0xa7, 0xc5, 0xb5, 0x93, 0x3b, 0x70, 0x94, 0xbf, 0x06, 0x0d, 0xc7,
+	0x51, 0x8d, 0xe5, 0x4b, 0x20, 0xa0, 0x67, 0x59, 0x40, 0xd3, 0x87, 0x23, 0xfc, 0x4d, 0xae, 0xa1,
+	0xb6, 0x09, 0x19, 0x3e, 0x8b, 0xbd, 0x41, 0xcd, 0x6f, 0x51, 0x36, 0x8e, 0xf3, 0x58, 0xf5, 0xdf,
+	0x2c, 0x7f, 0x1b, 0x95, 0xe5, 0xca, 0xd0, 0x6e, 0x56, 0x86, 0xf6, 0x6b, 0x65, 0x68, 0xdf, 0xd6,
+	0x46, 0xe5, 0x66, 0x6d, 0x54, 0x7e, 0xac, 0x8d, 0xca, 0x87, 0xab, 0x99, 0x2f, 0x3e, 0xa6, 0x63,
+	0xcb, 0x65, 0xa1, 0x9d, 0xf7, 0x7d, 0x10, 0x79, 0xe2, 0x13, 0x4b, 0xe6, 0x9b, 0x82, 0xbd, 0x78,
+	0x64, 0x7f, 0xc6, 0x2f, 0x86, 0xf8, 0x12, 0x7b, 0x7c, 0x5c, 0xc5, 0x2f, 0xc5, 0xd5, 0xdf, 0x01,
+	0x00, 0x88, 0x31, 0x88, 0xb7, 0xe2, 0x04, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -213,6 +219,11 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.LastAutoCompoundPositionId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.LastAutoCompoundPositionId))
+		i--
+		dAtA[i] = 0x50
+	}
 	if len(m.FarmingPlans) > 0 {
 		for iNdEx := len(m.FarmingPlans) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -322,6 +333,20 @@ func (m *PoolRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Observations) > 0 {
+		for iNdEx := len(m.Observations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Observations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	{
 		size, err := m.State.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -443,6 +468,9 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if m.LastAutoCompoundPositionId != 0 {
+		n += 1 + sovGenesis(uint64(m.LastAutoCompoundPositionId))
+	}
 	return n
 }
 
@@ -456,6 +484,12 @@ func (m *PoolRecord) Size() (n int) {
 	n += 1 + l + sovGenesis(uint64(l))
 	l = m.State.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.Observations) > 0 {
+		for _, e := range m.Observations {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -756,6 +790,25 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastAutoCompoundPositionId", wireType)
+			}
+			m.LastAutoCompoundPositionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastAutoCompoundPositionId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -872,6 +925,40 @@ func (m *PoolRecord) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Observations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Observations = append(m.Observations, Observation{})
+			if err := m.Observations[len(m.Observations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -39,6 +39,14 @@ func TestGenesisState_Validate(t *testing.T) {
 			},
 			"invalid tick info record: pool id must not be 0",
 		},
+		{
+			"invalid last auto-compound position id",
+			func(genState *types.GenesisState) {
+				genState.LastPositionId = 1
+				genState.LastAutoCompoundPositionId = 2
+			},
+			"last auto-compound position id must not be greater than the last position id: 2 > 1",
+		},
 	} {
 		t.Run(tc.name, func(t *testing.T) {
 			genState := types.DefaultGenesis()
@@ -24,19 +24,22 @@ const (
 )
 
 var (
-	LastPoolIdKey                      = []byte{0x40}
-	LastPositionIdKey                  = []byte{0x41}
-	PoolKeyPrefix                      = []byte{0x42} // poolId => Pool
-	PoolStateKeyPrefix                 = []byte{0x43} // poolId => PoolState
-	PoolByReserveAddressIndexKeyPrefix = []byte{0x44} // reserveAddress => poolId
-	PoolByMarketIndexKeyPrefix         = []byte{0x45} // marketId => poolId
-	PositionKeyPrefix                  = []byte{0x46} // positionId => Position
-	PositionByParamsIndexKeyPrefix     = []byte{0x47} // poolId + owner + lowerTick + upperTick => positionId
-	PositionsByPoolIndexKeyPrefix      = []byte{0x48} // poolId + positionId => nil
-	TickInfoKeyPrefix                  = []byte{0x49} // poolId + tick => TickInfo
-	LastFarmingPlanIdKey               = []byte{0x4a}
-	FarmingPlanKeyPrefix               = []byte{0x4b} // planId => FarmingPlan
-	NumPrivateFarmingPlansKey          = []byte{0x4c}
+	LastPoolIdKey                       = []byte{0x40}
+	LastPositionIdKey                   = []byte{0x41}
+	PoolKeyPrefix                       = []byte{0x42} // poolId => Pool
+	PoolStateKeyPrefix                  = []byte{0x43} // poolId => PoolState
+	PoolByReserveAddressIndexKeyPrefix  = []byte{0x44} // reserveAddress => poolId
+	PoolsByMarketIndexKeyPrefix         = []byte{0x45} // marketId + poolId => nil
+	PositionKeyPrefix                   = []byte{0x46} // positionId => Position
+	PositionByParamsIndexKeyPrefix      = []byte{0x47} // poolId + owner + lowerTick + upperTick => positionId
+	PositionsByPoolIndexKeyPrefix       = []byte{0x48} // poolId + positionId => nil
+	TickInfoKeyPrefix                   = []byte{0x49} // poolId + tick => TickInfo
+	LastFarmingPlanIdKey                = []byte{0x4a}
+	FarmingPlanKeyPrefix                = []byte{0x4b} // planId => FarmingPlan
+	NumPrivateFarmingPlansKey           = []byte{0x4c}
+	AutoCompoundPositionsIndexKeyPrefix = []byte{0x4d} // positionId => nil
+	LastAutoCompoundPositionIdKey       = []byte{0x4e}
+	ObservationKeyPrefix                = []byte{0x4f} // poolId + index => Observation
 )
 
 func GetPoolKey(poolId uint64) []byte {
@@ -51,8 +54,15 @@ func GetPoolByReserveAddressIndexKey(reserveAddr sdk.AccAddress) []byte {
 	return utils.Key(PoolByReserveAddressIndexKeyPrefix, reserveAddr)
 }
 
-func GetPoolByMarketIndexKey(marketId uint64) []byte {
-	return utils.Key(PoolByMarketIndexKeyPrefix, sdk.Uint64ToBigEndian(marketId))
+func GetPoolsByMarketIndexKey(marketId, poolId uint64) []byte {
+	return utils.Key(
+		PoolsByMarketIndexKeyPrefix,
+		sdk.Uint64ToBigEndian(marketId),
+		sdk.Uint64ToBigEndian(poolId))
+}
+
+func GetPoolsByMarketIteratorPrefix(marketId uint64) []byte {
+	return utils.Key(PoolsByMarketIndexKeyPrefix, sdk.Uint64ToBigEndian(marketId))
 }
 
 func GetPositionKey(positionId uint64) []byte {
@@ -107,12 +117,37 @@ func GetFarmingPlanKey(planId uint64) []byte {
 	return utils.Key(FarmingPlanKeyPrefix, sdk.Uint64ToBigEndian(planId))
 }
 
+func GetAutoCompoundPositionsIndexKey(positionId uint64) []byte {
+	return utils.Key(AutoCompoundPositionsIndexKeyPrefix, sdk.Uint64ToBigEndian(positionId))
+}
+
+func GetObservationKey(poolId uint64, index uint32) []byte {
+	return utils.Key(
+		ObservationKeyPrefix,
+		sdk.Uint64ToBigEndian(poolId),
+		utils.Uint32ToBigEndian(index))
+}
+
+func GetObservationsByPoolIteratorPrefix(poolId uint64) []byte {
+	return utils.Key(ObservationKeyPrefix, sdk.Uint64ToBigEndian(poolId))
+}
+
+func ParsePoolsByMarketIndexKey(key []byte) (marketId, poolId uint64) {
+	marketId = sdk.BigEndianToUint64(key[1:9])
+	poolId = sdk.BigEndianToUint64(key[9:17])
+	return
+}
+
 func ParsePositionsByPoolIndexKey(key []byte) (poolId, positionId uint64) {
 	poolId = sdk.BigEndianToUint64(key[1:9])
 	positionId = sdk.BigEndianToUint64(key[9:17])
 	return
 }
 
+func ParseAutoCompoundPositionsIndexKey(key []byte) (positionId uint64) {
+	return sdk.BigEndianToUint64(key[1:9])
+}
+
 func ParseTickInfoKey(key []byte) (poolId uint64, tick int32) {
 	poolId = sdk.BigEndianToUint64(key[1:9])
 	tick = BytesToTick(key[9:])
@@ -26,8 +26,15 @@ func TestPoolByReserveAddressIndexKey(t *testing.T) {
 	}, types.GetPoolByReserveAddressIndexKey(reserveAddr))
 }
 
-func TestPoolByMarketIndexKey(t *testing.T) {
-	require.Equal(t, []byte{0x45, 0x0, 0x0, 0x0, 0x0, 0x0, 0xf, 0x42, 0x40}, types.GetPoolByMarketIndexKey(1000000))
+func TestPoolsByMarketIndexKey(t *testing.T) {
+	key := types.GetPoolsByMarketIndexKey(1000000, 2000000)
+	require.Equal(t, []byte{
+		0x45, 0x0, 0x0, 0x0, 0x0, 0x0, 0xf, 0x42, 0x40, 0x0, 0x0, 0x0, 0x0, 0x0, 0x1e, 0x84, 0x80,
+	}, key)
+	require.Equal(t, []byte{0x45, 0x0, 0x0, 0x0, 0x0, 0x0, 0xf, 0x42, 0x40}, types.GetPoolsByMarketIteratorPrefix(1000000))
+	marketId, poolId := types.ParsePoolsByMarketIndexKey(key)
+	require.Equal(t, uint64(1000000), marketId)
+	require.Equal(t, uint64(2000000), poolId)
 }
 
 func TestPositionKey(t *testing.T) {
@@ -13,7 +13,12 @@ var (
 	_ sdk.Msg = (*MsgCreatePool)(nil)
 	_ sdk.Msg = (*MsgAddLiquidity)(nil)
 	_ sdk.Msg = (*MsgRemoveLiquidity)(nil)
+	_ sdk.Msg = (*MsgIncreaseLiquidity)(nil)
+	_ sdk.Msg = (*MsgDecreaseLiquidity)(nil)
 	_ sdk.Msg = (*MsgCollect)(nil)
+	_ sdk.Msg = (*MsgTransferPosition)(nil)
+	_ sdk.Msg = (*MsgCompound)(nil)
+	_ sdk.Msg = (*MsgSetAutoCompound)(nil)
 	_ sdk.Msg = (*MsgCreatePrivateFarmingPlan)(nil)
 	_ sdk.Msg = (*MsgTerminatePrivateFarmingPlan)(nil)
 )
@@ -23,17 +28,23 @@ const (
 	TypeMsgCreatePool                  = "create_pool"
 	TypeMsgAddLiquidity                = "add_liquidity"
 	TypeMsgRemoveLiquidity             = "remove_liquidity"
+	TypeMsgIncreaseLiquidity           = "increase_liquidity"
+	TypeMsgDecreaseLiquidity           = "decrease_liquidity"
 	TypeMsgCollect                     = "collect"
+	TypeMsgTransferPosition            = "transfer_position"
+	TypeMsgCompound                    = "compound"
+	TypeMsgSetAutoCompound             = "set_auto_compound"
 	TypeMsgCreatePrivateFarmingPlan    = "create_private_farming_plan"
 	TypeMsgTerminatePrivateFarmingPlan = "terminate_private_farming_plan"
 )
 
 func NewMsgCreatePool(
-	senderAddr sdk.AccAddress, marketId uint64, price sdk.Dec) *MsgCreatePool {
+	senderAddr sdk.AccAddress, marketId uint64, price sdk.Dec, tickSpacing uint32) *MsgCreatePool {
 	return &MsgCreatePool{
-		Sender:   senderAddr.String(),
-		MarketId: marketId,
-		Price:    price,
+		Sender:      senderAddr.String(),
+		MarketId:    marketId,
+		Price:       price,
+		TickSpacing: tickSpacing,
 	}
 }
 
@@ -68,6 +79,9 @@ func (msg MsgCreatePool) ValidateBasic() error {
 	if msg.Price.GT(exchangetypes.MaxPrice) {
 		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "price is higher than the max price %s", exchangetypes.MaxPrice)
 	}
+	if msg.TickSpacing != 0 && !IsAllowedTickSpacing(msg.TickSpacing) {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "tick spacing must be one of %v: %d", AllowedTickSpacings, msg.TickSpacing)
+	}
 	return nil
 }
 
@@ -174,6 +188,97 @@ func (msg MsgRemoveLiquidity) ValidateBasic() error {
 	return nil
 }
 
+func NewMsgIncreaseLiquidity(
+	senderAddr sdk.AccAddress, positionId uint64, desiredAmt, minAmt sdk.Coins, deadline *time.Time) *MsgIncreaseLiquidity {
+	return &MsgIncreaseLiquidity{
+		Sender:        senderAddr.String(),
+		PositionId:    positionId,
+		DesiredAmount: desiredAmt,
+		MinAmount:     minAmt,
+		Deadline:      deadline,
+	}
+}
+
+func (msg MsgIncreaseLiquidity) Route() string { return RouterKey }
+func (msg MsgIncreaseLiquidity) Type() string  { return TypeMsgIncreaseLiquidity }
+
+func (msg MsgIncreaseLiquidity) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgIncreaseLiquidity) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
+func (msg MsgIncreaseLiquidity) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address: %v", err)
+	}
+	if msg.PositionId == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "position id must not be 0")
+	}
+	if err := msg.DesiredAmount.Validate(); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "invalid desired amount: %v", err)
+	}
+	if msg.DesiredAmount.IsZero() || len(msg.DesiredAmount) > 2 {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid desired amount length: %d", len(msg.DesiredAmount))
+	}
+	if err := msg.MinAmount.Validate(); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "invalid min amount: %v", err)
+	}
+	if !msg.DesiredAmount.IsAllGTE(msg.MinAmount) {
+		return sdkerrors.Wrapf(
+			sdkerrors.ErrInvalidRequest, "min amount %s must not exceed desired amount %s", msg.MinAmount, msg.DesiredAmount)
+	}
+	return nil
+}
+
+func NewMsgDecreaseLiquidity(
+	senderAddr sdk.AccAddress, positionId uint64, liquidity sdk.Int, minAmt sdk.Coins, deadline *time.Time) *MsgDecreaseLiquidity {
+	return &MsgDecreaseLiquidity{
+		Sender:     senderAddr.String(),
+		PositionId: positionId,
+		Liquidity:  liquidity,
+		MinAmount:  minAmt,
+		Deadline:   deadline,
+	}
+}
+
+func (msg MsgDecreaseLiquidity) Route() string { return RouterKey }
+func (msg MsgDecreaseLiquidity) Type() string  { return TypeMsgDecreaseLiquidity }
+
+func (msg MsgDecreaseLiquidity) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgDecreaseLiquidity) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
+func (msg MsgDecreaseLiquidity) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address: %v", err)
+	}
+	if msg.PositionId == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "position id must not be 0")
+	}
+	if !msg.Liquidity.IsPositive() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "liquidity must be positive: %s", msg.Liquidity)
+	}
+	if err := msg.MinAmount.Validate(); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "invalid min amount: %v", err)
+	}
+	return nil
+}
+
 func NewMsgCollect(senderAddr sdk.AccAddress, positionId uint64, amt sdk.Coins) *MsgCollect {
 	return &MsgCollect{
 		Sender:     senderAddr.String(),
@@ -213,6 +318,117 @@ func (msg MsgCollect) ValidateBasic() error {
 	return nil
 }
 
+func NewMsgTransferPosition(senderAddr sdk.AccAddress, positionId uint64, recipientAddr sdk.AccAddress) *MsgTransferPosition {
+	return &MsgTransferPosition{
+		Sender:     senderAddr.String(),
+		PositionId: positionId,
+		Recipient:  recipientAddr.String(),
+	}
+}
+
+func (msg MsgTransferPosition) Route() string { return RouterKey }
+func (msg MsgTransferPosition) Type() string  { return TypeMsgTransferPosition }
+
+func (msg MsgTransferPosition) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgTransferPosition) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
+func (msg MsgTransferPosition) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address: %v", err)
+	}
+	if msg.PositionId == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "position id must not be 0")
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Recipient); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid recipient address: %v", err)
+	}
+	if msg.Sender == msg.Recipient {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "sender and recipient must not be the same")
+	}
+	return nil
+}
+
+func NewMsgCompound(senderAddr sdk.AccAddress, positionId uint64, maxSlippage sdk.Dec) *MsgCompound {
+	return &MsgCompound{
+		Sender:      senderAddr.String(),
+		PositionId:  positionId,
+		MaxSlippage: maxSlippage,
+	}
+}
+
+func (msg MsgCompound) Route() string { return RouterKey }
+func (msg MsgCompound) Type() string  { return TypeMsgCompound }
+
+func (msg MsgCompound) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgCompound) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
+func (msg MsgCompound) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address: %v", err)
+	}
+	if msg.PositionId == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "position id must not be 0")
+	}
+	if msg.MaxSlippage.IsNil() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "max slippage must not be nil")
+	}
+	if err := ValidateMaxSlippage(msg.MaxSlippage); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	return nil
+}
+
+func NewMsgSetAutoCompound(senderAddr sdk.AccAddress, positionId uint64, enabled bool) *MsgSetAutoCompound {
+	return &MsgSetAutoCompound{
+		Sender:     senderAddr.String(),
+		PositionId: positionId,
+		Enabled:    enabled,
+	}
+}
+
+func (msg MsgSetAutoCompound) Route() string { return RouterKey }
+func (msg MsgSetAutoCompound) Type() string  { return TypeMsgSetAutoCompound }
+
+func (msg MsgSetAutoCompound) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgSetAutoCompound) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
+func (msg MsgSetAutoCompound) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address: %v", err)
+	}
+	if msg.PositionId == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "position id must not be 0")
+	}
+	return nil
+}
+
 func NewMsgCreatePrivateFarmingPlan(
 	senderAddr sdk.AccAddress, description string, termAddr sdk.AccAddress, rewardAllocs []FarmingRewardAllocation,
 	startTime, endTime time.Time) *MsgCreatePrivateFarmingPlan {
@@ -64,10 +64,24 @@ func TestMsgCreatePool_ValidateBasic(t *testing.T) {
 			},
 			"price is higher than the max price 10000000000000000000000000000000000000000.000000000000000000: invalid request",
 		},
+		{
+			"valid tick spacing",
+			func(msg *types.MsgCreatePool) {
+				msg.TickSpacing = 10
+			},
+			"",
+		},
+		{
+			"invalid tick spacing",
+			func(msg *types.MsgCreatePool) {
+				msg.TickSpacing = 3
+			},
+			"tick spacing must be one of [1 5 10 50]: 3: invalid request",
+		},
 	} {
 		t.Run(tc.name, func(t *testing.T) {
 			senderAddr := utils.TestAddress(1)
-			msg := types.NewMsgCreatePool(senderAddr, 1, utils.ParseDec("12.3456789"))
+			msg := types.NewMsgCreatePool(senderAddr, 1, utils.ParseDec("12.3456789"), 0)
 			require.NoError(t, msg.ValidateBasic())
 			require.Equal(t, types.RouterKey, msg.Route())
 			require.Equal(t, []sdk.AccAddress{senderAddr}, msg.GetSigners())
@@ -258,6 +272,150 @@ func TestMsgRemoveLiquidity_ValidateBasic(t *testing.T) {
 	}
 }
 
+func TestMsgIncreaseLiquidity_ValidateBasic(t *testing.T) {
+	for _, tc := range []struct {
+		name        string
+		malleate    func(msg *types.MsgIncreaseLiquidity)
+		expectedErr string
+	}{
+		{
+			"valid",
+			func(msg *types.MsgIncreaseLiquidity) {},
+			"",
+		},
+		{
+			"invalid sender",
+			func(msg *types.MsgIncreaseLiquidity) {
+				msg.Sender = "invalidaddr"
+			},
+			"invalid sender address: decoding bech32 failed: invalid separator index -1: invalid address",
+		},
+		{
+			"invalid position id",
+			func(msg *types.MsgIncreaseLiquidity) {
+				msg.PositionId = 0
+			},
+			"position id must not be 0: invalid request",
+		},
+		{
+			"empty desired amount",
+			func(msg *types.MsgIncreaseLiquidity) {
+				msg.DesiredAmount = sdk.Coins{}
+				msg.MinAmount = nil
+			},
+			"invalid desired amount length: 0: invalid request",
+		},
+		{
+			"too many coins in desired amount",
+			func(msg *types.MsgIncreaseLiquidity) {
+				msg.DesiredAmount = utils.ParseCoins("100_000000ucre,500_000000uusd,1000_000000uatom")
+			},
+			"invalid desired amount length: 3: invalid request",
+		},
+		{
+			"invalid min amount",
+			func(msg *types.MsgIncreaseLiquidity) {
+				msg.MinAmount = sdk.Coins{sdk.NewInt64Coin("ucre", 0)}
+			},
+			"invalid min amount: coin 0ucre amount is not positive: invalid coins",
+		},
+		{
+			"min amount exceeding desired amount",
+			func(msg *types.MsgIncreaseLiquidity) {
+				msg.MinAmount = utils.ParseCoins("200_000000ucre")
+			},
+			"min amount 200000000ucre must not exceed desired amount 100000000ucre,500000000uusd: invalid request",
+		},
+		{
+			"no min amount",
+			func(msg *types.MsgIncreaseLiquidity) {
+				msg.MinAmount = nil
+				msg.Deadline = nil
+			},
+			"",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			senderAddr := utils.TestAddress(1)
+			deadline := utils.ParseTime("2023-01-01T00:00:00Z")
+			msg := types.NewMsgIncreaseLiquidity(
+				senderAddr, 1, utils.ParseCoins("100_000000ucre,500_000000uusd"),
+				utils.ParseCoins("99_000000ucre,495_000000uusd"), &deadline)
+			require.NoError(t, msg.ValidateBasic())
+			require.Equal(t, types.RouterKey, msg.Route())
+			require.Equal(t, []sdk.AccAddress{senderAddr}, msg.GetSigners())
+			require.Equal(t, types.TypeMsgIncreaseLiquidity, msg.Type())
+			tc.malleate(msg)
+			err := msg.ValidateBasic()
+			if tc.expectedErr == "" {
+				require.NoError(t, err)
+			} else {
+				require.EqualError(t, err, tc.expectedErr)
+			}
+		})
+	}
+}
+
+func TestMsgDecreaseLiquidity_ValidateBasic(t *testing.T) {
+	for _, tc := range []struct {
+		name        string
+		malleate    func(msg *types.MsgDecreaseLiquidity)
+		expectedErr string
+	}{
+		{
+			"valid",
+			func(msg *types.MsgDecreaseLiquidity) {},
+			"",
+		},
+		{
+			"invalid sender",
+			func(msg *types.MsgDecreaseLiquidity) {
+				msg.Sender = "invalidaddr"
+			},
+			"invalid sender address: decoding bech32 failed: invalid separator index -1: invalid address",
+		},
+		{
+			"invalid position id",
+			func(msg *types.MsgDecreaseLiquidity) {
+				msg.PositionId = 0
+			},
+			"position id must not be 0: invalid request",
+		},
+		{
+			"zero liquidity",
+			func(msg *types.MsgDecreaseLiquidity) {
+				msg.Liquidity = sdk.ZeroInt()
+			},
+			"liquidity must be positive: 0: invalid request",
+		},
+		{
+			"invalid min amount",
+			func(msg *types.MsgDecreaseLiquidity) {
+				msg.MinAmount = sdk.Coins{sdk.NewInt64Coin("ucre", 0)}
+			},
+			"invalid min amount: coin 0ucre amount is not positive: invalid coins",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			senderAddr := utils.TestAddress(1)
+			deadline := utils.ParseTime("2023-01-01T00:00:00Z")
+			msg := types.NewMsgDecreaseLiquidity(
+				senderAddr, 1, sdk.NewInt(10000), utils.ParseCoins("1000ucre"), &deadline)
+			require.NoError(t, msg.ValidateBasic())
+			require.Equal(t, types.RouterKey, msg.Route())
+			require.Equal(t, []sdk.AccAddress{senderAddr}, msg.GetSigners())
+			require.Equal(t, types.TypeMsgDecreaseLiquidity, msg.Type())
+			tc.malleate(msg)
+			err := msg.ValidateBasic()
+			if tc.expectedErr == "" {
+				require.NoError(t, err)
+			} else {
+				require.EqualError(t, err, tc.expectedErr)
+			}
+		})
+	}
+}
+
 func TestMsgCollect_ValidateBasic(t *testing.T) {
 	for _, tc := range []struct {
 		name        string
@@ -316,6 +474,180 @@ func TestMsgCollect_ValidateBasic(t *testing.T) {
 	}
 }
 
+func TestMsgTransferPosition_ValidateBasic(t *testing.T) {
+	for _, tc := range []struct {
+		name        string
+		malleate    func(msg *types.MsgTransferPosition)
+		expectedErr string
+	}{
+		{
+			"valid",
+			func(msg *types.MsgTransferPosition) {},
+			"",
+		},
+		{
+			"invalid sender",
+			func(msg *types.MsgTransferPosition) {
+				msg.Sender = "invalidaddr"
+			},
+			"invalid sender address: decoding bech32 failed: invalid separator index -1: invalid address",
+		},
+		{
+			"invalid position id",
+			func(msg *types.MsgTransferPosition) {
+				msg.PositionId = 0
+			},
+			"position id must not be 0: invalid request",
+		},
+		{
+			"invalid recipient",
+			func(msg *types.MsgTransferPosition) {
+				msg.Recipient = "invalidaddr"
+			},
+			"invalid recipient address: decoding bech32 failed: invalid separator index -1: invalid address",
+		},
+		{
+			"same sender and recipient",
+			func(msg *types.MsgTransferPosition) {
+				msg.Recipient = msg.Sender
+			},
+			"sender and recipient must not be the same: invalid request",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			senderAddr := utils.TestAddress(1)
+			msg := types.NewMsgTransferPosition(senderAddr, 1, utils.TestAddress(2))
+			require.NoError(t, msg.ValidateBasic())
+			require.Equal(t, types.RouterKey, msg.Route())
+			require.Equal(t, []sdk.AccAddress{senderAddr}, msg.GetSigners())
+			require.Equal(t, types.TypeMsgTransferPosition, msg.Type())
+			tc.malleate(msg)
+			err := msg.ValidateBasic()
+			if tc.expectedErr == "" {
+				require.NoError(t, err)
+			} else {
+				require.EqualError(t, err, tc.expectedErr)
+			}
+		})
+	}
+}
+
+func TestMsgCompound_ValidateBasic(t *testing.T) {
+	for _, tc := range []struct {
+		name        string
+		malleate    func(msg *types.MsgCompound)
+		expectedErr string
+	}{
+		{
+			"valid",
+			func(msg *types.MsgCompound) {},
+			"",
+		},
+		{
+			"invalid sender",
+			func(msg *types.MsgCompound) {
+				msg.Sender = "invalidaddr"
+			},
+			"invalid sender address: decoding bech32 failed: invalid separator index -1: invalid address",
+		},
+		{
+			"invalid position id",
+			func(msg *types.MsgCompound) {
+				msg.PositionId = 0
+			},
+			"position id must not be 0: invalid request",
+		},
+		{
+			"zero max slippage",
+			func(msg *types.MsgCompound) {
+				msg.MaxSlippage = utils.ZeroDec
+			},
+			"",
+		},
+		{
+			"negative max slippage",
+			func(msg *types.MsgCompound) {
+				msg.MaxSlippage = utils.ParseDec("-0.01")
+			},
+			"max slippage must be in range [0, 1): -0.010000000000000000: invalid request",
+		},
+		{
+			"too big max slippage",
+			func(msg *types.MsgCompound) {
+				msg.MaxSlippage = utils.OneDec
+			},
+			"max slippage must be in range [0, 1): 1.000000000000000000: invalid request",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			senderAddr := utils.TestAddress(1)
+			msg := types.NewMsgCompound(senderAddr, 1, utils.ParseDec("0.01"))
+			require.NoError(t, msg.ValidateBasic())
+			require.Equal(t, types.RouterKey, msg.Route())
+			require.Equal(t, []sdk.AccAddress{senderAddr}, msg.GetSigners())
+			require.Equal(t, types.TypeMsgCompound, msg.Type())
+			tc.malleate(msg)
+			err := msg.ValidateBasic()
+			if tc.expectedErr == "" {
+				require.NoError(t, err)
+			} else {
+				require.EqualError(t, err, tc.expectedErr)
+			}
+		})
+	}
+}
+
+func TestMsgSetAutoCompound_ValidateBasic(t *testing.T) {
+	for _, tc := range []struct {
+		name        string
+		malleate    func(msg *types.MsgSetAutoCompound)
+		expectedErr string
+	}{
+		{
+			"valid",
+			func(msg *types.MsgSetAutoCompound) {},
+			"",
+		},
+		{
+			"disable",
+			func(msg *types.MsgSetAutoCompound) {
+				msg.Enabled = false
+			},
+			"",
+		},
+		{
+			"invalid sender",
+			func(msg *types.MsgSetAutoCompound) {
+				msg.Sender = "invalidaddr"
+			},
+			"invalid sender address: decoding bech32 failed: invalid separator index -1: invalid address",
+		},
+		{
+			"invalid position id",
+			func(msg *types.MsgSetAutoCompound) {
+				msg.PositionId = 0
+			},
+			"position id must not be 0: invalid request",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			senderAddr := utils.TestAddress(1)
+			msg := types.NewMsgSetAutoCompound(senderAddr, 1, true)
+			require.NoError(t, msg.ValidateBasic())
+			require.Equal(t, types.RouterKey, msg.Route())
+			require.Equal(t, []sdk.AccAddress{senderAddr}, msg.GetSigners())
+			require.Equal(t, types.TypeMsgSetAutoCompound, msg.Type())
+			tc.malleate(msg)
+			err := msg.ValidateBasic()
+			if tc.expectedErr == "" {
+				require.NoError(t, err)
+			} else {
+				require.EqualError(t, err, tc.expectedErr)
+			}
+		})
+	}
+}
+
 func TestMsgCreatePrivateFarmingPlan_ValidateBasic(t *testing.T) {
 	for _, tc := range []struct {
 		name        string
@@ -0,0 +1,62 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	utils "github.com/crescent-network/crescent/v5/types"
+)
+
+// MaxObservationCardinality is the maximum number of observations a pool can
+// store.
+const MaxObservationCardinality = 65535
+
+func NewObservation(blockTime time.Time) Observation {
+	return Observation{
+		BlockTime:                     blockTime,
+		TickCumulative:                0,
+		SecondsPerLiquidityCumulative: utils.ZeroDec,
+	}
+}
+
+// Transform returns a new observation at blockTime, accumulating the seconds
+// elapsed since the observation with the given tick and liquidity.
+func (observation Observation) Transform(blockTime time.Time, tick int32, liquidity sdk.Int) Observation {
+	delta := blockTime.Unix() - observation.BlockTime.Unix()
+	if !liquidity.IsPositive() {
+		liquidity = sdk.OneInt()
+	}
+	return Observation{
+		BlockTime:      blockTime,
+		TickCumulative: observation.TickCumulative + int64(tick)*delta,
+		SecondsPerLiquidityCumulative: observation.SecondsPerLiquidityCumulative.Add(
+			sdk.NewDec(delta).MulTruncate(DecMulFactor).QuoTruncate(liquidity.ToDec())),
+	}
+}
+
+func (observation Observation) Validate() error {
+	if observation.BlockTime.IsZero() {
+		return fmt.Errorf("block time must be set")
+	}
+	if observation.SecondsPerLiquidityCumulative.IsNegative() {
+		return fmt.Errorf(
+			"seconds per liquidity cumulative must not be negative: %s", observation.SecondsPerLiquidityCumulative)
+	}
+	return nil
+}
+
+// MeanTick returns the time-weighted mean tick between two tick cumulatives
+// observed secondsAgo0 and secondsAgo1 seconds ago, where secondsAgo0 is
+// greater than secondsAgo1.
+// The mean tick is rounded down.
+func MeanTick(tickCumulative0, tickCumulative1 int64, secondsAgo0, secondsAgo1 uint32) int32 {
+	period := int64(secondsAgo0) - int64(secondsAgo1)
+	tickCumulativeDelta := tickCumulative1 - tickCumulative0
+	meanTick := tickCumulativeDelta / period
+	if tickCumulativeDelta < 0 && tickCumulativeDelta%period != 0 {
+		meanTick--
+	}
+	return int32(meanTick)
+}
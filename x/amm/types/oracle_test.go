@@ -0,0 +1,91 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	utils "github.com/crescent-network/crescent/v5/types"
+	"github.com/crescent-network/crescent/v5/x/amm/types"
+)
+
+func TestObservation_Transform(t *testing.T) {
+	blockTime := utils.ParseTime("2023-01-01T00:00:00Z")
+	observation := types.NewObservation(blockTime)
+
+	observation = observation.Transform(blockTime.Add(10*time.Second), 100, sdk.NewInt(1000))
+	require.Equal(t, blockTime.Add(10*time.Second), observation.BlockTime)
+	require.EqualValues(t, 1000, observation.TickCumulative)
+	require.Equal(t, "10000000000.000000000000000000", observation.SecondsPerLiquidityCumulative.String())
+
+	// Zero liquidity is treated as 1.
+	observation = observation.Transform(blockTime.Add(15*time.Second), -200, utils.ZeroInt)
+	require.EqualValues(t, 0, observation.TickCumulative)
+	require.Equal(t, "5010000000000.000000000000000000", observation.SecondsPerLiquidityCumulative.String())
+}
+
+func TestObservation_Validate(t *testing.T) {
+	for _, tc := range []struct {
+		name        string
+		malleate    func(observation *types.Observation)
+		expectedErr string
+	}{
+		{
+			"valid",
+			func(observation *types.Observation) {},
+			"",
+		},
+		{
+			"zero block time",
+			func(observation *types.Observation) {
+				observation.BlockTime = time.Time{}
+			},
+			"block time must be set",
+		},
+		{
+			"negative seconds per liquidity cumulative",
+			func(observation *types.Observation) {
+				observation.SecondsPerLiquidityCumulative = utils.ParseDec("-1")
+			},
+			"seconds per liquidity cumulative must not be negative: -1.000000000000000000",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			observation := types.Observation{
+				BlockTime:                     utils.ParseTime("2023-01-01T00:00:00Z"),
+				TickCumulative:                -1000,
+				SecondsPerLiquidityCumulative: utils.ParseDec("1000"),
+			}
+			tc.malleate(&observation)
+			err := observation.Validate()
+			if tc.expectedErr == "" {
+				require.NoError(t, err)
+			} else {
+				require.EqualError(t, err, tc.expectedErr)
+			}
+		})
+	}
+}
+
+func TestMeanTick(t *testing.T) {
+	for i, tc := range []struct {
+		tickCumulative0, tickCumulative1 int64
+		secondsAgo0, secondsAgo1         uint32
+		expected                         int32
+	}{
+		{0, 1000, 10, 0, 100},
+		{1000, 1999, 20, 10, 99},
+		{0, -1000, 10, 0, -100},
+		{0, -1001, 10, 0, -101}, // Rounded down.
+		{-500, -500, 5, 0, 0},
+	} {
+		t.Run("", func(t *testing.T) {
+			require.Equal(
+				t, tc.expected,
+				types.MeanTick(tc.tickCumulative0, tc.tickCumulative1, tc.secondsAgo0, tc.secondsAgo1), i)
+		})
+	}
+}
@@ -7,6 +7,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
 
+	utils "github.com/crescent-network/crescent/v5/types"
 	exchangetypes "github.com/crescent-network/crescent/v5/x/exchange/types"
 )
 
@@ -30,6 +31,8 @@ var (
 	KeyPrivateFarmingPlanCreationFee = []byte("PrivateFarmingPlanCreationFee")
 	KeyMaxNumPrivateFarmingPlans     = []byte("MaxNumPrivateFarmingPlans")
 	KeyMaxFarmingBlockTime           = []byte("MaxFarmingBlockTime")
+	KeyAutoCompoundMaxSlippage       = []byte("AutoCompoundMaxSlippage")
+	KeyMaxNumAutoCompoundsPerBlock   = []byte("MaxNumAutoCompoundsPerBlock")
 )
 
 var (
@@ -40,6 +43,8 @@ var (
 	DefaultPrivateFarmingPlanCreationFee = sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000000))
 	DefaultMaxNumPrivateFarmingPlans     = uint32(50)
 	DefaultMaxFarmingBlockTime           = 10 * time.Second
+	DefaultAutoCompoundMaxSlippage       = sdk.NewDecWithPrec(1, 2) // 1%
+	DefaultMaxNumAutoCompoundsPerBlock   = uint32(100)
 
 	AllowedTickSpacings = []uint32{1, 5, 10, 50}
 	// DecMulFactor is multiplied to fee and farming rewards growth variables
@@ -66,6 +71,15 @@ func ValidateTickSpacing(prevTickSpacing, tickSpacing uint32) error {
 	return nil
 }
 
+// ValidateMaxSlippage validates the max slippage used when compounding
+// positions.
+func ValidateMaxSlippage(maxSlippage sdk.Dec) error {
+	if maxSlippage.IsNegative() || maxSlippage.GTE(utils.OneDec) {
+		return fmt.Errorf("max slippage must be in range [0, 1): %s", maxSlippage)
+	}
+	return nil
+}
+
 func ParamKeyTable() paramstypes.KeyTable {
 	return paramstypes.NewKeyTable().RegisterParamSet(&Params{})
 }
@@ -80,6 +94,8 @@ func DefaultParams() Params {
 		PrivateFarmingPlanCreationFee: DefaultPrivateFarmingPlanCreationFee,
 		MaxNumPrivateFarmingPlans:     DefaultMaxNumPrivateFarmingPlans,
 		MaxFarmingBlockTime:           DefaultMaxFarmingBlockTime,
+		AutoCompoundMaxSlippage:       DefaultAutoCompoundMaxSlippage,
+		MaxNumAutoCompoundsPerBlock:   DefaultMaxNumAutoCompoundsPerBlock,
 	}
 }
 
@@ -93,6 +109,8 @@ func (params *Params) ParamSetPairs() paramstypes.ParamSetPairs {
 		paramstypes.NewParamSetPair(KeyPrivateFarmingPlanCreationFee, &params.PrivateFarmingPlanCreationFee, validatePrivateFarmingPlanCreationFee),
 		paramstypes.NewParamSetPair(KeyMaxNumPrivateFarmingPlans, &params.MaxNumPrivateFarmingPlans, validateMaxNumPrivateFarmingPlans),
 		paramstypes.NewParamSetPair(KeyMaxFarmingBlockTime, &params.MaxFarmingBlockTime, validateMaxFarmingBlockTime),
+		paramstypes.NewParamSetPair(KeyAutoCompoundMaxSlippage, &params.AutoCompoundMaxSlippage, validateAutoCompoundMaxSlippage),
+		paramstypes.NewParamSetPair(KeyMaxNumAutoCompoundsPerBlock, &params.MaxNumAutoCompoundsPerBlock, validateMaxNumAutoCompoundsPerBlock),
 	}
 }
 
@@ -109,6 +127,8 @@ func (params Params) Validate() error {
 		{params.PrivateFarmingPlanCreationFee, validatePrivateFarmingPlanCreationFee},
 		{params.MaxNumPrivateFarmingPlans, validateMaxNumPrivateFarmingPlans},
 		{params.MaxFarmingBlockTime, validateMaxFarmingBlockTime},
+		{params.AutoCompoundMaxSlippage, validateAutoCompoundMaxSlippage},
+		{params.MaxNumAutoCompoundsPerBlock, validateMaxNumAutoCompoundsPerBlock},
 	} {
 		if err := field.validateFunc(field.val); err != nil {
 			return err
@@ -190,3 +210,22 @@ func validateMaxFarmingBlockTime(i interface{}) error {
 	}
 	return nil
 }
+
+func validateAutoCompoundMaxSlippage(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if err := ValidateMaxSlippage(v); err != nil {
+		return fmt.Errorf("invalid auto compound max slippage: %w", err)
+	}
+	return nil
+}
+
+func validateMaxNumAutoCompoundsPerBlock(i interface{}) error {
+	_, ok := i.(uint32)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
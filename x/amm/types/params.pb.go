@@ -37,6 +37,8 @@ type Params struct {
 	PrivateFarmingPlanCreationFee github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,5,rep,name=private_farming_plan_creation_fee,json=privateFarmingPlanCreationFee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"private_farming_plan_creation_fee"`
 	MaxNumPrivateFarmingPlans     uint32                                   `protobuf:"varint,6,opt,name=max_num_private_farming_plans,json=maxNumPrivateFarmingPlans,proto3" json:"max_num_private_farming_plans,omitempty"`
 	MaxFarmingBlockTime           time.Duration                            `protobuf:"bytes,7,opt,name=max_farming_block_time,json=maxFarmingBlockTime,proto3,stdduration" json:"max_farming_block_time"`
+	AutoCompoundMaxSlippage       github_com_cosmos_cosmos_sdk_types.Dec   `protobuf:"bytes,8,opt,name=auto_compound_max_slippage,json=autoCompoundMaxSlippage,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"auto_compound_max_slippage"`
+	MaxNumAutoCompoundsPerBlock   uint32                                   `protobuf:"varint,9,opt,name=max_num_auto_compounds_per_block,json=maxNumAutoCompoundsPerBlock,proto3" json:"max_num_auto_compounds_per_block,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -79,39 +81,43 @@ func init() {
 func init() { proto.RegisterFile("crescent/amm/v1beta1/params.proto", fileDescriptor_6478a64964ea7eab) }
 
 var fileDescriptor_6478a64964ea7eab = []byte{
-	// 506 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x93, 0x4f, 0x6f, 0xd3, 0x30,
-	0x18, 0xc6, 0x1b, 0xc6, 0x06, 0x04, 0x21, 0x44, 0xa8, 0x58, 0x56, 0x69, 0x69, 0xc7, 0x01, 0xf5,
-	0xb2, 0x78, 0x63, 0xe2, 0x8e, 0xba, 0x69, 0x37, 0xa0, 0x2b, 0x3b, 0x20, 0x2e, 0x96, 0xe3, 0xbe,
-	0x0d, 0x56, 0x62, 0x3b, 0xd8, 0x4e, 0xd7, 0x7d, 0x0b, 0x2e, 0x48, 0x7c, 0x06, 0xbe, 0x02, 0x5f,
-	0xa0, 0xc7, 0x1d, 0x11, 0x87, 0x0d, 0xda, 0x2f, 0x82, 0xe2, 0x38, 0xd3, 0xd0, 0x76, 0x40, 0x88,
-	0x53, 0xfe, 0xbc, 0x8f, 0x9f, 0xdf, 0x63, 0xbf, 0xaf, 0xfd, 0x2d, 0xaa, 0x40, 0x53, 0x10, 0x06,
-	0x11, 0xce, 0xd1, 0x74, 0x37, 0x01, 0x43, 0x76, 0x51, 0x41, 0x14, 0xe1, 0x3a, 0x2e, 0x94, 0x34,
-	0x32, 0x68, 0x37, 0x92, 0x98, 0x70, 0x1e, 0x3b, 0x49, 0xa7, 0x9d, 0xca, 0x54, 0x5a, 0x01, 0xaa,
-	0xde, 0x6a, 0x6d, 0x27, 0xa2, 0x52, 0x73, 0xa9, 0x51, 0x42, 0x34, 0x5c, 0xba, 0x51, 0xc9, 0x44,
-	0x53, 0x4f, 0xa5, 0x4c, 0x73, 0x40, 0xf6, 0x2b, 0x29, 0x27, 0x68, 0x5c, 0x2a, 0x62, 0x98, 0x74,
-	0xf5, 0xa7, 0xdf, 0x56, 0xfd, 0xb5, 0xa1, 0x85, 0x07, 0x27, 0xfe, 0xa3, 0x42, 0xca, 0x1c, 0x53,
-	0x05, 0x56, 0x81, 0x27, 0x00, 0xa1, 0xd7, 0x5b, 0xe9, 0xdf, 0x7f, 0xbe, 0x11, 0xd7, 0x98, 0xb8,
-	0xc2, 0x34, 0x89, 0xe2, 0x7d, 0xc9, 0xc4, 0x60, 0x67, 0x7e, 0xde, 0x6d, 0x7d, 0xbd, 0xe8, 0xf6,
-	0x53, 0x66, 0x3e, 0x94, 0x49, 0x4c, 0x25, 0x47, 0x2e, 0x53, 0xfd, 0xd8, 0xd6, 0xe3, 0x0c, 0x99,
-	0xd3, 0x02, 0xb4, 0x5d, 0xa0, 0x47, 0x0f, 0x2b, 0xca, 0xbe, 0x83, 0x1c, 0x02, 0x04, 0x3b, 0x7e,
-	0x7b, 0x0c, 0x13, 0x52, 0xe6, 0x06, 0x1b, 0x46, 0x33, 0xac, 0x0b, 0x42, 0x99, 0x48, 0xc3, 0x5b,
-	0x3d, 0xaf, 0xff, 0x60, 0x14, 0xb8, 0xda, 0x31, 0xa3, 0xd9, 0xdb, 0xba, 0x12, 0x64, 0x7e, 0xa7,
-	0x59, 0xc1, 0x99, 0xc0, 0x52, 0x8d, 0x41, 0xe1, 0x8f, 0x25, 0x11, 0x86, 0x99, 0xd3, 0x70, 0xa5,
-	0xe7, 0xf5, 0xef, 0x0d, 0xe2, 0x2a, 0xd8, 0x8f, 0xf3, 0xee, 0xb3, 0xbf, 0x08, 0x76, 0x00, 0x74,
-	0xb4, 0xee, 0x1c, 0x5f, 0x31, 0xf1, 0xa6, 0xf2, 0x3b, 0x72, 0x76, 0x01, 0xf8, 0xeb, 0x37, 0xc1,
-	0xa4, 0x81, 0xf0, 0xf6, 0x3f, 0x91, 0xda, 0xd7, 0x48, 0xd2, 0x40, 0xf0, 0xd9, 0xf3, 0xb7, 0x0a,
-	0xc5, 0xa6, 0xc4, 0x00, 0x9e, 0x10, 0xc5, 0x99, 0x48, 0x71, 0x91, 0x13, 0xf1, 0x67, 0x3f, 0x56,
-	0xff, 0x7f, 0x3f, 0x36, 0x1d, 0xf5, 0xb0, 0x86, 0x0e, 0x73, 0x22, 0xae, 0x76, 0xe7, 0xa5, 0xbf,
-	0xc9, 0xc9, 0x0c, 0x8b, 0x92, 0xe3, 0x9b, 0xe2, 0xe9, 0x70, 0xcd, 0xb6, 0x69, 0x83, 0x93, 0xd9,
-	0xeb, 0x92, 0x0f, 0xaf, 0x79, 0xe9, 0xe0, 0x9d, 0xff, 0xa4, 0x72, 0x68, 0x56, 0x25, 0xb9, 0xa4,
-	0x19, 0x36, 0x8c, 0x43, 0x78, 0xa7, 0xe7, 0xd9, 0xdd, 0xd4, 0x43, 0x1a, 0x37, 0x43, 0x1a, 0x1f,
-	0xb8, 0x21, 0x1d, 0xdc, 0xad, 0x76, 0xf3, 0xe5, 0xa2, 0xeb, 0x8d, 0x1e, 0x73, 0x32, 0x73, 0xae,
-	0x83, 0xca, 0xe0, 0x98, 0x71, 0x18, 0x1c, 0xcd, 0x7f, 0x45, 0xad, 0xf9, 0x22, 0xf2, 0xce, 0x16,
-	0x91, 0xf7, 0x73, 0x11, 0x79, 0x9f, 0x96, 0x51, 0xeb, 0x6c, 0x19, 0xb5, 0xbe, 0x2f, 0xa3, 0xd6,
-	0xfb, 0xbd, 0xab, 0x47, 0xe0, 0xae, 0xd4, 0xb6, 0x00, 0x73, 0x22, 0x55, 0x76, 0xf9, 0x03, 0x4d,
-	0x5f, 0xa0, 0x99, 0xbd, 0x8b, 0xf6, 0x4c, 0x92, 0x35, 0x1b, 0x62, 0xef, 0x77, 0x00, 0x00, 0x00,
-	0xff, 0xff, 0x4e, 0xda, 0xf9, 0x6c, 0xa8, 0x03, 0x00, 0x00,
+	// 564 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x94, 0x4d, 0x4f, 0xd4, 0x40,
+	0x18, 0xc7, 0xb7, 0xa2, 0x2b, 0xd4, 0x18, 0x63, 0xdd, 0x48, 0xc1, 0xd0, 0x5d, 0x3c, 0x98, 0xbd,
+	0xd0, 0x82, 0xc4, 0xbb, 0x2e, 0xc8, 0x0d, 0x5d, 0x16, 0x0e, 0xc6, 0xcb, 0x64, 0x3a, 0xfb, 0x6c,
+	0x9d, 0xb4, 0x33, 0x53, 0x67, 0xa6, 0xb0, 0x7c, 0x0b, 0x2f, 0x26, 0x7e, 0x06, 0x3f, 0xc9, 0x1e,
+	0x39, 0x1a, 0x0f, 0xa0, 0xbb, 0x1f, 0xc0, 0xaf, 0x60, 0x3a, 0x9d, 0x92, 0x25, 0x70, 0x30, 0xc4,
+	0x53, 0x5f, 0x9e, 0x67, 0xfe, 0xbf, 0xff, 0xf3, 0x92, 0x71, 0xd7, 0x89, 0x04, 0x45, 0x80, 0xeb,
+	0x08, 0x33, 0x16, 0x1d, 0x6f, 0xc5, 0xa0, 0xf1, 0x56, 0x94, 0x63, 0x89, 0x99, 0x0a, 0x73, 0x29,
+	0xb4, 0xf0, 0x5a, 0x75, 0x4a, 0x88, 0x19, 0x0b, 0x6d, 0xca, 0x6a, 0x2b, 0x11, 0x89, 0x30, 0x09,
+	0x51, 0xf9, 0x56, 0xe5, 0xae, 0x06, 0x44, 0x28, 0x26, 0x54, 0x14, 0x63, 0x05, 0x97, 0x6a, 0x44,
+	0x50, 0x5e, 0xc7, 0x13, 0x21, 0x92, 0x0c, 0x22, 0xf3, 0x15, 0x17, 0xa3, 0x68, 0x58, 0x48, 0xac,
+	0xa9, 0xb0, 0xf1, 0xe7, 0x7f, 0x9a, 0x6e, 0xb3, 0x6f, 0xe0, 0xde, 0x89, 0xfb, 0x38, 0x17, 0x22,
+	0x43, 0x44, 0x82, 0xc9, 0x40, 0x23, 0x00, 0xdf, 0xe9, 0x2c, 0x74, 0x1f, 0xbc, 0x5c, 0x09, 0x2b,
+	0x4c, 0x58, 0x62, 0x6a, 0x47, 0xe1, 0x8e, 0xa0, 0xbc, 0xb7, 0x39, 0x39, 0x6f, 0x37, 0xbe, 0x5f,
+	0xb4, 0xbb, 0x09, 0xd5, 0x9f, 0x8a, 0x38, 0x24, 0x82, 0x45, 0xd6, 0x53, 0xf5, 0xd8, 0x50, 0xc3,
+	0x34, 0xd2, 0xa7, 0x39, 0x28, 0x73, 0x40, 0x0d, 0x1e, 0x95, 0x94, 0x1d, 0x0b, 0xd9, 0x03, 0xf0,
+	0x36, 0xdd, 0xd6, 0x10, 0x46, 0xb8, 0xc8, 0x34, 0xd2, 0x94, 0xa4, 0x48, 0xe5, 0x98, 0x50, 0x9e,
+	0xf8, 0x77, 0x3a, 0x4e, 0xf7, 0xe1, 0xc0, 0xb3, 0xb1, 0x23, 0x4a, 0xd2, 0xc3, 0x2a, 0xe2, 0xa5,
+	0xee, 0x6a, 0x7d, 0x82, 0x51, 0x8e, 0x84, 0x1c, 0x82, 0x44, 0x9f, 0x0b, 0xcc, 0x35, 0xd5, 0xa7,
+	0xfe, 0x42, 0xc7, 0xe9, 0x2e, 0xf5, 0xc2, 0xd2, 0xd8, 0xcf, 0xf3, 0xf6, 0x8b, 0x7f, 0x30, 0xb6,
+	0x0b, 0x64, 0xb0, 0x6c, 0x15, 0xf7, 0x29, 0x7f, 0x5f, 0xea, 0x1d, 0x58, 0x39, 0x0f, 0xdc, 0xe5,
+	0x9b, 0x60, 0x42, 0x83, 0x7f, 0xf7, 0x56, 0xa4, 0xd6, 0x35, 0x92, 0xd0, 0xe0, 0x7d, 0x75, 0xdc,
+	0xf5, 0x5c, 0xd2, 0x63, 0xac, 0x01, 0x8d, 0xb0, 0x64, 0x94, 0x27, 0x28, 0xcf, 0x30, 0xbf, 0x3a,
+	0x8f, 0x7b, 0xff, 0x7f, 0x1e, 0x6b, 0x96, 0xba, 0x57, 0x41, 0xfb, 0x19, 0xe6, 0xf3, 0xd3, 0x79,
+	0xed, 0xae, 0x31, 0x3c, 0x46, 0xbc, 0x60, 0xe8, 0x26, 0x7b, 0xca, 0x6f, 0x9a, 0x31, 0xad, 0x30,
+	0x3c, 0x7e, 0x57, 0xb0, 0xfe, 0x35, 0x2d, 0xe5, 0x7d, 0x70, 0x9f, 0x96, 0x0a, 0xf5, 0xa9, 0x38,
+	0x13, 0x24, 0x45, 0x9a, 0x32, 0xf0, 0xef, 0x77, 0x1c, 0x53, 0x4d, 0xb5, 0xa4, 0x61, 0xbd, 0xa4,
+	0xe1, 0xae, 0x5d, 0xd2, 0xde, 0x62, 0x59, 0xcd, 0xb7, 0x8b, 0xb6, 0x33, 0x78, 0xc2, 0xf0, 0xd8,
+	0xaa, 0xf6, 0x4a, 0x81, 0x23, 0xca, 0xa0, 0xdc, 0x03, 0x5c, 0x68, 0x81, 0x88, 0x60, 0xb9, 0x28,
+	0xf8, 0x10, 0x95, 0x1c, 0x95, 0xd1, 0x3c, 0xc7, 0x09, 0xf8, 0x8b, 0xb7, 0xdb, 0x83, 0x52, 0x71,
+	0xc7, 0x0a, 0xee, 0xe3, 0xf1, 0xa1, 0x95, 0xf3, 0xde, 0xba, 0x9d, 0xba, 0x11, 0x57, 0xa0, 0x0a,
+	0xe5, 0x20, 0xab, 0xaa, 0xfc, 0x25, 0xd3, 0x8b, 0x67, 0x55, 0x2f, 0xde, 0xcc, 0x09, 0xa9, 0x3e,
+	0x48, 0xe3, 0xbb, 0x77, 0x30, 0xf9, 0x1d, 0x34, 0x26, 0xd3, 0xc0, 0x39, 0x9b, 0x06, 0xce, 0xaf,
+	0x69, 0xe0, 0x7c, 0x99, 0x05, 0x8d, 0xb3, 0x59, 0xd0, 0xf8, 0x31, 0x0b, 0x1a, 0x1f, 0xb7, 0xe7,
+	0x5d, 0xda, 0x6b, 0x60, 0x83, 0x83, 0x3e, 0x11, 0x32, 0xbd, 0xfc, 0x11, 0x1d, 0xbf, 0x8a, 0xc6,
+	0xe6, 0xfe, 0x30, 0xb6, 0xe3, 0xa6, 0x69, 0xdc, 0xf6, 0xdf, 0x01, 0x00, 0xbf, 0xb5, 0x9b, 0x87,
+	0x5c, 0x04, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -134,6 +140,21 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MaxNumAutoCompoundsPerBlock != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxNumAutoCompoundsPerBlock))
+		i--
+		dAtA[i] = 0x48
+	}
+	{
+		size := m.AutoCompoundMaxSlippage.Size()
+		i -= size
+		if _, err := m.AutoCompoundMaxSlippage.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
 	n1, err1 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.MaxFarmingBlockTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.MaxFarmingBlockTime):])
 	if err1 != nil {
 		return 0, err1
@@ -244,6 +265,11 @@ func (m *Params) Size() (n int) {
 	}
 	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.MaxFarmingBlockTime)
 	n += 1 + l + sovParams(uint64(l))
+	l = m.AutoCompoundMaxSlippage.Size()
+	n += 1 + l + sovParams(uint64(l))
+	if m.MaxNumAutoCompoundsPerBlock != 0 {
+		n += 1 + sovParams(uint64(m.MaxNumAutoCompoundsPerBlock))
+	}
 	return n
 }
 
@@ -489,6 +515,59 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoCompoundMaxSlippage", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AutoCompoundMaxSlippage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxNumAutoCompoundsPerBlock", wireType)
+			}
+			m.MaxNumAutoCompoundsPerBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxNumAutoCompoundsPerBlock |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
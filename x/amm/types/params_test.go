@@ -94,6 +94,20 @@ func TestParams_Validate(t *testing.T) {
 			},
 			"max farming block time must be positive: -1s",
 		},
+		{
+			"negative auto compound max slippage",
+			func(params *types.Params) {
+				params.AutoCompoundMaxSlippage = sdk.NewDecWithPrec(-1, 2)
+			},
+			"invalid auto compound max slippage: max slippage must be in range [0, 1): -0.010000000000000000",
+		},
+		{
+			"too big auto compound max slippage",
+			func(params *types.Params) {
+				params.AutoCompoundMaxSlippage = sdk.OneDec()
+			},
+			"invalid auto compound max slippage: max slippage must be in range [0, 1): 1.000000000000000000",
+		},
 	} {
 		t.Run(tc.name, func(t *testing.T) {
 			params := types.DefaultParams()
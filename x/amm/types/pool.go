@@ -97,6 +97,9 @@ func NewPoolState(tick int32, price sdk.Dec) PoolState {
 		TotalLiquidity:             utils.ZeroInt,
 		FeeGrowthGlobal:            sdk.DecCoins{},
 		FarmingRewardsGrowthGlobal: sdk.DecCoins{},
+		ObservationIndex:           0,
+		ObservationCardinality:     1,
+		ObservationCardinalityNext: 1,
 	}
 }
 
@@ -120,5 +123,23 @@ func (poolState PoolState) Validate() error {
 	if err := poolState.FarmingRewardsGrowthGlobal.Validate(); err != nil {
 		return fmt.Errorf("invalid farming rewards growth global: %w", err)
 	}
+	if poolState.ObservationCardinality == 0 {
+		return fmt.Errorf("observation cardinality must be positive")
+	}
+	if poolState.ObservationCardinalityNext < poolState.ObservationCardinality {
+		return fmt.Errorf(
+			"observation cardinality next must not be smaller than observation cardinality %d: %d",
+			poolState.ObservationCardinality, poolState.ObservationCardinalityNext)
+	}
+	if poolState.ObservationCardinalityNext > MaxObservationCardinality {
+		return fmt.Errorf(
+			"observation cardinality next must not be greater than %d: %d",
+			MaxObservationCardinality, poolState.ObservationCardinalityNext)
+	}
+	if poolState.ObservationIndex >= poolState.ObservationCardinality {
+		return fmt.Errorf(
+			"observation index must be smaller than observation cardinality %d: %d",
+			poolState.ObservationCardinality, poolState.ObservationIndex)
+	}
 	return nil
 }
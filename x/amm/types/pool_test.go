@@ -179,6 +179,34 @@ func TestPoolState_Validate(t *testing.T) {
 			},
 			"invalid farming rewards growth global: coin 0.000000000000000000uatom amount is not positive",
 		},
+		{
+			"zero observation cardinality",
+			func(poolState *types.PoolState) {
+				poolState.ObservationCardinality = 0
+			},
+			"observation cardinality must be positive",
+		},
+		{
+			"invalid observation cardinality next",
+			func(poolState *types.PoolState) {
+				poolState.ObservationCardinalityNext = 2
+			},
+			"observation cardinality next must not be smaller than observation cardinality 3: 2",
+		},
+		{
+			"too big observation cardinality next",
+			func(poolState *types.PoolState) {
+				poolState.ObservationCardinalityNext = 65536
+			},
+			"observation cardinality next must not be greater than 65535: 65536",
+		},
+		{
+			"invalid observation index",
+			func(poolState *types.PoolState) {
+				poolState.ObservationIndex = 3
+			},
+			"observation index must be smaller than observation cardinality 3: 3",
+		},
 	} {
 		t.Run(tc.name, func(t *testing.T) {
 			pool := types.PoolState{
@@ -188,6 +216,9 @@ func TestPoolState_Validate(t *testing.T) {
 				TotalLiquidity:             sdk.NewInt(2000_000000),
 				FeeGrowthGlobal:            utils.ParseDecCoins("0.0001ucre,0.0001uusd"),
 				FarmingRewardsGrowthGlobal: utils.ParseDecCoins("0.0001uatom,0.0001stake"),
+				ObservationIndex:           1,
+				ObservationCardinality:     3,
+				ObservationCardinalityNext: 5,
 			}
 			tc.malleate(&pool)
 			err := pool.Validate()
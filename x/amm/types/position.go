@@ -2,12 +2,17 @@ package types
 
 import (
 	"fmt"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	utils "github.com/crescent-network/crescent/v5/types"
 )
 
+// CompoundTWAPWindow is the window of the pool's market TWAP used as the
+// reference price when compounding positions.
+const CompoundTWAPWindow = 10 * time.Minute
+
 func NewPosition(id, poolId uint64, ownerAddr sdk.AccAddress, lowerTick, upperTick int32) Position {
 	return Position{
 		Id:                             id,
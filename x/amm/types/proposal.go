@@ -66,22 +66,25 @@ func (p PoolParameterChangeProposal) String() string {
 `, p.Title, p.Description))
 	for _, change := range p.Changes {
 		b.WriteString(fmt.Sprintf(`    Pool Parameter Change:
-      Pool Id:            %d
-      Tick Spacing:       %d
-      Min Order Quantity: %s
-      Min Order Quote:    %s
-`, change.PoolId, change.TickSpacing, change.MinOrderQuantity, change.MinOrderQuote))
+      Pool Id:                 %d
+      Tick Spacing:            %d
+      Min Order Quantity:      %s
+      Min Order Quote:         %s
+      Observation Cardinality: %d
+`, change.PoolId, change.TickSpacing, change.MinOrderQuantity, change.MinOrderQuote, change.ObservationCardinality))
 	}
 	return b.String()
 }
 
 func NewPoolParameterChange(
-	poolId uint64, tickSpacing uint32, minOrderQty, minOrderQuote *sdk.Dec) PoolParameterChange {
+	poolId uint64, tickSpacing uint32, minOrderQty, minOrderQuote *sdk.Dec,
+	observationCardinality uint32) PoolParameterChange {
 	return PoolParameterChange{
-		PoolId:           poolId,
-		TickSpacing:      tickSpacing,
-		MinOrderQuantity: minOrderQty,
-		MinOrderQuote:    minOrderQuote,
+		PoolId:                 poolId,
+		TickSpacing:            tickSpacing,
+		MinOrderQuantity:       minOrderQty,
+		MinOrderQuote:          minOrderQuote,
+		ObservationCardinality: observationCardinality,
 	}
 }
 
@@ -89,7 +92,8 @@ func (change PoolParameterChange) Validate() error {
 	if change.PoolId == 0 {
 		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "pool id must not be 0")
 	}
-	if change.TickSpacing == 0 && change.MinOrderQuantity == nil && change.MinOrderQuote == nil {
+	if change.TickSpacing == 0 && change.MinOrderQuantity == nil && change.MinOrderQuote == nil &&
+		change.ObservationCardinality == 0 {
 		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "no changes")
 	}
 	if change.TickSpacing != 0 {
@@ -109,6 +113,11 @@ func (change PoolParameterChange) Validate() error {
 				sdkerrors.ErrInvalidRequest, "min order quote must not be negative: %s", change.MinOrderQuote)
 		}
 	}
+	if change.ObservationCardinality > MaxObservationCardinality {
+		return sdkerrors.Wrapf(
+			sdkerrors.ErrInvalidRequest, "observation cardinality must not be greater than %d: %d",
+			MaxObservationCardinality, change.ObservationCardinality)
+	}
 	return nil
 }
 
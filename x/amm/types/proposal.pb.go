@@ -189,6 +189,9 @@ type PoolParameterChange struct {
 	TickSpacing      uint32                                  `protobuf:"varint,2,opt,name=tick_spacing,json=tickSpacing,proto3" json:"tick_spacing,omitempty"`
 	MinOrderQuantity *github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=min_order_quantity,json=minOrderQuantity,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"min_order_quantity,omitempty"`
 	MinOrderQuote    *github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=min_order_quote,json=minOrderQuote,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"min_order_quote,omitempty"`
+	// observation_cardinality is the number of observations to be stored for
+	// the pool's price oracle. It cannot be decreased.
+	ObservationCardinality uint32 `protobuf:"varint,5,opt,name=observation_cardinality,json=observationCardinality,proto3" json:"observation_cardinality,omitempty"`
 }
 
 func (m *PoolParameterChange) Reset()         { *m = PoolParameterChange{} }
@@ -237,49 +240,50 @@ func init() {
 }
 
 var fileDescriptor_283b61c22b8db5bb = []byte{
-	// 658 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x54, 0xcf, 0x4f, 0x13, 0x41,
-	0x18, 0xed, 0xd2, 0xf2, 0x6b, 0x2a, 0xa2, 0x43, 0x13, 0x2b, 0x24, 0xdb, 0x5a, 0x13, 0x82, 0x26,
-	0xec, 0x0a, 0xe8, 0xc5, 0x8b, 0xa1, 0xa8, 0x09, 0x27, 0xcb, 0xca, 0xc1, 0x78, 0xd9, 0x4c, 0x67,
-	0xa7, 0xcb, 0x84, 0xdd, 0x99, 0x65, 0x66, 0x0a, 0xf2, 0x57, 0xc8, 0xd1, 0xa3, 0x27, 0xfe, 0x16,
-	0x8e, 0x1c, 0x8d, 0x07, 0x54, 0xf8, 0x43, 0x34, 0x3b, 0x3b, 0xbb, 0x54, 0x58, 0x49, 0x88, 0xa7,
-	0x76, 0xbe, 0xef, 0xbd, 0xf7, 0x7d, 0xf3, 0xe6, 0xb5, 0xe0, 0x31, 0x16, 0x44, 0x62, 0xc2, 0x94,
-	0x8b, 0xe2, 0xd8, 0xdd, 0x5f, 0xe9, 0x13, 0x85, 0x56, 0xdc, 0x44, 0xf0, 0x84, 0x4b, 0x14, 0x39,
-	0x89, 0xe0, 0x8a, 0xc3, 0x46, 0x0e, 0x72, 0x50, 0x1c, 0x3b, 0x06, 0x34, 0xdf, 0x08, 0x79, 0xc8,
-	0x35, 0xc0, 0x4d, 0xbf, 0x65, 0xd8, 0xf9, 0x4e, 0xa9, 0xe0, 0x00, 0x89, 0x98, 0xb2, 0xd0, 0x60,
-	0x5a, 0x21, 0xe7, 0x61, 0x44, 0x5c, 0x7d, 0xea, 0x0f, 0x07, 0xae, 0xa2, 0x31, 0x91, 0x0a, 0xc5,
-	0x49, 0x06, 0xe8, 0x1c, 0x8f, 0x81, 0x87, 0xbd, 0x61, 0x3f, 0xa2, 0xf8, 0x6d, 0x46, 0xec, 0x45,
-	0x88, 0xf5, 0xcc, 0x52, 0xb0, 0x01, 0xc6, 0x15, 0x55, 0x11, 0x69, 0x5a, 0x6d, 0x6b, 0x69, 0xda,
-	0xcb, 0x0e, 0xb0, 0x0d, 0xea, 0x01, 0x91, 0x58, 0xd0, 0x44, 0x51, 0xce, 0x9a, 0x63, 0xba, 0x37,
-	0x5a, 0x82, 0x18, 0xcc, 0x62, 0x41, 0x90, 0x22, 0xbe, 0x20, 0x7b, 0x43, 0x22, 0x95, 0x6c, 0x56,
-	0xdb, 0xd5, 0xa5, 0xfa, 0xea, 0x73, 0xa7, 0xec, 0x82, 0xce, 0x86, 0x06, 0x5f, 0xdb, 0xc3, 0xcb,
-	0xc8, 0xdd, 0xda, 0xc9, 0x59, 0xab, 0xe2, 0xdd, 0xcd, 0x24, 0x4d, 0x51, 0xc2, 0x01, 0x80, 0x8a,
-	0xa4, 0xd8, 0xbf, 0xe6, 0xd4, 0xf4, 0x9c, 0x95, 0xf2, 0x39, 0xdb, 0x39, 0xfe, 0x9f, 0x43, 0xee,
-	0x17, 0x92, 0xf9, 0x9c, 0x97, 0xb5, 0x2f, 0x5f, 0x5b, 0x95, 0xce, 0xe7, 0x2a, 0xb0, 0x6f, 0x5e,
-	0xf3, 0xaa, 0x2f, 0xd6, 0x75, 0x5f, 0x9e, 0x81, 0x86, 0x79, 0x1f, 0x3f, 0xe1, 0x3c, 0xf2, 0x51,
-	0x10, 0x08, 0x22, 0xa5, 0xb1, 0x10, 0x9a, 0x5e, 0x8f, 0xf3, 0x68, 0x3d, 0xeb, 0x40, 0x17, 0xcc,
-	0xe5, 0x1b, 0x51, 0xce, 0x0a, 0x42, 0x35, 0x23, 0x8c, 0xb4, 0x72, 0x42, 0x1f, 0x40, 0x41, 0x0e,
-	0x90, 0x08, 0x7c, 0x14, 0x45, 0x1c, 0xeb, 0x5e, 0xee, 0xca, 0x72, 0xb9, 0x2b, 0xe6, 0x2a, 0x9e,
-	0xa6, 0xad, 0x17, 0xac, 0xdc, 0x11, 0x71, 0xa5, 0x2e, 0xe1, 0x06, 0x00, 0x52, 0x21, 0xa1, 0xfc,
-	0x34, 0x4d, 0xcd, 0xf1, 0xb6, 0xb5, 0x54, 0x5f, 0x9d, 0x77, 0xb2, 0xa8, 0x39, 0x79, 0xd4, 0x9c,
-	0xed, 0x3c, 0x6a, 0xdd, 0xa9, 0x54, 0xe8, 0xe8, 0x47, 0xcb, 0xf2, 0xa6, 0x35, 0x2f, 0xed, 0xc0,
-	0x57, 0x60, 0x8a, 0xb0, 0x20, 0x93, 0x98, 0xb8, 0x85, 0xc4, 0x24, 0x61, 0x41, 0x5a, 0xef, 0xbc,
-	0x01, 0x0b, 0x37, 0xbc, 0x27, 0x5c, 0x04, 0xb3, 0x85, 0xd7, 0x11, 0x62, 0x3e, 0x0d, 0xf4, 0x8b,
-	0xd4, 0xbc, 0x99, 0xc1, 0x25, 0x78, 0x33, 0xe8, 0x1c, 0x5b, 0x60, 0x21, 0x75, 0xbc, 0x87, 0x04,
-	0x8a, 0x89, 0x22, 0x62, 0x63, 0x07, 0xb1, 0x90, 0xfc, 0xf7, 0x6f, 0x60, 0x13, 0x4c, 0x62, 0xad,
-	0x94, 0x67, 0xff, 0x49, 0xb9, 0xfb, 0x25, 0xb3, 0x8d, 0xf3, 0x39, 0xdf, 0x24, 0xf0, 0xb7, 0x05,
-	0xe6, 0x4a, 0xc0, 0xf0, 0x01, 0x98, 0xd4, 0x61, 0x2a, 0x2e, 0x38, 0x91, 0x1e, 0x37, 0x03, 0xf8,
-	0x08, 0xdc, 0x51, 0x14, 0xef, 0xfa, 0x32, 0x41, 0x98, 0xb2, 0x50, 0x2f, 0x39, 0xe3, 0xd5, 0xd3,
-	0xda, 0xfb, 0xac, 0x04, 0x3f, 0x00, 0x18, 0x53, 0xe6, 0x73, 0x11, 0x10, 0xe1, 0xef, 0x0d, 0x11,
-	0x53, 0x54, 0x1d, 0x66, 0xe9, 0xea, 0x3e, 0xfd, 0x7e, 0xd6, 0x5a, 0x0c, 0xa9, 0xda, 0x19, 0xf6,
-	0x1d, 0xcc, 0x63, 0x17, 0x73, 0x19, 0x73, 0x69, 0x3e, 0x96, 0x65, 0xb0, 0xeb, 0xaa, 0xc3, 0x84,
-	0x48, 0xe7, 0x35, 0xc1, 0xde, 0xbd, 0x98, 0xb2, 0x77, 0xa9, 0xc8, 0x96, 0xd1, 0x80, 0x1e, 0x98,
-	0x1d, 0x55, 0xe6, 0x8a, 0x34, 0x6b, 0xb7, 0x96, 0x9d, 0xb9, 0x94, 0xe5, 0x8a, 0x74, 0xb7, 0x4e,
-	0x7e, 0xd9, 0x95, 0x93, 0x73, 0xdb, 0x3a, 0x3d, 0xb7, 0xad, 0x9f, 0xe7, 0xb6, 0x75, 0x74, 0x61,
-	0x57, 0x4e, 0x2f, 0xec, 0xca, 0xb7, 0x0b, 0xbb, 0xf2, 0x71, 0x6d, 0x54, 0xd4, 0x38, 0xbd, 0xcc,
-	0x88, 0x3a, 0xe0, 0x62, 0xb7, 0x28, 0xb8, 0xfb, 0x2f, 0xdc, 0x4f, 0xfa, 0x0f, 0x53, 0x4f, 0xe9,
-	0x4f, 0xe8, 0xac, 0xad, 0xfd, 0x09, 0x00, 0x00, 0xff, 0xff, 0x01, 0xd2, 0x29, 0x5b, 0x9e, 0x05,
-	0x00, 0x00,
+	// 686 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x54, 0x4f, 0x4f, 0x13, 0x4f,
+	0x18, 0xee, 0xd2, 0xf2, 0x6f, 0xfa, 0xe3, 0x87, 0x0e, 0x8d, 0x54, 0x48, 0xb6, 0xb5, 0x26, 0x04,
+	0x4d, 0xd8, 0x15, 0xd0, 0x98, 0x78, 0x31, 0xb4, 0x6a, 0xc2, 0xc9, 0xb2, 0x72, 0x30, 0x5e, 0x36,
+	0xd3, 0xd9, 0xe9, 0x32, 0x61, 0x77, 0x66, 0x99, 0x99, 0x82, 0x7c, 0x0a, 0x39, 0x7a, 0xf4, 0xc4,
+	0x67, 0xf0, 0x23, 0x70, 0xe4, 0x68, 0x3c, 0xa0, 0xc2, 0x17, 0x31, 0x3b, 0x3b, 0x5b, 0x2a, 0xac,
+	0x24, 0xc4, 0x13, 0xcc, 0xfb, 0x3e, 0xcf, 0xf3, 0xbe, 0xf3, 0xcc, 0xb3, 0x05, 0x0f, 0xb1, 0x20,
+	0x12, 0x13, 0xa6, 0x5c, 0x14, 0xc7, 0xee, 0xfe, 0x6a, 0x8f, 0x28, 0xb4, 0xea, 0x26, 0x82, 0x27,
+	0x5c, 0xa2, 0xc8, 0x49, 0x04, 0x57, 0x1c, 0xd6, 0x72, 0x90, 0x83, 0xe2, 0xd8, 0x31, 0xa0, 0x85,
+	0x5a, 0xc8, 0x43, 0xae, 0x01, 0x6e, 0xfa, 0x5f, 0x86, 0x5d, 0x68, 0x15, 0x0a, 0xf6, 0x91, 0x88,
+	0x29, 0x0b, 0x0d, 0xa6, 0x11, 0x72, 0x1e, 0x46, 0xc4, 0xd5, 0xa7, 0xde, 0xa0, 0xef, 0x2a, 0x1a,
+	0x13, 0xa9, 0x50, 0x9c, 0x64, 0x80, 0xd6, 0xf1, 0x18, 0xb8, 0xdf, 0x1d, 0xf4, 0x22, 0x8a, 0xdf,
+	0x64, 0xc4, 0x6e, 0x84, 0x58, 0xd7, 0x2c, 0x05, 0x6b, 0x60, 0x5c, 0x51, 0x15, 0x91, 0xba, 0xd5,
+	0xb4, 0x96, 0xa7, 0xbd, 0xec, 0x00, 0x9b, 0xa0, 0x1a, 0x10, 0x89, 0x05, 0x4d, 0x14, 0xe5, 0xac,
+	0x3e, 0xa6, 0x7b, 0xa3, 0x25, 0x88, 0xc1, 0x2c, 0x16, 0x04, 0x29, 0xe2, 0x0b, 0xb2, 0x37, 0x20,
+	0x52, 0xc9, 0x7a, 0xb9, 0x59, 0x5e, 0xae, 0xae, 0x3d, 0x75, 0x8a, 0x2e, 0xe8, 0x74, 0x34, 0xf8,
+	0xda, 0x1e, 0x5e, 0x46, 0x6e, 0x57, 0x4e, 0xce, 0x1a, 0x25, 0xef, 0xff, 0x4c, 0xd2, 0x14, 0x25,
+	0xec, 0x03, 0xa8, 0x48, 0x8a, 0xfd, 0x63, 0x4e, 0x45, 0xcf, 0x59, 0x2d, 0x9e, 0xb3, 0x9d, 0xe3,
+	0xff, 0x3a, 0xe4, 0xee, 0x50, 0x32, 0x9f, 0xf3, 0xa2, 0xf2, 0xf9, 0x4b, 0xa3, 0xd4, 0xfa, 0x54,
+	0x06, 0xf6, 0xcd, 0x6b, 0x5e, 0xf5, 0xc5, 0xba, 0xee, 0xcb, 0x13, 0x50, 0x33, 0xef, 0xe3, 0x27,
+	0x9c, 0x47, 0x3e, 0x0a, 0x02, 0x41, 0xa4, 0x34, 0x16, 0x42, 0xd3, 0xeb, 0x72, 0x1e, 0x6d, 0x64,
+	0x1d, 0xe8, 0x82, 0xb9, 0x7c, 0x23, 0xca, 0xd9, 0x90, 0x50, 0xce, 0x08, 0x23, 0xad, 0x9c, 0xd0,
+	0x03, 0x50, 0x90, 0x03, 0x24, 0x02, 0x1f, 0x45, 0x11, 0xc7, 0xba, 0x97, 0xbb, 0xb2, 0x52, 0xec,
+	0x8a, 0xb9, 0x8a, 0xa7, 0x69, 0x1b, 0x43, 0x56, 0xee, 0x88, 0xb8, 0x52, 0x97, 0xb0, 0x03, 0x80,
+	0x54, 0x48, 0x28, 0x3f, 0x4d, 0x53, 0x7d, 0xbc, 0x69, 0x2d, 0x57, 0xd7, 0x16, 0x9c, 0x2c, 0x6a,
+	0x4e, 0x1e, 0x35, 0x67, 0x3b, 0x8f, 0x5a, 0x7b, 0x2a, 0x15, 0x3a, 0xfa, 0xd1, 0xb0, 0xbc, 0x69,
+	0xcd, 0x4b, 0x3b, 0xf0, 0x25, 0x98, 0x22, 0x2c, 0xc8, 0x24, 0x26, 0x6e, 0x21, 0x31, 0x49, 0x58,
+	0x90, 0xd6, 0x5b, 0xaf, 0xc1, 0xe2, 0x0d, 0xef, 0x09, 0x97, 0xc0, 0xec, 0xd0, 0xeb, 0x08, 0x31,
+	0x9f, 0x06, 0xfa, 0x45, 0x2a, 0xde, 0x4c, 0xff, 0x12, 0xbc, 0x19, 0xb4, 0x8e, 0x2d, 0xb0, 0x98,
+	0x3a, 0xde, 0x45, 0x02, 0xc5, 0x44, 0x11, 0xd1, 0xd9, 0x41, 0x2c, 0x24, 0xff, 0xfc, 0x0d, 0x6c,
+	0x82, 0x49, 0xac, 0x95, 0xf2, 0xec, 0x3f, 0x2a, 0x76, 0xbf, 0x60, 0xb6, 0x71, 0x3e, 0xe7, 0x9b,
+	0x04, 0x7e, 0x1d, 0x03, 0x73, 0x05, 0x60, 0x38, 0x0f, 0x26, 0x75, 0x98, 0x86, 0x17, 0x9c, 0x48,
+	0x8f, 0x9b, 0x01, 0x7c, 0x00, 0xfe, 0x53, 0x14, 0xef, 0xfa, 0x32, 0x41, 0x98, 0xb2, 0x50, 0x2f,
+	0x39, 0xe3, 0x55, 0xd3, 0xda, 0xbb, 0xac, 0x04, 0xdf, 0x03, 0x18, 0x53, 0xe6, 0x73, 0x11, 0x10,
+	0xe1, 0xef, 0x0d, 0x10, 0x53, 0x54, 0x1d, 0x66, 0xe9, 0x6a, 0x3f, 0xfe, 0x7e, 0xd6, 0x58, 0x0a,
+	0xa9, 0xda, 0x19, 0xf4, 0x1c, 0xcc, 0x63, 0x17, 0x73, 0x19, 0x73, 0x69, 0xfe, 0xac, 0xc8, 0x60,
+	0xd7, 0x55, 0x87, 0x09, 0x91, 0xce, 0x2b, 0x82, 0xbd, 0x3b, 0x31, 0x65, 0x6f, 0x53, 0x91, 0x2d,
+	0xa3, 0x01, 0x3d, 0x30, 0x3b, 0xaa, 0xcc, 0x15, 0xa9, 0x57, 0x6e, 0x2d, 0x3b, 0x73, 0x29, 0xcb,
+	0x15, 0x81, 0xcf, 0xc1, 0x3c, 0xef, 0x49, 0x22, 0xf6, 0xb3, 0x8f, 0x01, 0x23, 0x11, 0x50, 0x86,
+	0xa2, 0x74, 0xe5, 0x71, 0x7d, 0xb7, 0x7b, 0x23, 0xed, 0xce, 0x65, 0xb7, 0xbd, 0x75, 0xf2, 0xcb,
+	0x2e, 0x9d, 0x9c, 0xdb, 0xd6, 0xe9, 0xb9, 0x6d, 0xfd, 0x3c, 0xb7, 0xad, 0xa3, 0x0b, 0xbb, 0x74,
+	0x7a, 0x61, 0x97, 0xbe, 0x5d, 0xd8, 0xa5, 0x0f, 0xeb, 0xa3, 0xdb, 0x98, 0x27, 0x5a, 0x61, 0x44,
+	0x1d, 0x70, 0xb1, 0x3b, 0x2c, 0xb8, 0xfb, 0xcf, 0xdc, 0x8f, 0xfa, 0x97, 0x56, 0xaf, 0xd7, 0x9b,
+	0xd0, 0x21, 0x5d, 0xff, 0x3d, 0x00, 0xdc, 0xb8, 0x7a, 0xa2, 0xd7, 0x05, 0x00, 0x00,
 }
 
 func (m *PublicFarmingPlanProposal) Marshal() (dAtA []byte, err error) {
@@ -520,6 +524,11 @@ func (m *PoolParameterChange) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ObservationCardinality != 0 {
+		i = encodeVarintProposal(dAtA, i, uint64(m.ObservationCardinality))
+		i--
+		dAtA[i] = 0x28
+	}
 	if m.MinOrderQuote != nil {
 		{
 			size := m.MinOrderQuote.Size()
@@ -683,6 +692,9 @@ func (m *PoolParameterChange) Size() (n int) {
 		l = m.MinOrderQuote.Size()
 		n += 1 + l + sovProposal(uint64(l))
 	}
+	if m.ObservationCardinality != 0 {
+		n += 1 + sovProposal(uint64(m.ObservationCardinality))
+	}
 	return n
 }
 
@@ -1476,6 +1488,25 @@ func (m *PoolParameterChange) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObservationCardinality", wireType)
+			}
+			m.ObservationCardinality = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ObservationCardinality |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipProposal(dAtA[iNdEx:])
@@ -32,7 +32,7 @@ func TestPoolParameterChangeProposal_ValidateBasic(t *testing.T) {
 			"invalid pool id",
 			func(p *types.PoolParameterChangeProposal) {
 				p.Changes = []types.PoolParameterChange{
-					types.NewPoolParameterChange(0, 5, nil, nil),
+					types.NewPoolParameterChange(0, 5, nil, nil, 0),
 				}
 			},
 			"pool id must not be 0: invalid request",
@@ -41,7 +41,7 @@ func TestPoolParameterChangeProposal_ValidateBasic(t *testing.T) {
 			"not allowed tick spacing",
 			func(p *types.PoolParameterChangeProposal) {
 				p.Changes = []types.PoolParameterChange{
-					types.NewPoolParameterChange(1, 7, nil, nil),
+					types.NewPoolParameterChange(1, 7, nil, nil, 0),
 				}
 			},
 			"tick spacing 7 is not allowed: invalid request",
@@ -50,7 +50,7 @@ func TestPoolParameterChangeProposal_ValidateBasic(t *testing.T) {
 			"change only min order qty",
 			func(p *types.PoolParameterChangeProposal) {
 				p.Changes = []types.PoolParameterChange{
-					types.NewPoolParameterChange(1, 0, utils.ParseDecP("10"), nil),
+					types.NewPoolParameterChange(1, 0, utils.ParseDecP("10"), nil, 0),
 				}
 			},
 			"",
@@ -59,7 +59,7 @@ func TestPoolParameterChangeProposal_ValidateBasic(t *testing.T) {
 			"change only min order quote",
 			func(p *types.PoolParameterChangeProposal) {
 				p.Changes = []types.PoolParameterChange{
-					types.NewPoolParameterChange(1, 0, nil, utils.ParseDecP("10")),
+					types.NewPoolParameterChange(1, 0, nil, utils.ParseDecP("10"), 0),
 				}
 			},
 			"",
@@ -68,7 +68,7 @@ func TestPoolParameterChangeProposal_ValidateBasic(t *testing.T) {
 			"negative min order qty",
 			func(p *types.PoolParameterChangeProposal) {
 				p.Changes = []types.PoolParameterChange{
-					types.NewPoolParameterChange(1, 5, utils.ParseDecP("-10"), nil),
+					types.NewPoolParameterChange(1, 5, utils.ParseDecP("-10"), nil, 0),
 				}
 			},
 			"min order quantity must not be negative: -10.000000000000000000: invalid request",
@@ -77,7 +77,7 @@ func TestPoolParameterChangeProposal_ValidateBasic(t *testing.T) {
 			"negative min order quote",
 			func(p *types.PoolParameterChangeProposal) {
 				p.Changes = []types.PoolParameterChange{
-					types.NewPoolParameterChange(1, 5, nil, utils.ParseDecP("-10")),
+					types.NewPoolParameterChange(1, 5, nil, utils.ParseDecP("-10"), 0),
 				}
 			},
 			"min order quote must not be negative: -10.000000000000000000: invalid request",
@@ -86,7 +86,7 @@ func TestPoolParameterChangeProposal_ValidateBasic(t *testing.T) {
 			"zero min order qty",
 			func(p *types.PoolParameterChangeProposal) {
 				p.Changes = []types.PoolParameterChange{
-					types.NewPoolParameterChange(1, 5, utils.ParseDecP("0"), nil),
+					types.NewPoolParameterChange(1, 5, utils.ParseDecP("0"), nil, 0),
 				}
 			},
 			"",
@@ -95,16 +95,34 @@ func TestPoolParameterChangeProposal_ValidateBasic(t *testing.T) {
 			"zero min order quote",
 			func(p *types.PoolParameterChangeProposal) {
 				p.Changes = []types.PoolParameterChange{
-					types.NewPoolParameterChange(1, 5, nil, utils.ParseDecP("0")),
+					types.NewPoolParameterChange(1, 5, nil, utils.ParseDecP("0"), 0),
 				}
 			},
 			"",
 		},
+		{
+			"observation cardinality only",
+			func(p *types.PoolParameterChangeProposal) {
+				p.Changes = []types.PoolParameterChange{
+					types.NewPoolParameterChange(1, 0, nil, nil, 100),
+				}
+			},
+			"",
+		},
+		{
+			"too big observation cardinality",
+			func(p *types.PoolParameterChangeProposal) {
+				p.Changes = []types.PoolParameterChange{
+					types.NewPoolParameterChange(1, 0, nil, nil, 65536),
+				}
+			},
+			"observation cardinality must not be greater than 65535: 65536: invalid request",
+		},
 		{
 			"no change",
 			func(p *types.PoolParameterChangeProposal) {
 				p.Changes = []types.PoolParameterChange{
-					types.NewPoolParameterChange(1, 0, nil, nil),
+					types.NewPoolParameterChange(1, 0, nil, nil, 0),
 				}
 			},
 			"no changes: invalid request",
@@ -113,9 +131,9 @@ func TestPoolParameterChangeProposal_ValidateBasic(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			p := types.NewPoolParameterChangeProposal(
 				"Title", "Description", []types.PoolParameterChange{
-					types.NewPoolParameterChange(1, 5, nil, nil),
-					types.NewPoolParameterChange(2, 10, utils.ParseDecP("100"), nil),
-					types.NewPoolParameterChange(2, 10, nil, utils.ParseDecP("1000")),
+					types.NewPoolParameterChange(1, 5, nil, nil, 0),
+					types.NewPoolParameterChange(2, 10, utils.ParseDecP("100"), nil, 0),
+					types.NewPoolParameterChange(2, 10, nil, utils.ParseDecP("1000"), 0),
 				})
 			require.Equal(t, types.ProposalTypePoolParameterChange, p.ProposalType())
 			tc.malleate(p)
@@ -187,9 +205,9 @@ func TestPublicFarmingPlanProposal_ValidateBasic(t *testing.T) {
 func ExamplePoolParameterChangeProposal_String() {
 	p := types.NewPoolParameterChangeProposal(
 		"Title", "Description", []types.PoolParameterChange{
-			types.NewPoolParameterChange(1, 5, nil, nil),
-			types.NewPoolParameterChange(2, 10, utils.ParseDecP("100"), nil),
-			types.NewPoolParameterChange(2, 10, nil, utils.ParseDecP("1000")),
+			types.NewPoolParameterChange(1, 5, nil, nil, 0),
+			types.NewPoolParameterChange(2, 10, utils.ParseDecP("100"), nil, 0),
+			types.NewPoolParameterChange(2, 10, nil, utils.ParseDecP("1000"), 0),
 		})
 	fmt.Println(p.String())
 
@@ -199,20 +217,23 @@ func ExamplePoolParameterChangeProposal_String() {
 	//   Description: Description
 	//   Changes:
 	//     Pool Parameter Change:
-	//       Pool Id:            1
-	//       Tick Spacing:       5
-	//       Min Order Quantity: <nil>
-	//       Min Order Quote:    <nil>
+	//       Pool Id:                 1
+	//       Tick Spacing:            5
+	//       Min Order Quantity:      <nil>
+	//       Min Order Quote:         <nil>
+	//       Observation Cardinality: 0
 	//     Pool Parameter Change:
-	//       Pool Id:            2
-	//       Tick Spacing:       10
-	//       Min Order Quantity: 100.000000000000000000
-	//       Min Order Quote:    <nil>
+	//       Pool Id:                 2
+	//       Tick Spacing:            10
+	//       Min Order Quantity:      100.000000000000000000
+	//       Min Order Quote:         <nil>
+	//       Observation Cardinality: 0
 	//     Pool Parameter Change:
-	//       Pool Id:            2
-	//       Tick Spacing:       10
-	//       Min Order Quantity: <nil>
-	//       Min Order Quote:    1000.000000000000000000
+	//       Pool Id:                 2
+	//       Tick Spacing:            10
+	//       Min Order Quantity:      <nil>
+	//       Min Order Quote:         1000.000000000000000000
+	//       Observation Cardinality: 0
 }
 
 func ExamplePublicFarmingPlanProposal_String() {
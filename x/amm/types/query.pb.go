@@ -865,6 +865,82 @@ func (m *QueryTickInfoResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_QueryTickInfoResponse proto.InternalMessageInfo
 
+type QueryObserveRequest struct {
+	PoolId      uint64   `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	SecondsAgos []uint32 `protobuf:"varint,2,rep,packed,name=seconds_agos,json=secondsAgos,proto3" json:"seconds_agos,omitempty"`
+}
+
+func (m *QueryObserveRequest) Reset()         { *m = QueryObserveRequest{} }
+func (m *QueryObserveRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryObserveRequest) ProtoMessage()    {}
+func (*QueryObserveRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c4c6a0c012683a24, []int{22}
+}
+func (m *QueryObserveRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryObserveRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryObserveRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryObserveRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryObserveRequest.Merge(m, src)
+}
+func (m *QueryObserveRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryObserveRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryObserveRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryObserveRequest proto.InternalMessageInfo
+
+type QueryObserveResponse struct {
+	TickCumulatives                []int64                                  `protobuf:"varint,1,rep,packed,name=tick_cumulatives,json=tickCumulatives,proto3" json:"tick_cumulatives,omitempty"`
+	SecondsPerLiquidityCumulatives []github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,rep,name=seconds_per_liquidity_cumulatives,json=secondsPerLiquidityCumulatives,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"seconds_per_liquidity_cumulatives"`
+}
+
+func (m *QueryObserveResponse) Reset()         { *m = QueryObserveResponse{} }
+func (m *QueryObserveResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryObserveResponse) ProtoMessage()    {}
+func (*QueryObserveResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c4c6a0c012683a24, []int{23}
+}
+func (m *QueryObserveResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryObserveResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryObserveResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryObserveResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryObserveResponse.Merge(m, src)
+}
+func (m *QueryObserveResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryObserveResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryObserveResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryObserveResponse proto.InternalMessageInfo
+
 type QueryAllFarmingPlansRequest struct {
 	IsPrivate    string             `protobuf:"bytes,1,opt,name=is_private,json=isPrivate,proto3" json:"is_private,omitempty"`
 	IsTerminated string             `protobuf:"bytes,2,opt,name=is_terminated,json=isTerminated,proto3" json:"is_terminated,omitempty"`
@@ -875,7 +951,7 @@ func (m *QueryAllFarmingPlansRequest) Reset()         { *m = QueryAllFarmingPlan
 func (m *QueryAllFarmingPlansRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryAllFarmingPlansRequest) ProtoMessage()    {}
 func (*QueryAllFarmingPlansRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c4c6a0c012683a24, []int{22}
+	return fileDescriptor_c4c6a0c012683a24, []int{24}
 }
 func (m *QueryAllFarmingPlansRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -913,7 +989,7 @@ func (m *QueryAllFarmingPlansResponse) Reset()         { *m = QueryAllFarmingPla
 func (m *QueryAllFarmingPlansResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryAllFarmingPlansResponse) ProtoMessage()    {}
 func (*QueryAllFarmingPlansResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c4c6a0c012683a24, []int{23}
+	return fileDescriptor_c4c6a0c012683a24, []int{25}
 }
 func (m *QueryAllFarmingPlansResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -950,7 +1026,7 @@ func (m *QueryFarmingPlanRequest) Reset()         { *m = QueryFarmingPlanRequest
 func (m *QueryFarmingPlanRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryFarmingPlanRequest) ProtoMessage()    {}
 func (*QueryFarmingPlanRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c4c6a0c012683a24, []int{24}
+	return fileDescriptor_c4c6a0c012683a24, []int{26}
 }
 func (m *QueryFarmingPlanRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -987,7 +1063,7 @@ func (m *QueryFarmingPlanResponse) Reset()         { *m = QueryFarmingPlanRespon
 func (m *QueryFarmingPlanResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryFarmingPlanResponse) ProtoMessage()    {}
 func (*QueryFarmingPlanResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c4c6a0c012683a24, []int{25}
+	return fileDescriptor_c4c6a0c012683a24, []int{27}
 }
 func (m *QueryFarmingPlanResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1038,7 +1114,7 @@ func (m *PoolResponse) Reset()         { *m = PoolResponse{} }
 func (m *PoolResponse) String() string { return proto.CompactTextString(m) }
 func (*PoolResponse) ProtoMessage()    {}
 func (*PoolResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c4c6a0c012683a24, []int{26}
+	return fileDescriptor_c4c6a0c012683a24, []int{28}
 }
 func (m *PoolResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1084,7 +1160,7 @@ func (m *PositionResponse) Reset()         { *m = PositionResponse{} }
 func (m *PositionResponse) String() string { return proto.CompactTextString(m) }
 func (*PositionResponse) ProtoMessage()    {}
 func (*PositionResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c4c6a0c012683a24, []int{27}
+	return fileDescriptor_c4c6a0c012683a24, []int{29}
 }
 func (m *PositionResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1125,7 +1201,7 @@ func (m *TickInfoResponse) Reset()         { *m = TickInfoResponse{} }
 func (m *TickInfoResponse) String() string { return proto.CompactTextString(m) }
 func (*TickInfoResponse) ProtoMessage()    {}
 func (*TickInfoResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c4c6a0c012683a24, []int{28}
+	return fileDescriptor_c4c6a0c012683a24, []int{30}
 }
 func (m *TickInfoResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1177,6 +1253,8 @@ func init() {
 	proto.RegisterType((*QueryAllTickInfosResponse)(nil), "crescent.amm.v1beta1.QueryAllTickInfosResponse")
 	proto.RegisterType((*QueryTickInfoRequest)(nil), "crescent.amm.v1beta1.QueryTickInfoRequest")
 	proto.RegisterType((*QueryTickInfoResponse)(nil), "crescent.amm.v1beta1.QueryTickInfoResponse")
+	proto.RegisterType((*QueryObserveRequest)(nil), "crescent.amm.v1beta1.QueryObserveRequest")
+	proto.RegisterType((*QueryObserveResponse)(nil), "crescent.amm.v1beta1.QueryObserveResponse")
 	proto.RegisterType((*QueryAllFarmingPlansRequest)(nil), "crescent.amm.v1beta1.QueryAllFarmingPlansRequest")
 	proto.RegisterType((*QueryAllFarmingPlansResponse)(nil), "crescent.amm.v1beta1.QueryAllFarmingPlansResponse")
 	proto.RegisterType((*QueryFarmingPlanRequest)(nil), "crescent.amm.v1beta1.QueryFarmingPlanRequest")
@@ -1189,134 +1267,141 @@ func init() {
 func init() { proto.RegisterFile("crescent/amm/v1beta1/query.proto", fileDescriptor_c4c6a0c012683a24) }
 
 var fileDescriptor_c4c6a0c012683a24 = []byte{
-	// 2032 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x59, 0xdd, 0x6f, 0x1b, 0x59,
-	0x15, 0xcf, 0x4d, 0x9c, 0xd4, 0x3e, 0xce, 0x57, 0xef, 0x86, 0x5d, 0xd7, 0x4d, 0x9c, 0x76, 0x4a,
-	0x93, 0xec, 0x56, 0xf1, 0xc4, 0x09, 0xdd, 0xdd, 0xb2, 0x05, 0x94, 0x76, 0xd5, 0x12, 0x58, 0xa9,
-	0x89, 0xbb, 0x80, 0xc4, 0x87, 0xac, 0x89, 0xe7, 0xda, 0x3b, 0xca, 0x78, 0xae, 0x3b, 0x33, 0x4e,
-	0xa8, 0xaa, 0x08, 0x09, 0xa4, 0x15, 0x12, 0x42, 0x02, 0x81, 0x10, 0x42, 0x02, 0x21, 0xf1, 0x21,
-	0x2d, 0xe2, 0x01, 0x84, 0x78, 0xe0, 0x0f, 0x40, 0xea, 0xe3, 0xae, 0x78, 0x59, 0xf1, 0xb0, 0x40,
-	0xcb, 0x33, 0x4f, 0xbc, 0xf1, 0x82, 0xee, 0xc7, 0xcc, 0xdc, 0x99, 0x8c, 0xc7, 0x8e, 0x37, 0x79,
-	0xaa, 0x7b, 0xe6, 0x7c, 0xfc, 0x7e, 0xe7, 0x9e, 0x39, 0xf7, 0xcc, 0x09, 0x5c, 0x69, 0xba, 0xc4,
-	0x6b, 0x12, 0xc7, 0xd7, 0x8d, 0x4e, 0x47, 0x3f, 0xac, 0xed, 0x13, 0xdf, 0xa8, 0xe9, 0x8f, 0x7a,
-	0xc4, 0x7d, 0x5c, 0xed, 0xba, 0xd4, 0xa7, 0x78, 0x21, 0xd0, 0xa8, 0x1a, 0x9d, 0x4e, 0x55, 0x6a,
-	0x94, 0x17, 0xda, 0xb4, 0x4d, 0xb9, 0x82, 0xce, 0x7e, 0x09, 0xdd, 0xf2, 0x62, 0x9b, 0xd2, 0xb6,
-	0x4d, 0x74, 0xa3, 0x6b, 0xe9, 0x86, 0xe3, 0x50, 0xdf, 0xf0, 0x2d, 0xea, 0x78, 0xf2, 0x69, 0x25,
-	0x35, 0x16, 0xf3, 0x2a, 0x9e, 0x6b, 0xa9, 0xcf, 0x5b, 0x86, 0xdb, 0xb1, 0x9c, 0xb6, 0xd4, 0xb9,
-	0x9a, 0xaa, 0xd3, 0x35, 0x5c, 0xa3, 0x13, 0x85, 0xa1, 0x5e, 0x87, 0x7a, 0xfa, 0xbe, 0xe1, 0x91,
-	0x50, 0xa3, 0x49, 0x2d, 0x47, 0x3e, 0x7f, 0x45, 0x7d, 0xce, 0x99, 0x2a, 0x7e, 0xda, 0x96, 0xc3,
-	0x31, 0x0b, 0x5d, 0x6d, 0x01, 0xf0, 0x1e, 0xd3, 0xd8, 0xe5, 0x01, 0xea, 0xe4, 0x51, 0x8f, 0x78,
-	0xbe, 0xb6, 0x07, 0x2f, 0xc4, 0xa4, 0x5e, 0x97, 0x3a, 0x1e, 0xc1, 0x9f, 0x86, 0x29, 0x01, 0xa4,
-	0x84, 0xae, 0xa0, 0xb5, 0xe2, 0xe6, 0x62, 0x35, 0x2d, 0x75, 0x55, 0x61, 0x75, 0x27, 0xf7, 0xf4,
-	0xa3, 0xe5, 0xb1, 0xba, 0xb4, 0xd0, 0x9e, 0xc0, 0x02, 0x77, 0xb9, 0x6d, 0xdb, 0xbb, 0x94, 0xda,
-	0x41, 0x28, 0x7c, 0x19, 0x0a, 0x1d, 0xc3, 0x3d, 0x20, 0x7e, 0xc3, 0x32, 0xb9, 0xdb, 0x5c, 0x3d,
-	0x2f, 0x04, 0x3b, 0x26, 0xbe, 0x07, 0x10, 0x21, 0x2e, 0x8d, 0xf3, 0xa0, 0x2b, 0x55, 0x41, 0xaf,
-	0xca, 0xe8, 0x55, 0xc5, 0x41, 0x46, 0x91, 0xdb, 0x44, 0x3a, 0xae, 0x2b, 0x96, 0xda, 0x2f, 0x11,
-	0x7c, 0x22, 0x11, 0x5d, 0x52, 0xfa, 0x2c, 0x4c, 0x76, 0x99, 0xa0, 0x84, 0xae, 0x4c, 0xac, 0x15,
-	0x37, 0xb5, 0x3e, 0x8c, 0x28, 0xb5, 0x03, 0x13, 0xc9, 0x4b, 0x98, 0xe1, 0xfb, 0x29, 0x08, 0x57,
-	0x07, 0x22, 0x14, 0x9e, 0x62, 0x10, 0x6f, 0xc0, 0xbc, 0x48, 0x39, 0x0f, 0x25, 0x72, 0xf3, 0x12,
-	0x5c, 0x60, 0x51, 0xa2, 0xcc, 0x4c, 0xb1, 0xff, 0xee, 0x98, 0xda, 0x1e, 0x5c, 0x54, 0x94, 0x25,
-	0x95, 0xdb, 0x90, 0x63, 0x8f, 0xe5, 0xd9, 0x0c, 0xcf, 0x84, 0x5b, 0x69, 0x3f, 0x44, 0x50, 0x8a,
-	0x52, 0xe4, 0x59, 0xbc, 0xae, 0x07, 0x01, 0xc1, 0x0b, 0x30, 0x49, 0x8f, 0x1c, 0xe2, 0x72, 0xe6,
-	0x85, 0xba, 0xf8, 0x4f, 0xe2, 0xd8, 0x26, 0x46, 0x3e, 0xb6, 0x3f, 0x20, 0xb8, 0x94, 0x82, 0x49,
-	0xf2, 0xfd, 0x02, 0x14, 0xba, 0x81, 0x50, 0x1e, 0xdf, 0x4a, 0x3f, 0xd2, 0x42, 0x2d, 0x41, 0x3c,
-	0x32, 0x3f, 0xbb, 0x63, 0x7c, 0x4d, 0x96, 0x79, 0x14, 0x52, 0x64, 0x70, 0x19, 0x8a, 0x41, 0xb4,
-	0x28, 0x8b, 0x10, 0x88, 0x76, 0x4c, 0xcd, 0x90, 0x15, 0x9a, 0xc4, 0x8a, 0x3f, 0x0f, 0xf9, 0x40,
-	0x4d, 0x1e, 0xed, 0xe9, 0x58, 0x86, 0xd6, 0xda, 0x67, 0xa0, 0x1c, 0x0b, 0xb1, 0xed, 0x79, 0xc4,
-	0xf7, 0x86, 0x46, 0xf8, 0x3d, 0x04, 0x97, 0x53, 0xed, 0x25, 0xd0, 0x9b, 0x30, 0xc9, 0x9a, 0xd0,
-	0x86, 0x44, 0x79, 0x29, 0x96, 0xbe, 0x00, 0xe4, 0x5d, 0x6a, 0x39, 0xc1, 0x1b, 0xc4, 0xb5, 0x03,
-	0xb3, 0x9a, 0xcc, 0xfa, 0x70, 0x66, 0x35, 0xed, 0xb7, 0x08, 0x34, 0x51, 0x1b, 0xa6, 0xf9, 0x96,
-	0xf5, 0xa8, 0x67, 0x99, 0x96, 0xff, 0xf8, 0xa1, 0xd5, 0xe9, 0xd9, 0x86, 0x9a, 0xf7, 0xbe, 0x95,
-	0xbb, 0x0c, 0x45, 0x9b, 0x1e, 0x11, 0xb7, 0xd1, 0x75, 0xad, 0x26, 0x91, 0xf5, 0x0b, 0x5c, 0xb4,
-	0xcb, 0x24, 0x4c, 0xa1, 0xd7, 0xed, 0x86, 0x0a, 0x13, 0x42, 0x81, 0x8b, 0x84, 0xc2, 0x75, 0x98,
-	0x35, 0x89, 0x67, 0xb9, 0xc4, 0x6c, 0x18, 0x1d, 0xda, 0x73, 0xfc, 0x52, 0x8e, 0xeb, 0xcc, 0x48,
-	0xe9, 0x36, 0x17, 0x6a, 0x1f, 0x22, 0xb8, 0x96, 0x09, 0x54, 0xa6, 0xef, 0x2d, 0x28, 0xd8, 0xc1,
-	0x63, 0x8e, 0xb5, 0x70, 0xa7, 0xca, 0x08, 0xff, 0xfd, 0xa3, 0xe5, 0x95, 0xb6, 0xe5, 0xbf, 0xd3,
-	0xdb, 0xaf, 0x36, 0x69, 0x47, 0x97, 0xbd, 0x5d, 0xfc, 0xb3, 0xee, 0x99, 0x07, 0xba, 0xff, 0xb8,
-	0x4b, 0xbc, 0xea, 0x8e, 0xe3, 0xd7, 0x23, 0x07, 0xb8, 0x09, 0x53, 0x12, 0xd4, 0x38, 0x7f, 0x33,
-	0x32, 0xd2, 0xba, 0xc1, 0xa2, 0xfc, 0xee, 0x1f, 0xcb, 0x6b, 0x43, 0x44, 0x61, 0x06, 0x5e, 0x5d,
-	0xba, 0xd6, 0x5a, 0x70, 0x9d, 0x33, 0xab, 0x93, 0x0e, 0x3d, 0x24, 0x19, 0xa7, 0x30, 0xa8, 0xb6,
-	0xf0, 0xa2, 0x4a, 0x5e, 0x9c, 0x45, 0x24, 0xd0, 0xbe, 0x8f, 0x60, 0x65, 0x50, 0x20, 0x99, 0xc5,
-	0x88, 0x37, 0x3a, 0x3f, 0xde, 0x5f, 0x82, 0x45, 0x0e, 0xe7, 0x2e, 0xb5, 0x6d, 0xd2, 0xf4, 0xad,
-	0x7d, 0x9b, 0x08, 0x05, 0x49, 0x37, 0xec, 0x8a, 0x48, 0xed, 0x8a, 0x89, 0x24, 0x8c, 0x9f, 0x78,
-	0xc1, 0xfe, 0x8b, 0x60, 0xa9, 0x8f, 0x5f, 0xc9, 0xee, 0x1b, 0x30, 0xd1, 0x22, 0xe4, 0x3c, 0xa8,
-	0x31, 0xbf, 0xd8, 0x87, 0x39, 0x39, 0x8c, 0x34, 0x5c, 0x72, 0x64, 0xb8, 0xa6, 0x77, 0x1e, 0xd5,
-	0x33, 0x2b, 0x63, 0xd4, 0x45, 0x08, 0xed, 0x2f, 0xca, 0xcd, 0xf3, 0xb6, 0xd5, 0x3c, 0xd8, 0x71,
-	0x5a, 0x74, 0xf0, 0xcd, 0xb3, 0x04, 0xe2, 0x65, 0x6d, 0xf8, 0x56, 0xf3, 0x20, 0x2c, 0x19, 0x26,
-	0x61, 0x3e, 0xd8, 0x63, 0xf1, 0xf6, 0xf2, 0xc7, 0xe2, 0xe5, 0x2d, 0x70, 0x09, 0x7f, 0x1c, 0xbf,
-	0xa1, 0x72, 0x23, 0xdf, 0x50, 0x7f, 0x54, 0x6e, 0x28, 0x05, 0xbb, 0x3c, 0xae, 0x2f, 0x02, 0xb0,
-	0xf0, 0x0d, 0x8b, 0x49, 0xb3, 0xaf, 0xa8, 0xc0, 0x38, 0x79, 0x45, 0xf9, 0x81, 0xd3, 0xb3, 0xbb,
-	0xa2, 0xee, 0xca, 0x2b, 0x2a, 0x0a, 0x39, 0x20, 0xd5, 0x18, 0x72, 0x61, 0x92, 0x27, 0xeb, 0xfc,
-	0xb7, 0xb6, 0x2f, 0xaf, 0xab, 0x24, 0x6e, 0xbc, 0x03, 0x85, 0x90, 0x73, 0xf6, 0x7d, 0xd5, 0x87,
-	0x72, 0x3e, 0xa0, 0xac, 0xbd, 0x17, 0x5c, 0x38, 0xdb, 0xb6, 0x7d, 0x4f, 0xd4, 0xcc, 0xae, 0x6d,
-	0x44, 0xaf, 0xd9, 0x12, 0x80, 0xe5, 0xb1, 0xf6, 0x7c, 0x68, 0xf8, 0x44, 0xbe, 0x6b, 0x05, 0xcb,
-	0xdb, 0x15, 0x02, 0x7c, 0x0d, 0x66, 0x2c, 0xaf, 0xe1, 0x13, 0x66, 0x68, 0xf8, 0xc4, 0x94, 0x45,
-	0x32, 0x6d, 0x79, 0x6f, 0x87, 0xb2, 0x33, 0x1b, 0x55, 0xfe, 0x8c, 0x64, 0x4f, 0x38, 0x81, 0x35,
-	0x6c, 0xef, 0x33, 0xc1, 0xbb, 0xd5, 0x65, 0x0f, 0x64, 0x39, 0x5c, 0x4d, 0xcf, 0x8d, 0xe2, 0x42,
-	0xa6, 0x65, 0xba, 0xa5, 0x78, 0x3d, 0xbb, 0x62, 0xd8, 0x84, 0x97, 0x38, 0x6c, 0x25, 0xa0, 0x5a,
-	0x0f, 0xb6, 0xe1, 0xa8, 0xf5, 0x60, 0x1b, 0xac, 0x4f, 0xb5, 0xe4, 0xfb, 0x1a, 0xb3, 0x09, 0x87,
-	0xb2, 0x69, 0x95, 0xa6, 0xac, 0x80, 0xa1, 0x59, 0x16, 0x15, 0x96, 0xda, 0x2f, 0xf2, 0x30, 0x1d,
-	0x9b, 0x70, 0x67, 0x61, 0x3c, 0x04, 0x33, 0x6e, 0x99, 0xf1, 0x6f, 0x87, 0xf1, 0xc4, 0xb7, 0xc3,
-	0x1b, 0x90, 0xdf, 0x37, 0x6c, 0xc3, 0x69, 0x92, 0x0d, 0x79, 0xae, 0x03, 0x47, 0x8b, 0xd0, 0x40,
-	0x31, 0xae, 0xc9, 0xee, 0x30, 0xb4, 0x71, 0x0d, 0xaf, 0xc2, 0x9c, 0x4b, 0x3c, 0xe2, 0x1e, 0x92,
-	0x86, 0x61, 0x9a, 0x2e, 0xf1, 0xbc, 0xd2, 0x24, 0x2f, 0xbd, 0x59, 0x29, 0xde, 0x16, 0x52, 0x7c,
-	0x15, 0xa6, 0x65, 0x9f, 0x6d, 0xf0, 0xc9, 0x7d, 0x8a, 0x6b, 0x15, 0xa5, 0x8c, 0x51, 0x67, 0x2a,
-	0xfc, 0x75, 0xf2, 0xba, 0x46, 0xd3, 0x72, 0xda, 0xa5, 0x0b, 0x57, 0xd0, 0xda, 0x4c, 0xbd, 0xc8,
-	0x64, 0x0f, 0x85, 0x08, 0x7f, 0x1d, 0x70, 0xc7, 0x72, 0x1a, 0xd4, 0x35, 0x89, 0xdb, 0x78, 0xd4,
-	0x33, 0x1c, 0x9f, 0x5d, 0xa2, 0xf9, 0x53, 0x4f, 0x10, 0x6f, 0x92, 0x66, 0x7d, 0xbe, 0x63, 0x39,
-	0x0f, 0x98, 0xa3, 0x3d, 0xe9, 0x07, 0x7f, 0x19, 0xe6, 0x54, 0xef, 0xd4, 0x27, 0xa5, 0xc2, 0x48,
-	0xae, 0x67, 0x22, 0xd7, 0xd4, 0x27, 0x8c, 0x58, 0xb3, 0xe7, 0xba, 0xc4, 0xf1, 0x45, 0x8b, 0x06,
-	0xde, 0x5c, 0x8a, 0x52, 0xc6, 0x9b, 0xf4, 0x43, 0x98, 0x09, 0x54, 0xc4, 0x0c, 0x56, 0x1c, 0x29,
-	0x70, 0x10, 0x47, 0x4c, 0x6d, 0x5f, 0x83, 0x8b, 0x81, 0xd3, 0x68, 0xe2, 0x98, 0x1e, 0x69, 0xdc,
-	0x9a, 0x97, 0x8e, 0xc2, 0x79, 0x04, 0x7f, 0x05, 0xe6, 0x7c, 0xea, 0x1b, 0xb6, 0xe2, 0x7a, 0x66,
-	0x24, 0xd7, 0xb3, 0xdc, 0x4d, 0xe4, 0xf8, 0x18, 0x2e, 0xb6, 0x08, 0x69, 0xb4, 0x5d, 0x7a, 0xe4,
-	0xbf, 0xd3, 0x68, 0xdb, 0x74, 0xdf, 0xb0, 0x4b, 0xb3, 0xbc, 0x83, 0x2c, 0xa6, 0x16, 0xe6, 0x9b,
-	0xa4, 0xc9, 0x6b, 0x73, 0x4b, 0x5e, 0xcf, 0x37, 0x86, 0x4b, 0x96, 0xb8, 0xa1, 0xe7, 0x5a, 0x84,
-	0xdc, 0xe7, 0xa1, 0xee, 0xf3, 0x48, 0xf8, 0xc7, 0x08, 0x96, 0x12, 0x93, 0x41, 0x02, 0xcb, 0xdc,
-	0x79, 0x61, 0x29, 0xc7, 0xa7, 0x05, 0x15, 0x96, 0xf6, 0xc1, 0x14, 0xcc, 0x9f, 0xf8, 0x5e, 0x4a,
-	0x36, 0x09, 0xe5, 0x5a, 0x1b, 0x4f, 0xff, 0x76, 0x9d, 0x50, 0xa7, 0xb4, 0x07, 0xf1, 0xef, 0x82,
-	0xdc, 0x48, 0x25, 0xa7, 0x7e, 0x47, 0x3c, 0x88, 0x7f, 0x47, 0x4c, 0x8e, 0xe6, 0x50, 0xf9, 0xee,
-	0x88, 0x7d, 0x28, 0x4c, 0x7d, 0xdc, 0x0f, 0x85, 0x77, 0x11, 0xbc, 0x68, 0x1b, 0x9e, 0xdf, 0x50,
-	0xea, 0xcb, 0x72, 0x3c, 0xcb, 0x24, 0xa5, 0x0b, 0xe7, 0x75, 0xa6, 0x2f, 0xb0, 0x80, 0xf7, 0x82,
-	0x1a, 0xdb, 0xe1, 0xd1, 0x70, 0x0b, 0xf2, 0xf4, 0x88, 0x98, 0x0c, 0x47, 0x29, 0x7f, 0xf6, 0x53,
-	0xe7, 0x05, 0xe6, 0xfc, 0x1e, 0x21, 0xf8, 0xe7, 0x08, 0x34, 0x41, 0x38, 0xbd, 0xa0, 0x25, 0xf9,
-	0xc2, 0x79, 0x91, 0xaf, 0x70, 0xf2, 0x29, 0x45, 0x2d, 0xf3, 0x70, 0x0c, 0x0b, 0x22, 0x0f, 0x89,
-	0x49, 0x1c, 0xce, 0x3e, 0x27, 0x98, 0xe7, 0x24, 0x3e, 0x8d, 0xff, 0x67, 0x02, 0xe6, 0x4f, 0x0c,
-	0x75, 0xc1, 0x04, 0x88, 0xa2, 0x09, 0x90, 0xf5, 0xba, 0xb6, 0x4b, 0x3d, 0xaf, 0x91, 0xf8, 0x70,
-	0x3b, 0x7d, 0xaf, 0xe3, 0x6e, 0xa2, 0x5e, 0xf7, 0x10, 0x66, 0x1c, 0xa2, 0x76, 0xe7, 0x89, 0x91,
-	0xdc, 0x4e, 0x3b, 0x44, 0xe9, 0xcc, 0xdf, 0x02, 0xac, 0x14, 0x38, 0xed, 0xf9, 0xfc, 0x90, 0x73,
-	0xe7, 0x75, 0xc8, 0xf3, 0x61, 0x07, 0x7d, 0x20, 0x42, 0xe1, 0x9f, 0x20, 0xa8, 0xf4, 0xa9, 0xb8,
-	0x00, 0xcd, 0xe4, 0x79, 0xa1, 0xb9, 0x9c, 0xd6, 0x43, 0x25, 0xb0, 0xcd, 0xff, 0xcd, 0xc3, 0x24,
-	0x1f, 0xe7, 0xf0, 0x77, 0x10, 0x4c, 0x89, 0xdd, 0x2d, 0x5e, 0x4b, 0x1f, 0xd8, 0x4e, 0xae, 0x8a,
-	0xcb, 0x2f, 0x0f, 0xa1, 0x29, 0xaa, 0x48, 0xfb, 0xe4, 0xb7, 0xff, 0xf6, 0xef, 0x1f, 0x8d, 0x57,
-	0xf0, 0xa2, 0x9e, 0xb1, 0xe3, 0xc6, 0xdf, 0x45, 0x90, 0x0f, 0xd6, 0xb4, 0xf8, 0x95, 0x0c, 0xef,
-	0x89, 0x4d, 0x72, 0xf9, 0xc6, 0x50, 0xba, 0x12, 0xcb, 0x35, 0x8e, 0x65, 0x09, 0x5f, 0xee, 0x83,
-	0x85, 0x47, 0x7f, 0x17, 0x41, 0x8e, 0x4f, 0x61, 0x2b, 0x59, 0x24, 0xa3, 0x85, 0x6d, 0x79, 0x75,
-	0xa0, 0x9e, 0x0c, 0xbf, 0xce, 0xc3, 0xaf, 0xe2, 0xeb, 0x19, 0xe1, 0xf5, 0x27, 0xf2, 0xde, 0x3a,
-	0xc6, 0x3f, 0x45, 0x30, 0xad, 0xee, 0x40, 0x71, 0x75, 0x10, 0xd7, 0xf8, 0x02, 0xb7, 0xac, 0x0f,
-	0xad, 0x2f, 0x01, 0xae, 0x72, 0x80, 0x57, 0xf1, 0x72, 0x3f, 0x80, 0x01, 0x92, 0x9f, 0x21, 0xc8,
-	0x07, 0xe6, 0x99, 0xc7, 0x95, 0xd8, 0x88, 0x66, 0x1e, 0x57, 0xf2, 0x52, 0xd7, 0x6e, 0x72, 0x38,
-	0x3a, 0x5e, 0x1f, 0x00, 0x87, 0xe5, 0x2c, 0x5c, 0xb1, 0x1c, 0xe3, 0x3f, 0x21, 0x98, 0x8d, 0x6f,
-	0x2b, 0xf1, 0xc6, 0x10, 0x61, 0x63, 0x8b, 0xd1, 0x72, 0xed, 0x14, 0x16, 0x12, 0xee, 0x6d, 0x0e,
-	0xf7, 0x55, 0xfc, 0xa9, 0x53, 0xc1, 0xd5, 0x0d, 0x01, 0xf1, 0xaf, 0x08, 0x5e, 0x4c, 0x5f, 0x16,
-	0xe2, 0xd7, 0xb3, 0xce, 0x31, 0x6b, 0x11, 0x5a, 0xbe, 0x35, 0x82, 0xa5, 0x64, 0xf3, 0x2a, 0x67,
-	0xb3, 0x81, 0xab, 0xe9, 0x6c, 0xbc, 0xd0, 0x42, 0x37, 0x4c, 0x33, 0xea, 0xdb, 0xf8, 0x03, 0x04,
-	0x97, 0xfa, 0x6e, 0xec, 0xf0, 0x1b, 0x19, 0x80, 0x06, 0x2d, 0x14, 0xcb, 0xb7, 0x47, 0x33, 0x96,
-	0x84, 0x6e, 0x71, 0x42, 0x5b, 0xb8, 0x36, 0x90, 0x90, 0xcb, 0x7d, 0x29, 0x9c, 0x7e, 0x8f, 0x60,
-	0x3e, 0xb9, 0x9e, 0xc3, 0x9b, 0x19, 0x68, 0xfa, 0xec, 0x08, 0xcb, 0x5b, 0xa7, 0xb2, 0x91, 0xc0,
-	0x75, 0x0e, 0xfc, 0x65, 0xbc, 0x9a, 0x0e, 0xbc, 0x19, 0xd9, 0x35, 0x9a, 0x1c, 0xd9, 0x7b, 0xa2,
-	0x71, 0x84, 0xab, 0xa9, 0x41, 0x8d, 0x23, 0xb9, 0x7f, 0x1b, 0xd4, 0x38, 0x4e, 0xec, 0xbc, 0xb4,
-	0xd7, 0x39, 0xc4, 0x4d, 0xbc, 0x31, 0x54, 0x67, 0xd3, 0xa3, 0xfd, 0x18, 0xfe, 0x35, 0x82, 0x7c,
-	0xe0, 0x2f, 0xb3, 0x93, 0x24, 0x16, 0x57, 0x99, 0x9d, 0x24, 0x39, 0xca, 0x68, 0x9f, 0xe3, 0xf8,
-	0x6e, 0xe1, 0xd7, 0x4e, 0x8b, 0x4f, 0x7f, 0xc2, 0x7e, 0x1f, 0xe3, 0xdf, 0x20, 0x98, 0x4b, 0x2c,
-	0x79, 0x70, 0x2d, 0x3b, 0x4b, 0x29, 0xcb, 0xab, 0xf2, 0xe6, 0x69, 0x4c, 0x24, 0xf6, 0x1b, 0x1c,
-	0xfb, 0x75, 0x7c, 0x4d, 0xcf, 0xfa, 0x43, 0xb2, 0xd8, 0x2f, 0xe1, 0x5f, 0x21, 0x28, 0x2a, 0x5e,
-	0xf0, 0x7a, 0x46, 0xc0, 0x93, 0xdb, 0x9f, 0x72, 0x75, 0x58, 0xf5, 0xe1, 0x3a, 0x74, 0x0c, 0x9b,
-	0xfe, 0x44, 0x2e, 0x96, 0x8e, 0xef, 0xec, 0x3d, 0xfd, 0x57, 0x65, 0xec, 0xe9, 0xb3, 0x0a, 0x7a,
-	0xff, 0x59, 0x05, 0xfd, 0xf3, 0x59, 0x05, 0xfd, 0xe0, 0x79, 0x65, 0xec, 0xfd, 0xe7, 0x95, 0xb1,
-	0x0f, 0x9f, 0x57, 0xc6, 0xbe, 0xba, 0xa5, 0x4e, 0x39, 0xd2, 0xed, 0xba, 0x43, 0xfc, 0x23, 0xea,
-	0x1e, 0x44, 0x71, 0x0e, 0x6f, 0xea, 0xdf, 0xe4, 0xc1, 0xf8, 0xd8, 0xb3, 0x3f, 0xc5, 0xff, 0xb2,
-	0xbd, 0xf5, 0xff, 0x00, 0x00, 0x00, 0xff, 0xff, 0x11, 0xf0, 0x2a, 0x0e, 0xfa, 0x1f, 0x00, 0x00,
+	// 2130 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x5a, 0x5b, 0x6f, 0x1c, 0x49,
+	0x15, 0x4e, 0xd9, 0x63, 0x7b, 0xe6, 0x8c, 0x6f, 0xa9, 0x75, 0x76, 0x27, 0x13, 0x7b, 0x6c, 0x77,
+	0x88, 0x9d, 0xc4, 0xf2, 0xb4, 0x2f, 0x64, 0x77, 0xc3, 0x06, 0x90, 0x93, 0x55, 0x82, 0x61, 0x25,
+	0xdb, 0x93, 0x05, 0x24, 0x2e, 0x1a, 0xb5, 0xbb, 0xcb, 0xb3, 0x2d, 0xf7, 0x74, 0x4d, 0xba, 0x7b,
+	0x6c, 0x22, 0xcb, 0x42, 0x02, 0x69, 0x85, 0x84, 0x90, 0x40, 0x5c, 0x85, 0x04, 0x42, 0xe2, 0x22,
+	0x2d, 0xe2, 0x01, 0x84, 0x78, 0x40, 0xe2, 0x15, 0x29, 0x8f, 0xbb, 0xe2, 0x65, 0xc5, 0xc3, 0x02,
+	0x09, 0xcf, 0x3c, 0xf1, 0x03, 0x50, 0x5d, 0xfa, 0xea, 0x9e, 0x9e, 0x0b, 0xf6, 0xd3, 0x8e, 0x4f,
+	0x9f, 0xcb, 0xf7, 0x55, 0x9d, 0x3a, 0x75, 0xea, 0x6c, 0x60, 0x41, 0x77, 0x88, 0xab, 0x13, 0xdb,
+	0x53, 0xb5, 0x66, 0x53, 0x3d, 0x5a, 0xdf, 0x27, 0x9e, 0xb6, 0xae, 0x3e, 0x69, 0x13, 0xe7, 0x69,
+	0xb5, 0xe5, 0x50, 0x8f, 0xe2, 0x19, 0x5f, 0xa3, 0xaa, 0x35, 0x9b, 0x55, 0xa9, 0x51, 0x9e, 0x69,
+	0xd0, 0x06, 0xe5, 0x0a, 0x2a, 0xfb, 0x25, 0x74, 0xcb, 0xb3, 0x0d, 0x4a, 0x1b, 0x16, 0x51, 0xb5,
+	0x96, 0xa9, 0x6a, 0xb6, 0x4d, 0x3d, 0xcd, 0x33, 0xa9, 0xed, 0xca, 0xaf, 0x95, 0xd4, 0x58, 0xcc,
+	0xab, 0xf8, 0xae, 0xa4, 0x7e, 0x3f, 0xd0, 0x9c, 0xa6, 0x69, 0x37, 0xa4, 0xce, 0x62, 0xaa, 0x4e,
+	0x4b, 0x73, 0xb4, 0x66, 0x18, 0x86, 0xba, 0x4d, 0xea, 0xaa, 0xfb, 0x9a, 0x4b, 0x02, 0x0d, 0x9d,
+	0x9a, 0xb6, 0xfc, 0x7e, 0x3b, 0xfa, 0x9d, 0x33, 0x8d, 0xf8, 0x69, 0x98, 0x36, 0xc7, 0x2c, 0x74,
+	0x95, 0x19, 0xc0, 0x7b, 0x4c, 0x63, 0x97, 0x07, 0xa8, 0x91, 0x27, 0x6d, 0xe2, 0x7a, 0xca, 0x1e,
+	0xbc, 0x14, 0x93, 0xba, 0x2d, 0x6a, 0xbb, 0x04, 0x7f, 0x02, 0x46, 0x05, 0x90, 0x12, 0x5a, 0x40,
+	0x37, 0x8b, 0x1b, 0xb3, 0xd5, 0xb4, 0xa5, 0xab, 0x0a, 0xab, 0xfb, 0xb9, 0x67, 0x1f, 0xcd, 0x5f,
+	0xaa, 0x49, 0x0b, 0xe5, 0x04, 0x66, 0xb8, 0xcb, 0x2d, 0xcb, 0xda, 0xa5, 0xd4, 0xf2, 0x43, 0xe1,
+	0x6b, 0x50, 0x68, 0x6a, 0xce, 0x21, 0xf1, 0xea, 0xa6, 0xc1, 0xdd, 0xe6, 0x6a, 0x79, 0x21, 0xd8,
+	0x36, 0xf0, 0x43, 0x80, 0x10, 0x71, 0x69, 0x88, 0x07, 0x5d, 0xaa, 0x0a, 0x7a, 0x55, 0x46, 0xaf,
+	0x2a, 0x36, 0x32, 0x8c, 0xdc, 0x20, 0xd2, 0x71, 0x2d, 0x62, 0xa9, 0xfc, 0x02, 0xc1, 0x95, 0x44,
+	0x74, 0x49, 0xe9, 0x53, 0x30, 0xd2, 0x62, 0x82, 0x12, 0x5a, 0x18, 0xbe, 0x59, 0xdc, 0x50, 0x3a,
+	0x30, 0xa2, 0xd4, 0xf2, 0x4d, 0x24, 0x2f, 0x61, 0x86, 0x1f, 0xa5, 0x20, 0x5c, 0xee, 0x8a, 0x50,
+	0x78, 0x8a, 0x41, 0x5c, 0x81, 0x69, 0xb1, 0xe4, 0x3c, 0x94, 0x58, 0x9b, 0x57, 0x60, 0x8c, 0x45,
+	0x09, 0x57, 0x66, 0x94, 0xfd, 0xb9, 0x6d, 0x28, 0x7b, 0x70, 0x39, 0xa2, 0x2c, 0xa9, 0xdc, 0x83,
+	0x1c, 0xfb, 0x2c, 0xf7, 0xa6, 0x77, 0x26, 0xdc, 0x4a, 0xf9, 0x1e, 0x82, 0x52, 0xb8, 0x44, 0xae,
+	0xc9, 0xf3, 0xba, 0x1b, 0x10, 0x3c, 0x03, 0x23, 0xf4, 0xd8, 0x26, 0x0e, 0x67, 0x5e, 0xa8, 0x89,
+	0x3f, 0x12, 0xdb, 0x36, 0x3c, 0xf0, 0xb6, 0xfd, 0x1e, 0xc1, 0xd5, 0x14, 0x4c, 0x92, 0xef, 0x67,
+	0xa1, 0xd0, 0xf2, 0x85, 0x72, 0xfb, 0x96, 0x3a, 0x91, 0x16, 0x6a, 0x09, 0xe2, 0xa1, 0xf9, 0xf9,
+	0x6d, 0xe3, 0x6b, 0x32, 0xcd, 0xc3, 0x90, 0x62, 0x05, 0xe7, 0xa1, 0xe8, 0x47, 0x0b, 0x57, 0x11,
+	0x7c, 0xd1, 0xb6, 0xa1, 0x68, 0x70, 0x25, 0x61, 0x28, 0x69, 0x7e, 0x06, 0xf2, 0xbe, 0x9a, 0xdc,
+	0xda, 0xfe, 0x58, 0x06, 0xd6, 0xca, 0x27, 0xa1, 0x1c, 0x0b, 0xb1, 0xe5, 0xba, 0xc4, 0x73, 0x7b,
+	0x46, 0xf8, 0x6d, 0x04, 0xd7, 0x52, 0xed, 0x25, 0xd0, 0x3b, 0x30, 0xc2, 0x8a, 0xd0, 0x9a, 0x44,
+	0x79, 0x35, 0xb6, 0x7c, 0x3e, 0xc8, 0x07, 0xd4, 0xb4, 0xfd, 0x13, 0xc4, 0xb5, 0x7d, 0xb3, 0xf5,
+	0xd2, 0x50, 0x1f, 0x66, 0xeb, 0xca, 0x6f, 0x10, 0x28, 0x22, 0x37, 0x0c, 0xe3, 0x2d, 0xf3, 0x49,
+	0xdb, 0x34, 0x4c, 0xef, 0xe9, 0x63, 0xb3, 0xd9, 0xb6, 0xb4, 0xe8, 0xba, 0x77, 0xcc, 0xdc, 0x79,
+	0x28, 0x5a, 0xf4, 0x98, 0x38, 0xf5, 0x96, 0x63, 0xea, 0x44, 0xe6, 0x2f, 0x70, 0xd1, 0x2e, 0x93,
+	0x30, 0x85, 0x76, 0xab, 0x15, 0x28, 0x0c, 0x0b, 0x05, 0x2e, 0x12, 0x0a, 0x37, 0x60, 0xd2, 0x20,
+	0xae, 0xe9, 0x10, 0xa3, 0xae, 0x35, 0x69, 0xdb, 0xf6, 0x4a, 0x39, 0xae, 0x33, 0x21, 0xa5, 0x5b,
+	0x5c, 0xa8, 0x7c, 0x88, 0xe0, 0x7a, 0x26, 0x50, 0xb9, 0x7c, 0x6f, 0x41, 0xc1, 0xf2, 0x3f, 0x73,
+	0xac, 0x85, 0xfb, 0x55, 0x46, 0xf8, 0xef, 0x1f, 0xcd, 0x2f, 0x35, 0x4c, 0xef, 0x9d, 0xf6, 0x7e,
+	0x55, 0xa7, 0x4d, 0x55, 0xd6, 0x76, 0xf1, 0x9f, 0x55, 0xd7, 0x38, 0x54, 0xbd, 0xa7, 0x2d, 0xe2,
+	0x56, 0xb7, 0x6d, 0xaf, 0x16, 0x3a, 0xc0, 0x3a, 0x8c, 0x4a, 0x50, 0x43, 0x0b, 0xc3, 0xd9, 0xcb,
+	0xba, 0xc6, 0xa2, 0xfc, 0xf6, 0x1f, 0xf3, 0x37, 0x7b, 0x88, 0xc2, 0x0c, 0xdc, 0x9a, 0x74, 0xad,
+	0x1c, 0xc0, 0x0d, 0xce, 0xac, 0x46, 0x9a, 0xf4, 0x88, 0x64, 0xec, 0x42, 0xb7, 0xdc, 0xc2, 0xb3,
+	0x51, 0xf2, 0x62, 0x2f, 0x42, 0x81, 0xf2, 0x1d, 0x04, 0x4b, 0xdd, 0x02, 0xc9, 0x55, 0x0c, 0x79,
+	0xa3, 0x8b, 0xe3, 0xfd, 0x79, 0x98, 0xe5, 0x70, 0x1e, 0x50, 0xcb, 0x22, 0xba, 0x67, 0xee, 0x5b,
+	0x44, 0x28, 0x48, 0xba, 0x41, 0x55, 0x44, 0xd1, 0xaa, 0x98, 0x58, 0x84, 0xa1, 0x33, 0x07, 0xec,
+	0xbf, 0x08, 0xe6, 0x3a, 0xf8, 0x95, 0xec, 0xbe, 0x0a, 0xc3, 0x07, 0x84, 0x5c, 0x04, 0x35, 0xe6,
+	0x17, 0x7b, 0x30, 0x25, 0x9b, 0x91, 0xba, 0x43, 0x8e, 0x35, 0xc7, 0x70, 0x2f, 0x22, 0x7b, 0x26,
+	0x65, 0x8c, 0x9a, 0x08, 0xa1, 0xfc, 0x39, 0x72, 0xf3, 0xbc, 0x6d, 0xea, 0x87, 0xdb, 0xf6, 0x01,
+	0xed, 0x7e, 0xf3, 0xcc, 0x81, 0x38, 0xac, 0x75, 0xcf, 0xd4, 0x0f, 0x83, 0x94, 0x61, 0x12, 0xe6,
+	0x83, 0x7d, 0x16, 0xa7, 0x97, 0x7f, 0x16, 0x87, 0xb7, 0xc0, 0x25, 0xfc, 0x73, 0xfc, 0x86, 0xca,
+	0x0d, 0x7c, 0x43, 0xfd, 0x21, 0x72, 0x43, 0x45, 0xb0, 0xcb, 0xed, 0xfa, 0x1c, 0x00, 0x0b, 0x5f,
+	0x37, 0x99, 0x34, 0xfb, 0x8a, 0xf2, 0x8d, 0x93, 0x57, 0x94, 0xe7, 0x3b, 0x3d, 0xbf, 0x2b, 0xea,
+	0x81, 0xbc, 0xa2, 0xc2, 0x90, 0x5d, 0x96, 0x1a, 0x43, 0x2e, 0x58, 0xe4, 0x91, 0x1a, 0xff, 0xad,
+	0xec, 0xc3, 0x95, 0x84, 0x13, 0xc9, 0x79, 0x1b, 0x0a, 0x01, 0xe7, 0xec, 0xfb, 0xaa, 0x03, 0xe5,
+	0xbc, 0x4f, 0x39, 0xe8, 0x42, 0x77, 0xf6, 0x5d, 0xe2, 0x1c, 0x91, 0xae, 0x38, 0x17, 0x61, 0xdc,
+	0x25, 0x3a, 0xb5, 0x0d, 0xb7, 0xae, 0x35, 0xa8, 0xc8, 0xdd, 0x89, 0x5a, 0x51, 0xca, 0xb6, 0x1a,
+	0xd4, 0x55, 0xfe, 0x82, 0x60, 0x26, 0xee, 0x53, 0xc2, 0xbe, 0x05, 0xd3, 0x1c, 0xb6, 0xde, 0x16,
+	0x25, 0xe5, 0x88, 0x88, 0x0d, 0x1b, 0xae, 0x4d, 0x31, 0xf9, 0x83, 0x50, 0x8c, 0x9f, 0xc2, 0xa2,
+	0x1f, 0x86, 0x25, 0x58, 0x50, 0xa6, 0x62, 0xb6, 0x2c, 0x76, 0x7f, 0x05, 0xfc, 0x4d, 0xa2, 0xd7,
+	0x2a, 0xd2, 0xf1, 0x2e, 0x71, 0x82, 0x22, 0x17, 0x09, 0xad, 0xbc, 0xe7, 0x5f, 0xc1, 0x5b, 0x96,
+	0xf5, 0x50, 0x9c, 0xa2, 0x5d, 0x4b, 0x0b, 0x0b, 0xcf, 0x1c, 0x80, 0xe9, 0xb2, 0x0b, 0xeb, 0x48,
+	0xf3, 0x88, 0xac, 0x3e, 0x05, 0xd3, 0xdd, 0x15, 0x02, 0x7c, 0x1d, 0x26, 0x4c, 0xb7, 0xee, 0x11,
+	0x66, 0xa8, 0x79, 0xc4, 0x90, 0xc7, 0x66, 0xdc, 0x74, 0xdf, 0x0e, 0x64, 0xe7, 0xd6, 0xbc, 0xfd,
+	0x09, 0xc1, 0x6c, 0x3a, 0xd6, 0xe0, 0xc2, 0x9b, 0xf0, 0xab, 0x4d, 0x8b, 0x7d, 0x90, 0x07, 0x64,
+	0x31, 0x3d, 0x5b, 0x22, 0x2e, 0x64, 0xa2, 0x8c, 0x1f, 0x44, 0xbc, 0x9e, 0xdf, 0xf1, 0xd8, 0x80,
+	0x57, 0x38, 0xec, 0x48, 0xc0, 0x68, 0xe6, 0x59, 0x9a, 0x1d, 0xcd, 0x3c, 0x4b, 0x63, 0x95, 0xfb,
+	0x00, 0x4a, 0x67, 0x6d, 0x82, 0x36, 0x75, 0x3c, 0x4a, 0x53, 0x9e, 0x89, 0x9e, 0x59, 0x16, 0x23,
+	0x2c, 0x95, 0x9f, 0xe7, 0x61, 0x3c, 0xd6, 0xf3, 0x4f, 0xc2, 0x50, 0x00, 0x66, 0xc8, 0x34, 0xe2,
+	0xaf, 0xa9, 0xa1, 0xc4, 0x6b, 0xea, 0x0d, 0xc8, 0xef, 0x6b, 0x96, 0x66, 0xeb, 0x64, 0x4d, 0xee,
+	0x6b, 0xd7, 0x66, 0x2b, 0x30, 0x88, 0x18, 0xaf, 0x97, 0x72, 0xfd, 0x19, 0xaf, 0xe3, 0x65, 0x98,
+	0x72, 0x08, 0x3f, 0x70, 0x75, 0xcd, 0x30, 0x1c, 0xe2, 0xba, 0xa5, 0x11, 0x9e, 0x7a, 0x93, 0x52,
+	0xbc, 0x25, 0xa4, 0xec, 0x08, 0xcb, 0x9b, 0xa7, 0xce, 0xdf, 0x32, 0xa3, 0x5c, 0xab, 0x28, 0x65,
+	0x8c, 0x3a, 0x53, 0xe1, 0x27, 0xd5, 0x6d, 0x69, 0xba, 0x69, 0x37, 0x4a, 0x63, 0x0b, 0x88, 0x9d,
+	0x72, 0x26, 0x7b, 0x2c, 0x44, 0xf8, 0x2b, 0x80, 0x9b, 0xa6, 0x5d, 0xa7, 0x8e, 0x41, 0x9c, 0xfa,
+	0x93, 0xb6, 0x66, 0x7b, 0xac, 0xad, 0xc8, 0x2f, 0xa0, 0x01, 0x8e, 0xe4, 0x74, 0xd3, 0xb4, 0x77,
+	0x98, 0xa3, 0x3d, 0xe9, 0x07, 0x7f, 0x01, 0xa6, 0xa2, 0xde, 0xa9, 0x47, 0x4a, 0x85, 0x81, 0x5c,
+	0x4f, 0x84, 0xae, 0xa9, 0x47, 0x18, 0x31, 0xbd, 0xed, 0x38, 0xc4, 0xf6, 0xc4, 0xa5, 0x05, 0xbc,
+	0xdc, 0x16, 0xa5, 0x8c, 0x5f, 0x5b, 0x8f, 0x61, 0xc2, 0x57, 0x11, 0x5d, 0x69, 0x71, 0xa0, 0xc0,
+	0x7e, 0x1c, 0xd1, 0xc7, 0x7e, 0x19, 0x2e, 0xfb, 0x4e, 0xc3, 0x1e, 0x6c, 0x7c, 0xa0, 0x06, 0x74,
+	0x5a, 0x3a, 0x0a, 0x8a, 0x17, 0xfe, 0x22, 0x4c, 0x79, 0xd4, 0xd3, 0xac, 0x88, 0xeb, 0x89, 0x81,
+	0x5c, 0x4f, 0x72, 0x37, 0xa1, 0xe3, 0x53, 0xb8, 0x7c, 0x40, 0x48, 0xbd, 0xe1, 0xd0, 0x63, 0xef,
+	0x9d, 0x7a, 0xc3, 0xa2, 0xfb, 0x9a, 0x55, 0x9a, 0xe4, 0x15, 0x64, 0x36, 0x35, 0x31, 0xdf, 0x24,
+	0x3a, 0xcf, 0xcd, 0x4d, 0xd9, 0xb0, 0xac, 0xf4, 0xb6, 0x58, 0xa2, 0x67, 0x99, 0x3a, 0x20, 0xe4,
+	0x11, 0x0f, 0xf5, 0x88, 0x47, 0xc2, 0x3f, 0x40, 0x30, 0x97, 0xe8, 0x95, 0x12, 0x58, 0xa6, 0x2e,
+	0x0a, 0x4b, 0x39, 0xde, 0x3f, 0x45, 0x61, 0x29, 0x1f, 0x8c, 0xc2, 0xf4, 0x99, 0x17, 0x64, 0xb2,
+	0x48, 0x44, 0x2e, 0xd0, 0xa1, 0xf4, 0xd7, 0xfc, 0x70, 0xb4, 0x6f, 0xdd, 0x89, 0xbf, 0x94, 0x72,
+	0x03, 0xa5, 0x5c, 0xf4, 0x65, 0xb5, 0x13, 0x7f, 0x59, 0x8d, 0x0c, 0xe6, 0x30, 0xf2, 0x12, 0x8b,
+	0x3d, 0x9d, 0x46, 0xff, 0xdf, 0xa7, 0xd3, 0xbb, 0x08, 0x5e, 0xb6, 0x34, 0xd7, 0xab, 0x47, 0xf2,
+	0xcb, 0xb4, 0x5d, 0xd3, 0x20, 0xa5, 0xb1, 0x8b, 0xda, 0xd3, 0x97, 0x58, 0xc0, 0x87, 0x7e, 0x8e,
+	0x6d, 0xf3, 0x68, 0xf8, 0x00, 0xf2, 0xf4, 0x98, 0x18, 0x0c, 0x47, 0x29, 0x7f, 0xfe, 0x7d, 0xf8,
+	0x18, 0x73, 0xfe, 0x90, 0x10, 0xfc, 0x33, 0x04, 0x8a, 0x20, 0x9c, 0x9e, 0xd0, 0x92, 0x7c, 0xe1,
+	0xa2, 0xc8, 0x57, 0x38, 0xf9, 0x94, 0xa4, 0x96, 0xeb, 0x70, 0x0a, 0x33, 0x62, 0x1d, 0x12, 0x6f,
+	0x13, 0x38, 0xff, 0x35, 0xc1, 0x7c, 0x4d, 0xe2, 0xef, 0x93, 0xff, 0x0c, 0xc3, 0xf4, 0x99, 0x36,
+	0xd7, 0xef, 0x89, 0x51, 0xd8, 0x13, 0xb3, 0x5a, 0xd7, 0x70, 0xa8, 0xeb, 0xd6, 0x13, 0x4f, 0xd9,
+	0xfe, 0x6b, 0x1d, 0x77, 0x13, 0xd6, 0xba, 0xc7, 0x30, 0x61, 0x93, 0x68, 0x75, 0x1e, 0x1e, 0xc8,
+	0xed, 0xb8, 0x4d, 0x22, 0x95, 0xf9, 0xeb, 0x80, 0x23, 0x09, 0x4e, 0xdb, 0x1e, 0xdf, 0xe4, 0xdc,
+	0x45, 0x6d, 0xf2, 0x74, 0x50, 0x41, 0x77, 0x44, 0x28, 0xfc, 0x23, 0x04, 0x95, 0x0e, 0x19, 0xe7,
+	0xa3, 0x19, 0xb9, 0x28, 0x34, 0xd7, 0xd2, 0x6a, 0xa8, 0x04, 0xb6, 0xf1, 0x43, 0x0c, 0x23, 0xbc,
+	0x9d, 0xc3, 0xdf, 0x44, 0x30, 0x2a, 0xa6, 0xd9, 0xf8, 0x66, 0x7a, 0xc3, 0x76, 0x76, 0x78, 0x5e,
+	0xbe, 0xd5, 0x83, 0xa6, 0xc8, 0x22, 0xe5, 0x63, 0xdf, 0xf8, 0xdb, 0xbf, 0xbf, 0x3f, 0x54, 0xc1,
+	0xb3, 0x6a, 0xc6, 0xd4, 0x1f, 0x7f, 0x0b, 0x41, 0xde, 0x1f, 0x5c, 0xe3, 0xdb, 0x19, 0xde, 0x13,
+	0xb3, 0xf5, 0xf2, 0x4a, 0x4f, 0xba, 0x12, 0xcb, 0x75, 0x8e, 0x65, 0x0e, 0x5f, 0xeb, 0x80, 0x85,
+	0x47, 0x7f, 0x17, 0x41, 0x8e, 0x99, 0xe1, 0xa5, 0x2c, 0x92, 0xe1, 0x08, 0xbb, 0xbc, 0xdc, 0x55,
+	0x4f, 0x86, 0x5f, 0xe5, 0xe1, 0x97, 0xf1, 0x8d, 0x8c, 0xf0, 0xea, 0x89, 0xbc, 0xb7, 0x4e, 0xf1,
+	0x4f, 0x10, 0x8c, 0x47, 0xa7, 0xc2, 0xb8, 0xda, 0x8d, 0x6b, 0x7c, 0xa4, 0x5d, 0x56, 0x7b, 0xd6,
+	0x97, 0x00, 0x97, 0x39, 0xc0, 0x45, 0x3c, 0xdf, 0x09, 0xa0, 0x8f, 0xe4, 0xa7, 0x08, 0xf2, 0xbe,
+	0x79, 0xe6, 0x76, 0x25, 0x66, 0xc4, 0xe5, 0x95, 0x9e, 0x74, 0x25, 0x9c, 0x3b, 0x1c, 0x8e, 0x8a,
+	0x57, 0xbb, 0xc0, 0x51, 0x4f, 0xfc, 0x9f, 0x7c, 0xdd, 0xfe, 0x88, 0x60, 0x32, 0x3e, 0xbf, 0xc5,
+	0x6b, 0x3d, 0x84, 0x8d, 0x8d, 0x8a, 0xcb, 0xeb, 0x7d, 0x58, 0x48, 0xb8, 0xf7, 0x38, 0xdc, 0x57,
+	0xf1, 0xc7, 0xfb, 0x82, 0xab, 0x6a, 0x02, 0xe2, 0x5f, 0x11, 0xbc, 0x9c, 0x3e, 0x3e, 0xc5, 0xaf,
+	0x67, 0xed, 0x63, 0xd6, 0x68, 0xb8, 0x7c, 0x77, 0x00, 0x4b, 0xc9, 0xe6, 0x55, 0xce, 0x66, 0x0d,
+	0x57, 0xd3, 0xd9, 0xb8, 0x81, 0x85, 0xaa, 0x19, 0x46, 0x58, 0xb7, 0xf1, 0x07, 0x08, 0xae, 0x76,
+	0x9c, 0x61, 0xe2, 0x37, 0x32, 0x00, 0x75, 0x1b, 0xb1, 0x96, 0xef, 0x0d, 0x66, 0x2c, 0x09, 0xdd,
+	0xe5, 0x84, 0x36, 0xf1, 0x7a, 0x57, 0x42, 0x0e, 0xf7, 0x15, 0xe1, 0xf4, 0x3b, 0x04, 0xd3, 0xc9,
+	0x81, 0x25, 0xde, 0xc8, 0x40, 0xd3, 0x61, 0x6a, 0x5a, 0xde, 0xec, 0xcb, 0x46, 0x02, 0x57, 0x39,
+	0xf0, 0x5b, 0x78, 0x39, 0x1d, 0xb8, 0x1e, 0xda, 0xd5, 0x75, 0x8e, 0xec, 0x3d, 0x51, 0x38, 0x82,
+	0x61, 0x5d, 0xb7, 0xc2, 0x91, 0x9c, 0x48, 0x96, 0xd5, 0x9e, 0xf5, 0x25, 0xc4, 0xd7, 0x39, 0xc4,
+	0x0d, 0xbc, 0xd6, 0x53, 0x65, 0x53, 0xc3, 0x89, 0x21, 0xfe, 0x15, 0x82, 0xbc, 0xef, 0x2f, 0xb3,
+	0x92, 0x24, 0x46, 0x79, 0xe5, 0x95, 0x9e, 0x74, 0x25, 0xbe, 0x4f, 0x73, 0x7c, 0x77, 0xf1, 0x6b,
+	0xfd, 0xe2, 0x53, 0x4f, 0xd8, 0xef, 0x53, 0xfc, 0x63, 0x04, 0x63, 0x72, 0x9e, 0x86, 0xb3, 0x2e,
+	0xbf, 0xf8, 0x1c, 0xaf, 0x7c, 0xbb, 0x17, 0xd5, 0x5e, 0xab, 0x5d, 0x1c, 0x23, 0x95, 0x68, 0x7e,
+	0x8d, 0x60, 0x2a, 0x31, 0x7e, 0xc2, 0xeb, 0xd9, 0xfb, 0x97, 0x32, 0x56, 0x2b, 0x6f, 0xf4, 0x63,
+	0x22, 0x11, 0xaf, 0x70, 0xc4, 0x37, 0xf0, 0x75, 0x35, 0xeb, 0x7f, 0xfa, 0x8b, 0xc9, 0x17, 0xfe,
+	0x25, 0x82, 0x62, 0xc4, 0x0b, 0x5e, 0xcd, 0x08, 0x78, 0x76, 0x2e, 0x55, 0xae, 0xf6, 0xaa, 0xde,
+	0xdb, 0x6a, 0xc6, 0xb0, 0xa9, 0x27, 0x72, 0xe4, 0x75, 0x7a, 0x7f, 0xef, 0xd9, 0xbf, 0x2a, 0x97,
+	0x9e, 0x3d, 0xaf, 0xa0, 0xf7, 0x9f, 0x57, 0xd0, 0x3f, 0x9f, 0x57, 0xd0, 0x77, 0x5f, 0x54, 0x2e,
+	0xbd, 0xff, 0xa2, 0x72, 0xe9, 0xc3, 0x17, 0x95, 0x4b, 0x5f, 0xda, 0x8c, 0xf6, 0x5f, 0xd2, 0xed,
+	0xaa, 0x4d, 0xbc, 0x63, 0xea, 0x1c, 0x86, 0x71, 0x8e, 0xee, 0xa8, 0x5f, 0xe3, 0xc1, 0x78, 0x43,
+	0xb6, 0x3f, 0xca, 0xff, 0x15, 0xc2, 0xe6, 0xff, 0x06, 0x00, 0x04, 0xea, 0x07, 0x1e, 0xa6, 0x21,
+	0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1342,6 +1427,7 @@ type QueryClient interface {
 	CollectibleCoins(ctx context.Context, in *QueryCollectibleCoinsRequest, opts ...grpc.CallOption) (*QueryCollectibleCoinsResponse, error)
 	AllTickInfos(ctx context.Context, in *QueryAllTickInfosRequest, opts ...grpc.CallOption) (*QueryAllTickInfosResponse, error)
 	TickInfo(ctx context.Context, in *QueryTickInfoRequest, opts ...grpc.CallOption) (*QueryTickInfoResponse, error)
+	Observe(ctx context.Context, in *QueryObserveRequest, opts ...grpc.CallOption) (*QueryObserveResponse, error)
 	AllFarmingPlans(ctx context.Context, in *QueryAllFarmingPlansRequest, opts ...grpc.CallOption) (*QueryAllFarmingPlansResponse, error)
 	FarmingPlan(ctx context.Context, in *QueryFarmingPlanRequest, opts ...grpc.CallOption) (*QueryFarmingPlanResponse, error)
 }
@@ -1453,6 +1539,15 @@ func (c *queryClient) TickInfo(ctx context.Context, in *QueryTickInfoRequest, op
 	return out, nil
 }
 
+func (c *queryClient) Observe(ctx context.Context, in *QueryObserveRequest, opts ...grpc.CallOption) (*QueryObserveResponse, error) {
+	out := new(QueryObserveResponse)
+	err := c.cc.Invoke(ctx, "/crescent.amm.v1beta1.Query/Observe", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) AllFarmingPlans(ctx context.Context, in *QueryAllFarmingPlansRequest, opts ...grpc.CallOption) (*QueryAllFarmingPlansResponse, error) {
 	out := new(QueryAllFarmingPlansResponse)
 	err := c.cc.Invoke(ctx, "/crescent.amm.v1beta1.Query/AllFarmingPlans", in, out, opts...)
@@ -1484,6 +1579,7 @@ type QueryServer interface {
 	CollectibleCoins(context.Context, *QueryCollectibleCoinsRequest) (*QueryCollectibleCoinsResponse, error)
 	AllTickInfos(context.Context, *QueryAllTickInfosRequest) (*QueryAllTickInfosResponse, error)
 	TickInfo(context.Context, *QueryTickInfoRequest) (*QueryTickInfoResponse, error)
+	Observe(context.Context, *QueryObserveRequest) (*QueryObserveResponse, error)
 	AllFarmingPlans(context.Context, *QueryAllFarmingPlansRequest) (*QueryAllFarmingPlansResponse, error)
 	FarmingPlan(context.Context, *QueryFarmingPlanRequest) (*QueryFarmingPlanResponse, error)
 }
@@ -1525,6 +1621,9 @@ func (*UnimplementedQueryServer) AllTickInfos(ctx context.Context, req *QueryAll
 func (*UnimplementedQueryServer) TickInfo(ctx context.Context, req *QueryTickInfoRequest) (*QueryTickInfoResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TickInfo not implemented")
 }
+func (*UnimplementedQueryServer) Observe(ctx context.Context, req *QueryObserveRequest) (*QueryObserveResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Observe not implemented")
+}
 func (*UnimplementedQueryServer) AllFarmingPlans(ctx context.Context, req *QueryAllFarmingPlansRequest) (*QueryAllFarmingPlansResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AllFarmingPlans not implemented")
 }
@@ -1734,6 +1833,24 @@ func _Query_TickInfo_Handler(srv interface{}, ctx context.Context, dec func(inte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_Observe_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryObserveRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Observe(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/crescent.amm.v1beta1.Query/Observe",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Observe(ctx, req.(*QueryObserveRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_AllFarmingPlans_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryAllFarmingPlansRequest)
 	if err := dec(in); err != nil {
@@ -1818,6 +1935,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "TickInfo",
 			Handler:    _Query_TickInfo_Handler,
 		},
+		{
+			MethodName: "Observe",
+			Handler:    _Query_Observe_Handler,
+		},
 		{
 			MethodName: "AllFarmingPlans",
 			Handler:    _Query_AllFarmingPlans_Handler,
@@ -2688,6 +2809,108 @@ func (m *QueryTickInfoResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryObserveRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryObserveRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryObserveRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SecondsAgos) > 0 {
+		dAtA14 := make([]byte, len(m.SecondsAgos)*10)
+		var j13 int
+		for _, num := range m.SecondsAgos {
+			for num >= 1<<7 {
+				dAtA14[j13] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j13++
+			}
+			dAtA14[j13] = uint8(num)
+			j13++
+		}
+		i -= j13
+		copy(dAtA[i:], dAtA14[:j13])
+		i = encodeVarintQuery(dAtA, i, uint64(j13))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryObserveResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryObserveResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryObserveResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SecondsPerLiquidityCumulatives) > 0 {
+		for iNdEx := len(m.SecondsPerLiquidityCumulatives) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.SecondsPerLiquidityCumulatives[iNdEx].Size()
+				i -= size
+				if _, err := m.SecondsPerLiquidityCumulatives[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.TickCumulatives) > 0 {
+		dAtA16 := make([]byte, len(m.TickCumulatives)*10)
+		var j15 int
+		for _, num1 := range m.TickCumulatives {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA16[j15] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j15++
+			}
+			dAtA16[j15] = uint8(num)
+			j15++
+		}
+		i -= j15
+		copy(dAtA[i:], dAtA16[:j15])
+		i = encodeVarintQuery(dAtA, i, uint64(j15))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *QueryAllFarmingPlansRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -3558,6 +3781,47 @@ func (m *QueryTickInfoResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryObserveRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	if len(m.SecondsAgos) > 0 {
+		l = 0
+		for _, e := range m.SecondsAgos {
+			l += sovQuery(uint64(e))
+		}
+		n += 1 + sovQuery(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *QueryObserveResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.TickCumulatives) > 0 {
+		l = 0
+		for _, e := range m.TickCumulatives {
+			l += sovQuery(uint64(e))
+		}
+		n += 1 + sovQuery(uint64(l)) + l
+	}
+	if len(m.SecondsPerLiquidityCumulatives) > 0 {
+		for _, e := range m.SecondsPerLiquidityCumulatives {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *QueryAllFarmingPlansRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -6010,6 +6274,313 @@ func (m *QueryTickInfoResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryObserveRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryObserveRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryObserveRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType == 0 {
+				var v uint32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.SecondsAgos = append(m.SecondsAgos, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthQuery
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthQuery
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.SecondsAgos) == 0 {
+					m.SecondsAgos = make([]uint32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowQuery
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.SecondsAgos = append(m.SecondsAgos, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field SecondsAgos", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryObserveResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryObserveResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryObserveResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType == 0 {
+				var v int64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.TickCumulatives = append(m.TickCumulatives, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthQuery
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthQuery
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.TickCumulatives) == 0 {
+					m.TickCumulatives = make([]int64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowQuery
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.TickCumulatives = append(m.TickCumulatives, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field TickCumulatives", wireType)
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SecondsPerLiquidityCumulatives", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Dec
+			m.SecondsPerLiquidityCumulatives = append(m.SecondsPerLiquidityCumulatives, v)
+			if err := m.SecondsPerLiquidityCumulatives[len(m.SecondsPerLiquidityCumulatives)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *QueryAllFarmingPlansRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
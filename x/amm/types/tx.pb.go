@@ -38,6 +38,9 @@ type MsgCreatePool struct {
 	Sender   string                                 `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
 	MarketId uint64                                 `protobuf:"varint,2,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
 	Price    github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=price,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"price"`
+	// tick_spacing is the tick spacing of the pool. The default tick spacing is
+	// used if 0.
+	TickSpacing uint32 `protobuf:"varint,4,opt,name=tick_spacing,json=tickSpacing,proto3" json:"tick_spacing,omitempty"`
 }
 
 func (m *MsgCreatePool) Reset()         { *m = MsgCreatePool{} }
@@ -266,6 +269,170 @@ func (m *MsgRemoveLiquidityResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgRemoveLiquidityResponse proto.InternalMessageInfo
 
+type MsgIncreaseLiquidity struct {
+	Sender        string                                   `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	PositionId    uint64                                   `protobuf:"varint,2,opt,name=position_id,json=positionId,proto3" json:"position_id,omitempty"`
+	DesiredAmount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=desired_amount,json=desiredAmount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"desired_amount"`
+	// min_amount is the minimum amount of each coin to be added to the position.
+	MinAmount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=min_amount,json=minAmount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"min_amount"`
+	// deadline is the time after which the message is rejected. There's no
+	// deadline if not set.
+	Deadline *time.Time `protobuf:"bytes,5,opt,name=deadline,proto3,stdtime" json:"deadline,omitempty"`
+}
+
+func (m *MsgIncreaseLiquidity) Reset()         { *m = MsgIncreaseLiquidity{} }
+func (m *MsgIncreaseLiquidity) String() string { return proto.CompactTextString(m) }
+func (*MsgIncreaseLiquidity) ProtoMessage()    {}
+func (*MsgIncreaseLiquidity) Descriptor() ([]byte, []int) {
+	return fileDescriptor_520126f80a2f40b0, []int{6}
+}
+func (m *MsgIncreaseLiquidity) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgIncreaseLiquidity) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgIncreaseLiquidity.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgIncreaseLiquidity) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgIncreaseLiquidity.Merge(m, src)
+}
+func (m *MsgIncreaseLiquidity) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgIncreaseLiquidity) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgIncreaseLiquidity.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgIncreaseLiquidity proto.InternalMessageInfo
+
+type MsgIncreaseLiquidityResponse struct {
+	Liquidity github_com_cosmos_cosmos_sdk_types.Int   `protobuf:"bytes,1,opt,name=liquidity,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"liquidity"`
+	Amount    github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+}
+
+func (m *MsgIncreaseLiquidityResponse) Reset()         { *m = MsgIncreaseLiquidityResponse{} }
+func (m *MsgIncreaseLiquidityResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgIncreaseLiquidityResponse) ProtoMessage()    {}
+func (*MsgIncreaseLiquidityResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_520126f80a2f40b0, []int{7}
+}
+func (m *MsgIncreaseLiquidityResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgIncreaseLiquidityResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgIncreaseLiquidityResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgIncreaseLiquidityResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgIncreaseLiquidityResponse.Merge(m, src)
+}
+func (m *MsgIncreaseLiquidityResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgIncreaseLiquidityResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgIncreaseLiquidityResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgIncreaseLiquidityResponse proto.InternalMessageInfo
+
+type MsgDecreaseLiquidity struct {
+	Sender     string                                 `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	PositionId uint64                                 `protobuf:"varint,2,opt,name=position_id,json=positionId,proto3" json:"position_id,omitempty"`
+	Liquidity  github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=liquidity,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"liquidity"`
+	// min_amount is the minimum amount of each coin to be withdrawn from the
+	// position.
+	MinAmount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=min_amount,json=minAmount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"min_amount"`
+	// deadline is the time after which the message is rejected. There's no
+	// deadline if not set.
+	Deadline *time.Time `protobuf:"bytes,5,opt,name=deadline,proto3,stdtime" json:"deadline,omitempty"`
+}
+
+func (m *MsgDecreaseLiquidity) Reset()         { *m = MsgDecreaseLiquidity{} }
+func (m *MsgDecreaseLiquidity) String() string { return proto.CompactTextString(m) }
+func (*MsgDecreaseLiquidity) ProtoMessage()    {}
+func (*MsgDecreaseLiquidity) Descriptor() ([]byte, []int) {
+	return fileDescriptor_520126f80a2f40b0, []int{8}
+}
+func (m *MsgDecreaseLiquidity) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDecreaseLiquidity) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDecreaseLiquidity.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgDecreaseLiquidity) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDecreaseLiquidity.Merge(m, src)
+}
+func (m *MsgDecreaseLiquidity) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDecreaseLiquidity) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDecreaseLiquidity.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgDecreaseLiquidity proto.InternalMessageInfo
+
+type MsgDecreaseLiquidityResponse struct {
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+}
+
+func (m *MsgDecreaseLiquidityResponse) Reset()         { *m = MsgDecreaseLiquidityResponse{} }
+func (m *MsgDecreaseLiquidityResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgDecreaseLiquidityResponse) ProtoMessage()    {}
+func (*MsgDecreaseLiquidityResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_520126f80a2f40b0, []int{9}
+}
+func (m *MsgDecreaseLiquidityResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDecreaseLiquidityResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDecreaseLiquidityResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgDecreaseLiquidityResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDecreaseLiquidityResponse.Merge(m, src)
+}
+func (m *MsgDecreaseLiquidityResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDecreaseLiquidityResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDecreaseLiquidityResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgDecreaseLiquidityResponse proto.InternalMessageInfo
+
 type MsgCollect struct {
 	Sender     string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
 	PositionId uint64 `protobuf:"varint,2,opt,name=position_id,json=positionId,proto3" json:"position_id,omitempty"`
@@ -277,7 +444,7 @@ func (m *MsgCollect) Reset()         { *m = MsgCollect{} }
 func (m *MsgCollect) String() string { return proto.CompactTextString(m) }
 func (*MsgCollect) ProtoMessage()    {}
 func (*MsgCollect) Descriptor() ([]byte, []int) {
-	return fileDescriptor_520126f80a2f40b0, []int{6}
+	return fileDescriptor_520126f80a2f40b0, []int{10}
 }
 func (m *MsgCollect) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -313,7 +480,7 @@ func (m *MsgCollectResponse) Reset()         { *m = MsgCollectResponse{} }
 func (m *MsgCollectResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgCollectResponse) ProtoMessage()    {}
 func (*MsgCollectResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_520126f80a2f40b0, []int{7}
+	return fileDescriptor_520126f80a2f40b0, []int{11}
 }
 func (m *MsgCollectResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -342,6 +509,235 @@ func (m *MsgCollectResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgCollectResponse proto.InternalMessageInfo
 
+type MsgTransferPosition struct {
+	Sender     string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	PositionId uint64 `protobuf:"varint,2,opt,name=position_id,json=positionId,proto3" json:"position_id,omitempty"`
+	Recipient  string `protobuf:"bytes,3,opt,name=recipient,proto3" json:"recipient,omitempty"`
+}
+
+func (m *MsgTransferPosition) Reset()         { *m = MsgTransferPosition{} }
+func (m *MsgTransferPosition) String() string { return proto.CompactTextString(m) }
+func (*MsgTransferPosition) ProtoMessage()    {}
+func (*MsgTransferPosition) Descriptor() ([]byte, []int) {
+	return fileDescriptor_520126f80a2f40b0, []int{12}
+}
+func (m *MsgTransferPosition) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgTransferPosition) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgTransferPosition.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgTransferPosition) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTransferPosition.Merge(m, src)
+}
+func (m *MsgTransferPosition) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgTransferPosition) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTransferPosition.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgTransferPosition proto.InternalMessageInfo
+
+type MsgTransferPositionResponse struct {
+}
+
+func (m *MsgTransferPositionResponse) Reset()         { *m = MsgTransferPositionResponse{} }
+func (m *MsgTransferPositionResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgTransferPositionResponse) ProtoMessage()    {}
+func (*MsgTransferPositionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_520126f80a2f40b0, []int{13}
+}
+func (m *MsgTransferPositionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgTransferPositionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgTransferPositionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgTransferPositionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTransferPositionResponse.Merge(m, src)
+}
+func (m *MsgTransferPositionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgTransferPositionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTransferPositionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgTransferPositionResponse proto.InternalMessageInfo
+
+type MsgCompound struct {
+	Sender     string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	PositionId uint64 `protobuf:"varint,2,opt,name=position_id,json=positionId,proto3" json:"position_id,omitempty"`
+	// max_slippage is the maximum slippage allowed when swapping the excess
+	// coin through the exchange, relative to the pool's current price.
+	MaxSlippage github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=max_slippage,json=maxSlippage,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_slippage"`
+}
+
+func (m *MsgCompound) Reset()         { *m = MsgCompound{} }
+func (m *MsgCompound) String() string { return proto.CompactTextString(m) }
+func (*MsgCompound) ProtoMessage()    {}
+func (*MsgCompound) Descriptor() ([]byte, []int) {
+	return fileDescriptor_520126f80a2f40b0, []int{14}
+}
+func (m *MsgCompound) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCompound) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCompound.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCompound) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCompound.Merge(m, src)
+}
+func (m *MsgCompound) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCompound) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCompound.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCompound proto.InternalMessageInfo
+
+type MsgCompoundResponse struct {
+	Liquidity github_com_cosmos_cosmos_sdk_types.Int   `protobuf:"bytes,1,opt,name=liquidity,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"liquidity"`
+	Amount    github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+}
+
+func (m *MsgCompoundResponse) Reset()         { *m = MsgCompoundResponse{} }
+func (m *MsgCompoundResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCompoundResponse) ProtoMessage()    {}
+func (*MsgCompoundResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_520126f80a2f40b0, []int{15}
+}
+func (m *MsgCompoundResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCompoundResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCompoundResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCompoundResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCompoundResponse.Merge(m, src)
+}
+func (m *MsgCompoundResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCompoundResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCompoundResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCompoundResponse proto.InternalMessageInfo
+
+type MsgSetAutoCompound struct {
+	Sender     string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	PositionId uint64 `protobuf:"varint,2,opt,name=position_id,json=positionId,proto3" json:"position_id,omitempty"`
+	Enabled    bool   `protobuf:"varint,3,opt,name=enabled,proto3" json:"enabled,omitempty"`
+}
+
+func (m *MsgSetAutoCompound) Reset()         { *m = MsgSetAutoCompound{} }
+func (m *MsgSetAutoCompound) String() string { return proto.CompactTextString(m) }
+func (*MsgSetAutoCompound) ProtoMessage()    {}
+func (*MsgSetAutoCompound) Descriptor() ([]byte, []int) {
+	return fileDescriptor_520126f80a2f40b0, []int{16}
+}
+func (m *MsgSetAutoCompound) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetAutoCompound) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetAutoCompound.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetAutoCompound) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetAutoCompound.Merge(m, src)
+}
+func (m *MsgSetAutoCompound) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetAutoCompound) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetAutoCompound.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetAutoCompound proto.InternalMessageInfo
+
+type MsgSetAutoCompoundResponse struct {
+}
+
+func (m *MsgSetAutoCompoundResponse) Reset()         { *m = MsgSetAutoCompoundResponse{} }
+func (m *MsgSetAutoCompoundResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetAutoCompoundResponse) ProtoMessage()    {}
+func (*MsgSetAutoCompoundResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_520126f80a2f40b0, []int{17}
+}
+func (m *MsgSetAutoCompoundResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetAutoCompoundResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetAutoCompoundResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetAutoCompoundResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetAutoCompoundResponse.Merge(m, src)
+}
+func (m *MsgSetAutoCompoundResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetAutoCompoundResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetAutoCompoundResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetAutoCompoundResponse proto.InternalMessageInfo
+
 type MsgCreatePrivateFarmingPlan struct {
 	Sender             string                    `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
 	Description        string                    `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
@@ -355,7 +751,7 @@ func (m *MsgCreatePrivateFarmingPlan) Reset()         { *m = MsgCreatePrivateFar
 func (m *MsgCreatePrivateFarmingPlan) String() string { return proto.CompactTextString(m) }
 func (*MsgCreatePrivateFarmingPlan) ProtoMessage()    {}
 func (*MsgCreatePrivateFarmingPlan) Descriptor() ([]byte, []int) {
-	return fileDescriptor_520126f80a2f40b0, []int{8}
+	return fileDescriptor_520126f80a2f40b0, []int{18}
 }
 func (m *MsgCreatePrivateFarmingPlan) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -393,7 +789,7 @@ func (m *MsgCreatePrivateFarmingPlanResponse) Reset()         { *m = MsgCreatePr
 func (m *MsgCreatePrivateFarmingPlanResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgCreatePrivateFarmingPlanResponse) ProtoMessage()    {}
 func (*MsgCreatePrivateFarmingPlanResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_520126f80a2f40b0, []int{9}
+	return fileDescriptor_520126f80a2f40b0, []int{19}
 }
 func (m *MsgCreatePrivateFarmingPlanResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -431,7 +827,7 @@ func (m *MsgTerminatePrivateFarmingPlan) Reset()         { *m = MsgTerminatePriv
 func (m *MsgTerminatePrivateFarmingPlan) String() string { return proto.CompactTextString(m) }
 func (*MsgTerminatePrivateFarmingPlan) ProtoMessage()    {}
 func (*MsgTerminatePrivateFarmingPlan) Descriptor() ([]byte, []int) {
-	return fileDescriptor_520126f80a2f40b0, []int{10}
+	return fileDescriptor_520126f80a2f40b0, []int{20}
 }
 func (m *MsgTerminatePrivateFarmingPlan) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -469,7 +865,7 @@ func (m *MsgTerminatePrivateFarmingPlanResponse) Reset() {
 func (m *MsgTerminatePrivateFarmingPlanResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgTerminatePrivateFarmingPlanResponse) ProtoMessage()    {}
 func (*MsgTerminatePrivateFarmingPlanResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_520126f80a2f40b0, []int{11}
+	return fileDescriptor_520126f80a2f40b0, []int{21}
 }
 func (m *MsgTerminatePrivateFarmingPlanResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -505,8 +901,18 @@ func init() {
 	proto.RegisterType((*MsgAddLiquidityResponse)(nil), "crescent.amm.v1beta1.MsgAddLiquidityResponse")
 	proto.RegisterType((*MsgRemoveLiquidity)(nil), "crescent.amm.v1beta1.MsgRemoveLiquidity")
 	proto.RegisterType((*MsgRemoveLiquidityResponse)(nil), "crescent.amm.v1beta1.MsgRemoveLiquidityResponse")
+	proto.RegisterType((*MsgIncreaseLiquidity)(nil), "crescent.amm.v1beta1.MsgIncreaseLiquidity")
+	proto.RegisterType((*MsgIncreaseLiquidityResponse)(nil), "crescent.amm.v1beta1.MsgIncreaseLiquidityResponse")
+	proto.RegisterType((*MsgDecreaseLiquidity)(nil), "crescent.amm.v1beta1.MsgDecreaseLiquidity")
+	proto.RegisterType((*MsgDecreaseLiquidityResponse)(nil), "crescent.amm.v1beta1.MsgDecreaseLiquidityResponse")
 	proto.RegisterType((*MsgCollect)(nil), "crescent.amm.v1beta1.MsgCollect")
 	proto.RegisterType((*MsgCollectResponse)(nil), "crescent.amm.v1beta1.MsgCollectResponse")
+	proto.RegisterType((*MsgTransferPosition)(nil), "crescent.amm.v1beta1.MsgTransferPosition")
+	proto.RegisterType((*MsgTransferPositionResponse)(nil), "crescent.amm.v1beta1.MsgTransferPositionResponse")
+	proto.RegisterType((*MsgCompound)(nil), "crescent.amm.v1beta1.MsgCompound")
+	proto.RegisterType((*MsgCompoundResponse)(nil), "crescent.amm.v1beta1.MsgCompoundResponse")
+	proto.RegisterType((*MsgSetAutoCompound)(nil), "crescent.amm.v1beta1.MsgSetAutoCompound")
+	proto.RegisterType((*MsgSetAutoCompoundResponse)(nil), "crescent.amm.v1beta1.MsgSetAutoCompoundResponse")
 	proto.RegisterType((*MsgCreatePrivateFarmingPlan)(nil), "crescent.amm.v1beta1.MsgCreatePrivateFarmingPlan")
 	proto.RegisterType((*MsgCreatePrivateFarmingPlanResponse)(nil), "crescent.amm.v1beta1.MsgCreatePrivateFarmingPlanResponse")
 	proto.RegisterType((*MsgTerminatePrivateFarmingPlan)(nil), "crescent.amm.v1beta1.MsgTerminatePrivateFarmingPlan")
@@ -516,65 +922,84 @@ func init() {
 func init() { proto.RegisterFile("crescent/amm/v1beta1/tx.proto", fileDescriptor_520126f80a2f40b0) }
 
 var fileDescriptor_520126f80a2f40b0 = []byte{
-	// 915 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x56, 0xcd, 0x6e, 0x23, 0x45,
-	0x10, 0x4e, 0xdb, 0x89, 0x93, 0x94, 0x09, 0x11, 0x4d, 0x60, 0xcd, 0x44, 0x8c, 0xad, 0x59, 0x11,
-	0x59, 0x42, 0x99, 0x49, 0xb2, 0x70, 0x40, 0x42, 0x42, 0x71, 0x56, 0x48, 0x96, 0xd6, 0x22, 0x8c,
-	0x96, 0x0b, 0x07, 0x4c, 0x7b, 0xba, 0x33, 0x8c, 0x32, 0x33, 0x3d, 0x74, 0xb7, 0x93, 0xdd, 0x13,
-	0xe2, 0x86, 0x38, 0x2d, 0x67, 0xde, 0x60, 0x4f, 0x3c, 0x46, 0x8e, 0x7b, 0x5c, 0x71, 0xd8, 0x85,
-	0xe4, 0xcc, 0x85, 0x27, 0x40, 0x3d, 0x7f, 0x76, 0xbc, 0xb6, 0xf3, 0xb3, 0xc0, 0xc9, 0xee, 0xae,
-	0xfa, 0xbe, 0xaa, 0xfa, 0xaa, 0x5d, 0x65, 0x78, 0xdf, 0x13, 0x4c, 0x7a, 0x2c, 0x56, 0x0e, 0x89,
-	0x22, 0xe7, 0x64, 0x77, 0xc0, 0x14, 0xd9, 0x75, 0xd4, 0x23, 0x3b, 0x11, 0x5c, 0x71, 0xbc, 0x51,
-	0x98, 0x6d, 0x12, 0x45, 0x76, 0x6e, 0x36, 0x36, 0x7c, 0xee, 0xf3, 0xd4, 0xc1, 0xd1, 0xdf, 0x32,
-	0x5f, 0xc3, 0x9c, 0x4a, 0xa5, 0x71, 0x99, 0xdd, 0x9a, 0x6a, 0x3f, 0x22, 0x22, 0x0a, 0x62, 0xbf,
-	0xe4, 0xe0, 0x32, 0xe2, 0xd2, 0x19, 0x10, 0xc9, 0x4a, 0x17, 0x8f, 0x07, 0x71, 0x6e, 0x6f, 0xfa,
-	0x9c, 0xfb, 0x21, 0x73, 0xd2, 0xd3, 0x60, 0x78, 0xe4, 0xa8, 0x20, 0x62, 0x52, 0x91, 0x28, 0xc9,
-	0x1c, 0xac, 0x9f, 0x11, 0xac, 0xf5, 0xa4, 0x7f, 0x20, 0x18, 0x51, 0xec, 0x90, 0xf3, 0x10, 0xbf,
-	0x0b, 0x35, 0xc9, 0x62, 0xca, 0x44, 0x03, 0xb5, 0x50, 0x7b, 0xd5, 0xcd, 0x4f, 0x78, 0x13, 0x56,
-	0x23, 0x22, 0x8e, 0x99, 0xea, 0x07, 0xb4, 0x51, 0x69, 0xa1, 0xf6, 0xa2, 0xbb, 0x92, 0x5d, 0x74,
-	0x29, 0xbe, 0x0f, 0x4b, 0x89, 0x08, 0x3c, 0xd6, 0xa8, 0x6a, 0x4c, 0xc7, 0x3e, 0x7b, 0xd1, 0x5c,
-	0xf8, 0xfd, 0x45, 0x73, 0xcb, 0x0f, 0xd4, 0x77, 0xc3, 0x81, 0xed, 0xf1, 0xc8, 0xc9, 0x33, 0xcd,
-	0x3e, 0xb6, 0x25, 0x3d, 0x76, 0xd4, 0xe3, 0x84, 0x49, 0xfb, 0x3e, 0xf3, 0xdc, 0x0c, 0x6c, 0xed,
-	0xc0, 0x3b, 0x97, 0x72, 0x71, 0x99, 0x4c, 0x78, 0x2c, 0x19, 0xbe, 0x03, 0xcb, 0x09, 0xe7, 0xa1,
-	0x8e, 0x8c, 0xd2, 0xc8, 0x35, 0x7d, 0xec, 0x52, 0xeb, 0x79, 0x05, 0xd6, 0x7b, 0xd2, 0xdf, 0xa7,
-	0xf4, 0x41, 0xf0, 0xfd, 0x30, 0xa0, 0x81, 0x7a, 0x3c, 0xb3, 0x80, 0x31, 0x92, 0xca, 0x38, 0x09,
-	0xfe, 0x02, 0xea, 0x21, 0x3f, 0x65, 0xa2, 0xff, 0x3a, 0x25, 0x40, 0x4a, 0x71, 0xa8, 0x19, 0x34,
-	0xe1, 0x30, 0x49, 0x4a, 0xc2, 0xc5, 0xdb, 0x11, 0xa6, 0x14, 0x19, 0xa1, 0x80, 0x37, 0x29, 0x93,
-	0x81, 0x60, 0xb4, 0x4f, 0x22, 0x3e, 0x8c, 0x55, 0x63, 0xa9, 0x55, 0x6d, 0xd7, 0xf7, 0xde, 0xb3,
-	0x33, 0xa8, 0xad, 0xfb, 0x5f, 0x3c, 0x37, 0xfb, 0x80, 0x07, 0x71, 0x67, 0x47, 0x87, 0x7b, 0xfa,
-	0xb2, 0xd9, 0xbe, 0x46, 0x38, 0x0d, 0x90, 0xee, 0x5a, 0x1e, 0x62, 0x3f, 0x8d, 0x60, 0xfd, 0x85,
-	0xe0, 0xce, 0x84, 0xb4, 0x65, 0x3f, 0x9a, 0x50, 0x4f, 0xb8, 0x0c, 0x54, 0xc0, 0xe3, 0x51, 0x4f,
-	0xa0, 0xb8, 0xea, 0x52, 0xfc, 0x00, 0x56, 0xc3, 0x02, 0x95, 0xaa, 0x7d, 0xb3, 0xfa, 0xbb, 0xb1,
-	0x72, 0x47, 0x04, 0xd8, 0x83, 0x5a, 0x5e, 0x76, 0xf5, 0xdf, 0x2f, 0x3b, 0xa7, 0xb6, 0x7e, 0x45,
-	0x80, 0x7b, 0xd2, 0x77, 0x59, 0xc4, 0x4f, 0xd8, 0xd5, 0xaf, 0x69, 0x42, 0x82, 0xca, 0x7c, 0x09,
-	0xaa, 0xaf, 0x29, 0x81, 0xf5, 0x23, 0x02, 0xe3, 0xd5, 0xec, 0xca, 0x86, 0x8c, 0x14, 0x42, 0xff,
-	0x9d, 0x42, 0x4f, 0x11, 0x80, 0xfe, 0x7d, 0xf2, 0x30, 0x64, 0x9e, 0xba, 0xbd, 0x32, 0xff, 0x4b,
-	0x3b, 0x37, 0xd2, 0x6e, 0xe6, 0xb9, 0x16, 0x3a, 0x59, 0x7f, 0x57, 0x60, 0x73, 0x34, 0x62, 0x44,
-	0x70, 0x42, 0x14, 0xfb, 0x3c, 0x9b, 0xa8, 0x87, 0x21, 0x89, 0x67, 0xd6, 0xd4, 0x82, 0x3a, 0x65,
-	0xd2, 0x13, 0x41, 0xa2, 0x6b, 0xc8, 0x5e, 0xb4, 0x3b, 0x7e, 0x85, 0x1d, 0x78, 0x5b, 0x31, 0x4d,
-	0x44, 0xd2, 0xc2, 0x09, 0xa5, 0x82, 0x49, 0x99, 0x35, 0xde, 0xc5, 0x63, 0xa6, 0xfd, 0xcc, 0x82,
-	0x07, 0x80, 0x05, 0x3b, 0x25, 0x82, 0xf6, 0x49, 0x18, 0x72, 0x2f, 0xb5, 0xc9, 0xc6, 0x62, 0xaa,
-	0xc8, 0xb6, 0x3d, 0x6d, 0x8f, 0xd8, 0x79, 0xa6, 0x6e, 0x0a, 0xdb, 0x2f, 0x51, 0x9d, 0x45, 0xad,
-	0x92, 0xfb, 0x96, 0x98, 0xb8, 0x97, 0xf8, 0x00, 0x40, 0x2a, 0x22, 0x54, 0x5f, 0x8f, 0xfd, 0xc6,
-	0x52, 0x0b, 0xb5, 0xeb, 0x7b, 0x86, 0x9d, 0xed, 0x04, 0xbb, 0xd8, 0x09, 0xf6, 0xc3, 0x62, 0x27,
-	0x74, 0x56, 0x34, 0xd1, 0x93, 0x97, 0x4d, 0xe4, 0xae, 0xa6, 0x38, 0x6d, 0xc1, 0x9f, 0xc1, 0x0a,
-	0x8b, 0x69, 0x46, 0x51, 0xbb, 0x01, 0xc5, 0x32, 0x8b, 0xa9, 0xbe, 0xb7, 0x7e, 0x80, 0xbb, 0x73,
-	0x34, 0x2f, 0xdf, 0xf0, 0x16, 0xac, 0xe7, 0xcb, 0xad, 0x9f, 0x84, 0x64, 0x6c, 0xb0, 0xac, 0x1d,
-	0x8d, 0xbc, 0xbb, 0x14, 0xef, 0xc0, 0x46, 0xe9, 0xa7, 0xe7, 0x79, 0x21, 0x75, 0xd6, 0x14, 0x5c,
-	0x38, 0x73, 0x1e, 0xe6, 0x52, 0x5b, 0xdf, 0x82, 0xd9, 0x93, 0xfe, 0xc3, 0xbc, 0x07, 0x37, 0xe9,
-	0xfb, 0x94, 0x9c, 0x2a, 0x53, 0x72, 0xb2, 0xda, 0xb0, 0x35, 0x3f, 0x42, 0x51, 0xe5, 0xde, 0x6f,
-	0x4b, 0x50, 0xed, 0x49, 0x1f, 0x7f, 0x03, 0x30, 0xb6, 0x74, 0xef, 0x4e, 0x6f, 0xf8, 0xa5, 0x6d,
-	0x68, 0x7c, 0x78, 0x0d, 0xa7, 0x52, 0x4d, 0x0a, 0x6f, 0x5c, 0xda, 0x8a, 0x1f, 0xcc, 0x04, 0x8f,
-	0xbb, 0x19, 0xdb, 0xd7, 0x72, 0x2b, 0xa3, 0x44, 0xb0, 0x3e, 0x39, 0x30, 0xdb, 0x33, 0x19, 0x26,
-	0x3c, 0x8d, 0x9d, 0xeb, 0x7a, 0x96, 0xe1, 0xbe, 0x82, 0xe5, 0x62, 0xfa, 0xb4, 0x66, 0x8b, 0x91,
-	0x79, 0x18, 0xed, 0xab, 0x3c, 0x4a, 0xda, 0x9f, 0x10, 0x34, 0x66, 0x8e, 0x84, 0xdd, 0xab, 0x54,
-	0x7f, 0x05, 0x62, 0x7c, 0x72, 0x63, 0x48, 0x99, 0xca, 0x2f, 0x08, 0x36, 0xe7, 0x3d, 0xd4, 0x8f,
-	0x66, 0x52, 0xcf, 0x41, 0x19, 0x9f, 0xde, 0x06, 0x55, 0xe4, 0xd4, 0xf9, 0xf2, 0xec, 0x4f, 0x73,
-	0xe1, 0xec, 0xdc, 0x44, 0xcf, 0xce, 0x4d, 0xf4, 0xc7, 0xb9, 0x89, 0x9e, 0x5c, 0x98, 0x0b, 0xcf,
-	0x2e, 0xcc, 0x85, 0xe7, 0x17, 0xe6, 0xc2, 0xd7, 0xf7, 0xc6, 0x47, 0x73, 0x1e, 0x65, 0x3b, 0x66,
-	0xea, 0x94, 0x8b, 0xe3, 0xf2, 0xc2, 0x39, 0xf9, 0xd8, 0x79, 0x94, 0xfe, 0x8f, 0x4d, 0x67, 0xf5,
-	0xa0, 0x96, 0x4e, 0x8e, 0x7b, 0xff, 0x04, 0x00, 0x00, 0xff, 0xff, 0x1e, 0x6c, 0xa0, 0xba, 0x4f,
-	0x0b, 0x00, 0x00,
+	// 1229 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x58, 0xcf, 0x6f, 0x1b, 0xc5,
+	0x17, 0xcf, 0xda, 0x6e, 0x62, 0x3f, 0x37, 0xdf, 0x7c, 0xbb, 0x09, 0xd4, 0x6c, 0x52, 0xdb, 0xdd,
+	0x8a, 0xc8, 0x05, 0x65, 0x37, 0x49, 0xe1, 0x80, 0x54, 0x09, 0x25, 0xb1, 0x90, 0x2c, 0xd5, 0x6a,
+	0xba, 0x29, 0x12, 0xe2, 0x80, 0x19, 0xef, 0x4e, 0x96, 0x21, 0xbb, 0x3b, 0xcb, 0xce, 0x38, 0x49,
+	0x4f, 0x08, 0x71, 0xe1, 0x58, 0x8e, 0x88, 0xff, 0xa0, 0x12, 0x7f, 0x07, 0x39, 0x96, 0x03, 0x52,
+	0xc5, 0xa1, 0x85, 0xe4, 0xdc, 0x0b, 0x7f, 0x01, 0xda, 0x9f, 0x76, 0x6c, 0x6f, 0xe2, 0x38, 0x4e,
+	0xa5, 0x9e, 0x92, 0x99, 0xf9, 0xbc, 0xcf, 0xfb, 0xcc, 0x7b, 0x6f, 0xdf, 0xcc, 0x18, 0x6e, 0xe9,
+	0x1e, 0x66, 0x3a, 0x76, 0xb8, 0x8a, 0x6c, 0x5b, 0xdd, 0x5f, 0x6b, 0x63, 0x8e, 0xd6, 0x54, 0x7e,
+	0xa8, 0xb8, 0x1e, 0xe5, 0x54, 0x5c, 0x88, 0x97, 0x15, 0x64, 0xdb, 0x4a, 0xb4, 0x2c, 0x2d, 0x98,
+	0xd4, 0xa4, 0x01, 0x40, 0xf5, 0xff, 0x0b, 0xb1, 0x52, 0x79, 0x28, 0x95, 0x6f, 0x17, 0xae, 0xcb,
+	0x43, 0xd7, 0x77, 0x91, 0x67, 0x13, 0xc7, 0x4c, 0x38, 0x28, 0xb3, 0x29, 0x53, 0xdb, 0x88, 0xe1,
+	0x04, 0xa2, 0x53, 0xe2, 0x44, 0xeb, 0x15, 0x93, 0x52, 0xd3, 0xc2, 0x6a, 0x30, 0x6a, 0x77, 0x76,
+	0x55, 0x4e, 0x6c, 0xcc, 0x38, 0xb2, 0xdd, 0x10, 0x20, 0xff, 0x26, 0xc0, 0x6c, 0x93, 0x99, 0x5b,
+	0x1e, 0x46, 0x1c, 0x6f, 0x53, 0x6a, 0x89, 0xef, 0xc2, 0x34, 0xc3, 0x8e, 0x81, 0xbd, 0x92, 0x50,
+	0x15, 0x6a, 0x05, 0x2d, 0x1a, 0x89, 0x8b, 0x50, 0xb0, 0x91, 0xb7, 0x87, 0x79, 0x8b, 0x18, 0xa5,
+	0x4c, 0x55, 0xa8, 0xe5, 0xb4, 0x7c, 0x38, 0xd1, 0x30, 0xc4, 0x3a, 0x5c, 0x73, 0x3d, 0xa2, 0xe3,
+	0x52, 0xd6, 0xb7, 0xd9, 0x54, 0x8e, 0x5e, 0x56, 0xa6, 0xfe, 0x7a, 0x59, 0x59, 0x36, 0x09, 0xff,
+	0xa6, 0xd3, 0x56, 0x74, 0x6a, 0xab, 0x91, 0xd2, 0xf0, 0xcf, 0x0a, 0x33, 0xf6, 0x54, 0xfe, 0xc4,
+	0xc5, 0x4c, 0xa9, 0x63, 0x5d, 0x0b, 0x8d, 0xc5, 0xdb, 0x70, 0x9d, 0x13, 0x7d, 0xaf, 0xc5, 0x5c,
+	0xa4, 0x13, 0xc7, 0x2c, 0xe5, 0xaa, 0x42, 0x6d, 0x56, 0x2b, 0xfa, 0x73, 0x3b, 0xe1, 0x94, 0xbc,
+	0x0a, 0xef, 0x9c, 0x92, 0xab, 0x61, 0xe6, 0x52, 0x87, 0x61, 0xf1, 0x26, 0xcc, 0xb8, 0x94, 0x5a,
+	0xbe, 0x38, 0x21, 0x10, 0x37, 0xed, 0x0f, 0x1b, 0x86, 0xfc, 0x22, 0x03, 0x73, 0x4d, 0x66, 0x6e,
+	0x18, 0xc6, 0x03, 0xf2, 0x5d, 0x87, 0x18, 0x84, 0x3f, 0x49, 0xdd, 0x63, 0x0f, 0x49, 0xa6, 0x97,
+	0x44, 0x7c, 0x08, 0x45, 0x8b, 0x1e, 0x60, 0xaf, 0x75, 0x99, 0x5d, 0x42, 0x40, 0xb1, 0x1d, 0x6c,
+	0xf5, 0x21, 0x14, 0x3b, 0xae, 0x9b, 0x10, 0xe6, 0xc6, 0x23, 0x0c, 0x28, 0x42, 0x42, 0x0f, 0xfe,
+	0x67, 0x60, 0x46, 0x3c, 0x6c, 0xb4, 0x90, 0x4d, 0x3b, 0x0e, 0x2f, 0x5d, 0xab, 0x66, 0x6b, 0xc5,
+	0xf5, 0xf7, 0x94, 0xd0, 0x54, 0xf1, 0x4b, 0x24, 0xae, 0x48, 0x65, 0x8b, 0x12, 0x67, 0x73, 0xd5,
+	0x77, 0xf7, 0xec, 0x55, 0xa5, 0x36, 0x82, 0x3b, 0xdf, 0x80, 0x69, 0xb3, 0x91, 0x8b, 0x8d, 0xc0,
+	0x83, 0xfc, 0x5a, 0x80, 0x9b, 0x7d, 0xa1, 0x4d, 0xf2, 0x51, 0x81, 0xa2, 0x4b, 0x19, 0xe1, 0x84,
+	0x3a, 0xdd, 0x9c, 0x40, 0x3c, 0xd5, 0x30, 0xc4, 0x07, 0x50, 0xb0, 0x62, 0xab, 0x52, 0xe6, 0xc2,
+	0xfb, 0x6f, 0x38, 0x5c, 0xeb, 0x12, 0x88, 0x3a, 0x4c, 0x47, 0xdb, 0xce, 0x4e, 0x7e, 0xdb, 0x11,
+	0xb5, 0xfc, 0xab, 0x00, 0x62, 0x93, 0x99, 0x1a, 0xb6, 0xe9, 0x3e, 0x3e, 0xbf, 0x9a, 0xfa, 0x42,
+	0x90, 0x39, 0x3b, 0x04, 0xd9, 0x4b, 0x86, 0x40, 0xfe, 0x41, 0x00, 0x69, 0x50, 0x5d, 0x92, 0x90,
+	0x6e, 0x84, 0x84, 0xab, 0x8b, 0xd0, 0xeb, 0x0c, 0x2c, 0x34, 0x99, 0xd9, 0x70, 0x74, 0x0f, 0x23,
+	0x36, 0x89, 0x18, 0x0d, 0xd6, 0x75, 0xf6, 0xaa, 0xeb, 0x5a, 0xfc, 0x16, 0xc0, 0x26, 0x4e, 0xec,
+	0x2f, 0x37, 0x79, 0x7f, 0x05, 0x9b, 0x38, 0x91, 0xaf, 0xfb, 0x90, 0x37, 0x30, 0x32, 0x2c, 0xe2,
+	0xe0, 0xd2, 0xb5, 0xaa, 0x50, 0x2b, 0xae, 0x4b, 0x4a, 0xd8, 0xb4, 0x95, 0xb8, 0x69, 0x2b, 0x8f,
+	0xe3, 0xa6, 0xbd, 0x99, 0x7b, 0xfa, 0xaa, 0x22, 0x68, 0x89, 0x85, 0xfc, 0x87, 0x00, 0x4b, 0xc3,
+	0xe2, 0x9d, 0x64, 0xfd, 0x54, 0x89, 0x09, 0x93, 0xfb, 0xca, 0x32, 0x57, 0x57, 0x43, 0xbf, 0x87,
+	0x35, 0x54, 0xc7, 0x13, 0xab, 0xa1, 0x89, 0x7e, 0x67, 0x6f, 0x51, 0x75, 0xfc, 0x18, 0x56, 0x47,
+	0x1d, 0xa7, 0x55, 0xc7, 0x1b, 0xe9, 0x09, 0xcf, 0x04, 0x00, 0xff, 0xcc, 0xa6, 0x96, 0x85, 0x75,
+	0x3e, 0x7e, 0x16, 0xdf, 0x48, 0x8b, 0x5f, 0x00, 0xb1, 0xab, 0x35, 0x8e, 0x93, 0x6c, 0xc1, 0x7c,
+	0x93, 0x99, 0x8f, 0x3d, 0xe4, 0xb0, 0x5d, 0xec, 0x6d, 0x47, 0x9a, 0xc6, 0xdf, 0xca, 0x12, 0x14,
+	0x3c, 0xac, 0x13, 0x97, 0xe0, 0x60, 0x37, 0xbe, 0x6d, 0x77, 0x42, 0xbe, 0x05, 0x8b, 0x43, 0xbc,
+	0x25, 0x62, 0x7e, 0x11, 0xa0, 0x18, 0x68, 0xb4, 0x5d, 0xda, 0x71, 0x8c, 0xf1, 0x55, 0x3c, 0x82,
+	0xeb, 0x36, 0x3a, 0x6c, 0x31, 0x8b, 0xb8, 0x2e, 0x32, 0xc7, 0xbd, 0xd5, 0x14, 0x6d, 0x74, 0xb8,
+	0x13, 0x51, 0xc8, 0x47, 0x02, 0xcc, 0xf7, 0x68, 0x7b, 0x9b, 0xdb, 0x90, 0x19, 0x54, 0xc2, 0x0e,
+	0xe6, 0x1b, 0x1d, 0x4e, 0x2f, 0x1f, 0xec, 0x12, 0xcc, 0x60, 0x07, 0xb5, 0x2d, 0x6c, 0x04, 0x71,
+	0xce, 0x6b, 0xf1, 0x50, 0x5e, 0x02, 0x69, 0xd0, 0x51, 0x92, 0xed, 0x7f, 0x33, 0xb0, 0xd8, 0xbd,
+	0xf1, 0x7a, 0x64, 0x1f, 0x71, 0xfc, 0x59, 0xf8, 0x06, 0xd8, 0xb6, 0x50, 0x7a, 0x0d, 0x56, 0xa1,
+	0x68, 0x60, 0xa6, 0x7b, 0xc4, 0xf5, 0x05, 0x84, 0x17, 0x2c, 0xad, 0x77, 0x4a, 0x54, 0x61, 0x9e,
+	0x63, 0x9f, 0x08, 0x05, 0xaa, 0x91, 0x61, 0x78, 0x98, 0xb1, 0xa8, 0x1c, 0xc5, 0x9e, 0xa5, 0x8d,
+	0x70, 0x45, 0x6c, 0x83, 0xe8, 0xe1, 0x03, 0xe4, 0x19, 0x2d, 0x64, 0x59, 0x54, 0x0f, 0xd6, 0x58,
+	0xd4, 0x00, 0x57, 0x94, 0x61, 0x2f, 0x1f, 0x25, 0x52, 0xaa, 0x05, 0x66, 0x1b, 0x89, 0xd5, 0x66,
+	0xce, 0x4f, 0x8b, 0x76, 0xc3, 0xeb, 0x9b, 0x67, 0xe2, 0x16, 0x00, 0xe3, 0xc8, 0xe3, 0x2d, 0x4e,
+	0xec, 0x51, 0x5a, 0x5e, 0xde, 0x27, 0x0a, 0xda, 0x5e, 0x21, 0xb0, 0xf3, 0x57, 0xc4, 0x4f, 0x21,
+	0x8f, 0x1d, 0x23, 0xa4, 0x98, 0xbe, 0x00, 0xc5, 0x0c, 0x76, 0x0c, 0x7f, 0x5e, 0xfe, 0x1e, 0xee,
+	0x9c, 0x11, 0xf3, 0xa4, 0xaa, 0x97, 0x61, 0x2e, 0x7a, 0x8e, 0xb5, 0x5c, 0x0b, 0xf5, 0xdc, 0x73,
+	0x67, 0x77, 0xbb, 0xe8, 0x86, 0x21, 0xae, 0xc2, 0x42, 0x82, 0xf3, 0x9f, 0x17, 0x71, 0xa8, 0xc3,
+	0xa4, 0x88, 0x31, 0x98, 0x52, 0x2b, 0x0a, 0xb5, 0xfc, 0x35, 0x94, 0xfd, 0x16, 0x10, 0xe5, 0xe0,
+	0x22, 0x79, 0x1f, 0xa2, 0x29, 0x33, 0x44, 0x93, 0x5c, 0x83, 0xe5, 0xb3, 0x3d, 0xc4, 0xbb, 0x5c,
+	0xff, 0xb3, 0x00, 0xd9, 0x26, 0x33, 0xc5, 0xaf, 0x00, 0x7a, 0x9e, 0x89, 0x77, 0x86, 0x27, 0xfc,
+	0xd4, 0xe3, 0x4c, 0xfa, 0x70, 0x04, 0x50, 0x12, 0x4d, 0x03, 0xae, 0x9f, 0x7a, 0xa4, 0xbd, 0x9f,
+	0x6a, 0xdc, 0x0b, 0x93, 0x56, 0x46, 0x82, 0x25, 0x5e, 0x6c, 0x98, 0xeb, 0xbf, 0xbf, 0xd7, 0x52,
+	0x19, 0xfa, 0x90, 0xd2, 0xea, 0xa8, 0xc8, 0xc4, 0x1d, 0x83, 0x1b, 0x83, 0x97, 0xe1, 0x0f, 0x52,
+	0x69, 0x06, 0xb0, 0xd2, 0xfa, 0xe8, 0xd8, 0x5e, 0xa7, 0x75, 0x3c, 0xba, 0xd3, 0x3a, 0x1e, 0xdd,
+	0x69, 0xfa, 0x5d, 0xe2, 0x73, 0x98, 0x89, 0x8f, 0xf8, 0x6a, 0x7a, 0xda, 0x43, 0x84, 0x54, 0x3b,
+	0x0f, 0x91, 0xd0, 0xba, 0xf0, 0xff, 0x81, 0x73, 0xf7, 0x6e, 0xaa, 0x75, 0x3f, 0x54, 0x5a, 0x1b,
+	0x19, 0x9a, 0x78, 0xfc, 0x02, 0xf2, 0x49, 0xbb, 0xbf, 0x7d, 0x86, 0xce, 0x10, 0x22, 0xdd, 0x3d,
+	0x17, 0xd2, 0x5b, 0x7b, 0xfd, 0xe7, 0x49, 0x7a, 0x20, 0xfa, 0x90, 0xd2, 0xea, 0xa8, 0xc8, 0xc4,
+	0xdd, 0x4f, 0x02, 0x94, 0x52, 0xcf, 0x8d, 0xb5, 0xf3, 0x3e, 0xcd, 0x01, 0x13, 0xe9, 0x93, 0x0b,
+	0x9b, 0x24, 0x52, 0x7e, 0x16, 0x60, 0xf1, 0xac, 0x6e, 0xf6, 0x51, 0x7a, 0x9a, 0xd2, 0xad, 0xa4,
+	0xfb, 0xe3, 0x58, 0xc5, 0x9a, 0x36, 0x1f, 0x1d, 0xfd, 0x53, 0x9e, 0x3a, 0x3a, 0x2e, 0x0b, 0xcf,
+	0x8f, 0xcb, 0xc2, 0xdf, 0xc7, 0x65, 0xe1, 0xe9, 0x49, 0x79, 0xea, 0xf9, 0x49, 0x79, 0xea, 0xc5,
+	0x49, 0x79, 0xea, 0xcb, 0x7b, 0xbd, 0x17, 0x86, 0xc8, 0xcb, 0x8a, 0x83, 0xf9, 0x01, 0xf5, 0xf6,
+	0x92, 0x09, 0x75, 0xff, 0x63, 0xf5, 0x30, 0xf8, 0x79, 0x2e, 0xb8, 0x41, 0xb4, 0xa7, 0x83, 0xe3,
+	0xe5, 0xde, 0x7f, 0x03, 0x00, 0x5d, 0xec, 0x1d, 0xd4, 0x26, 0x14, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -592,7 +1017,12 @@ type MsgClient interface {
 	CreatePool(ctx context.Context, in *MsgCreatePool, opts ...grpc.CallOption) (*MsgCreatePoolResponse, error)
 	AddLiquidity(ctx context.Context, in *MsgAddLiquidity, opts ...grpc.CallOption) (*MsgAddLiquidityResponse, error)
 	RemoveLiquidity(ctx context.Context, in *MsgRemoveLiquidity, opts ...grpc.CallOption) (*MsgRemoveLiquidityResponse, error)
+	IncreaseLiquidity(ctx context.Context, in *MsgIncreaseLiquidity, opts ...grpc.CallOption) (*MsgIncreaseLiquidityResponse, error)
+	DecreaseLiquidity(ctx context.Context, in *MsgDecreaseLiquidity, opts ...grpc.CallOption) (*MsgDecreaseLiquidityResponse, error)
 	Collect(ctx context.Context, in *MsgCollect, opts ...grpc.CallOption) (*MsgCollectResponse, error)
+	TransferPosition(ctx context.Context, in *MsgTransferPosition, opts ...grpc.CallOption) (*MsgTransferPositionResponse, error)
+	Compound(ctx context.Context, in *MsgCompound, opts ...grpc.CallOption) (*MsgCompoundResponse, error)
+	SetAutoCompound(ctx context.Context, in *MsgSetAutoCompound, opts ...grpc.CallOption) (*MsgSetAutoCompoundResponse, error)
 	CreatePrivateFarmingPlan(ctx context.Context, in *MsgCreatePrivateFarmingPlan, opts ...grpc.CallOption) (*MsgCreatePrivateFarmingPlanResponse, error)
 	TerminatePrivateFarmingPlan(ctx context.Context, in *MsgTerminatePrivateFarmingPlan, opts ...grpc.CallOption) (*MsgTerminatePrivateFarmingPlanResponse, error)
 }
@@ -632,6 +1062,24 @@ func (c *msgClient) RemoveLiquidity(ctx context.Context, in *MsgRemoveLiquidity,
 	return out, nil
 }
 
+func (c *msgClient) IncreaseLiquidity(ctx context.Context, in *MsgIncreaseLiquidity, opts ...grpc.CallOption) (*MsgIncreaseLiquidityResponse, error) {
+	out := new(MsgIncreaseLiquidityResponse)
+	err := c.cc.Invoke(ctx, "/crescent.amm.v1beta1.Msg/IncreaseLiquidity", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) DecreaseLiquidity(ctx context.Context, in *MsgDecreaseLiquidity, opts ...grpc.CallOption) (*MsgDecreaseLiquidityResponse, error) {
+	out := new(MsgDecreaseLiquidityResponse)
+	err := c.cc.Invoke(ctx, "/crescent.amm.v1beta1.Msg/DecreaseLiquidity", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) Collect(ctx context.Context, in *MsgCollect, opts ...grpc.CallOption) (*MsgCollectResponse, error) {
 	out := new(MsgCollectResponse)
 	err := c.cc.Invoke(ctx, "/crescent.amm.v1beta1.Msg/Collect", in, out, opts...)
@@ -641,30 +1089,62 @@ func (c *msgClient) Collect(ctx context.Context, in *MsgCollect, opts ...grpc.Ca
 	return out, nil
 }
 
-func (c *msgClient) CreatePrivateFarmingPlan(ctx context.Context, in *MsgCreatePrivateFarmingPlan, opts ...grpc.CallOption) (*MsgCreatePrivateFarmingPlanResponse, error) {
-	out := new(MsgCreatePrivateFarmingPlanResponse)
-	err := c.cc.Invoke(ctx, "/crescent.amm.v1beta1.Msg/CreatePrivateFarmingPlan", in, out, opts...)
+func (c *msgClient) TransferPosition(ctx context.Context, in *MsgTransferPosition, opts ...grpc.CallOption) (*MsgTransferPositionResponse, error) {
+	out := new(MsgTransferPositionResponse)
+	err := c.cc.Invoke(ctx, "/crescent.amm.v1beta1.Msg/TransferPosition", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *msgClient) TerminatePrivateFarmingPlan(ctx context.Context, in *MsgTerminatePrivateFarmingPlan, opts ...grpc.CallOption) (*MsgTerminatePrivateFarmingPlanResponse, error) {
-	out := new(MsgTerminatePrivateFarmingPlanResponse)
-	err := c.cc.Invoke(ctx, "/crescent.amm.v1beta1.Msg/TerminatePrivateFarmingPlan", in, out, opts...)
+func (c *msgClient) Compound(ctx context.Context, in *MsgCompound, opts ...grpc.CallOption) (*MsgCompoundResponse, error) {
+	out := new(MsgCompoundResponse)
+	err := c.cc.Invoke(ctx, "/crescent.amm.v1beta1.Msg/Compound", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-// MsgServer is the server API for Msg service.
-type MsgServer interface {
-	CreatePool(context.Context, *MsgCreatePool) (*MsgCreatePoolResponse, error)
-	AddLiquidity(context.Context, *MsgAddLiquidity) (*MsgAddLiquidityResponse, error)
-	RemoveLiquidity(context.Context, *MsgRemoveLiquidity) (*MsgRemoveLiquidityResponse, error)
+func (c *msgClient) SetAutoCompound(ctx context.Context, in *MsgSetAutoCompound, opts ...grpc.CallOption) (*MsgSetAutoCompoundResponse, error) {
+	out := new(MsgSetAutoCompoundResponse)
+	err := c.cc.Invoke(ctx, "/crescent.amm.v1beta1.Msg/SetAutoCompound", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CreatePrivateFarmingPlan(ctx context.Context, in *MsgCreatePrivateFarmingPlan, opts ...grpc.CallOption) (*MsgCreatePrivateFarmingPlanResponse, error) {
+	out := new(MsgCreatePrivateFarmingPlanResponse)
+	err := c.cc.Invoke(ctx, "/crescent.amm.v1beta1.Msg/CreatePrivateFarmingPlan", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) TerminatePrivateFarmingPlan(ctx context.Context, in *MsgTerminatePrivateFarmingPlan, opts ...grpc.CallOption) (*MsgTerminatePrivateFarmingPlanResponse, error) {
+	out := new(MsgTerminatePrivateFarmingPlanResponse)
+	err := c.cc.Invoke(ctx, "/crescent.amm.v1beta1.Msg/TerminatePrivateFarmingPlan", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	CreatePool(context.Context, *MsgCreatePool) (*MsgCreatePoolResponse, error)
+	AddLiquidity(context.Context, *MsgAddLiquidity) (*MsgAddLiquidityResponse, error)
+	RemoveLiquidity(context.Context, *MsgRemoveLiquidity) (*MsgRemoveLiquidityResponse, error)
+	IncreaseLiquidity(context.Context, *MsgIncreaseLiquidity) (*MsgIncreaseLiquidityResponse, error)
+	DecreaseLiquidity(context.Context, *MsgDecreaseLiquidity) (*MsgDecreaseLiquidityResponse, error)
 	Collect(context.Context, *MsgCollect) (*MsgCollectResponse, error)
+	TransferPosition(context.Context, *MsgTransferPosition) (*MsgTransferPositionResponse, error)
+	Compound(context.Context, *MsgCompound) (*MsgCompoundResponse, error)
+	SetAutoCompound(context.Context, *MsgSetAutoCompound) (*MsgSetAutoCompoundResponse, error)
 	CreatePrivateFarmingPlan(context.Context, *MsgCreatePrivateFarmingPlan) (*MsgCreatePrivateFarmingPlanResponse, error)
 	TerminatePrivateFarmingPlan(context.Context, *MsgTerminatePrivateFarmingPlan) (*MsgTerminatePrivateFarmingPlanResponse, error)
 }
@@ -682,9 +1162,24 @@ func (*UnimplementedMsgServer) AddLiquidity(ctx context.Context, req *MsgAddLiqu
 func (*UnimplementedMsgServer) RemoveLiquidity(ctx context.Context, req *MsgRemoveLiquidity) (*MsgRemoveLiquidityResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RemoveLiquidity not implemented")
 }
+func (*UnimplementedMsgServer) IncreaseLiquidity(ctx context.Context, req *MsgIncreaseLiquidity) (*MsgIncreaseLiquidityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IncreaseLiquidity not implemented")
+}
+func (*UnimplementedMsgServer) DecreaseLiquidity(ctx context.Context, req *MsgDecreaseLiquidity) (*MsgDecreaseLiquidityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DecreaseLiquidity not implemented")
+}
 func (*UnimplementedMsgServer) Collect(ctx context.Context, req *MsgCollect) (*MsgCollectResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Collect not implemented")
 }
+func (*UnimplementedMsgServer) TransferPosition(ctx context.Context, req *MsgTransferPosition) (*MsgTransferPositionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransferPosition not implemented")
+}
+func (*UnimplementedMsgServer) Compound(ctx context.Context, req *MsgCompound) (*MsgCompoundResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Compound not implemented")
+}
+func (*UnimplementedMsgServer) SetAutoCompound(ctx context.Context, req *MsgSetAutoCompound) (*MsgSetAutoCompoundResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetAutoCompound not implemented")
+}
 func (*UnimplementedMsgServer) CreatePrivateFarmingPlan(ctx context.Context, req *MsgCreatePrivateFarmingPlan) (*MsgCreatePrivateFarmingPlanResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreatePrivateFarmingPlan not implemented")
 }
@@ -750,6 +1245,42 @@ func _Msg_RemoveLiquidity_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_IncreaseLiquidity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgIncreaseLiquidity)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).IncreaseLiquidity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/crescent.amm.v1beta1.Msg/IncreaseLiquidity",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).IncreaseLiquidity(ctx, req.(*MsgIncreaseLiquidity))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_DecreaseLiquidity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDecreaseLiquidity)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).DecreaseLiquidity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/crescent.amm.v1beta1.Msg/DecreaseLiquidity",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DecreaseLiquidity(ctx, req.(*MsgDecreaseLiquidity))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_Collect_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgCollect)
 	if err := dec(in); err != nil {
@@ -768,6 +1299,60 @@ func _Msg_Collect_Handler(srv interface{}, ctx context.Context, dec func(interfa
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_TransferPosition_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgTransferPosition)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).TransferPosition(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/crescent.amm.v1beta1.Msg/TransferPosition",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).TransferPosition(ctx, req.(*MsgTransferPosition))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_Compound_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCompound)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).Compound(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/crescent.amm.v1beta1.Msg/Compound",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).Compound(ctx, req.(*MsgCompound))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetAutoCompound_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetAutoCompound)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetAutoCompound(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/crescent.amm.v1beta1.Msg/SetAutoCompound",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetAutoCompound(ctx, req.(*MsgSetAutoCompound))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_CreatePrivateFarmingPlan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgCreatePrivateFarmingPlan)
 	if err := dec(in); err != nil {
@@ -820,10 +1405,30 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "RemoveLiquidity",
 			Handler:    _Msg_RemoveLiquidity_Handler,
 		},
+		{
+			MethodName: "IncreaseLiquidity",
+			Handler:    _Msg_IncreaseLiquidity_Handler,
+		},
+		{
+			MethodName: "DecreaseLiquidity",
+			Handler:    _Msg_DecreaseLiquidity_Handler,
+		},
 		{
 			MethodName: "Collect",
 			Handler:    _Msg_Collect_Handler,
 		},
+		{
+			MethodName: "TransferPosition",
+			Handler:    _Msg_TransferPosition_Handler,
+		},
+		{
+			MethodName: "Compound",
+			Handler:    _Msg_Compound_Handler,
+		},
+		{
+			MethodName: "SetAutoCompound",
+			Handler:    _Msg_SetAutoCompound_Handler,
+		},
 		{
 			MethodName: "CreatePrivateFarmingPlan",
 			Handler:    _Msg_CreatePrivateFarmingPlan_Handler,
@@ -857,6 +1462,11 @@ func (m *MsgCreatePool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.TickSpacing != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TickSpacing))
+		i--
+		dAtA[i] = 0x20
+	}
 	{
 		size := m.Price.Size()
 		i -= size
@@ -1113,7 +1723,7 @@ func (m *MsgRemoveLiquidityResponse) MarshalToSizedBuffer(dAtA []byte) (int, err
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgCollect) Marshal() (dAtA []byte, err error) {
+func (m *MsgIncreaseLiquidity) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1123,20 +1733,44 @@ func (m *MsgCollect) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgCollect) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgIncreaseLiquidity) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgCollect) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgIncreaseLiquidity) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Amount) > 0 {
-		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+	if m.Deadline != nil {
+		n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.Deadline, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.Deadline):])
+		if err1 != nil {
+			return 0, err1
+		}
+		i -= n1
+		i = encodeVarintTx(dAtA, i, uint64(n1))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.MinAmount) > 0 {
+		for iNdEx := len(m.MinAmount) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.MinAmount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.DesiredAmount) > 0 {
+		for iNdEx := len(m.DesiredAmount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DesiredAmount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -1162,7 +1796,7 @@ func (m *MsgCollect) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgCollectResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgIncreaseLiquidityResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1172,20 +1806,44 @@ func (m *MsgCollectResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgCollectResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgIncreaseLiquidityResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgCollectResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgIncreaseLiquidityResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size := m.Liquidity.Size()
+		i -= size
+		if _, err := m.Liquidity.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgCreatePrivateFarmingPlan) Marshal() (dAtA []byte, err error) {
+func (m *MsgDecreaseLiquidity) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1195,36 +1853,30 @@ func (m *MsgCreatePrivateFarmingPlan) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgCreatePrivateFarmingPlan) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgDecreaseLiquidity) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgCreatePrivateFarmingPlan) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgDecreaseLiquidity) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.EndTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.EndTime):])
-	if err1 != nil {
-		return 0, err1
-	}
-	i -= n1
-	i = encodeVarintTx(dAtA, i, uint64(n1))
-	i--
-	dAtA[i] = 0x32
-	n2, err2 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime):])
-	if err2 != nil {
-		return 0, err2
+	if m.Deadline != nil {
+		n2, err2 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.Deadline, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.Deadline):])
+		if err2 != nil {
+			return 0, err2
+		}
+		i -= n2
+		i = encodeVarintTx(dAtA, i, uint64(n2))
+		i--
+		dAtA[i] = 0x2a
 	}
-	i -= n2
-	i = encodeVarintTx(dAtA, i, uint64(n2))
-	i--
-	dAtA[i] = 0x2a
-	if len(m.RewardAllocations) > 0 {
-		for iNdEx := len(m.RewardAllocations) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.MinAmount) > 0 {
+		for iNdEx := len(m.MinAmount) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.RewardAllocations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.MinAmount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -1235,19 +1887,20 @@ func (m *MsgCreatePrivateFarmingPlan) MarshalToSizedBuffer(dAtA []byte) (int, er
 			dAtA[i] = 0x22
 		}
 	}
-	if len(m.TerminationAddress) > 0 {
-		i -= len(m.TerminationAddress)
-		copy(dAtA[i:], m.TerminationAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.TerminationAddress)))
-		i--
-		dAtA[i] = 0x1a
+	{
+		size := m.Liquidity.Size()
+		i -= size
+		if _, err := m.Liquidity.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	if len(m.Description) > 0 {
-		i -= len(m.Description)
-		copy(dAtA[i:], m.Description)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Description)))
+	i--
+	dAtA[i] = 0x1a
+	if m.PositionId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PositionId))
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x10
 	}
 	if len(m.Sender) > 0 {
 		i -= len(m.Sender)
@@ -1259,7 +1912,7 @@ func (m *MsgCreatePrivateFarmingPlan) MarshalToSizedBuffer(dAtA []byte) (int, er
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgCreatePrivateFarmingPlanResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgDecreaseLiquidityResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1269,32 +1922,34 @@ func (m *MsgCreatePrivateFarmingPlanResponse) Marshal() (dAtA []byte, err error)
 	return dAtA[:n], nil
 }
 
-func (m *MsgCreatePrivateFarmingPlanResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgDecreaseLiquidityResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgCreatePrivateFarmingPlanResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgDecreaseLiquidityResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.FarmingPoolAddress) > 0 {
-		i -= len(m.FarmingPoolAddress)
-		copy(dAtA[i:], m.FarmingPoolAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.FarmingPoolAddress)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.FarmingPlanId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.FarmingPlanId))
-		i--
-		dAtA[i] = 0x8
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgTerminatePrivateFarmingPlan) Marshal() (dAtA []byte, err error) {
+func (m *MsgCollect) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1304,18 +1959,32 @@ func (m *MsgTerminatePrivateFarmingPlan) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgTerminatePrivateFarmingPlan) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgCollect) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgTerminatePrivateFarmingPlan) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgCollect) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.FarmingPlanId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.FarmingPlanId))
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.PositionId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PositionId))
 		i--
 		dAtA[i] = 0x10
 	}
@@ -1329,7 +1998,7 @@ func (m *MsgTerminatePrivateFarmingPlan) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgTerminatePrivateFarmingPlanResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgCollectResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1339,12 +2008,12 @@ func (m *MsgTerminatePrivateFarmingPlanResponse) Marshal() (dAtA []byte, err err
 	return dAtA[:n], nil
 }
 
-func (m *MsgTerminatePrivateFarmingPlanResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgCollectResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgTerminatePrivateFarmingPlanResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgCollectResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -1352,236 +2021,2209 @@ func (m *MsgTerminatePrivateFarmingPlanResponse) MarshalToSizedBuffer(dAtA []byt
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgTransferPosition) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MsgCreatePool) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *MsgTransferPosition) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTransferPosition) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	if m.MarketId != 0 {
-		n += 1 + sovTx(uint64(m.MarketId))
+	if m.PositionId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PositionId))
+		i--
+		dAtA[i] = 0x10
 	}
-	l = m.Price.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgCreatePoolResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgTransferPositionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTransferPositionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTransferPositionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.PoolId != 0 {
-		n += 1 + sovTx(uint64(m.PoolId))
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgAddLiquidity) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgCompound) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCompound) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCompound) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	{
+		size := m.MaxSlippage.Size()
+		i -= size
+		if _, err := m.MaxSlippage.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	if m.PoolId != 0 {
-		n += 1 + sovTx(uint64(m.PoolId))
+	i--
+	dAtA[i] = 0x1a
+	if m.PositionId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PositionId))
+		i--
+		dAtA[i] = 0x10
 	}
-	l = m.LowerPrice.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = m.UpperPrice.Size()
-	n += 1 + l + sovTx(uint64(l))
-	if len(m.DesiredAmount) > 0 {
-		for _, e := range m.DesiredAmount {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgAddLiquidityResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgCompoundResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCompoundResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCompoundResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.PositionId != 0 {
-		n += 1 + sovTx(uint64(m.PositionId))
-	}
-	l = m.Liquidity.Size()
-	n += 1 + l + sovTx(uint64(l))
 	if len(m.Amount) > 0 {
-		for _, e := range m.Amount {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
 		}
 	}
-	return n
+	{
+		size := m.Liquidity.Size()
+		i -= size
+		if _, err := m.Liquidity.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgRemoveLiquidity) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgSetAutoCompound) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetAutoCompound) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetAutoCompound) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.Enabled {
+		i--
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
 	}
 	if m.PositionId != 0 {
-		n += 1 + sovTx(uint64(m.PositionId))
+		i = encodeVarintTx(dAtA, i, uint64(m.PositionId))
+		i--
+		dAtA[i] = 0x10
 	}
-	l = m.Liquidity.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgRemoveLiquidityResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgSetAutoCompoundResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetAutoCompoundResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetAutoCompoundResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Amount) > 0 {
-		for _, e := range m.Amount {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgCollect) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgCreatePrivateFarmingPlan) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreatePrivateFarmingPlan) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreatePrivateFarmingPlan) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	n3, err3 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.EndTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.EndTime):])
+	if err3 != nil {
+		return 0, err3
 	}
-	if m.PositionId != 0 {
-		n += 1 + sovTx(uint64(m.PositionId))
+	i -= n3
+	i = encodeVarintTx(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x32
+	n4, err4 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime):])
+	if err4 != nil {
+		return 0, err4
 	}
-	if len(m.Amount) > 0 {
-		for _, e := range m.Amount {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
+	i -= n4
+	i = encodeVarintTx(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0x2a
+	if len(m.RewardAllocations) > 0 {
+		for iNdEx := len(m.RewardAllocations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RewardAllocations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.TerminationAddress) > 0 {
+		i -= len(m.TerminationAddress)
+		copy(dAtA[i:], m.TerminationAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.TerminationAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreatePrivateFarmingPlanResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreatePrivateFarmingPlanResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreatePrivateFarmingPlanResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.FarmingPoolAddress) > 0 {
+		i -= len(m.FarmingPoolAddress)
+		copy(dAtA[i:], m.FarmingPoolAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.FarmingPoolAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.FarmingPlanId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.FarmingPlanId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTerminatePrivateFarmingPlan) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTerminatePrivateFarmingPlan) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTerminatePrivateFarmingPlan) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.FarmingPlanId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.FarmingPlanId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTerminatePrivateFarmingPlanResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTerminatePrivateFarmingPlanResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTerminatePrivateFarmingPlanResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgCreatePool) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.MarketId != 0 {
+		n += 1 + sovTx(uint64(m.MarketId))
+	}
+	l = m.Price.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.TickSpacing != 0 {
+		n += 1 + sovTx(uint64(m.TickSpacing))
+	}
+	return n
+}
+
+func (m *MsgCreatePoolResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovTx(uint64(m.PoolId))
+	}
+	return n
+}
+
+func (m *MsgAddLiquidity) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.PoolId != 0 {
+		n += 1 + sovTx(uint64(m.PoolId))
+	}
+	l = m.LowerPrice.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.UpperPrice.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if len(m.DesiredAmount) > 0 {
+		for _, e := range m.DesiredAmount {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgAddLiquidityResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PositionId != 0 {
+		n += 1 + sovTx(uint64(m.PositionId))
+	}
+	l = m.Liquidity.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgRemoveLiquidity) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.PositionId != 0 {
+		n += 1 + sovTx(uint64(m.PositionId))
+	}
+	l = m.Liquidity.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgRemoveLiquidityResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgIncreaseLiquidity) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.PositionId != 0 {
+		n += 1 + sovTx(uint64(m.PositionId))
+	}
+	if len(m.DesiredAmount) > 0 {
+		for _, e := range m.DesiredAmount {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.MinAmount) > 0 {
+		for _, e := range m.MinAmount {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.Deadline != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.Deadline)
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgIncreaseLiquidityResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Liquidity.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgDecreaseLiquidity) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.PositionId != 0 {
+		n += 1 + sovTx(uint64(m.PositionId))
+	}
+	l = m.Liquidity.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if len(m.MinAmount) > 0 {
+		for _, e := range m.MinAmount {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.Deadline != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.Deadline)
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgDecreaseLiquidityResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgCollect) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.PositionId != 0 {
+		n += 1 + sovTx(uint64(m.PositionId))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgCollectResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgTransferPosition) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.PositionId != 0 {
+		n += 1 + sovTx(uint64(m.PositionId))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgTransferPositionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCompound) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.PositionId != 0 {
+		n += 1 + sovTx(uint64(m.PositionId))
+	}
+	l = m.MaxSlippage.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgCompoundResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Liquidity.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgSetAutoCompound) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.PositionId != 0 {
+		n += 1 + sovTx(uint64(m.PositionId))
+	}
+	if m.Enabled {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgSetAutoCompoundResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCreatePrivateFarmingPlan) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.TerminationAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.RewardAllocations) > 0 {
+		for _, e := range m.RewardAllocations {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime)
+	n += 1 + l + sovTx(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.EndTime)
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgCreatePrivateFarmingPlanResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.FarmingPlanId != 0 {
+		n += 1 + sovTx(uint64(m.FarmingPlanId))
+	}
+	l = len(m.FarmingPoolAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgTerminatePrivateFarmingPlan) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.FarmingPlanId != 0 {
+		n += 1 + sovTx(uint64(m.FarmingPlanId))
+	}
+	return n
+}
+
+func (m *MsgTerminatePrivateFarmingPlanResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgCreatePool) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreatePool: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreatePool: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+			}
+			m.MarketId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MarketId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TickSpacing", wireType)
+			}
+			m.TickSpacing = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TickSpacing |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreatePoolResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreatePoolResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreatePoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAddLiquidity) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddLiquidity: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddLiquidity: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LowerPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LowerPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpperPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.UpperPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DesiredAmount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DesiredAmount = append(m.DesiredAmount, types.Coin{})
+			if err := m.DesiredAmount[len(m.DesiredAmount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAddLiquidityResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddLiquidityResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddLiquidityResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PositionId", wireType)
+			}
+			m.PositionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PositionId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Liquidity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Liquidity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRemoveLiquidity) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRemoveLiquidity: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRemoveLiquidity: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PositionId", wireType)
+			}
+			m.PositionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PositionId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Liquidity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Liquidity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRemoveLiquidityResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRemoveLiquidityResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRemoveLiquidityResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgIncreaseLiquidity) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgIncreaseLiquidity: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgIncreaseLiquidity: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PositionId", wireType)
+			}
+			m.PositionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PositionId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DesiredAmount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DesiredAmount = append(m.DesiredAmount, types.Coin{})
+			if err := m.DesiredAmount[len(m.DesiredAmount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinAmount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MinAmount = append(m.MinAmount, types.Coin{})
+			if err := m.MinAmount[len(m.MinAmount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deadline", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Deadline == nil {
+				m.Deadline = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.Deadline, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgIncreaseLiquidityResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgIncreaseLiquidityResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgIncreaseLiquidityResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Liquidity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Liquidity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDecreaseLiquidity) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDecreaseLiquidity: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDecreaseLiquidity: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PositionId", wireType)
+			}
+			m.PositionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PositionId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Liquidity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Liquidity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinAmount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MinAmount = append(m.MinAmount, types.Coin{})
+			if err := m.MinAmount[len(m.MinAmount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deadline", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Deadline == nil {
+				m.Deadline = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.Deadline, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
 
-func (m *MsgCollectResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
+	return nil
 }
-
-func (m *MsgCreatePrivateFarmingPlan) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Description)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.TerminationAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if len(m.RewardAllocations) > 0 {
-		for _, e := range m.RewardAllocations {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
+func (m *MsgDecreaseLiquidityResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDecreaseLiquidityResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDecreaseLiquidityResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime)
-	n += 1 + l + sovTx(uint64(l))
-	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.EndTime)
-	n += 1 + l + sovTx(uint64(l))
-	return n
-}
-
-func (m *MsgCreatePrivateFarmingPlanResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.FarmingPlanId != 0 {
-		n += 1 + sovTx(uint64(m.FarmingPlanId))
-	}
-	l = len(m.FarmingPoolAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	return n
-}
-
-func (m *MsgTerminatePrivateFarmingPlan) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.FarmingPlanId != 0 {
-		n += 1 + sovTx(uint64(m.FarmingPlanId))
-	}
-	return n
-}
 
-func (m *MsgTerminatePrivateFarmingPlanResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *MsgCreatePool) Unmarshal(dAtA []byte) error {
+func (m *MsgCollect) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1604,10 +4246,10 @@ func (m *MsgCreatePool) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreatePool: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCollect: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreatePool: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCollect: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1644,9 +4286,9 @@ func (m *MsgCreatePool) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PositionId", wireType)
 			}
-			m.MarketId = 0
+			m.PositionId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1656,16 +4298,16 @@ func (m *MsgCreatePool) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MarketId |= uint64(b&0x7F) << shift
+				m.PositionId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1675,23 +4317,23 @@ func (m *MsgCreatePool) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1716,7 +4358,7 @@ func (m *MsgCreatePool) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreatePoolResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgCollectResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1727,43 +4369,24 @@ func (m *MsgCreatePoolResponse) Unmarshal(dAtA []byte) error {
 				return ErrIntOverflowTx
 			}
 			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreatePoolResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreatePoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
-			}
-			m.PoolId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.PoolId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCollectResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCollectResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1785,7 +4408,7 @@ func (m *MsgCreatePoolResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgAddLiquidity) Unmarshal(dAtA []byte) error {
+func (m *MsgTransferPosition) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1808,10 +4431,10 @@ func (m *MsgAddLiquidity) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAddLiquidity: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgTransferPosition: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAddLiquidity: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgTransferPosition: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1848,9 +4471,9 @@ func (m *MsgAddLiquidity) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PositionId", wireType)
 			}
-			m.PoolId = 0
+			m.PositionId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1860,48 +4483,14 @@ func (m *MsgAddLiquidity) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PoolId |= uint64(b&0x7F) << shift
+				m.PositionId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LowerPrice", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.LowerPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UpperPrice", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1929,43 +4518,7 @@ func (m *MsgAddLiquidity) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.UpperPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DesiredAmount", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.DesiredAmount = append(m.DesiredAmount, types.Coin{})
-			if err := m.DesiredAmount[len(m.DesiredAmount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1988,7 +4541,7 @@ func (m *MsgAddLiquidity) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgAddLiquidityResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgTransferPositionResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2011,99 +4564,12 @@ func (m *MsgAddLiquidityResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAddLiquidityResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgTransferPositionResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAddLiquidityResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgTransferPositionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PositionId", wireType)
-			}
-			m.PositionId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.PositionId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Liquidity", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Liquidity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Amount = append(m.Amount, types.Coin{})
-			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2125,7 +4591,7 @@ func (m *MsgAddLiquidityResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRemoveLiquidity) Unmarshal(dAtA []byte) error {
+func (m *MsgCompound) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2148,10 +4614,10 @@ func (m *MsgRemoveLiquidity) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRemoveLiquidity: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCompound: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRemoveLiquidity: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCompound: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2207,7 +4673,7 @@ func (m *MsgRemoveLiquidity) Unmarshal(dAtA []byte) error {
 			}
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Liquidity", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSlippage", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2235,7 +4701,7 @@ func (m *MsgRemoveLiquidity) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Liquidity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.MaxSlippage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2260,7 +4726,7 @@ func (m *MsgRemoveLiquidity) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRemoveLiquidityResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgCompoundResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2283,13 +4749,47 @@ func (m *MsgRemoveLiquidityResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRemoveLiquidityResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCompoundResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRemoveLiquidityResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCompoundResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Liquidity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Liquidity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
@@ -2344,7 +4844,7 @@ func (m *MsgRemoveLiquidityResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCollect) Unmarshal(dAtA []byte) error {
+func (m *MsgSetAutoCompound) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2367,10 +4867,10 @@ func (m *MsgCollect) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCollect: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetAutoCompound: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCollect: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetAutoCompound: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2425,10 +4925,10 @@ func (m *MsgCollect) Unmarshal(dAtA []byte) error {
 				}
 			}
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2438,26 +4938,12 @@ func (m *MsgCollect) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Amount = append(m.Amount, types.Coin{})
-			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+			m.Enabled = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2479,7 +4965,7 @@ func (m *MsgCollect) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCollectResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetAutoCompoundResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2502,10 +4988,10 @@ func (m *MsgCollectResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCollectResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetAutoCompoundResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCollectResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetAutoCompoundResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -16,6 +16,12 @@ func BeginBlocker(ctx sdk.Context, k keeper.Keeper) {
 	if err := k.CancelExpiredOrders(ctx); err != nil {
 		panic(err)
 	}
+	if err := k.CancelExpiredTriggerOrders(ctx); err != nil {
+		panic(err)
+	}
+	if err := k.CancelOrdersAfterTimeout(ctx); err != nil {
+		panic(err)
+	}
 }
 
 func MidBlocker(ctx sdk.Context, k keeper.Keeper) {
@@ -26,7 +32,10 @@ func MidBlocker(ctx sdk.Context, k keeper.Keeper) {
 	// when we receive batch order msgs.
 	var markets []types.Market
 	k.IterateAllMarkets(ctx, func(market types.Market) (stop bool) {
-		markets = append(markets, market)
+		// Orders in inactive markets are not matched.
+		if market.IsActive() {
+			markets = append(markets, market)
+		}
 		return false
 	})
 	for _, market := range markets {
@@ -30,8 +30,15 @@ func GetQueryCmd() *cobra.Command {
 		NewQueryMarketCmd(),
 		NewQueryAllOrdersCmd(),
 		NewQueryOrderCmd(),
+		NewQueryAllTriggerOrdersCmd(),
+		NewQueryTriggerOrderCmd(),
 		NewQueryBestSwapExactAmountInRoutesCmd(),
+		NewQueryBestSwapExactAmountOutRoutesCmd(),
 		NewQueryOrderBookCmd(),
+		NewQueryTWAPCmd(),
+		NewQueryAccountFeeTierCmd(),
+		NewQueryAccountCancelAftersCmd(),
+		NewQueryAccountReferrerStatsCmd(),
 	)
 
 	return cmd
@@ -238,18 +245,116 @@ $ %s query %s order 1
 	return cmd
 }
 
+func NewQueryAllTriggerOrdersCmd() *cobra.Command {
+	const (
+		flagOrderer  = "orderer"
+		flagMarketId = "market-id"
+	)
+	cmd := &cobra.Command{
+		Use:   "trigger-orders",
+		Args:  cobra.NoArgs,
+		Short: "Query all trigger orders",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query all trigger orders.
+
+Example:
+$ %s query %s trigger-orders
+$ %s query %s trigger-orders --orderer=cre1...
+$ %s query %s trigger-orders --market-id=1
+$ %s query %s trigger-orders --orderer=cre1... --market-id=1
+`,
+				version.AppName, types.ModuleName,
+				version.AppName, types.ModuleName,
+				version.AppName, types.ModuleName,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			orderer, _ := cmd.Flags().GetString(flagOrderer)
+			marketId, err := cmd.Flags().GetUint64(flagMarketId)
+			if err != nil {
+				return fmt.Errorf("invalid market id: %w", err)
+			}
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.AllTriggerOrders(cmd.Context(), &types.QueryAllTriggerOrdersRequest{
+				Orderer:    orderer,
+				MarketId:   marketId,
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	cmd.Flags().String(flagOrderer, "", "Query trigger orders placed by an orderer")
+	cmd.Flags().Uint64(flagMarketId, 0, "Query trigger orders in a market")
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "trigger-orders")
+	return cmd
+}
+
+func NewQueryTriggerOrderCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "trigger-order [order-id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query a specific trigger order",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query a specific trigger order by its ID.
+
+Example:
+$ %s query %s trigger-order 1
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			orderId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid order id: %w", err)
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.TriggerOrder(cmd.Context(), &types.QueryTriggerOrderRequest{
+				OrderId: orderId,
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
 func NewQueryBestSwapExactAmountInRoutesCmd() *cobra.Command {
+	const flagMaxSplits = "max-splits"
 	cmd := &cobra.Command{
 		Use:   "best-swap-exact-amount-in-routes [input] [output-denom]",
 		Args:  cobra.ExactArgs(2),
 		Short: "Query the best routes for a swap with exact amount in",
 		Long: strings.TrimSpace(
 			fmt.Sprintf(`Query the best routes for a swap with exact amount in.
+If --max-splits is greater than 1, the input may be split across multiple routes.
 
 Example:
 $ %s query %s best-swap-exact-amount-in-routes 1000000stake uatom
+$ %s query %s best-swap-exact-amount-in-routes 1000000stake uatom --max-splits=3
 `,
 				version.AppName, types.ModuleName,
+				version.AppName, types.ModuleName,
 			),
 		),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -259,10 +364,52 @@ $ %s query %s best-swap-exact-amount-in-routes 1000000stake uatom
 			}
 			input := args[0]
 			outputDenom := args[1]
+			maxSplits, err := cmd.Flags().GetUint32(flagMaxSplits)
+			if err != nil {
+				return err
+			}
 			queryClient := types.NewQueryClient(clientCtx)
 			res, err := queryClient.BestSwapExactAmountInRoutes(cmd.Context(), &types.QueryBestSwapExactAmountInRoutesRequest{
 				Input:       input,
 				OutputDenom: outputDenom,
+				MaxSplits:   maxSplits,
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	cmd.Flags().Uint32(flagMaxSplits, 0, "maximum number of routes the input can be split across")
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func NewQueryBestSwapExactAmountOutRoutesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "best-swap-exact-amount-out-routes [input-denom] [output]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Query the best routes for a swap with exact amount out",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the best routes for a swap with exact amount out.
+
+Example:
+$ %s query %s best-swap-exact-amount-out-routes stake 1000000uatom
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			inputDenom := args[0]
+			output := args[1]
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.BestSwapExactAmountOutRoutes(cmd.Context(), &types.QueryBestSwapExactAmountOutRoutesRequest{
+				InputDenom: inputDenom,
+				Output:     output,
 			})
 			if err != nil {
 				return err
@@ -310,3 +457,151 @@ $ %s query %s order-book 1
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+func NewQueryTWAPCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "twap [market-id] [window]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Query the market's time-weighted average price",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the market's time-weighted average price over the window
+ending at the latest block time.
+
+Example:
+$ %s query %s twap 1 30m
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			marketId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid market id: %w", err)
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.TWAP(cmd.Context(), &types.QueryTWAPRequest{
+				MarketId: marketId,
+				Window:   args[1],
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func NewQueryAccountFeeTierCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "account-fee-tier [address]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the account's fee tier and trailing volume",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the account's current fee tier and trailing 30-day volume.
+
+Example:
+$ %s query %s account-fee-tier cre1...
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.AccountFeeTier(cmd.Context(), &types.QueryAccountFeeTierRequest{
+				Address: args[0],
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func NewQueryAccountCancelAftersCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "account-cancel-afters [address]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the account's cancel-after timers",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the account's cancel-after timers for all markets.
+
+Example:
+$ %s query %s account-cancel-afters cre1...
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.AccountCancelAfters(cmd.Context(), &types.QueryAccountCancelAftersRequest{
+				Address: args[0],
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func NewQueryAccountReferrerStatsCmd() *cobra.Command {
+	const flagMarketId = "market-id"
+	cmd := &cobra.Command{
+		Use:   "account-referrer-stats [address]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the account's accumulated stats as a referrer",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the volume of orders referred by the account and the referral fees
+paid to the account, for each market.
+
+Example:
+$ %s query %s account-referrer-stats cre1...
+$ %s query %s account-referrer-stats cre1... --market-id=1
+`,
+				version.AppName, types.ModuleName,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			marketId, err := cmd.Flags().GetUint64(flagMarketId)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.AccountReferrerStats(cmd.Context(), &types.QueryAccountReferrerStatsRequest{
+				Address:  args[0],
+				MarketId: marketId,
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	cmd.Flags().Uint64(flagMarketId, 0, "Query the stats in a market")
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
@@ -20,6 +20,12 @@ import (
 	"github.com/crescent-network/crescent/v5/x/exchange/types"
 )
 
+const (
+	flagTimeInForce         = "time-in-force"
+	flagSelfTradePrevention = "self-trade-prevention"
+	flagReferrer            = "referrer"
+)
+
 // GetTxCmd returns the transaction commands for the module
 func GetTxCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -40,23 +46,36 @@ func GetTxCmd() *cobra.Command {
 		NewCancelOrderCmd(),
 		NewCancelAllOrdersCmd(),
 		NewSwapExactAmountInCmd(),
+		NewSwapExactAmountOutCmd(),
+		NewPlaceTriggerOrderCmd(),
+		NewAmendOrderCmd(),
+		NewSetCancelAfterCmd(),
+		NewReplaceMMOrdersCmd(),
 	)
 
 	return cmd
 }
 
 func NewCreateMarketCmd() *cobra.Command {
+	const (
+		flagTickSize         = "tick-size"
+		flagMinOrderQuantity = "min-order-quantity"
+		flagLotSize          = "lot-size"
+	)
 	cmd := &cobra.Command{
 		Use:   "create-market [base-denom] [quote-denom]",
 		Args:  cobra.ExactArgs(2),
 		Short: "Create a market",
 		Long: strings.TrimSpace(
 			fmt.Sprintf(`Create a market.
+Optionally, the tick size, min order quantity and lot size of the market can be set.
 
 Example:
 $ %s tx %s create-market uatom stake --from mykey
+$ %s tx %s create-market uatom stake --tick-size=0.001 --min-order-quantity=10000 --lot-size=1000 --from mykey
 `,
 				version.AppName, types.ModuleName,
+				version.AppName, types.ModuleName,
 			),
 		),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -66,15 +85,45 @@ $ %s tx %s create-market uatom stake --from mykey
 			}
 			baseDenom := args[0]
 			quoteDenom := args[1]
-			msg := types.NewMsgCreateMarket(clientCtx.GetFromAddress(), baseDenom, quoteDenom)
+			var tickSize, minOrderQty, lotSize *sdk.Dec
+			tickSizeStr, _ := cmd.Flags().GetString(flagTickSize)
+			if tickSizeStr != "" {
+				d, err := sdk.NewDecFromStr(tickSizeStr)
+				if err != nil {
+					return fmt.Errorf("invalid tick size: %w", err)
+				}
+				tickSize = &d
+			}
+			minOrderQtyStr, _ := cmd.Flags().GetString(flagMinOrderQuantity)
+			if minOrderQtyStr != "" {
+				d, err := sdk.NewDecFromStr(minOrderQtyStr)
+				if err != nil {
+					return fmt.Errorf("invalid min order quantity: %w", err)
+				}
+				minOrderQty = &d
+			}
+			lotSizeStr, _ := cmd.Flags().GetString(flagLotSize)
+			if lotSizeStr != "" {
+				d, err := sdk.NewDecFromStr(lotSizeStr)
+				if err != nil {
+					return fmt.Errorf("invalid lot size: %w", err)
+				}
+				lotSize = &d
+			}
+			msg := types.NewMsgCreateMarket(
+				clientCtx.GetFromAddress(), baseDenom, quoteDenom, tickSize, minOrderQty, lotSize)
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
+	cmd.Flags().String(flagTickSize, "", "Price increment of orders in the market")
+	cmd.Flags().String(flagMinOrderQuantity, "", "Minimum quantity of orders in the market")
+	cmd.Flags().String(flagLotSize, "", "Quantity increment of orders in the market")
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
 
 func NewPlaceLimitOrderCmd() *cobra.Command {
+	const flagDisplayQuantity = "display-quantity"
 	cmd := &cobra.Command{
 		Use:   "place-limit-order [market-id] [is-buy] [price] [quantity] [lifespan]",
 		Args:  cobra.ExactArgs(5),
@@ -82,10 +131,20 @@ func NewPlaceLimitOrderCmd() *cobra.Command {
 		Long: strings.TrimSpace(
 			fmt.Sprintf(`Place a limit order.
 
+Time in force can be one of gtt(good-til-time, default), post-only,
+ioc(immediate-or-cancel) and fok(fill-or-kill).
+
+If --display-quantity is set, the order becomes an iceberg order which displays
+only the display quantity on the order book at a time.
+
 Example:
 $ %s tx %s place-limit-order 1 true 15 100000 1h --from mykey
+$ %s tx %s place-limit-order 1 true 15 100000 0s --time-in-force=ioc --from mykey
+$ %s tx %s place-limit-order 1 true 15 100000 1h --display-quantity=10000 --from mykey
 `,
 				version.AppName, types.ModuleName,
+				version.AppName, types.ModuleName,
+				version.AppName, types.ModuleName,
 			),
 		),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -113,11 +172,35 @@ $ %s tx %s place-limit-order 1 true 15 100000 1h --from mykey
 			if err != nil {
 				return fmt.Errorf("invalid lifespan: %w", err)
 			}
+			timeInForceStr, _ := cmd.Flags().GetString(flagTimeInForce)
+			timeInForce, err := parseTimeInForce(timeInForceStr)
+			if err != nil {
+				return err
+			}
+			selfTradePreventionStr, _ := cmd.Flags().GetString(flagSelfTradePrevention)
+			selfTradePrevention, err := parseSelfTradePrevention(selfTradePreventionStr)
+			if err != nil {
+				return err
+			}
 			msg := types.NewMsgPlaceLimitOrder(
-				clientCtx.GetFromAddress(), marketId, isBuy, price, qty, lifespan)
+				clientCtx.GetFromAddress(), marketId, isBuy, price, qty, lifespan, timeInForce, selfTradePrevention)
+			msg.Referrer, _ = cmd.Flags().GetString(flagReferrer)
+			displayQtyStr, _ := cmd.Flags().GetString(flagDisplayQuantity)
+			if displayQtyStr != "" {
+				displayQty, err := sdk.NewDecFromStr(displayQtyStr)
+				if err != nil {
+					return fmt.Errorf("invalid display quantity: %w", err)
+				}
+				msg.DisplayQuantity = &displayQty
+			}
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
+	cmd.Flags().String(flagTimeInForce, "gtt", "Time in force of the order (gtt|post-only|ioc|fok)")
+	cmd.Flags().String(
+		flagSelfTradePrevention, "", "Self-trade prevention mode of the order (none|cancel-newest|cancel-oldest|cancel-both|decrement-and-cancel); the market's mode is used if empty")
+	cmd.Flags().String(flagReferrer, "", "Address of the referrer receiving a share of the taker fee")
+	cmd.Flags().String(flagDisplayQuantity, "", "Quantity displayed on the order book at a time for an iceberg order")
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
@@ -131,6 +214,8 @@ func NewPlaceBatchLimitOrderCmd() *cobra.Command {
 			fmt.Sprintf(`Place a batch limit order.
 Batch orders are matched prior to normal orders in a batch matching stage.
 
+Time in force can be either gtt(good-til-time, default) or post-only.
+
 Example:
 $ %s tx %s place-batch-limit-order 1 true 15 100000 1h --from mykey
 `,
@@ -162,11 +247,24 @@ $ %s tx %s place-batch-limit-order 1 true 15 100000 1h --from mykey
 			if err != nil {
 				return fmt.Errorf("invalid lifespan: %w", err)
 			}
+			timeInForceStr, _ := cmd.Flags().GetString(flagTimeInForce)
+			timeInForce, err := parseTimeInForce(timeInForceStr)
+			if err != nil {
+				return err
+			}
+			selfTradePreventionStr, _ := cmd.Flags().GetString(flagSelfTradePrevention)
+			selfTradePrevention, err := parseSelfTradePrevention(selfTradePreventionStr)
+			if err != nil {
+				return err
+			}
 			msg := types.NewMsgPlaceBatchLimitOrder(
-				clientCtx.GetFromAddress(), marketId, isBuy, price, qty, lifespan)
+				clientCtx.GetFromAddress(), marketId, isBuy, price, qty, lifespan, timeInForce, selfTradePrevention)
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
+	cmd.Flags().String(flagTimeInForce, "gtt", "Time in force of the order (gtt|post-only)")
+	cmd.Flags().String(
+		flagSelfTradePrevention, "", "Self-trade prevention mode of the order (none|cancel-newest|cancel-oldest|cancel-both|decrement-and-cancel); the market's mode is used if empty")
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
@@ -179,10 +277,15 @@ func NewPlaceMMLimitOrderCmd() *cobra.Command {
 		Long: strings.TrimSpace(
 			fmt.Sprintf(`Place a market maker limit order.
 
+Time in force can be one of gtt(good-til-time, default), post-only,
+ioc(immediate-or-cancel) and fok(fill-or-kill).
+
 Example:
 $ %s tx %s place-mm-limit-order 1 true 15 100000 1h --from mykey
+$ %s tx %s place-mm-limit-order 1 true 15 100000 1h --time-in-force=post-only --from mykey
 `,
 				version.AppName, types.ModuleName,
+				version.AppName, types.ModuleName,
 			),
 		),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -210,11 +313,24 @@ $ %s tx %s place-mm-limit-order 1 true 15 100000 1h --from mykey
 			if err != nil {
 				return fmt.Errorf("invalid lifespan: %w", err)
 			}
+			timeInForceStr, _ := cmd.Flags().GetString(flagTimeInForce)
+			timeInForce, err := parseTimeInForce(timeInForceStr)
+			if err != nil {
+				return err
+			}
+			selfTradePreventionStr, _ := cmd.Flags().GetString(flagSelfTradePrevention)
+			selfTradePrevention, err := parseSelfTradePrevention(selfTradePreventionStr)
+			if err != nil {
+				return err
+			}
 			msg := types.NewMsgPlaceMMLimitOrder(
-				clientCtx.GetFromAddress(), marketId, isBuy, price, qty, lifespan)
+				clientCtx.GetFromAddress(), marketId, isBuy, price, qty, lifespan, timeInForce, selfTradePrevention)
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
+	cmd.Flags().String(flagTimeInForce, "gtt", "Time in force of the order (gtt|post-only|ioc|fok)")
+	cmd.Flags().String(
+		flagSelfTradePrevention, "", "Self-trade prevention mode of the order (none|cancel-newest|cancel-oldest|cancel-both|decrement-and-cancel); the market's mode is used if empty")
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
@@ -228,6 +344,8 @@ func NewPlaceMMBatchLimitOrderCmd() *cobra.Command {
 			fmt.Sprintf(`Place a market maker batch limit order.
 Batch orders are matched prior to normal orders in a batch matching stage.
 
+Time in force can be either gtt(good-til-time, default) or post-only.
+
 Example:
 $ %s tx %s place-mm-batch-limit-order 1 true 15 100000 1h --from mykey
 `,
@@ -259,11 +377,24 @@ $ %s tx %s place-mm-batch-limit-order 1 true 15 100000 1h --from mykey
 			if err != nil {
 				return fmt.Errorf("invalid lifespan: %w", err)
 			}
+			timeInForceStr, _ := cmd.Flags().GetString(flagTimeInForce)
+			timeInForce, err := parseTimeInForce(timeInForceStr)
+			if err != nil {
+				return err
+			}
+			selfTradePreventionStr, _ := cmd.Flags().GetString(flagSelfTradePrevention)
+			selfTradePrevention, err := parseSelfTradePrevention(selfTradePreventionStr)
+			if err != nil {
+				return err
+			}
 			msg := types.NewMsgPlaceMMBatchLimitOrder(
-				clientCtx.GetFromAddress(), marketId, isBuy, price, qty, lifespan)
+				clientCtx.GetFromAddress(), marketId, isBuy, price, qty, lifespan, timeInForce, selfTradePrevention)
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
+	cmd.Flags().String(flagTimeInForce, "gtt", "Time in force of the order (gtt|post-only)")
+	cmd.Flags().String(
+		flagSelfTradePrevention, "", "Self-trade prevention mode of the order (none|cancel-newest|cancel-oldest|cancel-both|decrement-and-cancel); the market's mode is used if empty")
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
@@ -300,9 +431,11 @@ $ %s tx %s place-market-order 1 false 100000 --from mykey
 				return fmt.Errorf("invalid quantity: %w", err)
 			}
 			msg := types.NewMsgPlaceMarketOrder(clientCtx.GetFromAddress(), marketId, isBuy, qty)
+			msg.Referrer, _ = cmd.Flags().GetString(flagReferrer)
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
+	cmd.Flags().String(flagReferrer, "", "Address of the referrer receiving a share of the taker fee")
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
@@ -376,11 +509,123 @@ func NewSwapExactAmountInCmd() *cobra.Command {
 		Short: "Swap with exact amount in",
 		Long: strings.TrimSpace(
 			fmt.Sprintf(`Swap with exact amount in.
+To split the input across multiple routes, separate the routes by "/" and
+append each route's weight after ":".
 
 Example:
 $ %s tx %s swap-exact-amount-in 1,2,3 1000000stake 98000uatom --from mykey
+$ %s tx %s swap-exact-amount-in 1,2,3:0.6/4,5:0.4 1000000stake 98000uatom --from mykey
+`,
+				version.AppName, types.ModuleName,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			input, err := sdk.ParseDecCoin(args[1])
+			if err != nil {
+				return fmt.Errorf("invalid input: %w", err)
+			}
+			minOutput, err := sdk.ParseDecCoin(args[2])
+			if err != nil {
+				return fmt.Errorf("invalid minimum output: %w", err)
+			}
+			var msg *types.MsgSwapExactAmountIn
+			if strings.Contains(args[0], ":") {
+				var weightedRoutes []types.WeightedSwapRoute
+				for _, chunk := range strings.Split(args[0], "/") {
+					routesStr, weightStr, found := strings.Cut(chunk, ":")
+					if !found {
+						return fmt.Errorf("missing weight in routes: %s", chunk)
+					}
+					routes, err := parseRoutes(routesStr)
+					if err != nil {
+						return err
+					}
+					weight, err := sdk.NewDecFromStr(weightStr)
+					if err != nil {
+						return fmt.Errorf("invalid weight: %w", err)
+					}
+					weightedRoutes = append(weightedRoutes, types.NewWeightedSwapRoute(routes, weight))
+				}
+				msg = types.NewMsgSwapExactAmountInWeightedRoutes(clientCtx.GetFromAddress(), weightedRoutes, input, minOutput)
+			} else {
+				routes, err := parseRoutes(args[0])
+				if err != nil {
+					return err
+				}
+				msg = types.NewMsgSwapExactAmountIn(clientCtx.GetFromAddress(), routes, input, minOutput)
+			}
+			msg.Referrer, _ = cmd.Flags().GetString(flagReferrer)
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	cmd.Flags().String(flagReferrer, "", "Address of the referrer receiving a share of the taker fees")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func NewSwapExactAmountOutCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "swap-exact-amount-out [routes] [output] [max-input]",
+		Args:  cobra.ExactArgs(3),
+		Short: "Swap with exact amount out",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Swap with exact amount out.
+
+Example:
+$ %s tx %s swap-exact-amount-out 1,2,3 98000uatom 1000000stake --from mykey
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			routes, err := parseRoutes(args[0])
+			if err != nil {
+				return err
+			}
+			output, err := sdk.ParseDecCoin(args[1])
+			if err != nil {
+				return fmt.Errorf("invalid output: %w", err)
+			}
+			maxInput, err := sdk.ParseDecCoin(args[2])
+			if err != nil {
+				return fmt.Errorf("invalid maximum input: %w", err)
+			}
+			msg := types.NewMsgSwapExactAmountOut(clientCtx.GetFromAddress(), routes, output, maxInput)
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func NewPlaceTriggerOrderCmd() *cobra.Command {
+	const flagPrice = "price"
+	cmd := &cobra.Command{
+		Use:   "place-trigger-order [market-id] [is-buy] [condition] [trigger-price] [quantity] [lifespan]",
+		Args:  cobra.ExactArgs(6),
+		Short: "Place a trigger(stop-loss/take-profit) order",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Place a trigger order which will be converted into a limit order
+or a market order when the market's last price crosses the trigger price.
+Condition must be either "above" or "below".
+If --price is set, a limit order with the price is placed when triggered.
+Otherwise, a market order is placed.
+
+Example:
+$ %s tx %s place-trigger-order 1 false below 4.5 100000 24h --from mykey
+$ %s tx %s place-trigger-order 1 false above 6 100000 24h --price=5.9 --from mykey
 `,
 				version.AppName, types.ModuleName,
+				version.AppName, types.ModuleName,
 			),
 		),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -388,26 +633,218 @@ $ %s tx %s swap-exact-amount-in 1,2,3 1000000stake 98000uatom --from mykey
 			if err != nil {
 				return err
 			}
-			var routes []uint64
+			marketId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid market id: %w", err)
+			}
+			isBuy, err := strconv.ParseBool(args[1])
+			if err != nil {
+				return fmt.Errorf("invalid buy flag: %w", err)
+			}
+			var condition types.TriggerCondition
+			switch strings.ToLower(args[2]) {
+			case "above":
+				condition = types.TriggerConditionPriceAbove
+			case "below":
+				condition = types.TriggerConditionPriceBelow
+			default:
+				return fmt.Errorf("invalid condition: %s", args[2])
+			}
+			triggerPrice, err := sdk.NewDecFromStr(args[3])
+			if err != nil {
+				return fmt.Errorf("invalid trigger price: %w", err)
+			}
+			qty, err := sdk.NewDecFromStr(args[4])
+			if err != nil {
+				return fmt.Errorf("invalid quantity: %w", err)
+			}
+			lifespan, err := time.ParseDuration(args[5])
+			if err != nil {
+				return fmt.Errorf("invalid lifespan: %w", err)
+			}
+			var price *sdk.Dec
+			priceStr, _ := cmd.Flags().GetString(flagPrice)
+			if priceStr != "" {
+				p, err := sdk.NewDecFromStr(priceStr)
+				if err != nil {
+					return fmt.Errorf("invalid price: %w", err)
+				}
+				price = &p
+			}
+			msg := types.NewMsgPlaceTriggerOrder(
+				clientCtx.GetFromAddress(), marketId, isBuy, condition, triggerPrice, price, qty, lifespan)
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	cmd.Flags().String(flagPrice, "", "Limit price of the order placed when triggered")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func NewAmendOrderCmd() *cobra.Command {
+	const (
+		flagPrice    = "price"
+		flagQuantity = "quantity"
+		flagLifespan = "lifespan"
+	)
+	cmd := &cobra.Command{
+		Use:   "amend-order [order-id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Amend an order",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Change the price, quantity or deadline of an existing order.
+At least one of --price, --quantity and --lifespan must be set.
+Quantity is the new total quantity of the order including the executed quantity.
+Reducing the quantity without changing the price keeps the order's priority.
+
+Example:
+$ %s tx %s amend-order 1000 --quantity=50000 --from mykey
+$ %s tx %s amend-order 1000 --price=15.1 --lifespan=1h --from mykey
+`,
+				version.AppName, types.ModuleName,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			orderId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid order id: %w", err)
+			}
+			var price, qty *sdk.Dec
+			priceStr, _ := cmd.Flags().GetString(flagPrice)
+			if priceStr != "" {
+				p, err := sdk.NewDecFromStr(priceStr)
+				if err != nil {
+					return fmt.Errorf("invalid price: %w", err)
+				}
+				price = &p
+			}
+			qtyStr, _ := cmd.Flags().GetString(flagQuantity)
+			if qtyStr != "" {
+				q, err := sdk.NewDecFromStr(qtyStr)
+				if err != nil {
+					return fmt.Errorf("invalid quantity: %w", err)
+				}
+				qty = &q
+			}
+			var lifespan *time.Duration
+			lifespanStr, _ := cmd.Flags().GetString(flagLifespan)
+			if lifespanStr != "" {
+				l, err := time.ParseDuration(lifespanStr)
+				if err != nil {
+					return fmt.Errorf("invalid lifespan: %w", err)
+				}
+				lifespan = &l
+			}
+			msg := types.NewMsgAmendOrder(clientCtx.GetFromAddress(), orderId, price, qty, lifespan)
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	cmd.Flags().String(flagPrice, "", "New price of the order")
+	cmd.Flags().String(flagQuantity, "", "New quantity of the order")
+	cmd.Flags().String(flagLifespan, "", "New lifespan of the order from now")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func NewSetCancelAfterCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-cancel-after [market-ids] [cancel-after]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Set a cancel-after timer for markets",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Set or refresh a cancel-after timer for markets.
+All orders of the sender in the markets are canceled once the block time reaches cancel-after,
+unless the timer is refreshed before it.
+cancel-after is a RFC3339 timestamp, or "none" to remove the timers.
+
+Example:
+$ %s tx %s set-cancel-after 1,2 2023-06-01T00:00:00Z --from mykey
+$ %s tx %s set-cancel-after 1,2 none --from mykey
+`,
+				version.AppName, types.ModuleName,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			var marketIds []uint64
 			for _, chunk := range strings.Split(args[0], ",") {
 				marketId, err := strconv.ParseUint(chunk, 10, 64)
 				if err != nil {
-					return fmt.Errorf("invalid routes: %w", err)
+					return fmt.Errorf("invalid market id: %w", err)
 				}
-				routes = append(routes, marketId)
+				marketIds = append(marketIds, marketId)
 			}
-			input, err := sdk.ParseDecCoin(args[1])
+			var cancelAfter *time.Time
+			if args[1] != "none" {
+				t, err := time.Parse(time.RFC3339, args[1])
+				if err != nil {
+					return fmt.Errorf("invalid cancel after: %w", err)
+				}
+				cancelAfter = &t
+			}
+			msg := types.NewMsgSetCancelAfter(clientCtx.GetFromAddress(), marketIds, cancelAfter)
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func NewReplaceMMOrdersCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "replace-mm-orders [market-id] [orders]...",
+		Args:  cobra.MinimumNArgs(1),
+		Short: "Replace all market maker orders in a market",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Cancel all market maker orders of the sender in a market and place new
+market maker batch orders at once.
+Each order is given in the format of is-buy:price:quantity:lifespan.
+If no orders are given, all market maker orders in the market are canceled.
+
+Example:
+$ %s tx %s replace-mm-orders 1 true:14.9:100000:1h false:15.1:100000:1h --from mykey
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
-				return fmt.Errorf("invalid input: %w", err)
+				return err
 			}
-			minOutput, err := sdk.ParseDecCoin(args[2])
+			marketId, err := strconv.ParseUint(args[0], 10, 64)
 			if err != nil {
-				return fmt.Errorf("invalid minimum output: %w", err)
+				return fmt.Errorf("invalid market id: %w", err)
 			}
-			msg := types.NewMsgSwapExactAmountIn(clientCtx.GetFromAddress(), routes, input, minOutput)
+			selfTradePreventionStr, _ := cmd.Flags().GetString(flagSelfTradePrevention)
+			selfTradePrevention, err := parseSelfTradePrevention(selfTradePreventionStr)
+			if err != nil {
+				return err
+			}
+			var orders []types.MMOrderParameters
+			for _, arg := range args[1:] {
+				order, err := parseMMOrderParameters(arg)
+				if err != nil {
+					return err
+				}
+				order.SelfTradePrevention = selfTradePrevention
+				orders = append(orders, order)
+			}
+			msg := types.NewMsgReplaceMMOrders(clientCtx.GetFromAddress(), marketId, orders)
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
+	cmd.Flags().String(
+		flagSelfTradePrevention, "", "Self-trade prevention mode of the orders (none|cancel-newest|cancel-oldest|cancel-both|decrement-and-cancel); the market's mode is used if empty")
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
@@ -440,10 +877,16 @@ Where proposal.json contains:
       "market_id": "2",
       "maker_fee_rate"": "-0.001",
       "taker_fee_rate": "0.002",
-      "order_source_ratio": "0.8"
+      "order_source_ratio": "0.8",
+      "tick_size": "0.001",
+      "min_order_quantity": "10000",
+      "lot_size": "1000",
+      "self_trade_prevention": "SELF_TRADE_PREVENTION_CANCEL_OLDEST"
     }
   ]
 }
+
+tick_size, min_order_quantity, lot_size and self_trade_prevention are optional and left unchanged if omitted.
 `,
 				version.AppName,
 			),
@@ -476,3 +919,133 @@ Where proposal.json contains:
 	cmd.Flags().String(cli.FlagDeposit, "", "deposit of proposal")
 	return cmd
 }
+
+func NewCmdSubmitMarketStatusChangeProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "market-status-change [proposal-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a market status change proposal",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a market status change proposal along with an initial deposit.
+The proposal details must be supplied via a JSON file.
+
+Example:
+$ %s tx gov submit-proposal market-status-change <path/to/proposal.json> --from=<key_or_address> --deposit=<deposit_amount>
+
+Where proposal.json contains:
+
+{
+  "title": "Market status change",
+  "description": "Delist the market",
+  "changes": [
+    {
+      "market_id": "1",
+      "status": "MARKET_STATUS_DELISTED"
+    }
+  ]
+}
+
+Available statuses: MARKET_STATUS_ACTIVE, MARKET_STATUS_CANCEL_ONLY,
+MARKET_STATUS_HALTED and MARKET_STATUS_DELISTED.
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			depositStr, _ := cmd.Flags().GetString(cli.FlagDeposit)
+			deposit, err := sdk.ParseCoinsNormalized(depositStr)
+			if err != nil {
+				return fmt.Errorf("invalid deposit: %w", err)
+			}
+			var proposal types.MarketStatusChangeProposal
+			bz, err := os.ReadFile(args[0])
+			if err != nil {
+				return fmt.Errorf("read proposal: %w", err)
+			}
+			if err = clientCtx.Codec.UnmarshalJSON(bz, &proposal); err != nil {
+				return fmt.Errorf("unmarshal proposal: %w", err)
+			}
+			msg, err := gov.NewMsgSubmitProposal(&proposal, deposit, clientCtx.GetFromAddress())
+			if err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	cmd.Flags().String(cli.FlagDeposit, "", "deposit of proposal")
+	return cmd
+}
+
+func parseTimeInForce(s string) (types.TimeInForce, error) {
+	switch strings.ToLower(s) {
+	case "gtt":
+		return types.TimeInForceGoodTilTime, nil
+	case "post-only":
+		return types.TimeInForcePostOnly, nil
+	case "ioc":
+		return types.TimeInForceImmediateOrCancel, nil
+	case "fok":
+		return types.TimeInForceFillOrKill, nil
+	default:
+		return 0, fmt.Errorf("invalid time in force: %s", s)
+	}
+}
+
+func parseSelfTradePrevention(s string) (types.SelfTradePrevention, error) {
+	switch strings.ToLower(s) {
+	case "":
+		return types.SelfTradePreventionUnspecified, nil
+	case "none":
+		return types.SelfTradePreventionNone, nil
+	case "cancel-newest":
+		return types.SelfTradePreventionCancelNewest, nil
+	case "cancel-oldest":
+		return types.SelfTradePreventionCancelOldest, nil
+	case "cancel-both":
+		return types.SelfTradePreventionCancelBoth, nil
+	case "decrement-and-cancel":
+		return types.SelfTradePreventionDecrementAndCancel, nil
+	default:
+		return 0, fmt.Errorf("invalid self-trade prevention: %s", s)
+	}
+}
+
+// parseRoutes parses comma-separated market ids.
+func parseRoutes(s string) (routes []uint64, err error) {
+	for _, chunk := range strings.Split(s, ",") {
+		marketId, err := strconv.ParseUint(chunk, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid routes: %w", err)
+		}
+		routes = append(routes, marketId)
+	}
+	return routes, nil
+}
+
+func parseMMOrderParameters(s string) (order types.MMOrderParameters, err error) {
+	chunks := strings.Split(s, ":")
+	if len(chunks) != 4 {
+		return order, fmt.Errorf("invalid order: %s", s)
+	}
+	isBuy, err := strconv.ParseBool(chunks[0])
+	if err != nil {
+		return order, fmt.Errorf("invalid buy flag: %w", err)
+	}
+	price, err := sdk.NewDecFromStr(chunks[1])
+	if err != nil {
+		return order, fmt.Errorf("invalid price: %w", err)
+	}
+	qty, err := sdk.NewDecFromStr(chunks[2])
+	if err != nil {
+		return order, fmt.Errorf("invalid quantity: %w", err)
+	}
+	lifespan, err := time.ParseDuration(chunks[3])
+	if err != nil {
+		return order, fmt.Errorf("invalid lifespan: %w", err)
+	}
+	return types.NewMMOrderParameters(isBuy, price, qty, lifespan, types.SelfTradePreventionUnspecified), nil
+}
@@ -19,4 +19,5 @@ func dummyRESTHandler(client.Context) rest.ProposalRESTHandler {
 
 var (
 	MarketParameterChangeProposalHandler = govclient.NewProposalHandler(cli.NewCmdSubmitMarketParameterChangeProposal, dummyRESTHandler)
+	MarketStatusChangeProposalHandler    = govclient.NewProposalHandler(cli.NewCmdSubmitMarketStatusChangeProposal, dummyRESTHandler)
 )
@@ -41,6 +41,21 @@ func NewHandler(k keeper.Keeper) sdk.Handler {
 		case *types.MsgSwapExactAmountIn:
 			res, err := msgServer.SwapExactAmountIn(sdk.WrapSDKContext(ctx), msg)
 			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgSwapExactAmountOut:
+			res, err := msgServer.SwapExactAmountOut(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgPlaceTriggerOrder:
+			res, err := msgServer.PlaceTriggerOrder(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgAmendOrder:
+			res, err := msgServer.AmendOrder(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgSetCancelAfter:
+			res, err := msgServer.SetCancelAfter(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgReplaceMMOrders:
+			res, err := msgServer.ReplaceMMOrders(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
 		default:
 			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized %s message type: %T", types.ModuleName, msg)
 		}
@@ -52,6 +67,8 @@ func NewProposalHandler(k keeper.Keeper) govtypes.Handler {
 		switch c := content.(type) {
 		case *types.MarketParameterChangeProposal:
 			return keeper.HandleMarketParameterChangeProposal(ctx, k, c)
+		case *types.MarketStatusChangeProposal:
+			return keeper.HandleMarketStatusChangeProposal(ctx, k, c)
 		default:
 			return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized exchange proposal content type: %T", c)
 		}
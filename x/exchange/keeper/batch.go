@@ -3,22 +3,115 @@ package keeper
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	utils "github.com/crescent-network/crescent/v5/types"
 	"github.com/crescent-network/crescent/v5/x/exchange/types"
 )
 
+// RunBatchMatching matches the market's crossed orders and then executes the
+// market's triggered orders.
+// Orders are not matched while the market's circuit breaker is tripped, and
+// the market is reopened with a single price auction at the circuit breaker's
+// end height.
 func (k Keeper) RunBatchMatching(ctx sdk.Context, market types.Market) (err error) {
+	if err = k.matchBatchOrders(ctx, market); err != nil {
+		return err
+	}
+	// Trigger orders left over from the last execution are also triggered here.
+	return k.executeTriggerOrders(ctx, market.Id)
+}
+
+// matchBatchOrders matches the market's crossed orders, considering the
+// market's circuit breaker.
+func (k Keeper) matchBatchOrders(ctx sdk.Context, market types.Market) (err error) {
+	marketState := k.MustGetMarketState(ctx, market.Id)
+	if marketState.IsCircuitBreakerTripped() {
+		if ctx.BlockHeight() < marketState.CircuitBreakerEndHeight {
+			return nil
+		}
+		return k.reopenMarket(ctx, market)
+	}
+	return k.runBatchMatching(ctx, market, false)
+}
+
+// runBatchMatching matches the market's crossed orders. If auction is true
+// or the market has no last price, orders are matched at a single price.
+// Replenished iceberg orders may cross the order book again, so orders are
+// matched in rounds until no iceberg order is replenished, up to
+// types.MaxNumBatchMatchingRounds rounds. The rounds are summarized as a single
+// batch matching.
+func (k Keeper) runBatchMatching(ctx sdk.Context, market types.Market, auction bool) (err error) {
+	marketState := k.MustGetMarketState(ctx, market.Id)
+	lastPrice := marketState.LastPrice
+	var (
+		memOrders []*types.MemOrder
+		matched   bool
+	)
+	for i := 0; i < types.MaxNumBatchMatchingRounds; i++ {
+		var (
+			roundOrders               []*types.MemOrder
+			price                     sdk.Dec
+			roundMatched, replenished bool
+		)
+		roundOrders, price, roundMatched, replenished, err = k.matchBatchRound(
+			ctx, market, auction || lastPrice == nil, lastPrice)
+		if err != nil {
+			return err
+		}
+		memOrders = append(memOrders, roundOrders...)
+		if roundMatched {
+			lastPrice = &price
+			matched = true
+		}
+		if !replenished {
+			break
+		}
+		// The following rounds are continuous matching at the new last price.
+		auction = false
+	}
+	if !matched {
+		return nil
+	}
+
+	summary := newBatchMatchedEvent(market.Id, *lastPrice, memOrders)
+	if err = ctx.EventManager().EmitTypedEvent(&summary); err != nil {
+		return
+	}
+	marketState.LastPrice = lastPrice
+	marketState.LastMatchingHeight = ctx.BlockHeight()
+	marketState.AddVolume(ctx.BlockTime(), summary.MatchedQuantity, summary.MatchedQuote)
+	k.updatePriceObservation(ctx, market.Id, &marketState, *lastPrice)
+	if err = k.checkCircuitBreaker(ctx, market.Id, &marketState); err != nil {
+		return
+	}
+	k.SetMarketState(ctx, market.Id, marketState)
+	if k.hooks != nil {
+		if err = k.hooks.AfterBatchMatched(ctx, market, *lastPrice); err != nil {
+			return
+		}
+	}
+	return nil
+}
+
+// matchBatchRound runs a round of the batch matching and applies the match
+// results. If auction is true, orders are matched at a single price.
+// Otherwise, orders are matched starting from lastPrice.
+// It returns the orders in the round, the last matched price, whether any
+// order has been matched and whether any iceberg order has been replenished.
+func (k Keeper) matchBatchRound(
+	ctx sdk.Context, market types.Market, auction bool,
+	lastPrice *sdk.Dec) (memOrders []*types.MemOrder, price sdk.Dec, matched, replenished bool, err error) {
 	// Find the best buy(bid) and sell(ask) prices to limit the price to load
 	// on the other side.
 	bestBuyPrice, found := k.getBestPrice(ctx, market, true)
 	if !found { // Nothing to match, exit early
-		return nil
+		return
 	}
 	bestSellPrice, found := k.getBestPrice(ctx, market, false)
 	if !found { // Nothing to match, exit early
-		return nil
+		return
 	}
 	if bestBuyPrice.LT(bestSellPrice) {
-		return nil
+		return
 	}
 
 	// Construct order book sides with the price limits we obtained previously.
@@ -32,29 +125,75 @@ func (k Keeper) RunBatchMatching(ctx sdk.Context, market types.Market) (err erro
 		PriceLimit: &bestBuyPrice,
 	}, escrow)
 
-	marketState := k.MustGetMarketState(ctx, market.Id)
-	mCtx := types.NewMatchingContext(market, false)
-	var (
-		lastPrice sdk.Dec
-		matched   bool
-	)
-	if marketState.LastPrice == nil {
-		lastPrice, matched = mCtx.RunSinglePriceAuction(buyObs, sellObs)
+	mCtx := k.newMatchingContext(ctx, market, false)
+	mCtx.PreventSelfTrades(buyObs, sellObs)
+	if auction {
+		price, matched = mCtx.RunSinglePriceAuction(buyObs, sellObs)
 	} else {
-		lastPrice, matched = mCtx.BatchMatchOrderBookSides(buyObs, sellObs, *marketState.LastPrice)
+		price, matched = mCtx.BatchMatchOrderBookSides(buyObs, sellObs, *lastPrice)
 	}
-	// If there was no matching, exit early.
-	if !matched {
+	// If there was no matching nor prevented self-trade, exit early.
+	if !matched && len(mCtx.PreventedSelfTrades()) == 0 {
 		return
 	}
 
 	// Apply the match results.
-	memOrders := append(append(([]*types.MemOrder)(nil), buyObs.Orders()...), sellObs.Orders()...)
-	if err = k.finalizeMatching(ctx, market, memOrders, escrow); err != nil {
+	if err = k.emitPreventedSelfTrades(ctx, mCtx); err != nil {
 		return
 	}
-	marketState.LastPrice = &lastPrice
-	marketState.LastMatchingHeight = ctx.BlockHeight()
-	k.SetMarketState(ctx, market.Id, marketState)
-	return nil
+	memOrders = append(append(([]*types.MemOrder)(nil), buyObs.Orders()...), sellObs.Orders()...)
+	replenished, err = k.finalizeMatching(ctx, market, memOrders, escrow)
+	return
+}
+
+// newBatchMatchedEvent summarizes the matched orders.
+// memOrders may contain the same user order more than once when it has been
+// matched in multiple rounds, but the order is counted as a filled order once.
+// Positive fees are paid in the denom orders receive, while negative fees,
+// which are maker rebates, are paid in the denom orders pay.
+func newBatchMatchedEvent(marketId uint64, clearingPrice sdk.Dec, memOrders []*types.MemOrder) types.EventBatchMatched {
+	event := types.EventBatchMatched{
+		MarketId:            marketId,
+		ClearingPrice:       clearingPrice,
+		MatchedQuantity:     utils.ZeroDec,
+		MatchedQuote:        utils.ZeroDec,
+		BaseFee:             utils.ZeroDec,
+		QuoteFee:            utils.ZeroDec,
+		OrderSourceQuantity: utils.ZeroDec,
+	}
+	filledOrderIds := map[uint64]struct{}{}
+	for _, memOrder := range memOrders {
+		if !memOrder.IsMatched() {
+			continue
+		}
+		if memOrder.Type() == types.UserMemOrder {
+			orderId := memOrder.Order().Id
+			if _, ok := filledOrderIds[orderId]; !ok {
+				filledOrderIds[orderId] = struct{}{}
+				event.NumFilledOrders++
+			}
+		} else {
+			event.NumFilledOrders++
+		}
+		if memOrder.Type() == types.OrderSourceMemOrder {
+			event.OrderSourceQuantity = event.OrderSourceQuantity.Add(memOrder.ExecutedQuantity())
+		}
+		fee := memOrder.Fee()
+		if memOrder.IsBuy() {
+			event.MatchedQuantity = event.MatchedQuantity.Add(memOrder.ExecutedQuantity())
+			event.MatchedQuote = event.MatchedQuote.Add(memOrder.ExecutedQuote())
+			if fee.IsPositive() {
+				event.BaseFee = event.BaseFee.Add(fee)
+			} else {
+				event.QuoteFee = event.QuoteFee.Add(fee)
+			}
+		} else {
+			if fee.IsPositive() {
+				event.QuoteFee = event.QuoteFee.Add(fee)
+			} else {
+				event.BaseFee = event.BaseFee.Add(fee)
+			}
+		}
+	}
+	return event
 }
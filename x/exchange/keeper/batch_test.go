@@ -3,9 +3,12 @@ package keeper_test
 import (
 	"time"
 
+	"github.com/gogo/protobuf/proto"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	utils "github.com/crescent-network/crescent/v5/types"
+	"github.com/crescent-network/crescent/v5/x/exchange/types"
 )
 
 func (s *KeeperTestSuite) TestBatchMatchingEdgecase() {
@@ -47,3 +50,136 @@ func (s *KeeperTestSuite) TestBatchMatchingEdgecase() {
 	order = s.keeper.MustGetOrder(s.Ctx, order.Id)
 	s.AssertEqual(sdk.NewDec(6_000000), order.OpenQuantity)
 }
+
+func (s *KeeperTestSuite) TestBatchMatching_SelfTradePrevention() {
+	for _, tc := range []struct {
+		name       string
+		mode       types.SelfTradePrevention
+		buyOpenQty sdk.Dec
+		buyDeposit sdk.Dec
+	}{
+		{"none", types.SelfTradePreventionNone, sdk.NewDec(2_000000), sdk.NewDec(2_000000)},
+		{"cancel newest", types.SelfTradePreventionCancelNewest, sdk.NewDec(7_000000), sdk.NewDec(7_000000)},
+		{"decrement and cancel", types.SelfTradePreventionDecrementAndCancel, sdk.NewDec(2_000000), sdk.NewDec(2_000000)},
+	} {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			market := s.CreateMarket("ucre", "uusd")
+
+			ordererAddr1 := s.FundedAccount(1, enoughCoins)
+			ordererAddr2 := s.FundedAccount(2, enoughCoins)
+
+			buyOrder := s.PlaceBatchLimitOrder(
+				market.Id, ordererAddr1, true, utils.ParseDec("1"), sdk.NewDec(10_000000), time.Hour)
+			s.PlaceBatchLimitOrder(
+				market.Id, ordererAddr2, false, utils.ParseDec("1"), sdk.NewDec(3_000000), time.Hour)
+			// The newest order's mode is applied.
+			sellOrder, _, err := s.keeper.PlaceBatchLimitOrder(
+				s.Ctx, market.Id, ordererAddr1, false, utils.ParseDec("1"), sdk.NewDec(5_000000), time.Hour,
+				types.TimeInForceGoodTilTime, tc.mode)
+			s.Require().NoError(err)
+
+			s.NextBlock()
+
+			buyOrder = s.keeper.MustGetOrder(s.Ctx, buyOrder.Id)
+			s.AssertEqual(tc.buyOpenQty, buyOrder.OpenQuantity)
+			s.AssertEqual(tc.buyDeposit, buyOrder.RemainingDeposit)
+			_, found := s.keeper.GetOrder(s.Ctx, sellOrder.Id)
+			s.Require().False(found)
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestBatchMatching_Summary() {
+	market := s.CreateMarket("ucre", "uusd")
+
+	mmAddr := s.FundedAccount(1, enoughCoins)
+	s.MakeLastPrice(market.Id, mmAddr, utils.ParseDec("1"))
+
+	ordererAddr1 := s.FundedAccount(2, enoughCoins)
+	ordererAddr2 := s.FundedAccount(3, enoughCoins)
+
+	s.PlaceBatchLimitOrder(
+		market.Id, ordererAddr1, false, utils.ParseDec("1"), sdk.NewDec(5_000000), time.Hour)
+	s.PlaceBatchLimitOrder(
+		market.Id, ordererAddr2, true, utils.ParseDec("1"), sdk.NewDec(3_000000), time.Hour)
+	s.PlaceBatchLimitOrder(
+		market.Id, ordererAddr2, true, utils.ParseDec("1.01"), sdk.NewDec(1_000000), time.Hour)
+
+	s.Ctx = s.Ctx.WithEventManager(sdk.NewEventManager())
+	s.Require().NoError(s.keeper.RunBatchMatching(s.Ctx, market))
+	s.CheckEvent(&types.EventBatchMatched{}, map[string][]byte{
+		"market_id":             []byte(`"1"`),
+		"clearing_price":        []byte(`"1.000000000000000000"`),
+		"matched_quantity":      []byte(`"4000000.000000000000000000"`),
+		"matched_quote":         []byte(`"4000000.000000000000000000"`),
+		"base_fee":              []byte(`"12000.000000000000000000"`),
+		"quote_fee":             []byte(`"12000.000000000000000000"`),
+		"num_filled_orders":     []byte(`3`),
+		"order_source_quantity": []byte(`"0.000000000000000000"`),
+	})
+
+	resp, err := s.querier.Market(sdk.WrapSDKContext(s.Ctx), &types.QueryMarketRequest{MarketId: market.Id})
+	s.Require().NoError(err)
+	// Volume from the continuous matching in MakeLastPrice is included.
+	s.AssertEqual(sdk.NewDec(4_010000), resp.Market.Volume24h.BaseVolume)
+	s.AssertEqual(sdk.NewDec(4_010000), resp.Market.Volume24h.QuoteVolume)
+
+	s.Ctx = s.Ctx.WithBlockTime(s.Ctx.BlockTime().Add(23 * time.Hour))
+	s.MakeLastPrice(market.Id, mmAddr, utils.ParseDec("1"))
+	resp, err = s.querier.Market(sdk.WrapSDKContext(s.Ctx), &types.QueryMarketRequest{MarketId: market.Id})
+	s.Require().NoError(err)
+	s.AssertEqual(sdk.NewDec(4_020000), resp.Market.Volume24h.BaseVolume)
+
+	// Volumes older than 24 hours are excluded.
+	s.Ctx = s.Ctx.WithBlockTime(s.Ctx.BlockTime().Add(2 * time.Hour))
+	resp, err = s.querier.Market(sdk.WrapSDKContext(s.Ctx), &types.QueryMarketRequest{MarketId: market.Id})
+	s.Require().NoError(err)
+	s.AssertEqual(sdk.NewDec(10000), resp.Market.Volume24h.BaseVolume)
+}
+
+func (s *KeeperTestSuite) TestBatchMatching_IcebergReplenishment() {
+	market := s.CreateMarket("ucre", "uusd")
+
+	mmAddr := s.FundedAccount(1, enoughCoins)
+	s.MakeLastPrice(market.Id, mmAddr, utils.ParseDec("1"))
+
+	icebergAddr := s.FundedAccount(2, enoughCoins)
+	ordererAddr := s.FundedAccount(3, enoughCoins)
+
+	_, icebergOrder, _ := s.PlaceIcebergOrder(
+		market.Id, icebergAddr, false, utils.ParseDec("1"), sdk.NewDec(20_000000), sdk.NewDec(1_000000), time.Hour)
+	s.NextBlock()
+	buyOrder := s.PlaceBatchLimitOrder(
+		market.Id, ordererAddr, true, utils.ParseDec("1"), sdk.NewDec(15_000000), time.Hour)
+
+	s.Ctx = s.Ctx.WithEventManager(sdk.NewEventManager())
+	s.Require().NoError(s.keeper.RunBatchMatching(s.Ctx, market))
+
+	// The iceberg order is replenished in every round, but the number of
+	// rounds is limited.
+	icebergOrder = s.keeper.MustGetOrder(s.Ctx, icebergOrder.Id)
+	s.AssertEqual(sdk.NewDec(10_000000), icebergOrder.OpenQuantity)
+	buyOrder = s.keeper.MustGetOrder(s.Ctx, buyOrder.Id)
+	s.AssertEqual(sdk.NewDec(5_000000), buyOrder.OpenQuantity)
+
+	// The rounds are summarized as a single batch matching.
+	numEvents := 0
+	for _, ev := range s.Ctx.EventManager().ABCIEvents() {
+		if ev.Type == proto.MessageName(&types.EventBatchMatched{}) {
+			numEvents++
+		}
+	}
+	s.Require().Equal(1, numEvents)
+	s.CheckEvent(&types.EventBatchMatched{}, map[string][]byte{
+		"market_id":         []byte(`"1"`),
+		"clearing_price":    []byte(`"1.000000000000000000"`),
+		"matched_quantity":  []byte(`"10000000.000000000000000000"`),
+		"matched_quote":     []byte(`"10000000.000000000000000000"`),
+		"num_filled_orders": []byte(`2`),
+	})
+	resp, err := s.querier.Market(sdk.WrapSDKContext(s.Ctx), &types.QueryMarketRequest{MarketId: market.Id})
+	s.Require().NoError(err)
+	// Volume from the continuous matching in MakeLastPrice is included.
+	s.AssertEqual(sdk.NewDec(10_010000), resp.Market.Volume24h.BaseVolume)
+}
@@ -0,0 +1,95 @@
+package keeper
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/crescent-network/crescent/v5/x/exchange/types"
+)
+
+// SetCancelAfter sets or refreshes the orderer's cancel-after timers for the
+// markets.
+// When the block time reaches cancelAfter, all orders of the orderer in the
+// markets are canceled.
+// If cancelAfter is nil, the timers are removed.
+func (k Keeper) SetCancelAfter(
+	ctx sdk.Context, ordererAddr sdk.AccAddress, marketIds []uint64, cancelAfter *time.Time) error {
+	for _, marketId := range marketIds {
+		if found := k.LookupMarket(ctx, marketId); !found {
+			return sdkerrors.Wrapf(sdkerrors.ErrNotFound, "market %d not found", marketId)
+		}
+	}
+	if cancelAfter != nil && !cancelAfter.After(ctx.BlockTime()) {
+		return sdkerrors.Wrapf(
+			sdkerrors.ErrInvalidRequest, "cancel after must be after the current block time: %s <= %s",
+			cancelAfter, ctx.BlockTime())
+	}
+	for _, marketId := range marketIds {
+		if cancelAfter != nil {
+			k.SetCancelAfterTime(ctx, ordererAddr, marketId, *cancelAfter)
+		} else {
+			k.DeleteCancelAfterTime(ctx, ordererAddr, marketId)
+		}
+	}
+	return ctx.EventManager().EmitTypedEvent(&types.EventSetCancelAfter{
+		Orderer:     ordererAddr.String(),
+		MarketIds:   marketIds,
+		CancelAfter: cancelAfter,
+	})
+}
+
+// CancelOrdersAfterTimeout cancels all orders of orderers whose cancel-after
+// timers have been reached.
+func (k Keeper) CancelOrdersAfterTimeout(ctx sdk.Context) error {
+	type timer struct {
+		ordererAddr sdk.AccAddress
+		marketId    uint64
+	}
+	blockTime := ctx.BlockTime()
+	var timers []timer
+	k.IterateAllCancelAfters(ctx, func(ordererAddr sdk.AccAddress, marketId uint64, cancelAfter time.Time) (stop bool) {
+		if !blockTime.Before(cancelAfter) {
+			timers = append(timers, timer{ordererAddr, marketId})
+		}
+		return false
+	})
+	for _, t := range timers {
+		market, found := k.GetMarket(ctx, t.marketId)
+		if !found { // sanity check
+			panic("market not found")
+		}
+		// Orders in a halted market are frozen until the market is resumed,
+		// so the timer is kept.
+		if market.Status == types.MarketStatusHalted {
+			continue
+		}
+		var orders []types.Order
+		k.IterateOrdersByOrdererAndMarket(ctx, t.ordererAddr, market.Id, func(order types.Order) (stop bool) {
+			orders = append(orders, order)
+			return false
+		})
+		var cancelledOrderIds []uint64
+		for _, order := range orders {
+			if err := k.cancelOrder(ctx, market, order); err != nil {
+				return err
+			}
+			if k.hooks != nil {
+				if err := k.hooks.AfterOrderCanceled(ctx, order); err != nil {
+					return err
+				}
+			}
+			cancelledOrderIds = append(cancelledOrderIds, order.Id)
+		}
+		k.DeleteCancelAfterTime(ctx, t.ordererAddr, market.Id)
+		if err := ctx.EventManager().EmitTypedEvent(&types.EventCancelAfterTriggered{
+			Orderer:           t.ordererAddr.String(),
+			MarketId:          market.Id,
+			CancelledOrderIds: cancelledOrderIds,
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,89 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	utils "github.com/crescent-network/crescent/v5/types"
+	"github.com/crescent-network/crescent/v5/x/exchange/types"
+)
+
+func (s *KeeperTestSuite) TestCancelAfter() {
+	market1 := s.CreateMarket("ucre", "uusd")
+	market2 := s.CreateMarket("uatom", "uusd")
+
+	mmAddr := s.FundedAccount(1, enoughCoins)
+	s.PlaceMMLimitOrder(market1.Id, mmAddr, true, utils.ParseDec("4.9"), sdk.NewDec(10_000000), time.Hour)
+	s.PlaceMMLimitOrder(market1.Id, mmAddr, false, utils.ParseDec("5.1"), sdk.NewDec(10_000000), time.Hour)
+	_, market2Order, _ := s.PlaceMMLimitOrder(
+		market2.Id, mmAddr, true, utils.ParseDec("9.9"), sdk.NewDec(10_000000), time.Hour)
+
+	cancelAfter := s.Ctx.BlockTime().Add(10 * time.Second)
+	s.SetCancelAfter(mmAddr, []uint64{market1.Id}, &cancelAfter)
+	t, found := s.keeper.GetCancelAfterTime(s.Ctx, mmAddr, market1.Id)
+	s.Require().True(found)
+	s.Require().Equal(cancelAfter, t)
+
+	s.NextBlock()
+	// The timer hasn't been reached yet.
+	numMMOrders, _ := s.keeper.GetNumMMOrders(s.Ctx, mmAddr, market1.Id)
+	s.Require().EqualValues(2, numMMOrders)
+
+	// Refresh the timer.
+	cancelAfter = s.Ctx.BlockTime().Add(10 * time.Second)
+	s.SetCancelAfter(mmAddr, []uint64{market1.Id}, &cancelAfter)
+	s.NextBlock()
+	numMMOrders, _ = s.keeper.GetNumMMOrders(s.Ctx, mmAddr, market1.Id)
+	s.Require().EqualValues(2, numMMOrders)
+
+	s.NextBlock()
+	// All orders in market 1 have been canceled.
+	_, found = s.keeper.GetNumMMOrders(s.Ctx, mmAddr, market1.Id)
+	s.Require().False(found)
+	s.keeper.IterateOrdersByOrdererAndMarket(s.Ctx, mmAddr, market1.Id, func(order types.Order) (stop bool) {
+		s.Fail("order must have been canceled")
+		return false
+	})
+	_, found = s.keeper.GetCancelAfterTime(s.Ctx, mmAddr, market1.Id)
+	s.Require().False(found)
+
+	// Orders in other markets are untouched.
+	_, found = s.keeper.GetOrder(s.Ctx, market2Order.Id)
+	s.Require().True(found)
+	numMMOrders, _ = s.keeper.GetNumMMOrders(s.Ctx, mmAddr, market2.Id)
+	s.Require().EqualValues(1, numMMOrders)
+}
+
+func (s *KeeperTestSuite) TestCancelAfter_Remove() {
+	market := s.CreateMarket("ucre", "uusd")
+
+	mmAddr := s.FundedAccount(1, enoughCoins)
+	orderId, _, _ := s.PlaceMMLimitOrder(
+		market.Id, mmAddr, true, utils.ParseDec("4.9"), sdk.NewDec(10_000000), time.Hour)
+
+	cancelAfter := s.Ctx.BlockTime().Add(5 * time.Second)
+	s.SetCancelAfter(mmAddr, []uint64{market.Id}, &cancelAfter)
+	s.SetCancelAfter(mmAddr, []uint64{market.Id}, nil)
+	_, found := s.keeper.GetCancelAfterTime(s.Ctx, mmAddr, market.Id)
+	s.Require().False(found)
+
+	s.NextBlock()
+	s.NextBlock()
+	_, found = s.keeper.GetOrder(s.Ctx, orderId)
+	s.Require().True(found)
+}
+
+func (s *KeeperTestSuite) TestSetCancelAfter_Errors() {
+	market := s.CreateMarket("ucre", "uusd")
+	ordererAddr := utils.TestAddress(1)
+
+	cancelAfter := s.Ctx.BlockTime().Add(time.Hour)
+	err := s.keeper.SetCancelAfter(s.Ctx, ordererAddr, []uint64{market.Id, 10}, &cancelAfter)
+	s.Require().EqualError(err, "market 10 not found: not found")
+
+	past := s.Ctx.BlockTime()
+	err = s.keeper.SetCancelAfter(s.Ctx, ordererAddr, []uint64{market.Id}, &past)
+	s.Require().ErrorIs(err, sdkerrors.ErrInvalidRequest)
+}
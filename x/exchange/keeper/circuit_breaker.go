@@ -0,0 +1,127 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/crescent-network/crescent/v5/x/exchange/types"
+)
+
+// iterateCircuitBreakerRefPrices iterates through the market's prices the
+// last price is compared against, from the latest to the oldest.
+// Those are the prices observed within the window, including the price in
+// effect at the start of the window, except for prices observed in the
+// current block or before the market was last reopened.
+func (k Keeper) iterateCircuitBreakerRefPrices(
+	ctx sdk.Context, marketId uint64, marketState types.MarketState, window uint32,
+	cb func(price sdk.Dec) (stop bool)) {
+	startHeight := ctx.BlockHeight() - int64(window)
+	for i := int(marketState.NumPriceObservations) - 1; i >= 0; i-- {
+		obs := k.MustGetPriceObservation(ctx, marketId, types.PriceObservationIndex(marketState, uint32(i)))
+		if obs.Height < marketState.CircuitBreakerReopenHeight {
+			break
+		}
+		if obs.Height >= ctx.BlockHeight() {
+			continue
+		}
+		if cb(obs.Price) || obs.Height <= startHeight {
+			break
+		}
+	}
+}
+
+// CircuitBreakerPriceBand returns the range of prices the market's last price
+// can move within without tripping the circuit breaker.
+// found is false if the circuit breaker is disabled or there's no price to
+// compare against.
+func (k Keeper) CircuitBreakerPriceBand(
+	ctx sdk.Context, marketId uint64, marketState types.MarketState) (minPrice, maxPrice sdk.Dec, found bool) {
+	cb := k.GetCircuitBreaker(ctx)
+	if !cb.IsEnabled() {
+		return
+	}
+	k.iterateCircuitBreakerRefPrices(ctx, marketId, marketState, cb.Window, func(price sdk.Dec) (stop bool) {
+		bandMinPrice, bandMaxPrice := cb.PriceBand(price)
+		if !found || bandMinPrice.GT(minPrice) {
+			minPrice = bandMinPrice
+		}
+		if !found || bandMaxPrice.LT(maxPrice) {
+			maxPrice = bandMaxPrice
+		}
+		found = true
+		return false
+	})
+	return
+}
+
+// checkCircuitBreaker trips the market's circuit breaker if the market's last
+// price has moved beyond the price band around any of the prices within the
+// window.
+// The caller must save the market state afterwards.
+func (k Keeper) checkCircuitBreaker(ctx sdk.Context, marketId uint64, marketState *types.MarketState) error {
+	cb := k.GetCircuitBreaker(ctx)
+	if !cb.IsEnabled() || marketState.LastPrice == nil || marketState.IsCircuitBreakerTripped() {
+		return nil
+	}
+	lastPrice := *marketState.LastPrice
+	var (
+		refPrice sdk.Dec
+		tripped  bool
+	)
+	k.iterateCircuitBreakerRefPrices(ctx, marketId, *marketState, cb.Window, func(price sdk.Dec) (stop bool) {
+		minPrice, maxPrice := cb.PriceBand(price)
+		if lastPrice.LT(minPrice) || lastPrice.GT(maxPrice) {
+			refPrice = price
+			tripped = true
+			return true
+		}
+		return false
+	})
+	if !tripped {
+		return nil
+	}
+	marketState.CircuitBreakerEndHeight = ctx.BlockHeight() + int64(cb.Duration)
+	return ctx.EventManager().EmitTypedEvent(&types.EventCircuitBreakerTripped{
+		MarketId:       marketId,
+		ReferencePrice: refPrice,
+		LastPrice:      lastPrice,
+		EndHeight:      marketState.CircuitBreakerEndHeight,
+	})
+}
+
+// validateCircuitBreaker returns an error if the market's circuit breaker is
+// tripped in the cancel-only mode, or if allowInAuction is false.
+// It returns whether the market is waiting for the reopening auction, in
+// which case new orders must not be executed immediately.
+func (k Keeper) validateCircuitBreaker(
+	ctx sdk.Context, market types.Market, allowInAuction bool) (inAuction bool, err error) {
+	marketState := k.MustGetMarketState(ctx, market.Id)
+	if !marketState.IsCircuitBreakerTripped() {
+		return false, nil
+	}
+	if allowInAuction && k.GetCircuitBreaker(ctx).Mode == types.CircuitBreakerModeAuction {
+		return true, nil
+	}
+	return false, sdkerrors.Wrapf(
+		types.ErrCircuitBreakerTripped, "market %d reopens at height %d",
+		market.Id, marketState.CircuitBreakerEndHeight)
+}
+
+// reopenMarket resets the market's tripped circuit breaker and reopens the
+// market with a single price auction.
+func (k Keeper) reopenMarket(ctx sdk.Context, market types.Market) error {
+	// Reset the circuit breaker first so that the prices observed before
+	// reopening don't trip it again.
+	marketState := k.MustGetMarketState(ctx, market.Id)
+	marketState.CircuitBreakerEndHeight = 0
+	marketState.CircuitBreakerReopenHeight = ctx.BlockHeight()
+	k.SetMarketState(ctx, market.Id, marketState)
+	if err := k.runBatchMatching(ctx, market, true); err != nil {
+		return err
+	}
+	marketState = k.MustGetMarketState(ctx, market.Id)
+	return ctx.EventManager().EmitTypedEvent(&types.EventCircuitBreakerReset{
+		MarketId:  market.Id,
+		LastPrice: marketState.LastPrice,
+	})
+}
@@ -0,0 +1,124 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	utils "github.com/crescent-network/crescent/v5/types"
+	"github.com/crescent-network/crescent/v5/x/exchange/types"
+)
+
+func (s *KeeperTestSuite) TestCircuitBreaker() {
+	s.keeper.SetCircuitBreaker(s.Ctx, types.CircuitBreaker{
+		PriceBandRatio: utils.ParseDec("0.1"),
+		Window:         10,
+		Duration:       3,
+		Mode:           types.CircuitBreakerModeAuction,
+	})
+	market := s.CreateMarket("ucre", "uusd")
+	mmAddr := s.FundedAccount(1, enoughCoins)
+	ordererAddr1 := s.FundedAccount(2, enoughCoins)
+	ordererAddr2 := s.FundedAccount(3, enoughCoins)
+
+	s.MakeLastPrice(market.Id, mmAddr, utils.ParseDec("5"))
+	s.NextBlock()
+
+	resp, err := s.querier.Market(sdk.WrapSDKContext(s.Ctx), &types.QueryMarketRequest{MarketId: market.Id})
+	s.Require().NoError(err)
+	s.Require().Equal("4.500000000000000000", resp.Market.MinBandPrice.String())
+	s.Require().Equal("5.500000000000000000", resp.Market.MaxBandPrice.String())
+	s.Require().EqualValues(0, resp.Market.CircuitBreakerEndHeight)
+
+	// The price moves 8% within a block, which is within the price band.
+	s.MakeLastPrice(market.Id, mmAddr, utils.ParseDec("4.6"))
+	s.NextBlock()
+
+	// The price moves only 4.3% from the last price, but 12% from the price
+	// within the window.
+	s.Ctx = s.Ctx.WithEventManager(sdk.NewEventManager())
+	s.MakeLastPrice(market.Id, mmAddr, utils.ParseDec("4.4"))
+	endHeight := s.Ctx.BlockHeight() + 3
+	s.CheckEvent(&types.EventCircuitBreakerTripped{}, map[string][]byte{
+		"market_id":       []byte(`"1"`),
+		"reference_price": []byte(`"5.000000000000000000"`),
+		"last_price":      []byte(`"4.400000000000000000"`),
+	})
+	marketState := s.keeper.MustGetMarketState(s.Ctx, market.Id)
+	s.Require().True(marketState.IsCircuitBreakerTripped())
+	s.Require().Equal(endHeight, marketState.CircuitBreakerEndHeight)
+
+	// Orders cannot be executed immediately until the market is reopened.
+	_, _, err = s.keeper.PlaceMarketOrder(s.Ctx, market.Id, ordererAddr1, true, sdk.NewDec(1000), nil)
+	s.Require().ErrorIs(err, types.ErrCircuitBreakerTripped)
+	_, _, _, _, err = s.keeper.PlaceLimitOrder(
+		s.Ctx, market.Id, ordererAddr1, true, utils.ParseDec("4.4"), sdk.NewDec(1000), nil, 0,
+		types.TimeInForceImmediateOrCancel, types.SelfTradePreventionUnspecified, nil)
+	s.Require().Error(err)
+	_, _, err = s.keeper.SwapExactAmountIn(
+		s.Ctx, ordererAddr1, []uint64{market.Id}, utils.ParseDecCoin("1000uusd"), utils.ParseDecCoin("0ucre"), nil, false)
+	s.Require().ErrorIs(err, types.ErrCircuitBreakerTripped)
+
+	// Limit orders are placed without being executed.
+	_, order1, res := s.PlaceLimitOrder(market.Id, ordererAddr1, true, utils.ParseDec("4.5"), sdk.NewDec(1000), time.Hour)
+	s.Require().False(res.Executed())
+	_, order2, res := s.PlaceLimitOrder(market.Id, ordererAddr2, false, utils.ParseDec("4.3"), sdk.NewDec(1000), time.Hour)
+	s.Require().False(res.Executed())
+
+	s.NextBlock()
+	s.NextBlock()
+	s.Require().Less(s.Ctx.BlockHeight(), endHeight)
+	order1 = s.keeper.MustGetOrder(s.Ctx, order1.Id)
+	s.Require().Equal(sdk.NewDec(1000), order1.OpenQuantity)
+
+	// The market is reopened with a single price auction.
+	s.NextBlock()
+	s.Require().Equal(endHeight, s.Ctx.BlockHeight())
+	_, found := s.keeper.GetOrder(s.Ctx, order1.Id)
+	s.Require().False(found)
+	_, found = s.keeper.GetOrder(s.Ctx, order2.Id)
+	s.Require().False(found)
+	marketState = s.keeper.MustGetMarketState(s.Ctx, market.Id)
+	s.Require().False(marketState.IsCircuitBreakerTripped())
+	s.Require().Equal(endHeight, marketState.CircuitBreakerReopenHeight)
+	s.Require().Equal("4.400000000000000000", marketState.LastPrice.String())
+
+	// Prices observed before reopening don't trip the circuit breaker again.
+	s.MakeLastPrice(market.Id, mmAddr, utils.ParseDec("4.45"))
+	marketState = s.keeper.MustGetMarketState(s.Ctx, market.Id)
+	s.Require().False(marketState.IsCircuitBreakerTripped())
+}
+
+func (s *KeeperTestSuite) TestCircuitBreaker_CancelOnly() {
+	s.keeper.SetCircuitBreaker(s.Ctx, types.CircuitBreaker{
+		PriceBandRatio: utils.ParseDec("0.05"),
+		Window:         10,
+		Duration:       2,
+		Mode:           types.CircuitBreakerModeCancelOnly,
+	})
+	market := s.CreateMarket("ucre", "uusd")
+	mmAddr := s.FundedAccount(1, enoughCoins)
+	ordererAddr := s.FundedAccount(2, enoughCoins)
+
+	s.MakeLastPrice(market.Id, mmAddr, utils.ParseDec("5"))
+	_, order, _ := s.PlaceLimitOrder(market.Id, ordererAddr, true, utils.ParseDec("4.9"), sdk.NewDec(1000), time.Hour)
+	s.NextBlock()
+	s.MakeLastPrice(market.Id, mmAddr, utils.ParseDec("5.3"))
+	marketState := s.keeper.MustGetMarketState(s.Ctx, market.Id)
+	s.Require().True(marketState.IsCircuitBreakerTripped())
+
+	_, _, _, _, err := s.keeper.PlaceLimitOrder(
+		s.Ctx, market.Id, ordererAddr, true, utils.ParseDec("5.2"), sdk.NewDec(1000), nil, time.Hour,
+		types.TimeInForceGoodTilTime, types.SelfTradePreventionUnspecified, nil)
+	s.Require().ErrorIs(err, types.ErrCircuitBreakerTripped)
+	_, err = s.keeper.AmendOrder(s.Ctx, ordererAddr, order.Id, utils.ParseDecP("4.8"), nil, nil)
+	s.Require().ErrorIs(err, types.ErrCircuitBreakerTripped)
+	// Orders can still be cancelled.
+	s.CancelOrder(ordererAddr, order.Id)
+
+	s.NextBlock()
+	s.NextBlock()
+	marketState = s.keeper.MustGetMarketState(s.Ctx, market.Id)
+	s.Require().False(marketState.IsCircuitBreakerTripped())
+	s.PlaceLimitOrder(market.Id, ordererAddr, true, utils.ParseDec("5.2"), sdk.NewDec(1000), time.Hour)
+}
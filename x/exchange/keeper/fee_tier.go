@@ -0,0 +1,94 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	utils "github.com/crescent-network/crescent/v5/types"
+	"github.com/crescent-network/crescent/v5/x/exchange/types"
+)
+
+// GetTrailingVolume returns the account's volume summed up over the last
+// types.FeeTierVolumeWindowDays days, including today.
+func (k Keeper) GetTrailingVolume(ctx sdk.Context, addr sdk.AccAddress) sdk.Dec {
+	today := types.FeeTierVolumeDay(ctx.BlockTime())
+	volume := utils.ZeroDec
+	k.IterateAccountVolumesByAccount(ctx, addr, func(day uint64, dailyVolume sdk.Dec) (stop bool) {
+		if day+types.FeeTierVolumeWindowDays > today {
+			volume = volume.Add(dailyVolume)
+		}
+		return false
+	})
+	return volume
+}
+
+// tracksVolume returns whether accounts' volumes in the market are tracked
+// for fee tiers.
+func (k Keeper) tracksVolume(ctx sdk.Context, market types.Market) bool {
+	volumeDenom := k.GetFees(ctx).VolumeDenom
+	return volumeDenom != "" && market.QuoteDenom == volumeDenom
+}
+
+// addAccountVolume adds volume to the account's volume of today and prunes
+// the account's daily volumes which are out of the window.
+func (k Keeper) addAccountVolume(ctx sdk.Context, addr sdk.AccAddress, volume sdk.Dec) {
+	today := types.FeeTierVolumeDay(ctx.BlockTime())
+	var staleDays []uint64
+	k.IterateAccountVolumesByAccount(ctx, addr, func(day uint64, _ sdk.Dec) (stop bool) {
+		if day+types.FeeTierVolumeWindowDays > today {
+			return true
+		}
+		staleDays = append(staleDays, day)
+		return false
+	})
+	for _, day := range staleDays {
+		k.DeleteAccountVolume(ctx, addr, day)
+	}
+	if todayVolume, found := k.GetAccountVolume(ctx, addr, today); found {
+		volume = volume.Add(todayVolume)
+	}
+	k.SetAccountVolume(ctx, addr, today, volume)
+}
+
+// GetAccountFeeTier returns the highest fee tier the account belongs to.
+func (k Keeper) GetAccountFeeTier(ctx sdk.Context, addr sdk.AccAddress) (tier types.FeeTier, found bool) {
+	return k.accountFeeTier(ctx, k.GetFees(ctx).FeeTiers, addr)
+}
+
+func (k Keeper) accountFeeTier(ctx sdk.Context, tiers []types.FeeTier, addr sdk.AccAddress) (tier types.FeeTier, found bool) {
+	if len(tiers) == 0 {
+		return
+	}
+	volume := k.GetTrailingVolume(ctx, addr)
+	balances := k.bankKeeper.SpendableCoins(ctx, addr)
+	for i := len(tiers) - 1; i >= 0; i-- {
+		if tiers[i].IsEligible(volume, balances) {
+			return tiers[i], true
+		}
+	}
+	return
+}
+
+// newMatchingContext returns a new MatchingContext which applies accounts'
+// fee tiers and the referral fee ratio.
+func (k Keeper) newMatchingContext(ctx sdk.Context, market types.Market, halveFees bool) *types.MatchingContext {
+	mCtx := types.NewMatchingContext(market, halveFees)
+	fees := k.GetFees(ctx)
+	mCtx.SetReferralFeeRatio(fees.ReferralFeeRatio)
+	tiers := fees.FeeTiers
+	if len(tiers) > 0 {
+		type result struct {
+			tier  types.FeeTier
+			found bool
+		}
+		cache := map[string]result{}
+		mCtx.SetFeeTierFunc(func(addr sdk.AccAddress) (types.FeeTier, bool) {
+			res, ok := cache[addr.String()]
+			if !ok {
+				res.tier, res.found = k.accountFeeTier(ctx, tiers, addr)
+				cache[addr.String()] = res
+			}
+			return res.tier, res.found
+		})
+	}
+	return mCtx
+}
@@ -0,0 +1,94 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	utils "github.com/crescent-network/crescent/v5/types"
+	"github.com/crescent-network/crescent/v5/x/exchange/types"
+)
+
+func (s *KeeperTestSuite) setFeeTiers() {
+	fees := s.keeper.GetFees(s.Ctx)
+	fees.VolumeDenom = "uusd"
+	fees.FeeTiers = []types.FeeTier{
+		types.NewFeeTier(
+			utils.ParseDec("10_000000"), nil, utils.ParseDec("0.001"), utils.ParseDec("0.002")),
+		types.NewFeeTier(
+			utils.ParseDec("10_000000"), utils.ParseCoins("1000_000000ucre"),
+			utils.ParseDec("-0.0005"), utils.ParseDec("0.001")),
+	}
+	s.keeper.SetFees(s.Ctx, fees)
+}
+
+func (s *KeeperTestSuite) TestTrailingVolume() {
+	s.setFeeTiers()
+	market := s.CreateMarket("ucre", "uusd")
+	otherMarket := s.CreateMarket("ucre", "uatom")
+
+	makerAddr := s.FundedAccount(1, enoughCoins)
+	takerAddr := s.FundedAccount(2, enoughCoins)
+
+	s.PlaceLimitOrder(market.Id, makerAddr, false, utils.ParseDec("5"), sdk.NewDec(10_000000), time.Hour)
+	s.PlaceLimitOrder(market.Id, takerAddr, true, utils.ParseDec("5"), sdk.NewDec(4_000000), time.Hour)
+	s.AssertEqual(utils.ParseDec("20_000000"), s.keeper.GetTrailingVolume(s.Ctx, makerAddr))
+	s.AssertEqual(utils.ParseDec("20_000000"), s.keeper.GetTrailingVolume(s.Ctx, takerAddr))
+
+	// Volume in markets with other quote denoms is not tracked.
+	s.MakeLastPrice(otherMarket.Id, makerAddr, utils.ParseDec("5"))
+	s.AssertEqual(utils.ParseDec("20_000000"), s.keeper.GetTrailingVolume(s.Ctx, makerAddr))
+
+	day := types.FeeTierVolumeDay(s.Ctx.BlockTime())
+	s.Ctx = s.Ctx.WithBlockTime(s.Ctx.BlockTime().Add(29 * 24 * time.Hour))
+	s.AssertEqual(utils.ParseDec("20_000000"), s.keeper.GetTrailingVolume(s.Ctx, takerAddr))
+	s.Ctx = s.Ctx.WithBlockTime(s.Ctx.BlockTime().Add(24 * time.Hour))
+	s.AssertEqual(utils.ZeroDec, s.keeper.GetTrailingVolume(s.Ctx, takerAddr))
+	_, found := s.keeper.GetAccountVolume(s.Ctx, takerAddr, day)
+	s.Require().True(found)
+
+	// Stale daily volumes are pruned when a new volume is added.
+	s.PlaceLimitOrder(market.Id, takerAddr, true, utils.ParseDec("5"), sdk.NewDec(1_000000), time.Hour)
+	s.AssertEqual(utils.ParseDec("5_000000"), s.keeper.GetTrailingVolume(s.Ctx, takerAddr))
+	_, found = s.keeper.GetAccountVolume(s.Ctx, takerAddr, day)
+	s.Require().False(found)
+}
+
+func (s *KeeperTestSuite) TestFeeTiers() {
+	s.setFeeTiers()
+	market := s.CreateMarket("ucre", "uusd")
+
+	makerAddr := s.FundedAccount(1, enoughCoins)
+	takerAddr := s.FundedAccount(2, utils.ParseCoins("1000_000000uusd"))
+
+	_, found := s.keeper.GetAccountFeeTier(s.Ctx, takerAddr)
+	s.Require().False(found)
+
+	// No fee tier at first.
+	makerOrderId, _, _ := s.PlaceLimitOrder(market.Id, makerAddr, false, utils.ParseDec("5"), sdk.NewDec(10_000000), time.Hour)
+	_, _, res := s.PlaceLimitOrder(market.Id, takerAddr, true, utils.ParseDec("5"), sdk.NewDec(4_000000), time.Hour)
+	s.AssertEqual(utils.ParseDecCoin("12000ucre"), res.Fee) // 0.3%
+	s.AssertEqual(utils.ParseCoins("3988000ucre,980_000000uusd"), s.GetAllBalances(takerAddr))
+
+	// The taker now belongs to the first tier.
+	tier, found := s.keeper.GetAccountFeeTier(s.Ctx, takerAddr)
+	s.Require().True(found)
+	s.AssertEqual(utils.ParseDec("0.002"), tier.TakerFeeRate)
+	_, _, res = s.PlaceLimitOrder(market.Id, takerAddr, true, utils.ParseDec("5"), sdk.NewDec(1_000000), time.Hour)
+	s.AssertEqual(utils.ParseDecCoin("2000ucre"), res.Fee) // 0.2%
+
+	// The maker belongs to the second tier due to its holdings and gets rebates.
+	tier, found = s.keeper.GetAccountFeeTier(s.Ctx, makerAddr)
+	s.Require().True(found)
+	s.AssertEqual(utils.ParseDec("-0.0005"), tier.MakerFeeRate)
+	makerOrder := s.keeper.MustGetOrder(s.Ctx, makerOrderId)
+	makerBalancesBefore := s.GetAllBalances(makerAddr)
+	s.PlaceLimitOrder(market.Id, takerAddr, true, utils.ParseDec("5"), sdk.NewDec(2_000000), time.Hour)
+	// The maker receives 10_000000uusd without fee and pays 2_000000ucre minus
+	// the rebate(1000ucre).
+	s.AssertEqual(
+		makerBalancesBefore.Add(utils.ParseCoin("10_000000uusd")), s.GetAllBalances(makerAddr))
+	s.AssertEqual(
+		makerOrder.RemainingDeposit.Sub(utils.ParseDec("1999000")),
+		s.keeper.MustGetOrder(s.Ctx, makerOrderId).RemainingDeposit)
+}
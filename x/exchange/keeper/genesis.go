@@ -1,6 +1,8 @@
 package keeper
 
 import (
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/crescent-network/crescent/v5/x/exchange/types"
@@ -19,6 +21,12 @@ func (k Keeper) InitGenesis(ctx sdk.Context, genState types.GenesisState) {
 		k.SetMarket(ctx, marketRecord.Market)
 		k.SetMarketByDenomsIndex(ctx, marketRecord.Market)
 		k.SetMarketState(ctx, marketRecord.Market.Id, marketRecord.State)
+		if marketRecord.Market.IsActive() {
+			k.SetActiveMarketsByDenomIndex(ctx, marketRecord.Market)
+		}
+		for i, obs := range marketRecord.PriceObservations {
+			k.SetPriceObservation(ctx, marketRecord.Market.Id, uint32(i), obs)
+		}
 	}
 	for _, order := range genState.Orders {
 		k.SetOrder(ctx, order)
@@ -29,15 +37,36 @@ func (k Keeper) InitGenesis(ctx sdk.Context, genState types.GenesisState) {
 		ordererAddr := sdk.MustAccAddressFromBech32(numMMOrdersRecord.Orderer)
 		k.SetNumMMOrders(ctx, ordererAddr, numMMOrdersRecord.MarketId, numMMOrdersRecord.NumMMOrders)
 	}
+	for _, order := range genState.TriggerOrders {
+		k.SetTriggerOrder(ctx, order)
+		k.SetTriggerOrderIndex(ctx, order)
+		k.SetTriggerOrdersByOrdererIndex(ctx, order)
+		k.SetTriggerOrdersByDeadlineIndex(ctx, order)
+	}
+	for _, record := range genState.AccountVolumeRecords {
+		k.SetAccountVolume(ctx, sdk.MustAccAddressFromBech32(record.Address), record.Day, record.Volume)
+	}
+	for _, record := range genState.CancelAfterRecords {
+		k.SetCancelAfterTime(ctx, sdk.MustAccAddressFromBech32(record.Orderer), record.MarketId, record.CancelAfter)
+	}
+	for _, record := range genState.ReferrerStatsRecords {
+		k.SetReferrerStats(ctx, sdk.MustAccAddressFromBech32(record.Referrer), record.MarketId, record.Stats)
+	}
 }
 
 // ExportGenesis returns the module's exported genesis.
 func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 	marketRecords := []types.MarketRecord{}
 	k.IterateAllMarkets(ctx, func(market types.Market) (stop bool) {
+		priceObservations := []types.PriceObservation{}
+		k.IteratePriceObservationsByMarket(ctx, market.Id, func(obs types.PriceObservation) (stop bool) {
+			priceObservations = append(priceObservations, obs)
+			return false
+		})
 		marketRecords = append(marketRecords, types.MarketRecord{
-			Market: market,
-			State:  k.MustGetMarketState(ctx, market.Id),
+			Market:            market,
+			State:             k.MustGetMarketState(ctx, market.Id),
+			PriceObservations: priceObservations,
 		})
 		return false
 	})
@@ -55,11 +84,51 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 		})
 		return false
 	})
+	triggerOrders := []types.TriggerOrder{}
+	k.IterateAllTriggerOrders(ctx, func(order types.TriggerOrder) (stop bool) {
+		triggerOrders = append(triggerOrders, order)
+		return false
+	})
+	accountVolumeRecords := []types.AccountVolumeRecord{}
+	k.IterateAllAccountVolumes(ctx, func(addr sdk.AccAddress, day uint64, volume sdk.Dec) (stop bool) {
+		accountVolumeRecords = append(accountVolumeRecords, types.AccountVolumeRecord{
+			Address: addr.String(),
+			Day:     day,
+			Volume:  volume,
+		})
+		return false
+	})
+	cancelAfterRecords := []types.CancelAfterRecord{}
+	k.IterateAllCancelAfters(ctx, func(ordererAddr sdk.AccAddress, marketId uint64, cancelAfter time.Time) (stop bool) {
+		cancelAfterRecords = append(cancelAfterRecords, types.CancelAfterRecord{
+			Orderer:     ordererAddr.String(),
+			MarketId:    marketId,
+			CancelAfter: cancelAfter,
+		})
+		return false
+	})
+	referrerStatsRecords := []types.ReferrerStatsRecord{}
+	k.IterateAllReferrerStats(ctx, func(referrerAddr sdk.AccAddress, marketId uint64, stats types.ReferrerStats) (stop bool) {
+		referrerStatsRecords = append(referrerStatsRecords, types.ReferrerStatsRecord{
+			Referrer: referrerAddr.String(),
+			MarketId: marketId,
+			Stats:    stats,
+		})
+		return false
+	})
+	params := k.GetParams(ctx)
+	if params.Fees.FeeTiers == nil { // for consistent json encoding
+		params.Fees.FeeTiers = []types.FeeTier{}
+	}
 	return types.NewGenesisState(
-		k.GetParams(ctx),
+		params,
 		k.GetLastMarketId(ctx),
 		k.GetLastOrderId(ctx),
 		marketRecords,
 		orders,
-		numMMOrdersRecords)
+		numMMOrdersRecords,
+		triggerOrders,
+		accountVolumeRecords,
+		cancelAfterRecords,
+		referrerStatsRecords)
 }
@@ -10,13 +10,29 @@ import (
 )
 
 func (s *KeeperTestSuite) TestImportExportGenesis() {
+	s.setFeeTiers()
 	s.CreateMarket("ucre", "uusd")
 	ordererAddr1 := s.FundedAccount(1, enoughCoins)
 	ordererAddr2 := s.FundedAccount(1, enoughCoins)
+	s.MakeLastPrice(1, ordererAddr1, utils.ParseDec("5"))
+	s.NextBlock()
+	s.MakeLastPrice(1, ordererAddr1, utils.ParseDec("5.1"))
 	s.PlaceLimitOrder(1, ordererAddr1, true, utils.ParseDec("4.9"), sdk.NewDec(10_000000), time.Hour)
 	s.PlaceLimitOrder(1, ordererAddr2, false, utils.ParseDec("5"), sdk.NewDec(20_000000), time.Hour)
+	price := utils.ParseDec("5.6")
+	s.PlaceTriggerOrder(
+		1, ordererAddr1, true, types.TriggerConditionPriceAbove,
+		utils.ParseDec("5.5"), &price, sdk.NewDec(10_000000), time.Hour)
+	cancelAfter := s.Ctx.BlockTime().Add(time.Hour)
+	s.SetCancelAfter(ordererAddr1, []uint64{1}, &cancelAfter)
+	s.keeper.SetReferrerStats(
+		s.Ctx, utils.TestAddress(3), 1, types.NewReferrerStats(sdk.NewDec(10_000000), utils.ParseDecCoins("3000ucre")))
 
 	genState := s.keeper.ExportGenesis(s.Ctx)
+	s.Require().Len(genState.MarketRecords[0].PriceObservations, 2)
+	s.Require().Len(genState.AccountVolumeRecords, 1)
+	s.Require().Len(genState.CancelAfterRecords, 1)
+	s.Require().Len(genState.ReferrerStatsRecords, 1)
 	bz := s.App.AppCodec().MustMarshalJSON(genState)
 
 	s.SetupTest()
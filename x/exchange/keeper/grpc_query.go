@@ -3,6 +3,7 @@ package keeper
 import (
 	"context"
 	"errors"
+	"time"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -140,6 +141,80 @@ func (k Querier) Order(c context.Context, req *types.QueryOrderRequest) (*types.
 	return &types.QueryOrderResponse{Order: order}, nil
 }
 
+func (k Querier) AllTriggerOrders(c context.Context, req *types.QueryAllTriggerOrdersRequest) (*types.QueryAllTriggerOrdersResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	var ordererAddr sdk.AccAddress
+	if req.Orderer != "" {
+		var err error
+		ordererAddr, err = sdk.AccAddressFromBech32(req.Orderer)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid orderer: %v", err)
+		}
+	}
+	if req.MarketId > 0 {
+		if found := k.LookupMarket(ctx, req.MarketId); !found {
+			return nil, status.Error(codes.NotFound, "market not found")
+		}
+	}
+	var (
+		keyPrefix   []byte
+		orderGetter func(key, value []byte) types.TriggerOrder
+	)
+	getOrderFromOrdersByOrdererIndexKey := func(key, _ []byte) types.TriggerOrder {
+		orderId := types.ParseOrderIdFromTriggerOrdersByOrdererIndexKey(utils.Key(keyPrefix, key))
+		return k.MustGetTriggerOrder(ctx, orderId)
+	}
+	if req.Orderer != "" && req.MarketId > 0 {
+		keyPrefix = types.GetTriggerOrdersByOrdererAndMarketIteratorPrefix(ordererAddr, req.MarketId)
+		orderGetter = getOrderFromOrdersByOrdererIndexKey
+	} else if req.Orderer != "" {
+		keyPrefix = types.GetTriggerOrdersByOrdererIteratorPrefix(ordererAddr)
+		orderGetter = getOrderFromOrdersByOrdererIndexKey
+	} else if req.MarketId > 0 {
+		keyPrefix = types.GetTriggerOrdersByMarketIteratorPrefix(req.MarketId)
+		orderGetter = func(_, value []byte) types.TriggerOrder {
+			return k.MustGetTriggerOrder(ctx, sdk.BigEndianToUint64(value))
+		}
+	} else {
+		keyPrefix = types.TriggerOrderKeyPrefix
+		orderGetter = func(_, value []byte) types.TriggerOrder {
+			var order types.TriggerOrder
+			k.cdc.MustUnmarshal(value, &order)
+			return order
+		}
+	}
+	store := ctx.KVStore(k.storeKey)
+	orderStore := prefix.NewStore(store, keyPrefix)
+	var orders []types.TriggerOrder
+	pageRes, err := query.Paginate(orderStore, req.Pagination, func(key, value []byte) error {
+		order := orderGetter(key, value)
+		orders = append(orders, order)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &types.QueryAllTriggerOrdersResponse{
+		TriggerOrders: orders,
+		Pagination:    pageRes,
+	}, nil
+}
+
+func (k Querier) TriggerOrder(c context.Context, req *types.QueryTriggerOrderRequest) (*types.QueryTriggerOrderResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	order, found := k.GetTriggerOrder(ctx, req.OrderId)
+	if !found {
+		return nil, status.Error(codes.NotFound, "trigger order not found")
+	}
+	return &types.QueryTriggerOrderResponse{TriggerOrder: order}, nil
+}
+
 func (k Querier) BestSwapExactAmountInRoutes(c context.Context, req *types.QueryBestSwapExactAmountInRoutesRequest) (*types.QueryBestSwapExactAmountInRoutesResponse, error) {
 	if req == nil {
 		return nil, status.Error(codes.InvalidArgument, "empty request")
@@ -157,6 +232,11 @@ func (k Querier) BestSwapExactAmountInRoutes(c context.Context, req *types.Query
 	if len(allRoutes) == 0 {
 		return nil, status.Error(codes.InvalidArgument, "no routes")
 	}
+	k.rankSwapRoutes(ctx, input.Denom, allRoutes)
+	// Only simulate the most liquid routes.
+	if len(allRoutes) > types.MaxNumSwapRouteCandidates {
+		allRoutes = allRoutes[:types.MaxNumSwapRouteCandidates]
+	}
 	var (
 		bestRoutes  []uint64
 		bestOutput  = sdk.NewDecCoin(req.OutputDenom, utils.ZeroInt)
@@ -165,8 +245,9 @@ func (k Querier) BestSwapExactAmountInRoutes(c context.Context, req *types.Query
 	// TODO: cache (begin, end, input) <-> output
 	for _, routes := range allRoutes {
 		output, results, err := k.SwapExactAmountIn(
-			ctx, sdk.AccAddress{}, routes, input, sdk.NewDecCoin(req.OutputDenom, utils.ZeroInt), true)
-		if err != nil && !errors.Is(err, types.ErrSwapNotEnoughInput) && !errors.Is(err, types.ErrSwapNotEnoughLiquidity) { // sanity check
+			ctx, sdk.AccAddress{}, routes, input, sdk.NewDecCoin(req.OutputDenom, utils.ZeroInt), nil, true)
+		if err != nil && !errors.Is(err, types.ErrSwapNotEnoughInput) && !errors.Is(err, types.ErrSwapNotEnoughLiquidity) &&
+			!errors.Is(err, types.ErrCircuitBreakerTripped) { // sanity check
 			panic(err)
 		}
 		if err == nil {
@@ -180,6 +261,24 @@ func (k Querier) BestSwapExactAmountInRoutes(c context.Context, req *types.Query
 	if len(bestRoutes) == 0 {
 		return nil, status.Error(codes.InvalidArgument, "no possible routes for positive output")
 	}
+	if req.MaxSplits > 1 {
+		// Split the input only when it gives more output than the best single route.
+		weightedRoutes := k.findBestWeightedRoutes(ctx, allRoutes, input, int(req.MaxSplits))
+		if len(weightedRoutes) > 1 {
+			output, weightedResults, err := k.SwapExactAmountInWeightedRoutes(
+				ctx, sdk.AccAddress{}, weightedRoutes, input, sdk.NewDecCoin(req.OutputDenom, utils.ZeroInt), nil, true)
+			if err != nil { // sanity check
+				panic(err)
+			}
+			if output.Amount.GT(bestOutput.Amount) {
+				return &types.QueryBestSwapExactAmountInRoutesResponse{
+					Output:          output,
+					WeightedRoutes:  weightedRoutes,
+					WeightedResults: weightedResults,
+				}, nil
+			}
+		}
+	}
 	return &types.QueryBestSwapExactAmountInRoutesResponse{
 		Routes:  bestRoutes,
 		Output:  bestOutput,
@@ -187,6 +286,150 @@ func (k Querier) BestSwapExactAmountInRoutes(c context.Context, req *types.Query
 	}, nil
 }
 
+func (k Querier) BestSwapExactAmountOutRoutes(c context.Context, req *types.QueryBestSwapExactAmountOutRoutesRequest) (*types.QueryBestSwapExactAmountOutRoutesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	maxRoutesLen := int(k.GetMaxSwapRoutesLen(ctx))
+	if err := sdk.ValidateDenom(req.InputDenom); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid input denom: %v", err)
+	}
+	output, err := sdk.ParseDecCoin(req.Output)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid output: %v", err)
+	}
+	if !output.IsPositive() {
+		return nil, status.Error(codes.InvalidArgument, "output must be positive")
+	}
+	allRoutes := k.FindAllRoutes(ctx, req.InputDenom, output.Denom, maxRoutesLen)
+	if len(allRoutes) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "no routes")
+	}
+	k.rankSwapRoutes(ctx, req.InputDenom, allRoutes)
+	// Only simulate the most liquid routes.
+	if len(allRoutes) > types.MaxNumSwapRouteCandidates {
+		allRoutes = allRoutes[:types.MaxNumSwapRouteCandidates]
+	}
+	var (
+		bestRoutes  []uint64
+		bestInput   sdk.DecCoin
+		bestResults []types.SwapRouteResult
+	)
+	for _, routes := range allRoutes {
+		input, results, err := k.swapExactAmountOut(ctx, sdk.AccAddress{}, routes, output, true)
+		if err != nil && !errors.Is(err, types.ErrSwapNotEnoughLiquidity) &&
+			!errors.Is(err, types.ErrCircuitBreakerTripped) { // sanity check
+			panic(err)
+		}
+		if err == nil {
+			if bestRoutes == nil || input.Amount.LT(bestInput.Amount) {
+				bestRoutes = routes
+				bestInput = input
+				bestResults = results
+			}
+		}
+	}
+	if len(bestRoutes) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "no possible routes for the output")
+	}
+	return &types.QueryBestSwapExactAmountOutRoutesResponse{
+		Routes:  bestRoutes,
+		Input:   bestInput,
+		Results: bestResults,
+	}, nil
+}
+
+func (k Querier) TWAP(c context.Context, req *types.QueryTWAPRequest) (*types.QueryTWAPResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if req.MarketId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "market id must not be 0")
+	}
+	window, err := time.ParseDuration(req.Window)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid window: %v", err)
+	}
+	if window <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "window must be positive")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	if found := k.LookupMarket(ctx, req.MarketId); !found {
+		return nil, status.Error(codes.NotFound, "market not found")
+	}
+	twap, err := k.Keeper.TWAP(ctx, req.MarketId, window)
+	if err != nil {
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	}
+	return &types.QueryTWAPResponse{Twap: twap}, nil
+}
+
+func (k Querier) AccountFeeTier(c context.Context, req *types.QueryAccountFeeTierRequest) (*types.QueryAccountFeeTierResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	addr, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid address: %v", err)
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	resp := &types.QueryAccountFeeTierResponse{
+		Volume: k.GetTrailingVolume(ctx, addr),
+	}
+	if tier, found := k.GetAccountFeeTier(ctx, addr); found {
+		resp.FeeTier = &tier
+	}
+	return resp, nil
+}
+
+func (k Querier) AccountCancelAfters(c context.Context, req *types.QueryAccountCancelAftersRequest) (*types.QueryAccountCancelAftersResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	addr, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid address: %v", err)
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	cancelAfters := []types.CancelAfterResponse{}
+	k.IterateCancelAftersByOrderer(ctx, addr, func(marketId uint64, cancelAfter time.Time) (stop bool) {
+		cancelAfters = append(cancelAfters, types.CancelAfterResponse{
+			MarketId:    marketId,
+			CancelAfter: cancelAfter,
+		})
+		return false
+	})
+	return &types.QueryAccountCancelAftersResponse{
+		CancelAfters: cancelAfters,
+	}, nil
+}
+
+func (k Querier) AccountReferrerStats(c context.Context, req *types.QueryAccountReferrerStatsRequest) (*types.QueryAccountReferrerStatsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	addr, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid address: %v", err)
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	referrerStats := []types.ReferrerStatsResponse{}
+	k.IterateReferrerStatsByReferrer(ctx, addr, func(marketId uint64, stats types.ReferrerStats) (stop bool) {
+		if req.MarketId == 0 || req.MarketId == marketId {
+			referrerStats = append(referrerStats, types.ReferrerStatsResponse{
+				MarketId: marketId,
+				Volume:   stats.Volume,
+				Fees:     stats.Fees,
+			})
+		}
+		return false
+	})
+	return &types.QueryAccountReferrerStatsResponse{
+		ReferrerStats: referrerStats,
+	}, nil
+}
+
 func (k Querier) OrderBook(c context.Context, req *types.QueryOrderBookRequest) (*types.QueryOrderBookResponse, error) {
 	if req == nil {
 		return nil, status.Error(codes.InvalidArgument, "empty request")
@@ -208,40 +451,42 @@ func (k Querier) OrderBook(c context.Context, req *types.QueryOrderBookRequest)
 
 func (k Querier) MakeMarketResponse(ctx sdk.Context, market types.Market) types.MarketResponse {
 	marketState := k.MustGetMarketState(ctx, market.Id)
-	return types.NewMarketResponse(market, marketState)
+	resp := types.NewMarketResponse(market, marketState, ctx.BlockTime())
+	if minPrice, maxPrice, found := k.CircuitBreakerPriceBand(ctx, market.Id, marketState); found {
+		resp.MinBandPrice = &minPrice
+		resp.MaxBandPrice = &maxPrice
+	}
+	return resp
 }
 
 func (k Querier) MakeOrderBooks(ctx sdk.Context, market types.Market, lastPrice sdk.Dec, maxNumPriceLevels int) []types.OrderBook {
 	maxPriceRatio := k.GetMaxOrderPriceRatio(ctx)
 	minPrice, maxPrice := types.OrderPriceLimit(lastPrice, maxPriceRatio)
 
-	sellObs := k.ConstructMemOrderBookSide(ctx, market, types.MemOrderBookSideOptions{
-		IsBuy:             false,
-		PriceLimit:        &maxPrice,
-		MaxNumPriceLevels: maxNumPriceLevels * 100,
-	}, nil)
-	// Read at most one level to check if any buy order exists.
-	buyObs := k.ConstructMemOrderBookSide(ctx, market, types.MemOrderBookSideOptions{
-		IsBuy:             true,
-		PriceLimit:        &minPrice,
-		MaxNumPriceLevels: maxNumPriceLevels * 100,
-	}, nil)
+	sellLevels := k.aggregatedPriceLevels(ctx, market, false, maxPrice, maxNumPriceLevels*100)
+	buyLevels := k.aggregatedPriceLevels(ctx, market, true, minPrice, maxNumPriceLevels*100)
 
-	// Since price intervals among all price levels in an order book must be
-	// consistent, we have to group price levels together below the price
-	// where the tick interval changes.
+	// The market's tick size is the natural price interval of the order book.
+	// If the market has no tick size, since price intervals among all price
+	// levels in an order book must be consistent, we have to group price levels
+	// together below the price where the tick interval changes.
 	// Because of this, we read the highest sell price in the order book and
 	// use the price interval at that price as the smallest possible price
 	// interval.
 	var highestPrice sdk.Dec
-	if len(sellObs.Levels()) > 0 {
-		highestPrice = sellObs.Levels()[len(sellObs.Levels())-1].Price()
-	} else if len(buyObs.Levels()) > 0 {
-		highestPrice = buyObs.Levels()[0].Price()
+	if len(sellLevels) > 0 {
+		highestPrice = sellLevels[len(sellLevels)-1].P
+	} else if len(buyLevels) > 0 {
+		highestPrice = buyLevels[0].P
 	} else {
 		return nil // No orders
 	}
-	smallestPriceInterval := types.PriceIntervalAtTick(types.TickAtPrice(highestPrice))
+	var smallestPriceInterval sdk.Dec
+	if market.TickSize.IsPositive() {
+		smallestPriceInterval = market.TickSize
+	} else {
+		smallestPriceInterval = types.PriceIntervalAtTick(types.TickAtPrice(highestPrice))
+	}
 
 	var orderBooks []types.OrderBook
 	for _, p := range []int{1, 10, 100} {
@@ -251,13 +496,13 @@ func (k Querier) MakeOrderBooks(ctx sdk.Context, market types.Market, lastPrice
 			Sells:         nil,
 			Buys:          nil,
 		}
-		if len(sellObs.Levels()) > 0 {
+		if len(sellLevels) > 0 {
 			levelIdx := 0
-			currentPrice := FitPriceToPriceInterval(sellObs.Levels()[levelIdx].Price(), priceInterval, true)
-			for i := 0; i < maxNumPriceLevels && levelIdx < len(sellObs.Levels()); {
+			currentPrice := FitPriceToPriceInterval(sellLevels[levelIdx].P, priceInterval, true)
+			for i := 0; i < maxNumPriceLevels && levelIdx < len(sellLevels); {
 				qty := utils.ZeroDec
-				for levelIdx < len(sellObs.Levels()) && sellObs.Levels()[levelIdx].Price().LTE(currentPrice) {
-					qty = qty.Add(types.TotalExecutableQuantity(sellObs.Levels()[levelIdx].Orders()))
+				for levelIdx < len(sellLevels) && sellLevels[levelIdx].P.LTE(currentPrice) {
+					qty = qty.Add(sellLevels[levelIdx].Q)
 					levelIdx++
 				}
 				if qty.IsPositive() {
@@ -270,13 +515,13 @@ func (k Querier) MakeOrderBooks(ctx sdk.Context, market types.Market, lastPrice
 				currentPrice = currentPrice.Add(priceInterval)
 			}
 		}
-		if len(buyObs.Levels()) > 0 {
+		if len(buyLevels) > 0 {
 			levelIdx := 0
-			currentPrice := FitPriceToPriceInterval(buyObs.Levels()[levelIdx].Price(), priceInterval, false)
-			for i := 0; !currentPrice.IsNegative() && i < maxNumPriceLevels && levelIdx < len(buyObs.Levels()); {
+			currentPrice := FitPriceToPriceInterval(buyLevels[levelIdx].P, priceInterval, false)
+			for i := 0; !currentPrice.IsNegative() && i < maxNumPriceLevels && levelIdx < len(buyLevels); {
 				qty := utils.ZeroDec
-				for levelIdx < len(buyObs.Levels()) && buyObs.Levels()[levelIdx].Price().GTE(currentPrice) {
-					qty = qty.Add(types.TotalExecutableQuantity(buyObs.Levels()[levelIdx].Orders()))
+				for levelIdx < len(buyLevels) && buyLevels[levelIdx].P.GTE(currentPrice) {
+					qty = qty.Add(buyLevels[levelIdx].Q)
 					levelIdx++
 				}
 				if qty.IsPositive() {
@@ -294,6 +539,59 @@ func (k Querier) MakeOrderBooks(ctx sdk.Context, market types.Market, lastPrice
 	return orderBooks
 }
 
+// aggregatedPriceLevels returns at most maxNumPriceLevels price levels of an
+// order book side within the price limit, sorted from the best price.
+// User orders are read from the price level index and merged with orders from
+// order sources.
+func (k Querier) aggregatedPriceLevels(
+	ctx sdk.Context, market types.Market, isBuy bool, priceLimit sdk.Dec, maxNumPriceLevels int) []types.OrderBookPriceLevel {
+	var userLevels []types.OrderBookPriceLevel
+	k.IteratePriceLevels(ctx, market.Id, isBuy, &priceLimit, func(price sdk.Dec, level types.PriceLevel) (stop bool) {
+		if len(userLevels) >= maxNumPriceLevels {
+			return true
+		}
+		userLevels = append(userLevels, types.OrderBookPriceLevel{P: price, Q: level.TotalOpenQuantity})
+		return false
+	})
+	sourceObs := k.constructOrderSourceMemOrderBookSide(ctx, market, types.MemOrderBookSideOptions{
+		IsBuy:             isBuy,
+		PriceLimit:        &priceLimit,
+		MaxNumPriceLevels: maxNumPriceLevels,
+	})
+	sourceLevels := sourceObs.Levels()
+
+	levels := make([]types.OrderBookPriceLevel, 0, len(userLevels)+len(sourceLevels))
+	i, j := 0, 0
+	for len(levels) < maxNumPriceLevels && (i < len(userLevels) || j < len(sourceLevels)) {
+		var level types.OrderBookPriceLevel
+		switch {
+		case j == len(sourceLevels):
+			level = userLevels[i]
+			i++
+		case i == len(userLevels):
+			level = types.OrderBookPriceLevel{
+				P: sourceLevels[j].Price(), Q: types.TotalExecutableQuantity(sourceLevels[j].Orders())}
+			j++
+		case userLevels[i].P.Equal(sourceLevels[j].Price()):
+			level = types.OrderBookPriceLevel{
+				P: userLevels[i].P,
+				Q: userLevels[i].Q.Add(types.TotalExecutableQuantity(sourceLevels[j].Orders())),
+			}
+			i++
+			j++
+		case isBuy == userLevels[i].P.GT(sourceLevels[j].Price()):
+			level = userLevels[i]
+			i++
+		default:
+			level = types.OrderBookPriceLevel{
+				P: sourceLevels[j].Price(), Q: types.TotalExecutableQuantity(sourceLevels[j].Orders())}
+			j++
+		}
+		levels = append(levels, level)
+	}
+	return levels
+}
+
 func FitPriceToPriceInterval(price, interval sdk.Dec, roundUp bool) sdk.Dec {
 	b := price.BigInt()
 	b.Quo(b, interval.BigInt()).Mul(b, interval.BigInt())
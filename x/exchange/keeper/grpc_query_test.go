@@ -264,6 +264,97 @@ func (s *KeeperTestSuite) TestQueryOrder() {
 	}
 }
 
+func (s *KeeperTestSuite) TestQueryAllTriggerOrders() {
+	market1 := s.CreateMarket("ucre", "uusd")
+	market2 := s.CreateMarket("uatom", "uusd")
+	ordererAddr1 := s.FundedAccount(1, enoughCoins)
+	ordererAddr2 := s.FundedAccount(2, enoughCoins)
+
+	s.PlaceTriggerOrder(
+		market1.Id, ordererAddr1, false, types.TriggerConditionPriceBelow,
+		utils.ParseDec("4.5"), nil, sdk.NewDec(10_000000), time.Hour)
+	s.PlaceTriggerOrder(
+		market2.Id, ordererAddr1, false, types.TriggerConditionPriceBelow,
+		utils.ParseDec("9"), nil, sdk.NewDec(10_000000), time.Hour)
+	s.PlaceTriggerOrder(
+		market1.Id, ordererAddr2, true, types.TriggerConditionPriceAbove,
+		utils.ParseDec("5.5"), nil, sdk.NewDec(10_000000), time.Hour)
+
+	for _, tc := range []struct {
+		name        string
+		req         *types.QueryAllTriggerOrdersRequest
+		expectedErr string
+		postRun     func(resp *types.QueryAllTriggerOrdersResponse)
+	}{
+		{
+			"query all",
+			&types.QueryAllTriggerOrdersRequest{},
+			"",
+			func(resp *types.QueryAllTriggerOrdersResponse) {
+				s.Require().Len(resp.TriggerOrders, 3)
+			},
+		},
+		{
+			"query by orderer",
+			&types.QueryAllTriggerOrdersRequest{
+				Orderer: ordererAddr1.String(),
+			},
+			"",
+			func(resp *types.QueryAllTriggerOrdersResponse) {
+				s.Require().Len(resp.TriggerOrders, 2)
+				s.Require().EqualValues(1, resp.TriggerOrders[0].Id)
+				s.Require().EqualValues(2, resp.TriggerOrders[1].Id)
+			},
+		},
+		{
+			"query by market",
+			&types.QueryAllTriggerOrdersRequest{
+				MarketId: market1.Id,
+			},
+			"",
+			func(resp *types.QueryAllTriggerOrdersResponse) {
+				s.Require().Len(resp.TriggerOrders, 2)
+			},
+		},
+		{
+			"query by orderer and market",
+			&types.QueryAllTriggerOrdersRequest{
+				Orderer:  ordererAddr2.String(),
+				MarketId: market1.Id,
+			},
+			"",
+			func(resp *types.QueryAllTriggerOrdersResponse) {
+				s.Require().Len(resp.TriggerOrders, 1)
+				s.Require().EqualValues(3, resp.TriggerOrders[0].Id)
+			},
+		},
+		{
+			"market not found",
+			&types.QueryAllTriggerOrdersRequest{
+				MarketId: 10,
+			},
+			"rpc error: code = NotFound desc = market not found",
+			nil,
+		},
+	} {
+		s.Run(tc.name, func() {
+			resp, err := s.querier.AllTriggerOrders(sdk.WrapSDKContext(s.Ctx), tc.req)
+			if tc.expectedErr == "" {
+				s.Require().NoError(err)
+				tc.postRun(resp)
+			} else {
+				s.Require().EqualError(err, tc.expectedErr)
+			}
+		})
+	}
+
+	resp, err := s.querier.TriggerOrder(sdk.WrapSDKContext(s.Ctx), &types.QueryTriggerOrderRequest{OrderId: 2})
+	s.Require().NoError(err)
+	s.Require().Equal(market2.Id, resp.TriggerOrder.MarketId)
+	_, err = s.querier.TriggerOrder(sdk.WrapSDKContext(s.Ctx), &types.QueryTriggerOrderRequest{OrderId: 100})
+	s.Require().EqualError(err, "rpc error: code = NotFound desc = trigger order not found")
+}
+
 func (s *KeeperTestSuite) TestQueryBestSwapExactAmountInRoutes() {
 	s.SetupSampleScenario()
 
@@ -306,6 +397,64 @@ func (s *KeeperTestSuite) TestQueryBestSwapExactAmountInRoutes() {
 	}
 }
 
+func (s *KeeperTestSuite) TestQueryBestSwapExactAmountOutRoutes() {
+	s.SetupSampleScenario()
+
+	for _, tc := range []struct {
+		name        string
+		req         *types.QueryBestSwapExactAmountOutRoutesRequest
+		expectedErr string
+		postRun     func(resp *types.QueryBestSwapExactAmountOutRoutesResponse)
+	}{
+		{
+			"happy case",
+			&types.QueryBestSwapExactAmountOutRoutesRequest{
+				InputDenom: "uatom",
+				Output:     "9969723ucre",
+			},
+			"",
+			func(resp *types.QueryBestSwapExactAmountOutRoutesResponse) {
+				s.Require().Equal([]uint64{2, 1}, resp.Routes)
+				s.AssertEqual(utils.ParseDecCoin("5000001uatom"), resp.Input)
+				s.Assert().EqualValues(2, resp.Results[0].MarketId)
+				s.AssertEqual(utils.ParseDecCoin("5000001uatom"), resp.Results[0].Input)
+				s.AssertEqual(utils.ParseDecCoin("49924504uusd"), resp.Results[0].Output)
+				s.Assert().EqualValues(1, resp.Results[1].MarketId)
+				s.AssertEqual(utils.ParseDecCoin("49924504uusd"), resp.Results[1].Input)
+				s.AssertEqual(utils.ParseDecCoin("9969723ucre"), resp.Results[1].Output)
+			},
+		},
+		{
+			"invalid output",
+			&types.QueryBestSwapExactAmountOutRoutesRequest{
+				InputDenom: "uatom",
+				Output:     "0ucre",
+			},
+			"rpc error: code = InvalidArgument desc = output must be positive",
+			nil,
+		},
+		{
+			"no routes",
+			&types.QueryBestSwapExactAmountOutRoutesRequest{
+				InputDenom: "uatom",
+				Output:     "1000000ufoo",
+			},
+			"rpc error: code = InvalidArgument desc = no routes",
+			nil,
+		},
+	} {
+		s.Run(tc.name, func() {
+			resp, err := s.querier.BestSwapExactAmountOutRoutes(sdk.WrapSDKContext(s.Ctx), tc.req)
+			if tc.expectedErr == "" {
+				s.Require().NoError(err)
+				tc.postRun(resp)
+			} else {
+				s.Require().EqualError(err, tc.expectedErr)
+			}
+		})
+	}
+}
+
 func (s *KeeperTestSuite) TestQueryOrderBook() {
 	s.SetupSampleScenario()
 
@@ -385,6 +534,68 @@ func (s *KeeperTestSuite) TestQueryOrderBook() {
 	}
 }
 
+func (s *KeeperTestSuite) TestQueryOrderBookTickSize() {
+	market := s.CreateMarket("ucre", "uusd")
+	market.TickSize = utils.ParseDec("0.01")
+	s.keeper.SetMarket(s.Ctx, market)
+
+	mmAddr := s.FundedAccount(1, enoughCoins)
+	ordererAddr := s.FundedAccount(2, enoughCoins)
+	s.MakeLastPrice(market.Id, mmAddr, utils.ParseDec("5"))
+	s.PlaceLimitOrder(market.Id, ordererAddr, false, utils.ParseDec("5.01"), sdk.NewDec(10_000000), time.Hour)
+	s.PlaceLimitOrder(market.Id, ordererAddr, false, utils.ParseDec("5.02"), sdk.NewDec(10_000000), time.Hour)
+	s.PlaceLimitOrder(market.Id, ordererAddr, true, utils.ParseDec("4.99"), sdk.NewDec(10_000000), time.Hour)
+
+	resp, err := s.querier.OrderBook(sdk.WrapSDKContext(s.Ctx), &types.QueryOrderBookRequest{
+		MarketId: market.Id,
+	})
+	s.Require().NoError(err)
+	s.Require().Len(resp.OrderBooks, 3)
+	// The market's tick size is used as the smallest price interval.
+	s.Require().Equal(utils.ParseDec("0.01"), resp.OrderBooks[0].PriceInterval)
+	s.Require().Len(resp.OrderBooks[0].Sells, 2)
+	s.Require().Len(resp.OrderBooks[0].Buys, 1)
+	s.Require().Equal(utils.ParseDec("0.1"), resp.OrderBooks[1].PriceInterval)
+	s.Require().Len(resp.OrderBooks[1].Sells, 1)
+	s.Require().Equal(sdk.NewDec(20_000000), resp.OrderBooks[1].Sells[0].Q)
+}
+
+func (s *KeeperTestSuite) TestQueryOrderBookTickSize_SmallerThanPriceInterval() {
+	market := s.CreateMarket("ucre", "uusd")
+	market.TickSize = utils.ParseDec("0.0002")
+	s.keeper.SetMarket(s.Ctx, market)
+
+	mmAddr := s.FundedAccount(1, enoughCoins)
+	ordererAddr := s.FundedAccount(2, enoughCoins)
+	s.MakeLastPrice(market.Id, mmAddr, utils.ParseDec("10"))
+	// The price interval is 0.001 above 10 and 0.0001 below 10.
+	s.PlaceLimitOrder(market.Id, ordererAddr, false, utils.ParseDec("10.002"), sdk.NewDec(10_000000), time.Hour)
+	s.PlaceLimitOrder(market.Id, ordererAddr, false, utils.ParseDec("10.004"), sdk.NewDec(10_000000), time.Hour)
+	s.PlaceLimitOrder(market.Id, ordererAddr, true, utils.ParseDec("9.9998"), sdk.NewDec(10_000000), time.Hour)
+	s.PlaceLimitOrder(market.Id, ordererAddr, true, utils.ParseDec("9.9996"), sdk.NewDec(10_000000), time.Hour)
+
+	resp, err := s.querier.OrderBook(sdk.WrapSDKContext(s.Ctx), &types.QueryOrderBookRequest{
+		MarketId: market.Id,
+	})
+	s.Require().NoError(err)
+	s.Require().Len(resp.OrderBooks, 3)
+	// The market's tick size is used as the smallest price interval even
+	// though the price interval at the highest price is larger.
+	s.Require().Equal(utils.ParseDec("0.0002"), resp.OrderBooks[0].PriceInterval)
+	s.Require().Equal([]types.OrderBookPriceLevel{
+		{P: utils.ParseDec("10.002"), Q: sdk.NewDec(10_000000)},
+		{P: utils.ParseDec("10.004"), Q: sdk.NewDec(10_000000)},
+	}, resp.OrderBooks[0].Sells)
+	s.Require().Equal([]types.OrderBookPriceLevel{
+		{P: utils.ParseDec("9.9998"), Q: sdk.NewDec(10_000000)},
+		{P: utils.ParseDec("9.9996"), Q: sdk.NewDec(10_000000)},
+	}, resp.OrderBooks[0].Buys)
+	s.Require().Equal(utils.ParseDec("0.002"), resp.OrderBooks[1].PriceInterval)
+	s.Require().Equal([]types.OrderBookPriceLevel{
+		{P: utils.ParseDec("9.998"), Q: sdk.NewDec(20_000000)},
+	}, resp.OrderBooks[1].Buys)
+}
+
 func (s *KeeperTestSuite) TestFindBestSwapExactAmountInRoutes() {
 	s.CreateMarket("ucre", "uusd")
 	s.CreateMarket("uatom", "ucre")
@@ -416,3 +627,195 @@ func (s *KeeperTestSuite) TestFindBestSwapExactAmountInRoutes() {
 	output, _ := s.SwapExactAmountIn(ordererAddr, resp.Routes, utils.ParseDecCoin("20000000uusd"), resp.Output, false)
 	s.AssertEqual(resp.Output, output)
 }
+
+func (s *KeeperTestSuite) TestQueryTWAP() {
+	market := s.CreateMarket("ucre", "uusd")
+	mmAddr := s.FundedAccount(1, enoughCoins)
+	s.MakeLastPrice(market.Id, mmAddr, utils.ParseDec("5"))
+	s.NextBlock()
+	s.MakeLastPrice(market.Id, mmAddr, utils.ParseDec("5.5"))
+	s.NextBlock()
+
+	for _, tc := range []struct {
+		name        string
+		req         *types.QueryTWAPRequest
+		expectedErr string
+		postRun     func(resp *types.QueryTWAPResponse)
+	}{
+		{
+			"happy case",
+			&types.QueryTWAPRequest{
+				MarketId: market.Id,
+				Window:   "10s",
+			},
+			"",
+			func(resp *types.QueryTWAPResponse) {
+				s.AssertEqual(utils.ParseDec("5.25"), resp.Twap)
+			},
+		},
+		{
+			"market not found",
+			&types.QueryTWAPRequest{
+				MarketId: 2,
+				Window:   "10s",
+			},
+			"rpc error: code = NotFound desc = market not found",
+			nil,
+		},
+		{
+			"invalid window",
+			&types.QueryTWAPRequest{
+				MarketId: market.Id,
+				Window:   "0s",
+			},
+			"rpc error: code = InvalidArgument desc = window must be positive",
+			nil,
+		},
+		{
+			"window too long",
+			&types.QueryTWAPRequest{
+				MarketId: market.Id,
+				Window:   "1h",
+			},
+			"rpc error: code = FailedPrecondition desc = oldest price observation is at 2023-01-01 00:00:00 +0000 UTC: not enough price history",
+			nil,
+		},
+	} {
+		s.Run(tc.name, func() {
+			resp, err := s.querier.TWAP(sdk.WrapSDKContext(s.Ctx), tc.req)
+			if tc.expectedErr == "" {
+				s.Require().NoError(err)
+				tc.postRun(resp)
+			} else {
+				s.Require().EqualError(err, tc.expectedErr)
+			}
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestQueryAccountFeeTier() {
+	s.setFeeTiers()
+	market := s.CreateMarket("ucre", "uusd")
+	makerAddr := s.FundedAccount(1, enoughCoins)
+	takerAddr := s.FundedAccount(2, utils.ParseCoins("1000_000000uusd"))
+	s.PlaceLimitOrder(market.Id, makerAddr, false, utils.ParseDec("5"), sdk.NewDec(10_000000), time.Hour)
+	s.PlaceLimitOrder(market.Id, takerAddr, true, utils.ParseDec("5"), sdk.NewDec(4_000000), time.Hour)
+
+	for _, tc := range []struct {
+		name        string
+		req         *types.QueryAccountFeeTierRequest
+		expectedErr string
+		postRun     func(resp *types.QueryAccountFeeTierResponse)
+	}{
+		{
+			"happy case",
+			&types.QueryAccountFeeTierRequest{
+				Address: makerAddr.String(),
+			},
+			"",
+			func(resp *types.QueryAccountFeeTierResponse) {
+				s.AssertEqual(utils.ParseDec("20_000000"), resp.Volume)
+				s.Require().NotNil(resp.FeeTier)
+				s.AssertEqual(utils.ParseDec("-0.0005"), resp.FeeTier.MakerFeeRate)
+			},
+		},
+		{
+			"account without holdings",
+			&types.QueryAccountFeeTierRequest{
+				Address: takerAddr.String(),
+			},
+			"",
+			func(resp *types.QueryAccountFeeTierResponse) {
+				s.AssertEqual(utils.ParseDec("20_000000"), resp.Volume)
+				s.Require().NotNil(resp.FeeTier)
+				s.AssertEqual(utils.ParseDec("0.002"), resp.FeeTier.TakerFeeRate)
+			},
+		},
+		{
+			"account without volume",
+			&types.QueryAccountFeeTierRequest{
+				Address: utils.TestAddress(3).String(),
+			},
+			"",
+			func(resp *types.QueryAccountFeeTierResponse) {
+				s.AssertEqual(utils.ZeroDec, resp.Volume)
+				s.Require().Nil(resp.FeeTier)
+			},
+		},
+		{
+			"invalid address",
+			&types.QueryAccountFeeTierRequest{
+				Address: "invalidaddr",
+			},
+			"rpc error: code = InvalidArgument desc = invalid address: decoding bech32 failed: invalid separator index -1",
+			nil,
+		},
+	} {
+		s.Run(tc.name, func() {
+			resp, err := s.querier.AccountFeeTier(sdk.WrapSDKContext(s.Ctx), tc.req)
+			if tc.expectedErr == "" {
+				s.Require().NoError(err)
+				tc.postRun(resp)
+			} else {
+				s.Require().EqualError(err, tc.expectedErr)
+			}
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestQueryAccountCancelAfters() {
+	market1 := s.CreateMarket("ucre", "uusd")
+	market2 := s.CreateMarket("uatom", "uusd")
+	ordererAddr := utils.TestAddress(1)
+	cancelAfter := s.Ctx.BlockTime().Add(time.Minute)
+	s.SetCancelAfter(ordererAddr, []uint64{market1.Id, market2.Id}, &cancelAfter)
+
+	for _, tc := range []struct {
+		name        string
+		req         *types.QueryAccountCancelAftersRequest
+		expectedErr string
+		postRun     func(resp *types.QueryAccountCancelAftersResponse)
+	}{
+		{
+			"happy case",
+			&types.QueryAccountCancelAftersRequest{
+				Address: ordererAddr.String(),
+			},
+			"",
+			func(resp *types.QueryAccountCancelAftersResponse) {
+				s.Require().Len(resp.CancelAfters, 2)
+				s.Require().EqualValues(market1.Id, resp.CancelAfters[0].MarketId)
+				s.Require().Equal(cancelAfter, resp.CancelAfters[0].CancelAfter)
+				s.Require().EqualValues(market2.Id, resp.CancelAfters[1].MarketId)
+			},
+		},
+		{
+			"account without timers",
+			&types.QueryAccountCancelAftersRequest{
+				Address: utils.TestAddress(2).String(),
+			},
+			"",
+			func(resp *types.QueryAccountCancelAftersResponse) {
+				s.Require().Empty(resp.CancelAfters)
+			},
+		},
+		{
+			"invalid address",
+			&types.QueryAccountCancelAftersRequest{
+				Address: "invalidaddr",
+			},
+			"rpc error: code = InvalidArgument desc = invalid address: decoding bech32 failed: invalid separator index -1",
+			nil,
+		},
+	} {
+		s.Run(tc.name, func() {
+			resp, err := s.querier.AccountCancelAfters(sdk.WrapSDKContext(s.Ctx), tc.req)
+			if tc.expectedErr == "" {
+				s.Require().NoError(err)
+				tc.postRun(resp)
+			} else {
+				s.Require().EqualError(err, tc.expectedErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,104 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	utils "github.com/crescent-network/crescent/v5/types"
+	"github.com/crescent-network/crescent/v5/x/exchange/types"
+)
+
+var _ types.ExchangeHooks = &mockExchangeHooks{}
+
+type mockExchangeHooks struct {
+	createdMarketIds   []uint64
+	placedOrderIds     []uint64
+	fills              []types.OrderFill
+	canceledOrderIds   []uint64
+	batchMatchedPrices []sdk.Dec
+}
+
+func (h *mockExchangeHooks) AfterMarketCreated(_ sdk.Context, market types.Market) error {
+	h.createdMarketIds = append(h.createdMarketIds, market.Id)
+	return nil
+}
+
+func (h *mockExchangeHooks) AfterOrderPlaced(_ sdk.Context, order types.Order) error {
+	h.placedOrderIds = append(h.placedOrderIds, order.Id)
+	return nil
+}
+
+func (h *mockExchangeHooks) AfterOrderFilled(_ sdk.Context, _ types.Market, fill types.OrderFill) error {
+	h.fills = append(h.fills, fill)
+	return nil
+}
+
+func (h *mockExchangeHooks) AfterOrderCanceled(_ sdk.Context, order types.Order) error {
+	h.canceledOrderIds = append(h.canceledOrderIds, order.Id)
+	return nil
+}
+
+func (h *mockExchangeHooks) AfterBatchMatched(_ sdk.Context, _ types.Market, lastPrice sdk.Dec) error {
+	h.batchMatchedPrices = append(h.batchMatchedPrices, lastPrice)
+	return nil
+}
+
+func (s *KeeperTestSuite) TestSetHooks() {
+	hooks := &mockExchangeHooks{}
+	s.App.ExchangeKeeper.SetHooks(hooks)
+	s.keeper = s.App.ExchangeKeeper
+	s.Require().PanicsWithValue("cannot set exchange hooks twice", func() {
+		s.App.ExchangeKeeper.SetHooks(hooks)
+	})
+}
+
+func (s *KeeperTestSuite) TestExchangeHooks() {
+	hooks := &mockExchangeHooks{}
+	s.App.ExchangeKeeper.SetHooks(hooks)
+	s.keeper = s.App.ExchangeKeeper
+
+	market := s.CreateMarket("ucre", "uusd")
+	s.Require().Equal([]uint64{market.Id}, hooks.createdMarketIds)
+
+	makerAddr := s.FundedAccount(1, enoughCoins)
+	takerAddr := s.FundedAccount(2, enoughCoins)
+
+	makerOrderId, _, _ := s.PlaceLimitOrder(
+		market.Id, makerAddr, false, utils.ParseDec("5"), sdk.NewDec(10_000000), time.Hour)
+	s.Require().Equal([]uint64{makerOrderId}, hooks.placedOrderIds)
+
+	// The taker order is fully executed, so it doesn't rest on the order book.
+	takerOrderId, _, _ := s.PlaceLimitOrder(
+		market.Id, takerAddr, true, utils.ParseDec("5"), sdk.NewDec(4_000000), time.Hour)
+	s.Require().Equal([]uint64{makerOrderId}, hooks.placedOrderIds)
+	s.Require().Len(hooks.fills, 2)
+	makerFill, takerFill := hooks.fills[0], hooks.fills[1]
+	s.Require().Equal(makerOrderId, makerFill.OrderId)
+	s.Require().Equal(makerAddr, makerFill.Orderer)
+	s.Require().False(makerFill.IsBuy)
+	s.Require().True(makerFill.IsMaker)
+	s.AssertEqual(sdk.NewDec(4_000000), makerFill.ExecutedQuantity)
+	s.AssertEqual(utils.ParseDecCoin("4000000ucre"), makerFill.Paid)
+	s.Require().Equal(takerOrderId, takerFill.OrderId)
+	s.Require().Equal(takerAddr, takerFill.Orderer)
+	s.Require().True(takerFill.IsBuy)
+	s.Require().False(takerFill.IsMaker)
+	s.AssertEqual(sdk.NewDec(4_000000), takerFill.ExecutedQuantity)
+	s.AssertEqual(utils.ParseDecCoin("20000000uusd"), takerFill.Paid)
+	s.Require().True(takerFill.Fee.IsPositive())
+
+	s.NextBlock()
+	s.CancelOrder(makerAddr, makerOrderId)
+	s.Require().Equal([]uint64{makerOrderId}, hooks.canceledOrderIds)
+
+	buyOrder := s.PlaceBatchLimitOrder(
+		market.Id, makerAddr, true, utils.ParseDec("5.1"), sdk.NewDec(1_000000), time.Hour)
+	sellOrder := s.PlaceBatchLimitOrder(
+		market.Id, takerAddr, false, utils.ParseDec("5.1"), sdk.NewDec(1_000000), time.Hour)
+	s.Require().Equal([]uint64{makerOrderId, buyOrder.Id, sellOrder.Id}, hooks.placedOrderIds)
+	s.Require().NoError(s.keeper.RunBatchMatching(s.Ctx, market))
+	s.Require().Len(hooks.batchMatchedPrices, 1)
+	s.AssertEqual(utils.ParseDec("5.1"), hooks.batchMatchedPrices[0])
+	s.Require().Len(hooks.fills, 4)
+}
@@ -2,9 +2,11 @@ package keeper
 
 import (
 	"fmt"
+	"sort"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	utils "github.com/crescent-network/crescent/v5/types"
 	"github.com/crescent-network/crescent/v5/x/exchange/types"
 )
 
@@ -14,6 +16,7 @@ func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
 	ir.RegisterRoute(types.ModuleName, "order-book", OrderBookInvariant(k))
 	ir.RegisterRoute(types.ModuleName, "order-book-order", OrderBookOrderInvariant(k))
 	ir.RegisterRoute(types.ModuleName, "num-mm-orders", NumMMOrdersInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "price-level", PriceLevelInvariant(k))
 }
 
 func AllInvariants(k Keeper) sdk.Invariant {
@@ -34,7 +37,11 @@ func AllInvariants(k Keeper) sdk.Invariant {
 		if broken {
 			return
 		}
-		return NumMMOrdersInvariant(k)(ctx)
+		res, broken = NumMMOrdersInvariant(k)(ctx)
+		if broken {
+			return
+		}
+		return PriceLevelInvariant(k)(ctx)
 	}
 }
 
@@ -75,6 +82,11 @@ func OrderStateInvariant(k Keeper) sdk.Invariant {
 				msg += fmt.Sprintf("\torder %d should have been deleted since it has no executable quantity\n", order.Id)
 				cnt++
 			}
+			if order.HiddenQuantity.IsPositive() && order.VisibleQuantity().TruncateDec().IsZero() {
+				msg += fmt.Sprintf("\torder %d should have been replenished from its hidden quantity %s\n",
+					order.Id, order.HiddenQuantity)
+				cnt++
+			}
 			if !order.RemainingDeposit.TruncateDec().Equal(order.RemainingDeposit) {
 				msg += fmt.Sprintf("\torder %d should have integer remaining deposit but has %s\n",
 					order.Id, order.RemainingDeposit)
@@ -94,6 +106,10 @@ func OrderBookInvariant(k Keeper) sdk.Invariant {
 		msg := ""
 		cnt := 0
 		k.IterateAllMarkets(ctx, func(market types.Market) (stop bool) {
+			// Orders are not matched until the market is reopened.
+			if k.MustGetMarketState(ctx, market.Id).IsCircuitBreakerTripped() {
+				return false
+			}
 			bestBuyPrice, found := k.GetBestOrderPrice(ctx, market.Id, true)
 			if !found { // Skip
 				return false
@@ -168,3 +184,57 @@ func NumMMOrdersInvariant(k Keeper) sdk.Invariant {
 			fmt.Sprintf("found %d wrong num MM orders state(s)\n%s", cnt, msg)), broken
 	}
 }
+
+func PriceLevelInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		msg := ""
+		cnt := 0
+		// Rebuild price levels from the orders.
+		expectedLevels := map[string]types.PriceLevel{}
+		k.IterateAllOrders(ctx, func(order types.Order) (stop bool) {
+			key := string(types.GetPriceLevelKey(order.MarketId, order.IsBuy, order.Price))
+			level, ok := expectedLevels[key]
+			if !ok {
+				level.TotalOpenQuantity = utils.ZeroDec
+			}
+			level.TotalOpenQuantity = level.TotalOpenQuantity.Add(order.VisibleQuantity())
+			level.NumOrders++
+			expectedLevels[key] = level
+			return false
+		})
+		k.IterateAllPriceLevels(ctx, func(marketId uint64, isBuy bool, price sdk.Dec, level types.PriceLevel) (stop bool) {
+			key := string(types.GetPriceLevelKey(marketId, isBuy, price))
+			expected, ok := expectedLevels[key]
+			if !ok {
+				msg += fmt.Sprintf(
+					"\tmarket %d has a price level at %s(is buy: %v) without orders\n", marketId, price, isBuy)
+				cnt++
+				return false
+			}
+			delete(expectedLevels, key)
+			if !level.TotalOpenQuantity.Equal(expected.TotalOpenQuantity) || level.NumOrders != expected.NumOrders {
+				msg += fmt.Sprintf(
+					"\tmarket %d price level at %s(is buy: %v) should have %s open quantity and %d orders, "+
+						"but has %s open quantity and %d orders\n",
+					marketId, price, isBuy, expected.TotalOpenQuantity, expected.NumOrders,
+					level.TotalOpenQuantity, level.NumOrders)
+				cnt++
+			}
+			return false
+		})
+		missingKeys := make([]string, 0, len(expectedLevels))
+		for key := range expectedLevels {
+			missingKeys = append(missingKeys, key)
+		}
+		sort.Strings(missingKeys)
+		for _, key := range missingKeys {
+			marketId, isBuy, price := types.ParsePriceLevelKey([]byte(key))
+			msg += fmt.Sprintf("\tmarket %d has no price level at %s(is buy: %v)\n", marketId, price, isBuy)
+			cnt++
+		}
+		broken := cnt != 0
+		return sdk.FormatInvariant(
+			types.ModuleName, "price level",
+			fmt.Sprintf("found %d wrong price level state(s)\n%s", cnt, msg)), broken
+	}
+}
@@ -115,3 +115,43 @@ func (s *KeeperTestSuite) TestNumMMOrdersInvariant() {
 	_, broken = keeper.NumMMOrdersInvariant(s.keeper)(s.Ctx)
 	s.Require().True(broken)
 }
+
+func (s *KeeperTestSuite) TestPriceLevelInvariant() {
+	market := s.CreateMarket("ucre", "uusd")
+	ordererAddr1 := s.FundedAccount(1, enoughCoins)
+	ordererAddr2 := s.FundedAccount(2, enoughCoins)
+
+	s.PlaceLimitOrder(market.Id, ordererAddr1, true, utils.ParseDec("4.99"), sdk.NewDec(10_000000), time.Hour)
+	_, order, _ := s.PlaceLimitOrder(market.Id, ordererAddr1, true, utils.ParseDec("4.99"), sdk.NewDec(5_000000), time.Hour)
+	s.PlaceLimitOrder(market.Id, ordererAddr2, false, utils.ParseDec("5.01"), sdk.NewDec(5_000000), time.Hour)
+	s.PlaceLimitOrder(market.Id, ordererAddr2, false, utils.ParseDec("4.99"), sdk.NewDec(3_000000), time.Hour)
+
+	_, broken := keeper.PriceLevelInvariant(s.keeper)(s.Ctx)
+	s.Require().False(broken)
+
+	level, found := s.keeper.GetPriceLevel(s.Ctx, market.Id, true, order.Price)
+	s.Require().True(found)
+	origLevel := level
+	level.TotalOpenQuantity = level.TotalOpenQuantity.Add(sdk.NewDec(1))
+	s.keeper.SetPriceLevel(s.Ctx, market.Id, true, order.Price, level)
+	_, broken = keeper.PriceLevelInvariant(s.keeper)(s.Ctx)
+	s.Require().True(broken)
+
+	level = origLevel
+	level.NumOrders--
+	s.keeper.SetPriceLevel(s.Ctx, market.Id, true, order.Price, level)
+	_, broken = keeper.PriceLevelInvariant(s.keeper)(s.Ctx)
+	s.Require().True(broken)
+
+	s.keeper.DeletePriceLevel(s.Ctx, market.Id, true, order.Price)
+	_, broken = keeper.PriceLevelInvariant(s.keeper)(s.Ctx)
+	s.Require().True(broken)
+
+	s.keeper.SetPriceLevel(s.Ctx, market.Id, true, order.Price, origLevel)
+	_, broken = keeper.PriceLevelInvariant(s.keeper)(s.Ctx)
+	s.Require().False(broken)
+
+	s.keeper.SetPriceLevel(s.Ctx, market.Id, false, utils.ParseDec("5.02"), origLevel)
+	_, broken = keeper.PriceLevelInvariant(s.keeper)(s.Ctx)
+	s.Require().True(broken)
+}
@@ -20,6 +20,7 @@ type Keeper struct {
 	bankKeeper  types.BankKeeper
 	sources     map[string]types.OrderSource
 	sourceNames []string
+	hooks       types.ExchangeHooks
 }
 
 // NewKeeper creates a new Keeper instance.
@@ -61,3 +62,16 @@ func (k *Keeper) SetOrderSources(sources ...types.OrderSource) *Keeper {
 	}
 	return k
 }
+
+// SetHooks sets the exchange hooks. It must be called before the keeper is
+// copied into other keepers or modules.
+// Calling SetHooks without hooks leaves the keeper without hooks.
+func (k *Keeper) SetHooks(hooks ...types.ExchangeHooks) *Keeper {
+	if k.hooks != nil {
+		panic("cannot set exchange hooks twice")
+	}
+	if len(hooks) > 0 {
+		k.hooks = types.NewMultiExchangeHooks(hooks...)
+	}
+	return k
+}
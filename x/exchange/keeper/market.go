@@ -8,7 +8,8 @@ import (
 )
 
 func (k Keeper) CreateMarket(
-	ctx sdk.Context, creatorAddr sdk.AccAddress, baseDenom, quoteDenom string) (market types.Market, err error) {
+	ctx sdk.Context, creatorAddr sdk.AccAddress, baseDenom, quoteDenom string,
+	tickSize, minOrderQty, lotSize sdk.Dec) (market types.Market, err error) {
 	if !k.bankKeeper.HasSupply(ctx, baseDenom) {
 		err = sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "base denom %s has no supply", baseDenom)
 		return
@@ -31,9 +32,13 @@ func (k Keeper) CreateMarket(
 	market = types.NewMarket(
 		marketId, baseDenom, quoteDenom,
 		fees.DefaultMakerFeeRate, fees.DefaultTakerFeeRate, fees.DefaultOrderSourceFeeRatio)
+	market.TickSize = tickSize
+	market.MinOrderQuantity = minOrderQty
+	market.LotSize = lotSize
 	k.SetMarket(ctx, market)
 	k.SetMarketByDenomsIndex(ctx, market)
 	k.SetMarketState(ctx, market.Id, types.NewMarketState(nil))
+	k.SetActiveMarketsByDenomIndex(ctx, market)
 
 	if err = ctx.EventManager().EmitTypedEvent(&types.EventCreateMarket{
 		Creator:    creatorAddr.String(),
@@ -43,6 +48,11 @@ func (k Keeper) CreateMarket(
 	}); err != nil {
 		return
 	}
+	if k.hooks != nil {
+		if err = k.hooks.AfterMarketCreated(ctx, market); err != nil {
+			return
+		}
+	}
 
 	return market, nil
 }
@@ -64,3 +74,36 @@ func (k Keeper) ReleaseCoins(
 	}
 	return nil
 }
+
+// cancelAllMarketOrders cancels all orders and trigger orders in the market
+// and refunds their deposits.
+func (k Keeper) cancelAllMarketOrders(ctx sdk.Context, market types.Market) (cancelledOrderIds []uint64, err error) {
+	var orders []types.Order
+	k.IterateOrdersByMarket(ctx, market.Id, func(order types.Order) (stop bool) {
+		orders = append(orders, order)
+		return false
+	})
+	for _, order := range orders {
+		if err := k.cancelOrder(ctx, market, order); err != nil {
+			return nil, err
+		}
+		if k.hooks != nil {
+			if err := k.hooks.AfterOrderCanceled(ctx, order); err != nil {
+				return nil, err
+			}
+		}
+		cancelledOrderIds = append(cancelledOrderIds, order.Id)
+	}
+	var triggerOrders []types.TriggerOrder
+	k.IterateTriggerOrdersByMarket(ctx, market.Id, func(order types.TriggerOrder) (stop bool) {
+		triggerOrders = append(triggerOrders, order)
+		return false
+	})
+	for _, order := range triggerOrders {
+		if err := k.cancelTriggerOrder(ctx, market, order); err != nil {
+			return nil, err
+		}
+		cancelledOrderIds = append(cancelledOrderIds, order.Id)
+	}
+	return cancelledOrderIds, nil
+}
@@ -19,12 +19,35 @@ func (k Keeper) ConstructMemOrderBookSide(
 		}
 		for _, order := range orders {
 			obs.AddOrder(types.NewUserMemOrder(order))
-			accQty = accQty.Add(order.OpenQuantity)
-			accQuote = accQuote.Add(types.QuoteAmount(!opts.IsBuy, order.Price, order.OpenQuantity))
+			accQty = accQty.Add(order.VisibleQuantity())
+			accQuote = accQuote.Add(types.QuoteAmount(!opts.IsBuy, order.Price, order.VisibleQuantity()))
 		}
 		numPriceLevels++
 		return false
 	})
+	k.addOrderSourceMemOrders(ctx, market, obs, opts, escrow)
+	if opts.MaxNumPriceLevels > 0 {
+		// TODO: can refund?
+		obs.Limit(opts.MaxNumPriceLevels)
+	}
+	return obs
+}
+
+// constructOrderSourceMemOrderBookSide constructs an order book side only with
+// orders from order sources.
+func (k Keeper) constructOrderSourceMemOrderBookSide(
+	ctx sdk.Context, market types.Market, opts types.MemOrderBookSideOptions) *types.MemOrderBookSide {
+	obs := types.NewMemOrderBookSide(opts.IsBuy)
+	k.addOrderSourceMemOrders(ctx, market, obs, opts, nil)
+	if opts.MaxNumPriceLevels > 0 {
+		obs.Limit(opts.MaxNumPriceLevels)
+	}
+	return obs
+}
+
+func (k Keeper) addOrderSourceMemOrders(
+	ctx sdk.Context, market types.Market, obs *types.MemOrderBookSide, opts types.MemOrderBookSideOptions,
+	escrow *types.Escrow) {
 	for _, name := range k.sourceNames {
 		source := k.sources[name]
 		if err := source.ConstructMemOrderBookSide(ctx, market, func(ordererAddr sdk.AccAddress, price, qty, openQty sdk.Dec) {
@@ -38,46 +61,169 @@ func (k Keeper) ConstructMemOrderBookSide(
 			panic(err)
 		}
 	}
-	if opts.MaxNumPriceLevels > 0 {
-		// TODO: can refund?
-		obs.Limit(opts.MaxNumPriceLevels)
-	}
-	return obs
 }
 
+// executeOrder executes an order against the order book side constructed with
+// opts. orderId is only used to report the taker's fill to the hooks and
+// self-trade prevention events and should be 0 for swaps.
+// referrerAddr, if not empty, receives a share of the taker fee.
+// Since iceberg orders replenished after the matching may still match the
+// order, the order is executed again until no iceberg order is replenished.
+// Replenishment is not simulated, so the result of a simulation can be
+// smaller than the actual result.
+// Trigger orders are not executed here; the caller must call
+// executeTriggerOrders after the whole order or swap completes.
 func (k Keeper) executeOrder(
-	ctx sdk.Context, market types.Market, ordererAddr sdk.AccAddress,
-	opts types.MemOrderBookSideOptions, halveFees, simulate bool) (res types.ExecuteOrderResult, err error) {
+	ctx sdk.Context, market types.Market, ordererAddr sdk.AccAddress, orderId uint64,
+	selfTradePrevention types.SelfTradePrevention, referrerAddr sdk.AccAddress, opts types.MemOrderBookSideOptions,
+	halveFees, simulate bool) (res types.ExecuteOrderResult, err error) {
 	if simulate {
 		ctx, _ = ctx.CacheContext()
 	}
+	res = types.NewExecuteOrderResult(types.PayReceiveDenoms(market.BaseDenom, market.QuoteDenom, !opts.IsBuy))
+	for {
+		var (
+			stepRes     types.ExecuteOrderResult
+			replenished bool
+		)
+		stepRes, replenished, err = k.matchOrder(
+			ctx, market, ordererAddr, orderId, selfTradePrevention, referrerAddr, opts, halveFees, simulate)
+		if err != nil {
+			return
+		}
+		res.Add(stepRes)
+		if !replenished || !stepRes.Executed() || stepRes.FullyExecuted ||
+			stepRes.SelfTradePrevented || stepRes.DecrementedQuantity.IsPositive() {
+			break
+		}
+		if opts.QuantityLimit != nil {
+			qtyLimit := opts.QuantityLimit.Sub(stepRes.ExecutedQuantity)
+			opts.QuantityLimit = &qtyLimit
+		}
+		if opts.QuoteLimit != nil {
+			quoteLimit := opts.QuoteLimit.Sub(stepRes.ExecutedQuote)
+			opts.QuoteLimit = &quoteLimit
+		}
+	}
+	if simulate || !res.Executed() {
+		return
+	}
+	if k.tracksVolume(ctx, market) {
+		k.addAccountVolume(ctx, ordererAddr, res.ExecutedQuote)
+	}
+	if !referrerAddr.Empty() {
+		k.addReferrerStats(ctx, referrerAddr, market.Id, res.ExecutedQuote, res.ReferralFee)
+	}
+	if k.hooks != nil {
+		if err = k.hooks.AfterOrderFilled(ctx, market, types.OrderFill{
+			OrderId:          orderId,
+			Orderer:          ordererAddr,
+			IsBuy:            !opts.IsBuy,
+			IsMaker:          false,
+			ExecutedQuantity: res.ExecutedQuantity,
+			ExecutedQuote:    res.ExecutedQuote,
+			Paid:             res.Paid,
+			Received:         res.Received,
+			Fee:              res.Fee.Amount,
+		}); err != nil {
+			return
+		}
+	}
+	state := k.MustGetMarketState(ctx, market.Id)
+	state.LastPrice = &res.LastPrice
+	state.LastMatchingHeight = ctx.BlockHeight()
+	state.AddVolume(ctx.BlockTime(), res.ExecutedQuantity, res.ExecutedQuote)
+	k.updatePriceObservation(ctx, market.Id, &state, res.LastPrice)
+	if err = k.checkCircuitBreaker(ctx, market.Id, &state); err != nil {
+		return
+	}
+	k.SetMarketState(ctx, market.Id, state)
+	return
+}
+
+// matchOrder matches an order against the order book side once and settles
+// the result. It returns whether any iceberg order has been replenished.
+func (k Keeper) matchOrder(
+	ctx sdk.Context, market types.Market, ordererAddr sdk.AccAddress, orderId uint64,
+	selfTradePrevention types.SelfTradePrevention, referrerAddr sdk.AccAddress, opts types.MemOrderBookSideOptions,
+	halveFees, simulate bool) (res types.ExecuteOrderResult, replenished bool, err error) {
 	escrow := types.NewEscrow(market.MustGetEscrowAddress())
-	mCtx := types.NewMatchingContext(market, halveFees)
+	mCtx := k.newMatchingContext(ctx, market, halveFees)
 	obs := k.ConstructMemOrderBookSide(ctx, market, opts, escrow)
-	res = mCtx.ExecuteOrder(obs, opts.QuantityLimit, opts.QuoteLimit)
+	res = mCtx.ExecuteOrder(
+		obs, ordererAddr, orderId, selfTradePrevention, referrerAddr, opts.QuantityLimit, opts.QuoteLimit)
 	if res.Executed() {
 		res.Paid.Amount = res.Paid.Amount.Ceil()
 		res.Received.Amount = res.Received.Amount.TruncateDec()
+		res.ReferralFee.Amount = res.ReferralFee.Amount.TruncateDec()
 		// TODO fee?
-		if !simulate {
-			escrow.Lock(ordererAddr, res.Paid)
-			escrow.Unlock(ordererAddr, res.Received)
-			if err = k.finalizeMatching(ctx, market, obs.Orders(), escrow); err != nil {
-				return
-			}
-			state := k.MustGetMarketState(ctx, market.Id)
-			state.LastPrice = &res.LastPrice
-			state.LastMatchingHeight = ctx.BlockHeight()
-			k.SetMarketState(ctx, market.Id, state)
+	}
+	// Orders cancelled or decremented by self-trade prevention must be settled
+	// even if the order hasn't been executed.
+	if simulate || (!res.Executed() && len(mCtx.PreventedSelfTrades()) == 0) {
+		return
+	}
+	if err = k.emitPreventedSelfTrades(ctx, mCtx); err != nil {
+		return
+	}
+	if res.Executed() {
+		escrow.Lock(ordererAddr, res.Paid)
+		escrow.Unlock(ordererAddr, res.Received)
+		if res.ReferralFee.IsPositive() {
+			escrow.Unlock(referrerAddr, res.ReferralFee)
 		}
 	}
+	replenished, err = k.finalizeMatching(ctx, market, obs.Orders(), escrow)
 	return
 }
 
-func (k Keeper) finalizeMatching(ctx sdk.Context, market types.Market, orders []*types.MemOrder, escrow *types.Escrow) error {
+func (k Keeper) emitPreventedSelfTrades(ctx sdk.Context, mCtx *types.MatchingContext) error {
+	for _, event := range mCtx.PreventedSelfTrades() {
+		event := event
+		if err := ctx.EventManager().EmitTypedEvent(&event); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// settleSelfTradePrevention applies the result of self-trade prevention to a
+// user order. order's open quantity must already be updated.
+// It returns true if the order has been removed.
+func (k Keeper) settleSelfTradePrevention(
+	ctx sdk.Context, market types.Market, memOrder *types.MemOrder, order *types.Order,
+	escrow *types.Escrow) (removed bool, err error) {
+	depositDenom, _ := types.PayReceiveDenoms(market.BaseDenom, market.QuoteDenom, order.IsBuy)
+	ordererAddr := memOrder.OrdererAddress()
+	if memOrder.IsSelfTradeCancelled() {
+		escrow.Unlock(ordererAddr, sdk.NewDecCoinFromDec(depositDenom, order.RemainingDeposit.TruncateDec()))
+		k.removeOrder(ctx, market, *order)
+		if k.hooks != nil {
+			if err := k.hooks.AfterOrderCanceled(ctx, *order); err != nil {
+				return false, err
+			}
+		}
+		return true, nil
+	}
+	if memOrder.DecrementedQuantity().IsPositive() {
+		// Refund the deposit no longer needed for the decremented quantity.
+		requiredDeposit := types.DepositAmount(order.IsBuy, order.Price, order.OpenQuantity).Ceil()
+		if refund := order.RemainingDeposit.Sub(requiredDeposit).TruncateDec(); refund.IsPositive() {
+			escrow.Unlock(ordererAddr, sdk.NewDecCoinFromDec(depositDenom, refund))
+			order.RemainingDeposit = order.RemainingDeposit.Sub(refund)
+		}
+	}
+	return false, nil
+}
+
+// finalizeMatching applies the match results of the orders.
+// It returns whether any iceberg order has been replenished.
+func (k Keeper) finalizeMatching(
+	ctx sdk.Context, market types.Market, orders []*types.MemOrder, escrow *types.Escrow) (replenished bool, err error) {
 	if escrow == nil {
 		escrow = types.NewEscrow(market.MustGetEscrowAddress())
 	}
+	tracksVolume := k.tracksVolume(ctx, market)
 	var sourceNames []string
 	ordersBySource := map[string][]*types.MemOrder{}
 	for _, memOrder := range orders {
@@ -98,8 +244,17 @@ func (k Keeper) finalizeMatching(ctx sdk.Context, market types.Market, orders []
 				paid := memOrder.Paid().Ceil()
 				receivedCoin.Amount = receivedCoin.Amount.TruncateDec()
 				order := memOrder.Order()
-				order.OpenQuantity = order.OpenQuantity.Sub(memOrder.ExecutedQuantity())
+				filledQty := memOrder.ExecutedQuantity().Add(memOrder.DecrementedQuantity())
+				order.OpenQuantity = order.OpenQuantity.Sub(filledQty)
+				k.updatePriceLevel(ctx, order.MarketId, order.IsBuy, order.Price, filledQty.Neg(), 0)
 				order.RemainingDeposit = order.RemainingDeposit.Sub(paid)
+				referralFee := sdk.NewDecCoinFromDec(receiveDenom, memOrder.ReferralFee().TruncateDec())
+				if referrerAddr := memOrder.Referrer(); !referrerAddr.Empty() {
+					if referralFee.IsPositive() {
+						escrow.Unlock(referrerAddr, referralFee)
+					}
+					k.addReferrerStats(ctx, referrerAddr, market.Id, memOrder.ExecutedQuote(), referralFee)
+				}
 				if err := ctx.EventManager().EmitTypedEvent(&types.EventOrderFilled{
 					MarketId:         market.Id,
 					OrderId:          order.Id,
@@ -111,26 +266,75 @@ func (k Keeper) finalizeMatching(ctx sdk.Context, market types.Market, orders []
 					ExecutedQuantity: memOrder.ExecutedQuantity(),
 					Paid:             sdk.NewDecCoinFromDec(payDenom, paid),
 					Received:         receivedCoin,
+					Referrer:         order.Referrer,
+					ReferralFee:      referralFee,
 				}); err != nil {
-					return err
+					return false, err
 				}
-				// Update user orders
-				executableQty := order.ExecutableQuantity()
-				if executableQty.TruncateDec().IsZero() ||
-					!order.IsBuy && executableQty.MulTruncate(order.Price).TruncateDec().IsZero() {
-					if err := k.cancelOrder(ctx, market, order); err != nil {
-						return err
-					}
-					if err := ctx.EventManager().EmitTypedEvent(&types.EventOrderCompleted{
-						OrderId: order.Id,
+				if tracksVolume {
+					k.addAccountVolume(ctx, ordererAddr, memOrder.ExecutedQuote())
+				}
+				if k.hooks != nil {
+					if err := k.hooks.AfterOrderFilled(ctx, market, types.OrderFill{
+						OrderId:          order.Id,
+						Orderer:          ordererAddr,
+						IsBuy:            order.IsBuy,
+						IsMaker:          memOrder.IsMaker(),
+						ExecutedQuantity: memOrder.ExecutedQuantity(),
+						ExecutedQuote:    memOrder.ExecutedQuote(),
+						Paid:             sdk.NewDecCoinFromDec(payDenom, paid),
+						Received:         receivedCoin,
+						Fee:              memOrder.Fee(),
 					}); err != nil {
-						return err
+						return false, err
+					}
+				}
+				// Update user orders
+				removed, err := k.settleSelfTradePrevention(ctx, market, memOrder, &order, escrow)
+				if err != nil {
+					return false, err
+				}
+				if !removed {
+					executableQty := order.ExecutableQuantity()
+					if executableQty.TruncateDec().IsZero() ||
+						!order.IsBuy && executableQty.MulTruncate(order.Price).TruncateDec().IsZero() {
+						if err := k.cancelOrder(ctx, market, order); err != nil {
+							return false, err
+						}
+						if err := ctx.EventManager().EmitTypedEvent(&types.EventOrderCompleted{
+							OrderId: order.Id,
+						}); err != nil {
+							return false, err
+						}
+					} else {
+						orderReplenished, err := k.replenishIcebergOrder(ctx, &order)
+						if err != nil {
+							return false, err
+						}
+						replenished = replenished || orderReplenished
+						k.SetOrder(ctx, order)
 					}
-				} else {
-					k.SetOrder(ctx, order)
 				}
 			}
 			escrow.Unlock(ordererAddr, receivedCoin)
+		} else if memOrder.Type() == types.UserMemOrder &&
+			(memOrder.IsSelfTradeCancelled() || memOrder.DecrementedQuantity().IsPositive()) {
+			order := memOrder.Order()
+			order.OpenQuantity = order.OpenQuantity.Sub(memOrder.DecrementedQuantity())
+			k.updatePriceLevel(
+				ctx, order.MarketId, order.IsBuy, order.Price, memOrder.DecrementedQuantity().Neg(), 0)
+			removed, err := k.settleSelfTradePrevention(ctx, market, memOrder, &order, escrow)
+			if err != nil {
+				return false, err
+			}
+			if !removed {
+				orderReplenished, err := k.replenishIcebergOrder(ctx, &order)
+				if err != nil {
+					return false, err
+				}
+				replenished = replenished || orderReplenished
+				k.SetOrder(ctx, order)
+			}
 		}
 		// Should refund deposit
 		if memOrder.Type() == types.OrderSourceMemOrder && memOrder.RemainingDeposit().IsPositive() {
@@ -138,18 +342,17 @@ func (k Keeper) finalizeMatching(ctx sdk.Context, market types.Market, orders []
 		}
 	}
 	if err := escrow.Transact(ctx, k.bankKeeper); err != nil {
-		return err
+		return false, err
 	}
 	for _, sourceName := range sourceNames {
 		results := ordersBySource[sourceName]
 		if len(results) > 0 {
 			source := k.sources[sourceName]
-			// TODO: pass grouped results
 			totalExecQty := utils.ZeroDec
-			ordererAddrs, m := types.GroupMemOrdersByOrderer(results) // TODO: bit redundant
+			ordererAddrs, m := types.GroupMemOrdersByOrderer(results)
 			for _, ordererAddr := range ordererAddrs {
-				if err := source.AfterOrdersExecuted(ctx, market, ordererAddr, results); err != nil {
-					return err
+				if err := source.AfterOrdersExecuted(ctx, market, ordererAddr, m[ordererAddr.String()]); err != nil {
+					return false, err
 				}
 				var (
 					isBuy         bool
@@ -158,6 +361,22 @@ func (k Keeper) finalizeMatching(ctx sdk.Context, market types.Market, orders []
 					totalFee      sdk.Dec
 				)
 				for _, order := range m[ordererAddr.String()] {
+					if k.hooks != nil {
+						payDenom, receiveDenom := types.PayReceiveDenoms(market.BaseDenom, market.QuoteDenom, order.IsBuy())
+						if err := k.hooks.AfterOrderFilled(ctx, market, types.OrderFill{
+							SourceName:       sourceName,
+							Orderer:          ordererAddr,
+							IsBuy:            order.IsBuy(),
+							IsMaker:          order.IsMaker(),
+							ExecutedQuantity: order.ExecutedQuantity(),
+							ExecutedQuote:    order.ExecutedQuote(),
+							Paid:             sdk.NewDecCoinFromDec(payDenom, order.Paid()),
+							Received:         sdk.NewDecCoinFromDec(receiveDenom, order.Received()),
+							Fee:              order.Fee(),
+						}); err != nil {
+							return false, err
+						}
+					}
 					totalExecQty = totalExecQty.Add(order.ExecutedQuantity())
 					if totalPaid.IsNil() {
 						isBuy = order.IsBuy()
@@ -189,23 +408,33 @@ func (k Keeper) finalizeMatching(ctx sdk.Context, market types.Market, orders []
 					Paid:             paid,
 					Received:         received,
 				}); err != nil {
-					return err
+					return false, err
 				}
 			}
 		}
 	}
-	return nil
+	return replenished, nil
 }
 
 // getBestPrice returns the best(the highest for buy and the lowest for sell)
 // price on the order book.
+// The best price of user orders is read from the price level index, so
+// individual orders are not loaded.
 func (k Keeper) getBestPrice(ctx sdk.Context, market types.Market, isBuy bool) (bestPrice sdk.Dec, found bool) {
-	obs := k.ConstructMemOrderBookSide(ctx, market, types.MemOrderBookSideOptions{
+	k.IteratePriceLevels(ctx, market.Id, isBuy, nil, func(price sdk.Dec, _ types.PriceLevel) (stop bool) {
+		bestPrice = price
+		found = true
+		return true
+	})
+	obs := k.constructOrderSourceMemOrderBookSide(ctx, market, types.MemOrderBookSideOptions{
 		IsBuy:             isBuy,
 		MaxNumPriceLevels: 1,
-	}, nil)
+	})
 	if len(obs.Levels()) > 0 {
-		return obs.Levels()[0].Price(), true
+		price := obs.Levels()[0].Price()
+		if !found || (isBuy && price.GT(bestPrice)) || (!isBuy && price.LT(bestPrice)) {
+			return price, true
+		}
 	}
-	return bestPrice, false
+	return bestPrice, found
 }
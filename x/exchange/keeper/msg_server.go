@@ -5,6 +5,7 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	utils "github.com/crescent-network/crescent/v5/types"
 	"github.com/crescent-network/crescent/v5/x/exchange/types"
 )
 
@@ -22,8 +23,19 @@ func NewMsgServerImpl(keeper Keeper) types.MsgServer {
 
 func (k msgServer) CreateMarket(goCtx context.Context, msg *types.MsgCreateMarket) (*types.MsgCreateMarketResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
+	tickSize, minOrderQty, lotSize := utils.ZeroDec, utils.ZeroDec, utils.ZeroDec
+	if msg.TickSize != nil {
+		tickSize = *msg.TickSize
+	}
+	if msg.MinOrderQuantity != nil {
+		minOrderQty = *msg.MinOrderQuantity
+	}
+	if msg.LotSize != nil {
+		lotSize = *msg.LotSize
+	}
 	market, err := k.Keeper.CreateMarket(
-		ctx, sdk.MustAccAddressFromBech32(msg.Sender), msg.BaseDenom, msg.QuoteDenom)
+		ctx, sdk.MustAccAddressFromBech32(msg.Sender), msg.BaseDenom, msg.QuoteDenom,
+		tickSize, minOrderQty, lotSize)
 	if err != nil {
 		return nil, err
 	}
@@ -32,9 +44,10 @@ func (k msgServer) CreateMarket(goCtx context.Context, msg *types.MsgCreateMarke
 
 func (k msgServer) PlaceLimitOrder(goCtx context.Context, msg *types.MsgPlaceLimitOrder) (*types.MsgPlaceLimitOrderResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
-	orderId, _, res, err := k.Keeper.PlaceLimitOrder(
+	orderId, _, res, rejectReason, err := k.Keeper.PlaceLimitOrder(
 		ctx, msg.MarketId, sdk.MustAccAddressFromBech32(msg.Sender),
-		msg.IsBuy, msg.Price, msg.Quantity, msg.Lifespan)
+		msg.IsBuy, msg.Price, msg.Quantity, msg.DisplayQuantity, msg.Lifespan, msg.TimeInForce, msg.SelfTradePrevention,
+		referrerAddress(msg.Referrer))
 	if err != nil {
 		return nil, err
 	}
@@ -43,27 +56,29 @@ func (k msgServer) PlaceLimitOrder(goCtx context.Context, msg *types.MsgPlaceLim
 		ExecutedQuantity: res.ExecutedQuantity,
 		Paid:             res.Paid,
 		Received:         res.Received,
+		RejectReason:     rejectReason,
 	}, nil
 }
 
 func (k msgServer) PlaceBatchLimitOrder(goCtx context.Context, msg *types.MsgPlaceBatchLimitOrder) (*types.MsgPlaceBatchLimitOrderResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
-	order, err := k.Keeper.PlaceBatchLimitOrder(
+	order, rejectReason, err := k.Keeper.PlaceBatchLimitOrder(
 		ctx, msg.MarketId, sdk.MustAccAddressFromBech32(msg.Sender),
-		msg.IsBuy, msg.Price, msg.Quantity, msg.Lifespan)
+		msg.IsBuy, msg.Price, msg.Quantity, msg.Lifespan, msg.TimeInForce, msg.SelfTradePrevention)
 	if err != nil {
 		return nil, err
 	}
 	return &types.MsgPlaceBatchLimitOrderResponse{
-		OrderId: order.Id,
+		OrderId:      order.Id,
+		RejectReason: rejectReason,
 	}, nil
 }
 
 func (k msgServer) PlaceMMLimitOrder(goCtx context.Context, msg *types.MsgPlaceMMLimitOrder) (*types.MsgPlaceMMLimitOrderResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
-	orderId, _, res, err := k.Keeper.PlaceMMLimitOrder(
+	orderId, _, res, rejectReason, err := k.Keeper.PlaceMMLimitOrder(
 		ctx, msg.MarketId, sdk.MustAccAddressFromBech32(msg.Sender),
-		msg.IsBuy, msg.Price, msg.Quantity, msg.Lifespan)
+		msg.IsBuy, msg.Price, msg.Quantity, msg.Lifespan, msg.TimeInForce, msg.SelfTradePrevention)
 	if err != nil {
 		return nil, err
 	}
@@ -72,19 +87,21 @@ func (k msgServer) PlaceMMLimitOrder(goCtx context.Context, msg *types.MsgPlaceM
 		ExecutedQuantity: res.ExecutedQuantity,
 		Paid:             res.Paid,
 		Received:         res.Received,
+		RejectReason:     rejectReason,
 	}, nil
 }
 
 func (k msgServer) PlaceMMBatchLimitOrder(goCtx context.Context, msg *types.MsgPlaceMMBatchLimitOrder) (*types.MsgPlaceMMBatchLimitOrderResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
-	order, err := k.Keeper.PlaceMMBatchLimitOrder(
+	order, rejectReason, err := k.Keeper.PlaceMMBatchLimitOrder(
 		ctx, msg.MarketId, sdk.MustAccAddressFromBech32(msg.Sender),
-		msg.IsBuy, msg.Price, msg.Quantity, msg.Lifespan)
+		msg.IsBuy, msg.Price, msg.Quantity, msg.Lifespan, msg.TimeInForce, msg.SelfTradePrevention)
 	if err != nil {
 		return nil, err
 	}
 	return &types.MsgPlaceMMBatchLimitOrderResponse{
-		OrderId: order.Id,
+		OrderId:      order.Id,
+		RejectReason: rejectReason,
 	}, nil
 }
 
@@ -92,7 +109,7 @@ func (k msgServer) PlaceMarketOrder(goCtx context.Context, msg *types.MsgPlaceMa
 	ctx := sdk.UnwrapSDKContext(goCtx)
 	orderId, res, err := k.Keeper.PlaceMarketOrder(
 		ctx, msg.MarketId, sdk.MustAccAddressFromBech32(msg.Sender),
-		msg.IsBuy, msg.Quantity)
+		msg.IsBuy, msg.Quantity, referrerAddress(msg.Referrer))
 	if err != nil {
 		return nil, err
 	}
@@ -106,8 +123,14 @@ func (k msgServer) PlaceMarketOrder(goCtx context.Context, msg *types.MsgPlaceMa
 
 func (k msgServer) CancelOrder(goCtx context.Context, msg *types.MsgCancelOrder) (*types.MsgCancelOrderResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
-	_, err := k.Keeper.CancelOrder(
-		ctx, sdk.MustAccAddressFromBech32(msg.Sender), msg.OrderId)
+	var err error
+	if found := k.LookupTriggerOrder(ctx, msg.OrderId); found {
+		_, err = k.Keeper.CancelTriggerOrder(
+			ctx, sdk.MustAccAddressFromBech32(msg.Sender), msg.OrderId)
+	} else {
+		_, err = k.Keeper.CancelOrder(
+			ctx, sdk.MustAccAddressFromBech32(msg.Sender), msg.OrderId)
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -130,8 +153,21 @@ func (k msgServer) CancelAllOrders(goCtx context.Context, msg *types.MsgCancelAl
 
 func (k msgServer) SwapExactAmountIn(goCtx context.Context, msg *types.MsgSwapExactAmountIn) (*types.MsgSwapExactAmountInResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
+	if len(msg.WeightedRoutes) > 0 {
+		output, weightedResults, err := k.Keeper.SwapExactAmountInWeightedRoutes(
+			ctx, sdk.MustAccAddressFromBech32(msg.Sender), msg.WeightedRoutes, msg.Input, msg.MinOutput,
+			referrerAddress(msg.Referrer), false)
+		if err != nil {
+			return nil, err
+		}
+		return &types.MsgSwapExactAmountInResponse{
+			Output:          output,
+			WeightedResults: weightedResults,
+		}, nil
+	}
 	output, results, err := k.Keeper.SwapExactAmountIn(
-		ctx, sdk.MustAccAddressFromBech32(msg.Sender), msg.Routes, msg.Input, msg.MinOutput, false)
+		ctx, sdk.MustAccAddressFromBech32(msg.Sender), msg.Routes, msg.Input, msg.MinOutput,
+		referrerAddress(msg.Referrer), false)
 	if err != nil {
 		return nil, err
 	}
@@ -140,3 +176,70 @@ func (k msgServer) SwapExactAmountIn(goCtx context.Context, msg *types.MsgSwapEx
 		Results: results,
 	}, nil
 }
+
+func (k msgServer) SwapExactAmountOut(goCtx context.Context, msg *types.MsgSwapExactAmountOut) (*types.MsgSwapExactAmountOutResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	input, results, err := k.Keeper.SwapExactAmountOut(
+		ctx, sdk.MustAccAddressFromBech32(msg.Sender), msg.Routes, msg.Output, msg.MaxInput, false)
+	if err != nil {
+		return nil, err
+	}
+	return &types.MsgSwapExactAmountOutResponse{
+		Input:   input,
+		Results: results,
+	}, nil
+}
+
+func (k msgServer) PlaceTriggerOrder(goCtx context.Context, msg *types.MsgPlaceTriggerOrder) (*types.MsgPlaceTriggerOrderResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	order, err := k.Keeper.PlaceTriggerOrder(
+		ctx, msg.MarketId, sdk.MustAccAddressFromBech32(msg.Sender), msg.IsBuy,
+		msg.Condition, msg.TriggerPrice, msg.Price, msg.Quantity, msg.Lifespan)
+	if err != nil {
+		return nil, err
+	}
+	return &types.MsgPlaceTriggerOrderResponse{
+		OrderId: order.Id,
+	}, nil
+}
+
+func (k msgServer) AmendOrder(goCtx context.Context, msg *types.MsgAmendOrder) (*types.MsgAmendOrderResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if _, err := k.Keeper.AmendOrder(
+		ctx, sdk.MustAccAddressFromBech32(msg.Sender), msg.OrderId,
+		msg.Price, msg.Quantity, msg.Lifespan); err != nil {
+		return nil, err
+	}
+	return &types.MsgAmendOrderResponse{}, nil
+}
+
+func (k msgServer) SetCancelAfter(goCtx context.Context, msg *types.MsgSetCancelAfter) (*types.MsgSetCancelAfterResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.Keeper.SetCancelAfter(
+		ctx, sdk.MustAccAddressFromBech32(msg.Sender), msg.MarketIds, msg.CancelAfter); err != nil {
+		return nil, err
+	}
+	return &types.MsgSetCancelAfterResponse{}, nil
+}
+
+func (k msgServer) ReplaceMMOrders(goCtx context.Context, msg *types.MsgReplaceMMOrders) (*types.MsgReplaceMMOrdersResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	cancelledOrderIds, orderIds, err := k.Keeper.ReplaceMMOrders(
+		ctx, sdk.MustAccAddressFromBech32(msg.Sender), msg.MarketId, msg.Orders)
+	if err != nil {
+		return nil, err
+	}
+	return &types.MsgReplaceMMOrdersResponse{
+		CancelledOrderIds: cancelledOrderIds,
+		OrderIds:          orderIds,
+	}, nil
+}
+
+// referrerAddress returns the address of the referrer, which is nil if
+// referrer is empty.
+func referrerAddress(referrer string) sdk.AccAddress {
+	if referrer == "" {
+		return nil
+	}
+	return sdk.MustAccAddressFromBech32(referrer)
+}
@@ -0,0 +1,70 @@
+package keeper
+
+import (
+	"sort"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/crescent-network/crescent/v5/x/exchange/types"
+)
+
+// updatePriceObservation records the market's new last price.
+// Only one observation is kept per block, so the price changes within a block
+// don't affect the cumulative price until the next block.
+// The caller must save the market state afterwards.
+func (k Keeper) updatePriceObservation(ctx sdk.Context, marketId uint64, marketState *types.MarketState, price sdk.Dec) {
+	now := ctx.BlockTime()
+	if marketState.NumPriceObservations > 0 {
+		lastObs := k.MustGetPriceObservation(ctx, marketId, marketState.LastPriceObservationIndex)
+		if lastObs.Time.Equal(now) {
+			lastObs.Price = price
+			k.SetPriceObservation(ctx, marketId, marketState.LastPriceObservationIndex, lastObs)
+			return
+		}
+		obs := types.NewPriceObservation(now, lastObs.CumulativePriceAt(now), price, ctx.BlockHeight())
+		index := types.NextPriceObservationIndex(*marketState)
+		k.SetPriceObservation(ctx, marketId, index, obs)
+		marketState.LastPriceObservationIndex = index
+		if marketState.NumPriceObservations < types.MaxNumPriceObservations {
+			marketState.NumPriceObservations++
+		}
+		return
+	}
+	k.SetPriceObservation(ctx, marketId, 0, types.NewPriceObservation(now, sdk.ZeroDec(), price, ctx.BlockHeight()))
+	marketState.LastPriceObservationIndex = 0
+	marketState.NumPriceObservations = 1
+}
+
+// TWAP returns the market's time-weighted average price over the window
+// ending at the current block time.
+func (k Keeper) TWAP(ctx sdk.Context, marketId uint64, window time.Duration) (twap sdk.Dec, err error) {
+	if window <= 0 {
+		return twap, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "window must be positive: %s", window)
+	}
+	marketState, found := k.GetMarketState(ctx, marketId)
+	if !found {
+		return twap, sdkerrors.Wrapf(sdkerrors.ErrNotFound, "market %d not found", marketId)
+	}
+	if marketState.NumPriceObservations == 0 {
+		return twap, sdkerrors.Wrapf(types.ErrNotEnoughPriceHistory, "market %d has no price observations", marketId)
+	}
+	now := ctx.BlockTime()
+	startTime := now.Add(-window)
+	oldestObs := k.MustGetPriceObservation(ctx, marketId, types.PriceObservationIndex(marketState, 0))
+	if startTime.Before(oldestObs.Time) {
+		return twap, sdkerrors.Wrapf(
+			types.ErrNotEnoughPriceHistory, "oldest price observation is at %s", oldestObs.Time)
+	}
+	// Find the latest observation made at or before the start time.
+	n := int(marketState.NumPriceObservations)
+	i := sort.Search(n, func(i int) bool {
+		obs := k.MustGetPriceObservation(ctx, marketId, types.PriceObservationIndex(marketState, uint32(i)))
+		return obs.Time.After(startTime)
+	}) - 1
+	startObs := k.MustGetPriceObservation(ctx, marketId, types.PriceObservationIndex(marketState, uint32(i)))
+	lastObs := k.MustGetPriceObservation(ctx, marketId, marketState.LastPriceObservationIndex)
+	cumulativePrice := lastObs.CumulativePriceAt(now).Sub(startObs.CumulativePriceAt(startTime))
+	return cumulativePrice.Quo(types.DurationToSeconds(window)), nil
+}
@@ -0,0 +1,76 @@
+package keeper_test
+
+import (
+	"time"
+
+	utils "github.com/crescent-network/crescent/v5/types"
+	"github.com/crescent-network/crescent/v5/x/exchange/types"
+)
+
+func (s *KeeperTestSuite) TestTWAP() {
+	market := s.CreateMarket("ucre", "uusd")
+	mmAddr := s.FundedAccount(1, enoughCoins)
+
+	_, err := s.keeper.TWAP(s.Ctx, market.Id, time.Minute)
+	s.Require().ErrorIs(err, types.ErrNotEnoughPriceHistory)
+
+	s.MakeLastPrice(market.Id, mmAddr, utils.ParseDec("5"))
+	s.NextBlock()
+	// Only the last price at the end of the block is used.
+	s.MakeLastPrice(market.Id, mmAddr, utils.ParseDec("5.4"))
+	s.MakeLastPrice(market.Id, mmAddr, utils.ParseDec("5.5"))
+	marketState := s.keeper.MustGetMarketState(s.Ctx, market.Id)
+	s.Require().EqualValues(2, marketState.NumPriceObservations)
+	s.Require().EqualValues(1, marketState.LastPriceObservationIndex)
+	s.NextBlock()
+
+	for _, tc := range []struct {
+		window      time.Duration
+		expected    string
+		expectedErr error
+	}{
+		{10 * time.Second, "5.250000000000000000", nil}, // (5*5 + 5.5*5) / 10
+		{5 * time.Second, "5.500000000000000000", nil},
+		{7 * time.Second, "5.357142857142857143", nil}, // (5*2 + 5.5*5) / 7
+		{11 * time.Second, "", types.ErrNotEnoughPriceHistory},
+	} {
+		twap, err := s.keeper.TWAP(s.Ctx, market.Id, tc.window)
+		if tc.expectedErr == nil {
+			s.Require().NoError(err)
+			s.Require().Equal(tc.expected, twap.String(), tc.window)
+		} else {
+			s.Require().ErrorIs(err, tc.expectedErr)
+		}
+	}
+	_, err = s.keeper.TWAP(s.Ctx, market.Id, 0)
+	s.Require().EqualError(err, "window must be positive: 0s: invalid request")
+}
+
+func (s *KeeperTestSuite) TestTWAP_RingWrapAround() {
+	market := s.CreateMarket("ucre", "uusd")
+	mmAddr := s.FundedAccount(1, enoughCoins)
+
+	numBlocks := int(types.MaxNumPriceObservations) + 10
+	for i := 0; i < numBlocks; i++ {
+		price := utils.ParseDec("5")
+		if i%2 == 1 {
+			price = utils.ParseDec("5.4")
+		}
+		s.MakeLastPrice(market.Id, mmAddr, price)
+		s.NextBlock()
+	}
+	marketState := s.keeper.MustGetMarketState(s.Ctx, market.Id)
+	s.Require().Equal(types.MaxNumPriceObservations, marketState.NumPriceObservations)
+	s.Require().EqualValues(9, marketState.LastPriceObservationIndex)
+
+	twap, err := s.keeper.TWAP(s.Ctx, market.Id, 100*time.Second)
+	s.Require().NoError(err)
+	s.Require().Equal("5.200000000000000000", twap.String())
+
+	// The oldest observations have been overwritten.
+	window := time.Duration(types.MaxNumPriceObservations) * 5 * time.Second
+	_, err = s.keeper.TWAP(s.Ctx, market.Id, window)
+	s.Require().NoError(err)
+	_, err = s.keeper.TWAP(s.Ctx, market.Id, window+5*time.Second)
+	s.Require().ErrorIs(err, types.ErrNotEnoughPriceHistory)
+}
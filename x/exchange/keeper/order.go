@@ -10,26 +10,53 @@ import (
 	"github.com/crescent-network/crescent/v5/x/exchange/types"
 )
 
+// PlaceLimitOrder places a limit order. referrerAddr, if not empty, receives a
+// share of the taker fee paid by the order.
+// If displayQty is not nil, the order rests on the order book as an iceberg
+// order which displays only displayQty at a time.
 func (k Keeper) PlaceLimitOrder(
 	ctx sdk.Context, marketId uint64, ordererAddr sdk.AccAddress,
-	isBuy bool, price, qty sdk.Dec, lifespan time.Duration) (orderId uint64, order types.Order, res types.ExecuteOrderResult, err error) {
-	orderId, order, res, err = k.placeLimitOrder(
-		ctx, types.OrderTypeLimit, marketId, ordererAddr, isBuy, price, qty, lifespan, false)
+	isBuy bool, price, qty sdk.Dec, displayQty *sdk.Dec, lifespan time.Duration, timeInForce types.TimeInForce,
+	selfTradePrevention types.SelfTradePrevention, referrerAddr sdk.AccAddress) (orderId uint64, order types.Order, res types.ExecuteOrderResult, rejectReason string, err error) {
+	orderId, order, res, rejectReason, err = k.placeLimitOrderWithEvent(
+		ctx, marketId, ordererAddr, isBuy, price, qty, displayQty, lifespan, timeInForce, selfTradePrevention, referrerAddr)
+	if err != nil {
+		return
+	}
+	if res.Executed() {
+		err = k.executeTriggerOrders(ctx, marketId)
+	}
+	return
+}
+
+// placeLimitOrderWithEvent places a limit order and emits the event without
+// executing trigger orders.
+func (k Keeper) placeLimitOrderWithEvent(
+	ctx sdk.Context, marketId uint64, ordererAddr sdk.AccAddress,
+	isBuy bool, price, qty sdk.Dec, displayQty *sdk.Dec, lifespan time.Duration, timeInForce types.TimeInForce,
+	selfTradePrevention types.SelfTradePrevention, referrerAddr sdk.AccAddress) (orderId uint64, order types.Order, res types.ExecuteOrderResult, rejectReason string, err error) {
+	orderId, order, res, rejectReason, err = k.placeLimitOrder(
+		ctx, types.OrderTypeLimit, marketId, ordererAddr, isBuy, price, qty, displayQty, lifespan, timeInForce, selfTradePrevention, referrerAddr, false, nil)
 	if err != nil {
 		return
 	}
 	if err = ctx.EventManager().EmitTypedEvent(&types.EventPlaceLimitOrder{
-		MarketId:         marketId,
-		OrderId:          orderId,
-		Orderer:          ordererAddr.String(),
-		IsBuy:            isBuy,
-		Price:            price,
-		Quantity:         qty,
-		Lifespan:         lifespan,
-		Deadline:         ctx.BlockTime().Add(lifespan),
-		ExecutedQuantity: res.ExecutedQuantity,
-		Paid:             res.Paid,
-		Received:         res.Received,
+		MarketId:            marketId,
+		OrderId:             orderId,
+		Orderer:             ordererAddr.String(),
+		IsBuy:               isBuy,
+		Price:               price,
+		Quantity:            qty,
+		Lifespan:            lifespan,
+		Deadline:            ctx.BlockTime().Add(lifespan),
+		ExecutedQuantity:    res.ExecutedQuantity,
+		Paid:                res.Paid,
+		Received:            res.Received,
+		TimeInForce:         timeInForce,
+		SelfTradePrevention: selfTradePrevention,
+		RejectReason:        rejectReason,
+		Referrer:            referrerAddr.String(),
+		DisplayQuantity:     displayQty,
 	}); err != nil {
 		return
 	}
@@ -38,21 +65,25 @@ func (k Keeper) PlaceLimitOrder(
 
 func (k Keeper) PlaceBatchLimitOrder(
 	ctx sdk.Context, marketId uint64, ordererAddr sdk.AccAddress,
-	isBuy bool, price, qty sdk.Dec, lifespan time.Duration) (order types.Order, err error) {
-	_, order, _, err = k.placeLimitOrder(
-		ctx, types.OrderTypeLimit, marketId, ordererAddr, isBuy, price, qty, lifespan, true)
+	isBuy bool, price, qty sdk.Dec, lifespan time.Duration, timeInForce types.TimeInForce,
+	selfTradePrevention types.SelfTradePrevention) (order types.Order, rejectReason string, err error) {
+	_, order, _, rejectReason, err = k.placeLimitOrder(
+		ctx, types.OrderTypeLimit, marketId, ordererAddr, isBuy, price, qty, nil, lifespan, timeInForce, selfTradePrevention, nil, true, nil)
 	if err != nil {
 		return
 	}
 	if err = ctx.EventManager().EmitTypedEvent(&types.EventPlaceBatchLimitOrder{
-		MarketId: marketId,
-		OrderId:  order.Id,
-		Orderer:  ordererAddr.String(),
-		IsBuy:    isBuy,
-		Price:    price,
-		Quantity: qty,
-		Lifespan: lifespan,
-		Deadline: ctx.BlockTime().Add(lifespan),
+		MarketId:            marketId,
+		OrderId:             order.Id,
+		Orderer:             ordererAddr.String(),
+		IsBuy:               isBuy,
+		Price:               price,
+		Quantity:            qty,
+		Lifespan:            lifespan,
+		Deadline:            ctx.BlockTime().Add(lifespan),
+		TimeInForce:         timeInForce,
+		SelfTradePrevention: selfTradePrevention,
+		RejectReason:        rejectReason,
 	}); err != nil {
 		return
 	}
@@ -61,47 +92,58 @@ func (k Keeper) PlaceBatchLimitOrder(
 
 func (k Keeper) PlaceMMLimitOrder(
 	ctx sdk.Context, marketId uint64, ordererAddr sdk.AccAddress,
-	isBuy bool, price, qty sdk.Dec, lifespan time.Duration) (orderId uint64, order types.Order, res types.ExecuteOrderResult, err error) {
-	orderId, order, res, err = k.placeLimitOrder(
-		ctx, types.OrderTypeMM, marketId, ordererAddr, isBuy, price, qty, lifespan, false)
+	isBuy bool, price, qty sdk.Dec, lifespan time.Duration, timeInForce types.TimeInForce,
+	selfTradePrevention types.SelfTradePrevention) (orderId uint64, order types.Order, res types.ExecuteOrderResult, rejectReason string, err error) {
+	orderId, order, res, rejectReason, err = k.placeLimitOrder(
+		ctx, types.OrderTypeMM, marketId, ordererAddr, isBuy, price, qty, nil, lifespan, timeInForce, selfTradePrevention, nil, false, nil)
 	if err != nil {
 		return
 	}
 	if err = ctx.EventManager().EmitTypedEvent(&types.EventPlaceMMLimitOrder{
-		MarketId:         marketId,
-		OrderId:          orderId,
-		Orderer:          ordererAddr.String(),
-		IsBuy:            isBuy,
-		Price:            price,
-		Quantity:         qty,
-		Lifespan:         lifespan,
-		Deadline:         ctx.BlockTime().Add(lifespan),
-		ExecutedQuantity: res.ExecutedQuantity,
-		Paid:             res.Paid,
-		Received:         res.Received,
+		MarketId:            marketId,
+		OrderId:             orderId,
+		Orderer:             ordererAddr.String(),
+		IsBuy:               isBuy,
+		Price:               price,
+		Quantity:            qty,
+		Lifespan:            lifespan,
+		Deadline:            ctx.BlockTime().Add(lifespan),
+		ExecutedQuantity:    res.ExecutedQuantity,
+		Paid:                res.Paid,
+		Received:            res.Received,
+		TimeInForce:         timeInForce,
+		SelfTradePrevention: selfTradePrevention,
+		RejectReason:        rejectReason,
 	}); err != nil {
 		return
 	}
+	if res.Executed() {
+		err = k.executeTriggerOrders(ctx, marketId)
+	}
 	return
 }
 
 func (k Keeper) PlaceMMBatchLimitOrder(
 	ctx sdk.Context, marketId uint64, ordererAddr sdk.AccAddress,
-	isBuy bool, price, qty sdk.Dec, lifespan time.Duration) (order types.Order, err error) {
-	_, order, _, err = k.placeLimitOrder(
-		ctx, types.OrderTypeMM, marketId, ordererAddr, isBuy, price, qty, lifespan, true)
+	isBuy bool, price, qty sdk.Dec, lifespan time.Duration, timeInForce types.TimeInForce,
+	selfTradePrevention types.SelfTradePrevention) (order types.Order, rejectReason string, err error) {
+	_, order, _, rejectReason, err = k.placeLimitOrder(
+		ctx, types.OrderTypeMM, marketId, ordererAddr, isBuy, price, qty, nil, lifespan, timeInForce, selfTradePrevention, nil, true, nil)
 	if err != nil {
 		return
 	}
 	if err = ctx.EventManager().EmitTypedEvent(&types.EventPlaceMMBatchLimitOrder{
-		MarketId: marketId,
-		OrderId:  order.Id,
-		Orderer:  ordererAddr.String(),
-		IsBuy:    isBuy,
-		Price:    price,
-		Quantity: qty,
-		Lifespan: lifespan,
-		Deadline: ctx.BlockTime().Add(lifespan),
+		MarketId:            marketId,
+		OrderId:             order.Id,
+		Orderer:             ordererAddr.String(),
+		IsBuy:               isBuy,
+		Price:               price,
+		Quantity:            qty,
+		Lifespan:            lifespan,
+		Deadline:            ctx.BlockTime().Add(lifespan),
+		TimeInForce:         timeInForce,
+		SelfTradePrevention: selfTradePrevention,
+		RejectReason:        rejectReason,
 	}); err != nil {
 		return
 	}
@@ -110,7 +152,9 @@ func (k Keeper) PlaceMMBatchLimitOrder(
 
 func (k Keeper) placeLimitOrder(
 	ctx sdk.Context, typ types.OrderType, marketId uint64, ordererAddr sdk.AccAddress,
-	isBuy bool, price, qty sdk.Dec, lifespan time.Duration, isBatch bool) (orderId uint64, order types.Order, res types.ExecuteOrderResult, err error) {
+	isBuy bool, price, qty sdk.Dec, displayQty *sdk.Dec, lifespan time.Duration, timeInForce types.TimeInForce,
+	selfTradePrevention types.SelfTradePrevention, referrerAddr sdk.AccAddress,
+	isBatch bool, escrow *types.Escrow) (orderId uint64, order types.Order, res types.ExecuteOrderResult, rejectReason string, err error) {
 	if !qty.IsPositive() { // sanity check
 		panic("quantity must be positive")
 	}
@@ -120,6 +164,25 @@ func (k Keeper) placeLimitOrder(
 		err = sdkerrors.Wrap(sdkerrors.ErrNotFound, "market not found")
 		return
 	}
+	if !market.IsActive() {
+		err = sdkerrors.Wrapf(types.ErrMarketNotActive, "market %d is %s", market.Id, market.Status)
+		return
+	}
+	// Orders placed while the market waits for the reopening auction are
+	// matched in the auction, just like batch orders.
+	var inAuction bool
+	if inAuction, err = k.validateCircuitBreaker(ctx, market, true); err != nil {
+		return
+	}
+	isBatch = isBatch || inAuction
+	if err = types.ValidateTimeInForce(timeInForce, isBatch); err != nil {
+		err = sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+		return
+	}
+	if err = types.ValidateSelfTradePrevention(selfTradePrevention); err != nil {
+		err = sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+		return
+	}
 
 	var (
 		maxNumMMOrders, numMMOrders uint32
@@ -133,15 +196,50 @@ func (k Keeper) placeLimitOrder(
 		}
 	}
 
-	marketState := k.MustGetMarketState(ctx, market.Id)
-	if marketState.LastPrice != nil {
-		maxPriceRatio := k.GetMaxOrderPriceRatio(ctx)
-		minPrice, maxPrice := types.OrderPriceLimit(*marketState.LastPrice, maxPriceRatio)
-		if isBuy && price.GT(maxPrice) {
-			err = sdkerrors.Wrapf(types.ErrOrderPriceOutOfRange, "price is higher than the limit %s", maxPrice)
+	if err = k.validateOrderPrice(ctx, market, isBuy, price); err != nil {
+		return
+	}
+	if err = market.ValidateOrderQuantity(qty); err != nil {
+		err = sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+		return
+	}
+	if displayQty != nil {
+		if timeInForce != types.TimeInForceGoodTilTime && timeInForce != types.TimeInForcePostOnly {
+			err = sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "iceberg order cannot be %s", timeInForce)
+			return
+		}
+		if err = types.ValidateDisplayQuantity(*displayQty, qty); err != nil {
+			err = sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+			return
+		}
+		if err = market.ValidateOrderQuantity(*displayQty); err != nil {
+			err = sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+			return
+		}
+	}
+
+	res = types.NewExecuteOrderResult(types.PayReceiveDenoms(market.BaseDenom, market.QuoteDenom, isBuy))
+	execOpts := types.MemOrderBookSideOptions{
+		IsBuy:         !isBuy,
+		PriceLimit:    &price,
+		QuantityLimit: &qty,
+	}
+	// Orders rejected due to their time in force are not considered as an
+	// error, so the rejection reason can be reported to the orderer.
+	switch timeInForce {
+	case types.TimeInForcePostOnly:
+		if k.wouldMatch(ctx, market, isBuy, price) {
+			rejectReason = "post-only order would match against existing orders"
 			return
-		} else if !isBuy && price.LT(minPrice) {
-			err = sdkerrors.Wrapf(types.ErrOrderPriceOutOfRange, "price is lower than the limit %s", minPrice)
+		}
+	case types.TimeInForceFillOrKill:
+		var simRes types.ExecuteOrderResult
+		simRes, err = k.executeOrder(ctx, market, ordererAddr, 0, selfTradePrevention, referrerAddr, execOpts, false, true)
+		if err != nil {
+			return
+		}
+		if !simRes.FullyExecuted {
+			rejectReason = "fill-or-kill order cannot be fully executed"
 			return
 		}
 	}
@@ -149,26 +247,35 @@ func (k Keeper) placeLimitOrder(
 	orderId = k.GetNextOrderIdWithUpdate(ctx)
 	openQty := qty
 	if !isBatch {
-		res, err = k.executeOrder(
-			ctx, market, ordererAddr, types.MemOrderBookSideOptions{
-				IsBuy:         !isBuy,
-				PriceLimit:    &price,
-				QuantityLimit: &qty,
-			}, false, false)
+		res, err = k.executeOrder(ctx, market, ordererAddr, orderId, selfTradePrevention, referrerAddr, execOpts, false, false)
 		if err != nil {
 			return
 		}
-		openQty = openQty.Sub(res.ExecutedQuantity)
+		openQty = openQty.Sub(res.ExecutedQuantity).Sub(res.DecrementedQuantity)
 	}
 
-	if isBatch || openQty.GTE(utils.OneDec) {
+	// Immediate-or-cancel and fill-or-kill orders never rest on the order book,
+	// nor do orders cancelled by self-trade prevention.
+	rests := (timeInForce == types.TimeInForceGoodTilTime || timeInForce == types.TimeInForcePostOnly) &&
+		!res.SelfTradePrevented
+	if rests && (isBatch || openQty.GTE(utils.OneDec)) {
 		deadline := ctx.BlockTime().Add(lifespan)
 		depositDenom, _ := types.PayReceiveDenoms(market.BaseDenom, market.QuoteDenom, isBuy)
 		depositCoin := sdk.NewCoin(depositDenom, types.DepositAmount(isBuy, price, openQty).Ceil().TruncateInt())
 		order = types.NewOrder(
 			orderId, typ, ordererAddr, market.Id, isBuy, price, qty,
-			ctx.BlockHeight(), openQty, depositCoin.Amount.ToDec(), deadline)
-		if err = k.EscrowCoins(ctx, market, ordererAddr, depositCoin); err != nil {
+			ctx.BlockHeight(), openQty, depositCoin.Amount.ToDec(), deadline, timeInForce, selfTradePrevention)
+		order.Referrer = referrerAddr.String()
+		// The deposit covers the whole open quantity, but only the displayed
+		// slice of an iceberg order is put on the order book.
+		if displayQty != nil {
+			order.DisplayQuantity = displayQty
+			order.HiddenQuantity = openQty.Sub(sdk.MinDec(*displayQty, openQty))
+		}
+		// If escrow is given, the caller is responsible for settling it.
+		if escrow != nil {
+			escrow.Lock(ordererAddr, sdk.NewDecCoinFromCoin(depositCoin))
+		} else if err = k.EscrowCoins(ctx, market, ordererAddr, depositCoin); err != nil {
 			return
 		}
 		k.SetOrder(ctx, order)
@@ -181,13 +288,69 @@ func (k Keeper) placeLimitOrder(
 			numMMOrders, _ = k.GetNumMMOrders(ctx, ordererAddr, marketId)
 			k.SetNumMMOrders(ctx, ordererAddr, marketId, numMMOrders+1)
 		}
+		if k.hooks != nil {
+			if err = k.hooks.AfterOrderPlaced(ctx, order); err != nil {
+				return
+			}
+		}
 	}
 	return
 }
 
+// validateOrderPrice returns an error if the order price is not a multiple of
+// the market's tick size or is out of the range allowed around the market's
+// last price.
+func (k Keeper) validateOrderPrice(ctx sdk.Context, market types.Market, isBuy bool, price sdk.Dec) error {
+	if err := market.ValidateOrderPrice(price); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	marketState := k.MustGetMarketState(ctx, market.Id)
+	if marketState.LastPrice == nil {
+		return nil
+	}
+	maxPriceRatio := k.GetMaxOrderPriceRatio(ctx)
+	minPrice, maxPrice := types.OrderPriceLimit(*marketState.LastPrice, maxPriceRatio)
+	if isBuy && price.GT(maxPrice) {
+		return sdkerrors.Wrapf(types.ErrOrderPriceOutOfRange, "price is higher than the limit %s", maxPrice)
+	} else if !isBuy && price.LT(minPrice) {
+		return sdkerrors.Wrapf(types.ErrOrderPriceOutOfRange, "price is lower than the limit %s", minPrice)
+	}
+	return nil
+}
+
+// wouldMatch returns whether an order with the price would match against the
+// best order on the opposite side of the order book.
+func (k Keeper) wouldMatch(ctx sdk.Context, market types.Market, isBuy bool, price sdk.Dec) bool {
+	bestPrice, found := k.getBestPrice(ctx, market, !isBuy)
+	if !found {
+		return false
+	}
+	if isBuy {
+		return price.GTE(bestPrice)
+	}
+	return price.LTE(bestPrice)
+}
+
+// PlaceMarketOrder places a market order. referrerAddr, if not empty, receives
+// a share of the taker fee paid by the order.
 func (k Keeper) PlaceMarketOrder(
 	ctx sdk.Context, marketId uint64, ordererAddr sdk.AccAddress,
-	isBuy bool, qty sdk.Dec) (orderId uint64, res types.ExecuteOrderResult, err error) {
+	isBuy bool, qty sdk.Dec, referrerAddr sdk.AccAddress) (orderId uint64, res types.ExecuteOrderResult, err error) {
+	orderId, res, err = k.placeMarketOrderWithEvent(ctx, marketId, ordererAddr, isBuy, qty, referrerAddr)
+	if err != nil {
+		return
+	}
+	if res.Executed() {
+		err = k.executeTriggerOrders(ctx, marketId)
+	}
+	return
+}
+
+// placeMarketOrderWithEvent places a market order and emits the event without
+// executing trigger orders.
+func (k Keeper) placeMarketOrderWithEvent(
+	ctx sdk.Context, marketId uint64, ordererAddr sdk.AccAddress,
+	isBuy bool, qty sdk.Dec, referrerAddr sdk.AccAddress) (orderId uint64, res types.ExecuteOrderResult, err error) {
 	if !qty.IsPositive() { // sanity check
 		panic("quantity must be positive")
 	}
@@ -197,6 +360,17 @@ func (k Keeper) PlaceMarketOrder(
 		err = sdkerrors.Wrap(sdkerrors.ErrNotFound, "market not found")
 		return
 	}
+	if !market.IsActive() {
+		err = sdkerrors.Wrapf(types.ErrMarketNotActive, "market %d is %s", market.Id, market.Status)
+		return
+	}
+	if _, err = k.validateCircuitBreaker(ctx, market, false); err != nil {
+		return
+	}
+	if err = market.ValidateOrderQuantity(qty); err != nil {
+		err = sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+		return
+	}
 	marketState := k.MustGetMarketState(ctx, market.Id)
 	if marketState.LastPrice == nil {
 		err = sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "market has no last price")
@@ -226,7 +400,7 @@ func (k Keeper) PlaceMarketOrder(
 		priceLimit = minPrice
 	}
 	res, err = k.executeOrder(
-		ctx, market, ordererAddr, types.MemOrderBookSideOptions{
+		ctx, market, ordererAddr, orderId, types.SelfTradePreventionUnspecified, referrerAddr, types.MemOrderBookSideOptions{
 			IsBuy:         !isBuy,
 			PriceLimit:    &priceLimit,
 			QuantityLimit: &qty,
@@ -245,6 +419,7 @@ func (k Keeper) PlaceMarketOrder(
 		ExecutedQuantity: res.ExecutedQuantity,
 		Paid:             res.Paid,
 		Received:         res.Received,
+		Referrer:         referrerAddr.String(),
 	}); err != nil {
 		return
 	}
@@ -265,9 +440,17 @@ func (k Keeper) CancelOrder(ctx sdk.Context, ordererAddr sdk.AccAddress, orderId
 	if ordererAddr.String() != order.Orderer {
 		return order, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "order is not created by the sender")
 	}
+	if !market.CanCancelOrders() {
+		return order, sdkerrors.Wrapf(types.ErrMarketNotActive, "market %d is %s", market.Id, market.Status)
+	}
 	if err = k.cancelOrder(ctx, market, order); err != nil {
 		return order, err
 	}
+	if k.hooks != nil {
+		if err = k.hooks.AfterOrderCanceled(ctx, order); err != nil {
+			return order, err
+		}
+	}
 	if err = ctx.EventManager().EmitTypedEvent(&types.EventCancelOrder{
 		Orderer: ordererAddr.String(),
 		OrderId: orderId,
@@ -277,11 +460,119 @@ func (k Keeper) CancelOrder(ctx sdk.Context, ordererAddr sdk.AccAddress, orderId
 	return order, nil
 }
 
+// AmendOrder changes the price, quantity or deadline of an existing order.
+// Reducing the quantity at the same price keeps the order's priority, while
+// any other change re-queues the order as if it were placed in this block.
+func (k Keeper) AmendOrder(
+	ctx sdk.Context, ordererAddr sdk.AccAddress, orderId uint64,
+	price, qty *sdk.Dec, lifespan *time.Duration) (order types.Order, err error) {
+	var found bool
+	order, found = k.GetOrder(ctx, orderId)
+	if !found {
+		return order, sdkerrors.Wrap(sdkerrors.ErrNotFound, "order not found")
+	}
+	if order.MsgHeight == ctx.BlockHeight() {
+		return order, sdkerrors.Wrap(
+			sdkerrors.ErrInvalidRequest, "cannot amend order placed in the same block")
+	}
+	market := k.MustGetMarket(ctx, order.MarketId)
+	if ordererAddr.String() != order.Orderer {
+		return order, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "order is not created by the sender")
+	}
+	if !market.IsActive() {
+		return order, sdkerrors.Wrapf(types.ErrMarketNotActive, "market %d is %s", market.Id, market.Status)
+	}
+	if _, err = k.validateCircuitBreaker(ctx, market, true); err != nil {
+		return order, err
+	}
+
+	newPrice := order.Price
+	if price != nil && !price.Equal(order.Price) {
+		if err = k.validateOrderPrice(ctx, market, order.IsBuy, *price); err != nil {
+			return order, err
+		}
+		// Amending an order must not make it executed immediately.
+		if k.wouldMatch(ctx, market, order.IsBuy, *price) {
+			return order, sdkerrors.Wrap(
+				sdkerrors.ErrInvalidRequest, "amended order would match against existing orders")
+		}
+		newPrice = *price
+	}
+	newQty := order.Quantity
+	if qty != nil {
+		if err = market.ValidateOrderQuantity(*qty); err != nil {
+			return order, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+		}
+		newQty = *qty
+	}
+	executedQty := order.Quantity.Sub(order.OpenQuantity)
+	newOpenQty := newQty.Sub(executedQty)
+	if newOpenQty.LT(utils.OneDec) {
+		return order, sdkerrors.Wrapf(
+			sdkerrors.ErrInvalidRequest, "quantity must be greater than the executed quantity %s", executedQty)
+	}
+	newDeadline := order.Deadline
+	if lifespan != nil {
+		newDeadline = ctx.BlockTime().Add(*lifespan)
+	}
+
+	// Adjust the escrowed deposit.
+	depositDenom, _ := types.PayReceiveDenoms(market.BaseDenom, market.QuoteDenom, order.IsBuy)
+	newDeposit := types.DepositAmount(order.IsBuy, newPrice, newOpenQty).Ceil()
+	if diff := newDeposit.Sub(order.RemainingDeposit); diff.IsPositive() {
+		if err = k.EscrowCoins(ctx, market, ordererAddr, sdk.NewCoin(depositDenom, diff.TruncateInt())); err != nil {
+			return order, err
+		}
+	} else if diff.IsNegative() {
+		if err = k.ReleaseCoins(ctx, market, ordererAddr, sdk.NewCoin(depositDenom, diff.Neg().TruncateInt())); err != nil {
+			return order, err
+		}
+	}
+
+	requeued := !newPrice.Equal(order.Price) || newQty.GT(order.Quantity) || lifespan != nil
+	k.DeleteOrderBookOrderIndex(ctx, order)
+	// The displayed quantity of an iceberg order is kept and the hidden
+	// reserve absorbs the change of the open quantity.
+	if order.IsIceberg() {
+		if newQty.LT(*order.DisplayQuantity) {
+			order.DisplayQuantity = &newQty
+		}
+		order.HiddenQuantity = newOpenQty.Sub(sdk.MinDec(order.VisibleQuantity(), newOpenQty))
+	}
+	order.Price = newPrice
+	order.Quantity = newQty
+	order.OpenQuantity = newOpenQty
+	order.RemainingDeposit = newDeposit
+	order.Deadline = newDeadline
+	if requeued {
+		order.PriorityHeight = ctx.BlockHeight()
+	}
+	k.SetOrder(ctx, order)
+	k.SetOrderBookOrderIndex(ctx, order)
+
+	if err = ctx.EventManager().EmitTypedEvent(&types.EventAmendOrder{
+		MarketId:     market.Id,
+		OrderId:      order.Id,
+		Orderer:      order.Orderer,
+		Price:        order.Price,
+		Quantity:     order.Quantity,
+		OpenQuantity: order.OpenQuantity,
+		Deadline:     order.Deadline,
+		Requeued:     requeued,
+	}); err != nil {
+		return order, err
+	}
+	return order, nil
+}
+
 func (k Keeper) CancelAllOrders(ctx sdk.Context, ordererAddr sdk.AccAddress, marketId uint64) (orders []types.Order, err error) {
 	market, found := k.GetMarket(ctx, marketId)
 	if !found {
 		return nil, sdkerrors.Wrap(sdkerrors.ErrNotFound, "market not found")
 	}
+	if !market.CanCancelOrders() {
+		return nil, sdkerrors.Wrapf(types.ErrMarketNotActive, "market %d is %s", market.Id, market.Status)
+	}
 	var cancelledOrderIds []uint64
 	k.IterateOrdersByOrdererAndMarket(ctx, ordererAddr, market.Id, func(order types.Order) (stop bool) {
 		if order.MsgHeight == ctx.BlockHeight() {
@@ -290,6 +581,11 @@ func (k Keeper) CancelAllOrders(ctx sdk.Context, ordererAddr sdk.AccAddress, mar
 		if err = k.cancelOrder(ctx, market, order); err != nil {
 			return true
 		}
+		if k.hooks != nil {
+			if err = k.hooks.AfterOrderCanceled(ctx, order); err != nil {
+				return true
+			}
+		}
 		orders = append(orders, order)
 		cancelledOrderIds = append(cancelledOrderIds, order.Id)
 		return false
@@ -307,6 +603,74 @@ func (k Keeper) CancelAllOrders(ctx sdk.Context, ordererAddr sdk.AccAddress, mar
 	return orders, nil
 }
 
+// ReplaceMMOrders cancels all market making orders of the orderer in the market
+// and places the new orders as market making batch orders.
+// Deposits of the cancelled orders are reused for the new orders, so only the
+// difference is escrowed or refunded.
+func (k Keeper) ReplaceMMOrders(
+	ctx sdk.Context, ordererAddr sdk.AccAddress, marketId uint64,
+	orders []types.MMOrderParameters) (cancelledOrderIds, orderIds []uint64, err error) {
+	market, found := k.GetMarket(ctx, marketId)
+	if !found {
+		return nil, nil, sdkerrors.Wrap(sdkerrors.ErrNotFound, "market not found")
+	}
+	if !market.IsActive() {
+		return nil, nil, sdkerrors.Wrapf(types.ErrMarketNotActive, "market %d is %s", market.Id, market.Status)
+	}
+
+	var oldOrders []types.Order
+	k.IterateOrdersByOrdererAndMarket(ctx, ordererAddr, market.Id, func(order types.Order) (stop bool) {
+		if order.Type != types.OrderTypeMM {
+			return false
+		}
+		if order.MsgHeight == ctx.BlockHeight() {
+			err = sdkerrors.Wrapf(
+				sdkerrors.ErrInvalidRequest, "cannot replace order %d placed in the same block", order.Id)
+			return true
+		}
+		oldOrders = append(oldOrders, order)
+		return false
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	escrow := types.NewEscrow(market.MustGetEscrowAddress())
+	for _, order := range oldOrders {
+		depositDenom, _ := types.PayReceiveDenoms(market.BaseDenom, market.QuoteDenom, order.IsBuy)
+		escrow.Unlock(ordererAddr, sdk.NewDecCoinFromDec(depositDenom, order.RemainingDeposit.TruncateDec()))
+		k.removeOrder(ctx, market, order)
+		if k.hooks != nil {
+			if err = k.hooks.AfterOrderCanceled(ctx, order); err != nil {
+				return nil, nil, err
+			}
+		}
+		cancelledOrderIds = append(cancelledOrderIds, order.Id)
+	}
+	for _, params := range orders {
+		var order types.Order
+		_, order, _, _, err = k.placeLimitOrder(
+			ctx, types.OrderTypeMM, market.Id, ordererAddr, params.IsBuy, params.Price, params.Quantity, nil,
+			params.Lifespan, types.TimeInForceGoodTilTime, params.SelfTradePrevention, nil, true, escrow)
+		if err != nil {
+			return nil, nil, err
+		}
+		orderIds = append(orderIds, order.Id)
+	}
+	if err = escrow.Transact(ctx, k.bankKeeper); err != nil {
+		return nil, nil, err
+	}
+
+	if err = ctx.EventManager().EmitTypedEvent(&types.EventReplaceMMOrders{
+		Orderer:           ordererAddr.String(),
+		MarketId:          market.Id,
+		CancelledOrderIds: cancelledOrderIds,
+		OrderIds:          orderIds,
+	}); err != nil {
+		return nil, nil, err
+	}
+	return cancelledOrderIds, orderIds, nil
+}
+
 func (k Keeper) cancelOrder(ctx sdk.Context, market types.Market, order types.Order) error {
 	ordererAddr := order.MustGetOrdererAddress()
 	depositDenom, _ := types.PayReceiveDenoms(market.BaseDenom, market.QuoteDenom, order.IsBuy)
@@ -314,6 +678,14 @@ func (k Keeper) cancelOrder(ctx sdk.Context, market types.Market, order types.Or
 	if err := k.ReleaseCoins(ctx, market, ordererAddr, refunded); err != nil {
 		return err
 	}
+	k.removeOrder(ctx, market, order)
+	return nil
+}
+
+// removeOrder deletes the order and its indexes without refunding the
+// remaining deposit.
+func (k Keeper) removeOrder(ctx sdk.Context, market types.Market, order types.Order) {
+	ordererAddr := order.MustGetOrdererAddress()
 	if order.Type == types.OrderTypeMM {
 		numMMOrders, found := k.GetNumMMOrders(ctx, ordererAddr, market.Id)
 		if !found { // sanity check
@@ -328,18 +700,50 @@ func (k Keeper) cancelOrder(ctx sdk.Context, market types.Market, order types.Or
 	k.DeleteOrder(ctx, order)
 	k.DeleteOrderBookOrderIndex(ctx, order)
 	k.DeleteOrdersByOrdererIndex(ctx, order)
-	return nil
+}
+
+// replenishIcebergOrder replenishes the displayed quantity of an iceberg order
+// from its hidden reserve when the displayed quantity has been filled.
+// Like an amended order, the replenished order loses its time priority.
+// order's open quantity must already be updated.
+func (k Keeper) replenishIcebergOrder(ctx sdk.Context, order *types.Order) (replenished bool, err error) {
+	if !order.IsIceberg() || !order.HiddenQuantity.IsPositive() ||
+		!order.VisibleQuantity().TruncateDec().IsZero() {
+		return false, nil
+	}
+	visibleQty := order.VisibleQuantity()
+	newVisibleQty := sdk.MinDec(*order.DisplayQuantity, order.OpenQuantity)
+	order.HiddenQuantity = order.OpenQuantity.Sub(newVisibleQty)
+	order.PriorityHeight = ctx.BlockHeight()
+	k.updatePriceLevel(ctx, order.MarketId, order.IsBuy, order.Price, newVisibleQty.Sub(visibleQty), 0)
+	if err = ctx.EventManager().EmitTypedEvent(&types.EventIcebergOrderReplenished{
+		MarketId:        order.MarketId,
+		OrderId:         order.Id,
+		VisibleQuantity: newVisibleQty,
+	}); err != nil {
+		return false, err
+	}
+	return true, nil
 }
 
 func (k Keeper) CancelExpiredOrders(ctx sdk.Context) (err error) {
 	blockTime := ctx.BlockTime()
 	k.IterateAllMarkets(ctx, func(market types.Market) (stop bool) {
+		// Orders in a halted market are frozen until the market is resumed.
+		if market.Status == types.MarketStatusHalted {
+			return false
+		}
 		// TODO: optimize by using timestamp queue
 		k.IterateOrdersByMarket(ctx, market.Id, func(order types.Order) (stop bool) {
 			if !blockTime.Before(order.Deadline) {
 				if err = k.cancelOrder(ctx, market, order); err != nil {
 					return true
 				}
+				if k.hooks != nil {
+					if err = k.hooks.AfterOrderCanceled(ctx, order); err != nil {
+						return true
+					}
+				}
 				if err = ctx.EventManager().EmitTypedEvent(&types.EventOrderExpired{
 					OrderId: order.Id,
 				}); err != nil {
@@ -0,0 +1,104 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	chain "github.com/crescent-network/crescent/v5/app"
+	utils "github.com/crescent-network/crescent/v5/types"
+	"github.com/crescent-network/crescent/v5/x/exchange/keeper"
+	"github.com/crescent-network/crescent/v5/x/exchange/types"
+)
+
+// setupDeepOrderBook creates a market with numPriceLevels price levels on each
+// side of the order book, each of which has numOrdersPerLevel orders.
+func setupDeepOrderBook(b *testing.B, numPriceLevels, numOrdersPerLevel int) (*chain.App, sdk.Context, types.Market) {
+	app := chain.Setup(false)
+	ctx := app.NewContext(false, tmproto.Header{})
+
+	creatorAddr := utils.TestAddress(0)
+	require.NoError(b, chain.FundAccount(app.BankKeeper, ctx, creatorAddr, enoughCoins))
+	market, err := app.ExchangeKeeper.CreateMarket(
+		ctx, creatorAddr, "ucre", "uusd", utils.ZeroDec, utils.ZeroDec, utils.ZeroDec)
+	require.NoError(b, err)
+
+	buyerAddr := utils.TestAddress(1)
+	require.NoError(b, chain.FundAccount(app.BankKeeper, ctx, buyerAddr, enoughCoins))
+	sellerAddr := utils.TestAddress(2)
+	require.NoError(b, chain.FundAccount(app.BankKeeper, ctx, sellerAddr, enoughCoins))
+
+	placeLimitOrder := func(ordererAddr sdk.AccAddress, isBuy bool, price sdk.Dec) {
+		_, _, _, _, err := app.ExchangeKeeper.PlaceLimitOrder(
+			ctx, market.Id, ordererAddr, isBuy, price, sdk.NewDec(1_000000), nil, 0,
+			types.TimeInForceGoodTilTime, types.SelfTradePreventionUnspecified, nil)
+		require.NoError(b, err)
+	}
+	// Make the last price.
+	placeLimitOrder(sellerAddr, false, utils.ParseDec("5"))
+	placeLimitOrder(buyerAddr, true, utils.ParseDec("5"))
+
+	tick := utils.ParseDec("0.001")
+	for i := 1; i <= numPriceLevels; i++ {
+		for j := 0; j < numOrdersPerLevel; j++ {
+			placeLimitOrder(buyerAddr, true, utils.ParseDec("5").Sub(tick.MulInt64(int64(i))))
+			placeLimitOrder(sellerAddr, false, utils.ParseDec("5").Add(tick.MulInt64(int64(i))))
+		}
+	}
+	return app, ctx, market
+}
+
+func BenchmarkBestPrice(b *testing.B) {
+	app, ctx, market := setupDeepOrderBook(b, 100, 50)
+	b.ResetTimer()
+
+	// Finding the best prices by loading the best price levels' orders, which
+	// is how the best prices were found without the price level index.
+	b.Run("orders", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			for _, isBuy := range []bool{true, false} {
+				app.ExchangeKeeper.ConstructMemOrderBookSide(ctx, market, types.MemOrderBookSideOptions{
+					IsBuy:             isBuy,
+					MaxNumPriceLevels: 1,
+				}, nil)
+			}
+		}
+	})
+	// RunBatchMatching finds the best prices from the price level index and
+	// exits early since the order book is not crossed.
+	b.Run("price levels", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			require.NoError(b, app.ExchangeKeeper.RunBatchMatching(ctx, market))
+		}
+	})
+}
+
+func BenchmarkOrderBookDepth(b *testing.B) {
+	app, ctx, market := setupDeepOrderBook(b, 100, 50)
+	querier := keeper.Querier{Keeper: app.ExchangeKeeper}
+	b.ResetTimer()
+
+	// Aggregating the order book by loading every order, which is how the
+	// order book was made without the price level index.
+	b.Run("orders", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			for _, isBuy := range []bool{true, false} {
+				obs := app.ExchangeKeeper.ConstructMemOrderBookSide(ctx, market, types.MemOrderBookSideOptions{
+					IsBuy:             isBuy,
+					MaxNumPriceLevels: 3000,
+				}, nil)
+				for _, level := range obs.Levels() {
+					types.TotalExecutableQuantity(level.Orders())
+				}
+			}
+		}
+	})
+	b.Run("price levels", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			querier.MakeOrderBooks(ctx, market, utils.ParseDec("5"), 30)
+		}
+	})
+}
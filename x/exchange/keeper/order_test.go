@@ -1,9 +1,11 @@
 package keeper_test
 
 import (
+	"fmt"
 	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
 	utils "github.com/crescent-network/crescent/v5/types"
 	"github.com/crescent-network/crescent/v5/x/exchange/keeper"
@@ -20,7 +22,8 @@ func (s *KeeperTestSuite) TestPlaceLimitOrder() {
 	s.Ctx = s.Ctx.WithEventManager(sdk.NewEventManager())
 	resp, err := msgServer.PlaceLimitOrder(
 		sdk.WrapSDKContext(s.Ctx), types.NewMsgPlaceLimitOrder(
-			ordererAddr1, market.Id, true, utils.ParseDec("5.1"), sdk.NewDec(10_000000), time.Hour))
+			ordererAddr1, market.Id, true, utils.ParseDec("5.1"), sdk.NewDec(10_000000), time.Hour,
+			types.TimeInForceGoodTilTime, types.SelfTradePreventionUnspecified))
 	s.Require().NoError(err)
 	s.Require().EqualValues(1, resp.OrderId)
 	s.Require().Equal(sdk.NewDec(0), resp.ExecutedQuantity)
@@ -35,7 +38,8 @@ func (s *KeeperTestSuite) TestPlaceLimitOrder() {
 	s.Ctx = s.Ctx.WithEventManager(sdk.NewEventManager())
 	resp, err = msgServer.PlaceLimitOrder(
 		sdk.WrapSDKContext(s.Ctx), types.NewMsgPlaceLimitOrder(
-			ordererAddr2, market.Id, false, utils.ParseDec("5"), sdk.NewDec(5_000000), time.Hour))
+			ordererAddr2, market.Id, false, utils.ParseDec("5"), sdk.NewDec(5_000000), time.Hour,
+			types.TimeInForceGoodTilTime, types.SelfTradePreventionUnspecified))
 	s.Require().NoError(err)
 	s.Require().EqualValues(2, resp.OrderId)
 	s.Require().Equal(sdk.NewDec(5_000000), resp.ExecutedQuantity)
@@ -49,6 +53,111 @@ func (s *KeeperTestSuite) TestPlaceLimitOrder() {
 	})
 }
 
+func (s *KeeperTestSuite) TestPlaceLimitOrder_TimeInForce() {
+	market := s.CreateMarket("ucre", "uusd")
+
+	mmAddr := s.FundedAccount(1, enoughCoins)
+	ordererAddr := s.FundedAccount(2, enoughCoins)
+
+	_, sellOrder, _ := s.PlaceLimitOrder(
+		market.Id, mmAddr, false, utils.ParseDec("5.1"), sdk.NewDec(10_000000), time.Hour)
+	s.PlaceLimitOrder(market.Id, mmAddr, true, utils.ParseDec("4.9"), sdk.NewDec(10_000000), time.Hour)
+
+	msgServer := keeper.NewMsgServerImpl(s.keeper)
+	placeLimitOrder := func(price, qty sdk.Dec, timeInForce types.TimeInForce) *types.MsgPlaceLimitOrderResponse {
+		s.T().Helper()
+		resp, err := msgServer.PlaceLimitOrder(
+			sdk.WrapSDKContext(s.Ctx), types.NewMsgPlaceLimitOrder(
+				ordererAddr, market.Id, true, price, qty, time.Hour, timeInForce, types.SelfTradePreventionUnspecified))
+		s.Require().NoError(err)
+		return resp
+	}
+
+	// Post-only orders which would match are rejected.
+	balancesBefore := s.GetAllBalances(ordererAddr)
+	s.Ctx = s.Ctx.WithEventManager(sdk.NewEventManager())
+	resp := placeLimitOrder(utils.ParseDec("5.1"), sdk.NewDec(1_000000), types.TimeInForcePostOnly)
+	s.Require().EqualValues(0, resp.OrderId)
+	s.Require().Equal("post-only order would match against existing orders", resp.RejectReason)
+	s.CheckEvent(&types.EventPlaceLimitOrder{}, map[string][]byte{
+		"time_in_force": []byte(`"TIME_IN_FORCE_POST_ONLY"`),
+		"reject_reason": []byte(`"post-only order would match against existing orders"`),
+	})
+	s.Require().Equal(balancesBefore, s.GetAllBalances(ordererAddr))
+
+	resp = placeLimitOrder(utils.ParseDec("5"), sdk.NewDec(1_000000), types.TimeInForcePostOnly)
+	s.Require().Empty(resp.RejectReason)
+	order := s.keeper.MustGetOrder(s.Ctx, resp.OrderId)
+	s.Require().Equal(types.TimeInForcePostOnly, order.TimeInForce)
+
+	// Fill-or-kill orders which cannot be fully executed are rejected.
+	balancesBefore = s.GetAllBalances(ordererAddr)
+	resp = placeLimitOrder(utils.ParseDec("5.1"), sdk.NewDec(20_000000), types.TimeInForceFillOrKill)
+	s.Require().EqualValues(0, resp.OrderId)
+	s.Require().Equal("fill-or-kill order cannot be fully executed", resp.RejectReason)
+	s.Require().True(resp.ExecutedQuantity.IsZero())
+	s.Require().Equal(balancesBefore, s.GetAllBalances(ordererAddr))
+	s.Require().Equal(sdk.NewDec(10_000000), s.keeper.MustGetOrder(s.Ctx, sellOrder.Id).OpenQuantity)
+
+	resp = placeLimitOrder(utils.ParseDec("5.1"), sdk.NewDec(4_000000), types.TimeInForceFillOrKill)
+	s.Require().Empty(resp.RejectReason)
+	s.Require().Equal(sdk.NewDec(4_000000), resp.ExecutedQuantity)
+	_, found := s.keeper.GetOrder(s.Ctx, resp.OrderId)
+	s.Require().False(found)
+
+	// The unfilled quantity of immediate-or-cancel orders doesn't rest on the
+	// order book.
+	balancesBefore = s.GetAllBalances(ordererAddr)
+	resp = placeLimitOrder(utils.ParseDec("5.1"), sdk.NewDec(10_000000), types.TimeInForceImmediateOrCancel)
+	s.Require().Empty(resp.RejectReason)
+	s.Require().Equal(sdk.NewDec(6_000000), resp.ExecutedQuantity)
+	_, found = s.keeper.GetOrder(s.Ctx, resp.OrderId)
+	s.Require().False(found)
+	balancesAfter := s.GetAllBalances(ordererAddr)
+	diff, _ := balancesAfter.SafeSub(balancesBefore)
+	// 6_000000 * 5.1 = 30_600000, 6_000000 * (1 - 0.003) = 5_982000
+	s.Require().Equal("5982000ucre,-30600000uusd", diff.String())
+}
+
+func (s *KeeperTestSuite) TestPlaceBatchLimitOrder_PostOnly() {
+	market := s.CreateMarket("ucre", "uusd")
+
+	mmAddr := s.FundedAccount(1, enoughCoins)
+	ordererAddr := s.FundedAccount(2, enoughCoins)
+
+	s.MakeLastPrice(market.Id, mmAddr, utils.ParseDec("5.1"))
+	s.PlaceLimitOrder(market.Id, mmAddr, true, utils.ParseDec("5"), sdk.NewDec(10_000000), time.Hour)
+
+	_, rejectReason, err := s.keeper.PlaceBatchLimitOrder(
+		s.Ctx, market.Id, ordererAddr, false, utils.ParseDec("5"), sdk.NewDec(1_000000), time.Hour,
+		types.TimeInForcePostOnly, types.SelfTradePreventionUnspecified)
+	s.Require().NoError(err)
+	s.Require().Equal("post-only order would match against existing orders", rejectReason)
+
+	_, _, err = s.keeper.PlaceBatchLimitOrder(
+		s.Ctx, market.Id, ordererAddr, false, utils.ParseDec("5"), sdk.NewDec(1_000000), time.Hour,
+		types.TimeInForceImmediateOrCancel, types.SelfTradePreventionUnspecified)
+	s.Require().EqualError(err, "TIME_IN_FORCE_IMMEDIATE_OR_CANCEL is not allowed for batch orders: invalid request")
+
+	order, rejectReason, err := s.keeper.PlaceBatchLimitOrder(
+		s.Ctx, market.Id, ordererAddr, false, utils.ParseDec("5.05"), sdk.NewDec(1_000000), time.Hour,
+		types.TimeInForcePostOnly, types.SelfTradePreventionUnspecified)
+	s.Require().NoError(err)
+	s.Require().Empty(rejectReason)
+	s.PlaceBatchLimitOrder(market.Id, mmAddr, true, utils.ParseDec("5.1"), sdk.NewDec(1_000000), time.Hour)
+
+	// Both orders are matched at the last price as takers, but the post-only
+	// order pays the maker fee.
+	balancesBefore := s.GetAllBalances(ordererAddr)
+	s.Require().NoError(s.keeper.RunBatchMatching(s.Ctx, market))
+	_, found := s.keeper.GetOrder(s.Ctx, order.Id)
+	s.Require().False(found)
+	balancesAfter := s.GetAllBalances(ordererAddr)
+	diff, _ := balancesAfter.SafeSub(balancesBefore)
+	// 1_000000 * 5.1 * (1 - 0.0015) = 5_092350
+	s.Require().Equal("5092350uusd", diff.String())
+}
+
 func (s *KeeperTestSuite) TestPlaceBatchLimitOrder() {
 	market := s.CreateMarket("ucre", "uusd")
 
@@ -108,8 +217,9 @@ func (s *KeeperTestSuite) TestPlaceMMLimitOrder() {
 		s.PlaceMMLimitOrder(
 			market.Id, ordererAddr1, true, price, sdk.NewDec(10_000000), time.Hour)
 	}
-	_, _, _, err := s.keeper.PlaceMMLimitOrder(
-		s.Ctx, market.Id, ordererAddr1, true, utils.ParseDec("5.1"), sdk.NewDec(10_00000), time.Hour)
+	_, _, _, _, err := s.keeper.PlaceMMLimitOrder(
+		s.Ctx, market.Id, ordererAddr1, true, utils.ParseDec("5.1"), sdk.NewDec(10_00000), time.Hour,
+		types.TimeInForceGoodTilTime, types.SelfTradePreventionUnspecified)
 	s.Require().EqualError(err, "16 > 15: number of MM orders exceeded the limit")
 
 	s.PlaceLimitOrder(
@@ -131,8 +241,9 @@ func (s *KeeperTestSuite) TestPlaceMMBatchLimitOrder() {
 		s.PlaceMMBatchLimitOrder(
 			market.Id, ordererAddr1, true, price, sdk.NewDec(10_000000), time.Hour)
 	}
-	_, err := s.keeper.PlaceMMBatchLimitOrder(
-		s.Ctx, market.Id, ordererAddr1, true, utils.ParseDec("5.1"), sdk.NewDec(10_00000), time.Hour)
+	_, _, err := s.keeper.PlaceMMBatchLimitOrder(
+		s.Ctx, market.Id, ordererAddr1, true, utils.ParseDec("5.1"), sdk.NewDec(10_00000), time.Hour,
+		types.TimeInForceGoodTilTime, types.SelfTradePreventionUnspecified)
 	s.Require().EqualError(err, "16 > 15: number of MM orders exceeded the limit")
 
 	s.PlaceLimitOrder(
@@ -143,6 +254,174 @@ func (s *KeeperTestSuite) TestPlaceMMBatchLimitOrder() {
 		market.Id, ordererAddr1, true, utils.ParseDec("4.9"), sdk.NewDec(10_00000), time.Hour)
 }
 
+func (s *KeeperTestSuite) TestReplaceMMOrders() {
+	market := s.CreateMarket("ucre", "uusd")
+	mmAddr := s.FundedAccount(1, utils.ParseCoins("102_000000uusd"))
+
+	orderId1, _, _ := s.PlaceMMLimitOrder(
+		market.Id, mmAddr, true, utils.ParseDec("4.9"), sdk.NewDec(10_000000), time.Hour)
+	orderId2, _, _ := s.PlaceMMLimitOrder(
+		market.Id, mmAddr, true, utils.ParseDec("4.8"), sdk.NewDec(10_000000), time.Hour)
+	orderId3, _, _ := s.PlaceLimitOrder(
+		market.Id, mmAddr, true, utils.ParseDec("4.5"), sdk.NewDec(1_000000), time.Hour)
+	s.AssertEqual(utils.ParseCoins("500000uusd"), s.GetAllBalances(mmAddr))
+
+	// Orders placed in the same block cannot be replaced.
+	_, _, err := s.keeper.ReplaceMMOrders(s.Ctx, mmAddr, market.Id, nil)
+	s.Require().EqualError(err, fmt.Sprintf("cannot replace order %d placed in the same block: invalid request", orderId1))
+
+	s.NextBlock()
+	// The new orders need more than the balance, but the deposits of the
+	// cancelled orders are reused.
+	cancelledOrderIds, orderIds, err := s.keeper.ReplaceMMOrders(s.Ctx, mmAddr, market.Id, []types.MMOrderParameters{
+		types.NewMMOrderParameters(true, utils.ParseDec("5"), sdk.NewDec(19_000000), time.Hour, types.SelfTradePreventionUnspecified),
+	})
+	s.Require().NoError(err)
+	s.Require().Equal([]uint64{orderId1, orderId2}, cancelledOrderIds)
+	s.Require().Len(orderIds, 1)
+	s.AssertEqual(utils.ParseCoins("2_500000uusd"), s.GetAllBalances(mmAddr))
+	numMMOrders, _ := s.keeper.GetNumMMOrders(s.Ctx, mmAddr, market.Id)
+	s.Require().EqualValues(1, numMMOrders)
+	order, found := s.keeper.GetOrder(s.Ctx, orderIds[0])
+	s.Require().True(found)
+	s.Require().Equal(types.OrderTypeMM, order.Type)
+	s.AssertEqual(utils.ParseDec("95_000000"), order.RemainingDeposit)
+	// Normal orders are untouched.
+	_, found = s.keeper.GetOrder(s.Ctx, orderId3)
+	s.Require().True(found)
+
+	s.NextBlock()
+	// Not enough funds even with the reused deposits.
+	cacheCtx, _ := s.Ctx.CacheContext()
+	_, _, err = s.keeper.ReplaceMMOrders(cacheCtx, mmAddr, market.Id, []types.MMOrderParameters{
+		types.NewMMOrderParameters(true, utils.ParseDec("5"), sdk.NewDec(20_000000), time.Hour, types.SelfTradePreventionUnspecified),
+	})
+	s.Require().ErrorIs(err, sdkerrors.ErrInsufficientFunds)
+
+	// The number of new orders is limited by MaxNumMMOrders.
+	maxNumMMOrders := s.keeper.GetMaxNumMMOrders(s.Ctx)
+	var orders []types.MMOrderParameters
+	for i := uint32(0); i <= maxNumMMOrders; i++ {
+		orders = append(orders, types.NewMMOrderParameters(true, utils.ParseDec("4"), sdk.NewDec(10000), time.Hour, types.SelfTradePreventionUnspecified))
+	}
+	cacheCtx, _ = s.Ctx.CacheContext()
+	_, _, err = s.keeper.ReplaceMMOrders(cacheCtx, mmAddr, market.Id, orders)
+	s.Require().EqualError(err, "16 > 15: number of MM orders exceeded the limit")
+
+	// Replacing with no orders cancels all MM orders.
+	cancelledOrderIds, orderIds, err = s.keeper.ReplaceMMOrders(s.Ctx, mmAddr, market.Id, nil)
+	s.Require().NoError(err)
+	s.Require().Equal([]uint64{order.Id}, cancelledOrderIds)
+	s.Require().Empty(orderIds)
+	_, found = s.keeper.GetNumMMOrders(s.Ctx, mmAddr, market.Id)
+	s.Require().False(found)
+	s.AssertEqual(utils.ParseCoins("97_500000uusd"), s.GetAllBalances(mmAddr))
+}
+
+func (s *KeeperTestSuite) TestPlaceLimitOrder_SelfTradePrevention() {
+	for _, tc := range []struct {
+		name                  string
+		mode                  types.SelfTradePrevention
+		executedQty           sdk.Dec
+		oldestOrderFound      bool
+		newestOrderOpenQty    sdk.Dec // zero if the order does not rest
+		newestOrderDeposit    sdk.Dec
+		selfTradePreventedEvt bool
+	}{
+		{
+			"none", types.SelfTradePreventionNone,
+			sdk.NewDec(8_000000), false, sdk.NewDec(2_000000), sdk.NewDec(2_000000), false,
+		},
+		{
+			"cancel newest", types.SelfTradePreventionCancelNewest,
+			utils.ZeroDec, true, utils.ZeroDec, utils.ZeroDec, true,
+		},
+		{
+			"cancel oldest", types.SelfTradePreventionCancelOldest,
+			sdk.NewDec(3_000000), false, sdk.NewDec(7_000000), sdk.NewDec(7_000000), true,
+		},
+		{
+			"cancel both", types.SelfTradePreventionCancelBoth,
+			utils.ZeroDec, false, utils.ZeroDec, utils.ZeroDec, true,
+		},
+		{
+			"decrement and cancel", types.SelfTradePreventionDecrementAndCancel,
+			sdk.NewDec(3_000000), false, sdk.NewDec(2_000000), sdk.NewDec(2_000000), true,
+		},
+	} {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			market := s.CreateMarket("ucre", "uusd")
+
+			ordererAddr1 := s.FundedAccount(1, enoughCoins)
+			ordererAddr2 := s.FundedAccount(2, enoughCoins)
+
+			_, oldestOrder, _ := s.PlaceLimitOrder(
+				market.Id, ordererAddr1, false, utils.ParseDec("1"), sdk.NewDec(5_000000), time.Hour)
+			_, otherOrder, _ := s.PlaceLimitOrder(
+				market.Id, ordererAddr2, false, utils.ParseDec("1"), sdk.NewDec(3_000000), time.Hour)
+
+			s.Ctx = s.Ctx.WithEventManager(sdk.NewEventManager())
+			orderId, _, res, _, err := s.keeper.PlaceLimitOrder(
+				s.Ctx, market.Id, ordererAddr1, true, utils.ParseDec("1"), sdk.NewDec(10_000000), nil, time.Hour,
+				types.TimeInForceGoodTilTime, tc.mode, nil)
+			s.Require().NoError(err)
+			s.AssertEqual(tc.executedQty, res.ExecutedQuantity)
+			if tc.selfTradePreventedEvt {
+				s.CheckEvent(&types.EventSelfTradePrevented{}, map[string][]byte{
+					"newest_order_id": []byte(fmt.Sprintf(`"%d"`, orderId)),
+					"oldest_order_id": []byte(fmt.Sprintf(`"%d"`, oldestOrder.Id)),
+				})
+			}
+
+			_, found := s.keeper.GetOrder(s.Ctx, oldestOrder.Id)
+			s.Require().Equal(tc.oldestOrderFound, found)
+			// The other orderer's order is not affected by self-trade prevention.
+			if tc.executedQty.IsZero() {
+				_, found = s.keeper.GetOrder(s.Ctx, otherOrder.Id)
+				s.Require().True(found)
+			}
+			newestOrder, found := s.keeper.GetOrder(s.Ctx, orderId)
+			if tc.newestOrderOpenQty.IsZero() {
+				s.Require().False(found)
+			} else {
+				s.Require().True(found)
+				s.AssertEqual(tc.newestOrderOpenQty, newestOrder.OpenQuantity)
+				s.AssertEqual(tc.newestOrderDeposit, newestOrder.RemainingDeposit)
+			}
+			if tc.mode == types.SelfTradePreventionCancelBoth {
+				// All deposits have been refunded.
+				s.AssertEqual(enoughCoins, s.GetAllBalances(ordererAddr1))
+			}
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestPlaceLimitOrder_MarketSelfTradePrevention() {
+	market := s.CreateMarket("ucre", "uusd")
+	market.SelfTradePrevention = types.SelfTradePreventionCancelNewest
+	s.keeper.SetMarket(s.Ctx, market)
+
+	ordererAddr := s.FundedAccount(1, enoughCoins)
+	_, sellOrder, _ := s.PlaceLimitOrder(
+		market.Id, ordererAddr, false, utils.ParseDec("1"), sdk.NewDec(5_000000), time.Hour)
+
+	// The market's mode is applied.
+	_, _, res := s.PlaceLimitOrder(
+		market.Id, ordererAddr, true, utils.ParseDec("1"), sdk.NewDec(5_000000), time.Hour)
+	s.AssertEqual(utils.ZeroDec, res.ExecutedQuantity)
+	s.Require().True(res.SelfTradePrevented)
+
+	// The order's mode overrides the market's mode.
+	_, _, res, _, err := s.keeper.PlaceLimitOrder(
+		s.Ctx, market.Id, ordererAddr, true, utils.ParseDec("1"), sdk.NewDec(5_000000), nil, time.Hour,
+		types.TimeInForceGoodTilTime, types.SelfTradePreventionNone, nil)
+	s.Require().NoError(err)
+	s.AssertEqual(sdk.NewDec(5_000000), res.ExecutedQuantity)
+	_, found := s.keeper.GetOrder(s.Ctx, sellOrder.Id)
+	s.Require().False(found)
+}
+
 func (s *KeeperTestSuite) TestOrderMatching() {
 	aliceAddr := s.FundedAccount(1, utils.ParseCoins("1000000ucre,1000000uusd"))
 	bobAddr := s.FundedAccount(2, utils.ParseCoins("1000000ucre,1000000uusd"))
@@ -236,6 +515,101 @@ func (s *KeeperTestSuite) TestCancelAllOrders() {
 	s.Require().EqualValues(6, cancelledOrders[5].Id)
 }
 
+func (s *KeeperTestSuite) TestAmendOrder() {
+	market := s.CreateMarket("ucre", "uusd")
+	mmAddr := s.FundedAccount(1, enoughCoins)
+	ordererAddr := s.FundedAccount(2, enoughCoins)
+
+	s.PlaceLimitOrder(market.Id, mmAddr, false, utils.ParseDec("5.1"), sdk.NewDec(10_000000), time.Hour)
+	balancesBefore := s.GetAllBalances(ordererAddr)
+	_, order, _ := s.PlaceLimitOrder(
+		market.Id, ordererAddr, true, utils.ParseDec("5"), sdk.NewDec(10_000000), time.Hour)
+	msgHeight := order.MsgHeight
+
+	qty := sdk.NewDec(6_000000)
+	_, err := s.keeper.AmendOrder(s.Ctx, ordererAddr, order.Id, nil, &qty, nil)
+	s.Require().EqualError(err, "cannot amend order placed in the same block: invalid request")
+
+	s.NextBlock()
+	_, err = s.keeper.AmendOrder(s.Ctx, mmAddr, order.Id, nil, &qty, nil)
+	s.Require().EqualError(err, "order is not created by the sender: unauthorized")
+
+	// Reducing the quantity keeps the priority.
+	s.Ctx = s.Ctx.WithEventManager(sdk.NewEventManager())
+	order = s.AmendOrder(ordererAddr, order.Id, nil, &qty, nil)
+	s.Require().Equal(msgHeight, order.PriorityHeight)
+	s.Require().Equal(sdk.NewDec(6_000000), order.OpenQuantity)
+	s.Require().Equal(sdk.NewDec(30_000000), order.RemainingDeposit)
+	s.CheckEvent(&types.EventAmendOrder{}, map[string][]byte{
+		"open_quantity": []byte(`"6000000.000000000000000000"`),
+		"requeued":      []byte(`false`),
+	})
+	diff, _ := s.GetAllBalances(ordererAddr).SafeSub(balancesBefore)
+	s.Require().Equal("-30000000uusd", diff.String())
+
+	// The order cannot be amended to be executed immediately.
+	price := utils.ParseDec("5.1")
+	_, err = s.keeper.AmendOrder(s.Ctx, ordererAddr, order.Id, &price, nil, nil)
+	s.Require().EqualError(err, "amended order would match against existing orders: invalid request")
+
+	// Changing the price re-queues the order.
+	price = utils.ParseDec("4.9")
+	order = s.AmendOrder(ordererAddr, order.Id, &price, nil, nil)
+	s.Require().Equal(s.Ctx.BlockHeight(), order.PriorityHeight)
+	s.Require().Equal(sdk.NewDec(29_400000), order.RemainingDeposit)
+	// The re-queued order keeps its msg height, so it can still be cancelled
+	// or amended again in the same block.
+	s.Require().Equal(msgHeight, order.MsgHeight)
+	cacheCtx, _ := s.Ctx.CacheContext()
+	_, err = s.keeper.CancelOrder(cacheCtx, ordererAddr, order.Id)
+	s.Require().NoError(err)
+	found := false
+	s.keeper.IterateOrderBookSide(s.Ctx, market.Id, true, nil, func(price sdk.Dec, orders []types.Order) (stop bool) {
+		s.Require().Equal(utils.ParseDec("4.9"), price)
+		s.Require().Len(orders, 1)
+		found = true
+		return false
+	})
+	s.Require().True(found)
+
+	// Increasing the quantity re-queues the order, too.
+	s.NextBlock()
+	qty = sdk.NewDec(8_000000)
+	order = s.AmendOrder(ordererAddr, order.Id, nil, &qty, nil)
+	s.Require().Equal(s.Ctx.BlockHeight(), order.PriorityHeight)
+	s.Require().Equal(msgHeight, order.MsgHeight)
+	// Amending the re-queued order again in the same block is allowed.
+	qty = sdk.NewDec(7_000000)
+	order = s.AmendOrder(ordererAddr, order.Id, nil, &qty, nil)
+	qty = sdk.NewDec(8_000000)
+	order = s.AmendOrder(ordererAddr, order.Id, nil, &qty, nil)
+	s.Require().Equal(sdk.NewDec(39_200000), order.RemainingDeposit)
+
+	s.NextBlock()
+	s.PlaceLimitOrder(market.Id, mmAddr, false, utils.ParseDec("4.9"), sdk.NewDec(3_000000), time.Hour)
+	order = s.keeper.MustGetOrder(s.Ctx, order.Id)
+	s.Require().Equal(sdk.NewDec(5_000000), order.OpenQuantity)
+
+	s.NextBlock()
+	qty = sdk.NewDec(3_000000)
+	_, err = s.keeper.AmendOrder(s.Ctx, ordererAddr, order.Id, nil, &qty, nil)
+	s.Require().EqualError(
+		err, "quantity must be greater than the executed quantity 3000000.000000000000000000: invalid request")
+	qty = sdk.NewDec(4_000000)
+	lifespan := 2 * time.Hour
+	order = s.AmendOrder(ordererAddr, order.Id, nil, &qty, &lifespan)
+	s.Require().Equal(sdk.NewDec(1_000000), order.OpenQuantity)
+	s.Require().Equal(s.Ctx.BlockTime().Add(lifespan), order.Deadline)
+	// Changing the deadline re-queues the order.
+	s.Require().Equal(s.Ctx.BlockHeight(), order.PriorityHeight)
+
+	s.NextBlock()
+	s.CancelOrder(ordererAddr, order.Id)
+	diff, _ = s.GetAllBalances(ordererAddr).SafeSub(balancesBefore)
+	// 3_000000 * (1 - 0.0015) = 2_995500, 3_000000 * 4.9 = 14_700000
+	s.Require().Equal("2995500ucre,-14700000uusd", diff.String())
+}
+
 func (s *KeeperTestSuite) TestFairMatching() {
 	market := s.CreateMarket("ucre", "uusd")
 
@@ -357,7 +731,7 @@ func (s *KeeperTestSuite) TestPlaceMarketOrder_SellInsufficientFunds() {
 	s.PlaceLimitOrder(market.Id, ordererAddr1, true, utils.ParseDec("5"), sdk.NewDec(1_000000), time.Hour)
 	s.PlaceLimitOrder(market.Id, ordererAddr2, false, utils.ParseDec("5"), sdk.NewDec(1_000000), time.Hour)
 
-	_, _, err := s.keeper.PlaceMarketOrder(s.Ctx, market.Id, ordererAddr2, false, sdk.NewDec(500000))
+	_, _, err := s.keeper.PlaceMarketOrder(s.Ctx, market.Id, ordererAddr2, false, sdk.NewDec(500000), nil)
 	s.Require().EqualError(err, "200000ucre is smaller than 500000.000000000000000000ucre: insufficient funds")
 }
 
@@ -375,16 +749,18 @@ func (s *KeeperTestSuite) TestMaxOrderPriceRatio() {
 	s.AssertEqual(utils.ParseDec("5"), *marketState.LastPrice)
 
 	// 5.6 > 5 * 1.1 (not allowed for buy orders)
-	_, _, _, err := s.keeper.PlaceLimitOrder(
-		s.Ctx, market.Id, ordererAddr1, true, utils.ParseDec("5.6"), sdk.NewDec(1_000000), time.Hour)
+	_, _, _, _, err := s.keeper.PlaceLimitOrder(
+		s.Ctx, market.Id, ordererAddr1, true, utils.ParseDec("5.6"), sdk.NewDec(1_000000), nil, time.Hour,
+		types.TimeInForceGoodTilTime, types.SelfTradePreventionUnspecified, nil)
 	s.Require().EqualError(err, "price is higher than the limit 5.500000000000000000: order price out of range")
 	// 4 < 5 * 0.9 (allowed for buy orders)
 	s.PlaceLimitOrder(
 		market.Id, ordererAddr1, true, utils.ParseDec("4"), sdk.NewDec(1_000000), time.Hour)
 
 	// 4.4 < 5 * 0.9 (not allowed for sell orders)
-	_, _, _, err = s.keeper.PlaceLimitOrder(
-		s.Ctx, market.Id, ordererAddr2, false, utils.ParseDec("4.4"), sdk.NewDec(1_000000), time.Hour)
+	_, _, _, _, err = s.keeper.PlaceLimitOrder(
+		s.Ctx, market.Id, ordererAddr2, false, utils.ParseDec("4.4"), sdk.NewDec(1_000000), nil, time.Hour,
+		types.TimeInForceGoodTilTime, types.SelfTradePreventionUnspecified, nil)
 	s.Require().EqualError(err, "price is lower than the limit 4.500000000000000000: order price out of range")
 	// 6 > 5 * 1.1 (allowed for sell orders)
 	s.PlaceLimitOrder(
@@ -411,3 +787,122 @@ func (s *KeeperTestSuite) TestMaxOrderPriceRatio() {
 	s.AssertEqual(utils.ParseDec("4.5"), res.LastPrice)
 	s.AssertEqual(sdk.NewDec(1_000000), res.ExecutedQuantity)
 }
+
+func (s *KeeperTestSuite) TestOrderSizes() {
+	market := s.CreateMarket("ucre", "uusd")
+	market.TickSize = utils.ParseDec("0.05")
+	market.MinOrderQuantity = sdk.NewDec(10000)
+	market.LotSize = sdk.NewDec(1000)
+	s.keeper.SetMarket(s.Ctx, market)
+
+	ordererAddr := s.FundedAccount(1, enoughCoins)
+	placeLimitOrder := func(price, qty sdk.Dec) error {
+		_, _, _, _, err := s.keeper.PlaceLimitOrder(
+			s.Ctx, market.Id, ordererAddr, true, price, qty, nil, time.Hour, types.TimeInForceGoodTilTime, types.SelfTradePreventionUnspecified, nil)
+		return err
+	}
+	s.Require().EqualError(
+		placeLimitOrder(utils.ParseDec("5.01"), sdk.NewDec(10000)),
+		"price must be a multiple of the tick size 0.050000000000000000: 5.010000000000000000: invalid request")
+	s.Require().EqualError(
+		placeLimitOrder(utils.ParseDec("5.05"), sdk.NewDec(9000)),
+		"quantity is smaller than the min order quantity 10000.000000000000000000: 9000.000000000000000000: invalid request")
+	s.Require().EqualError(
+		placeLimitOrder(utils.ParseDec("5.05"), sdk.NewDec(10500)),
+		"quantity must be a multiple of the lot size 1000.000000000000000000: 10500.000000000000000000: invalid request")
+	s.Require().NoError(placeLimitOrder(utils.ParseDec("5.05"), sdk.NewDec(11000)))
+
+	_, _, err := s.keeper.PlaceMarketOrder(s.Ctx, market.Id, ordererAddr, false, sdk.NewDec(10500), nil)
+	s.Require().EqualError(
+		err, "quantity must be a multiple of the lot size 1000.000000000000000000: 10500.000000000000000000: invalid request")
+}
+
+func (s *KeeperTestSuite) TestIcebergOrder() {
+	market := s.CreateMarket("ucre", "uusd")
+	icebergAddr := s.FundedAccount(1, enoughCoins)
+	makerAddr := s.FundedAccount(2, enoughCoins)
+	takerAddr := s.FundedAccount(3, enoughCoins)
+
+	balancesBefore := s.GetAllBalances(icebergAddr)
+	_, order, _ := s.PlaceIcebergOrder(
+		market.Id, icebergAddr, false, utils.ParseDec("5"), sdk.NewDec(10_000000), sdk.NewDec(2_000000), time.Hour)
+	s.Require().Equal(sdk.NewDec(8_000000), order.HiddenQuantity)
+	// The deposit covers the whole quantity.
+	diff, _ := s.GetAllBalances(icebergAddr).SafeSub(balancesBefore)
+	s.Require().Equal("-10000000ucre", diff.String())
+	// Only the displayed quantity is on the order book.
+	level, _ := s.keeper.GetPriceLevel(s.Ctx, market.Id, false, utils.ParseDec("5"))
+	s.Require().Equal(sdk.NewDec(2_000000), level.TotalOpenQuantity)
+
+	s.NextBlock()
+	_, otherOrder, _ := s.PlaceLimitOrder(
+		market.Id, makerAddr, false, utils.ParseDec("5"), sdk.NewDec(3_000000), time.Hour)
+
+	s.NextBlock()
+	// The displayed slice keeps its priority over the newer order.
+	s.Ctx = s.Ctx.WithEventManager(sdk.NewEventManager())
+	s.PlaceLimitOrder(market.Id, takerAddr, true, utils.ParseDec("5"), sdk.NewDec(2_000000), time.Hour)
+	order = s.keeper.MustGetOrder(s.Ctx, order.Id)
+	s.Require().Equal(sdk.NewDec(8_000000), order.OpenQuantity)
+	s.Require().Equal(sdk.NewDec(6_000000), order.HiddenQuantity)
+	// The replenished slice gets a fresh priority, but the order can still be
+	// cancelled in the same block.
+	s.Require().Equal(s.Ctx.BlockHeight(), order.PriorityHeight)
+	s.Require().Less(order.MsgHeight, s.Ctx.BlockHeight())
+	cacheCtx, _ := s.Ctx.CacheContext()
+	_, err := s.keeper.CancelOrder(cacheCtx, icebergAddr, order.Id)
+	s.Require().NoError(err)
+	s.CheckEvent(&types.EventIcebergOrderReplenished{}, map[string][]byte{
+		"order_id":         []byte(fmt.Sprintf(`"%d"`, order.Id)),
+		"visible_quantity": []byte(`"2000000.000000000000000000"`),
+	})
+	otherOrder = s.keeper.MustGetOrder(s.Ctx, otherOrder.Id)
+	s.Require().Equal(sdk.NewDec(3_000000), otherOrder.OpenQuantity)
+
+	resp, err := s.querier.OrderBook(sdk.WrapSDKContext(s.Ctx), &types.QueryOrderBookRequest{
+		MarketId: market.Id,
+	})
+	s.Require().NoError(err)
+	s.Require().Len(resp.OrderBooks[0].Sells, 1)
+	s.Require().Equal(sdk.NewDec(5_000000), resp.OrderBooks[0].Sells[0].Q)
+
+	s.NextBlock()
+	// Now the other order has priority over the replenished slice.
+	s.PlaceLimitOrder(market.Id, takerAddr, true, utils.ParseDec("5"), sdk.NewDec(3_000000), time.Hour)
+	s.Require().False(s.keeper.LookupOrder(s.Ctx, otherOrder.Id))
+	order = s.keeper.MustGetOrder(s.Ctx, order.Id)
+	s.Require().Equal(sdk.NewDec(8_000000), order.OpenQuantity)
+
+	s.NextBlock()
+	// A large order keeps matching against the replenished slices.
+	_, _, res := s.PlaceLimitOrder(
+		market.Id, takerAddr, true, utils.ParseDec("5"), sdk.NewDec(9_000000), time.Hour)
+	s.Require().Equal(sdk.NewDec(8_000000), res.ExecutedQuantity)
+	s.Require().False(s.keeper.LookupOrder(s.Ctx, order.Id))
+	_, broken := keeper.OrderBookInvariant(s.keeper)(s.Ctx)
+	s.Require().False(broken)
+	_, broken = keeper.PriceLevelInvariant(s.keeper)(s.Ctx)
+	s.Require().False(broken)
+}
+
+func (s *KeeperTestSuite) TestIcebergOrder_Cancel() {
+	market := s.CreateMarket("ucre", "uusd")
+	ordererAddr := s.FundedAccount(1, enoughCoins)
+
+	balancesBefore := s.GetAllBalances(ordererAddr)
+	_, order, _ := s.PlaceIcebergOrder(
+		market.Id, ordererAddr, true, utils.ParseDec("5"), sdk.NewDec(10_000000), sdk.NewDec(1_000000), time.Hour)
+	s.NextBlock()
+	s.CancelOrder(ordererAddr, order.Id)
+	// The whole deposit including the hidden quantity is refunded.
+	s.Require().Equal(balancesBefore, s.GetAllBalances(ordererAddr))
+	_, found := s.keeper.GetPriceLevel(s.Ctx, market.Id, true, utils.ParseDec("5"))
+	s.Require().False(found)
+
+	// Iceberg orders must rest on the order book.
+	displayQty := sdk.NewDec(1_000000)
+	_, _, _, _, err := s.keeper.PlaceLimitOrder(
+		s.Ctx, market.Id, ordererAddr, true, utils.ParseDec("5"), sdk.NewDec(10_000000), &displayQty, time.Hour,
+		types.TimeInForceImmediateOrCancel, types.SelfTradePreventionUnspecified, nil)
+	s.Require().EqualError(err, "iceberg order cannot be TIME_IN_FORCE_IMMEDIATE_OR_CANCEL: invalid request")
+}
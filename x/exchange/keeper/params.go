@@ -72,3 +72,12 @@ func (k Keeper) GetMaxNumMMOrders(ctx sdk.Context) (maxNum uint32) {
 func (k Keeper) SetMaxNumMMOrders(ctx sdk.Context, maxNum uint32) {
 	k.paramSpace.Set(ctx, types.KeyMaxNumMMOrders, maxNum)
 }
+
+func (k Keeper) GetCircuitBreaker(ctx sdk.Context) (cb types.CircuitBreaker) {
+	k.paramSpace.Get(ctx, types.KeyCircuitBreaker, &cb)
+	return
+}
+
+func (k Keeper) SetCircuitBreaker(ctx sdk.Context, cb types.CircuitBreaker) {
+	k.paramSpace.Set(ctx, types.KeyCircuitBreaker, cb)
+}
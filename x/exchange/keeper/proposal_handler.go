@@ -16,12 +16,70 @@ func HandleMarketParameterChangeProposal(ctx sdk.Context, k Keeper, p *types.Mar
 		market.MakerFeeRate = change.MakerFeeRate
 		market.TakerFeeRate = change.TakerFeeRate
 		market.OrderSourceFeeRatio = change.OrderSourceFeeRatio
+		if err := types.ValidateOrderSourceFeeRatio(
+			market.OrderSourceFeeRatio, k.GetFees(ctx).ReferralFeeRatio); err != nil {
+			return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+		}
+		if change.TickSize != nil {
+			market.TickSize = *change.TickSize
+		}
+		if change.MinOrderQuantity != nil {
+			market.MinOrderQuantity = *change.MinOrderQuantity
+		}
+		if change.LotSize != nil {
+			market.LotSize = *change.LotSize
+		}
+		if change.SelfTradePrevention != types.SelfTradePreventionUnspecified {
+			market.SelfTradePrevention = change.SelfTradePrevention
+		}
 		k.SetMarket(ctx, market)
 		if err := ctx.EventManager().EmitTypedEvent(&types.EventMarketParameterChanged{
 			MarketId:            change.MarketId,
 			MakerFeeRate:        change.MakerFeeRate,
 			TakerFeeRate:        change.TakerFeeRate,
 			OrderSourceFeeRatio: change.OrderSourceFeeRatio,
+			TickSize:            market.TickSize,
+			MinOrderQuantity:    market.MinOrderQuantity,
+			LotSize:             market.LotSize,
+			SelfTradePrevention: market.SelfTradePrevention,
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func HandleMarketStatusChangeProposal(ctx sdk.Context, k Keeper, p *types.MarketStatusChangeProposal) error {
+	for _, change := range p.Changes {
+		market, found := k.GetMarket(ctx, change.MarketId)
+		if !found {
+			return sdkerrors.Wrapf(sdkerrors.ErrNotFound, "market %d not found", change.MarketId)
+		}
+		if market.Status == types.MarketStatusDelisted {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "market %d is already delisted", market.Id)
+		}
+		wasActive := market.IsActive()
+		market.Status = change.Status
+		k.SetMarket(ctx, market)
+		if market.IsActive() != wasActive {
+			if market.IsActive() {
+				k.SetActiveMarketsByDenomIndex(ctx, market)
+			} else {
+				k.DeleteActiveMarketsByDenomIndex(ctx, market)
+			}
+		}
+		var cancelledOrderIds []uint64
+		if market.Status == types.MarketStatusDelisted {
+			var err error
+			cancelledOrderIds, err = k.cancelAllMarketOrders(ctx, market)
+			if err != nil {
+				return err
+			}
+		}
+		if err := ctx.EventManager().EmitTypedEvent(&types.EventMarketStatusChanged{
+			MarketId:          change.MarketId,
+			Status:            change.Status,
+			CancelledOrderIds: cancelledOrderIds,
 		}); err != nil {
 			return err
 		}
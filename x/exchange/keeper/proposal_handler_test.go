@@ -1,6 +1,10 @@
 package keeper_test
 
 import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
 	utils "github.com/crescent-network/crescent/v5/types"
 	"github.com/crescent-network/crescent/v5/x/exchange"
 	"github.com/crescent-network/crescent/v5/x/exchange/types"
@@ -23,6 +27,34 @@ func (s *KeeperTestSuite) TestPoolParameterChangeProposal() {
 	s.Require().Equal(utils.ParseDec("0.001"), market2.MakerFeeRate)
 	s.Require().Equal(utils.ParseDec("0.002"), market2.TakerFeeRate)
 	s.Require().Equal(utils.ParseDec("0.3"), market2.OrderSourceFeeRatio)
+	s.Require().True(market2.TickSize.IsZero())
+
+	// Change order sizes only
+	change := types.NewMarketParameterChange(
+		market2.Id, utils.ParseDec("0.001"), utils.ParseDec("0.002"), utils.ParseDec("0.3"))
+	change.TickSize = utils.ParseDecP("0.01")
+	change.LotSize = utils.ParseDecP("1000")
+	proposal = types.NewMarketParameterChangeProposal(
+		"Title", "Description", []types.MarketParameterChange{change})
+	s.Require().NoError(proposal.ValidateBasic())
+	s.Require().NoError(handler(s.Ctx, proposal))
+	market2, _ = s.keeper.GetMarket(s.Ctx, market2.Id)
+	s.Require().Equal(utils.ParseDec("0.01"), market2.TickSize)
+	s.Require().True(market2.MinOrderQuantity.IsZero()) // unchanged
+	s.Require().Equal(utils.ParseDec("1000"), market2.LotSize)
+	s.Require().Equal(types.SelfTradePreventionUnspecified, market2.SelfTradePrevention)
+
+	// Change self-trade prevention mode
+	change = types.NewMarketParameterChange(
+		market2.Id, utils.ParseDec("0.001"), utils.ParseDec("0.002"), utils.ParseDec("0.3"))
+	change.SelfTradePrevention = types.SelfTradePreventionCancelOldest
+	proposal = types.NewMarketParameterChangeProposal(
+		"Title", "Description", []types.MarketParameterChange{change})
+	s.Require().NoError(proposal.ValidateBasic())
+	s.Require().NoError(handler(s.Ctx, proposal))
+	market2, _ = s.keeper.GetMarket(s.Ctx, market2.Id)
+	s.Require().Equal(types.SelfTradePreventionCancelOldest, market2.SelfTradePrevention)
+	s.Require().Equal(utils.ParseDec("0.01"), market2.TickSize) // unchanged
 
 	// Untouched
 	market1, _ = s.keeper.GetMarket(s.Ctx, market1.Id)
@@ -31,6 +63,22 @@ func (s *KeeperTestSuite) TestPoolParameterChangeProposal() {
 	s.Require().Equal(fees.DefaultTakerFeeRate, market1.TakerFeeRate)
 	s.Require().Equal(fees.DefaultOrderSourceFeeRatio, market1.OrderSourceFeeRatio)
 
+	// Order source fee ratio and referral fee ratio sum up to more than 1
+	fees.ReferralFeeRatio = utils.ParseDec("0.3")
+	s.keeper.SetFees(s.Ctx, fees)
+	proposal = types.NewMarketParameterChangeProposal(
+		"Title", "Description", []types.MarketParameterChange{
+			types.NewMarketParameterChange(
+				market2.Id, utils.ParseDec("0.001"), utils.ParseDec("0.002"), utils.ParseDec("0.8")),
+		})
+	s.Require().NoError(proposal.ValidateBasic())
+	s.Require().EqualError(
+		handler(s.Ctx, proposal),
+		"order source fee ratio and referral fee ratio must not sum up to more than 1: "+
+			"0.800000000000000000 + 0.300000000000000000: invalid request")
+	market2, _ = s.keeper.GetMarket(s.Ctx, market2.Id)
+	s.Require().Equal(utils.ParseDec("0.3"), market2.OrderSourceFeeRatio) // unchanged
+
 	// Market not found
 	proposal = types.NewMarketParameterChangeProposal(
 		"Title", "Description", []types.MarketParameterChange{
@@ -40,3 +88,56 @@ func (s *KeeperTestSuite) TestPoolParameterChangeProposal() {
 	s.Require().NoError(proposal.ValidateBasic())
 	s.Require().EqualError(handler(s.Ctx, proposal), "market 3 not found: not found")
 }
+
+func (s *KeeperTestSuite) TestMarketStatusChangeProposal() {
+	handler := exchange.NewProposalHandler(s.keeper)
+	market := s.CreateMarket("ucre", "uusd")
+	s.Require().Equal(types.MarketStatusActive, market.Status)
+
+	mmAddr := s.FundedAccount(1, enoughCoins)
+	ordererAddr := s.FundedAccount(2, enoughCoins)
+	s.MakeLastPrice(market.Id, mmAddr, utils.ParseDec("5"))
+	_, order, _ := s.PlaceLimitOrder(market.Id, ordererAddr, true, utils.ParseDec("4.9"), sdk.NewDec(100_000000), time.Hour)
+	s.NextBlock()
+
+	changeStatus := func(status types.MarketStatus) error {
+		proposal := types.NewMarketStatusChangeProposal(
+			"Title", "Description", []types.MarketStatusChange{
+				types.NewMarketStatusChange(market.Id, status),
+			})
+		s.Require().NoError(proposal.ValidateBasic())
+		return handler(s.Ctx, proposal)
+	}
+
+	// Cancel-only: orders cannot be placed but can be cancelled.
+	s.Require().NoError(changeStatus(types.MarketStatusCancelOnly))
+	_, _, _, _, err := s.keeper.PlaceLimitOrder(
+		s.Ctx, market.Id, ordererAddr, true, utils.ParseDec("4.9"), sdk.NewDec(100_000000), nil, time.Hour,
+		types.TimeInForceGoodTilTime, types.SelfTradePreventionUnspecified, nil)
+	s.Require().ErrorIs(err, types.ErrMarketNotActive)
+	_, _, err = s.keeper.PlaceMarketOrder(s.Ctx, market.Id, ordererAddr, true, sdk.NewDec(100_000000), nil)
+	s.Require().ErrorIs(err, types.ErrMarketNotActive)
+	_, _, err = s.keeper.SwapExactAmountIn(
+		s.Ctx, ordererAddr, []uint64{market.Id}, utils.ParseDecCoin("1000000uusd"), utils.ParseDecCoin("0ucre"), nil, false)
+	s.Require().ErrorIs(err, types.ErrMarketNotActive)
+	s.Require().Empty(s.keeper.FindAllRoutes(s.Ctx, "uusd", "ucre", 3))
+
+	// Halted: orders cannot be cancelled either.
+	s.Require().NoError(changeStatus(types.MarketStatusHalted))
+	_, err = s.keeper.CancelOrder(s.Ctx, ordererAddr, order.Id)
+	s.Require().ErrorIs(err, types.ErrMarketNotActive)
+
+	// Active again.
+	s.Require().NoError(changeStatus(types.MarketStatusActive))
+	s.Require().Len(s.keeper.FindAllRoutes(s.Ctx, "uusd", "ucre", 3), 1)
+
+	// Delisting refunds all open orders.
+	s.Require().NoError(changeStatus(types.MarketStatusDelisted))
+	_, found := s.keeper.GetOrder(s.Ctx, order.Id)
+	s.Require().False(found)
+	s.Require().Equal(enoughCoins, s.GetAllBalances(ordererAddr))
+	s.Require().Empty(s.keeper.FindAllRoutes(s.Ctx, "uusd", "ucre", 3))
+
+	// Delisted markets cannot be relisted.
+	s.Require().EqualError(changeStatus(types.MarketStatusActive), "market 1 is already delisted: invalid request")
+}
@@ -0,0 +1,23 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	utils "github.com/crescent-network/crescent/v5/types"
+	"github.com/crescent-network/crescent/v5/x/exchange/types"
+)
+
+// addReferrerStats adds the volume and the referral fee of an order referred
+// by the referrer to the referrer's accumulated stats in the market.
+func (k Keeper) addReferrerStats(
+	ctx sdk.Context, referrerAddr sdk.AccAddress, marketId uint64, volume sdk.Dec, referralFee sdk.DecCoin) {
+	stats, found := k.GetReferrerStats(ctx, referrerAddr, marketId)
+	if !found {
+		stats = types.NewReferrerStats(utils.ZeroDec, nil)
+	}
+	stats.Volume = stats.Volume.Add(volume)
+	if referralFee.IsPositive() {
+		stats.Fees = stats.Fees.Add(referralFee)
+	}
+	k.SetReferrerStats(ctx, referrerAddr, marketId, stats)
+}
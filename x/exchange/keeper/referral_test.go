@@ -0,0 +1,84 @@
+package keeper_test
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	utils "github.com/crescent-network/crescent/v5/types"
+	"github.com/crescent-network/crescent/v5/x/exchange/types"
+)
+
+func (s *KeeperTestSuite) setReferralFeeRatio(ratio sdk.Dec) {
+	fees := s.keeper.GetFees(s.Ctx)
+	fees.ReferralFeeRatio = ratio
+	s.keeper.SetFees(s.Ctx, fees)
+}
+
+func (s *KeeperTestSuite) TestReferralFee() {
+	s.setReferralFeeRatio(utils.ParseDec("0.2"))
+	market := s.CreateMarket("ucre", "uusd")
+
+	makerAddr := s.FundedAccount(1, enoughCoins)
+	takerAddr := s.FundedAccount(2, enoughCoins)
+	referrerAddr := utils.TestAddress(3)
+
+	s.PlaceLimitOrder(market.Id, makerAddr, false, utils.ParseDec("5"), sdk.NewDec(10_000000), time.Hour)
+	s.Ctx = s.Ctx.WithEventManager(sdk.NewEventManager())
+	_, _, res, _, err := s.keeper.PlaceLimitOrder(
+		s.Ctx, market.Id, takerAddr, true, utils.ParseDec("5"), sdk.NewDec(4_000000), nil, time.Hour,
+		types.TimeInForceGoodTilTime, types.SelfTradePreventionUnspecified, referrerAddr)
+	s.Require().NoError(err)
+	s.AssertEqual(utils.ParseDecCoin("12000ucre"), res.Fee)        // 0.3%
+	s.AssertEqual(utils.ParseDecCoin("2400ucre"), res.ReferralFee) // 20% of the fee
+	s.AssertEqual(utils.ParseDecCoin("3988000ucre"), res.Received)
+	s.AssertEqual(utils.ParseCoins("2400ucre"), s.GetAllBalances(referrerAddr))
+	s.CheckEvent(&types.EventPlaceLimitOrder{}, map[string][]byte{
+		"referrer": []byte(fmt.Sprintf("%q", referrerAddr.String())),
+	})
+
+	stats, found := s.keeper.GetReferrerStats(s.Ctx, referrerAddr, market.Id)
+	s.Require().True(found)
+	s.AssertEqual(utils.ParseDec("20_000000"), stats.Volume)
+	s.AssertEqual(utils.ParseDecCoins("2400ucre"), stats.Fees)
+
+	// Swaps pay the referral fee too.
+	_, _, err = s.keeper.SwapExactAmountIn(
+		s.Ctx, takerAddr, []uint64{market.Id}, utils.ParseDecCoin("5_000000uusd"), utils.ParseDecCoin("0ucre"),
+		referrerAddr, false)
+	s.Require().NoError(err)
+	s.AssertEqual(utils.ParseCoins("3000ucre"), s.GetAllBalances(referrerAddr))
+	stats, _ = s.keeper.GetReferrerStats(s.Ctx, referrerAddr, market.Id)
+	s.AssertEqual(utils.ParseDec("25_000000"), stats.Volume)
+	s.AssertEqual(utils.ParseDecCoins("3000ucre"), stats.Fees)
+}
+
+func (s *KeeperTestSuite) TestReferralFee_RestingOrder() {
+	s.setReferralFeeRatio(utils.ParseDec("0.2"))
+	market := s.CreateMarket("ucre", "uusd")
+
+	makerAddr := s.FundedAccount(1, enoughCoins)
+	takerAddr := s.FundedAccount(2, enoughCoins)
+	referrerAddr := utils.TestAddress(3)
+
+	// The referred order rests on the order book and is filled as a maker.
+	_, order, _, _, err := s.keeper.PlaceLimitOrder(
+		s.Ctx, market.Id, makerAddr, false, utils.ParseDec("5"), sdk.NewDec(10_000000), nil, time.Hour,
+		types.TimeInForceGoodTilTime, types.SelfTradePreventionUnspecified, referrerAddr)
+	s.Require().NoError(err)
+	s.Require().Equal(referrerAddr.String(), order.Referrer)
+
+	s.Ctx = s.Ctx.WithEventManager(sdk.NewEventManager())
+	s.PlaceLimitOrder(market.Id, takerAddr, true, utils.ParseDec("5"), sdk.NewDec(4_000000), time.Hour)
+	s.CheckEvent(&types.EventOrderFilled{}, map[string][]byte{
+		"order_id": []byte(fmt.Sprintf(`"%d"`, order.Id)),
+		"referrer": []byte(fmt.Sprintf("%q", referrerAddr.String())),
+	})
+	// Makers don't pay referral fees, but the volume is accumulated.
+	s.Require().True(s.GetAllBalances(referrerAddr).IsZero())
+	stats, found := s.keeper.GetReferrerStats(s.Ctx, referrerAddr, market.Id)
+	s.Require().True(found)
+	s.AssertEqual(utils.ParseDec("20_000000"), stats.Volume)
+	s.Require().True(stats.Fees.IsZero())
+}
@@ -1,6 +1,8 @@
 package keeper
 
 import (
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	utils "github.com/crescent-network/crescent/v5/types"
@@ -109,6 +111,34 @@ func (k Keeper) SetMarketByDenomsIndex(ctx sdk.Context, market types.Market) {
 		types.GetMarketByDenomsIndexKey(market.BaseDenom, market.QuoteDenom), sdk.Uint64ToBigEndian(market.Id))
 }
 
+// SetActiveMarketsByDenomIndex adds the market to the denom graph used for
+// finding swap routes.
+func (k Keeper) SetActiveMarketsByDenomIndex(ctx sdk.Context, market types.Market) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetActiveMarketsByDenomIndexKey(market.BaseDenom, market.QuoteDenom, market.Id), []byte{})
+	store.Set(types.GetActiveMarketsByDenomIndexKey(market.QuoteDenom, market.BaseDenom, market.Id), []byte{})
+}
+
+func (k Keeper) DeleteActiveMarketsByDenomIndex(ctx sdk.Context, market types.Market) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetActiveMarketsByDenomIndexKey(market.BaseDenom, market.QuoteDenom, market.Id))
+	store.Delete(types.GetActiveMarketsByDenomIndexKey(market.QuoteDenom, market.BaseDenom, market.Id))
+}
+
+// IterateActiveMarketsByDenom iterates through active markets which have
+// the denom as either base or quote denom.
+func (k Keeper) IterateActiveMarketsByDenom(ctx sdk.Context, denom string, cb func(counterDenom string, marketId uint64) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.GetActiveMarketsByDenomIteratorPrefix(denom))
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		_, counterDenom, marketId := types.ParseActiveMarketsByDenomIndexKey(iter.Key())
+		if cb(counterDenom, marketId) {
+			break
+		}
+	}
+}
+
 func (k Keeper) GetLastOrderId(ctx sdk.Context) (orderId uint64) {
 	store := ctx.KVStore(k.storeKey)
 	bz := store.Get(types.LastOrderIdKey)
@@ -216,11 +246,14 @@ func (k Keeper) DeleteOrder(ctx sdk.Context, order types.Order) {
 	store.Delete(types.GetOrderKey(order.Id))
 }
 
+// SetOrderBookOrderIndex adds the order to the order book and to the order
+// book's price level index.
 func (k Keeper) SetOrderBookOrderIndex(ctx sdk.Context, order types.Order) {
 	store := ctx.KVStore(k.storeKey)
 	store.Set(
 		types.GetOrderBookOrderIndexKey(order.MarketId, order.IsBuy, order.Price, order.Id),
 		sdk.Uint64ToBigEndian(order.Id))
+	k.updatePriceLevel(ctx, order.MarketId, order.IsBuy, order.Price, order.VisibleQuantity(), 1)
 }
 
 func (k Keeper) LookupOrderBookOrderIndex(ctx sdk.Context, marketId uint64, isBuy bool, price sdk.Dec, orderId uint64) (found bool) {
@@ -327,10 +360,103 @@ func (k Keeper) IterateOrderBookSide(
 	}
 }
 
+// DeleteOrderBookOrderIndex removes the order from the order book and from the
+// order book's price level index.
 func (k Keeper) DeleteOrderBookOrderIndex(ctx sdk.Context, order types.Order) {
 	store := ctx.KVStore(k.storeKey)
 	store.Delete(
 		types.GetOrderBookOrderIndexKey(order.MarketId, order.IsBuy, order.Price, order.Id))
+	k.updatePriceLevel(ctx, order.MarketId, order.IsBuy, order.Price, order.VisibleQuantity().Neg(), -1)
+}
+
+func (k Keeper) GetPriceLevel(ctx sdk.Context, marketId uint64, isBuy bool, price sdk.Dec) (level types.PriceLevel, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetPriceLevelKey(marketId, isBuy, price))
+	if bz == nil {
+		return
+	}
+	k.cdc.MustUnmarshal(bz, &level)
+	return level, true
+}
+
+func (k Keeper) SetPriceLevel(ctx sdk.Context, marketId uint64, isBuy bool, price sdk.Dec, level types.PriceLevel) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&level)
+	store.Set(types.GetPriceLevelKey(marketId, isBuy, price), bz)
+}
+
+func (k Keeper) DeletePriceLevel(ctx sdk.Context, marketId uint64, isBuy bool, price sdk.Dec) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetPriceLevelKey(marketId, isBuy, price))
+}
+
+// IteratePriceLevels iterates through the price levels of an order book side
+// from the best price.
+// If priceLimit is not nil, the iteration stops at the price limit(inclusive).
+func (k Keeper) IteratePriceLevels(
+	ctx sdk.Context, marketId uint64, isBuy bool, priceLimit *sdk.Dec,
+	cb func(price sdk.Dec, level types.PriceLevel) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	var iter sdk.Iterator
+	if isBuy {
+		if priceLimit == nil {
+			iter = sdk.KVStoreReversePrefixIterator(
+				store, types.GetPriceLevelsBySideIteratorPrefix(marketId, true))
+		} else {
+			iter = store.ReverseIterator(
+				types.GetPriceLevelKey(marketId, true, *priceLimit),
+				sdk.PrefixEndBytes(types.GetPriceLevelsBySideIteratorPrefix(marketId, true)))
+		}
+	} else {
+		if priceLimit == nil {
+			iter = sdk.KVStorePrefixIterator(
+				store, types.GetPriceLevelsBySideIteratorPrefix(marketId, false))
+		} else {
+			iter = store.Iterator(
+				types.GetPriceLevelsBySideIteratorPrefix(marketId, false),
+				sdk.PrefixEndBytes(types.GetPriceLevelKey(marketId, false, *priceLimit)))
+		}
+	}
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		_, _, price := types.ParsePriceLevelKey(iter.Key())
+		var level types.PriceLevel
+		k.cdc.MustUnmarshal(iter.Value(), &level)
+		if cb(price, level) {
+			break
+		}
+	}
+}
+
+func (k Keeper) IterateAllPriceLevels(
+	ctx sdk.Context, cb func(marketId uint64, isBuy bool, price sdk.Dec, level types.PriceLevel) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.PriceLevelKeyPrefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		marketId, isBuy, price := types.ParsePriceLevelKey(iter.Key())
+		var level types.PriceLevel
+		k.cdc.MustUnmarshal(iter.Value(), &level)
+		if cb(marketId, isBuy, price, level) {
+			break
+		}
+	}
+}
+
+// updatePriceLevel adds qtyDelta and numOrdersDelta to the price level and
+// deletes the price level when there's no order left at the price.
+func (k Keeper) updatePriceLevel(ctx sdk.Context, marketId uint64, isBuy bool, price, qtyDelta sdk.Dec, numOrdersDelta int64) {
+	level, found := k.GetPriceLevel(ctx, marketId, isBuy, price)
+	if !found {
+		level.TotalOpenQuantity = utils.ZeroDec
+	}
+	level.TotalOpenQuantity = level.TotalOpenQuantity.Add(qtyDelta)
+	level.NumOrders = uint64(int64(level.NumOrders) + numOrdersDelta)
+	if level.NumOrders == 0 {
+		k.DeletePriceLevel(ctx, marketId, isBuy, price)
+		return
+	}
+	k.SetPriceLevel(ctx, marketId, isBuy, price, level)
 }
 
 func (k Keeper) SetOrdersByOrdererIndex(ctx sdk.Context, order types.Order) {
@@ -376,3 +502,341 @@ func (k Keeper) DeleteNumMMOrders(ctx sdk.Context, ordererAddr sdk.AccAddress, m
 	store := ctx.KVStore(k.storeKey)
 	store.Delete(types.GetNumMMOrdersKey(ordererAddr, marketId))
 }
+
+func (k Keeper) GetTriggerOrder(ctx sdk.Context, orderId uint64) (order types.TriggerOrder, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetTriggerOrderKey(orderId))
+	if bz == nil {
+		return
+	}
+	k.cdc.MustUnmarshal(bz, &order)
+	return order, true
+}
+
+func (k Keeper) MustGetTriggerOrder(ctx sdk.Context, orderId uint64) (order types.TriggerOrder) {
+	order, found := k.GetTriggerOrder(ctx, orderId)
+	if !found {
+		panic("trigger order not found")
+	}
+	return order
+}
+
+func (k Keeper) LookupTriggerOrder(ctx sdk.Context, orderId uint64) (found bool) {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.GetTriggerOrderKey(orderId))
+}
+
+func (k Keeper) SetTriggerOrder(ctx sdk.Context, order types.TriggerOrder) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&order)
+	store.Set(types.GetTriggerOrderKey(order.Id), bz)
+}
+
+func (k Keeper) IterateAllTriggerOrders(ctx sdk.Context, cb func(order types.TriggerOrder) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.TriggerOrderKeyPrefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var order types.TriggerOrder
+		k.cdc.MustUnmarshal(iter.Value(), &order)
+		if cb(order) {
+			break
+		}
+	}
+}
+
+func (k Keeper) DeleteTriggerOrder(ctx sdk.Context, order types.TriggerOrder) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetTriggerOrderKey(order.Id))
+}
+
+func (k Keeper) SetTriggerOrderIndex(ctx sdk.Context, order types.TriggerOrder) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(
+		types.GetTriggerOrderIndexKey(order.MarketId, order.Condition, order.TriggerPrice, order.Id),
+		sdk.Uint64ToBigEndian(order.Id))
+}
+
+func (k Keeper) DeleteTriggerOrderIndex(ctx sdk.Context, order types.TriggerOrder) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(
+		types.GetTriggerOrderIndexKey(order.MarketId, order.Condition, order.TriggerPrice, order.Id))
+}
+
+// IterateTriggeredOrders iterates through all trigger orders in the market
+// whose trigger condition is met at the given last price.
+func (k Keeper) IterateTriggeredOrders(
+	ctx sdk.Context, marketId uint64, lastPrice sdk.Dec, cb func(order types.TriggerOrder) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	for _, condition := range []types.TriggerCondition{
+		types.TriggerConditionPriceAbove, types.TriggerConditionPriceBelow,
+	} {
+		var iter sdk.Iterator
+		if condition == types.TriggerConditionPriceAbove {
+			// Trigger price <= last price
+			iter = store.Iterator(
+				types.GetTriggerOrdersByConditionIteratorPrefix(marketId, condition),
+				sdk.PrefixEndBytes(
+					types.GetTriggerOrdersByTriggerPriceIteratorPrefix(marketId, condition, lastPrice)))
+		} else {
+			// Trigger price >= last price
+			iter = store.Iterator(
+				types.GetTriggerOrdersByTriggerPriceIteratorPrefix(marketId, condition, lastPrice),
+				sdk.PrefixEndBytes(
+					types.GetTriggerOrdersByConditionIteratorPrefix(marketId, condition)))
+		}
+		stop := false
+		for ; iter.Valid(); iter.Next() {
+			orderId := types.ParseOrderIdFromTriggerOrderIndexKey(iter.Key())
+			if cb(k.MustGetTriggerOrder(ctx, orderId)) {
+				stop = true
+				break
+			}
+		}
+		iter.Close()
+		if stop {
+			break
+		}
+	}
+}
+
+func (k Keeper) IterateTriggerOrdersByMarket(ctx sdk.Context, marketId uint64, cb func(order types.TriggerOrder) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.GetTriggerOrdersByMarketIteratorPrefix(marketId))
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		orderId := types.ParseOrderIdFromTriggerOrderIndexKey(iter.Key())
+		order := k.MustGetTriggerOrder(ctx, orderId)
+		if cb(order) {
+			break
+		}
+	}
+}
+
+func (k Keeper) SetTriggerOrdersByOrdererIndex(ctx sdk.Context, order types.TriggerOrder) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(
+		types.GetTriggerOrdersByOrdererIndexKey(order.MustGetOrdererAddress(), order.MarketId, order.Id), []byte{})
+}
+
+func (k Keeper) DeleteTriggerOrdersByOrdererIndex(ctx sdk.Context, order types.TriggerOrder) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(
+		types.GetTriggerOrdersByOrdererIndexKey(order.MustGetOrdererAddress(), order.MarketId, order.Id))
+}
+
+func (k Keeper) SetTriggerOrdersByDeadlineIndex(ctx sdk.Context, order types.TriggerOrder) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetTriggerOrdersByDeadlineIndexKey(order.Deadline, order.Id), []byte{})
+}
+
+func (k Keeper) DeleteTriggerOrdersByDeadlineIndex(ctx sdk.Context, order types.TriggerOrder) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetTriggerOrdersByDeadlineIndexKey(order.Deadline, order.Id))
+}
+
+// IterateExpiredTriggerOrders iterates through trigger orders whose deadline
+// is not after blockTime, in the order of their deadlines.
+func (k Keeper) IterateExpiredTriggerOrders(
+	ctx sdk.Context, blockTime time.Time, cb func(order types.TriggerOrder) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := store.Iterator(
+		types.TriggerOrdersByDeadlineIndexKeyPrefix, types.GetExpiredTriggerOrdersIteratorEndBytes(blockTime))
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		orderId := types.ParseOrderIdFromTriggerOrdersByDeadlineIndexKey(iter.Key())
+		if cb(k.MustGetTriggerOrder(ctx, orderId)) {
+			break
+		}
+	}
+}
+
+func (k Keeper) GetPriceObservation(ctx sdk.Context, marketId uint64, index uint32) (obs types.PriceObservation, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetPriceObservationKey(marketId, index))
+	if bz == nil {
+		return
+	}
+	k.cdc.MustUnmarshal(bz, &obs)
+	return obs, true
+}
+
+func (k Keeper) MustGetPriceObservation(ctx sdk.Context, marketId uint64, index uint32) (obs types.PriceObservation) {
+	obs, found := k.GetPriceObservation(ctx, marketId, index)
+	if !found {
+		panic("price observation not found")
+	}
+	return obs
+}
+
+func (k Keeper) SetPriceObservation(ctx sdk.Context, marketId uint64, index uint32, obs types.PriceObservation) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&obs)
+	store.Set(types.GetPriceObservationKey(marketId, index), bz)
+}
+
+// IteratePriceObservationsByMarket iterates through the market's price
+// observations ordered by their ring indexes.
+func (k Keeper) IteratePriceObservationsByMarket(ctx sdk.Context, marketId uint64, cb func(obs types.PriceObservation) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.GetPriceObservationsByMarketIteratorPrefix(marketId))
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var obs types.PriceObservation
+		k.cdc.MustUnmarshal(iter.Value(), &obs)
+		if cb(obs) {
+			break
+		}
+	}
+}
+
+func (k Keeper) GetAccountVolume(ctx sdk.Context, addr sdk.AccAddress, day uint64) (volume sdk.Dec, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetAccountVolumeKey(addr, day))
+	if bz == nil {
+		return
+	}
+	var dp sdk.DecProto
+	k.cdc.MustUnmarshal(bz, &dp)
+	return dp.Dec, true
+}
+
+func (k Keeper) SetAccountVolume(ctx sdk.Context, addr sdk.AccAddress, day uint64, volume sdk.Dec) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&sdk.DecProto{Dec: volume})
+	store.Set(types.GetAccountVolumeKey(addr, day), bz)
+}
+
+func (k Keeper) DeleteAccountVolume(ctx sdk.Context, addr sdk.AccAddress, day uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetAccountVolumeKey(addr, day))
+}
+
+// IterateAccountVolumesByAccount iterates through the account's daily volumes
+// ordered by their days.
+func (k Keeper) IterateAccountVolumesByAccount(ctx sdk.Context, addr sdk.AccAddress, cb func(day uint64, volume sdk.Dec) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.GetAccountVolumesByAccountIteratorPrefix(addr))
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		_, day := types.ParseAccountVolumeKey(iter.Key())
+		var dp sdk.DecProto
+		k.cdc.MustUnmarshal(iter.Value(), &dp)
+		if cb(day, dp.Dec) {
+			break
+		}
+	}
+}
+
+func (k Keeper) IterateAllAccountVolumes(ctx sdk.Context, cb func(addr sdk.AccAddress, day uint64, volume sdk.Dec) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.AccountVolumeKeyPrefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		addr, day := types.ParseAccountVolumeKey(iter.Key())
+		var dp sdk.DecProto
+		k.cdc.MustUnmarshal(iter.Value(), &dp)
+		if cb(addr, day, dp.Dec) {
+			break
+		}
+	}
+}
+
+func (k Keeper) GetCancelAfterTime(ctx sdk.Context, ordererAddr sdk.AccAddress, marketId uint64) (cancelAfter time.Time, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetCancelAfterKey(ordererAddr, marketId))
+	if bz == nil {
+		return
+	}
+	cancelAfter, err := sdk.ParseTimeBytes(bz)
+	if err != nil {
+		panic(err)
+	}
+	return cancelAfter, true
+}
+
+func (k Keeper) SetCancelAfterTime(ctx sdk.Context, ordererAddr sdk.AccAddress, marketId uint64, cancelAfter time.Time) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetCancelAfterKey(ordererAddr, marketId), sdk.FormatTimeBytes(cancelAfter))
+}
+
+func (k Keeper) DeleteCancelAfterTime(ctx sdk.Context, ordererAddr sdk.AccAddress, marketId uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetCancelAfterKey(ordererAddr, marketId))
+}
+
+func (k Keeper) IterateCancelAftersByOrderer(ctx sdk.Context, ordererAddr sdk.AccAddress, cb func(marketId uint64, cancelAfter time.Time) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.GetCancelAftersByOrdererIteratorPrefix(ordererAddr))
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		_, marketId := types.ParseCancelAfterKey(iter.Key())
+		cancelAfter, err := sdk.ParseTimeBytes(iter.Value())
+		if err != nil {
+			panic(err)
+		}
+		if cb(marketId, cancelAfter) {
+			break
+		}
+	}
+}
+
+func (k Keeper) IterateAllCancelAfters(ctx sdk.Context, cb func(ordererAddr sdk.AccAddress, marketId uint64, cancelAfter time.Time) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.CancelAfterKeyPrefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		ordererAddr, marketId := types.ParseCancelAfterKey(iter.Key())
+		cancelAfter, err := sdk.ParseTimeBytes(iter.Value())
+		if err != nil {
+			panic(err)
+		}
+		if cb(ordererAddr, marketId, cancelAfter) {
+			break
+		}
+	}
+}
+
+func (k Keeper) GetReferrerStats(ctx sdk.Context, referrerAddr sdk.AccAddress, marketId uint64) (stats types.ReferrerStats, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetReferrerStatsKey(referrerAddr, marketId))
+	if bz == nil {
+		return
+	}
+	k.cdc.MustUnmarshal(bz, &stats)
+	return stats, true
+}
+
+func (k Keeper) SetReferrerStats(ctx sdk.Context, referrerAddr sdk.AccAddress, marketId uint64, stats types.ReferrerStats) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&stats)
+	store.Set(types.GetReferrerStatsKey(referrerAddr, marketId), bz)
+}
+
+func (k Keeper) IterateReferrerStatsByReferrer(ctx sdk.Context, referrerAddr sdk.AccAddress, cb func(marketId uint64, stats types.ReferrerStats) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.GetReferrerStatsByReferrerIteratorPrefix(referrerAddr))
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		_, marketId := types.ParseReferrerStatsKey(iter.Key())
+		var stats types.ReferrerStats
+		k.cdc.MustUnmarshal(iter.Value(), &stats)
+		if cb(marketId, stats) {
+			break
+		}
+	}
+}
+
+func (k Keeper) IterateAllReferrerStats(ctx sdk.Context, cb func(referrerAddr sdk.AccAddress, marketId uint64, stats types.ReferrerStats) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.ReferrerStatsKeyPrefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		referrerAddr, marketId := types.ParseReferrerStatsKey(iter.Key())
+		var stats types.ReferrerStats
+		k.cdc.MustUnmarshal(iter.Value(), &stats)
+		if cb(referrerAddr, marketId, stats) {
+			break
+		}
+	}
+}
@@ -192,3 +192,71 @@ func (s *KeeperTestSuite) TestIterateOrderBookSide() {
 		})
 	}
 }
+
+func (s *KeeperTestSuite) TestIteratePriceLevels() {
+	market := s.CreateMarket("ucre", "uusd")
+	ordererAddr1 := s.FundedAccount(1, enoughCoins)
+	ordererAddr2 := s.FundedAccount(2, enoughCoins)
+	s.PlaceLimitOrder(
+		market.Id, ordererAddr1, true, utils.ParseDec("1.2"), sdk.NewDec(10000), time.Hour)
+	s.PlaceLimitOrder(
+		market.Id, ordererAddr1, true, utils.ParseDec("1.2"), sdk.NewDec(20000), time.Hour)
+	_, order, _ := s.PlaceLimitOrder(
+		market.Id, ordererAddr1, true, utils.ParseDec("1.1"), sdk.NewDec(10000), time.Hour)
+	s.PlaceLimitOrder(
+		market.Id, ordererAddr1, true, utils.ParseDec("1.0"), sdk.NewDec(10000), time.Hour)
+	s.PlaceLimitOrder(
+		market.Id, ordererAddr1, false, utils.ParseDec("1.3"), sdk.NewDec(10000), time.Hour)
+	s.PlaceLimitOrder(
+		market.Id, ordererAddr1, false, utils.ParseDec("1.4"), sdk.NewDec(10000), time.Hour)
+
+	type priceLevel struct {
+		price     sdk.Dec
+		qty       sdk.Dec
+		numOrders uint64
+	}
+	assertLevels := func(isBuy bool, priceLimit *sdk.Dec, expected []priceLevel) {
+		var levels []priceLevel
+		s.keeper.IteratePriceLevels(
+			s.Ctx, market.Id, isBuy, priceLimit, func(price sdk.Dec, level types.PriceLevel) (stop bool) {
+				levels = append(levels, priceLevel{price, level.TotalOpenQuantity, level.NumOrders})
+				return false
+			})
+		s.Require().Len(levels, len(expected))
+		for i, level := range expected {
+			s.AssertEqual(level.price, levels[i].price)
+			s.AssertEqual(level.qty, levels[i].qty)
+			s.Assert().Equal(level.numOrders, levels[i].numOrders)
+		}
+	}
+
+	assertLevels(true, nil, []priceLevel{
+		{utils.ParseDec("1.2"), sdk.NewDec(30000), 2},
+		{utils.ParseDec("1.1"), sdk.NewDec(10000), 1},
+		{utils.ParseDec("1.0"), sdk.NewDec(10000), 1},
+	})
+	assertLevels(true, utils.ParseDecP("1.1"), []priceLevel{
+		{utils.ParseDec("1.2"), sdk.NewDec(30000), 2},
+		{utils.ParseDec("1.1"), sdk.NewDec(10000), 1},
+	})
+	assertLevels(false, utils.ParseDecP("1.3"), []priceLevel{
+		{utils.ParseDec("1.3"), sdk.NewDec(10000), 1},
+	})
+
+	// Partially fill the best buy price level.
+	s.PlaceLimitOrder(
+		market.Id, ordererAddr2, false, utils.ParseDec("1.2"), sdk.NewDec(15000), time.Hour)
+	assertLevels(true, utils.ParseDecP("1.2"), []priceLevel{
+		{utils.ParseDec("1.2"), sdk.NewDec(15000), 2},
+	})
+
+	// Cancelling the only order at a price removes the price level.
+	s.NextBlock()
+	s.CancelOrder(ordererAddr1, order.Id)
+	assertLevels(true, nil, []priceLevel{
+		{utils.ParseDec("1.2"), sdk.NewDec(15000), 2},
+		{utils.ParseDec("1.0"), sdk.NewDec(10000), 1},
+	})
+	_, found := s.keeper.GetPriceLevel(s.Ctx, market.Id, true, utils.ParseDec("1.1"))
+	s.Require().False(found)
+}
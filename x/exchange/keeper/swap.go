@@ -1,18 +1,112 @@
 package keeper
 
 import (
+	"errors"
+	"sort"
+
 	"golang.org/x/exp/maps"
 	"golang.org/x/exp/slices"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
+	utils "github.com/crescent-network/crescent/v5/types"
 	"github.com/crescent-network/crescent/v5/x/exchange/types"
 )
 
+// SwapExactAmountIn swaps the input through the routes. referrerAddr, if not
+// empty, receives a share of the taker fees paid in each market.
 func (k Keeper) SwapExactAmountIn(
 	ctx sdk.Context, ordererAddr sdk.AccAddress,
-	routes []uint64, input, minOutput sdk.DecCoin, simulate bool) (output sdk.DecCoin, results []types.SwapRouteResult, err error) {
+	routes []uint64, input, minOutput sdk.DecCoin, referrerAddr sdk.AccAddress, simulate bool) (output sdk.DecCoin, results []types.SwapRouteResult, err error) {
+	output, results, err = k.swapExactAmountIn(ctx, ordererAddr, routes, input, referrerAddr, simulate)
+	if err != nil {
+		return output, nil, err
+	}
+	if output.Denom != minOutput.Denom {
+		return output, nil, sdkerrors.Wrapf(
+			sdkerrors.ErrInvalidRequest, "output denom %s != min output denom %s", output.Denom, minOutput.Denom)
+	}
+	if output.Amount.LT(minOutput.Amount) {
+		return output, nil, sdkerrors.Wrapf(
+			types.ErrSwapNotEnoughOutput, "output %s < min output %s", output, minOutput)
+	}
+	if err = ctx.EventManager().EmitTypedEvent(&types.EventSwapExactAmountIn{
+		Orderer:  ordererAddr.String(),
+		Routes:   routes,
+		Input:    input,
+		Output:   output,
+		Results:  results,
+		Referrer: referrerAddr.String(),
+	}); err != nil {
+		return output, nil, err
+	}
+	if !simulate {
+		if err = k.executeTriggerOrders(ctx, routes...); err != nil {
+			return output, nil, err
+		}
+	}
+	return output, results, nil
+}
+
+// SwapExactAmountInWeightedRoutes splits the input across the weighted routes
+// and swaps through all of them atomically.
+// The sum of all routes' outputs is checked against minOutput.
+func (k Keeper) SwapExactAmountInWeightedRoutes(
+	ctx sdk.Context, ordererAddr sdk.AccAddress,
+	weightedRoutes []types.WeightedSwapRoute, input, minOutput sdk.DecCoin, referrerAddr sdk.AccAddress,
+	simulate bool) (output sdk.DecCoin, results []types.WeightedSwapRouteResult, err error) {
+	if len(weightedRoutes) == 0 {
+		return output, nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "weighted routes must not be empty")
+	}
+	output = sdk.NewDecCoinFromDec(minOutput.Denom, utils.ZeroDec)
+	inputs := types.SplitSwapInput(input, weightedRoutes)
+	for i, weightedRoute := range weightedRoutes {
+		routeOutput, routeResults, err := k.swapExactAmountIn(ctx, ordererAddr, weightedRoute.Routes, inputs[i], referrerAddr, simulate)
+		if err != nil {
+			return output, nil, err
+		}
+		if routeOutput.Denom != minOutput.Denom {
+			return output, nil, sdkerrors.Wrapf(
+				sdkerrors.ErrInvalidRequest, "output denom %s != min output denom %s", routeOutput.Denom, minOutput.Denom)
+		}
+		output = output.Add(routeOutput)
+		results = append(results, types.WeightedSwapRouteResult{
+			Routes:  weightedRoute.Routes,
+			Input:   inputs[i],
+			Output:  routeOutput,
+			Results: routeResults,
+		})
+	}
+	if output.Amount.LT(minOutput.Amount) {
+		return output, nil, sdkerrors.Wrapf(
+			types.ErrSwapNotEnoughOutput, "output %s < min output %s", output, minOutput)
+	}
+	if err = ctx.EventManager().EmitTypedEvent(&types.EventSwapExactAmountIn{
+		Orderer:         ordererAddr.String(),
+		Input:           input,
+		Output:          output,
+		WeightedResults: results,
+		Referrer:        referrerAddr.String(),
+	}); err != nil {
+		return output, nil, err
+	}
+	if !simulate {
+		var marketIds []uint64
+		for _, weightedRoute := range weightedRoutes {
+			marketIds = append(marketIds, weightedRoute.Routes...)
+		}
+		if err = k.executeTriggerOrders(ctx, marketIds...); err != nil {
+			return output, nil, err
+		}
+	}
+	return output, results, nil
+}
+
+func (k Keeper) swapExactAmountIn(
+	ctx sdk.Context, ordererAddr sdk.AccAddress,
+	routes []uint64, input sdk.DecCoin, referrerAddr sdk.AccAddress,
+	simulate bool) (output sdk.DecCoin, results []types.SwapRouteResult, err error) {
 	if maxRoutesLen := int(k.GetMaxSwapRoutesLen(ctx)); len(routes) > maxRoutesLen {
 		return output, nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "routes length exceeded the limit %d", maxRoutesLen)
 	}
@@ -34,6 +128,12 @@ func (k Keeper) SwapExactAmountIn(
 		if !found {
 			return output, nil, sdkerrors.Wrapf(sdkerrors.ErrNotFound, "market %d not found", marketId)
 		}
+		if !market.IsActive() {
+			return output, nil, sdkerrors.Wrapf(types.ErrMarketNotActive, "market %d is %s", marketId, market.Status)
+		}
+		if _, err = k.validateCircuitBreaker(ctx, market, false); err != nil {
+			return output, nil, err
+		}
 		marketState := k.MustGetMarketState(ctx, marketId)
 		if marketState.LastPrice == nil {
 			return output, nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "market %d has no last price", marketId)
@@ -58,7 +158,7 @@ func (k Keeper) SwapExactAmountIn(
 				sdkerrors.ErrInvalidRequest, "denom %s not in market %d", currentIn.Denom, market.Id)
 		}
 		res, err := k.executeOrder(
-			ctx, market, ordererAddr, types.MemOrderBookSideOptions{
+			ctx, market, ordererAddr, 0, types.SelfTradePreventionUnspecified, referrerAddr, types.MemOrderBookSideOptions{
 				IsBuy:         !isBuy,
 				PriceLimit:    &priceLimit,
 				QuantityLimit: qtyLimit,
@@ -83,77 +183,429 @@ func (k Keeper) SwapExactAmountIn(
 		})
 		currentIn = output
 	}
-	if output.Denom != minOutput.Denom {
-		return output, nil, sdkerrors.Wrapf(
-			sdkerrors.ErrInvalidRequest, "output denom %s != min output denom %s", output.Denom, minOutput.Denom)
+	return output, results, nil
+}
+
+func (k Keeper) SwapExactAmountOut(
+	ctx sdk.Context, ordererAddr sdk.AccAddress,
+	routes []uint64, output, maxInput sdk.DecCoin, simulate bool) (input sdk.DecCoin, results []types.SwapRouteResult, err error) {
+	input, results, err = k.swapExactAmountOut(ctx, ordererAddr, routes, output, simulate)
+	if err != nil {
+		return input, nil, err
 	}
-	if output.Amount.LT(minOutput.Amount) {
-		return output, nil, sdkerrors.Wrapf(
-			types.ErrSwapNotEnoughOutput, "output %s < min output %s", output, minOutput)
+	if input.Denom != maxInput.Denom {
+		return input, nil, sdkerrors.Wrapf(
+			sdkerrors.ErrInvalidRequest, "input denom %s != max input denom %s", input.Denom, maxInput.Denom)
 	}
-	if err = ctx.EventManager().EmitTypedEvent(&types.EventSwapExactAmountIn{
+	if input.Amount.GT(maxInput.Amount) {
+		return input, nil, sdkerrors.Wrapf(
+			types.ErrSwapNotEnoughInput, "input %s > max input %s", input, maxInput)
+	}
+	if len(results) > 0 { // the actual output may slightly exceed the requested output
+		output = results[len(results)-1].Output
+	}
+	if err = ctx.EventManager().EmitTypedEvent(&types.EventSwapExactAmountOut{
 		Orderer: ordererAddr.String(),
 		Routes:  routes,
 		Input:   input,
 		Output:  output,
 		Results: results,
 	}); err != nil {
-		return output, nil, err
+		return input, nil, err
 	}
-	return output, results, nil
+	if !simulate {
+		if err = k.executeTriggerOrders(ctx, routes...); err != nil {
+			return input, nil, err
+		}
+	}
+	return input, results, nil
 }
 
-func (k Keeper) FindAllRoutes(ctx sdk.Context, fromDenom, toDenom string, maxRoutesLen int) (allRoutes [][]uint64) {
-	// TODO: cache all routes on-chain?
-	denomMap := map[string]map[string][]uint64{}
-	store := ctx.KVStore(k.storeKey)
-	iter := sdk.KVStorePrefixIterator(store, types.MarketByDenomsIndexKeyPrefix)
-	defer iter.Close()
-	for ; iter.Valid(); iter.Next() {
-		baseDenom, quoteDenom := types.ParseMarketByDenomsIndexKey(iter.Key())
-		marketId := sdk.BigEndianToUint64(iter.Value())
+// swapExactAmountOut walks the routes backwards to find out the amount each
+// hop has to execute to receive the output required by the next hop, and then
+// executes the hops from the first to the last if simulate is false.
+func (k Keeper) swapExactAmountOut(
+	ctx sdk.Context, ordererAddr sdk.AccAddress,
+	routes []uint64, output sdk.DecCoin, simulate bool) (input sdk.DecCoin, results []types.SwapRouteResult, err error) {
+	if len(routes) == 0 {
+		return input, nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "routes must not be empty")
+	}
+	if maxRoutesLen := int(k.GetMaxSwapRoutesLen(ctx)); len(routes) > maxRoutesLen {
+		return input, nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "routes length exceeded the limit %d", maxRoutesLen)
+	}
+	halveFees := len(routes) > 1
+	currentOut := output
+	maxPriceRatio := k.GetMaxOrderPriceRatio(ctx)
+	hopOpts := make([]types.MemOrderBookSideOptions, len(routes))
+	results = make([]types.SwapRouteResult, len(routes))
+	for i := len(routes) - 1; i >= 0; i-- {
+		marketId := routes[i]
+		market, found := k.GetMarket(ctx, marketId)
+		if !found {
+			return input, nil, sdkerrors.Wrapf(sdkerrors.ErrNotFound, "market %d not found", marketId)
+		}
+		if !market.IsActive() {
+			return input, nil, sdkerrors.Wrapf(types.ErrMarketNotActive, "market %d is %s", marketId, market.Status)
+		}
+		if _, err = k.validateCircuitBreaker(ctx, market, false); err != nil {
+			return input, nil, err
+		}
 		marketState := k.MustGetMarketState(ctx, marketId)
-		if marketState.LastPrice == nil { // Skip markets with no last price
-			continue
+		if marketState.LastPrice == nil {
+			return input, nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "market %d has no last price", marketId)
 		}
-		if _, ok := denomMap[baseDenom]; !ok {
-			denomMap[baseDenom] = map[string][]uint64{}
+		minPrice, maxPrice := types.OrderPriceLimit(*marketState.LastPrice, maxPriceRatio)
+		takerFeeRate := k.newMatchingContext(ctx, market, halveFees).TakerFeeRate(ordererAddr)
+		// The amount to be executed before the taker fee is deducted.
+		limit := currentOut.Amount.Quo(utils.OneDec.Sub(takerFeeRate)).Ceil()
+		var opts types.MemOrderBookSideOptions
+		switch currentOut.Denom {
+		case market.BaseDenom: // buy the base coin
+			opts = types.MemOrderBookSideOptions{
+				IsBuy:         false,
+				PriceLimit:    &maxPrice,
+				QuantityLimit: &limit,
+			}
+		case market.QuoteDenom: // sell the base coin
+			opts = types.MemOrderBookSideOptions{
+				IsBuy:      true,
+				PriceLimit: &minPrice,
+				QuoteLimit: &limit,
+			}
+		default:
+			return input, nil, sdkerrors.Wrapf(
+				sdkerrors.ErrInvalidRequest, "denom %s not in market %d", currentOut.Denom, market.Id)
+		}
+		res, err := k.executeOrder(ctx, market, ordererAddr, 0, types.SelfTradePreventionUnspecified, nil, opts, halveFees, true)
+		if err != nil {
+			return input, nil, err
+		}
+		if res.FullyExecuted && res.Received.Amount.LT(currentOut.Amount) {
+			// The execution stops when the remaining amount becomes less than 1,
+			// so the order could have been executed slightly less than the limit.
+			limit = limit.Add(utils.OneDec)
+			if res, err = k.executeOrder(ctx, market, ordererAddr, 0, types.SelfTradePreventionUnspecified, nil, opts, halveFees, true); err != nil {
+				return input, nil, err
+			}
+		}
+		if res.Received.Amount.LT(currentOut.Amount) {
+			return input, nil, sdkerrors.Wrapf(
+				types.ErrSwapNotEnoughLiquidity, "in market %d; received %s < output %s", marketId, res.Received, currentOut)
 		}
-		if _, ok := denomMap[quoteDenom]; !ok {
-			denomMap[quoteDenom] = map[string][]uint64{}
+		hopOpts[i] = opts
+		results[i] = types.SwapRouteResult{
+			MarketId:         marketId,
+			ExecutedQuantity: res.ExecutedQuantity,
+			Input:            res.Paid,
+			Output:           res.Received,
+			Fee:              res.Fee,
+		}
+		currentOut = res.Paid
+	}
+	input = currentOut
+	if simulate {
+		return input, results, nil
+	}
+
+	for i, marketId := range routes {
+		currentIn := results[i].Input
+		requiredOut := output
+		if i < len(routes)-1 {
+			requiredOut = results[i+1].Input
+		}
+		balances := k.bankKeeper.SpendableCoins(ctx, ordererAddr)
+		if balance := balances.AmountOf(currentIn.Denom).ToDec(); balance.LT(currentIn.Amount) {
+			return input, nil, sdkerrors.Wrapf(
+				sdkerrors.ErrInsufficientFunds, "%s%s < %s", balance, currentIn.Denom, currentIn)
+		}
+		market := k.MustGetMarket(ctx, marketId)
+		res, err := k.executeOrder(
+			ctx, market, ordererAddr, 0, types.SelfTradePreventionUnspecified, nil, hopOpts[i], halveFees, false)
+		if err != nil {
+			return input, nil, err
+		}
+		if res.Received.Amount.LT(requiredOut.Amount) {
+			return input, nil, sdkerrors.Wrapf(
+				types.ErrSwapNotEnoughLiquidity, "in market %d; received %s < output %s", marketId, res.Received, requiredOut)
+		}
+		results[i] = types.SwapRouteResult{
+			MarketId:         marketId,
+			ExecutedQuantity: res.ExecutedQuantity,
+			Input:            res.Paid,
+			Output:           res.Received,
+			Fee:              res.Fee,
+		}
+		if i == 0 {
+			input = res.Paid
+		}
+	}
+	return input, results, nil
+}
+
+// numSwapInputChunks is the number of chunks the input is divided into when
+// finding the best split of a swap.
+const numSwapInputChunks = 10
+
+// findBestWeightedRoutes distributes the input chunk by chunk to the route
+// giving the largest additional output, using at most maxSplits routes.
+// Routes sharing a market are never used together since their executions
+// would affect each other's output.
+// It returns nil if the input cannot be split.
+func (k Keeper) findBestWeightedRoutes(
+	ctx sdk.Context, allRoutes [][]uint64, input sdk.DecCoin, maxSplits int) (weightedRoutes []types.WeightedSwapRoute) {
+	chunk := input.Amount.QuoInt64(numSwapInputChunks).TruncateDec()
+	if !chunk.IsPositive() {
+		return nil
+	}
+	// The first chunk takes the remainder.
+	firstChunk := input.Amount.Sub(chunk.MulInt64(numSwapInputChunks - 1))
+	allocated := make([]sdk.Dec, len(allRoutes))
+	outputs := make([]sdk.Dec, len(allRoutes))
+	for i := range allRoutes {
+		allocated[i] = utils.ZeroDec
+		outputs[i] = utils.ZeroDec
+	}
+	usedMarkets := map[uint64]struct{}{}
+	numUsedRoutes := 0
+	for i := 0; i < numSwapInputChunks; i++ {
+		amt := chunk
+		if i == 0 {
+			amt = firstChunk
+		}
+		bestIdx := -1
+		var bestGain, bestOutput sdk.Dec
+	RoutesLoop:
+		for j, routes := range allRoutes {
+			if allocated[j].IsZero() {
+				if numUsedRoutes >= maxSplits {
+					continue
+				}
+				for _, marketId := range routes {
+					if _, ok := usedMarkets[marketId]; ok {
+						continue RoutesLoop
+					}
+				}
+			}
+			output, _, err := k.swapExactAmountIn(
+				ctx, sdk.AccAddress{}, routes, sdk.NewDecCoinFromDec(input.Denom, allocated[j].Add(amt)), nil, true)
+			if err != nil {
+				if !errors.Is(err, types.ErrSwapNotEnoughInput) && !errors.Is(err, types.ErrSwapNotEnoughLiquidity) &&
+					!errors.Is(err, types.ErrCircuitBreakerTripped) { // sanity check
+					panic(err)
+				}
+				continue
+			}
+			if gain := output.Amount.Sub(outputs[j]); bestIdx == -1 || gain.GT(bestGain) {
+				bestIdx = j
+				bestGain = gain
+				bestOutput = output.Amount
+			}
+		}
+		if bestIdx == -1 {
+			return nil
+		}
+		if allocated[bestIdx].IsZero() {
+			numUsedRoutes++
+			for _, marketId := range allRoutes[bestIdx] {
+				usedMarkets[marketId] = struct{}{}
+			}
 		}
-		denomMap[baseDenom][quoteDenom] = append(denomMap[baseDenom][quoteDenom], marketId)
-		denomMap[quoteDenom][baseDenom] = append(denomMap[quoteDenom][baseDenom], marketId)
+		allocated[bestIdx] = allocated[bestIdx].Add(amt)
+		outputs[bestIdx] = bestOutput
 	}
+	for i, routes := range allRoutes {
+		if allocated[i].IsPositive() {
+			weightedRoutes = append(weightedRoutes, types.NewWeightedSwapRoute(routes, allocated[i]))
+		}
+	}
+	return weightedRoutes
+}
+
+// FindAllRoutes returns swap routes from fromDenom to toDenom whose length is
+// at most maxRoutesLen, through active markets with a last price.
+// Shorter routes come first and at most types.MaxNumSwapRoutes routes are
+// returned.
+func (k Keeper) FindAllRoutes(ctx sdk.Context, fromDenom, toDenom string, maxRoutesLen int) (allRoutes [][]uint64) {
+	hasLastPrice := map[uint64]bool{}
+	neighbors := k.denomGraph(ctx)
+	// Skip markets with no last price.
+	activeNeighbors := func(denom string) map[string][]uint64 {
+		marketIdsByDenom := map[string][]uint64{}
+		for counterDenom, marketIds := range neighbors(denom) {
+			for _, marketId := range marketIds {
+				has, cached := hasLastPrice[marketId]
+				if !cached {
+					has = k.MustGetMarketState(ctx, marketId).LastPrice != nil
+					hasLastPrice[marketId] = has
+				}
+				if has {
+					marketIdsByDenom[counterDenom] = append(marketIdsByDenom[counterDenom], marketId)
+				}
+			}
+		}
+		return marketIdsByDenom
+	}
+	return findRoutes(activeNeighbors, fromDenom, toDenom, maxRoutesLen, types.MaxNumSwapRoutes)
+}
+
+// denomGraph returns a function which returns active markets grouped by the
+// counter denom for a denom.
+// The result is memoized for each denom.
+func (k Keeper) denomGraph(ctx sdk.Context) func(denom string) map[string][]uint64 {
+	graph := map[string]map[string][]uint64{}
+	return func(denom string) map[string][]uint64 {
+		marketIdsByDenom, ok := graph[denom]
+		if !ok {
+			marketIdsByDenom = map[string][]uint64{}
+			k.IterateActiveMarketsByDenom(ctx, denom, func(counterDenom string, marketId uint64) (stop bool) {
+				marketIdsByDenom[counterDenom] = append(marketIdsByDenom[counterDenom], marketId)
+				return false
+			})
+			graph[denom] = marketIdsByDenom
+		}
+		return marketIdsByDenom
+	}
+}
+
+// findRoutes finds swap routes from fromDenom to toDenom whose length is at
+// most maxRoutesLen, in ascending order of the route length.
+// The search stops after maxNumRoutes routes are found, so shorter routes are
+// never dropped in favor of longer ones.
+// A route never passes through its destination denom or the same market twice.
+func findRoutes(
+	neighbors func(denom string) map[string][]uint64,
+	fromDenom, toDenom string, maxRoutesLen, maxNumRoutes int) (allRoutes [][]uint64) {
 	var currentRoutes []uint64
 	visited := map[uint64]struct{}{}
-	var backtrack func(currentDenom string)
-	// TODO: prevent stack overflow?
-	backtrack = func(currentDenom string) {
-		denoms := maps.Keys(denomMap[currentDenom])
+	// backtrack finds routes of exactly routesLen markets.
+	var backtrack func(currentDenom string, routesLen int) (stop bool)
+	backtrack = func(currentDenom string, routesLen int) (stop bool) {
+		marketIdsByDenom := neighbors(currentDenom)
+		denoms := maps.Keys(marketIdsByDenom)
 		slices.Sort(denoms)
 		for _, denom := range denoms {
-			marketIds := denomMap[currentDenom][denom]
-			for _, marketId := range marketIds {
-				if _, ok := visited[marketId]; !ok {
-					if denom == toDenom {
-						routes := make([]uint64, len(currentRoutes), len(currentRoutes)+1)
-						copy(routes[:len(currentRoutes)], currentRoutes)
-						routes = append(routes, marketId)
-						allRoutes = append(allRoutes, routes)
-					} else {
-						visited[marketId] = struct{}{}
-						currentRoutes = append(currentRoutes, marketId)
-						if len(currentRoutes) < maxRoutesLen {
-							backtrack(denom)
-						}
-						currentRoutes = currentRoutes[:len(currentRoutes)-1]
-						delete(visited, marketId)
+			if (denom == toDenom) != (len(currentRoutes)+1 == routesLen) {
+				continue
+			}
+			for _, marketId := range marketIdsByDenom[denom] {
+				if _, ok := visited[marketId]; ok {
+					continue
+				}
+				if denom == toDenom {
+					routes := make([]uint64, len(currentRoutes), len(currentRoutes)+1)
+					copy(routes, currentRoutes)
+					allRoutes = append(allRoutes, append(routes, marketId))
+					if len(allRoutes) >= maxNumRoutes {
+						return true
+					}
+				} else {
+					visited[marketId] = struct{}{}
+					currentRoutes = append(currentRoutes, marketId)
+					stop = backtrack(denom, routesLen)
+					currentRoutes = currentRoutes[:len(currentRoutes)-1]
+					delete(visited, marketId)
+					if stop {
+						return true
 					}
 				}
 			}
 		}
+		return false
+	}
+	if maxNumRoutes > 0 {
+		for routesLen := 1; routesLen <= maxRoutesLen; routesLen++ {
+			if backtrack(fromDenom, routesLen) {
+				break
+			}
+		}
+	}
+	return allRoutes
+}
+
+// rankSwapRoutes sorts the routes by their liquidity in descending order.
+// The liquidity of a route is the smallest liquidity among the markets in the
+// route, measured in fromDenom using the markets' last prices.
+// The liquidity of a market is the quantity on the order book side which the
+// swap would be executed against, within the max order price ratio from the
+// market's last price.
+// rankSwapRoutes iterates through the order books of all markets in the routes,
+// so it must only be used in queries.
+func (k Keeper) rankSwapRoutes(ctx sdk.Context, fromDenom string, allRoutes [][]uint64) {
+	if len(allRoutes) < 2 {
+		return
+	}
+	maxPriceRatio := k.GetMaxOrderPriceRatio(ctx)
+	type orderBookSide struct {
+		marketId uint64
+		isBuy    bool
+	}
+	depths := map[orderBookSide]sdk.Dec{}
+	liquidities := make([]sdk.Dec, len(allRoutes))
+	for i, routes := range allRoutes {
+		currentDenom := fromDenom
+		// The amount of fromDenom per unit of currentDenom.
+		currentValue := utils.OneDec
+		var liquidity sdk.Dec
+		for _, marketId := range routes {
+			market := k.MustGetMarket(ctx, marketId)
+			lastPrice := *k.MustGetMarketState(ctx, marketId).LastPrice
+			isBuy := market.QuoteDenom == currentDenom
+			side := orderBookSide{marketId, !isBuy}
+			depth, ok := depths[side]
+			if !ok {
+				depth = k.orderBookDepth(ctx, market, lastPrice, maxPriceRatio, !isBuy)
+				depths[side] = depth
+			}
+			var marketLiquidity sdk.Dec
+			if isBuy {
+				marketLiquidity = depth.Mul(lastPrice).Mul(currentValue)
+				currentValue = currentValue.Mul(lastPrice)
+				currentDenom = market.BaseDenom
+			} else {
+				marketLiquidity = depth.Mul(currentValue)
+				currentValue = currentValue.Quo(lastPrice)
+				currentDenom = market.QuoteDenom
+			}
+			if liquidity.IsNil() || marketLiquidity.LT(liquidity) {
+				liquidity = marketLiquidity
+			}
+		}
+		liquidities[i] = liquidity
+	}
+	indices := make([]int, len(allRoutes))
+	for i := range indices {
+		indices[i] = i
+	}
+	sort.SliceStable(indices, func(i, j int) bool {
+		return liquidities[indices[i]].GT(liquidities[indices[j]])
+	})
+	sorted := make([][]uint64, len(allRoutes))
+	for i, idx := range indices {
+		sorted[i] = allRoutes[idx]
+	}
+	copy(allRoutes, sorted)
+}
+
+// orderBookDepth returns the total quantity on the order book side within the
+// max order price ratio from the last price.
+func (k Keeper) orderBookDepth(
+	ctx sdk.Context, market types.Market, lastPrice, maxPriceRatio sdk.Dec, isBuy bool) sdk.Dec {
+	minPrice, maxPrice := types.OrderPriceLimit(lastPrice, maxPriceRatio)
+	priceLimit := maxPrice
+	if isBuy {
+		priceLimit = minPrice
+	}
+	depth := utils.ZeroDec
+	k.IteratePriceLevels(ctx, market.Id, isBuy, &priceLimit, func(_ sdk.Dec, level types.PriceLevel) (stop bool) {
+		depth = depth.Add(level.TotalOpenQuantity)
+		return false
+	})
+	obs := k.constructOrderSourceMemOrderBookSide(ctx, market, types.MemOrderBookSideOptions{
+		IsBuy:      isBuy,
+		PriceLimit: &priceLimit,
+	})
+	for _, level := range obs.Levels() {
+		depth = depth.Add(types.TotalExecutableQuantity(level.Orders()))
 	}
-	backtrack(fromDenom)
-	return
+	return depth
 }
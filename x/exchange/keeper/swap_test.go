@@ -1,11 +1,14 @@
 package keeper_test
 
 import (
+	"fmt"
 	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	utils "github.com/crescent-network/crescent/v5/types"
+	"github.com/crescent-network/crescent/v5/x/exchange"
+	"github.com/crescent-network/crescent/v5/x/exchange/keeper"
 	"github.com/crescent-network/crescent/v5/x/exchange/types"
 )
 
@@ -42,12 +45,12 @@ func (s *KeeperTestSuite) TestSwapInsufficientLiquidity() {
 	cacheCtx, _ := s.Ctx.CacheContext()
 	_, _, err := s.keeper.SwapExactAmountIn(
 		cacheCtx, ordererAddr, []uint64{market1.Id, market2.Id},
-		utils.ParseDecCoin("600_000000uusd"), utils.ParseDecCoin("58_000000uatom"), false)
+		utils.ParseDecCoin("600_000000uusd"), utils.ParseDecCoin("58_000000uatom"), nil, false)
 	s.Require().ErrorIs(err, types.ErrSwapNotEnoughLiquidity)
 	cacheCtx, _ = s.Ctx.CacheContext()
 	_, _, err = s.keeper.SwapExactAmountIn(
 		cacheCtx, ordererAddr, []uint64{market1.Id, market2.Id},
-		utils.ParseDecCoin("300_000000uusd"), utils.ParseDecCoin("28_000000uatom"), false)
+		utils.ParseDecCoin("300_000000uusd"), utils.ParseDecCoin("28_000000uatom"), nil, false)
 	s.Require().NoError(err)
 }
 
@@ -83,14 +86,259 @@ func (s *KeeperTestSuite) TestSwapExactAmountIn_MaxOrderPriceRatio() {
 	cacheCtx, _ := s.Ctx.CacheContext()
 	_, _, err = s.keeper.SwapExactAmountIn(
 		cacheCtx, ordererAddr, []uint64{creFooMarket.Id, atomFooMarket.Id},
-		utils.ParseDecCoin("3_000000ucre"), utils.ParseDecCoin("0uatom"), false)
+		utils.ParseDecCoin("3_000000ucre"), utils.ParseDecCoin("0uatom"), nil, false)
 	s.Require().EqualError(err, "market 4 has no last price: invalid request")
 
 	cacheCtx, _ = s.Ctx.CacheContext()
 	_, _, err = s.keeper.SwapExactAmountIn(
 		cacheCtx, ordererAddr, []uint64{creUsdMarket.Id, atomUsdMarket.Id},
-		utils.ParseDecCoin("50_000000ucre"), utils.ParseDecCoin("0uatom"), false)
+		utils.ParseDecCoin("50_000000ucre"), utils.ParseDecCoin("0uatom"), nil, false)
 	// Since the price impact is limited to MaxOrderPriceRatio(10% by default),
 	// cannot sell CRE fully.
 	s.Require().EqualError(err, "in market 1; paid 30000000.000000000000000000ucre < input 50000000.000000000000000000ucre: not enough liquidity in the market")
 }
+
+func (s *KeeperTestSuite) TestSwapExactAmountOut() {
+	market1 := s.CreateMarket("ucre", "uusd")
+	market2 := s.CreateMarket("uatom", "ucre")
+
+	mmAddr := s.FundedAccount(1, enoughCoins)
+	s.MakeLastPrice(market1.Id, mmAddr, utils.ParseDec("5"))
+	s.MakeLastPrice(market2.Id, mmAddr, utils.ParseDec("2"))
+
+	for i := 0; i < 10; i++ {
+		price := types.PriceAtTick(types.TickAtPrice(utils.ParseDec("4.999")) - int32(i*10))
+		s.PlaceLimitOrder(market1.Id, mmAddr, true, price, sdk.NewDec(10_000000), time.Minute)
+		price = types.PriceAtTick(types.TickAtPrice(utils.ParseDec("5.001")) + int32(i*10))
+		s.PlaceLimitOrder(market1.Id, mmAddr, false, price, sdk.NewDec(10_000000), time.Minute)
+		price = types.PriceAtTick(types.TickAtPrice(utils.ParseDec("1.999")) - int32(i*10))
+		s.PlaceLimitOrder(market2.Id, mmAddr, true, price, sdk.NewDec(5_000000), time.Minute)
+		price = types.PriceAtTick(types.TickAtPrice(utils.ParseDec("2.001")) + int32(i*10))
+		s.PlaceLimitOrder(market2.Id, mmAddr, false, price, sdk.NewDec(5_000000), time.Minute)
+	}
+
+	ordererAddr := s.FundedAccount(2, enoughCoins)
+	routes := []uint64{market1.Id, market2.Id}
+
+	// Simulation doesn't change the state.
+	balancesBefore := s.GetAllBalances(ordererAddr)
+	simInput, simResults := s.SwapExactAmountOut(
+		ordererAddr, routes, utils.ParseDecCoin("28_000000uatom"), utils.ParseDecCoin("300_000000uusd"), true)
+	s.Require().Equal(balancesBefore, s.GetAllBalances(ordererAddr))
+	s.AssertEqual(utils.ParseDecCoin("281496441uusd"), simInput)
+	s.Require().Len(simResults, 2)
+
+	input, results := s.SwapExactAmountOut(
+		ordererAddr, routes, utils.ParseDecCoin("28_000000uatom"), utils.ParseDecCoin("300_000000uusd"), false)
+	s.AssertEqual(simInput, input)
+	s.Require().Equal(simResults, results)
+	s.Require().EqualValues(market1.Id, results[0].MarketId)
+	s.AssertEqual(input, results[0].Input)
+	s.AssertEqual(results[0].Output, results[1].Input)
+	s.Require().EqualValues(market2.Id, results[1].MarketId)
+	s.AssertEqual(utils.ParseDecCoin("28_000000uatom"), results[1].Output)
+
+	balancesAfter := s.GetAllBalances(ordererAddr)
+	diff, _ := balancesAfter.SafeSub(balancesBefore)
+	s.Require().Equal("28000000uatom,-281496441uusd", diff.String())
+
+	// Exact amount out with the quote coin as the output.
+	balancesBefore = balancesAfter
+	input, _ = s.SwapExactAmountOut(
+		ordererAddr, []uint64{market1.Id}, utils.ParseDecCoin("10_000000uusd"), utils.ParseDecCoin("3_000000ucre"), false)
+	s.AssertEqual(utils.ParseDecCoin("2006420ucre"), input)
+	diff, _ = s.GetAllBalances(ordererAddr).SafeSub(balancesBefore)
+	s.Require().Equal("-2006420ucre,10000000uusd", diff.String())
+
+	cacheCtx, _ := s.Ctx.CacheContext()
+	_, _, err := s.keeper.SwapExactAmountOut(
+		cacheCtx, ordererAddr, routes, utils.ParseDecCoin("10_000000uatom"), utils.ParseDecCoin("100_000000uusd"), false)
+	s.Require().ErrorIs(err, types.ErrSwapNotEnoughInput)
+	cacheCtx, _ = s.Ctx.CacheContext()
+	_, _, err = s.keeper.SwapExactAmountOut(
+		cacheCtx, ordererAddr, routes, utils.ParseDecCoin("10_000000uatom"), utils.ParseDecCoin("300_000000ucre"), false)
+	s.Require().EqualError(err, "input denom uusd != max input denom ucre: invalid request")
+	cacheCtx, _ = s.Ctx.CacheContext()
+	_, _, err = s.keeper.SwapExactAmountOut(
+		cacheCtx, ordererAddr, routes, utils.ParseDecCoin("30_000000uatom"), utils.ParseDecCoin("1000_000000uusd"), false)
+	s.Require().ErrorIs(err, types.ErrSwapNotEnoughLiquidity)
+}
+
+func (s *KeeperTestSuite) TestSwapExactAmountInWeightedRoutes() {
+	atomCreMarket := s.CreateMarket("uatom", "ucre")
+	atomUsdMarket := s.CreateMarket("uatom", "uusd")
+	creUsdMarket := s.CreateMarket("ucre", "uusd")
+
+	mmAddr := s.FundedAccount(1, enoughCoins)
+	s.MakeLastPrice(atomCreMarket.Id, mmAddr, utils.ParseDec("2"))
+	s.MakeLastPrice(atomUsdMarket.Id, mmAddr, utils.ParseDec("10"))
+	s.MakeLastPrice(creUsdMarket.Id, mmAddr, utils.ParseDec("5"))
+	s.createLiquidity2(atomCreMarket.Id, mmAddr, utils.ParseDec("2"), utils.ParseDec("0.1"), sdk.NewDec(2_000000))
+	s.createLiquidity2(atomUsdMarket.Id, mmAddr, utils.ParseDec("10"), utils.ParseDec("0.1"), sdk.NewDec(2_000000))
+	s.createLiquidity2(creUsdMarket.Id, mmAddr, utils.ParseDec("5"), utils.ParseDec("0.1"), sdk.NewDec(4_000000))
+
+	input := utils.ParseDecCoin("20_000000uatom")
+	resp, err := s.querier.BestSwapExactAmountInRoutes(sdk.WrapSDKContext(s.Ctx), &types.QueryBestSwapExactAmountInRoutesRequest{
+		Input:       input.String(),
+		OutputDenom: "ucre",
+	})
+	s.Require().NoError(err)
+	singleOutput := resp.Output
+
+	resp, err = s.querier.BestSwapExactAmountInRoutes(sdk.WrapSDKContext(s.Ctx), &types.QueryBestSwapExactAmountInRoutesRequest{
+		Input:       input.String(),
+		OutputDenom: "ucre",
+		MaxSplits:   2,
+	})
+	s.Require().NoError(err)
+	s.AssertEqual(utils.ParseDecCoin("39235499ucre"), singleOutput)
+	// The input is split into the direct route and the route through USD.
+	// Routes are sorted by their liquidity.
+	s.AssertEqual(utils.ParseDecCoin("39432566ucre"), resp.Output)
+	s.Require().Empty(resp.Routes)
+	s.Require().Equal([]types.WeightedSwapRoute{
+		types.NewWeightedSwapRoute([]uint64{atomUsdMarket.Id, creUsdMarket.Id}, sdk.NewDec(12_000000)),
+		types.NewWeightedSwapRoute([]uint64{atomCreMarket.Id}, sdk.NewDec(8_000000)),
+	}, resp.WeightedRoutes)
+	s.Require().Len(resp.WeightedResults, 2)
+	s.AssertEqual(utils.ParseDecCoin("23679966ucre"), resp.WeightedResults[0].Output)
+	s.AssertEqual(utils.ParseDecCoin("15752600ucre"), resp.WeightedResults[1].Output)
+
+	ordererAddr := s.FundedAccount(2, enoughCoins)
+	balancesBefore := s.GetAllBalances(ordererAddr)
+	s.Ctx = s.Ctx.WithEventManager(sdk.NewEventManager())
+	msgServer := keeper.NewMsgServerImpl(s.keeper)
+	msgResp, err := msgServer.SwapExactAmountIn(sdk.WrapSDKContext(s.Ctx), types.NewMsgSwapExactAmountInWeightedRoutes(
+		ordererAddr, resp.WeightedRoutes, input, resp.Output))
+	s.Require().NoError(err)
+	s.AssertEqual(resp.Output, msgResp.Output)
+	s.Require().Equal(resp.WeightedResults, msgResp.WeightedResults)
+	diff, _ := s.GetAllBalances(ordererAddr).SafeSub(balancesBefore)
+	s.Require().Equal("-20000000uatom,39432566ucre", diff.String())
+	s.CheckEvent(&types.EventSwapExactAmountIn{}, map[string][]byte{
+		"output": []byte(`{"denom":"ucre","amount":"39432566.000000000000000000"}`),
+	})
+
+	// Only one min output check is made for the whole swap.
+	cacheCtx, _ := s.Ctx.CacheContext()
+	_, _, err = s.keeper.SwapExactAmountInWeightedRoutes(
+		cacheCtx, ordererAddr, resp.WeightedRoutes, input, utils.ParseDecCoin("40_000000ucre"), nil, false)
+	s.Require().ErrorIs(err, types.ErrSwapNotEnoughOutput)
+}
+
+func (s *KeeperTestSuite) TestFindAllRoutes() {
+	market1 := s.CreateMarket("ucre", "uusd")
+	market2 := s.CreateMarket("uatom", "ucre")
+	market3 := s.CreateMarket("uatom", "uusd")
+	market4 := s.CreateMarket("stake", "uatom")
+
+	mmAddr := s.FundedAccount(1, enoughCoins)
+	s.MakeLastPrice(market1.Id, mmAddr, utils.ParseDec("5"))
+	s.MakeLastPrice(market2.Id, mmAddr, utils.ParseDec("2"))
+	s.MakeLastPrice(market3.Id, mmAddr, utils.ParseDec("10"))
+
+	// Market 4 has no last price.
+	s.Require().Empty(s.keeper.FindAllRoutes(s.Ctx, "uusd", "stake", 3))
+	s.Require().Equal(
+		[][]uint64{{market3.Id}, {market1.Id, market2.Id}},
+		s.keeper.FindAllRoutes(s.Ctx, "uusd", "uatom", 3))
+	s.MakeLastPrice(market4.Id, mmAddr, utils.ParseDec("0.5"))
+	s.Require().Equal(
+		[][]uint64{{market3.Id, market4.Id}, {market1.Id, market2.Id, market4.Id}},
+		s.keeper.FindAllRoutes(s.Ctx, "uusd", "stake", 3))
+
+	// Inactive markets are excluded from the routes right away.
+	handler := exchange.NewProposalHandler(s.keeper)
+	s.Require().NoError(handler(s.Ctx, types.NewMarketStatusChangeProposal(
+		"Title", "Description", []types.MarketStatusChange{
+			types.NewMarketStatusChange(market3.Id, types.MarketStatusHalted),
+		})))
+	s.Require().Equal([][]uint64{{market1.Id, market2.Id}}, s.keeper.FindAllRoutes(s.Ctx, "uusd", "uatom", 3))
+
+	// New markets are included in the routes right away.
+	market5 := s.CreateMarket("uusd", "uatom")
+	s.MakeLastPrice(market5.Id, mmAddr, utils.ParseDec("0.1"))
+	s.Require().Equal(
+		[][]uint64{{market5.Id}, {market1.Id, market2.Id}},
+		s.keeper.FindAllRoutes(s.Ctx, "uusd", "uatom", 3))
+
+	// Routes are limited by the max routes length.
+	s.Require().Equal([][]uint64{{market5.Id}}, s.keeper.FindAllRoutes(s.Ctx, "uusd", "uatom", 1))
+	s.Require().Empty(s.keeper.FindAllRoutes(s.Ctx, "uusd", "stake", 1))
+}
+
+func (s *KeeperTestSuite) TestFindAllRoutes_MaxNumRoutes() {
+	// 10 * 11 routes from ucre to uusd, through denomA* and denomB*.
+	mmAddr := s.FundedAccount(1, enoughCoins)
+	createMarket := func(baseDenom, quoteDenom string) {
+		s.FundAccount(mmAddr, utils.ParseCoins(
+			fmt.Sprintf("1000_000000%s,1000_000000%s", baseDenom, quoteDenom)))
+		market := s.CreateMarket(baseDenom, quoteDenom)
+		s.MakeLastPrice(market.Id, mmAddr, utils.ParseDec("1"))
+	}
+	for i := 0; i < 10; i++ {
+		denomA := fmt.Sprintf("denomA%d", i)
+		createMarket(denomA, "ucre")
+		for j := 0; j < 11; j++ {
+			createMarket(denomA, fmt.Sprintf("denomB%d", j))
+		}
+	}
+	for j := 0; j < 11; j++ {
+		createMarket(fmt.Sprintf("denomB%d", j), "uusd")
+	}
+	s.Require().Len(s.keeper.FindAllRoutes(s.Ctx, "ucre", "uusd", 3), types.MaxNumSwapRoutes)
+	s.Require().Len(s.keeper.FindAllRoutes(s.Ctx, "ucre", "denomB0", 3), 10)
+
+	// The direct route is found first even though there are more than
+	// MaxNumSwapRoutes longer routes.
+	market := s.CreateMarket("ucre", "uusd")
+	s.MakeLastPrice(market.Id, mmAddr, utils.ParseDec("5"))
+	s.createLiquidity(market.Id, mmAddr, utils.ParseDec("5"), sdk.NewDec(10_000000))
+	s.NextBlock()
+	allRoutes := s.keeper.FindAllRoutes(s.Ctx, "ucre", "uusd", 3)
+	s.Require().Len(allRoutes, types.MaxNumSwapRoutes)
+	s.Require().Equal([]uint64{market.Id}, allRoutes[0])
+
+	resp, err := s.querier.BestSwapExactAmountInRoutes(sdk.WrapSDKContext(s.Ctx), &types.QueryBestSwapExactAmountInRoutesRequest{
+		Input:       "1000000ucre",
+		OutputDenom: "uusd",
+		MaxSplits:   1,
+	})
+	s.Require().NoError(err)
+	s.Require().Equal([]uint64{market.Id}, resp.Routes)
+}
+
+func (s *KeeperTestSuite) TestBestSwapRoutes_RankedByLiquidity() {
+	market1 := s.CreateMarket("ucre", "uusd")
+	market2 := s.CreateMarket("uusd", "ucre")
+	market3 := s.CreateMarket("uatom", "ucre")
+	market4 := s.CreateMarket("uatom", "uusd")
+
+	mmAddr := s.FundedAccount(1, enoughCoins)
+	s.MakeLastPrice(market1.Id, mmAddr, utils.ParseDec("5"))
+	s.MakeLastPrice(market2.Id, mmAddr, utils.ParseDec("0.2"))
+	s.MakeLastPrice(market3.Id, mmAddr, utils.ParseDec("2"))
+	s.MakeLastPrice(market4.Id, mmAddr, utils.ParseDec("10"))
+	// 500000ucre(=2_500000uusd) on each side.
+	s.createLiquidity(market1.Id, mmAddr, utils.ParseDec("5"), sdk.NewDec(1_000000))
+	// 5_000000uusd on each side.
+	s.createLiquidity(market2.Id, mmAddr, utils.ParseDec("0.2"), sdk.NewDec(10_000000))
+	s.createLiquidity(market3.Id, mmAddr, utils.ParseDec("2"), sdk.NewDec(100_000000))
+	s.createLiquidity(market4.Id, mmAddr, utils.ParseDec("10"), sdk.NewDec(100_000000))
+	s.NextBlock()
+
+	// The route through ATOM has the most liquidity, and market 2 has more
+	// liquidity than market 1 when measured in USD.
+	// Weighted routes are sorted in the order of the routes' liquidity.
+	resp, err := s.querier.BestSwapExactAmountInRoutes(sdk.WrapSDKContext(s.Ctx), &types.QueryBestSwapExactAmountInRoutesRequest{
+		Input:       "10000000uusd",
+		OutputDenom: "ucre",
+		MaxSplits:   3,
+	})
+	s.Require().NoError(err)
+	s.Require().Equal([]types.WeightedSwapRoute{
+		types.NewWeightedSwapRoute([]uint64{market4.Id, market3.Id}, sdk.NewDec(6_000000)),
+		types.NewWeightedSwapRoute([]uint64{market2.Id}, sdk.NewDec(2_000000)),
+		types.NewWeightedSwapRoute([]uint64{market1.Id}, sdk.NewDec(2_000000)),
+	}, resp.WeightedRoutes)
+}
@@ -0,0 +1,256 @@
+package keeper
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/crescent-network/crescent/v5/x/exchange/types"
+)
+
+func (k Keeper) PlaceTriggerOrder(
+	ctx sdk.Context, marketId uint64, ordererAddr sdk.AccAddress, isBuy bool,
+	condition types.TriggerCondition, triggerPrice sdk.Dec, price *sdk.Dec, qty sdk.Dec,
+	lifespan time.Duration) (order types.TriggerOrder, err error) {
+	if !qty.IsPositive() { // sanity check
+		panic("quantity must be positive")
+	}
+
+	market, found := k.GetMarket(ctx, marketId)
+	if !found {
+		err = sdkerrors.Wrap(sdkerrors.ErrNotFound, "market not found")
+		return
+	}
+	if !market.IsActive() {
+		err = sdkerrors.Wrapf(types.ErrMarketNotActive, "market %d is %s", market.Id, market.Status)
+		return
+	}
+	if _, err = k.validateCircuitBreaker(ctx, market, true); err != nil {
+		return
+	}
+	if price != nil {
+		if err = market.ValidateOrderPrice(*price); err != nil {
+			err = sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+			return
+		}
+	}
+	if err = market.ValidateOrderQuantity(qty); err != nil {
+		err = sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+		return
+	}
+	marketState := k.MustGetMarketState(ctx, market.Id)
+	if marketState.LastPrice != nil &&
+		types.IsTriggerConditionMet(condition, triggerPrice, *marketState.LastPrice) {
+		err = sdkerrors.Wrapf(
+			sdkerrors.ErrInvalidRequest, "trigger condition is already met at the last price %s", marketState.LastPrice)
+		return
+	}
+
+	// The deposit of a market buy order is calculated with the highest
+	// possible price the order can be executed right after being triggered.
+	depositPrice := triggerPrice
+	if price != nil {
+		depositPrice = *price
+	} else if isBuy {
+		_, depositPrice = types.OrderPriceLimit(triggerPrice, k.GetMaxOrderPriceRatio(ctx))
+	}
+	depositDenom, _ := types.PayReceiveDenoms(market.BaseDenom, market.QuoteDenom, isBuy)
+	depositCoin := sdk.NewCoin(depositDenom, types.DepositAmount(isBuy, depositPrice, qty).Ceil().TruncateInt())
+
+	orderId := k.GetNextOrderIdWithUpdate(ctx)
+	deadline := ctx.BlockTime().Add(lifespan)
+	order = types.NewTriggerOrder(
+		orderId, ordererAddr, market.Id, isBuy, condition, triggerPrice, price, qty,
+		ctx.BlockHeight(), depositCoin.Amount.ToDec(), deadline)
+	if err = k.EscrowCoins(ctx, market, ordererAddr, depositCoin); err != nil {
+		return
+	}
+	k.SetTriggerOrder(ctx, order)
+	k.SetTriggerOrderIndex(ctx, order)
+	k.SetTriggerOrdersByOrdererIndex(ctx, order)
+	k.SetTriggerOrdersByDeadlineIndex(ctx, order)
+
+	if err = ctx.EventManager().EmitTypedEvent(&types.EventPlaceTriggerOrder{
+		MarketId:     marketId,
+		OrderId:      orderId,
+		Orderer:      ordererAddr.String(),
+		IsBuy:        isBuy,
+		Condition:    condition,
+		TriggerPrice: triggerPrice,
+		Price:        price,
+		Quantity:     qty,
+		Lifespan:     lifespan,
+		Deadline:     deadline,
+	}); err != nil {
+		return
+	}
+	return
+}
+
+func (k Keeper) CancelTriggerOrder(ctx sdk.Context, ordererAddr sdk.AccAddress, orderId uint64) (order types.TriggerOrder, err error) {
+	var found bool
+	order, found = k.GetTriggerOrder(ctx, orderId)
+	if !found {
+		return order, sdkerrors.Wrap(sdkerrors.ErrNotFound, "trigger order not found")
+	}
+	if ordererAddr.String() != order.Orderer {
+		return order, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "order is not created by the sender")
+	}
+	market := k.MustGetMarket(ctx, order.MarketId)
+	if !market.CanCancelOrders() {
+		return order, sdkerrors.Wrapf(types.ErrMarketNotActive, "market %d is %s", market.Id, market.Status)
+	}
+	if err = k.cancelTriggerOrder(ctx, market, order); err != nil {
+		return order, err
+	}
+	if err = ctx.EventManager().EmitTypedEvent(&types.EventCancelOrder{
+		Orderer: ordererAddr.String(),
+		OrderId: orderId,
+	}); err != nil {
+		return order, err
+	}
+	return order, nil
+}
+
+// cancelTriggerOrder deletes the trigger order and refunds its deposit.
+func (k Keeper) cancelTriggerOrder(ctx sdk.Context, market types.Market, order types.TriggerOrder) error {
+	depositDenom, _ := types.PayReceiveDenoms(market.BaseDenom, market.QuoteDenom, order.IsBuy)
+	refunded := sdk.NewCoin(depositDenom, order.Deposit.TruncateInt())
+	if err := k.ReleaseCoins(ctx, market, order.MustGetOrdererAddress(), refunded); err != nil {
+		return err
+	}
+	k.DeleteTriggerOrder(ctx, order)
+	k.DeleteTriggerOrderIndex(ctx, order)
+	k.DeleteTriggerOrdersByOrdererIndex(ctx, order)
+	k.DeleteTriggerOrdersByDeadlineIndex(ctx, order)
+	return nil
+}
+
+// executeTriggerOrders converts trigger orders in the markets whose trigger
+// condition is met at the markets' last prices into limit or market orders.
+// It must be called once the order, swap or batch matching which changed the
+// last prices has completed.
+// Since the placed orders may change the last price again, a market is checked
+// again until no more orders are triggered in it, while at most
+// types.MaxNumTriggeredOrders orders are triggered in one call.
+func (k Keeper) executeTriggerOrders(ctx sdk.Context, marketIds ...uint64) error {
+	var queue []uint64
+	seen := map[uint64]struct{}{}
+	for _, marketId := range marketIds {
+		if _, ok := seen[marketId]; !ok {
+			seen[marketId] = struct{}{}
+			queue = append(queue, marketId)
+		}
+	}
+	numTriggered := 0
+	for len(queue) > 0 && numTriggered < types.MaxNumTriggeredOrders {
+		marketId := queue[0]
+		queue = queue[1:]
+		market := k.MustGetMarket(ctx, marketId)
+		n, err := k.executeMarketTriggerOrders(ctx, market, types.MaxNumTriggeredOrders-numTriggered)
+		if err != nil {
+			return err
+		}
+		numTriggered += n
+		if n > 0 {
+			queue = append(queue, marketId)
+		}
+	}
+	return nil
+}
+
+// executeMarketTriggerOrders converts at most limit trigger orders in the
+// market whose trigger condition is met at the market's last price into limit
+// or market orders. It returns the number of triggered orders.
+func (k Keeper) executeMarketTriggerOrders(ctx sdk.Context, market types.Market, limit int) (int, error) {
+	marketState := k.MustGetMarketState(ctx, market.Id)
+	// Orders are triggered once the market is reopened.
+	if marketState.LastPrice == nil || marketState.IsCircuitBreakerTripped() {
+		return 0, nil
+	}
+	lastPrice := *marketState.LastPrice
+	var orders []types.TriggerOrder
+	k.IterateTriggeredOrders(ctx, market.Id, lastPrice, func(order types.TriggerOrder) (stop bool) {
+		orders = append(orders, order)
+		return len(orders) >= limit
+	})
+	// Remove the triggered orders from the store first to refund their
+	// deposits, which are escrowed again when the orders are placed.
+	for _, order := range orders {
+		if err := k.cancelTriggerOrder(ctx, market, order); err != nil {
+			return 0, err
+		}
+	}
+	for _, order := range orders {
+		// Failing to place the order must not revert the whole matching, so
+		// the order is placed inside a cached context.
+		cacheCtx, writeCache := ctx.CacheContext()
+		orderId, err := k.placeTriggeredOrder(cacheCtx, order)
+		if err != nil {
+			if err := ctx.EventManager().EmitTypedEvent(&types.EventTriggerOrderFailed{
+				MarketId:       market.Id,
+				TriggerOrderId: order.Id,
+				Orderer:        order.Orderer,
+				Reason:         err.Error(),
+			}); err != nil {
+				return 0, err
+			}
+			continue
+		}
+		writeCache()
+		if err := ctx.EventManager().EmitTypedEvent(&types.EventTriggerOrderTriggered{
+			MarketId:       market.Id,
+			TriggerOrderId: order.Id,
+			Orderer:        order.Orderer,
+			LastPrice:      lastPrice,
+			OrderId:        orderId,
+		}); err != nil {
+			return 0, err
+		}
+		ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+	}
+	return len(orders), nil
+}
+
+func (k Keeper) placeTriggeredOrder(ctx sdk.Context, order types.TriggerOrder) (orderId uint64, err error) {
+	ordererAddr := order.MustGetOrdererAddress()
+	if order.Price != nil {
+		var lifespan time.Duration
+		if order.Deadline.After(ctx.BlockTime()) {
+			lifespan = order.Deadline.Sub(ctx.BlockTime())
+		}
+		orderId, _, _, _, err = k.placeLimitOrderWithEvent(
+			ctx, order.MarketId, ordererAddr, order.IsBuy, *order.Price, order.Quantity, nil, lifespan,
+			types.TimeInForceGoodTilTime, types.SelfTradePreventionUnspecified, nil)
+		return
+	}
+	orderId, _, err = k.placeMarketOrderWithEvent(ctx, order.MarketId, ordererAddr, order.IsBuy, order.Quantity, nil)
+	return
+}
+
+// CancelExpiredTriggerOrders cancels trigger orders whose deadline has passed.
+// Only the expired orders are iterated, using the deadline index.
+func (k Keeper) CancelExpiredTriggerOrders(ctx sdk.Context) error {
+	var expiredOrders []types.TriggerOrder
+	k.IterateExpiredTriggerOrders(ctx, ctx.BlockTime(), func(order types.TriggerOrder) (stop bool) {
+		expiredOrders = append(expiredOrders, order)
+		return false
+	})
+	for _, order := range expiredOrders {
+		market := k.MustGetMarket(ctx, order.MarketId)
+		// Orders in a halted market are frozen until the market is resumed.
+		if market.Status == types.MarketStatusHalted {
+			continue
+		}
+		if err := k.cancelTriggerOrder(ctx, market, order); err != nil {
+			return err
+		}
+		if err := ctx.EventManager().EmitTypedEvent(&types.EventOrderExpired{
+			OrderId: order.Id,
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
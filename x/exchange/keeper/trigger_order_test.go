@@ -0,0 +1,231 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	utils "github.com/crescent-network/crescent/v5/types"
+	"github.com/crescent-network/crescent/v5/x/exchange/keeper"
+	"github.com/crescent-network/crescent/v5/x/exchange/types"
+)
+
+func (s *KeeperTestSuite) TestPlaceTriggerOrder() {
+	market := s.CreateMarket("ucre", "uusd")
+	mmAddr := s.FundedAccount(1, enoughCoins)
+	ordererAddr := s.FundedAccount(2, enoughCoins)
+
+	s.MakeLastPrice(market.Id, mmAddr, utils.ParseDec("5"))
+
+	_, err := s.keeper.PlaceTriggerOrder(
+		s.Ctx, market.Id, ordererAddr, false, types.TriggerConditionPriceBelow,
+		utils.ParseDec("5"), nil, sdk.NewDec(10_000000), time.Hour)
+	s.Require().EqualError(err, "trigger condition is already met at the last price 5.000000000000000000: invalid request")
+
+	balancesBefore := s.GetAllBalances(ordererAddr)
+	// Stop-loss market sell order
+	order1 := s.PlaceTriggerOrder(
+		market.Id, ordererAddr, false, types.TriggerConditionPriceBelow,
+		utils.ParseDec("4.5"), nil, sdk.NewDec(10_000000), time.Hour)
+	s.Require().Equal("10000000.000000000000000000", order1.Deposit.String())
+	// Take-profit limit sell order
+	price := utils.ParseDec("5.4")
+	order2 := s.PlaceTriggerOrder(
+		market.Id, ordererAddr, false, types.TriggerConditionPriceAbove,
+		utils.ParseDec("5.5"), &price, sdk.NewDec(10_000000), time.Hour)
+	// Market buy order
+	order3 := s.PlaceTriggerOrder(
+		market.Id, ordererAddr, true, types.TriggerConditionPriceAbove,
+		utils.ParseDec("6"), nil, sdk.NewDec(10_000000), time.Hour)
+	// 6 * 1.1 * 10_000000
+	s.Require().Equal("66000000.000000000000000000", order3.Deposit.String())
+
+	balancesAfter := s.GetAllBalances(ordererAddr)
+	diff, _ := balancesAfter.SafeSub(balancesBefore)
+	s.Require().Equal("-20000000ucre,-66000000uusd", diff.String())
+
+	s.NextBlock()
+	// Trigger orders can be cancelled by MsgCancelOrder.
+	msgServer := keeper.NewMsgServerImpl(s.keeper)
+	_, err = msgServer.CancelOrder(sdk.WrapSDKContext(s.Ctx), types.NewMsgCancelOrder(mmAddr, order1.Id))
+	s.Require().EqualError(err, "order is not created by the sender: unauthorized")
+	for _, order := range []types.TriggerOrder{order1, order2, order3} {
+		_, err = msgServer.CancelOrder(sdk.WrapSDKContext(s.Ctx), types.NewMsgCancelOrder(ordererAddr, order.Id))
+		s.Require().NoError(err)
+		_, found := s.keeper.GetTriggerOrder(s.Ctx, order.Id)
+		s.Require().False(found)
+	}
+	s.Require().Equal(balancesBefore, s.GetAllBalances(ordererAddr))
+}
+
+func (s *KeeperTestSuite) TestTriggerOrder_MarketOrder() {
+	market := s.CreateMarket("ucre", "uusd")
+	mmAddr := s.FundedAccount(1, enoughCoins)
+	ordererAddr := s.FundedAccount(2, enoughCoins)
+	traderAddr := s.FundedAccount(3, enoughCoins)
+
+	s.MakeLastPrice(market.Id, mmAddr, utils.ParseDec("5"))
+
+	order := s.PlaceTriggerOrder(
+		market.Id, ordererAddr, false, types.TriggerConditionPriceBelow,
+		utils.ParseDec("4.5"), nil, sdk.NewDec(10_000000), time.Hour)
+	s.PlaceLimitOrder(market.Id, mmAddr, true, utils.ParseDec("4.4"), sdk.NewDec(100_000000), time.Hour)
+	s.PlaceLimitOrder(market.Id, mmAddr, true, utils.ParseDec("4.6"), sdk.NewDec(1_000000), time.Hour)
+
+	// Not triggered yet.
+	s.PlaceLimitOrder(market.Id, traderAddr, false, utils.ParseDec("4.6"), sdk.NewDec(1_000000), time.Hour)
+	s.Require().Equal("4.600000000000000000", s.keeper.MustGetMarketState(s.Ctx, market.Id).LastPrice.String())
+	_, found := s.keeper.GetTriggerOrder(s.Ctx, order.Id)
+	s.Require().True(found)
+
+	balancesBefore := s.GetAllBalances(ordererAddr)
+	s.Ctx = s.Ctx.WithEventManager(sdk.NewEventManager())
+	s.PlaceLimitOrder(market.Id, traderAddr, false, utils.ParseDec("4.4"), sdk.NewDec(1_000000), time.Hour)
+	_, found = s.keeper.GetTriggerOrder(s.Ctx, order.Id)
+	s.Require().False(found)
+	s.CheckEvent(&types.EventTriggerOrderTriggered{}, map[string][]byte{
+		"trigger_order_id": []byte(`"3"`),
+		"last_price":       []byte(`"4.400000000000000000"`),
+		"order_id":         []byte(`"8"`),
+	})
+
+	// The deposit was refunded and the market order was executed at 4.4.
+	balancesAfter := s.GetAllBalances(ordererAddr)
+	diff, _ := balancesAfter.SafeSub(balancesBefore)
+	s.Require().Equal("43868000uusd", diff.String())
+	s.Require().Equal(
+		sdk.NewInt(10_000000).Neg(),
+		balancesAfter.AmountOf("ucre").Sub(balancesBefore.AmountOf("ucre")).Sub(order.Deposit.TruncateInt()))
+}
+
+func (s *KeeperTestSuite) TestTriggerOrder_BatchMatching() {
+	market := s.CreateMarket("ucre", "uusd")
+	mmAddr := s.FundedAccount(1, enoughCoins)
+	ordererAddr := s.FundedAccount(2, enoughCoins)
+
+	s.MakeLastPrice(market.Id, mmAddr, utils.ParseDec("5"))
+
+	price := utils.ParseDec("5.6")
+	order := s.PlaceTriggerOrder(
+		market.Id, ordererAddr, true, types.TriggerConditionPriceAbove,
+		utils.ParseDec("5.5"), &price, sdk.NewDec(10_000000), time.Hour)
+	s.Require().Equal("56000000.000000000000000000", order.Deposit.String())
+
+	s.PlaceBatchLimitOrder(market.Id, mmAddr, true, utils.ParseDec("5.5"), sdk.NewDec(1_000000), time.Hour)
+	s.PlaceBatchLimitOrder(market.Id, mmAddr, false, utils.ParseDec("5.5"), sdk.NewDec(1_000000), time.Hour)
+	s.Require().NoError(s.keeper.RunBatchMatching(s.Ctx, market))
+	s.Require().Equal("5.500000000000000000", s.keeper.MustGetMarketState(s.Ctx, market.Id).LastPrice.String())
+
+	_, found := s.keeper.GetTriggerOrder(s.Ctx, order.Id)
+	s.Require().False(found)
+	// The limit order placed by the trigger order rests on the order book.
+	var orders []types.Order
+	s.keeper.IterateOrdersByOrdererAndMarket(s.Ctx, ordererAddr, market.Id, func(order types.Order) (stop bool) {
+		orders = append(orders, order)
+		return false
+	})
+	s.Require().Len(orders, 1)
+	s.Require().True(orders[0].IsBuy)
+	s.Require().Equal(price, orders[0].Price)
+	s.Require().Equal(order.Deadline, orders[0].Deadline)
+}
+
+func (s *KeeperTestSuite) TestTriggerOrder_Failed() {
+	market := s.CreateMarket("ucre", "uusd")
+	mmAddr := s.FundedAccount(1, enoughCoins)
+	ordererAddr := s.FundedAccount(2, enoughCoins)
+
+	s.MakeLastPrice(market.Id, mmAddr, utils.ParseDec("5"))
+
+	// The limit price is too far from the last price when triggered.
+	price := utils.ParseDec("3")
+	order := s.PlaceTriggerOrder(
+		market.Id, ordererAddr, false, types.TriggerConditionPriceAbove,
+		utils.ParseDec("5.5"), &price, sdk.NewDec(10_000000), time.Hour)
+
+	balancesBefore := s.GetAllBalances(ordererAddr)
+	s.Ctx = s.Ctx.WithEventManager(sdk.NewEventManager())
+	s.MakeLastPrice(market.Id, mmAddr, utils.ParseDec("5.5"))
+	_, found := s.keeper.GetTriggerOrder(s.Ctx, order.Id)
+	s.Require().False(found)
+	s.CheckEvent(&types.EventTriggerOrderFailed{}, map[string][]byte{
+		"trigger_order_id": []byte(`"3"`),
+	})
+	// The deposit has been refunded.
+	balancesAfter := s.GetAllBalances(ordererAddr)
+	diff, _ := balancesAfter.SafeSub(balancesBefore)
+	s.Require().Equal("10000000ucre", diff.String())
+}
+
+func (s *KeeperTestSuite) TestCancelExpiredTriggerOrders() {
+	market := s.CreateMarket("ucre", "uusd")
+	ordererAddr := s.FundedAccount(1, enoughCoins)
+
+	balancesBefore := s.GetAllBalances(ordererAddr)
+	order := s.PlaceTriggerOrder(
+		market.Id, ordererAddr, false, types.TriggerConditionPriceBelow,
+		utils.ParseDec("4.5"), nil, sdk.NewDec(10_000000), 10*time.Second)
+
+	s.NextBlock()
+	_, found := s.keeper.GetTriggerOrder(s.Ctx, order.Id)
+	s.Require().True(found)
+
+	s.NextBlock()
+	s.NextBlock()
+	_, found = s.keeper.GetTriggerOrder(s.Ctx, order.Id)
+	s.Require().False(found)
+	s.Require().Equal(balancesBefore, s.GetAllBalances(ordererAddr))
+}
+
+func (s *KeeperTestSuite) TestTriggerOrder_AfterParentOrderStored() {
+	market := s.CreateMarket("ucre", "uusd")
+	mmAddr := s.FundedAccount(1, enoughCoins)
+	ordererAddr := s.FundedAccount(2, enoughCoins)
+	traderAddr := s.FundedAccount(3, enoughCoins)
+
+	s.MakeLastPrice(market.Id, mmAddr, utils.ParseDec("5"))
+
+	// Take-profit market sell order
+	s.PlaceTriggerOrder(
+		market.Id, ordererAddr, false, types.TriggerConditionPriceAbove,
+		utils.ParseDec("5.5"), nil, sdk.NewDec(1_000000), time.Hour)
+	s.PlaceLimitOrder(market.Id, mmAddr, false, utils.ParseDec("5.5"), sdk.NewDec(1_000000), time.Hour)
+
+	// The triggered order is placed after the buy order rests on the order book,
+	// so it is matched against the buy order.
+	_, order, res := s.PlaceLimitOrder(
+		market.Id, traderAddr, true, utils.ParseDec("5.5"), sdk.NewDec(10_000000), time.Hour)
+	s.AssertEqual(sdk.NewDec(1_000000), res.ExecutedQuantity)
+	order = s.keeper.MustGetOrder(s.Ctx, order.Id)
+	s.AssertEqual(sdk.NewDec(8_000000), order.OpenQuantity)
+}
+
+func (s *KeeperTestSuite) TestTriggerOrder_MaxNumTriggeredOrders() {
+	market := s.CreateMarket("ucre", "uusd")
+	mmAddr := s.FundedAccount(1, enoughCoins)
+	ordererAddr := s.FundedAccount(2, enoughCoins)
+
+	s.MakeLastPrice(market.Id, mmAddr, utils.ParseDec("5"))
+
+	price := utils.ParseDec("6")
+	for i := 0; i < types.MaxNumTriggeredOrders+1; i++ {
+		s.PlaceTriggerOrder(
+			market.Id, ordererAddr, false, types.TriggerConditionPriceAbove,
+			utils.ParseDec("5.5"), &price, sdk.NewDec(1_000000), time.Hour)
+	}
+	numTriggerOrders := func() (num int) {
+		s.keeper.IterateTriggerOrdersByMarket(s.Ctx, market.Id, func(order types.TriggerOrder) (stop bool) {
+			num++
+			return false
+		})
+		return num
+	}
+
+	s.MakeLastPrice(market.Id, mmAddr, utils.ParseDec("5.5"))
+	// Only MaxNumTriggeredOrders orders have been triggered.
+	s.Require().Equal(1, numTriggerOrders())
+
+	// The rest is triggered in the next batch matching.
+	s.Require().NoError(s.keeper.RunBatchMatching(s.Ctx, market))
+	s.Require().Zero(numTriggerOrders())
+}
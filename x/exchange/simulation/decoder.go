@@ -42,6 +42,12 @@ func NewDecodeStore(cdc codec.Codec) func(kvA, kvB kv.Pair) string {
 			cdc.MustUnmarshal(kvB.Value, &oB)
 			return fmt.Sprintf("%v\n%v", oA, oB)
 
+		case bytes.Equal(kvA.Key[:1], types.TriggerOrderKeyPrefix):
+			var oA, oB types.TriggerOrder
+			cdc.MustUnmarshal(kvA.Value, &oA)
+			cdc.MustUnmarshal(kvB.Value, &oB)
+			return fmt.Sprintf("%v\n%v", oA, oB)
+
 		default:
 			panic(fmt.Sprintf("invalid exchange key prefix %X", kvA.Key[:1]))
 		}
@@ -25,7 +25,8 @@ func TestDecodeStore(t *testing.T) {
 	marketState := types.NewMarketState(utils.ParseDecP("12.345"))
 	order := types.NewOrder(
 		1, types.OrderTypeLimit, utils.TestAddress(1), 10, false, utils.ParseDec("12.345"), sdk.NewDec(100_000000),
-		200, sdk.NewDec(90_000000), sdk.NewDec(90_000000), utils.ParseTime("2023-06-01T00:00:00Z"))
+		200, sdk.NewDec(90_000000), sdk.NewDec(90_000000), utils.ParseTime("2023-06-01T00:00:00Z"),
+		types.TimeInForceGoodTilTime, types.SelfTradePreventionUnspecified)
 
 	kvPairs := kv.Pairs{
 		Pairs: []kv.Pair{
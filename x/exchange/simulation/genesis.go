@@ -22,10 +22,12 @@ func GenFees(r *rand.Rand) types.Fees {
 	takerFeeRate := utils.RandomDec(r, utils.ParseDec("0"), utils.ParseDec("0.01"))
 	makerFeeRate := utils.RandomDec(r, utils.ParseDec("0"), utils.ParseDec("0.005"))
 	orderSourceFeeRatio := sdk.NewDecWithPrec(r.Int63n(101), 2) // 0%, 1%, 2%, ..., 99%, 100%
+	referralFeeRatio := sdk.NewDecWithPrec(r.Int63n(101-orderSourceFeeRatio.MulInt64(100).TruncateInt64()), 2)
 	return types.Fees{
 		DefaultMakerFeeRate:        makerFeeRate,
 		DefaultTakerFeeRate:        takerFeeRate,
 		DefaultOrderSourceFeeRatio: orderSourceFeeRatio,
+		ReferralFeeRatio:           referralFeeRatio,
 	}
 }
 
@@ -331,7 +331,7 @@ func findMsgCreateMarketParams(r *rand.Rand, accs []simtypes.Account,
 					if !spendable.IsAllGTE(k.GetMarketCreationFee(ctx)) {
 						continue
 					}
-					msg = types.NewMsgCreateMarket(acc.Address, denomA, denomB)
+					msg = types.NewMsgCreateMarket(acc.Address, denomA, denomB, nil, nil, nil)
 					return acc, msg, true
 				}
 			}
@@ -369,14 +369,16 @@ func findMsgPlaceLimitOrderParams(
 				if balance := spendable.AmountOf(market.BaseDenom); balance.GT(sdk.NewInt(100_000000)) {
 					qty := utils.RandomDec(r, sdk.NewDec(100), sdk.NewDec(100_000000)).TruncateDec()
 					msg = types.NewMsgPlaceLimitOrder(
-						acc.Address, market.Id, false, price, qty, lifespan)
+						acc.Address, market.Id, false, price, qty, lifespan, types.TimeInForceGoodTilTime,
+						types.SelfTradePreventionUnspecified)
 					return acc, msg, true
 				}
 			}
 			if balance := spendable.AmountOf(market.QuoteDenom); balance.GT(price.MulInt64(100_000000).TruncateInt()) {
 				qty := utils.RandomDec(r, sdk.NewDec(100), sdk.NewDec(100_000000)).TruncateDec()
 				msg = types.NewMsgPlaceLimitOrder(
-					acc.Address, market.Id, true, price, qty, lifespan)
+					acc.Address, market.Id, true, price, qty, lifespan, types.TimeInForceGoodTilTime,
+					types.SelfTradePreventionUnspecified)
 				return acc, msg, true
 			}
 		}
@@ -418,14 +420,16 @@ func findMsgPlaceMMLimitOrderParams(
 				if balance := spendable.AmountOf(market.BaseDenom); balance.GT(sdk.NewInt(100_000000)) {
 					qty := utils.RandomDec(r, sdk.NewDec(100), sdk.NewDec(100_000000)).TruncateDec()
 					msg = types.NewMsgPlaceMMLimitOrder(
-						acc.Address, market.Id, false, price, qty, lifespan)
+						acc.Address, market.Id, false, price, qty, lifespan, types.TimeInForceGoodTilTime,
+						types.SelfTradePreventionUnspecified)
 					return acc, msg, true
 				}
 			}
 			if balance := spendable.AmountOf(market.QuoteDenom); balance.GT(price.MulInt64(100_000000).TruncateInt()) {
 				qty := utils.RandomDec(r, sdk.NewDec(100), sdk.NewDec(100_000000)).TruncateDec()
 				msg = types.NewMsgPlaceMMLimitOrder(
-					acc.Address, market.Id, true, price, qty, lifespan)
+					acc.Address, market.Id, true, price, qty, lifespan, types.TimeInForceGoodTilTime,
+					types.SelfTradePreventionUnspecified)
 				return acc, msg, true
 			}
 		}
@@ -466,7 +470,7 @@ func findMsgPlaceMarketOrderParams(
 			}
 			qty := utils.RandomDec(r, sdk.NewDec(100), sdk.NewDec(1_000000)).TruncateDec()
 			cacheCtx, _ := ctx.CacheContext()
-			if _, _, err := k.PlaceMarketOrder(cacheCtx, market.Id, acc.Address, true, qty); err != nil {
+			if _, _, err := k.PlaceMarketOrder(cacheCtx, market.Id, acc.Address, true, qty, nil); err != nil {
 				continue
 			}
 			msg = types.NewMsgPlaceMarketOrder(acc.Address, market.Id, true, qty)
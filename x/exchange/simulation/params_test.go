@@ -21,8 +21,8 @@ func TestParamChanges(t *testing.T) {
 		simValue    string
 		subspace    string
 	}{
-		{"exchange/Fees", "Fees", `{"default_maker_fee_rate":"0.001083067024517151","default_taker_fee_rate":"0.007709506529800694","default_order_source_fee_ratio":"0.320000000000000000"}`, "exchange"},
-		{"exchange/MaxOrderPriceRatio", "MaxOrderPriceRatio", `"0.235318889613999521"`, "exchange"},
+		{"exchange/Fees", "Fees", `{"default_maker_fee_rate":"0.001083067024517151","default_taker_fee_rate":"0.007709506529800694","default_order_source_fee_ratio":"0.320000000000000000","fee_tiers":null,"referral_fee_ratio":"0.660000000000000000"}`, "exchange"},
+		{"exchange/MaxOrderPriceRatio", "MaxOrderPriceRatio", `"0.167441417343774193"`, "exchange"},
 	}
 
 	for i, p := range paramChanges {
@@ -0,0 +1,46 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	utils "github.com/crescent-network/crescent/v5/types"
+)
+
+// Validate validates CircuitBreaker.
+func (cb CircuitBreaker) Validate() error {
+	if cb.PriceBandRatio.IsNil() || cb.PriceBandRatio.IsNegative() || cb.PriceBandRatio.GTE(utils.OneDec) {
+		return fmt.Errorf("price band ratio must be in range [0.0, 1.0): %s", cb.PriceBandRatio)
+	}
+	if cb.Window == 0 {
+		return fmt.Errorf("window must not be 0")
+	}
+	if cb.Duration == 0 {
+		return fmt.Errorf("duration must not be 0")
+	}
+	if _, ok := CircuitBreakerMode_name[int32(cb.Mode)]; !ok {
+		return fmt.Errorf("invalid circuit breaker mode: %d", cb.Mode)
+	}
+	return nil
+}
+
+// IsEnabled returns whether the circuit breaker is enabled.
+func (cb CircuitBreaker) IsEnabled() bool {
+	return cb.PriceBandRatio.IsPositive()
+}
+
+// PriceBand returns the range of prices within the price band around the
+// reference price.
+func (cb CircuitBreaker) PriceBand(refPrice sdk.Dec) (minPrice, maxPrice sdk.Dec) {
+	minPrice = refPrice.Mul(utils.OneDec.Sub(cb.PriceBandRatio))
+	maxPrice = refPrice.Mul(utils.OneDec.Add(cb.PriceBandRatio))
+	return
+}
+
+// IsCircuitBreakerTripped returns whether the market's circuit breaker is
+// tripped. The circuit breaker stays tripped until the market is reopened,
+// even after the end height.
+func (marketState MarketState) IsCircuitBreakerTripped() bool {
+	return marketState.CircuitBreakerEndHeight > 0
+}
@@ -21,7 +21,13 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgCancelOrder{}, "exchange/MsgCancelOrder", nil)
 	cdc.RegisterConcrete(&MsgCancelAllOrders{}, "exchange/MsgCancelAllOrders", nil)
 	cdc.RegisterConcrete(&MsgSwapExactAmountIn{}, "exchange/MsgSwapExactAmountIn", nil)
+	cdc.RegisterConcrete(&MsgSwapExactAmountOut{}, "exchange/MsgSwapExactAmountOut", nil)
+	cdc.RegisterConcrete(&MsgPlaceTriggerOrder{}, "exchange/MsgPlaceTriggerOrder", nil)
+	cdc.RegisterConcrete(&MsgAmendOrder{}, "exchange/MsgAmendOrder", nil)
+	cdc.RegisterConcrete(&MsgSetCancelAfter{}, "exchange/MsgSetCancelAfter", nil)
+	cdc.RegisterConcrete(&MsgReplaceMMOrders{}, "exchange/MsgReplaceMMOrders", nil)
 	cdc.RegisterConcrete(&MarketParameterChangeProposal{}, "exchange/MarketParameterChangeProposal", nil)
+	cdc.RegisterConcrete(&MarketStatusChangeProposal{}, "exchange/MarketStatusChangeProposal", nil)
 }
 
 // RegisterInterfaces registers the x/exchange interfaces types with the
@@ -38,10 +44,16 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 		&MsgCancelOrder{},
 		&MsgCancelAllOrders{},
 		&MsgSwapExactAmountIn{},
+		&MsgSwapExactAmountOut{},
+		&MsgPlaceTriggerOrder{},
+		&MsgAmendOrder{},
+		&MsgSetCancelAfter{},
+		&MsgReplaceMMOrders{},
 	)
 	registry.RegisterImplementations(
 		(*govtypes.Content)(nil),
 		&MarketParameterChangeProposal{},
+		&MarketStatusChangeProposal{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
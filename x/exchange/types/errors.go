@@ -10,4 +10,7 @@ var (
 	ErrSwapNotEnoughLiquidity = sdkerrors.Register(ModuleName, 4, "not enough liquidity in the market")
 	ErrOrderPriceOutOfRange   = sdkerrors.Register(ModuleName, 5, "order price out of range")
 	ErrMaxNumMMOrdersExceeded = sdkerrors.Register(ModuleName, 6, "number of MM orders exceeded the limit")
+	ErrNotEnoughPriceHistory  = sdkerrors.Register(ModuleName, 7, "not enough price history")
+	ErrMarketNotActive        = sdkerrors.Register(ModuleName, 8, "market is not active")
+	ErrCircuitBreakerTripped  = sdkerrors.Register(ModuleName, 9, "market circuit breaker is tripped")
 )
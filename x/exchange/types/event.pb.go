@@ -71,17 +71,22 @@ func (m *EventCreateMarket) XXX_DiscardUnknown() {
 var xxx_messageInfo_EventCreateMarket proto.InternalMessageInfo
 
 type EventPlaceLimitOrder struct {
-	MarketId         uint64                                 `protobuf:"varint,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
-	OrderId          uint64                                 `protobuf:"varint,2,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
-	Orderer          string                                 `protobuf:"bytes,3,opt,name=orderer,proto3" json:"orderer,omitempty"`
-	IsBuy            bool                                   `protobuf:"varint,4,opt,name=is_buy,json=isBuy,proto3" json:"is_buy,omitempty"`
-	Price            github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=price,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"price"`
-	Quantity         github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=quantity,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"quantity"`
-	Lifespan         time.Duration                          `protobuf:"bytes,7,opt,name=lifespan,proto3,stdduration" json:"lifespan"`
-	Deadline         time.Time                              `protobuf:"bytes,8,opt,name=deadline,proto3,stdtime" json:"deadline"`
-	ExecutedQuantity github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,9,opt,name=executed_quantity,json=executedQuantity,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"executed_quantity"`
-	Paid             types.DecCoin                          `protobuf:"bytes,10,opt,name=paid,proto3" json:"paid"`
-	Received         types.DecCoin                          `protobuf:"bytes,11,opt,name=received,proto3" json:"received"`
+	MarketId            uint64                                  `protobuf:"varint,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	OrderId             uint64                                  `protobuf:"varint,2,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	Orderer             string                                  `protobuf:"bytes,3,opt,name=orderer,proto3" json:"orderer,omitempty"`
+	IsBuy               bool                                    `protobuf:"varint,4,opt,name=is_buy,json=isBuy,proto3" json:"is_buy,omitempty"`
+	Price               github_com_cosmos_cosmos_sdk_types.Dec  `protobuf:"bytes,5,opt,name=price,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"price"`
+	Quantity            github_com_cosmos_cosmos_sdk_types.Dec  `protobuf:"bytes,6,opt,name=quantity,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"quantity"`
+	Lifespan            time.Duration                           `protobuf:"bytes,7,opt,name=lifespan,proto3,stdduration" json:"lifespan"`
+	Deadline            time.Time                               `protobuf:"bytes,8,opt,name=deadline,proto3,stdtime" json:"deadline"`
+	ExecutedQuantity    github_com_cosmos_cosmos_sdk_types.Dec  `protobuf:"bytes,9,opt,name=executed_quantity,json=executedQuantity,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"executed_quantity"`
+	Paid                types.DecCoin                           `protobuf:"bytes,10,opt,name=paid,proto3" json:"paid"`
+	Received            types.DecCoin                           `protobuf:"bytes,11,opt,name=received,proto3" json:"received"`
+	TimeInForce         TimeInForce                             `protobuf:"varint,12,opt,name=time_in_force,json=timeInForce,proto3,enum=crescent.exchange.v1beta1.TimeInForce" json:"time_in_force,omitempty"`
+	RejectReason        string                                  `protobuf:"bytes,13,opt,name=reject_reason,json=rejectReason,proto3" json:"reject_reason,omitempty"`
+	SelfTradePrevention SelfTradePrevention                     `protobuf:"varint,14,opt,name=self_trade_prevention,json=selfTradePrevention,proto3,enum=crescent.exchange.v1beta1.SelfTradePrevention" json:"self_trade_prevention,omitempty"`
+	Referrer            string                                  `protobuf:"bytes,15,opt,name=referrer,proto3" json:"referrer,omitempty"`
+	DisplayQuantity     *github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,16,opt,name=display_quantity,json=displayQuantity,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"display_quantity,omitempty"`
 }
 
 func (m *EventPlaceLimitOrder) Reset()         { *m = EventPlaceLimitOrder{} }
@@ -118,14 +123,17 @@ func (m *EventPlaceLimitOrder) XXX_DiscardUnknown() {
 var xxx_messageInfo_EventPlaceLimitOrder proto.InternalMessageInfo
 
 type EventPlaceBatchLimitOrder struct {
-	MarketId uint64                                 `protobuf:"varint,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
-	OrderId  uint64                                 `protobuf:"varint,2,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
-	Orderer  string                                 `protobuf:"bytes,3,opt,name=orderer,proto3" json:"orderer,omitempty"`
-	IsBuy    bool                                   `protobuf:"varint,4,opt,name=is_buy,json=isBuy,proto3" json:"is_buy,omitempty"`
-	Price    github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=price,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"price"`
-	Quantity github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=quantity,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"quantity"`
-	Lifespan time.Duration                          `protobuf:"bytes,7,opt,name=lifespan,proto3,stdduration" json:"lifespan"`
-	Deadline time.Time                              `protobuf:"bytes,8,opt,name=deadline,proto3,stdtime" json:"deadline"`
+	MarketId            uint64                                 `protobuf:"varint,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	OrderId             uint64                                 `protobuf:"varint,2,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	Orderer             string                                 `protobuf:"bytes,3,opt,name=orderer,proto3" json:"orderer,omitempty"`
+	IsBuy               bool                                   `protobuf:"varint,4,opt,name=is_buy,json=isBuy,proto3" json:"is_buy,omitempty"`
+	Price               github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=price,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"price"`
+	Quantity            github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=quantity,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"quantity"`
+	Lifespan            time.Duration                          `protobuf:"bytes,7,opt,name=lifespan,proto3,stdduration" json:"lifespan"`
+	Deadline            time.Time                              `protobuf:"bytes,8,opt,name=deadline,proto3,stdtime" json:"deadline"`
+	TimeInForce         TimeInForce                            `protobuf:"varint,9,opt,name=time_in_force,json=timeInForce,proto3,enum=crescent.exchange.v1beta1.TimeInForce" json:"time_in_force,omitempty"`
+	RejectReason        string                                 `protobuf:"bytes,10,opt,name=reject_reason,json=rejectReason,proto3" json:"reject_reason,omitempty"`
+	SelfTradePrevention SelfTradePrevention                    `protobuf:"varint,11,opt,name=self_trade_prevention,json=selfTradePrevention,proto3,enum=crescent.exchange.v1beta1.SelfTradePrevention" json:"self_trade_prevention,omitempty"`
 }
 
 func (m *EventPlaceBatchLimitOrder) Reset()         { *m = EventPlaceBatchLimitOrder{} }
@@ -162,17 +170,20 @@ func (m *EventPlaceBatchLimitOrder) XXX_DiscardUnknown() {
 var xxx_messageInfo_EventPlaceBatchLimitOrder proto.InternalMessageInfo
 
 type EventPlaceMMLimitOrder struct {
-	MarketId         uint64                                 `protobuf:"varint,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
-	OrderId          uint64                                 `protobuf:"varint,2,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
-	Orderer          string                                 `protobuf:"bytes,3,opt,name=orderer,proto3" json:"orderer,omitempty"`
-	IsBuy            bool                                   `protobuf:"varint,4,opt,name=is_buy,json=isBuy,proto3" json:"is_buy,omitempty"`
-	Price            github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=price,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"price"`
-	Quantity         github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=quantity,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"quantity"`
-	Lifespan         time.Duration                          `protobuf:"bytes,7,opt,name=lifespan,proto3,stdduration" json:"lifespan"`
-	Deadline         time.Time                              `protobuf:"bytes,8,opt,name=deadline,proto3,stdtime" json:"deadline"`
-	ExecutedQuantity github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,9,opt,name=executed_quantity,json=executedQuantity,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"executed_quantity"`
-	Paid             types.DecCoin                          `protobuf:"bytes,10,opt,name=paid,proto3" json:"paid"`
-	Received         types.DecCoin                          `protobuf:"bytes,11,opt,name=received,proto3" json:"received"`
+	MarketId            uint64                                 `protobuf:"varint,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	OrderId             uint64                                 `protobuf:"varint,2,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	Orderer             string                                 `protobuf:"bytes,3,opt,name=orderer,proto3" json:"orderer,omitempty"`
+	IsBuy               bool                                   `protobuf:"varint,4,opt,name=is_buy,json=isBuy,proto3" json:"is_buy,omitempty"`
+	Price               github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=price,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"price"`
+	Quantity            github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=quantity,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"quantity"`
+	Lifespan            time.Duration                          `protobuf:"bytes,7,opt,name=lifespan,proto3,stdduration" json:"lifespan"`
+	Deadline            time.Time                              `protobuf:"bytes,8,opt,name=deadline,proto3,stdtime" json:"deadline"`
+	ExecutedQuantity    github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,9,opt,name=executed_quantity,json=executedQuantity,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"executed_quantity"`
+	Paid                types.DecCoin                          `protobuf:"bytes,10,opt,name=paid,proto3" json:"paid"`
+	Received            types.DecCoin                          `protobuf:"bytes,11,opt,name=received,proto3" json:"received"`
+	TimeInForce         TimeInForce                            `protobuf:"varint,12,opt,name=time_in_force,json=timeInForce,proto3,enum=crescent.exchange.v1beta1.TimeInForce" json:"time_in_force,omitempty"`
+	RejectReason        string                                 `protobuf:"bytes,13,opt,name=reject_reason,json=rejectReason,proto3" json:"reject_reason,omitempty"`
+	SelfTradePrevention SelfTradePrevention                    `protobuf:"varint,14,opt,name=self_trade_prevention,json=selfTradePrevention,proto3,enum=crescent.exchange.v1beta1.SelfTradePrevention" json:"self_trade_prevention,omitempty"`
 }
 
 func (m *EventPlaceMMLimitOrder) Reset()         { *m = EventPlaceMMLimitOrder{} }
@@ -209,14 +220,17 @@ func (m *EventPlaceMMLimitOrder) XXX_DiscardUnknown() {
 var xxx_messageInfo_EventPlaceMMLimitOrder proto.InternalMessageInfo
 
 type EventPlaceMMBatchLimitOrder struct {
-	MarketId uint64                                 `protobuf:"varint,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
-	OrderId  uint64                                 `protobuf:"varint,2,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
-	Orderer  string                                 `protobuf:"bytes,3,opt,name=orderer,proto3" json:"orderer,omitempty"`
-	IsBuy    bool                                   `protobuf:"varint,4,opt,name=is_buy,json=isBuy,proto3" json:"is_buy,omitempty"`
-	Price    github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=price,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"price"`
-	Quantity github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=quantity,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"quantity"`
-	Lifespan time.Duration                          `protobuf:"bytes,7,opt,name=lifespan,proto3,stdduration" json:"lifespan"`
-	Deadline time.Time                              `protobuf:"bytes,8,opt,name=deadline,proto3,stdtime" json:"deadline"`
+	MarketId            uint64                                 `protobuf:"varint,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	OrderId             uint64                                 `protobuf:"varint,2,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	Orderer             string                                 `protobuf:"bytes,3,opt,name=orderer,proto3" json:"orderer,omitempty"`
+	IsBuy               bool                                   `protobuf:"varint,4,opt,name=is_buy,json=isBuy,proto3" json:"is_buy,omitempty"`
+	Price               github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=price,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"price"`
+	Quantity            github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=quantity,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"quantity"`
+	Lifespan            time.Duration                          `protobuf:"bytes,7,opt,name=lifespan,proto3,stdduration" json:"lifespan"`
+	Deadline            time.Time                              `protobuf:"bytes,8,opt,name=deadline,proto3,stdtime" json:"deadline"`
+	TimeInForce         TimeInForce                            `protobuf:"varint,9,opt,name=time_in_force,json=timeInForce,proto3,enum=crescent.exchange.v1beta1.TimeInForce" json:"time_in_force,omitempty"`
+	RejectReason        string                                 `protobuf:"bytes,10,opt,name=reject_reason,json=rejectReason,proto3" json:"reject_reason,omitempty"`
+	SelfTradePrevention SelfTradePrevention                    `protobuf:"varint,11,opt,name=self_trade_prevention,json=selfTradePrevention,proto3,enum=crescent.exchange.v1beta1.SelfTradePrevention" json:"self_trade_prevention,omitempty"`
 }
 
 func (m *EventPlaceMMBatchLimitOrder) Reset()         { *m = EventPlaceMMBatchLimitOrder{} }
@@ -261,6 +275,7 @@ type EventPlaceMarketOrder struct {
 	ExecutedQuantity github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=executed_quantity,json=executedQuantity,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"executed_quantity"`
 	Paid             types.DecCoin                          `protobuf:"bytes,7,opt,name=paid,proto3" json:"paid"`
 	Received         types.DecCoin                          `protobuf:"bytes,8,opt,name=received,proto3" json:"received"`
+	Referrer         string                                 `protobuf:"bytes,9,opt,name=referrer,proto3" json:"referrer,omitempty"`
 }
 
 func (m *EventPlaceMarketOrder) Reset()         { *m = EventPlaceMarketOrder{} }
@@ -373,19 +388,67 @@ func (m *EventCancelAllOrders) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_EventCancelAllOrders proto.InternalMessageInfo
 
+type EventPlaceTriggerOrder struct {
+	MarketId     uint64                                  `protobuf:"varint,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	OrderId      uint64                                  `protobuf:"varint,2,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	Orderer      string                                  `protobuf:"bytes,3,opt,name=orderer,proto3" json:"orderer,omitempty"`
+	IsBuy        bool                                    `protobuf:"varint,4,opt,name=is_buy,json=isBuy,proto3" json:"is_buy,omitempty"`
+	Condition    TriggerCondition                        `protobuf:"varint,5,opt,name=condition,proto3,enum=crescent.exchange.v1beta1.TriggerCondition" json:"condition,omitempty"`
+	TriggerPrice github_com_cosmos_cosmos_sdk_types.Dec  `protobuf:"bytes,6,opt,name=trigger_price,json=triggerPrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"trigger_price"`
+	Price        *github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,7,opt,name=price,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"price,omitempty"`
+	Quantity     github_com_cosmos_cosmos_sdk_types.Dec  `protobuf:"bytes,8,opt,name=quantity,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"quantity"`
+	Lifespan     time.Duration                           `protobuf:"bytes,9,opt,name=lifespan,proto3,stdduration" json:"lifespan"`
+	Deadline     time.Time                               `protobuf:"bytes,10,opt,name=deadline,proto3,stdtime" json:"deadline"`
+}
+
+func (m *EventPlaceTriggerOrder) Reset()         { *m = EventPlaceTriggerOrder{} }
+func (m *EventPlaceTriggerOrder) String() string { return proto.CompactTextString(m) }
+func (*EventPlaceTriggerOrder) ProtoMessage()    {}
+func (*EventPlaceTriggerOrder) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b894146a4e451bc4, []int{8}
+}
+func (m *EventPlaceTriggerOrder) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventPlaceTriggerOrder) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventPlaceTriggerOrder.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventPlaceTriggerOrder) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventPlaceTriggerOrder.Merge(m, src)
+}
+func (m *EventPlaceTriggerOrder) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventPlaceTriggerOrder) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventPlaceTriggerOrder.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventPlaceTriggerOrder proto.InternalMessageInfo
+
 type EventSwapExactAmountIn struct {
-	Orderer string            `protobuf:"bytes,1,opt,name=orderer,proto3" json:"orderer,omitempty"`
-	Routes  []uint64          `protobuf:"varint,2,rep,packed,name=routes,proto3" json:"routes,omitempty"`
-	Input   types.DecCoin     `protobuf:"bytes,3,opt,name=input,proto3" json:"input"`
-	Output  types.DecCoin     `protobuf:"bytes,4,opt,name=output,proto3" json:"output"`
-	Results []SwapRouteResult `protobuf:"bytes,5,rep,name=results,proto3" json:"results"`
+	Orderer         string                    `protobuf:"bytes,1,opt,name=orderer,proto3" json:"orderer,omitempty"`
+	Routes          []uint64                  `protobuf:"varint,2,rep,packed,name=routes,proto3" json:"routes,omitempty"`
+	Input           types.DecCoin             `protobuf:"bytes,3,opt,name=input,proto3" json:"input"`
+	Output          types.DecCoin             `protobuf:"bytes,4,opt,name=output,proto3" json:"output"`
+	Results         []SwapRouteResult         `protobuf:"bytes,5,rep,name=results,proto3" json:"results"`
+	WeightedResults []WeightedSwapRouteResult `protobuf:"bytes,6,rep,name=weighted_results,json=weightedResults,proto3" json:"weighted_results"`
+	Referrer        string                    `protobuf:"bytes,7,opt,name=referrer,proto3" json:"referrer,omitempty"`
 }
 
 func (m *EventSwapExactAmountIn) Reset()         { *m = EventSwapExactAmountIn{} }
 func (m *EventSwapExactAmountIn) String() string { return proto.CompactTextString(m) }
 func (*EventSwapExactAmountIn) ProtoMessage()    {}
 func (*EventSwapExactAmountIn) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b894146a4e451bc4, []int{8}
+	return fileDescriptor_b894146a4e451bc4, []int{9}
 }
 func (m *EventSwapExactAmountIn) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -414,6 +477,47 @@ func (m *EventSwapExactAmountIn) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_EventSwapExactAmountIn proto.InternalMessageInfo
 
+type EventSwapExactAmountOut struct {
+	Orderer string            `protobuf:"bytes,1,opt,name=orderer,proto3" json:"orderer,omitempty"`
+	Routes  []uint64          `protobuf:"varint,2,rep,packed,name=routes,proto3" json:"routes,omitempty"`
+	Input   types.DecCoin     `protobuf:"bytes,3,opt,name=input,proto3" json:"input"`
+	Output  types.DecCoin     `protobuf:"bytes,4,opt,name=output,proto3" json:"output"`
+	Results []SwapRouteResult `protobuf:"bytes,5,rep,name=results,proto3" json:"results"`
+}
+
+func (m *EventSwapExactAmountOut) Reset()         { *m = EventSwapExactAmountOut{} }
+func (m *EventSwapExactAmountOut) String() string { return proto.CompactTextString(m) }
+func (*EventSwapExactAmountOut) ProtoMessage()    {}
+func (*EventSwapExactAmountOut) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b894146a4e451bc4, []int{10}
+}
+func (m *EventSwapExactAmountOut) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventSwapExactAmountOut) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventSwapExactAmountOut.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventSwapExactAmountOut) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventSwapExactAmountOut.Merge(m, src)
+}
+func (m *EventSwapExactAmountOut) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventSwapExactAmountOut) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventSwapExactAmountOut.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventSwapExactAmountOut proto.InternalMessageInfo
+
 type EventOrderFilled struct {
 	MarketId         uint64                                 `protobuf:"varint,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
 	OrderId          uint64                                 `protobuf:"varint,2,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
@@ -425,13 +529,16 @@ type EventOrderFilled struct {
 	ExecutedQuantity github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,8,opt,name=executed_quantity,json=executedQuantity,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"executed_quantity"`
 	Paid             types.DecCoin                          `protobuf:"bytes,9,opt,name=paid,proto3" json:"paid"`
 	Received         types.DecCoin                          `protobuf:"bytes,10,opt,name=received,proto3" json:"received"`
+	Referrer         string                                 `protobuf:"bytes,11,opt,name=referrer,proto3" json:"referrer,omitempty"`
+	// referral_fee is the share of the taker fee paid to the referrer.
+	ReferralFee types.DecCoin `protobuf:"bytes,12,opt,name=referral_fee,json=referralFee,proto3" json:"referral_fee"`
 }
 
 func (m *EventOrderFilled) Reset()         { *m = EventOrderFilled{} }
 func (m *EventOrderFilled) String() string { return proto.CompactTextString(m) }
 func (*EventOrderFilled) ProtoMessage()    {}
 func (*EventOrderFilled) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b894146a4e451bc4, []int{9}
+	return fileDescriptor_b894146a4e451bc4, []int{11}
 }
 func (m *EventOrderFilled) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -474,7 +581,7 @@ func (m *EventOrderSourceOrdersFilled) Reset()         { *m = EventOrderSourceOr
 func (m *EventOrderSourceOrdersFilled) String() string { return proto.CompactTextString(m) }
 func (*EventOrderSourceOrdersFilled) ProtoMessage()    {}
 func (*EventOrderSourceOrdersFilled) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b894146a4e451bc4, []int{10}
+	return fileDescriptor_b894146a4e451bc4, []int{12}
 }
 func (m *EventOrderSourceOrdersFilled) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -511,7 +618,7 @@ func (m *EventOrderCompleted) Reset()         { *m = EventOrderCompleted{} }
 func (m *EventOrderCompleted) String() string { return proto.CompactTextString(m) }
 func (*EventOrderCompleted) ProtoMessage()    {}
 func (*EventOrderCompleted) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b894146a4e451bc4, []int{11}
+	return fileDescriptor_b894146a4e451bc4, []int{13}
 }
 func (m *EventOrderCompleted) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -540,6 +647,129 @@ func (m *EventOrderCompleted) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_EventOrderCompleted proto.InternalMessageInfo
 
+// EventIcebergOrderReplenished is emitted when the displayed quantity of an
+// iceberg order is replenished from its hidden reserve.
+type EventIcebergOrderReplenished struct {
+	MarketId        uint64                                 `protobuf:"varint,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	OrderId         uint64                                 `protobuf:"varint,2,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	VisibleQuantity github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=visible_quantity,json=visibleQuantity,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"visible_quantity"`
+}
+
+func (m *EventIcebergOrderReplenished) Reset()         { *m = EventIcebergOrderReplenished{} }
+func (m *EventIcebergOrderReplenished) String() string { return proto.CompactTextString(m) }
+func (*EventIcebergOrderReplenished) ProtoMessage()    {}
+func (*EventIcebergOrderReplenished) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b894146a4e451bc4, []int{14}
+}
+func (m *EventIcebergOrderReplenished) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventIcebergOrderReplenished) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventIcebergOrderReplenished.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventIcebergOrderReplenished) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventIcebergOrderReplenished.Merge(m, src)
+}
+func (m *EventIcebergOrderReplenished) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventIcebergOrderReplenished) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventIcebergOrderReplenished.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventIcebergOrderReplenished proto.InternalMessageInfo
+
+type EventTriggerOrderTriggered struct {
+	MarketId       uint64                                 `protobuf:"varint,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	TriggerOrderId uint64                                 `protobuf:"varint,2,opt,name=trigger_order_id,json=triggerOrderId,proto3" json:"trigger_order_id,omitempty"`
+	Orderer        string                                 `protobuf:"bytes,3,opt,name=orderer,proto3" json:"orderer,omitempty"`
+	LastPrice      github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=last_price,json=lastPrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"last_price"`
+	// order_id is the id of the order placed by triggering.
+	OrderId uint64 `protobuf:"varint,5,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+}
+
+func (m *EventTriggerOrderTriggered) Reset()         { *m = EventTriggerOrderTriggered{} }
+func (m *EventTriggerOrderTriggered) String() string { return proto.CompactTextString(m) }
+func (*EventTriggerOrderTriggered) ProtoMessage()    {}
+func (*EventTriggerOrderTriggered) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b894146a4e451bc4, []int{15}
+}
+func (m *EventTriggerOrderTriggered) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventTriggerOrderTriggered) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventTriggerOrderTriggered.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventTriggerOrderTriggered) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventTriggerOrderTriggered.Merge(m, src)
+}
+func (m *EventTriggerOrderTriggered) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventTriggerOrderTriggered) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventTriggerOrderTriggered.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventTriggerOrderTriggered proto.InternalMessageInfo
+
+type EventTriggerOrderFailed struct {
+	MarketId       uint64 `protobuf:"varint,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	TriggerOrderId uint64 `protobuf:"varint,2,opt,name=trigger_order_id,json=triggerOrderId,proto3" json:"trigger_order_id,omitempty"`
+	Orderer        string `protobuf:"bytes,3,opt,name=orderer,proto3" json:"orderer,omitempty"`
+	Reason         string `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (m *EventTriggerOrderFailed) Reset()         { *m = EventTriggerOrderFailed{} }
+func (m *EventTriggerOrderFailed) String() string { return proto.CompactTextString(m) }
+func (*EventTriggerOrderFailed) ProtoMessage()    {}
+func (*EventTriggerOrderFailed) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b894146a4e451bc4, []int{16}
+}
+func (m *EventTriggerOrderFailed) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventTriggerOrderFailed) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventTriggerOrderFailed.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventTriggerOrderFailed) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventTriggerOrderFailed.Merge(m, src)
+}
+func (m *EventTriggerOrderFailed) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventTriggerOrderFailed) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventTriggerOrderFailed.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventTriggerOrderFailed proto.InternalMessageInfo
+
 type EventOrderExpired struct {
 	OrderId uint64 `protobuf:"varint,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
 }
@@ -548,7 +778,7 @@ func (m *EventOrderExpired) Reset()         { *m = EventOrderExpired{} }
 func (m *EventOrderExpired) String() string { return proto.CompactTextString(m) }
 func (*EventOrderExpired) ProtoMessage()    {}
 func (*EventOrderExpired) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b894146a4e451bc4, []int{12}
+	return fileDescriptor_b894146a4e451bc4, []int{17}
 }
 func (m *EventOrderExpired) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -582,13 +812,17 @@ type EventMarketParameterChanged struct {
 	MakerFeeRate        github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=maker_fee_rate,json=makerFeeRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"maker_fee_rate"`
 	TakerFeeRate        github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=taker_fee_rate,json=takerFeeRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"taker_fee_rate"`
 	OrderSourceFeeRatio github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=order_source_fee_ratio,json=orderSourceFeeRatio,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"order_source_fee_ratio"`
+	TickSize            github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=tick_size,json=tickSize,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"tick_size"`
+	MinOrderQuantity    github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=min_order_quantity,json=minOrderQuantity,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"min_order_quantity"`
+	LotSize             github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,7,opt,name=lot_size,json=lotSize,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"lot_size"`
+	SelfTradePrevention SelfTradePrevention                    `protobuf:"varint,8,opt,name=self_trade_prevention,json=selfTradePrevention,proto3,enum=crescent.exchange.v1beta1.SelfTradePrevention" json:"self_trade_prevention,omitempty"`
 }
 
 func (m *EventMarketParameterChanged) Reset()         { *m = EventMarketParameterChanged{} }
 func (m *EventMarketParameterChanged) String() string { return proto.CompactTextString(m) }
 func (*EventMarketParameterChanged) ProtoMessage()    {}
 func (*EventMarketParameterChanged) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b894146a4e451bc4, []int{13}
+	return fileDescriptor_b894146a4e451bc4, []int{18}
 }
 func (m *EventMarketParameterChanged) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -617,261 +851,554 @@ func (m *EventMarketParameterChanged) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_EventMarketParameterChanged proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*EventCreateMarket)(nil), "crescent.exchange.v1beta1.EventCreateMarket")
-	proto.RegisterType((*EventPlaceLimitOrder)(nil), "crescent.exchange.v1beta1.EventPlaceLimitOrder")
-	proto.RegisterType((*EventPlaceBatchLimitOrder)(nil), "crescent.exchange.v1beta1.EventPlaceBatchLimitOrder")
-	proto.RegisterType((*EventPlaceMMLimitOrder)(nil), "crescent.exchange.v1beta1.EventPlaceMMLimitOrder")
-	proto.RegisterType((*EventPlaceMMBatchLimitOrder)(nil), "crescent.exchange.v1beta1.EventPlaceMMBatchLimitOrder")
-	proto.RegisterType((*EventPlaceMarketOrder)(nil), "crescent.exchange.v1beta1.EventPlaceMarketOrder")
-	proto.RegisterType((*EventCancelOrder)(nil), "crescent.exchange.v1beta1.EventCancelOrder")
-	proto.RegisterType((*EventCancelAllOrders)(nil), "crescent.exchange.v1beta1.EventCancelAllOrders")
-	proto.RegisterType((*EventSwapExactAmountIn)(nil), "crescent.exchange.v1beta1.EventSwapExactAmountIn")
-	proto.RegisterType((*EventOrderFilled)(nil), "crescent.exchange.v1beta1.EventOrderFilled")
-	proto.RegisterType((*EventOrderSourceOrdersFilled)(nil), "crescent.exchange.v1beta1.EventOrderSourceOrdersFilled")
-	proto.RegisterType((*EventOrderCompleted)(nil), "crescent.exchange.v1beta1.EventOrderCompleted")
-	proto.RegisterType((*EventOrderExpired)(nil), "crescent.exchange.v1beta1.EventOrderExpired")
-	proto.RegisterType((*EventMarketParameterChanged)(nil), "crescent.exchange.v1beta1.EventMarketParameterChanged")
+type EventMarketStatusChanged struct {
+	MarketId uint64       `protobuf:"varint,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	Status   MarketStatus `protobuf:"varint,2,opt,name=status,proto3,enum=crescent.exchange.v1beta1.MarketStatus" json:"status,omitempty"`
+	// cancelled_order_ids is the list of orders cancelled due to the market
+	// being delisted.
+	CancelledOrderIds []uint64 `protobuf:"varint,3,rep,packed,name=cancelled_order_ids,json=cancelledOrderIds,proto3" json:"cancelled_order_ids,omitempty"`
 }
 
-func init() {
-	proto.RegisterFile("crescent/exchange/v1beta1/event.proto", fileDescriptor_b894146a4e451bc4)
+func (m *EventMarketStatusChanged) Reset()         { *m = EventMarketStatusChanged{} }
+func (m *EventMarketStatusChanged) String() string { return proto.CompactTextString(m) }
+func (*EventMarketStatusChanged) ProtoMessage()    {}
+func (*EventMarketStatusChanged) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b894146a4e451bc4, []int{19}
 }
-
-var fileDescriptor_b894146a4e451bc4 = []byte{
-	// 1019 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x58, 0x4f, 0x6f, 0x1b, 0x45,
-	0x14, 0xf7, 0xfa, 0xef, 0xfa, 0x19, 0x50, 0xb3, 0x69, 0xa3, 0x4d, 0x5a, 0x6c, 0xcb, 0x12, 0xc8,
-	0x42, 0xea, 0x2e, 0x2d, 0x02, 0x55, 0x1c, 0x80, 0x3a, 0x49, 0x51, 0x2a, 0x42, 0xdb, 0x4d, 0xc5,
-	0x01, 0x0e, 0xd6, 0x78, 0xf7, 0xc5, 0x19, 0xc5, 0xbb, 0xb3, 0x9d, 0x9d, 0x4d, 0x9d, 0x03, 0x5f,
-	0x00, 0x71, 0xe8, 0x11, 0xc4, 0x89, 0x4f, 0xc1, 0x57, 0x88, 0xc4, 0xa5, 0x47, 0xc4, 0xa1, 0x40,
-	0x72, 0x42, 0x7c, 0x09, 0x34, 0xb3, 0xbb, 0xfe, 0x53, 0x5a, 0x93, 0x38, 0x01, 0x45, 0x6a, 0x4e,
-	0xde, 0x99, 0x79, 0xbf, 0xdf, 0xbc, 0x79, 0xef, 0xf7, 0xde, 0xce, 0x1a, 0xde, 0x72, 0x39, 0x46,
-	0x2e, 0x06, 0xc2, 0xc6, 0xa1, 0xbb, 0x43, 0x82, 0x3e, 0xda, 0x7b, 0x37, 0x7a, 0x28, 0xc8, 0x0d,
-	0x1b, 0xf7, 0x30, 0x10, 0x56, 0xc8, 0x99, 0x60, 0xc6, 0x72, 0x66, 0x66, 0x65, 0x66, 0x56, 0x6a,
-	0xb6, 0x72, 0xb9, 0xcf, 0xfa, 0x4c, 0x59, 0xd9, 0xf2, 0x29, 0x01, 0xac, 0xd4, 0x5d, 0x16, 0xf9,
-	0x2c, 0xb2, 0x7b, 0x24, 0x1a, 0x33, 0xba, 0x8c, 0x06, 0xe9, 0x7a, 0xa3, 0xcf, 0x58, 0x7f, 0x80,
-	0xb6, 0x1a, 0xf5, 0xe2, 0x6d, 0x5b, 0x50, 0x1f, 0x23, 0x41, 0xfc, 0x30, 0x23, 0x78, 0xde, 0xc0,
-	0x8b, 0x39, 0x11, 0x94, 0x65, 0x04, 0xed, 0x19, 0x8e, 0x67, 0x2e, 0x2a, 0xcb, 0xd6, 0x37, 0x1a,
-	0x2c, 0xac, 0xcb, 0xb3, 0xac, 0x72, 0x24, 0x02, 0x37, 0x09, 0xdf, 0x45, 0x61, 0x98, 0x50, 0x71,
-	0xe5, 0x98, 0x71, 0x53, 0x6b, 0x6a, 0xed, 0xaa, 0x93, 0x0d, 0x8d, 0x37, 0x01, 0xa4, 0xd7, 0x5d,
-	0x0f, 0x03, 0xe6, 0x9b, 0x79, 0xb5, 0x58, 0x95, 0x33, 0x6b, 0x72, 0xc2, 0x68, 0x40, 0xed, 0x51,
-	0xcc, 0x44, 0xb6, 0x5e, 0x50, 0xeb, 0xa0, 0xa6, 0x12, 0x83, 0xab, 0x50, 0xf5, 0xd5, 0x1e, 0x5d,
-	0xea, 0x99, 0xc5, 0xa6, 0xd6, 0x2e, 0x3a, 0x7a, 0x32, 0xb1, 0xe1, 0xb5, 0xfe, 0x2c, 0xc2, 0x65,
-	0xe5, 0xcc, 0xfd, 0x01, 0x71, 0xf1, 0x33, 0xea, 0x53, 0x71, 0x8f, 0x7b, 0xc8, 0xa7, 0x51, 0xda,
-	0x34, 0xca, 0x58, 0x06, 0x9d, 0x49, 0x2b, 0xb9, 0x96, 0x57, 0x6b, 0x15, 0x35, 0xde, 0xf0, 0xe4,
-	0x39, 0xd4, 0x23, 0xf2, 0xd4, 0x95, 0x6c, 0x68, 0x5c, 0x81, 0x32, 0x8d, 0xba, 0xbd, 0x78, 0x5f,
-	0x39, 0xa1, 0x3b, 0x25, 0x1a, 0x75, 0xe2, 0x7d, 0x63, 0x0d, 0x4a, 0x21, 0xa7, 0x2e, 0x9a, 0x25,
-	0x69, 0xde, 0xb1, 0x0e, 0x9e, 0x35, 0x72, 0xbf, 0x3e, 0x6b, 0xbc, 0xdd, 0xa7, 0x62, 0x27, 0xee,
-	0x59, 0x2e, 0xf3, 0xed, 0x34, 0x77, 0xc9, 0xcf, 0xf5, 0xc8, 0xdb, 0xb5, 0xc5, 0x7e, 0x88, 0x91,
-	0xb5, 0x86, 0xae, 0x93, 0x80, 0x8d, 0xbb, 0xa0, 0x3f, 0x8a, 0x49, 0x20, 0xa8, 0xd8, 0x37, 0xcb,
-	0x73, 0x11, 0x8d, 0xf0, 0xc6, 0xc7, 0xa0, 0x0f, 0xe8, 0x36, 0x46, 0x21, 0x09, 0xcc, 0x4a, 0x53,
-	0x6b, 0xd7, 0x6e, 0x2e, 0x5b, 0x49, 0xf6, 0xad, 0x2c, 0xfb, 0xd6, 0x5a, 0x9a, 0xfd, 0x8e, 0x2e,
-	0xb7, 0xf9, 0xee, 0xb7, 0x86, 0xe6, 0x8c, 0x40, 0xc6, 0x27, 0xa0, 0x7b, 0x48, 0xbc, 0x01, 0x0d,
-	0xd0, 0xd4, 0x15, 0xc1, 0xca, 0x3f, 0x08, 0x1e, 0x66, 0xfa, 0x4a, 0x18, 0x9e, 0x28, 0x86, 0x0c,
-	0x65, 0x7c, 0x05, 0x0b, 0x38, 0x44, 0x37, 0x16, 0xe8, 0x75, 0x47, 0xe7, 0xaa, 0xce, 0x75, 0xae,
-	0x4b, 0x19, 0xd1, 0x83, 0xec, 0x7c, 0x1f, 0x40, 0x31, 0x24, 0xd4, 0x33, 0x41, 0xb9, 0x76, 0xcd,
-	0x4a, 0x60, 0x96, 0x94, 0x54, 0x56, 0x45, 0x12, 0xb9, 0xca, 0x68, 0xd0, 0x29, 0xca, 0xdd, 0x1c,
-	0x65, 0x6f, 0x7c, 0x04, 0x3a, 0x47, 0x17, 0xe9, 0x1e, 0x7a, 0x66, 0xed, 0xd8, 0xd8, 0x11, 0xa6,
-	0xf5, 0x7d, 0x01, 0x96, 0xc7, 0x5a, 0xeb, 0x10, 0xe1, 0xee, 0x5c, 0x08, 0xee, 0x7c, 0x08, 0xae,
-	0xf5, 0x57, 0x11, 0x96, 0xc6, 0xb9, 0xd9, 0xdc, 0xbc, 0x48, 0xcc, 0x45, 0x27, 0xf8, 0xef, 0x3a,
-	0xc1, 0x0f, 0x05, 0xb8, 0x3a, 0xa9, 0xb6, 0x8b, 0x5e, 0x70, 0x9e, 0x7a, 0xc1, 0x8f, 0x05, 0xb8,
-	0x32, 0x91, 0x1d, 0x15, 0xf7, 0xff, 0x39, 0x2f, 0x93, 0x11, 0x2d, 0x9d, 0x32, 0xa2, 0x2f, 0xac,
-	0xa0, 0xf2, 0x19, 0x57, 0x50, 0xe5, 0x14, 0x15, 0xa4, 0xcf, 0x51, 0x41, 0x9f, 0xc2, 0xa5, 0xe4,
-	0x0e, 0x49, 0x02, 0x17, 0x07, 0x49, 0x76, 0x26, 0xa2, 0xac, 0x4d, 0x47, 0xf9, 0xe5, 0xa9, 0x69,
-	0x7d, 0x9d, 0xde, 0xff, 0x12, 0xa2, 0xdb, 0x83, 0x84, 0x2b, 0x9a, 0x41, 0x36, 0x25, 0x82, 0xfc,
-	0x73, 0x22, 0xb0, 0x60, 0xd1, 0x55, 0x4c, 0x03, 0xf4, 0xba, 0xd9, 0x9e, 0x91, 0x59, 0x68, 0x16,
-	0xda, 0x45, 0x67, 0x61, 0xb4, 0x74, 0x2f, 0xd9, 0x3d, 0x6a, 0x7d, 0x9b, 0x4f, 0xdf, 0x3b, 0x5b,
-	0x8f, 0x49, 0xb8, 0x3e, 0x24, 0xae, 0xb8, 0xed, 0xb3, 0x38, 0x10, 0x1b, 0xc1, 0x0c, 0x0f, 0x96,
-	0xa0, 0xcc, 0x59, 0x2c, 0x30, 0x32, 0xf3, 0x8a, 0x37, 0x1d, 0x19, 0xb7, 0xa0, 0x44, 0x83, 0x30,
-	0x16, 0x4a, 0x64, 0xc7, 0x8b, 0x68, 0x02, 0x30, 0x3e, 0x84, 0x32, 0x8b, 0x85, 0x84, 0x16, 0x8f,
-	0x0d, 0x4d, 0x11, 0xc6, 0x5d, 0xa8, 0x70, 0x8c, 0xe2, 0x81, 0x88, 0xcc, 0x52, 0xb3, 0xd0, 0xae,
-	0xdd, 0x7c, 0xc7, 0x7a, 0xe9, 0xd7, 0x89, 0x25, 0x8f, 0xe9, 0x48, 0x6f, 0x1d, 0x05, 0x49, 0xa9,
-	0x32, 0x82, 0xd6, 0x4f, 0xc5, 0x34, 0xaf, 0x2a, 0x40, 0x77, 0xa8, 0x8c, 0xd5, 0xab, 0xdc, 0x0d,
-	0xb7, 0xe0, 0x75, 0x16, 0x62, 0x30, 0xae, 0xdb, 0xca, 0x5c, 0x84, 0xaf, 0x49, 0x92, 0x07, 0x33,
-	0x1b, 0x82, 0x7e, 0xc6, 0x0d, 0xa1, 0x7a, 0x8a, 0x86, 0x00, 0x73, 0x34, 0x84, 0xc3, 0x3c, 0x5c,
-	0x1b, 0x2b, 0x67, 0x8b, 0xc5, 0xdc, 0xc5, 0xa4, 0x94, 0x8f, 0xa3, 0xa2, 0x06, 0xd4, 0x22, 0x05,
-	0xe9, 0x06, 0xc4, 0xc7, 0xf4, 0x23, 0x13, 0x92, 0xa9, 0xcf, 0x89, 0x8f, 0x27, 0xd7, 0xd2, 0x0b,
-	0x83, 0x5c, 0x3a, 0xe3, 0x20, 0x97, 0x4f, 0x11, 0xe4, 0xca, 0x1c, 0x41, 0x7e, 0x17, 0x16, 0xc7,
-	0x31, 0x5e, 0x65, 0x7e, 0x38, 0x40, 0x81, 0xd3, 0x35, 0xa8, 0x4d, 0xb7, 0x57, 0x2b, 0xfd, 0xd6,
-	0x57, 0x88, 0xf5, 0x61, 0x48, 0xf9, 0x6c, 0xfb, 0x9f, 0xf3, 0xe9, 0xcd, 0x28, 0x79, 0xed, 0xde,
-	0x27, 0x9c, 0xf8, 0x28, 0x90, 0xaf, 0xaa, 0x36, 0xf2, 0x2f, 0x59, 0x7c, 0x08, 0x6f, 0xf8, 0x64,
-	0x17, 0x79, 0x77, 0x1b, 0xb1, 0xcb, 0x89, 0x48, 0x13, 0x79, 0xf2, 0x72, 0x51, 0x2c, 0x77, 0x10,
-	0x1d, 0x22, 0x50, 0xb2, 0x8a, 0x69, 0xd6, 0xc2, 0x7c, 0xac, 0x62, 0x92, 0xd5, 0x85, 0xa5, 0x24,
-	0x06, 0xa9, 0xee, 0x52, 0x72, 0xca, 0x94, 0x8c, 0x4e, 0xce, 0xbe, 0xc8, 0xc6, 0xba, 0x4f, 0xf6,
-	0xa0, 0xac, 0xf3, 0xc5, 0xc1, 0x1f, 0xf5, 0xdc, 0xc1, 0x61, 0x5d, 0x7b, 0x7a, 0x58, 0xd7, 0x7e,
-	0x3f, 0xac, 0x6b, 0x4f, 0x8e, 0xea, 0xb9, 0xa7, 0x47, 0xf5, 0xdc, 0x2f, 0x47, 0xf5, 0xdc, 0x97,
-	0xb7, 0x26, 0xa9, 0xd3, 0x8e, 0x7d, 0x3d, 0x40, 0xf1, 0x98, 0xf1, 0xdd, 0xd1, 0x84, 0xbd, 0xf7,
-	0xbe, 0x3d, 0x1c, 0xff, 0xa7, 0xa3, 0x36, 0xec, 0x95, 0xd5, 0x4d, 0xea, 0xbd, 0xbf, 0x03, 0x00,
-	0x00, 0xff, 0xff, 0x89, 0x4e, 0x3d, 0x65, 0xae, 0x12, 0x00, 0x00,
+func (m *EventMarketStatusChanged) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *EventCreateMarket) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventMarketStatusChanged) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventMarketStatusChanged.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *EventCreateMarket) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *EventMarketStatusChanged) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventMarketStatusChanged.Merge(m, src)
 }
-
-func (m *EventCreateMarket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.MarketId != 0 {
-		i = encodeVarintEvent(dAtA, i, uint64(m.MarketId))
-		i--
-		dAtA[i] = 0x20
-	}
-	if len(m.QuoteDenom) > 0 {
-		i -= len(m.QuoteDenom)
-		copy(dAtA[i:], m.QuoteDenom)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.QuoteDenom)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.BaseDenom) > 0 {
-		i -= len(m.BaseDenom)
-		copy(dAtA[i:], m.BaseDenom)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.BaseDenom)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (m *EventMarketStatusChanged) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *EventPlaceLimitOrder) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *EventMarketStatusChanged) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventMarketStatusChanged.DiscardUnknown(m)
 }
 
-func (m *EventPlaceLimitOrder) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_EventMarketStatusChanged proto.InternalMessageInfo
+
+// EventBatchMatched summarizes the orders matched in a market's batch
+// matching.
+type EventBatchMatched struct {
+	MarketId        uint64                                 `protobuf:"varint,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	ClearingPrice   github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=clearing_price,json=clearingPrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"clearing_price"`
+	MatchedQuantity github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=matched_quantity,json=matchedQuantity,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"matched_quantity"`
+	MatchedQuote    github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=matched_quote,json=matchedQuote,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"matched_quote"`
+	// base_fee and quote_fee are the net fees collected in each denom, which
+	// can be negative due to maker rebates.
+	BaseFee  github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=base_fee,json=baseFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"base_fee"`
+	QuoteFee github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=quote_fee,json=quoteFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"quote_fee"`
+	// num_filled_orders is the number of matched orders including the orders
+	// from order sources.
+	NumFilledOrders uint32 `protobuf:"varint,7,opt,name=num_filled_orders,json=numFilledOrders,proto3" json:"num_filled_orders,omitempty"`
+	// order_source_quantity is the quantity executed by order sources on both
+	// sides.
+	OrderSourceQuantity github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,8,opt,name=order_source_quantity,json=orderSourceQuantity,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"order_source_quantity"`
 }
 
-func (m *EventPlaceLimitOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Received.MarshalToSizedBuffer(dAtA[:i])
+func (m *EventBatchMatched) Reset()         { *m = EventBatchMatched{} }
+func (m *EventBatchMatched) String() string { return proto.CompactTextString(m) }
+func (*EventBatchMatched) ProtoMessage()    {}
+func (*EventBatchMatched) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b894146a4e451bc4, []int{20}
+}
+func (m *EventBatchMatched) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventBatchMatched) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventBatchMatched.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintEvent(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x5a
-	{
-		size, err := m.Paid.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintEvent(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x52
-	{
-		size := m.ExecutedQuantity.Size()
-		i -= size
-		if _, err := m.ExecutedQuantity.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+}
+func (m *EventBatchMatched) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventBatchMatched.Merge(m, src)
+}
+func (m *EventBatchMatched) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventBatchMatched) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventBatchMatched.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventBatchMatched proto.InternalMessageInfo
+
+type EventCircuitBreakerTripped struct {
+	MarketId uint64 `protobuf:"varint,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	// reference_price is the price within the window the last price moved away
+	// from.
+	ReferencePrice github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=reference_price,json=referencePrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"reference_price"`
+	LastPrice      github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=last_price,json=lastPrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"last_price"`
+	EndHeight      int64                                  `protobuf:"varint,4,opt,name=end_height,json=endHeight,proto3" json:"end_height,omitempty"`
+}
+
+func (m *EventCircuitBreakerTripped) Reset()         { *m = EventCircuitBreakerTripped{} }
+func (m *EventCircuitBreakerTripped) String() string { return proto.CompactTextString(m) }
+func (*EventCircuitBreakerTripped) ProtoMessage()    {}
+func (*EventCircuitBreakerTripped) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b894146a4e451bc4, []int{21}
+}
+func (m *EventCircuitBreakerTripped) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventCircuitBreakerTripped) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventCircuitBreakerTripped.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintEvent(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x4a
-	n3, err3 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Deadline, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Deadline):])
-	if err3 != nil {
-		return 0, err3
-	}
-	i -= n3
-	i = encodeVarintEvent(dAtA, i, uint64(n3))
-	i--
-	dAtA[i] = 0x42
-	n4, err4 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Lifespan, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Lifespan):])
-	if err4 != nil {
-		return 0, err4
+		return b[:n], nil
 	}
-	i -= n4
-	i = encodeVarintEvent(dAtA, i, uint64(n4))
-	i--
-	dAtA[i] = 0x3a
-	{
-		size := m.Quantity.Size()
-		i -= size
-		if _, err := m.Quantity.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+}
+func (m *EventCircuitBreakerTripped) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventCircuitBreakerTripped.Merge(m, src)
+}
+func (m *EventCircuitBreakerTripped) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventCircuitBreakerTripped) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventCircuitBreakerTripped.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventCircuitBreakerTripped proto.InternalMessageInfo
+
+type EventCircuitBreakerReset struct {
+	MarketId uint64 `protobuf:"varint,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	// last_price is the market's last price after the reopening auction.
+	LastPrice *github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=last_price,json=lastPrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"last_price,omitempty"`
+}
+
+func (m *EventCircuitBreakerReset) Reset()         { *m = EventCircuitBreakerReset{} }
+func (m *EventCircuitBreakerReset) String() string { return proto.CompactTextString(m) }
+func (*EventCircuitBreakerReset) ProtoMessage()    {}
+func (*EventCircuitBreakerReset) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b894146a4e451bc4, []int{22}
+}
+func (m *EventCircuitBreakerReset) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventCircuitBreakerReset) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventCircuitBreakerReset.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintEvent(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x32
-	{
-		size := m.Price.Size()
-		i -= size
-		if _, err := m.Price.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+}
+func (m *EventCircuitBreakerReset) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventCircuitBreakerReset.Merge(m, src)
+}
+func (m *EventCircuitBreakerReset) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventCircuitBreakerReset) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventCircuitBreakerReset.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventCircuitBreakerReset proto.InternalMessageInfo
+
+type EventAmendOrder struct {
+	MarketId     uint64                                 `protobuf:"varint,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	OrderId      uint64                                 `protobuf:"varint,2,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	Orderer      string                                 `protobuf:"bytes,3,opt,name=orderer,proto3" json:"orderer,omitempty"`
+	Price        github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=price,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"price"`
+	Quantity     github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=quantity,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"quantity"`
+	OpenQuantity github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=open_quantity,json=openQuantity,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"open_quantity"`
+	Deadline     time.Time                              `protobuf:"bytes,7,opt,name=deadline,proto3,stdtime" json:"deadline"`
+	// requeued is true when the order has lost its priority.
+	Requeued bool `protobuf:"varint,8,opt,name=requeued,proto3" json:"requeued,omitempty"`
+}
+
+func (m *EventAmendOrder) Reset()         { *m = EventAmendOrder{} }
+func (m *EventAmendOrder) String() string { return proto.CompactTextString(m) }
+func (*EventAmendOrder) ProtoMessage()    {}
+func (*EventAmendOrder) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b894146a4e451bc4, []int{23}
+}
+func (m *EventAmendOrder) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventAmendOrder) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventAmendOrder.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintEvent(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x2a
-	if m.IsBuy {
-		i--
-		if m.IsBuy {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+}
+func (m *EventAmendOrder) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventAmendOrder.Merge(m, src)
+}
+func (m *EventAmendOrder) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventAmendOrder) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventAmendOrder.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventAmendOrder proto.InternalMessageInfo
+
+type EventSetCancelAfter struct {
+	Orderer   string   `protobuf:"bytes,1,opt,name=orderer,proto3" json:"orderer,omitempty"`
+	MarketIds []uint64 `protobuf:"varint,2,rep,packed,name=market_ids,json=marketIds,proto3" json:"market_ids,omitempty"`
+	// cancel_after is null when the timers are removed.
+	CancelAfter *time.Time `protobuf:"bytes,3,opt,name=cancel_after,json=cancelAfter,proto3,stdtime" json:"cancel_after,omitempty"`
+}
+
+func (m *EventSetCancelAfter) Reset()         { *m = EventSetCancelAfter{} }
+func (m *EventSetCancelAfter) String() string { return proto.CompactTextString(m) }
+func (*EventSetCancelAfter) ProtoMessage()    {}
+func (*EventSetCancelAfter) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b894146a4e451bc4, []int{24}
+}
+func (m *EventSetCancelAfter) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventSetCancelAfter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventSetCancelAfter.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x20
+		return b[:n], nil
 	}
-	if len(m.Orderer) > 0 {
-		i -= len(m.Orderer)
-		copy(dAtA[i:], m.Orderer)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.Orderer)))
-		i--
-		dAtA[i] = 0x1a
+}
+func (m *EventSetCancelAfter) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventSetCancelAfter.Merge(m, src)
+}
+func (m *EventSetCancelAfter) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventSetCancelAfter) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventSetCancelAfter.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventSetCancelAfter proto.InternalMessageInfo
+
+type EventCancelAfterTriggered struct {
+	Orderer           string   `protobuf:"bytes,1,opt,name=orderer,proto3" json:"orderer,omitempty"`
+	MarketId          uint64   `protobuf:"varint,2,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	CancelledOrderIds []uint64 `protobuf:"varint,3,rep,packed,name=cancelled_order_ids,json=cancelledOrderIds,proto3" json:"cancelled_order_ids,omitempty"`
+}
+
+func (m *EventCancelAfterTriggered) Reset()         { *m = EventCancelAfterTriggered{} }
+func (m *EventCancelAfterTriggered) String() string { return proto.CompactTextString(m) }
+func (*EventCancelAfterTriggered) ProtoMessage()    {}
+func (*EventCancelAfterTriggered) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b894146a4e451bc4, []int{25}
+}
+func (m *EventCancelAfterTriggered) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventCancelAfterTriggered) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventCancelAfterTriggered.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.OrderId != 0 {
-		i = encodeVarintEvent(dAtA, i, uint64(m.OrderId))
-		i--
-		dAtA[i] = 0x10
+}
+func (m *EventCancelAfterTriggered) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventCancelAfterTriggered.Merge(m, src)
+}
+func (m *EventCancelAfterTriggered) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventCancelAfterTriggered) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventCancelAfterTriggered.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventCancelAfterTriggered proto.InternalMessageInfo
+
+type EventReplaceMMOrders struct {
+	Orderer           string   `protobuf:"bytes,1,opt,name=orderer,proto3" json:"orderer,omitempty"`
+	MarketId          uint64   `protobuf:"varint,2,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	CancelledOrderIds []uint64 `protobuf:"varint,3,rep,packed,name=cancelled_order_ids,json=cancelledOrderIds,proto3" json:"cancelled_order_ids,omitempty"`
+	OrderIds          []uint64 `protobuf:"varint,4,rep,packed,name=order_ids,json=orderIds,proto3" json:"order_ids,omitempty"`
+}
+
+func (m *EventReplaceMMOrders) Reset()         { *m = EventReplaceMMOrders{} }
+func (m *EventReplaceMMOrders) String() string { return proto.CompactTextString(m) }
+func (*EventReplaceMMOrders) ProtoMessage()    {}
+func (*EventReplaceMMOrders) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b894146a4e451bc4, []int{26}
+}
+func (m *EventReplaceMMOrders) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventReplaceMMOrders) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventReplaceMMOrders.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.MarketId != 0 {
-		i = encodeVarintEvent(dAtA, i, uint64(m.MarketId))
-		i--
-		dAtA[i] = 0x8
+}
+func (m *EventReplaceMMOrders) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventReplaceMMOrders.Merge(m, src)
+}
+func (m *EventReplaceMMOrders) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventReplaceMMOrders) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventReplaceMMOrders.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventReplaceMMOrders proto.InternalMessageInfo
+
+// EventSelfTradePrevented is emitted when a match between orders from the same
+// orderer is prevented.
+// Order ids are 0 for market orders, swaps and order source orders.
+type EventSelfTradePrevented struct {
+	MarketId         uint64              `protobuf:"varint,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	Orderer          string              `protobuf:"bytes,2,opt,name=orderer,proto3" json:"orderer,omitempty"`
+	Mode             SelfTradePrevention `protobuf:"varint,3,opt,name=mode,proto3,enum=crescent.exchange.v1beta1.SelfTradePrevention" json:"mode,omitempty"`
+	NewestOrderId    uint64              `protobuf:"varint,4,opt,name=newest_order_id,json=newestOrderId,proto3" json:"newest_order_id,omitempty"`
+	NewestSourceName string              `protobuf:"bytes,5,opt,name=newest_source_name,json=newestSourceName,proto3" json:"newest_source_name,omitempty"`
+	OldestOrderId    uint64              `protobuf:"varint,6,opt,name=oldest_order_id,json=oldestOrderId,proto3" json:"oldest_order_id,omitempty"`
+	OldestSourceName string              `protobuf:"bytes,7,opt,name=oldest_source_name,json=oldestSourceName,proto3" json:"oldest_source_name,omitempty"`
+	// quantity is the quantity which would have been matched.
+	Quantity github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,8,opt,name=quantity,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"quantity"`
+}
+
+func (m *EventSelfTradePrevented) Reset()         { *m = EventSelfTradePrevented{} }
+func (m *EventSelfTradePrevented) String() string { return proto.CompactTextString(m) }
+func (*EventSelfTradePrevented) ProtoMessage()    {}
+func (*EventSelfTradePrevented) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b894146a4e451bc4, []int{27}
+}
+func (m *EventSelfTradePrevented) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventSelfTradePrevented) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventSelfTradePrevented.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *EventSelfTradePrevented) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventSelfTradePrevented.Merge(m, src)
+}
+func (m *EventSelfTradePrevented) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventSelfTradePrevented) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventSelfTradePrevented.DiscardUnknown(m)
 }
 
-func (m *EventPlaceBatchLimitOrder) Marshal() (dAtA []byte, err error) {
+var xxx_messageInfo_EventSelfTradePrevented proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*EventCreateMarket)(nil), "crescent.exchange.v1beta1.EventCreateMarket")
+	proto.RegisterType((*EventPlaceLimitOrder)(nil), "crescent.exchange.v1beta1.EventPlaceLimitOrder")
+	proto.RegisterType((*EventPlaceBatchLimitOrder)(nil), "crescent.exchange.v1beta1.EventPlaceBatchLimitOrder")
+	proto.RegisterType((*EventPlaceMMLimitOrder)(nil), "crescent.exchange.v1beta1.EventPlaceMMLimitOrder")
+	proto.RegisterType((*EventPlaceMMBatchLimitOrder)(nil), "crescent.exchange.v1beta1.EventPlaceMMBatchLimitOrder")
+	proto.RegisterType((*EventPlaceMarketOrder)(nil), "crescent.exchange.v1beta1.EventPlaceMarketOrder")
+	proto.RegisterType((*EventCancelOrder)(nil), "crescent.exchange.v1beta1.EventCancelOrder")
+	proto.RegisterType((*EventCancelAllOrders)(nil), "crescent.exchange.v1beta1.EventCancelAllOrders")
+	proto.RegisterType((*EventPlaceTriggerOrder)(nil), "crescent.exchange.v1beta1.EventPlaceTriggerOrder")
+	proto.RegisterType((*EventSwapExactAmountIn)(nil), "crescent.exchange.v1beta1.EventSwapExactAmountIn")
+	proto.RegisterType((*EventSwapExactAmountOut)(nil), "crescent.exchange.v1beta1.EventSwapExactAmountOut")
+	proto.RegisterType((*EventOrderFilled)(nil), "crescent.exchange.v1beta1.EventOrderFilled")
+	proto.RegisterType((*EventOrderSourceOrdersFilled)(nil), "crescent.exchange.v1beta1.EventOrderSourceOrdersFilled")
+	proto.RegisterType((*EventOrderCompleted)(nil), "crescent.exchange.v1beta1.EventOrderCompleted")
+	proto.RegisterType((*EventIcebergOrderReplenished)(nil), "crescent.exchange.v1beta1.EventIcebergOrderReplenished")
+	proto.RegisterType((*EventTriggerOrderTriggered)(nil), "crescent.exchange.v1beta1.EventTriggerOrderTriggered")
+	proto.RegisterType((*EventTriggerOrderFailed)(nil), "crescent.exchange.v1beta1.EventTriggerOrderFailed")
+	proto.RegisterType((*EventOrderExpired)(nil), "crescent.exchange.v1beta1.EventOrderExpired")
+	proto.RegisterType((*EventMarketParameterChanged)(nil), "crescent.exchange.v1beta1.EventMarketParameterChanged")
+	proto.RegisterType((*EventMarketStatusChanged)(nil), "crescent.exchange.v1beta1.EventMarketStatusChanged")
+	proto.RegisterType((*EventBatchMatched)(nil), "crescent.exchange.v1beta1.EventBatchMatched")
+	proto.RegisterType((*EventCircuitBreakerTripped)(nil), "crescent.exchange.v1beta1.EventCircuitBreakerTripped")
+	proto.RegisterType((*EventCircuitBreakerReset)(nil), "crescent.exchange.v1beta1.EventCircuitBreakerReset")
+	proto.RegisterType((*EventAmendOrder)(nil), "crescent.exchange.v1beta1.EventAmendOrder")
+	proto.RegisterType((*EventSetCancelAfter)(nil), "crescent.exchange.v1beta1.EventSetCancelAfter")
+	proto.RegisterType((*EventCancelAfterTriggered)(nil), "crescent.exchange.v1beta1.EventCancelAfterTriggered")
+	proto.RegisterType((*EventReplaceMMOrders)(nil), "crescent.exchange.v1beta1.EventReplaceMMOrders")
+	proto.RegisterType((*EventSelfTradePrevented)(nil), "crescent.exchange.v1beta1.EventSelfTradePrevented")
+}
+
+func init() {
+	proto.RegisterFile("crescent/exchange/v1beta1/event.proto", fileDescriptor_b894146a4e451bc4)
+}
+
+var fileDescriptor_b894146a4e451bc4 = []byte{
+	// 1951 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x5a, 0xcb, 0x6f, 0x24, 0x57,
+	0xf5, 0x76, 0xb9, 0xdf, 0xa7, 0xfd, 0xe8, 0xa9, 0x79, 0xfc, 0xca, 0x4e, 0xc6, 0xb6, 0xfa, 0x27,
+	0x06, 0x6b, 0x20, 0xdd, 0xc4, 0x08, 0x14, 0xb1, 0x20, 0x19, 0x7b, 0xc6, 0xe0, 0x01, 0x33, 0x33,
+	0xd5, 0x0e, 0x51, 0x00, 0xa9, 0x54, 0x5d, 0x75, 0xba, 0x7d, 0x71, 0x55, 0xdd, 0x9e, 0xaa, 0x5b,
+	0x7e, 0x44, 0x42, 0x42, 0x2c, 0x11, 0x91, 0x22, 0xd8, 0xa0, 0xec, 0x91, 0xb2, 0x0d, 0x7b, 0x24,
+	0x96, 0xb3, 0xcc, 0x12, 0xb1, 0x48, 0x60, 0x66, 0xc1, 0x0e, 0xf1, 0x27, 0xa0, 0xfb, 0xa8, 0xae,
+	0x6a, 0x8f, 0xa7, 0xed, 0x7e, 0xc4, 0x42, 0x8a, 0x37, 0x33, 0x55, 0xb7, 0xee, 0xf9, 0xee, 0xb9,
+	0xf7, 0x7c, 0xe7, 0xbb, 0xf7, 0x9e, 0x36, 0x7c, 0xcd, 0x09, 0x31, 0x72, 0x30, 0x60, 0x4d, 0x3c,
+	0x76, 0xf6, 0xed, 0xa0, 0x8b, 0xcd, 0xc3, 0x37, 0xdb, 0xc8, 0xec, 0x37, 0x9b, 0x78, 0x88, 0x01,
+	0x6b, 0xf4, 0x42, 0xca, 0xa8, 0xbe, 0x94, 0x74, 0x6b, 0x24, 0xdd, 0x1a, 0xaa, 0xdb, 0xf2, 0x8d,
+	0x2e, 0xed, 0x52, 0xd1, 0xab, 0xc9, 0x9f, 0xa4, 0xc1, 0xf2, 0x8a, 0x43, 0x23, 0x9f, 0x46, 0xcd,
+	0xb6, 0x1d, 0xa5, 0x88, 0x0e, 0x25, 0x81, 0xfa, 0xbe, 0xda, 0xa5, 0xb4, 0xeb, 0x61, 0x53, 0xbc,
+	0xb5, 0xe3, 0x4e, 0x93, 0x11, 0x1f, 0x23, 0x66, 0xfb, 0xbd, 0x04, 0xe0, 0x74, 0x07, 0x37, 0x0e,
+	0x6d, 0x46, 0x68, 0x02, 0xb0, 0x3e, 0xc4, 0xf1, 0xc4, 0x45, 0xd1, 0xb3, 0xfe, 0x5b, 0x0d, 0xae,
+	0x3d, 0xe0, 0x73, 0xd9, 0x0a, 0xd1, 0x66, 0xb8, 0x6b, 0x87, 0x07, 0xc8, 0x74, 0x03, 0x4a, 0x0e,
+	0x7f, 0xa7, 0xa1, 0xa1, 0xad, 0x69, 0xeb, 0x15, 0x33, 0x79, 0xd5, 0x6f, 0x03, 0x70, 0xaf, 0x2d,
+	0x17, 0x03, 0xea, 0x1b, 0xb3, 0xe2, 0x63, 0x85, 0xb7, 0xdc, 0xe7, 0x0d, 0xfa, 0x2a, 0x54, 0x9f,
+	0xc6, 0x94, 0x25, 0xdf, 0x73, 0xe2, 0x3b, 0x88, 0x26, 0xd9, 0xe1, 0x35, 0xa8, 0xf8, 0x62, 0x0c,
+	0x8b, 0xb8, 0x46, 0x7e, 0x4d, 0x5b, 0xcf, 0x9b, 0x65, 0xd9, 0xb0, 0xe3, 0xd6, 0x3f, 0x2d, 0xc1,
+	0x0d, 0xe1, 0xcc, 0x63, 0xcf, 0x76, 0xf0, 0xc7, 0xc4, 0x27, 0xec, 0x51, 0xe8, 0x62, 0x38, 0x68,
+	0xa5, 0x0d, 0x5a, 0xe9, 0x4b, 0x50, 0xa6, 0xbc, 0x17, 0xff, 0x36, 0x2b, 0xbe, 0x95, 0xc4, 0xfb,
+	0x8e, 0xcb, 0xe7, 0x21, 0x1e, 0x31, 0x54, 0xae, 0x24, 0xaf, 0xfa, 0x4d, 0x28, 0x92, 0xc8, 0x6a,
+	0xc7, 0x27, 0xc2, 0x89, 0xb2, 0x59, 0x20, 0xd1, 0x66, 0x7c, 0xa2, 0xdf, 0x87, 0x42, 0x2f, 0x24,
+	0x0e, 0x1a, 0x05, 0xde, 0x7d, 0xb3, 0xf1, 0xec, 0xf3, 0xd5, 0x99, 0xbf, 0x7f, 0xbe, 0x7a, 0xa7,
+	0x4b, 0xd8, 0x7e, 0xdc, 0x6e, 0x38, 0xd4, 0x6f, 0xaa, 0xd8, 0xc9, 0xff, 0xde, 0x88, 0xdc, 0x83,
+	0x26, 0x3b, 0xe9, 0x61, 0xd4, 0xb8, 0x8f, 0x8e, 0x29, 0x8d, 0xf5, 0x87, 0x50, 0x7e, 0x1a, 0xdb,
+	0x01, 0x23, 0xec, 0xc4, 0x28, 0x8e, 0x05, 0xd4, 0xb7, 0xd7, 0xdf, 0x86, 0xb2, 0x47, 0x3a, 0x18,
+	0xf5, 0xec, 0xc0, 0x28, 0xad, 0x69, 0xeb, 0xd5, 0x8d, 0xa5, 0x86, 0x8c, 0x7e, 0x23, 0x89, 0x7e,
+	0xe3, 0xbe, 0x8a, 0xfe, 0x66, 0x99, 0x0f, 0xf3, 0xc7, 0x2f, 0x56, 0x35, 0xb3, 0x6f, 0xa4, 0xbf,
+	0x03, 0x65, 0x17, 0x6d, 0xd7, 0x23, 0x01, 0x1a, 0x65, 0x01, 0xb0, 0xfc, 0x12, 0xc0, 0x5e, 0xc2,
+	0x2f, 0x89, 0xf0, 0x91, 0x40, 0x48, 0xac, 0xf4, 0x9f, 0xc3, 0x35, 0x3c, 0x46, 0x27, 0x66, 0xe8,
+	0x5a, 0xfd, 0x79, 0x55, 0xc6, 0x9a, 0x57, 0x2d, 0x01, 0x7a, 0x92, 0xcc, 0xef, 0xbb, 0x90, 0xef,
+	0xd9, 0xc4, 0x35, 0x40, 0xb8, 0xf6, 0x7a, 0x43, 0x9a, 0x35, 0x38, 0xa5, 0x92, 0x2c, 0xe2, 0x96,
+	0x5b, 0x94, 0x04, 0x9b, 0x79, 0x3e, 0x9a, 0x29, 0xfa, 0xeb, 0xdf, 0x87, 0x72, 0x88, 0x0e, 0x92,
+	0x43, 0x74, 0x8d, 0xea, 0x85, 0x6d, 0xfb, 0x36, 0xfa, 0x43, 0x98, 0xe7, 0x59, 0x65, 0x91, 0xc0,
+	0xea, 0xd0, 0xd0, 0x41, 0x63, 0x6e, 0x4d, 0x5b, 0x5f, 0xd8, 0xb8, 0xd3, 0x78, 0x65, 0x32, 0x8b,
+	0x55, 0xda, 0x09, 0xb6, 0x79, 0x6f, 0xb3, 0xca, 0xd2, 0x17, 0xfd, 0xff, 0x61, 0x3e, 0xc4, 0x5f,
+	0xa2, 0xc3, 0xac, 0x10, 0xed, 0x88, 0x06, 0xc6, 0xbc, 0x20, 0xdb, 0x9c, 0x6c, 0x34, 0x45, 0x9b,
+	0xde, 0x86, 0x9b, 0x11, 0x7a, 0x1d, 0x8b, 0x85, 0xb6, 0x8b, 0x56, 0x2f, 0x14, 0x0a, 0x42, 0x68,
+	0x60, 0x2c, 0x88, 0x81, 0x1b, 0x43, 0x06, 0x6e, 0xa1, 0xd7, 0xd9, 0xe3, 0x66, 0x8f, 0xfb, 0x56,
+	0xe6, 0xf5, 0xe8, 0xe5, 0x46, 0x7d, 0x99, 0x2f, 0x4a, 0x07, 0x43, 0x4e, 0xf8, 0x45, 0xe1, 0x43,
+	0xff, 0x5d, 0x7f, 0x17, 0x6a, 0x2e, 0x89, 0x7a, 0x9e, 0x7d, 0x92, 0x06, 0xb1, 0x26, 0x82, 0x78,
+	0x77, 0x84, 0x00, 0x2e, 0x2a, 0x8c, 0x24, 0x7e, 0xf5, 0x7f, 0xe7, 0x61, 0x29, 0xcd, 0xd9, 0x4d,
+	0x9b, 0x39, 0xfb, 0x57, 0x89, 0xfb, 0x3f, 0x92, 0xb8, 0x2f, 0x71, 0xbc, 0x32, 0x45, 0x8e, 0xc3,
+	0x28, 0x1c, 0xaf, 0x4e, 0x8d, 0xe3, 0xf5, 0xbf, 0x16, 0xe1, 0x56, 0x4a, 0xb8, 0xdd, 0xdd, 0x2b,
+	0xb6, 0x5d, 0x6d, 0x13, 0x57, 0xdb, 0xc4, 0x48, 0x29, 0xf4, 0x9f, 0x3c, 0xbc, 0x96, 0x4d, 0xa1,
+	0x2b, 0xd5, 0xbe, 0x52, 0xed, 0x2f, 0x59, 0xb5, 0xff, 0x92, 0x83, 0x9b, 0x19, 0xca, 0x09, 0x32,
+	0x5d, 0x32, 0xd9, 0xb2, 0x34, 0x29, 0x4c, 0x48, 0x93, 0x33, 0xb5, 0xae, 0x38, 0x65, 0xad, 0x2b,
+	0x4d, 0xa0, 0x75, 0xe5, 0x31, 0xb4, 0x2e, 0x7b, 0x7a, 0xac, 0x0c, 0x9e, 0x1e, 0xeb, 0x3f, 0x80,
+	0x9a, 0xbc, 0x26, 0xda, 0x81, 0x83, 0x9e, 0x8c, 0x5c, 0x26, 0x02, 0xda, 0x60, 0x04, 0x5e, 0x1d,
+	0xb6, 0xfa, 0xaf, 0xe0, 0x46, 0x06, 0xe8, 0x9e, 0x27, 0xb1, 0xa2, 0x21, 0x60, 0x03, 0x04, 0x99,
+	0x3d, 0x45, 0x90, 0x06, 0x5c, 0x77, 0x04, 0x92, 0x87, 0xae, 0x95, 0x8c, 0x19, 0x19, 0xb9, 0xb5,
+	0xdc, 0x7a, 0xde, 0xbc, 0xd6, 0xff, 0xf4, 0x48, 0x8e, 0x1e, 0xd5, 0xff, 0x9c, 0xcf, 0x9e, 0x1e,
+	0xf6, 0x42, 0xd2, 0xed, 0x62, 0x78, 0xc9, 0x44, 0xdc, 0x81, 0x8a, 0x43, 0x03, 0x97, 0x88, 0x1c,
+	0x2b, 0x88, 0x1c, 0xfb, 0xc6, 0xb0, 0xe4, 0x96, 0x4e, 0x6e, 0x25, 0x26, 0x66, 0x6a, 0xad, 0xb7,
+	0x60, 0x9e, 0xc9, 0xcf, 0x96, 0x14, 0xd2, 0xf1, 0x38, 0x38, 0xa7, 0x40, 0x1e, 0x0b, 0x3d, 0x7d,
+	0x27, 0x51, 0xe5, 0xd2, 0xc8, 0xd7, 0x83, 0x33, 0x14, 0xb9, 0x3c, 0x45, 0x45, 0xae, 0x4c, 0xaa,
+	0xc8, 0x30, 0x8e, 0x22, 0xd7, 0x3f, 0xcc, 0x29, 0xd2, 0xb4, 0x8e, 0xec, 0xde, 0x83, 0x63, 0xdb,
+	0x61, 0xf7, 0x7c, 0x1a, 0x07, 0x6c, 0x27, 0x18, 0x42, 0xdb, 0x5b, 0x50, 0x0c, 0x69, 0xcc, 0x30,
+	0x32, 0x66, 0x05, 0x19, 0xd5, 0x9b, 0xfe, 0x16, 0x14, 0x48, 0xd0, 0x8b, 0x99, 0x91, 0xbb, 0x70,
+	0x8a, 0x4a, 0x03, 0xfd, 0x7b, 0x50, 0xa4, 0x31, 0xe3, 0xa6, 0xf9, 0x0b, 0x9b, 0x2a, 0x0b, 0xfd,
+	0x21, 0x94, 0x42, 0x8c, 0x62, 0x8f, 0x45, 0x46, 0x61, 0x2d, 0xb7, 0x5e, 0xdd, 0xb8, 0x3b, 0x4c,
+	0xd5, 0x8f, 0xec, 0x9e, 0xc9, 0xbd, 0x35, 0x85, 0x89, 0x82, 0x4a, 0x00, 0x74, 0x07, 0x6a, 0x47,
+	0x48, 0xba, 0xfb, 0x5c, 0xfc, 0x12, 0xd0, 0xa2, 0x00, 0xdd, 0x18, 0x02, 0xfa, 0x9e, 0x32, 0x39,
+	0x1b, 0x7c, 0x31, 0x41, 0x34, 0xd5, 0x20, 0x59, 0x31, 0x2a, 0x9d, 0x12, 0xa3, 0x0f, 0x67, 0xe1,
+	0xff, 0xce, 0x8a, 0xc7, 0xa3, 0x98, 0x7d, 0x15, 0x03, 0x52, 0xff, 0xb8, 0xa0, 0xd4, 0x59, 0x08,
+	0xd9, 0x36, 0xe1, 0x8a, 0xf7, 0x55, 0x3e, 0xc4, 0xb5, 0x60, 0x9e, 0xf6, 0x30, 0x48, 0x77, 0xe6,
+	0xd2, 0x78, 0xaa, 0xc8, 0x41, 0x9e, 0x0c, 0xdd, 0xf2, 0xcb, 0x53, 0xde, 0xf2, 0x2b, 0x13, 0x6c,
+	0xf9, 0x30, 0xe1, 0x96, 0x5f, 0x3d, 0x55, 0x30, 0x7a, 0x00, 0x73, 0xf2, 0xd9, 0xf6, 0xac, 0x0e,
+	0xca, 0x9b, 0xcf, 0xc5, 0xf0, 0xab, 0x89, 0xdd, 0x36, 0x62, 0xfd, 0xf9, 0x2c, 0xbc, 0x9e, 0x92,
+	0xb3, 0x45, 0xe3, 0xd0, 0x41, 0xf1, 0x18, 0x5d, 0x84, 0xa8, 0xab, 0x50, 0x8d, 0x84, 0x89, 0x15,
+	0xd8, 0x3e, 0xaa, 0x82, 0x33, 0xc8, 0xa6, 0x9f, 0xd8, 0x3e, 0x8e, 0x4e, 0xd7, 0x33, 0xe3, 0x58,
+	0x98, 0x72, 0x1c, 0x8b, 0x13, 0xc4, 0xb1, 0x34, 0x7a, 0x1c, 0xeb, 0xdf, 0x82, 0xeb, 0xe9, 0x1a,
+	0x6f, 0x51, 0xbf, 0xe7, 0x21, 0xc3, 0xc1, 0x34, 0xd7, 0x06, 0xcf, 0x61, 0x9f, 0x6a, 0x2a, 0x2c,
+	0x3b, 0x0e, 0xb6, 0x31, 0xec, 0x0a, 0x4b, 0x13, 0x7b, 0x1e, 0x06, 0x24, 0xda, 0x9f, 0x40, 0x3f,
+	0xde, 0x87, 0xda, 0x21, 0x89, 0x48, 0xdb, 0xc3, 0x74, 0x79, 0x73, 0x63, 0x2d, 0xef, 0xa2, 0xc2,
+	0xe9, 0xd7, 0x1a, 0xff, 0xa5, 0xc1, 0xb2, 0xf0, 0x39, 0x7b, 0x6e, 0x53, 0xcf, 0xe7, 0x79, 0xbc,
+	0x0e, 0xb5, 0xe4, 0xa4, 0x74, 0xca, 0xf3, 0x05, 0x96, 0x41, 0x1b, 0x2a, 0x80, 0xbb, 0x00, 0x9e,
+	0x1d, 0x31, 0x75, 0xd4, 0xca, 0x8f, 0x35, 0xa9, 0x0a, 0x47, 0x90, 0xe7, 0xac, 0xec, 0x22, 0x16,
+	0x06, 0xa3, 0xf3, 0x7b, 0x4d, 0xed, 0x70, 0xd9, 0x99, 0x6e, 0xdb, 0xc4, 0xbb, 0x8c, 0x69, 0xf2,
+	0x8d, 0x52, 0xde, 0x16, 0xc5, 0x14, 0x4d, 0xf5, 0x56, 0x6f, 0xa8, 0x9f, 0x8a, 0x04, 0xc2, 0x83,
+	0xe3, 0x1e, 0x09, 0x87, 0x53, 0xec, 0x4f, 0x05, 0x55, 0x66, 0x90, 0xd7, 0xbd, 0xc7, 0x76, 0x68,
+	0xfb, 0xc8, 0x30, 0xdc, 0x12, 0x9b, 0xdb, 0x39, 0x13, 0xd9, 0x83, 0x05, 0xdf, 0x3e, 0xc0, 0x90,
+	0x4b, 0x8f, 0x15, 0xda, 0x4c, 0xe5, 0xfe, 0xe8, 0x22, 0x2e, 0x50, 0xb6, 0x11, 0x4d, 0x9b, 0x21,
+	0x47, 0x65, 0x83, 0xa8, 0xe3, 0x51, 0x73, 0x8e, 0x65, 0x51, 0x1d, 0xb8, 0x25, 0xd7, 0x40, 0x49,
+	0x95, 0x02, 0x27, 0x74, 0x4c, 0x8e, 0x5c, 0xa7, 0xa9, 0x54, 0xca, 0x31, 0x08, 0xd5, 0x7f, 0x04,
+	0x15, 0x46, 0x9c, 0x03, 0x2b, 0x22, 0x1f, 0x8c, 0xbb, 0xd5, 0x96, 0x39, 0x40, 0x8b, 0x7c, 0x80,
+	0xfa, 0x2f, 0x40, 0xf7, 0x49, 0xa0, 0x28, 0x32, 0xe9, 0x05, 0xd6, 0x27, 0x81, 0xa0, 0x44, 0x5f,
+	0x05, 0x77, 0xa0, 0xec, 0x51, 0x26, 0x3d, 0x1d, 0x6f, 0xeb, 0x2d, 0x79, 0x94, 0x09, 0x47, 0x5f,
+	0x59, 0x9b, 0x28, 0x4f, 0xaf, 0x36, 0xf1, 0x89, 0x06, 0x46, 0x86, 0xa7, 0x2d, 0x66, 0xb3, 0x38,
+	0xba, 0x10, 0x49, 0xdf, 0x86, 0x62, 0x24, 0x7a, 0x0b, 0x72, 0x2e, 0x6c, 0x7c, 0x7d, 0x88, 0x3b,
+	0x59, 0x70, 0x53, 0x99, 0x8d, 0x7c, 0x7d, 0xfd, 0x22, 0xaf, 0x72, 0x50, 0x94, 0xec, 0x76, 0xf9,
+	0x3f, 0xe7, 0xf9, 0xf8, 0x2e, 0x2c, 0x38, 0x1e, 0xda, 0x21, 0x09, 0xba, 0x4a, 0xb8, 0xc6, 0x4b,
+	0xa4, 0xf9, 0x04, 0x45, 0x8a, 0xd7, 0xfb, 0x50, 0xf3, 0xe5, 0xf0, 0x13, 0xcb, 0xbc, 0xc2, 0x79,
+	0x92, 0x39, 0xbe, 0xa5, 0xd0, 0x94, 0x8d, 0xab, 0xb4, 0x73, 0x7d, 0x5c, 0xca, 0x90, 0x73, 0x52,
+	0xfc, 0x70, 0xdd, 0xc1, 0x71, 0xb3, 0xa7, 0xc4, 0xed, 0xb7, 0x11, 0x79, 0x26, 0xca, 0x1f, 0xb9,
+	0x39, 0xd6, 0xd8, 0x67, 0x55, 0xca, 0x04, 0xd8, 0x5d, 0xb8, 0x16, 0xc4, 0xbe, 0xd5, 0x21, 0x29,
+	0x05, 0x22, 0x91, 0x34, 0xf3, 0xe6, 0x62, 0x10, 0xfb, 0xdb, 0xa4, 0x1f, 0xff, 0x88, 0x27, 0xc3,
+	0x80, 0xce, 0x4c, 0x78, 0x0c, 0xcd, 0xca, 0x4c, 0x7f, 0x8f, 0xfd, 0xf5, 0xac, 0xda, 0x63, 0xb7,
+	0x48, 0xe8, 0xc4, 0x84, 0x6d, 0x86, 0xc8, 0xa5, 0x6e, 0x2f, 0x24, 0xbd, 0xde, 0x79, 0x54, 0x7b,
+	0x0f, 0x16, 0xc5, 0xc9, 0x0f, 0x03, 0x07, 0x27, 0xe2, 0xda, 0x42, 0x1f, 0x46, 0x92, 0x6d, 0x70,
+	0xe3, 0xcd, 0x4d, 0xba, 0xf1, 0xde, 0x06, 0xc0, 0xc0, 0xb5, 0xf6, 0xc5, 0x95, 0x53, 0xb0, 0x2b,
+	0x67, 0x56, 0x30, 0x70, 0x7f, 0x28, 0x1a, 0xea, 0xbf, 0x49, 0xf4, 0x60, 0x70, 0x09, 0x4c, 0x8c,
+	0x90, 0x0d, 0x5f, 0x80, 0x9d, 0x01, 0x3f, 0x67, 0x47, 0x2e, 0x9f, 0xa4, 0x3e, 0xd6, 0x3f, 0xc9,
+	0xc1, 0xa2, 0x70, 0xe2, 0x9e, 0x8f, 0x81, 0xfb, 0x65, 0x55, 0xa8, 0xfa, 0x77, 0xb7, 0xfc, 0xb4,
+	0xee, 0x6e, 0x85, 0x69, 0xdf, 0xdd, 0x8a, 0x53, 0xb8, 0xbb, 0x65, 0x4b, 0x40, 0xa5, 0xb1, 0x8a,
+	0xf2, 0xe2, 0xa2, 0xf4, 0x34, 0xc6, 0x58, 0xd5, 0x56, 0xcb, 0x66, 0xff, 0xbd, 0xfe, 0x07, 0x4d,
+	0x9d, 0xbe, 0x5b, 0x98, 0x94, 0x35, 0x3b, 0x6c, 0x68, 0x7d, 0xf4, 0x36, 0x40, 0x3f, 0x90, 0x49,
+	0x39, 0xa2, 0x92, 0x44, 0x32, 0xd2, 0xb7, 0x60, 0x4e, 0x4a, 0xbf, 0x65, 0x77, 0x98, 0x0a, 0xda,
+	0x70, 0x97, 0xf3, 0xc2, 0xdd, 0xaa, 0x93, 0x8e, 0xce, 0x59, 0xbc, 0x94, 0xad, 0xb4, 0xf2, 0xc6,
+	0xf4, 0xac, 0x7c, 0x49, 0xe5, 0xd6, 0x8f, 0x35, 0x55, 0xee, 0xe5, 0x37, 0x0b, 0xf1, 0x5b, 0xd3,
+	0xa5, 0x96, 0x7b, 0x39, 0x58, 0xda, 0x2b, 0x2f, 0x7a, 0x95, 0x69, 0xe2, 0xdc, 0xef, 0x72, 0x49,
+	0x19, 0xe9, 0xd4, 0xa1, 0xe0, 0x3c, 0x9d, 0xcb, 0x38, 0x3f, 0x3b, 0xe8, 0xfc, 0x26, 0xe4, 0x7d,
+	0xea, 0x4a, 0x89, 0x1a, 0xfd, 0x74, 0x22, 0x6c, 0xf5, 0x3b, 0xb0, 0x18, 0xe0, 0x11, 0x46, 0x2c,
+	0x3d, 0xc1, 0xcb, 0x3f, 0x94, 0x9a, 0x97, 0xcd, 0xc9, 0x01, 0xfe, 0x9b, 0xa0, 0xab, 0x7e, 0xd9,
+	0x1b, 0xb2, 0xc8, 0x3f, 0xb3, 0x26, 0xbf, 0xb4, 0xd2, 0x7b, 0xf2, 0x1d, 0x58, 0xa4, 0x9e, 0x3b,
+	0x80, 0x5a, 0x94, 0xa8, 0xb2, 0x39, 0x83, 0xaa, 0xfa, 0x65, 0x51, 0x65, 0x05, 0xae, 0x26, 0xbf,
+	0x64, 0x50, 0xa7, 0x58, 0xe8, 0xdd, 0xfc, 0xe9, 0xb3, 0x7f, 0xae, 0xcc, 0x3c, 0x7b, 0xbe, 0xa2,
+	0x7d, 0xf6, 0x7c, 0x45, 0xfb, 0xc7, 0xf3, 0x15, 0xed, 0xa3, 0x17, 0x2b, 0x33, 0x9f, 0xbd, 0x58,
+	0x99, 0xf9, 0xdb, 0x8b, 0x95, 0x99, 0x9f, 0xbd, 0x95, 0xc5, 0x53, 0xab, 0xfa, 0x46, 0x80, 0xec,
+	0x88, 0x86, 0x07, 0xfd, 0x86, 0xe6, 0xe1, 0x77, 0x9a, 0xc7, 0xe9, 0xdf, 0xbc, 0x89, 0x51, 0xda,
+	0x45, 0x91, 0x2f, 0xdf, 0xfe, 0xef, 0x00, 0xbb, 0x88, 0x2f, 0x84, 0xce, 0x27, 0x00, 0x00,
+}
+
+func (m *EventCreateMarket) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -881,24 +1408,244 @@ func (m *EventPlaceBatchLimitOrder) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EventPlaceBatchLimitOrder) MarshalTo(dAtA []byte) (int, error) {
+func (m *EventCreateMarket) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventPlaceBatchLimitOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EventCreateMarket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	n5, err5 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Deadline, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Deadline):])
-	if err5 != nil {
-		return 0, err5
+	if m.MarketId != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.MarketId))
+		i--
+		dAtA[i] = 0x20
 	}
-	i -= n5
-	i = encodeVarintEvent(dAtA, i, uint64(n5))
-	i--
-	dAtA[i] = 0x42
+	if len(m.QuoteDenom) > 0 {
+		i -= len(m.QuoteDenom)
+		copy(dAtA[i:], m.QuoteDenom)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.QuoteDenom)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.BaseDenom) > 0 {
+		i -= len(m.BaseDenom)
+		copy(dAtA[i:], m.BaseDenom)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.BaseDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventPlaceLimitOrder) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventPlaceLimitOrder) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventPlaceLimitOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DisplayQuantity != nil {
+		{
+			size := m.DisplayQuantity.Size()
+			i -= size
+			if _, err := m.DisplayQuantity.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintEvent(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x82
+	}
+	if len(m.Referrer) > 0 {
+		i -= len(m.Referrer)
+		copy(dAtA[i:], m.Referrer)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Referrer)))
+		i--
+		dAtA[i] = 0x7a
+	}
+	if m.SelfTradePrevention != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.SelfTradePrevention))
+		i--
+		dAtA[i] = 0x70
+	}
+	if len(m.RejectReason) > 0 {
+		i -= len(m.RejectReason)
+		copy(dAtA[i:], m.RejectReason)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.RejectReason)))
+		i--
+		dAtA[i] = 0x6a
+	}
+	if m.TimeInForce != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.TimeInForce))
+		i--
+		dAtA[i] = 0x60
+	}
+	{
+		size, err := m.Received.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x5a
+	{
+		size, err := m.Paid.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	{
+		size := m.ExecutedQuantity.Size()
+		i -= size
+		if _, err := m.ExecutedQuantity.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	n3, err3 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Deadline, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Deadline):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintEvent(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x42
+	n4, err4 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Lifespan, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Lifespan):])
+	if err4 != nil {
+		return 0, err4
+	}
+	i -= n4
+	i = encodeVarintEvent(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.Quantity.Size()
+		i -= size
+		if _, err := m.Quantity.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.Price.Size()
+		i -= size
+		if _, err := m.Price.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if m.IsBuy {
+		i--
+		if m.IsBuy {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Orderer) > 0 {
+		i -= len(m.Orderer)
+		copy(dAtA[i:], m.Orderer)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Orderer)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.OrderId != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.OrderId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.MarketId != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.MarketId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventPlaceBatchLimitOrder) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventPlaceBatchLimitOrder) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventPlaceBatchLimitOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SelfTradePrevention != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.SelfTradePrevention))
+		i--
+		dAtA[i] = 0x58
+	}
+	if len(m.RejectReason) > 0 {
+		i -= len(m.RejectReason)
+		copy(dAtA[i:], m.RejectReason)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.RejectReason)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.TimeInForce != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.TimeInForce))
+		i--
+		dAtA[i] = 0x48
+	}
+	n5, err5 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Deadline, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Deadline):])
+	if err5 != nil {
+		return 0, err5
+	}
+	i -= n5
+	i = encodeVarintEvent(dAtA, i, uint64(n5))
+	i--
+	dAtA[i] = 0x42
 	n6, err6 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Lifespan, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Lifespan):])
 	if err6 != nil {
 		return 0, err6
@@ -977,6 +1724,23 @@ func (m *EventPlaceMMLimitOrder) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	_ = i
 	var l int
 	_ = l
+	if m.SelfTradePrevention != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.SelfTradePrevention))
+		i--
+		dAtA[i] = 0x70
+	}
+	if len(m.RejectReason) > 0 {
+		i -= len(m.RejectReason)
+		copy(dAtA[i:], m.RejectReason)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.RejectReason)))
+		i--
+		dAtA[i] = 0x6a
+	}
+	if m.TimeInForce != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.TimeInForce))
+		i--
+		dAtA[i] = 0x60
+	}
 	{
 		size, err := m.Received.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -1093,6 +1857,23 @@ func (m *EventPlaceMMBatchLimitOrder) MarshalToSizedBuffer(dAtA []byte) (int, er
 	_ = i
 	var l int
 	_ = l
+	if m.SelfTradePrevention != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.SelfTradePrevention))
+		i--
+		dAtA[i] = 0x58
+	}
+	if len(m.RejectReason) > 0 {
+		i -= len(m.RejectReason)
+		copy(dAtA[i:], m.RejectReason)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.RejectReason)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.TimeInForce != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.TimeInForce))
+		i--
+		dAtA[i] = 0x48
+	}
 	n11, err11 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Deadline, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Deadline):])
 	if err11 != nil {
 		return 0, err11
@@ -1179,6 +1960,13 @@ func (m *EventPlaceMarketOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Referrer) > 0 {
+		i -= len(m.Referrer)
+		copy(dAtA[i:], m.Referrer)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Referrer)))
+		i--
+		dAtA[i] = 0x4a
+	}
 	{
 		size, err := m.Received.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -1337,7 +2125,7 @@ func (m *EventCancelAllOrders) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *EventSwapExactAmountIn) Marshal() (dAtA []byte, err error) {
+func (m *EventPlaceTriggerOrder) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1347,76 +2135,282 @@ func (m *EventSwapExactAmountIn) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EventSwapExactAmountIn) MarshalTo(dAtA []byte) (int, error) {
+func (m *EventPlaceTriggerOrder) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventSwapExactAmountIn) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EventPlaceTriggerOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Results) > 0 {
-		for iNdEx := len(m.Results) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Results[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintEvent(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x2a
-		}
+	n17, err17 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Deadline, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Deadline):])
+	if err17 != nil {
+		return 0, err17
 	}
+	i -= n17
+	i = encodeVarintEvent(dAtA, i, uint64(n17))
+	i--
+	dAtA[i] = 0x52
+	n18, err18 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Lifespan, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Lifespan):])
+	if err18 != nil {
+		return 0, err18
+	}
+	i -= n18
+	i = encodeVarintEvent(dAtA, i, uint64(n18))
+	i--
+	dAtA[i] = 0x4a
 	{
-		size, err := m.Output.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
+		size := m.Quantity.Size()
+		i -= size
+		if _, err := m.Quantity.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
-		i -= size
 		i = encodeVarintEvent(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x22
+	dAtA[i] = 0x42
+	if m.Price != nil {
+		{
+			size := m.Price.Size()
+			i -= size
+			if _, err := m.Price.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintEvent(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
 	{
-		size, err := m.Input.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
+		size := m.TriggerPrice.Size()
+		i -= size
+		if _, err := m.TriggerPrice.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
-		i -= size
 		i = encodeVarintEvent(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x1a
-	if len(m.Routes) > 0 {
-		dAtA20 := make([]byte, len(m.Routes)*10)
-		var j19 int
-		for _, num := range m.Routes {
-			for num >= 1<<7 {
-				dAtA20[j19] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j19++
-			}
-			dAtA20[j19] = uint8(num)
-			j19++
+	dAtA[i] = 0x32
+	if m.Condition != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.Condition))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.IsBuy {
+		i--
+		if m.IsBuy {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
-		i -= j19
-		copy(dAtA[i:], dAtA20[:j19])
-		i = encodeVarintEvent(dAtA, i, uint64(j19))
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x20
 	}
 	if len(m.Orderer) > 0 {
 		i -= len(m.Orderer)
 		copy(dAtA[i:], m.Orderer)
 		i = encodeVarintEvent(dAtA, i, uint64(len(m.Orderer)))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x1a
 	}
-	return len(dAtA) - i, nil
+	if m.OrderId != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.OrderId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.MarketId != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.MarketId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventSwapExactAmountIn) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventSwapExactAmountIn) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventSwapExactAmountIn) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Referrer) > 0 {
+		i -= len(m.Referrer)
+		copy(dAtA[i:], m.Referrer)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Referrer)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.WeightedResults) > 0 {
+		for iNdEx := len(m.WeightedResults) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.WeightedResults[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvent(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.Results) > 0 {
+		for iNdEx := len(m.Results) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Results[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvent(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	{
+		size, err := m.Output.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.Input.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Routes) > 0 {
+		dAtA22 := make([]byte, len(m.Routes)*10)
+		var j21 int
+		for _, num := range m.Routes {
+			for num >= 1<<7 {
+				dAtA22[j21] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j21++
+			}
+			dAtA22[j21] = uint8(num)
+			j21++
+		}
+		i -= j21
+		copy(dAtA[i:], dAtA22[:j21])
+		i = encodeVarintEvent(dAtA, i, uint64(j21))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Orderer) > 0 {
+		i -= len(m.Orderer)
+		copy(dAtA[i:], m.Orderer)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Orderer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventSwapExactAmountOut) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventSwapExactAmountOut) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventSwapExactAmountOut) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Results) > 0 {
+		for iNdEx := len(m.Results) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Results[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvent(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	{
+		size, err := m.Output.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.Input.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Routes) > 0 {
+		dAtA26 := make([]byte, len(m.Routes)*10)
+		var j25 int
+		for _, num := range m.Routes {
+			for num >= 1<<7 {
+				dAtA26[j25] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j25++
+			}
+			dAtA26[j25] = uint8(num)
+			j25++
+		}
+		i -= j25
+		copy(dAtA[i:], dAtA26[:j25])
+		i = encodeVarintEvent(dAtA, i, uint64(j25))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Orderer) > 0 {
+		i -= len(m.Orderer)
+		copy(dAtA[i:], m.Orderer)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Orderer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
 func (m *EventOrderFilled) Marshal() (dAtA []byte, err error) {
@@ -1439,6 +2433,23 @@ func (m *EventOrderFilled) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size, err := m.ReferralFee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x62
+	if len(m.Referrer) > 0 {
+		i -= len(m.Referrer)
+		copy(dAtA[i:], m.Referrer)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Referrer)))
+		i--
+		dAtA[i] = 0x5a
+	}
 	{
 		size, err := m.Received.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -1639,7 +2650,7 @@ func (m *EventOrderCompleted) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *EventOrderExpired) Marshal() (dAtA []byte, err error) {
+func (m *EventIcebergOrderReplenished) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1649,25 +2660,40 @@ func (m *EventOrderExpired) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EventOrderExpired) MarshalTo(dAtA []byte) (int, error) {
+func (m *EventIcebergOrderReplenished) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventOrderExpired) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EventIcebergOrderReplenished) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.VisibleQuantity.Size()
+		i -= size
+		if _, err := m.VisibleQuantity.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
 	if m.OrderId != 0 {
 		i = encodeVarintEvent(dAtA, i, uint64(m.OrderId))
 		i--
+		dAtA[i] = 0x10
+	}
+	if m.MarketId != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.MarketId))
+		i--
 		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *EventMarketParameterChanged) Marshal() (dAtA []byte, err error) {
+func (m *EventTriggerOrderTriggered) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1677,46 +2703,43 @@ func (m *EventMarketParameterChanged) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EventMarketParameterChanged) MarshalTo(dAtA []byte) (int, error) {
+func (m *EventTriggerOrderTriggered) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventMarketParameterChanged) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EventTriggerOrderTriggered) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if m.OrderId != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.OrderId))
+		i--
+		dAtA[i] = 0x28
+	}
 	{
-		size := m.OrderSourceFeeRatio.Size()
+		size := m.LastPrice.Size()
 		i -= size
-		if _, err := m.OrderSourceFeeRatio.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.LastPrice.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintEvent(dAtA, i, uint64(size))
 	}
 	i--
 	dAtA[i] = 0x22
-	{
-		size := m.TakerFeeRate.Size()
-		i -= size
-		if _, err := m.TakerFeeRate.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintEvent(dAtA, i, uint64(size))
+	if len(m.Orderer) > 0 {
+		i -= len(m.Orderer)
+		copy(dAtA[i:], m.Orderer)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Orderer)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	i--
-	dAtA[i] = 0x1a
-	{
-		size := m.MakerFeeRate.Size()
-		i -= size
-		if _, err := m.MakerFeeRate.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintEvent(dAtA, i, uint64(size))
+	if m.TriggerOrderId != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.TriggerOrderId))
+		i--
+		dAtA[i] = 0x10
 	}
-	i--
-	dAtA[i] = 0x12
 	if m.MarketId != 0 {
 		i = encodeVarintEvent(dAtA, i, uint64(m.MarketId))
 		i--
@@ -1725,384 +2748,4613 @@ func (m *EventMarketParameterChanged) MarshalToSizedBuffer(dAtA []byte) (int, er
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintEvent(dAtA []byte, offset int, v uint64) int {
-	offset -= sovEvent(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *EventTriggerOrderFailed) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *EventCreateMarket) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *EventTriggerOrderFailed) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventTriggerOrderFailed) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x22
 	}
-	l = len(m.BaseDenom)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	if len(m.Orderer) > 0 {
+		i -= len(m.Orderer)
+		copy(dAtA[i:], m.Orderer)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Orderer)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.QuoteDenom)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	if m.TriggerOrderId != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.TriggerOrderId))
+		i--
+		dAtA[i] = 0x10
 	}
 	if m.MarketId != 0 {
-		n += 1 + sovEvent(uint64(m.MarketId))
+		i = encodeVarintEvent(dAtA, i, uint64(m.MarketId))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *EventPlaceLimitOrder) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EventOrderExpired) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *EventOrderExpired) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventOrderExpired) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.MarketId != 0 {
-		n += 1 + sovEvent(uint64(m.MarketId))
-	}
 	if m.OrderId != 0 {
-		n += 1 + sovEvent(uint64(m.OrderId))
-	}
-	l = len(m.Orderer)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
-	}
-	if m.IsBuy {
-		n += 2
+		i = encodeVarintEvent(dAtA, i, uint64(m.OrderId))
+		i--
+		dAtA[i] = 0x8
 	}
-	l = m.Price.Size()
-	n += 1 + l + sovEvent(uint64(l))
-	l = m.Quantity.Size()
-	n += 1 + l + sovEvent(uint64(l))
-	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.Lifespan)
-	n += 1 + l + sovEvent(uint64(l))
-	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Deadline)
-	n += 1 + l + sovEvent(uint64(l))
-	l = m.ExecutedQuantity.Size()
-	n += 1 + l + sovEvent(uint64(l))
-	l = m.Paid.Size()
-	n += 1 + l + sovEvent(uint64(l))
-	l = m.Received.Size()
-	n += 1 + l + sovEvent(uint64(l))
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *EventPlaceBatchLimitOrder) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EventMarketParameterChanged) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *EventMarketParameterChanged) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMarketParameterChanged) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.MarketId != 0 {
-		n += 1 + sovEvent(uint64(m.MarketId))
-	}
-	if m.OrderId != 0 {
-		n += 1 + sovEvent(uint64(m.OrderId))
+	if m.SelfTradePrevention != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.SelfTradePrevention))
+		i--
+		dAtA[i] = 0x40
 	}
-	l = len(m.Orderer)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	{
+		size := m.LotSize.Size()
+		i -= size
+		if _, err := m.LotSize.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
 	}
-	if m.IsBuy {
-		n += 2
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.MinOrderQuantity.Size()
+		i -= size
+		if _, err := m.MinOrderQuantity.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
 	}
-	l = m.Price.Size()
-	n += 1 + l + sovEvent(uint64(l))
-	l = m.Quantity.Size()
-	n += 1 + l + sovEvent(uint64(l))
-	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.Lifespan)
-	n += 1 + l + sovEvent(uint64(l))
-	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Deadline)
-	n += 1 + l + sovEvent(uint64(l))
-	return n
-}
-
-func (m *EventPlaceMMLimitOrder) Size() (n int) {
-	if m == nil {
-		return 0
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.TickSize.Size()
+		i -= size
+		if _, err := m.TickSize.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
 	}
-	var l int
-	_ = l
-	if m.MarketId != 0 {
-		n += 1 + sovEvent(uint64(m.MarketId))
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.OrderSourceFeeRatio.Size()
+		i -= size
+		if _, err := m.OrderSourceFeeRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
 	}
-	if m.OrderId != 0 {
-		n += 1 + sovEvent(uint64(m.OrderId))
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.TakerFeeRate.Size()
+		i -= size
+		if _, err := m.TakerFeeRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
 	}
-	l = len(m.Orderer)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.MakerFeeRate.Size()
+		i -= size
+		if _, err := m.MakerFeeRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
 	}
-	if m.IsBuy {
-		n += 2
+	i--
+	dAtA[i] = 0x12
+	if m.MarketId != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.MarketId))
+		i--
+		dAtA[i] = 0x8
 	}
-	l = m.Price.Size()
-	n += 1 + l + sovEvent(uint64(l))
-	l = m.Quantity.Size()
-	n += 1 + l + sovEvent(uint64(l))
-	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.Lifespan)
-	n += 1 + l + sovEvent(uint64(l))
-	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Deadline)
-	n += 1 + l + sovEvent(uint64(l))
-	l = m.ExecutedQuantity.Size()
-	n += 1 + l + sovEvent(uint64(l))
-	l = m.Paid.Size()
-	n += 1 + l + sovEvent(uint64(l))
-	l = m.Received.Size()
-	n += 1 + l + sovEvent(uint64(l))
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *EventPlaceMMBatchLimitOrder) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EventMarketStatusChanged) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *EventMarketStatusChanged) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMarketStatusChanged) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.MarketId != 0 {
-		n += 1 + sovEvent(uint64(m.MarketId))
-	}
-	if m.OrderId != 0 {
-		n += 1 + sovEvent(uint64(m.OrderId))
+	if len(m.CancelledOrderIds) > 0 {
+		dAtA33 := make([]byte, len(m.CancelledOrderIds)*10)
+		var j32 int
+		for _, num := range m.CancelledOrderIds {
+			for num >= 1<<7 {
+				dAtA33[j32] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j32++
+			}
+			dAtA33[j32] = uint8(num)
+			j32++
+		}
+		i -= j32
+		copy(dAtA[i:], dAtA33[:j32])
+		i = encodeVarintEvent(dAtA, i, uint64(j32))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.Orderer)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	if m.Status != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.IsBuy {
-		n += 2
+	if m.MarketId != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.MarketId))
+		i--
+		dAtA[i] = 0x8
 	}
-	l = m.Price.Size()
-	n += 1 + l + sovEvent(uint64(l))
-	l = m.Quantity.Size()
-	n += 1 + l + sovEvent(uint64(l))
-	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.Lifespan)
-	n += 1 + l + sovEvent(uint64(l))
-	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Deadline)
-	n += 1 + l + sovEvent(uint64(l))
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *EventPlaceMarketOrder) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EventBatchMatched) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.MarketId != 0 {
-		n += 1 + sovEvent(uint64(m.MarketId))
-	}
-	if m.OrderId != 0 {
-		n += 1 + sovEvent(uint64(m.OrderId))
-	}
-	l = len(m.Orderer)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
-	}
-	if m.IsBuy {
-		n += 2
-	}
-	l = m.Quantity.Size()
-	n += 1 + l + sovEvent(uint64(l))
-	l = m.ExecutedQuantity.Size()
-	n += 1 + l + sovEvent(uint64(l))
-	l = m.Paid.Size()
-	n += 1 + l + sovEvent(uint64(l))
-	l = m.Received.Size()
-	n += 1 + l + sovEvent(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *EventCancelOrder) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Orderer)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
-	}
-	if m.OrderId != 0 {
-		n += 1 + sovEvent(uint64(m.OrderId))
-	}
-	return n
+func (m *EventBatchMatched) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventCancelAllOrders) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *EventBatchMatched) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Orderer)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	{
+		size := m.OrderSourceQuantity.Size()
+		i -= size
+		if _, err := m.OrderSourceQuantity.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
 	}
-	if m.MarketId != 0 {
-		n += 1 + sovEvent(uint64(m.MarketId))
+	i--
+	dAtA[i] = 0x42
+	if m.NumFilledOrders != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.NumFilledOrders))
+		i--
+		dAtA[i] = 0x38
 	}
-	if len(m.CancelledOrderIds) > 0 {
-		l = 0
-		for _, e := range m.CancelledOrderIds {
-			l += sovEvent(uint64(e))
+	{
+		size := m.QuoteFee.Size()
+		i -= size
+		if _, err := m.QuoteFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
-		n += 1 + sovEvent(uint64(l)) + l
+		i = encodeVarintEvent(dAtA, i, uint64(size))
 	}
-	return n
-}
-
-func (m *EventSwapExactAmountIn) Size() (n int) {
-	if m == nil {
-		return 0
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.BaseFee.Size()
+		i -= size
+		if _, err := m.BaseFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
 	}
-	var l int
-	_ = l
-	l = len(m.Orderer)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.MatchedQuote.Size()
+		i -= size
+		if _, err := m.MatchedQuote.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
 	}
-	if len(m.Routes) > 0 {
-		l = 0
-		for _, e := range m.Routes {
-			l += sovEvent(uint64(e))
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.MatchedQuantity.Size()
+		i -= size
+		if _, err := m.MatchedQuantity.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
-		n += 1 + sovEvent(uint64(l)) + l
+		i = encodeVarintEvent(dAtA, i, uint64(size))
 	}
-	l = m.Input.Size()
-	n += 1 + l + sovEvent(uint64(l))
-	l = m.Output.Size()
-	n += 1 + l + sovEvent(uint64(l))
-	if len(m.Results) > 0 {
-		for _, e := range m.Results {
-			l = e.Size()
-			n += 1 + l + sovEvent(uint64(l))
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.ClearingPrice.Size()
+		i -= size
+		if _, err := m.ClearingPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0x12
+	if m.MarketId != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.MarketId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *EventOrderFilled) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EventCircuitBreakerTripped) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *EventCircuitBreakerTripped) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventCircuitBreakerTripped) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.MarketId != 0 {
-		n += 1 + sovEvent(uint64(m.MarketId))
+	if m.EndHeight != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.EndHeight))
+		i--
+		dAtA[i] = 0x20
 	}
-	if m.OrderId != 0 {
-		n += 1 + sovEvent(uint64(m.OrderId))
+	{
+		size := m.LastPrice.Size()
+		i -= size
+		if _, err := m.LastPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
 	}
-	l = len(m.Orderer)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.ReferencePrice.Size()
+		i -= size
+		if _, err := m.ReferencePrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
 	}
-	if m.IsBuy {
-		n += 2
+	i--
+	dAtA[i] = 0x12
+	if m.MarketId != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.MarketId))
+		i--
+		dAtA[i] = 0x8
 	}
-	l = m.Price.Size()
-	n += 1 + l + sovEvent(uint64(l))
-	l = m.Quantity.Size()
-	n += 1 + l + sovEvent(uint64(l))
-	l = m.OpenQuantity.Size()
-	n += 1 + l + sovEvent(uint64(l))
-	l = m.ExecutedQuantity.Size()
-	n += 1 + l + sovEvent(uint64(l))
-	l = m.Paid.Size()
-	n += 1 + l + sovEvent(uint64(l))
-	l = m.Received.Size()
-	n += 1 + l + sovEvent(uint64(l))
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *EventOrderSourceOrdersFilled) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EventCircuitBreakerReset) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *EventCircuitBreakerReset) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventCircuitBreakerReset) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.MarketId != 0 {
-		n += 1 + sovEvent(uint64(m.MarketId))
-	}
-	l = len(m.SourceName)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
-	}
-	l = len(m.Orderer)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	if m.LastPrice != nil {
+		{
+			size := m.LastPrice.Size()
+			i -= size
+			if _, err := m.LastPrice.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintEvent(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.IsBuy {
-		n += 2
+	if m.MarketId != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.MarketId))
+		i--
+		dAtA[i] = 0x8
 	}
-	l = m.ExecutedQuantity.Size()
-	n += 1 + l + sovEvent(uint64(l))
-	l = m.Paid.Size()
-	n += 1 + l + sovEvent(uint64(l))
-	l = m.Received.Size()
-	n += 1 + l + sovEvent(uint64(l))
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *EventOrderCompleted) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.OrderId != 0 {
-		n += 1 + sovEvent(uint64(m.OrderId))
+func (m *EventAmendOrder) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *EventOrderExpired) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *EventAmendOrder) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventAmendOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.OrderId != 0 {
+	if m.Requeued {
+		i--
+		if m.Requeued {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
+	}
+	n34, err34 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Deadline, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Deadline):])
+	if err34 != nil {
+		return 0, err34
+	}
+	i -= n34
+	i = encodeVarintEvent(dAtA, i, uint64(n34))
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.OpenQuantity.Size()
+		i -= size
+		if _, err := m.OpenQuantity.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.Quantity.Size()
+		i -= size
+		if _, err := m.Quantity.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.Price.Size()
+		i -= size
+		if _, err := m.Price.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Orderer) > 0 {
+		i -= len(m.Orderer)
+		copy(dAtA[i:], m.Orderer)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Orderer)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.OrderId != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.OrderId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.MarketId != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.MarketId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventSetCancelAfter) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventSetCancelAfter) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventSetCancelAfter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CancelAfter != nil {
+		n35, err35 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.CancelAfter, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.CancelAfter):])
+		if err35 != nil {
+			return 0, err35
+		}
+		i -= n35
+		i = encodeVarintEvent(dAtA, i, uint64(n35))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.MarketIds) > 0 {
+		dAtA37 := make([]byte, len(m.MarketIds)*10)
+		var j36 int
+		for _, num := range m.MarketIds {
+			for num >= 1<<7 {
+				dAtA37[j36] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j36++
+			}
+			dAtA37[j36] = uint8(num)
+			j36++
+		}
+		i -= j36
+		copy(dAtA[i:], dAtA37[:j36])
+		i = encodeVarintEvent(dAtA, i, uint64(j36))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Orderer) > 0 {
+		i -= len(m.Orderer)
+		copy(dAtA[i:], m.Orderer)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Orderer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventCancelAfterTriggered) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventCancelAfterTriggered) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventCancelAfterTriggered) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CancelledOrderIds) > 0 {
+		dAtA39 := make([]byte, len(m.CancelledOrderIds)*10)
+		var j38 int
+		for _, num := range m.CancelledOrderIds {
+			for num >= 1<<7 {
+				dAtA39[j38] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j38++
+			}
+			dAtA39[j38] = uint8(num)
+			j38++
+		}
+		i -= j38
+		copy(dAtA[i:], dAtA39[:j38])
+		i = encodeVarintEvent(dAtA, i, uint64(j38))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.MarketId != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.MarketId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Orderer) > 0 {
+		i -= len(m.Orderer)
+		copy(dAtA[i:], m.Orderer)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Orderer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventReplaceMMOrders) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventReplaceMMOrders) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventReplaceMMOrders) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.OrderIds) > 0 {
+		dAtA41 := make([]byte, len(m.OrderIds)*10)
+		var j40 int
+		for _, num := range m.OrderIds {
+			for num >= 1<<7 {
+				dAtA41[j40] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j40++
+			}
+			dAtA41[j40] = uint8(num)
+			j40++
+		}
+		i -= j40
+		copy(dAtA[i:], dAtA41[:j40])
+		i = encodeVarintEvent(dAtA, i, uint64(j40))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.CancelledOrderIds) > 0 {
+		dAtA43 := make([]byte, len(m.CancelledOrderIds)*10)
+		var j42 int
+		for _, num := range m.CancelledOrderIds {
+			for num >= 1<<7 {
+				dAtA43[j42] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j42++
+			}
+			dAtA43[j42] = uint8(num)
+			j42++
+		}
+		i -= j42
+		copy(dAtA[i:], dAtA43[:j42])
+		i = encodeVarintEvent(dAtA, i, uint64(j42))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.MarketId != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.MarketId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Orderer) > 0 {
+		i -= len(m.Orderer)
+		copy(dAtA[i:], m.Orderer)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Orderer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventSelfTradePrevented) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventSelfTradePrevented) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventSelfTradePrevented) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Quantity.Size()
+		i -= size
+		if _, err := m.Quantity.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	if len(m.OldestSourceName) > 0 {
+		i -= len(m.OldestSourceName)
+		copy(dAtA[i:], m.OldestSourceName)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.OldestSourceName)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.OldestOrderId != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.OldestOrderId))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.NewestSourceName) > 0 {
+		i -= len(m.NewestSourceName)
+		copy(dAtA[i:], m.NewestSourceName)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.NewestSourceName)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.NewestOrderId != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.NewestOrderId))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Mode != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.Mode))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Orderer) > 0 {
+		i -= len(m.Orderer)
+		copy(dAtA[i:], m.Orderer)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Orderer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.MarketId != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.MarketId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintEvent(dAtA []byte, offset int, v uint64) int {
+	offset -= sovEvent(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *EventCreateMarket) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.BaseDenom)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.QuoteDenom)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.MarketId != 0 {
+		n += 1 + sovEvent(uint64(m.MarketId))
+	}
+	return n
+}
+
+func (m *EventPlaceLimitOrder) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MarketId != 0 {
+		n += 1 + sovEvent(uint64(m.MarketId))
+	}
+	if m.OrderId != 0 {
+		n += 1 + sovEvent(uint64(m.OrderId))
+	}
+	l = len(m.Orderer)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.IsBuy {
+		n += 2
+	}
+	l = m.Price.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = m.Quantity.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.Lifespan)
+	n += 1 + l + sovEvent(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Deadline)
+	n += 1 + l + sovEvent(uint64(l))
+	l = m.ExecutedQuantity.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = m.Paid.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = m.Received.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	if m.TimeInForce != 0 {
+		n += 1 + sovEvent(uint64(m.TimeInForce))
+	}
+	l = len(m.RejectReason)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.SelfTradePrevention != 0 {
+		n += 1 + sovEvent(uint64(m.SelfTradePrevention))
+	}
+	l = len(m.Referrer)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.DisplayQuantity != nil {
+		l = m.DisplayQuantity.Size()
+		n += 2 + l + sovEvent(uint64(l))
+	}
+	return n
+}
+
+func (m *EventPlaceBatchLimitOrder) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MarketId != 0 {
+		n += 1 + sovEvent(uint64(m.MarketId))
+	}
+	if m.OrderId != 0 {
+		n += 1 + sovEvent(uint64(m.OrderId))
+	}
+	l = len(m.Orderer)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.IsBuy {
+		n += 2
+	}
+	l = m.Price.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = m.Quantity.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.Lifespan)
+	n += 1 + l + sovEvent(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Deadline)
+	n += 1 + l + sovEvent(uint64(l))
+	if m.TimeInForce != 0 {
+		n += 1 + sovEvent(uint64(m.TimeInForce))
+	}
+	l = len(m.RejectReason)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.SelfTradePrevention != 0 {
+		n += 1 + sovEvent(uint64(m.SelfTradePrevention))
+	}
+	return n
+}
+
+func (m *EventPlaceMMLimitOrder) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MarketId != 0 {
+		n += 1 + sovEvent(uint64(m.MarketId))
+	}
+	if m.OrderId != 0 {
+		n += 1 + sovEvent(uint64(m.OrderId))
+	}
+	l = len(m.Orderer)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.IsBuy {
+		n += 2
+	}
+	l = m.Price.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = m.Quantity.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.Lifespan)
+	n += 1 + l + sovEvent(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Deadline)
+	n += 1 + l + sovEvent(uint64(l))
+	l = m.ExecutedQuantity.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = m.Paid.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = m.Received.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	if m.TimeInForce != 0 {
+		n += 1 + sovEvent(uint64(m.TimeInForce))
+	}
+	l = len(m.RejectReason)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.SelfTradePrevention != 0 {
+		n += 1 + sovEvent(uint64(m.SelfTradePrevention))
+	}
+	return n
+}
+
+func (m *EventPlaceMMBatchLimitOrder) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MarketId != 0 {
+		n += 1 + sovEvent(uint64(m.MarketId))
+	}
+	if m.OrderId != 0 {
+		n += 1 + sovEvent(uint64(m.OrderId))
+	}
+	l = len(m.Orderer)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.IsBuy {
+		n += 2
+	}
+	l = m.Price.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = m.Quantity.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.Lifespan)
+	n += 1 + l + sovEvent(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Deadline)
+	n += 1 + l + sovEvent(uint64(l))
+	if m.TimeInForce != 0 {
+		n += 1 + sovEvent(uint64(m.TimeInForce))
+	}
+	l = len(m.RejectReason)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.SelfTradePrevention != 0 {
+		n += 1 + sovEvent(uint64(m.SelfTradePrevention))
+	}
+	return n
+}
+
+func (m *EventPlaceMarketOrder) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MarketId != 0 {
+		n += 1 + sovEvent(uint64(m.MarketId))
+	}
+	if m.OrderId != 0 {
+		n += 1 + sovEvent(uint64(m.OrderId))
+	}
+	l = len(m.Orderer)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.IsBuy {
+		n += 2
+	}
+	l = m.Quantity.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = m.ExecutedQuantity.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = m.Paid.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = m.Received.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = len(m.Referrer)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	return n
+}
+
+func (m *EventCancelOrder) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Orderer)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.OrderId != 0 {
+		n += 1 + sovEvent(uint64(m.OrderId))
+	}
+	return n
+}
+
+func (m *EventCancelAllOrders) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Orderer)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.MarketId != 0 {
+		n += 1 + sovEvent(uint64(m.MarketId))
+	}
+	if len(m.CancelledOrderIds) > 0 {
+		l = 0
+		for _, e := range m.CancelledOrderIds {
+			l += sovEvent(uint64(e))
+		}
+		n += 1 + sovEvent(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *EventPlaceTriggerOrder) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MarketId != 0 {
+		n += 1 + sovEvent(uint64(m.MarketId))
+	}
+	if m.OrderId != 0 {
+		n += 1 + sovEvent(uint64(m.OrderId))
+	}
+	l = len(m.Orderer)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.IsBuy {
+		n += 2
+	}
+	if m.Condition != 0 {
+		n += 1 + sovEvent(uint64(m.Condition))
+	}
+	l = m.TriggerPrice.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	if m.Price != nil {
+		l = m.Price.Size()
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = m.Quantity.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.Lifespan)
+	n += 1 + l + sovEvent(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Deadline)
+	n += 1 + l + sovEvent(uint64(l))
+	return n
+}
+
+func (m *EventSwapExactAmountIn) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Orderer)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if len(m.Routes) > 0 {
+		l = 0
+		for _, e := range m.Routes {
+			l += sovEvent(uint64(e))
+		}
+		n += 1 + sovEvent(uint64(l)) + l
+	}
+	l = m.Input.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = m.Output.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	if len(m.Results) > 0 {
+		for _, e := range m.Results {
+			l = e.Size()
+			n += 1 + l + sovEvent(uint64(l))
+		}
+	}
+	if len(m.WeightedResults) > 0 {
+		for _, e := range m.WeightedResults {
+			l = e.Size()
+			n += 1 + l + sovEvent(uint64(l))
+		}
+	}
+	l = len(m.Referrer)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	return n
+}
+
+func (m *EventSwapExactAmountOut) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Orderer)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if len(m.Routes) > 0 {
+		l = 0
+		for _, e := range m.Routes {
+			l += sovEvent(uint64(e))
+		}
+		n += 1 + sovEvent(uint64(l)) + l
+	}
+	l = m.Input.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = m.Output.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	if len(m.Results) > 0 {
+		for _, e := range m.Results {
+			l = e.Size()
+			n += 1 + l + sovEvent(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EventOrderFilled) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MarketId != 0 {
+		n += 1 + sovEvent(uint64(m.MarketId))
+	}
+	if m.OrderId != 0 {
+		n += 1 + sovEvent(uint64(m.OrderId))
+	}
+	l = len(m.Orderer)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.IsBuy {
+		n += 2
+	}
+	l = m.Price.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = m.Quantity.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = m.OpenQuantity.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = m.ExecutedQuantity.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = m.Paid.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = m.Received.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = len(m.Referrer)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = m.ReferralFee.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	return n
+}
+
+func (m *EventOrderSourceOrdersFilled) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MarketId != 0 {
+		n += 1 + sovEvent(uint64(m.MarketId))
+	}
+	l = len(m.SourceName)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Orderer)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.IsBuy {
+		n += 2
+	}
+	l = m.ExecutedQuantity.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = m.Paid.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = m.Received.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	return n
+}
+
+func (m *EventOrderCompleted) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.OrderId != 0 {
+		n += 1 + sovEvent(uint64(m.OrderId))
+	}
+	return n
+}
+
+func (m *EventIcebergOrderReplenished) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MarketId != 0 {
+		n += 1 + sovEvent(uint64(m.MarketId))
+	}
+	if m.OrderId != 0 {
+		n += 1 + sovEvent(uint64(m.OrderId))
+	}
+	l = m.VisibleQuantity.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	return n
+}
+
+func (m *EventTriggerOrderTriggered) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MarketId != 0 {
+		n += 1 + sovEvent(uint64(m.MarketId))
+	}
+	if m.TriggerOrderId != 0 {
+		n += 1 + sovEvent(uint64(m.TriggerOrderId))
+	}
+	l = len(m.Orderer)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = m.LastPrice.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	if m.OrderId != 0 {
+		n += 1 + sovEvent(uint64(m.OrderId))
+	}
+	return n
+}
+
+func (m *EventTriggerOrderFailed) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MarketId != 0 {
+		n += 1 + sovEvent(uint64(m.MarketId))
+	}
+	if m.TriggerOrderId != 0 {
+		n += 1 + sovEvent(uint64(m.TriggerOrderId))
+	}
+	l = len(m.Orderer)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	return n
+}
+
+func (m *EventOrderExpired) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.OrderId != 0 {
+		n += 1 + sovEvent(uint64(m.OrderId))
+	}
+	return n
+}
+
+func (m *EventMarketParameterChanged) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MarketId != 0 {
+		n += 1 + sovEvent(uint64(m.MarketId))
+	}
+	l = m.MakerFeeRate.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = m.TakerFeeRate.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = m.OrderSourceFeeRatio.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = m.TickSize.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = m.MinOrderQuantity.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = m.LotSize.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	if m.SelfTradePrevention != 0 {
+		n += 1 + sovEvent(uint64(m.SelfTradePrevention))
+	}
+	return n
+}
+
+func (m *EventMarketStatusChanged) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MarketId != 0 {
+		n += 1 + sovEvent(uint64(m.MarketId))
+	}
+	if m.Status != 0 {
+		n += 1 + sovEvent(uint64(m.Status))
+	}
+	if len(m.CancelledOrderIds) > 0 {
+		l = 0
+		for _, e := range m.CancelledOrderIds {
+			l += sovEvent(uint64(e))
+		}
+		n += 1 + sovEvent(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *EventBatchMatched) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MarketId != 0 {
+		n += 1 + sovEvent(uint64(m.MarketId))
+	}
+	l = m.ClearingPrice.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = m.MatchedQuantity.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = m.MatchedQuote.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = m.BaseFee.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = m.QuoteFee.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	if m.NumFilledOrders != 0 {
+		n += 1 + sovEvent(uint64(m.NumFilledOrders))
+	}
+	l = m.OrderSourceQuantity.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	return n
+}
+
+func (m *EventCircuitBreakerTripped) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MarketId != 0 {
+		n += 1 + sovEvent(uint64(m.MarketId))
+	}
+	l = m.ReferencePrice.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = m.LastPrice.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	if m.EndHeight != 0 {
+		n += 1 + sovEvent(uint64(m.EndHeight))
+	}
+	return n
+}
+
+func (m *EventCircuitBreakerReset) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MarketId != 0 {
+		n += 1 + sovEvent(uint64(m.MarketId))
+	}
+	if m.LastPrice != nil {
+		l = m.LastPrice.Size()
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	return n
+}
+
+func (m *EventAmendOrder) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MarketId != 0 {
+		n += 1 + sovEvent(uint64(m.MarketId))
+	}
+	if m.OrderId != 0 {
 		n += 1 + sovEvent(uint64(m.OrderId))
 	}
-	return n
-}
+	l = len(m.Orderer)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = m.Price.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = m.Quantity.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = m.OpenQuantity.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Deadline)
+	n += 1 + l + sovEvent(uint64(l))
+	if m.Requeued {
+		n += 2
+	}
+	return n
+}
+
+func (m *EventSetCancelAfter) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Orderer)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if len(m.MarketIds) > 0 {
+		l = 0
+		for _, e := range m.MarketIds {
+			l += sovEvent(uint64(e))
+		}
+		n += 1 + sovEvent(uint64(l)) + l
+	}
+	if m.CancelAfter != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.CancelAfter)
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	return n
+}
+
+func (m *EventCancelAfterTriggered) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Orderer)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.MarketId != 0 {
+		n += 1 + sovEvent(uint64(m.MarketId))
+	}
+	if len(m.CancelledOrderIds) > 0 {
+		l = 0
+		for _, e := range m.CancelledOrderIds {
+			l += sovEvent(uint64(e))
+		}
+		n += 1 + sovEvent(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *EventReplaceMMOrders) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Orderer)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.MarketId != 0 {
+		n += 1 + sovEvent(uint64(m.MarketId))
+	}
+	if len(m.CancelledOrderIds) > 0 {
+		l = 0
+		for _, e := range m.CancelledOrderIds {
+			l += sovEvent(uint64(e))
+		}
+		n += 1 + sovEvent(uint64(l)) + l
+	}
+	if len(m.OrderIds) > 0 {
+		l = 0
+		for _, e := range m.OrderIds {
+			l += sovEvent(uint64(e))
+		}
+		n += 1 + sovEvent(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *EventSelfTradePrevented) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MarketId != 0 {
+		n += 1 + sovEvent(uint64(m.MarketId))
+	}
+	l = len(m.Orderer)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.Mode != 0 {
+		n += 1 + sovEvent(uint64(m.Mode))
+	}
+	if m.NewestOrderId != 0 {
+		n += 1 + sovEvent(uint64(m.NewestOrderId))
+	}
+	l = len(m.NewestSourceName)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.OldestOrderId != 0 {
+		n += 1 + sovEvent(uint64(m.OldestOrderId))
+	}
+	l = len(m.OldestSourceName)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = m.Quantity.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	return n
+}
+
+func sovEvent(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozEvent(x uint64) (n int) {
+	return sovEvent(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *EventCreateMarket) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventCreateMarket: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventCreateMarket: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BaseDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuoteDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.QuoteDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+			}
+			m.MarketId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MarketId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventPlaceLimitOrder) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventPlaceLimitOrder: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventPlaceLimitOrder: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+			}
+			m.MarketId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MarketId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderId", wireType)
+			}
+			m.OrderId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OrderId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Orderer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Orderer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsBuy", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsBuy = bool(v != 0)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quantity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Quantity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Lifespan", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.Lifespan, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deadline", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Deadline, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutedQuantity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ExecutedQuantity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Paid", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Paid.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Received", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Received.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeInForce", wireType)
+			}
+			m.TimeInForce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimeInForce |= TimeInForce(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RejectReason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RejectReason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SelfTradePrevention", wireType)
+			}
+			m.SelfTradePrevention = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SelfTradePrevention |= SelfTradePrevention(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Referrer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Referrer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisplayQuantity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Dec
+			m.DisplayQuantity = &v
+			if err := m.DisplayQuantity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventPlaceBatchLimitOrder) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventPlaceBatchLimitOrder: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventPlaceBatchLimitOrder: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+			}
+			m.MarketId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MarketId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderId", wireType)
+			}
+			m.OrderId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OrderId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Orderer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Orderer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsBuy", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsBuy = bool(v != 0)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quantity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Quantity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Lifespan", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.Lifespan, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deadline", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Deadline, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeInForce", wireType)
+			}
+			m.TimeInForce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimeInForce |= TimeInForce(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RejectReason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RejectReason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SelfTradePrevention", wireType)
+			}
+			m.SelfTradePrevention = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SelfTradePrevention |= SelfTradePrevention(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventPlaceMMLimitOrder) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventPlaceMMLimitOrder: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventPlaceMMLimitOrder: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+			}
+			m.MarketId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MarketId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderId", wireType)
+			}
+			m.OrderId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OrderId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Orderer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Orderer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsBuy", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsBuy = bool(v != 0)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quantity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Quantity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Lifespan", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.Lifespan, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deadline", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Deadline, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutedQuantity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ExecutedQuantity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Paid", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Paid.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Received", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Received.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeInForce", wireType)
+			}
+			m.TimeInForce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimeInForce |= TimeInForce(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RejectReason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RejectReason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SelfTradePrevention", wireType)
+			}
+			m.SelfTradePrevention = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SelfTradePrevention |= SelfTradePrevention(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventPlaceMMBatchLimitOrder) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventPlaceMMBatchLimitOrder: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventPlaceMMBatchLimitOrder: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+			}
+			m.MarketId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MarketId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderId", wireType)
+			}
+			m.OrderId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OrderId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Orderer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Orderer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsBuy", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsBuy = bool(v != 0)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quantity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Quantity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Lifespan", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.Lifespan, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deadline", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Deadline, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeInForce", wireType)
+			}
+			m.TimeInForce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimeInForce |= TimeInForce(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RejectReason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RejectReason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SelfTradePrevention", wireType)
+			}
+			m.SelfTradePrevention = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SelfTradePrevention |= SelfTradePrevention(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventPlaceMarketOrder) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventPlaceMarketOrder: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventPlaceMarketOrder: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+			}
+			m.MarketId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MarketId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderId", wireType)
+			}
+			m.OrderId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OrderId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Orderer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Orderer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsBuy", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsBuy = bool(v != 0)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quantity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Quantity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutedQuantity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ExecutedQuantity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Paid", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Paid.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Received", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Received.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Referrer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Referrer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventCancelOrder) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventCancelOrder: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventCancelOrder: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Orderer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Orderer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderId", wireType)
+			}
+			m.OrderId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OrderId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventCancelAllOrders) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventCancelAllOrders: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventCancelAllOrders: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Orderer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Orderer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+			}
+			m.MarketId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MarketId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowEvent
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.CancelledOrderIds = append(m.CancelledOrderIds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowEvent
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthEvent
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthEvent
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.CancelledOrderIds) == 0 {
+					m.CancelledOrderIds = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowEvent
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.CancelledOrderIds = append(m.CancelledOrderIds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field CancelledOrderIds", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventPlaceTriggerOrder) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventPlaceTriggerOrder: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventPlaceTriggerOrder: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+			}
+			m.MarketId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MarketId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderId", wireType)
+			}
+			m.OrderId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OrderId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Orderer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Orderer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsBuy", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsBuy = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Condition", wireType)
+			}
+			m.Condition = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Condition |= TriggerCondition(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TriggerPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TriggerPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Dec
+			m.Price = &v
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quantity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Quantity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Lifespan", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.Lifespan, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deadline", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Deadline, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventSwapExactAmountIn) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventSwapExactAmountIn: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventSwapExactAmountIn: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Orderer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Orderer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowEvent
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Routes = append(m.Routes, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowEvent
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthEvent
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthEvent
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Routes) == 0 {
+					m.Routes = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowEvent
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Routes = append(m.Routes, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Routes", wireType)
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Input", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Input.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Output", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Output.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Results", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Results = append(m.Results, SwapRouteResult{})
+			if err := m.Results[len(m.Results)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WeightedResults", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WeightedResults = append(m.WeightedResults, WeightedSwapRouteResult{})
+			if err := m.WeightedResults[len(m.WeightedResults)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Referrer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Referrer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *EventMarketParameterChanged) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.MarketId != 0 {
-		n += 1 + sovEvent(uint64(m.MarketId))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	l = m.MakerFeeRate.Size()
-	n += 1 + l + sovEvent(uint64(l))
-	l = m.TakerFeeRate.Size()
-	n += 1 + l + sovEvent(uint64(l))
-	l = m.OrderSourceFeeRatio.Size()
-	n += 1 + l + sovEvent(uint64(l))
-	return n
-}
-
-func sovEvent(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozEvent(x uint64) (n int) {
-	return sovEvent(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *EventCreateMarket) Unmarshal(dAtA []byte) error {
+func (m *EventSwapExactAmountOut) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2125,15 +7377,15 @@ func (m *EventCreateMarket) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventCreateMarket: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventSwapExactAmountOut: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventCreateMarket: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventSwapExactAmountOut: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Orderer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2161,13 +7413,89 @@ func (m *EventCreateMarket) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
+			m.Orderer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowEvent
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Routes = append(m.Routes, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowEvent
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthEvent
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthEvent
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Routes) == 0 {
+					m.Routes = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowEvent
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Routes = append(m.Routes, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Routes", wireType)
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BaseDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Input", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -2177,29 +7505,30 @@ func (m *EventCreateMarket) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthEvent
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvent
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BaseDenom = string(dAtA[iNdEx:postIndex])
+			if err := m.Input.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field QuoteDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Output", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -2209,29 +7538,30 @@ func (m *EventCreateMarket) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthEvent
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvent
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.QuoteDenom = string(dAtA[iNdEx:postIndex])
+			if err := m.Output.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Results", wireType)
 			}
-			m.MarketId = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -2241,11 +7571,26 @@ func (m *EventCreateMarket) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MarketId |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Results = append(m.Results, SwapRouteResult{})
+			if err := m.Results[len(m.Results)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvent(dAtA[iNdEx:])
@@ -2267,7 +7612,7 @@ func (m *EventCreateMarket) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventPlaceLimitOrder) Unmarshal(dAtA []byte) error {
+func (m *EventOrderFilled) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2290,10 +7635,10 @@ func (m *EventPlaceLimitOrder) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventPlaceLimitOrder: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventOrderFilled: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventPlaceLimitOrder: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventOrderFilled: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2456,9 +7801,9 @@ func (m *EventPlaceLimitOrder) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Lifespan", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OpenQuantity", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -2468,28 +7813,63 @@ func (m *EventPlaceLimitOrder) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.OpenQuantity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutedQuantity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEvent
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvent
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.Lifespan, dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ExecutedQuantity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 8:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Deadline", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Paid", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2516,15 +7896,15 @@ func (m *EventPlaceLimitOrder) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Deadline, dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Paid.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 9:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExecutedQuantity", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Received", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -2534,31 +7914,30 @@ func (m *EventPlaceLimitOrder) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthEvent
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvent
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ExecutedQuantity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Received.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 10:
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Paid", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Referrer", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -2568,28 +7947,27 @@ func (m *EventPlaceLimitOrder) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEvent
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvent
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Paid.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Referrer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 11:
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Received", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ReferralFee", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2616,7 +7994,7 @@ func (m *EventPlaceLimitOrder) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Received.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ReferralFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2641,7 +8019,7 @@ func (m *EventPlaceLimitOrder) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventPlaceBatchLimitOrder) Unmarshal(dAtA []byte) error {
+func (m *EventOrderSourceOrdersFilled) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2664,10 +8042,10 @@ func (m *EventPlaceBatchLimitOrder) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventPlaceBatchLimitOrder: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventOrderSourceOrdersFilled: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventPlaceBatchLimitOrder: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventOrderSourceOrdersFilled: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2690,10 +8068,10 @@ func (m *EventPlaceBatchLimitOrder) Unmarshal(dAtA []byte) error {
 				}
 			}
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OrderId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceName", wireType)
 			}
-			m.OrderId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -2703,11 +8081,24 @@ func (m *EventPlaceBatchLimitOrder) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.OrderId |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SourceName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Orderer", wireType)
@@ -2762,7 +8153,7 @@ func (m *EventPlaceBatchLimitOrder) Unmarshal(dAtA []byte) error {
 			m.IsBuy = bool(v != 0)
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutedQuantity", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2790,15 +8181,200 @@ func (m *EventPlaceBatchLimitOrder) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ExecutedQuantity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Quantity", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Paid", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Paid.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Received", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Received.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventOrderCompleted) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventOrderCompleted: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventOrderCompleted: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderId", wireType)
+			}
+			m.OrderId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OrderId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventIcebergOrderReplenished) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventIcebergOrderReplenished: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventIcebergOrderReplenished: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
 			}
-			var stringLen uint64
+			m.MarketId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -2808,31 +8384,16 @@ func (m *EventPlaceBatchLimitOrder) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.MarketId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvent
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvent
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Quantity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Lifespan", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderId", wireType)
 			}
-			var msglen int
+			m.OrderId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -2842,30 +8403,16 @@ func (m *EventPlaceBatchLimitOrder) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.OrderId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthEvent
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvent
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.Lifespan, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 8:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Deadline", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VisibleQuantity", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -2875,22 +8422,23 @@ func (m *EventPlaceBatchLimitOrder) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEvent
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvent
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Deadline, dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.VisibleQuantity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2915,7 +8463,7 @@ func (m *EventPlaceBatchLimitOrder) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventPlaceMMLimitOrder) Unmarshal(dAtA []byte) error {
+func (m *EventTriggerOrderTriggered) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2938,10 +8486,10 @@ func (m *EventPlaceMMLimitOrder) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventPlaceMMLimitOrder: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventTriggerOrderTriggered: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventPlaceMMLimitOrder: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventTriggerOrderTriggered: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2965,9 +8513,9 @@ func (m *EventPlaceMMLimitOrder) Unmarshal(dAtA []byte) error {
 			}
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OrderId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TriggerOrderId", wireType)
 			}
-			m.OrderId = 0
+			m.TriggerOrderId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -2977,7 +8525,7 @@ func (m *EventPlaceMMLimitOrder) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.OrderId |= uint64(b&0x7F) << shift
+				m.TriggerOrderId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -3015,28 +8563,8 @@ func (m *EventPlaceMMLimitOrder) Unmarshal(dAtA []byte) error {
 			m.Orderer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsBuy", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvent
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.IsBuy = bool(v != 0)
-		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastPrice", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3064,15 +8592,15 @@ func (m *EventPlaceMMLimitOrder) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.LastPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Quantity", wireType)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderId", wireType)
 			}
-			var stringLen uint64
+			m.OrderId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -3082,31 +8610,66 @@ func (m *EventPlaceMMLimitOrder) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.OrderId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvent
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthEvent
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Quantity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventTriggerOrderFailed) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
 			}
-			iNdEx = postIndex
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Lifespan", wireType)
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			var msglen int
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventTriggerOrderFailed: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventTriggerOrderFailed: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+			}
+			m.MarketId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -3116,30 +8679,16 @@ func (m *EventPlaceMMLimitOrder) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.MarketId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthEvent
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvent
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.Lifespan, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 8:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Deadline", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TriggerOrderId", wireType)
 			}
-			var msglen int
+			m.TriggerOrderId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -3149,28 +8698,14 @@ func (m *EventPlaceMMLimitOrder) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.TriggerOrderId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthEvent
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvent
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Deadline, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 9:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExecutedQuantity", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Orderer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3198,15 +8733,13 @@ func (m *EventPlaceMMLimitOrder) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ExecutedQuantity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Orderer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 10:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Paid", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -3216,30 +8749,79 @@ func (m *EventPlaceMMLimitOrder) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEvent
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvent
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Paid.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 11:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Received", wireType)
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventOrderExpired) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			var msglen int
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventOrderExpired: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventOrderExpired: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderId", wireType)
+			}
+			m.OrderId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -3249,25 +8831,11 @@ func (m *EventPlaceMMLimitOrder) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.OrderId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthEvent
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvent
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Received.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvent(dAtA[iNdEx:])
@@ -3289,7 +8857,7 @@ func (m *EventPlaceMMLimitOrder) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventPlaceMMBatchLimitOrder) Unmarshal(dAtA []byte) error {
+func (m *EventMarketParameterChanged) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3312,10 +8880,10 @@ func (m *EventPlaceMMBatchLimitOrder) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventPlaceMMBatchLimitOrder: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventMarketParameterChanged: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventPlaceMMBatchLimitOrder: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventMarketParameterChanged: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3338,10 +8906,10 @@ func (m *EventPlaceMMBatchLimitOrder) Unmarshal(dAtA []byte) error {
 				}
 			}
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OrderId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MakerFeeRate", wireType)
 			}
-			m.OrderId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -3351,14 +8919,29 @@ func (m *EventPlaceMMBatchLimitOrder) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.OrderId |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MakerFeeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Orderer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TakerFeeRate", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3386,13 +8969,15 @@ func (m *EventPlaceMMBatchLimitOrder) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Orderer = string(dAtA[iNdEx:postIndex])
+			if err := m.TakerFeeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsBuy", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderSourceFeeRatio", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -3402,15 +8987,29 @@ func (m *EventPlaceMMBatchLimitOrder) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.IsBuy = bool(v != 0)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.OrderSourceFeeRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TickSize", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3438,13 +9037,13 @@ func (m *EventPlaceMMBatchLimitOrder) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TickSize.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Quantity", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MinOrderQuantity", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3472,15 +9071,15 @@ func (m *EventPlaceMMBatchLimitOrder) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Quantity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.MinOrderQuantity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Lifespan", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LotSize", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -3490,30 +9089,31 @@ func (m *EventPlaceMMBatchLimitOrder) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEvent
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvent
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.Lifespan, dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.LotSize.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 8:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Deadline", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SelfTradePrevention", wireType)
 			}
-			var msglen int
+			m.SelfTradePrevention = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -3523,25 +9123,175 @@ func (m *EventPlaceMMBatchLimitOrder) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.SelfTradePrevention |= SelfTradePrevention(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventMarketStatusChanged) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventMarketStatusChanged: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventMarketStatusChanged: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+			}
+			m.MarketId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MarketId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= MarketStatus(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthEvent
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvent
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Deadline, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+		case 3:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowEvent
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.CancelledOrderIds = append(m.CancelledOrderIds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowEvent
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthEvent
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthEvent
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.CancelledOrderIds) == 0 {
+					m.CancelledOrderIds = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowEvent
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.CancelledOrderIds = append(m.CancelledOrderIds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field CancelledOrderIds", wireType)
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvent(dAtA[iNdEx:])
@@ -3563,7 +9313,7 @@ func (m *EventPlaceMMBatchLimitOrder) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventPlaceMarketOrder) Unmarshal(dAtA []byte) error {
+func (m *EventBatchMatched) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3586,10 +9336,10 @@ func (m *EventPlaceMarketOrder) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventPlaceMarketOrder: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventBatchMatched: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventPlaceMarketOrder: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventBatchMatched: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3612,27 +9362,8 @@ func (m *EventPlaceMarketOrder) Unmarshal(dAtA []byte) error {
 				}
 			}
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OrderId", wireType)
-			}
-			m.OrderId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvent
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.OrderId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Orderer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ClearingPrice", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3660,31 +9391,13 @@ func (m *EventPlaceMarketOrder) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Orderer = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsBuy", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvent
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if err := m.ClearingPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.IsBuy = bool(v != 0)
-		case 5:
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Quantity", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MatchedQuantity", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3712,13 +9425,13 @@ func (m *EventPlaceMarketOrder) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Quantity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.MatchedQuantity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExecutedQuantity", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MatchedQuote", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3746,129 +9459,13 @@ func (m *EventPlaceMarketOrder) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ExecutedQuantity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Paid", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvent
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthEvent
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvent
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Paid.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 8:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Received", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvent
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthEvent
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvent
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Received.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.MatchedQuote.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipEvent(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthEvent
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *EventCancelOrder) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowEvent
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: EventCancelOrder: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventCancelOrder: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Orderer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseFee", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3893,83 +9490,16 @@ func (m *EventCancelOrder) Unmarshal(dAtA []byte) error {
 			if postIndex < 0 {
 				return ErrInvalidLengthEvent
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Orderer = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OrderId", wireType)
-			}
-			m.OrderId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvent
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.OrderId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipEvent(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthEvent
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *EventCancelAllOrders) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowEvent
-			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.BaseFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: EventCancelAllOrders: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventCancelAllOrders: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Orderer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field QuoteFee", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3997,13 +9527,15 @@ func (m *EventCancelAllOrders) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Orderer = string(dAtA[iNdEx:postIndex])
+			if err := m.QuoteFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 7:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NumFilledOrders", wireType)
 			}
-			m.MarketId = 0
+			m.NumFilledOrders = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -4013,87 +9545,45 @@ func (m *EventCancelAllOrders) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MarketId |= uint64(b&0x7F) << shift
+				m.NumFilledOrders |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
-			if wireType == 0 {
-				var v uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowEvent
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.CancelledOrderIds = append(m.CancelledOrderIds, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowEvent
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthEvent
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthEvent
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderSourceQuantity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
 				}
-				if postIndex > l {
+				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
 				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
-				}
-				elementCount = count
-				if elementCount != 0 && len(m.CancelledOrderIds) == 0 {
-					m.CancelledOrderIds = make([]uint64, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowEvent
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.CancelledOrderIds = append(m.CancelledOrderIds, v)
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field CancelledOrderIds", wireType)
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.OrderSourceQuantity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvent(dAtA[iNdEx:])
@@ -4115,7 +9605,7 @@ func (m *EventCancelAllOrders) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventSwapExactAmountIn) Unmarshal(dAtA []byte) error {
+func (m *EventCircuitBreakerTripped) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4138,15 +9628,34 @@ func (m *EventSwapExactAmountIn) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventSwapExactAmountIn: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventCircuitBreakerTripped: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventSwapExactAmountIn: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventCircuitBreakerTripped: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+			}
+			m.MarketId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MarketId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Orderer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ReferencePrice", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4174,89 +9683,49 @@ func (m *EventSwapExactAmountIn) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Orderer = string(dAtA[iNdEx:postIndex])
+			if err := m.ReferencePrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
-			if wireType == 0 {
-				var v uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowEvent
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.Routes = append(m.Routes, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowEvent
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthEvent
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthEvent
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
 				}
-				if postIndex > l {
+				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
 				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
-				}
-				elementCount = count
-				if elementCount != 0 && len(m.Routes) == 0 {
-					m.Routes = make([]uint64, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowEvent
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.Routes = append(m.Routes, v)
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field Routes", wireType)
 			}
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Input", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LastPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndHeight", wireType)
 			}
-			var msglen int
+			m.EndHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -4266,30 +9735,66 @@ func (m *EventSwapExactAmountIn) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.EndHeight |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthEvent
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthEvent
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Input.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventCircuitBreakerReset) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
 			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Output", wireType)
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			var msglen int
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventCircuitBreakerReset: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventCircuitBreakerReset: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+			}
+			m.MarketId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -4299,30 +9804,16 @@ func (m *EventSwapExactAmountIn) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.MarketId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthEvent
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvent
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Output.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 5:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Results", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastPrice", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -4332,23 +9823,25 @@ func (m *EventSwapExactAmountIn) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEvent
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvent
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Results = append(m.Results, SwapRouteResult{})
-			if err := m.Results[len(m.Results)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			var v github_com_cosmos_cosmos_sdk_types.Dec
+			m.LastPrice = &v
+			if err := m.LastPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4373,7 +9866,7 @@ func (m *EventSwapExactAmountIn) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventOrderFilled) Unmarshal(dAtA []byte) error {
+func (m *EventAmendOrder) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4396,10 +9889,10 @@ func (m *EventOrderFilled) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventOrderFilled: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventAmendOrder: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventOrderFilled: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventAmendOrder: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4473,8 +9966,143 @@ func (m *EventOrderFilled) Unmarshal(dAtA []byte) error {
 			m.Orderer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quantity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Quantity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OpenQuantity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.OpenQuantity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deadline", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Deadline, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsBuy", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Requeued", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -4491,10 +10119,60 @@ func (m *EventOrderFilled) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.IsBuy = bool(v != 0)
-		case 5:
+			m.Requeued = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventSetCancelAfter) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventSetCancelAfter: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventSetCancelAfter: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Orderer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4522,15 +10200,89 @@ func (m *EventOrderFilled) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Orderer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 2:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowEvent
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.MarketIds = append(m.MarketIds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowEvent
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthEvent
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthEvent
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.MarketIds) == 0 {
+					m.MarketIds = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowEvent
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.MarketIds = append(m.MarketIds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketIds", wireType)
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Quantity", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CancelAfter", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -4540,63 +10292,81 @@ func (m *EventOrderFilled) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthEvent
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvent
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Quantity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.CancelAfter == nil {
+				m.CancelAfter = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.CancelAfter, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OpenQuantity", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvent
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthEvent
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvent
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventCancelAfterTriggered) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.OpenQuantity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 8:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventCancelAfterTriggered: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventCancelAfterTriggered: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExecutedQuantity", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Orderer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4624,15 +10394,13 @@ func (m *EventOrderFilled) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ExecutedQuantity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Orderer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 9:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Paid", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
 			}
-			var msglen int
+			m.MarketId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -4642,58 +10410,87 @@ func (m *EventOrderFilled) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.MarketId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthEvent
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvent
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Paid.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 10:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Received", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvent
+		case 3:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowEvent
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.CancelledOrderIds = append(m.CancelledOrderIds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowEvent
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthEvent
 				}
-				if iNdEx >= l {
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthEvent
+				}
+				if postIndex > l {
 					return io.ErrUnexpectedEOF
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
 				}
+				elementCount = count
+				if elementCount != 0 && len(m.CancelledOrderIds) == 0 {
+					m.CancelledOrderIds = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowEvent
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.CancelledOrderIds = append(m.CancelledOrderIds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field CancelledOrderIds", wireType)
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthEvent
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvent
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Received.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvent(dAtA[iNdEx:])
@@ -4715,7 +10512,7 @@ func (m *EventOrderFilled) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventOrderSourceOrdersFilled) Unmarshal(dAtA []byte) error {
+func (m *EventReplaceMMOrders) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4738,64 +10535,13 @@ func (m *EventOrderSourceOrdersFilled) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventOrderSourceOrdersFilled: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventReplaceMMOrders: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventOrderSourceOrdersFilled: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventReplaceMMOrders: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
-			}
-			m.MarketId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvent
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.MarketId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SourceName", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvent
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvent
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvent
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.SourceName = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Orderer", wireType)
 			}
@@ -4827,11 +10573,11 @@ func (m *EventOrderSourceOrdersFilled) Unmarshal(dAtA []byte) error {
 			}
 			m.Orderer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsBuy", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
 			}
-			var v int
+			m.MarketId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -4841,112 +10587,163 @@ func (m *EventOrderSourceOrdersFilled) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.MarketId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.IsBuy = bool(v != 0)
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExecutedQuantity", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvent
+		case 3:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowEvent
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				if iNdEx >= l {
+				m.CancelledOrderIds = append(m.CancelledOrderIds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowEvent
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthEvent
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthEvent
+				}
+				if postIndex > l {
 					return io.ErrUnexpectedEOF
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
 				}
+				elementCount = count
+				if elementCount != 0 && len(m.CancelledOrderIds) == 0 {
+					m.CancelledOrderIds = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowEvent
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.CancelledOrderIds = append(m.CancelledOrderIds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field CancelledOrderIds", wireType)
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvent
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvent
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.ExecutedQuantity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Paid", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvent
+		case 4:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowEvent
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
+				m.OrderIds = append(m.OrderIds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowEvent
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				if packedLen < 0 {
+					return ErrInvalidLengthEvent
 				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthEvent
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvent
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Paid.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Received", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvent
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthEvent
 				}
-				if iNdEx >= l {
+				if postIndex > l {
 					return io.ErrUnexpectedEOF
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
 				}
+				elementCount = count
+				if elementCount != 0 && len(m.OrderIds) == 0 {
+					m.OrderIds = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowEvent
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.OrderIds = append(m.OrderIds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderIds", wireType)
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthEvent
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvent
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Received.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvent(dAtA[iNdEx:])
@@ -4968,7 +10765,7 @@ func (m *EventOrderSourceOrdersFilled) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventOrderCompleted) Unmarshal(dAtA []byte) error {
+func (m *EventSelfTradePrevented) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4991,17 +10788,17 @@ func (m *EventOrderCompleted) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventOrderCompleted: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventSelfTradePrevented: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventOrderCompleted: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventSelfTradePrevented: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OrderId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
 			}
-			m.OrderId = 0
+			m.MarketId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -5011,66 +10808,16 @@ func (m *EventOrderCompleted) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.OrderId |= uint64(b&0x7F) << shift
+				m.MarketId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipEvent(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthEvent
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *EventOrderExpired) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowEvent
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: EventOrderExpired: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventOrderExpired: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OrderId", wireType)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Orderer", wireType)
 			}
-			m.OrderId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -5080,66 +10827,48 @@ func (m *EventOrderExpired) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.OrderId |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipEvent(dAtA[iNdEx:])
-			if err != nil {
-				return err
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
 				return ErrInvalidLengthEvent
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *EventMarketParameterChanged) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowEvent
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			m.Orderer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mode", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Mode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Mode |= SelfTradePrevention(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: EventMarketParameterChanged: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventMarketParameterChanged: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NewestOrderId", wireType)
 			}
-			m.MarketId = 0
+			m.NewestOrderId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -5149,14 +10878,14 @@ func (m *EventMarketParameterChanged) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MarketId |= uint64(b&0x7F) << shift
+				m.NewestOrderId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MakerFeeRate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NewestSourceName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5184,13 +10913,30 @@ func (m *EventMarketParameterChanged) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MakerFeeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.NewestSourceName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OldestOrderId", wireType)
+			}
+			m.OldestOrderId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OldestOrderId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TakerFeeRate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OldestSourceName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5218,13 +10964,11 @@ func (m *EventMarketParameterChanged) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TakerFeeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.OldestSourceName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OrderSourceFeeRatio", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Quantity", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5252,7 +10996,7 @@ func (m *EventMarketParameterChanged) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.OrderSourceFeeRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Quantity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
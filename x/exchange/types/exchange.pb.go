@@ -29,6 +29,44 @@ var _ = time.Kitchen
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// MarketStatus specifies which operations are allowed in a market.
+type MarketStatus int32
+
+const (
+	// MARKET_STATUS_ACTIVE allows all operations.
+	MarketStatusActive MarketStatus = 0
+	// MARKET_STATUS_CANCEL_ONLY allows only cancelling orders. Orders are not
+	// matched.
+	MarketStatusCancelOnly MarketStatus = 1
+	// MARKET_STATUS_HALTED stops all operations including cancelling orders.
+	MarketStatusHalted MarketStatus = 2
+	// MARKET_STATUS_DELISTED is the final status of a market. All orders in the
+	// market are cancelled when the market is delisted.
+	MarketStatusDelisted MarketStatus = 3
+)
+
+var MarketStatus_name = map[int32]string{
+	0: "MARKET_STATUS_ACTIVE",
+	1: "MARKET_STATUS_CANCEL_ONLY",
+	2: "MARKET_STATUS_HALTED",
+	3: "MARKET_STATUS_DELISTED",
+}
+
+var MarketStatus_value = map[string]int32{
+	"MARKET_STATUS_ACTIVE":      0,
+	"MARKET_STATUS_CANCEL_ONLY": 1,
+	"MARKET_STATUS_HALTED":      2,
+	"MARKET_STATUS_DELISTED":    3,
+}
+
+func (x MarketStatus) String() string {
+	return proto.EnumName(MarketStatus_name, int32(x))
+}
+
+func (MarketStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_bb2114aee993f375, []int{0}
+}
+
 type OrderType int32
 
 const (
@@ -54,7 +92,127 @@ func (x OrderType) String() string {
 }
 
 func (OrderType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_bb2114aee993f375, []int{0}
+	return fileDescriptor_bb2114aee993f375, []int{1}
+}
+
+// TimeInForce specifies how long a limit order remains active.
+type TimeInForce int32
+
+const (
+	// TIME_IN_FORCE_GOOD_TIL_TIME rests the unfilled quantity of the order on
+	// the order book until the order's deadline.
+	TimeInForceGoodTilTime TimeInForce = 0
+	// TIME_IN_FORCE_POST_ONLY rejects the order if it would match against
+	// existing orders on placement, which guarantees the order to be a maker.
+	TimeInForcePostOnly TimeInForce = 1
+	// TIME_IN_FORCE_IMMEDIATE_OR_CANCEL executes the order as much as possible
+	// on placement and cancels the unfilled quantity.
+	TimeInForceImmediateOrCancel TimeInForce = 2
+	// TIME_IN_FORCE_FILL_OR_KILL rejects the order unless it can be fully
+	// executed on placement.
+	TimeInForceFillOrKill TimeInForce = 3
+)
+
+var TimeInForce_name = map[int32]string{
+	0: "TIME_IN_FORCE_GOOD_TIL_TIME",
+	1: "TIME_IN_FORCE_POST_ONLY",
+	2: "TIME_IN_FORCE_IMMEDIATE_OR_CANCEL",
+	3: "TIME_IN_FORCE_FILL_OR_KILL",
+}
+
+var TimeInForce_value = map[string]int32{
+	"TIME_IN_FORCE_GOOD_TIL_TIME":       0,
+	"TIME_IN_FORCE_POST_ONLY":           1,
+	"TIME_IN_FORCE_IMMEDIATE_OR_CANCEL": 2,
+	"TIME_IN_FORCE_FILL_OR_KILL":        3,
+}
+
+func (x TimeInForce) String() string {
+	return proto.EnumName(TimeInForce_name, int32(x))
+}
+
+func (TimeInForce) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_bb2114aee993f375, []int{2}
+}
+
+// SelfTradePrevention specifies what happens when orders from the same orderer
+// would match against each other.
+// The mode of the newest order among the two orders is applied.
+type SelfTradePrevention int32
+
+const (
+	// SELF_TRADE_PREVENTION_UNSPECIFIED follows the market's mode. For markets,
+	// it is the same as SELF_TRADE_PREVENTION_NONE.
+	SelfTradePreventionUnspecified SelfTradePrevention = 0
+	// SELF_TRADE_PREVENTION_NONE allows self-trades.
+	SelfTradePreventionNone SelfTradePrevention = 1
+	// SELF_TRADE_PREVENTION_CANCEL_NEWEST cancels the newest order.
+	SelfTradePreventionCancelNewest SelfTradePrevention = 2
+	// SELF_TRADE_PREVENTION_CANCEL_OLDEST cancels the oldest order.
+	SelfTradePreventionCancelOldest SelfTradePrevention = 3
+	// SELF_TRADE_PREVENTION_CANCEL_BOTH cancels both orders.
+	SelfTradePreventionCancelBoth SelfTradePrevention = 4
+	// SELF_TRADE_PREVENTION_DECREMENT_AND_CANCEL decreases the quantity of both
+	// orders by the smaller quantity of the two and cancels the smaller order.
+	SelfTradePreventionDecrementAndCancel SelfTradePrevention = 5
+)
+
+var SelfTradePrevention_name = map[int32]string{
+	0: "SELF_TRADE_PREVENTION_UNSPECIFIED",
+	1: "SELF_TRADE_PREVENTION_NONE",
+	2: "SELF_TRADE_PREVENTION_CANCEL_NEWEST",
+	3: "SELF_TRADE_PREVENTION_CANCEL_OLDEST",
+	4: "SELF_TRADE_PREVENTION_CANCEL_BOTH",
+	5: "SELF_TRADE_PREVENTION_DECREMENT_AND_CANCEL",
+}
+
+var SelfTradePrevention_value = map[string]int32{
+	"SELF_TRADE_PREVENTION_UNSPECIFIED":          0,
+	"SELF_TRADE_PREVENTION_NONE":                 1,
+	"SELF_TRADE_PREVENTION_CANCEL_NEWEST":        2,
+	"SELF_TRADE_PREVENTION_CANCEL_OLDEST":        3,
+	"SELF_TRADE_PREVENTION_CANCEL_BOTH":          4,
+	"SELF_TRADE_PREVENTION_DECREMENT_AND_CANCEL": 5,
+}
+
+func (x SelfTradePrevention) String() string {
+	return proto.EnumName(SelfTradePrevention_name, int32(x))
+}
+
+func (SelfTradePrevention) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_bb2114aee993f375, []int{3}
+}
+
+type TriggerCondition int32
+
+const (
+	TriggerConditionUnspecified TriggerCondition = 0
+	// TRIGGER_CONDITION_PRICE_ABOVE triggers the order when the last price
+	// becomes greater than or equal to the trigger price.
+	TriggerConditionPriceAbove TriggerCondition = 1
+	// TRIGGER_CONDITION_PRICE_BELOW triggers the order when the last price
+	// becomes less than or equal to the trigger price.
+	TriggerConditionPriceBelow TriggerCondition = 2
+)
+
+var TriggerCondition_name = map[int32]string{
+	0: "TRIGGER_CONDITION_UNSPECIFIED",
+	1: "TRIGGER_CONDITION_PRICE_ABOVE",
+	2: "TRIGGER_CONDITION_PRICE_BELOW",
+}
+
+var TriggerCondition_value = map[string]int32{
+	"TRIGGER_CONDITION_UNSPECIFIED": 0,
+	"TRIGGER_CONDITION_PRICE_ABOVE": 1,
+	"TRIGGER_CONDITION_PRICE_BELOW": 2,
+}
+
+func (x TriggerCondition) String() string {
+	return proto.EnumName(TriggerCondition_name, int32(x))
+}
+
+func (TriggerCondition) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_bb2114aee993f375, []int{4}
 }
 
 type Market struct {
@@ -65,6 +223,18 @@ type Market struct {
 	MakerFeeRate        github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=maker_fee_rate,json=makerFeeRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"maker_fee_rate"`
 	TakerFeeRate        github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=taker_fee_rate,json=takerFeeRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"taker_fee_rate"`
 	OrderSourceFeeRatio github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,7,opt,name=order_source_fee_ratio,json=orderSourceFeeRatio,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"order_source_fee_ratio"`
+	Status              MarketStatus                           `protobuf:"varint,8,opt,name=status,proto3,enum=crescent.exchange.v1beta1.MarketStatus" json:"status,omitempty"`
+	// tick_size is the price increment of orders in the market. Zero means
+	// orders can be placed at any valid tick price.
+	TickSize github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,9,opt,name=tick_size,json=tickSize,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"tick_size"`
+	// min_order_quantity is the minimum quantity of orders in the market.
+	MinOrderQuantity github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,10,opt,name=min_order_quantity,json=minOrderQuantity,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"min_order_quantity"`
+	// lot_size is the quantity increment of orders in the market. Zero means
+	// orders can be placed with any integer quantity.
+	LotSize github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,11,opt,name=lot_size,json=lotSize,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"lot_size"`
+	// self_trade_prevention is the default self-trade prevention mode of orders
+	// in the market.
+	SelfTradePrevention SelfTradePrevention `protobuf:"varint,12,opt,name=self_trade_prevention,json=selfTradePrevention,proto3,enum=crescent.exchange.v1beta1.SelfTradePrevention" json:"self_trade_prevention,omitempty"`
 }
 
 func (m *Market) Reset()         { *m = Market{} }
@@ -103,6 +273,24 @@ var xxx_messageInfo_Market proto.InternalMessageInfo
 type MarketState struct {
 	LastPrice          *github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=last_price,json=lastPrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"last_price,omitempty"`
 	LastMatchingHeight int64                                   `protobuf:"varint,2,opt,name=last_matching_height,json=lastMatchingHeight,proto3" json:"last_matching_height,omitempty"`
+	// num_price_observations is the number of price observations stored in the
+	// market's ring of observations.
+	NumPriceObservations uint32 `protobuf:"varint,3,opt,name=num_price_observations,json=numPriceObservations,proto3" json:"num_price_observations,omitempty"`
+	// last_price_observation_index is the ring index of the latest price
+	// observation.
+	LastPriceObservationIndex uint32 `protobuf:"varint,4,opt,name=last_price_observation_index,json=lastPriceObservationIndex,proto3" json:"last_price_observation_index,omitempty"`
+	// circuit_breaker_end_height is the height at which the market reopens
+	// after its circuit breaker tripped. The market is matched normally if 0.
+	CircuitBreakerEndHeight int64 `protobuf:"varint,5,opt,name=circuit_breaker_end_height,json=circuitBreakerEndHeight,proto3" json:"circuit_breaker_end_height,omitempty"`
+	// circuit_breaker_reopen_height is the height at which the market was last
+	// reopened. Prices observed before it don't trip the circuit breaker.
+	CircuitBreakerReopenHeight int64 `protobuf:"varint,6,opt,name=circuit_breaker_reopen_height,json=circuitBreakerReopenHeight,proto3" json:"circuit_breaker_reopen_height,omitempty"`
+	// hourly_volumes is the ring of the market's trading volumes in the last 24
+	// hours, indexed by the hour since the Unix epoch modulo 24.
+	HourlyVolumes []MarketVolume `protobuf:"bytes,7,rep,name=hourly_volumes,json=hourlyVolumes,proto3" json:"hourly_volumes"`
+	// last_volume_hour is the hour since the Unix epoch the volume was last
+	// added in.
+	LastVolumeHour int64 `protobuf:"varint,8,opt,name=last_volume_hour,json=lastVolumeHour,proto3" json:"last_volume_hour,omitempty"`
 }
 
 func (m *MarketState) Reset()         { *m = MarketState{} }
@@ -138,25 +326,123 @@ func (m *MarketState) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MarketState proto.InternalMessageInfo
 
+// MarketVolume is the market's trading volume in base and quote denoms.
+type MarketVolume struct {
+	BaseVolume  github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=base_volume,json=baseVolume,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"base_volume"`
+	QuoteVolume github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=quote_volume,json=quoteVolume,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"quote_volume"`
+}
+
+func (m *MarketVolume) Reset()         { *m = MarketVolume{} }
+func (m *MarketVolume) String() string { return proto.CompactTextString(m) }
+func (*MarketVolume) ProtoMessage()    {}
+func (*MarketVolume) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bb2114aee993f375, []int{2}
+}
+func (m *MarketVolume) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MarketVolume) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MarketVolume.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MarketVolume) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MarketVolume.Merge(m, src)
+}
+func (m *MarketVolume) XXX_Size() int {
+	return m.Size()
+}
+func (m *MarketVolume) XXX_DiscardUnknown() {
+	xxx_messageInfo_MarketVolume.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MarketVolume proto.InternalMessageInfo
+
+// PriceObservation records the market's cumulative price at a block.
+type PriceObservation struct {
+	Time time.Time `protobuf:"bytes,1,opt,name=time,proto3,stdtime" json:"time"`
+	// cumulative_price is the sum of the market's last price multiplied by the
+	// number of seconds the price lasted, until time.
+	CumulativePrice github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=cumulative_price,json=cumulativePrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"cumulative_price"`
+	// price is the market's last price at the end of the block.
+	Price  github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=price,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"price"`
+	Height int64                                  `protobuf:"varint,4,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *PriceObservation) Reset()         { *m = PriceObservation{} }
+func (m *PriceObservation) String() string { return proto.CompactTextString(m) }
+func (*PriceObservation) ProtoMessage()    {}
+func (*PriceObservation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bb2114aee993f375, []int{3}
+}
+func (m *PriceObservation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PriceObservation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PriceObservation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PriceObservation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PriceObservation.Merge(m, src)
+}
+func (m *PriceObservation) XXX_Size() int {
+	return m.Size()
+}
+func (m *PriceObservation) XXX_DiscardUnknown() {
+	xxx_messageInfo_PriceObservation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PriceObservation proto.InternalMessageInfo
+
 type Order struct {
-	Id               uint64                                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Type             OrderType                              `protobuf:"varint,2,opt,name=type,proto3,enum=crescent.exchange.v1beta1.OrderType" json:"type,omitempty"`
-	Orderer          string                                 `protobuf:"bytes,3,opt,name=orderer,proto3" json:"orderer,omitempty"`
-	MarketId         uint64                                 `protobuf:"varint,4,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
-	IsBuy            bool                                   `protobuf:"varint,5,opt,name=is_buy,json=isBuy,proto3" json:"is_buy,omitempty"`
-	Price            github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=price,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"price"`
-	Quantity         github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,7,opt,name=quantity,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"quantity"`
-	MsgHeight        int64                                  `protobuf:"varint,8,opt,name=msg_height,json=msgHeight,proto3" json:"msg_height,omitempty"`
-	OpenQuantity     github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,9,opt,name=open_quantity,json=openQuantity,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"open_quantity"`
-	RemainingDeposit github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,10,opt,name=remaining_deposit,json=remainingDeposit,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"remaining_deposit"`
-	Deadline         time.Time                              `protobuf:"bytes,11,opt,name=deadline,proto3,stdtime" json:"deadline"`
+	Id                  uint64                                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Type                OrderType                              `protobuf:"varint,2,opt,name=type,proto3,enum=crescent.exchange.v1beta1.OrderType" json:"type,omitempty"`
+	Orderer             string                                 `protobuf:"bytes,3,opt,name=orderer,proto3" json:"orderer,omitempty"`
+	MarketId            uint64                                 `protobuf:"varint,4,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	IsBuy               bool                                   `protobuf:"varint,5,opt,name=is_buy,json=isBuy,proto3" json:"is_buy,omitempty"`
+	Price               github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=price,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"price"`
+	Quantity            github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,7,opt,name=quantity,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"quantity"`
+	MsgHeight           int64                                  `protobuf:"varint,8,opt,name=msg_height,json=msgHeight,proto3" json:"msg_height,omitempty"`
+	OpenQuantity        github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,9,opt,name=open_quantity,json=openQuantity,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"open_quantity"`
+	RemainingDeposit    github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,10,opt,name=remaining_deposit,json=remainingDeposit,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"remaining_deposit"`
+	Deadline            time.Time                              `protobuf:"bytes,11,opt,name=deadline,proto3,stdtime" json:"deadline"`
+	TimeInForce         TimeInForce                            `protobuf:"varint,12,opt,name=time_in_force,json=timeInForce,proto3,enum=crescent.exchange.v1beta1.TimeInForce" json:"time_in_force,omitempty"`
+	SelfTradePrevention SelfTradePrevention                    `protobuf:"varint,13,opt,name=self_trade_prevention,json=selfTradePrevention,proto3,enum=crescent.exchange.v1beta1.SelfTradePrevention" json:"self_trade_prevention,omitempty"`
+	// referrer is the address which receives a share of the taker fee paid by
+	// the order.
+	Referrer string `protobuf:"bytes,14,opt,name=referrer,proto3" json:"referrer,omitempty"`
+	// display_quantity is the quantity displayed on the order book at a time
+	// for iceberg orders. It is not set for normal orders.
+	DisplayQuantity *github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,15,opt,name=display_quantity,json=displayQuantity,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"display_quantity,omitempty"`
+	// hidden_quantity is the reserve of an iceberg order which is not displayed
+	// on the order book. It is always zero for normal orders.
+	HiddenQuantity github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,16,opt,name=hidden_quantity,json=hiddenQuantity,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"hidden_quantity"`
+	// priority_height is the block height from which the order's time priority
+	// counts. It is the same as msg_height unless the order has lost its
+	// priority.
+	PriorityHeight int64 `protobuf:"varint,17,opt,name=priority_height,json=priorityHeight,proto3" json:"priority_height,omitempty"`
 }
 
 func (m *Order) Reset()         { *m = Order{} }
 func (m *Order) String() string { return proto.CompactTextString(m) }
 func (*Order) ProtoMessage()    {}
 func (*Order) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bb2114aee993f375, []int{2}
+	return fileDescriptor_bb2114aee993f375, []int{4}
 }
 func (m *Order) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -185,6 +471,141 @@ func (m *Order) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Order proto.InternalMessageInfo
 
+// ReferrerStats is the accumulated statistics of orders referred by a
+// referrer in a market.
+type ReferrerStats struct {
+	// volume is the accumulated executed quote amount of the referred orders.
+	Volume github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=volume,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"volume"`
+	// fees is the accumulated referral fees paid to the referrer.
+	Fees github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,2,rep,name=fees,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"fees"`
+}
+
+func (m *ReferrerStats) Reset()         { *m = ReferrerStats{} }
+func (m *ReferrerStats) String() string { return proto.CompactTextString(m) }
+func (*ReferrerStats) ProtoMessage()    {}
+func (*ReferrerStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bb2114aee993f375, []int{5}
+}
+func (m *ReferrerStats) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReferrerStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ReferrerStats.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ReferrerStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReferrerStats.Merge(m, src)
+}
+func (m *ReferrerStats) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReferrerStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReferrerStats.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReferrerStats proto.InternalMessageInfo
+
+// PriceLevel is the aggregate of user orders on an order book side at a
+// price.
+type PriceLevel struct {
+	// total_open_quantity is the sum of the open quantities of the orders at the
+	// price.
+	TotalOpenQuantity github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=total_open_quantity,json=totalOpenQuantity,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"total_open_quantity"`
+	NumOrders         uint64                                 `protobuf:"varint,2,opt,name=num_orders,json=numOrders,proto3" json:"num_orders,omitempty"`
+}
+
+func (m *PriceLevel) Reset()         { *m = PriceLevel{} }
+func (m *PriceLevel) String() string { return proto.CompactTextString(m) }
+func (*PriceLevel) ProtoMessage()    {}
+func (*PriceLevel) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bb2114aee993f375, []int{6}
+}
+func (m *PriceLevel) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PriceLevel) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PriceLevel.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PriceLevel) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PriceLevel.Merge(m, src)
+}
+func (m *PriceLevel) XXX_Size() int {
+	return m.Size()
+}
+func (m *PriceLevel) XXX_DiscardUnknown() {
+	xxx_messageInfo_PriceLevel.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PriceLevel proto.InternalMessageInfo
+
+// TriggerOrder is a conditional order which sits dormant until the market's
+// last price crosses the trigger price.
+// When triggered, a limit order is placed if price is set, otherwise a market
+// order is placed.
+type TriggerOrder struct {
+	Id           uint64                                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Orderer      string                                  `protobuf:"bytes,2,opt,name=orderer,proto3" json:"orderer,omitempty"`
+	MarketId     uint64                                  `protobuf:"varint,3,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	IsBuy        bool                                    `protobuf:"varint,4,opt,name=is_buy,json=isBuy,proto3" json:"is_buy,omitempty"`
+	Condition    TriggerCondition                        `protobuf:"varint,5,opt,name=condition,proto3,enum=crescent.exchange.v1beta1.TriggerCondition" json:"condition,omitempty"`
+	TriggerPrice github_com_cosmos_cosmos_sdk_types.Dec  `protobuf:"bytes,6,opt,name=trigger_price,json=triggerPrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"trigger_price"`
+	Price        *github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,7,opt,name=price,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"price,omitempty"`
+	Quantity     github_com_cosmos_cosmos_sdk_types.Dec  `protobuf:"bytes,8,opt,name=quantity,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"quantity"`
+	MsgHeight    int64                                   `protobuf:"varint,9,opt,name=msg_height,json=msgHeight,proto3" json:"msg_height,omitempty"`
+	Deposit      github_com_cosmos_cosmos_sdk_types.Dec  `protobuf:"bytes,10,opt,name=deposit,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"deposit"`
+	Deadline     time.Time                               `protobuf:"bytes,11,opt,name=deadline,proto3,stdtime" json:"deadline"`
+}
+
+func (m *TriggerOrder) Reset()         { *m = TriggerOrder{} }
+func (m *TriggerOrder) String() string { return proto.CompactTextString(m) }
+func (*TriggerOrder) ProtoMessage()    {}
+func (*TriggerOrder) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bb2114aee993f375, []int{7}
+}
+func (m *TriggerOrder) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TriggerOrder) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TriggerOrder.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TriggerOrder) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TriggerOrder.Merge(m, src)
+}
+func (m *TriggerOrder) XXX_Size() int {
+	return m.Size()
+}
+func (m *TriggerOrder) XXX_DiscardUnknown() {
+	xxx_messageInfo_TriggerOrder.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TriggerOrder proto.InternalMessageInfo
+
 type SwapRouteResult struct {
 	MarketId         uint64                                 `protobuf:"varint,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
 	ExecutedQuantity github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=executed_quantity,json=executedQuantity,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"executed_quantity"`
@@ -197,7 +618,7 @@ func (m *SwapRouteResult) Reset()         { *m = SwapRouteResult{} }
 func (m *SwapRouteResult) String() string { return proto.CompactTextString(m) }
 func (*SwapRouteResult) ProtoMessage()    {}
 func (*SwapRouteResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bb2114aee993f375, []int{3}
+	return fileDescriptor_bb2114aee993f375, []int{8}
 }
 func (m *SwapRouteResult) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -226,12 +647,103 @@ func (m *SwapRouteResult) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_SwapRouteResult proto.InternalMessageInfo
 
+// WeightedSwapRoute is one of the routes a swap's input is split across.
+// The input is distributed to the routes in proportion to their weights.
+type WeightedSwapRoute struct {
+	Routes []uint64                               `protobuf:"varint,1,rep,packed,name=routes,proto3" json:"routes,omitempty"`
+	Weight github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=weight,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"weight"`
+}
+
+func (m *WeightedSwapRoute) Reset()         { *m = WeightedSwapRoute{} }
+func (m *WeightedSwapRoute) String() string { return proto.CompactTextString(m) }
+func (*WeightedSwapRoute) ProtoMessage()    {}
+func (*WeightedSwapRoute) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bb2114aee993f375, []int{9}
+}
+func (m *WeightedSwapRoute) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WeightedSwapRoute) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WeightedSwapRoute.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *WeightedSwapRoute) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WeightedSwapRoute.Merge(m, src)
+}
+func (m *WeightedSwapRoute) XXX_Size() int {
+	return m.Size()
+}
+func (m *WeightedSwapRoute) XXX_DiscardUnknown() {
+	xxx_messageInfo_WeightedSwapRoute.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WeightedSwapRoute proto.InternalMessageInfo
+
+type WeightedSwapRouteResult struct {
+	Routes  []uint64          `protobuf:"varint,1,rep,packed,name=routes,proto3" json:"routes,omitempty"`
+	Input   types.DecCoin     `protobuf:"bytes,2,opt,name=input,proto3" json:"input"`
+	Output  types.DecCoin     `protobuf:"bytes,3,opt,name=output,proto3" json:"output"`
+	Results []SwapRouteResult `protobuf:"bytes,4,rep,name=results,proto3" json:"results"`
+}
+
+func (m *WeightedSwapRouteResult) Reset()         { *m = WeightedSwapRouteResult{} }
+func (m *WeightedSwapRouteResult) String() string { return proto.CompactTextString(m) }
+func (*WeightedSwapRouteResult) ProtoMessage()    {}
+func (*WeightedSwapRouteResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bb2114aee993f375, []int{10}
+}
+func (m *WeightedSwapRouteResult) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WeightedSwapRouteResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WeightedSwapRouteResult.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *WeightedSwapRouteResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WeightedSwapRouteResult.Merge(m, src)
+}
+func (m *WeightedSwapRouteResult) XXX_Size() int {
+	return m.Size()
+}
+func (m *WeightedSwapRouteResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_WeightedSwapRouteResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WeightedSwapRouteResult proto.InternalMessageInfo
+
 func init() {
+	proto.RegisterEnum("crescent.exchange.v1beta1.MarketStatus", MarketStatus_name, MarketStatus_value)
 	proto.RegisterEnum("crescent.exchange.v1beta1.OrderType", OrderType_name, OrderType_value)
+	proto.RegisterEnum("crescent.exchange.v1beta1.TimeInForce", TimeInForce_name, TimeInForce_value)
+	proto.RegisterEnum("crescent.exchange.v1beta1.SelfTradePrevention", SelfTradePrevention_name, SelfTradePrevention_value)
+	proto.RegisterEnum("crescent.exchange.v1beta1.TriggerCondition", TriggerCondition_name, TriggerCondition_value)
 	proto.RegisterType((*Market)(nil), "crescent.exchange.v1beta1.Market")
 	proto.RegisterType((*MarketState)(nil), "crescent.exchange.v1beta1.MarketState")
+	proto.RegisterType((*MarketVolume)(nil), "crescent.exchange.v1beta1.MarketVolume")
+	proto.RegisterType((*PriceObservation)(nil), "crescent.exchange.v1beta1.PriceObservation")
 	proto.RegisterType((*Order)(nil), "crescent.exchange.v1beta1.Order")
+	proto.RegisterType((*ReferrerStats)(nil), "crescent.exchange.v1beta1.ReferrerStats")
+	proto.RegisterType((*PriceLevel)(nil), "crescent.exchange.v1beta1.PriceLevel")
+	proto.RegisterType((*TriggerOrder)(nil), "crescent.exchange.v1beta1.TriggerOrder")
 	proto.RegisterType((*SwapRouteResult)(nil), "crescent.exchange.v1beta1.SwapRouteResult")
+	proto.RegisterType((*WeightedSwapRoute)(nil), "crescent.exchange.v1beta1.WeightedSwapRoute")
+	proto.RegisterType((*WeightedSwapRouteResult)(nil), "crescent.exchange.v1beta1.WeightedSwapRouteResult")
 }
 
 func init() {
@@ -239,60 +751,137 @@ func init() {
 }
 
 var fileDescriptor_bb2114aee993f375 = []byte{
-	// 843 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x55, 0x41, 0x6f, 0x1b, 0x55,
-	0x10, 0xf6, 0x3a, 0xb6, 0x6b, 0x8f, 0x89, 0xeb, 0x3e, 0x42, 0xb5, 0x18, 0x58, 0x5b, 0x16, 0x20,
-	0xab, 0x52, 0x77, 0x69, 0x28, 0x52, 0xc4, 0x09, 0x5c, 0xa7, 0xc2, 0xa8, 0xa6, 0x61, 0xed, 0x22,
-	0x41, 0x0f, 0xab, 0xf5, 0xee, 0xc4, 0x7e, 0x4a, 0x76, 0xdf, 0x76, 0xdf, 0xdb, 0x26, 0xfe, 0x07,
-	0x28, 0xa7, 0x5c, 0x38, 0xe6, 0xc4, 0x8f, 0xe0, 0x0f, 0x70, 0xc8, 0xb1, 0x47, 0xc4, 0xa1, 0x40,
-	0xf2, 0x47, 0xd0, 0x7b, 0xcf, 0xde, 0xa4, 0x20, 0xa4, 0xe0, 0x93, 0xbd, 0x33, 0xdf, 0xf7, 0x8d,
-	0x67, 0xbe, 0xd9, 0x31, 0xf4, 0x82, 0x14, 0x79, 0x80, 0xb1, 0x70, 0xf0, 0x38, 0x98, 0xfb, 0xf1,
-	0x0c, 0x9d, 0x97, 0x0f, 0xa6, 0x28, 0xfc, 0x07, 0x79, 0xc0, 0x4e, 0x52, 0x26, 0x18, 0x79, 0x77,
-	0x85, 0xb4, 0xf3, 0xc4, 0x12, 0xd9, 0xda, 0x9a, 0xb1, 0x19, 0x53, 0x28, 0x47, 0x7e, 0xd3, 0x84,
-	0x56, 0x7b, 0xc6, 0xd8, 0xec, 0x10, 0x1d, 0xf5, 0x34, 0xcd, 0xf6, 0x1d, 0x41, 0x23, 0xe4, 0xc2,
-	0x8f, 0x92, 0x25, 0xc0, 0x0a, 0x18, 0x8f, 0x18, 0x77, 0xa6, 0x3e, 0xbf, 0xaa, 0x1a, 0x30, 0x1a,
-	0xeb, 0x7c, 0xf7, 0x74, 0x03, 0x2a, 0x23, 0x3f, 0x3d, 0x40, 0x41, 0x1a, 0x50, 0xa4, 0xa1, 0x69,
-	0x74, 0x8c, 0x5e, 0xc9, 0x2d, 0xd2, 0x90, 0x7c, 0x00, 0x20, 0x59, 0x5e, 0x88, 0x31, 0x8b, 0xcc,
-	0x62, 0xc7, 0xe8, 0xd5, 0xdc, 0x9a, 0x8c, 0x0c, 0x64, 0x80, 0xb4, 0xa1, 0xfe, 0x22, 0x63, 0x62,
-	0x95, 0xdf, 0x50, 0x79, 0x50, 0x21, 0x0d, 0xf8, 0x08, 0x1a, 0xc8, 0x83, 0x94, 0x1d, 0x79, 0x7e,
-	0x18, 0xa6, 0xc8, 0xb9, 0x59, 0x52, 0x98, 0x4d, 0x1d, 0xfd, 0x52, 0x07, 0xc9, 0x04, 0x1a, 0x91,
-	0x7f, 0x80, 0xa9, 0xb7, 0x8f, 0xe8, 0xa5, 0xbe, 0x40, 0xb3, 0x2c, 0x61, 0x7d, 0xfb, 0xfc, 0x75,
-	0xbb, 0xf0, 0xfb, 0xeb, 0xf6, 0xc7, 0x33, 0x2a, 0xe6, 0xd9, 0xd4, 0x0e, 0x58, 0xe4, 0x2c, 0x9b,
-	0xd1, 0x1f, 0xf7, 0x79, 0x78, 0xe0, 0x88, 0x45, 0x82, 0xdc, 0x1e, 0x60, 0xe0, 0xbe, 0xa5, 0x54,
-	0x1e, 0x23, 0xba, 0xbe, 0x40, 0xa9, 0x2a, 0xde, 0x54, 0xad, 0xac, 0xa7, 0x2a, 0xae, 0xab, 0x06,
-	0x70, 0x97, 0xa5, 0x21, 0xa6, 0x1e, 0x67, 0x59, 0x1a, 0xe0, 0x4a, 0x9c, 0x32, 0xf3, 0xd6, 0x5a,
-	0xea, 0x6f, 0x2b, 0xb5, 0xb1, 0x12, 0xd3, 0x35, 0x28, 0xeb, 0x9e, 0x18, 0x50, 0xd7, 0x96, 0x8c,
-	0x85, 0x2c, 0x3a, 0x04, 0x38, 0xf4, 0xb9, 0xf0, 0x92, 0x94, 0x06, 0xa8, 0xfc, 0xa9, 0xf5, 0xef,
-	0xfd, 0x8f, 0x22, 0x35, 0xc9, 0xde, 0x93, 0x64, 0xf2, 0x09, 0x6c, 0x29, 0xa9, 0xc8, 0x17, 0xc1,
-	0x9c, 0xc6, 0x33, 0x6f, 0x8e, 0x74, 0x36, 0x17, 0xca, 0xdc, 0x0d, 0x97, 0xc8, 0xdc, 0x68, 0x99,
-	0xfa, 0x4a, 0x65, 0xba, 0xbf, 0x96, 0xa0, 0xfc, 0x54, 0xfe, 0xc8, 0x7f, 0xad, 0xc7, 0x0e, 0x94,
-	0x64, 0x0d, 0xc5, 0x6d, 0x6c, 0x7f, 0x68, 0xff, 0xe7, 0xea, 0xda, 0x8a, 0x3f, 0x59, 0x24, 0xe8,
-	0x2a, 0x06, 0x31, 0xe1, 0x96, 0xea, 0x1b, 0xd3, 0xe5, 0xd6, 0xac, 0x1e, 0xc9, 0x7b, 0x50, 0x8b,
-	0x54, 0xe7, 0x1e, 0x0d, 0xd5, 0xb6, 0x94, 0xdc, 0xaa, 0x0e, 0x0c, 0x43, 0xf2, 0x0e, 0x54, 0x28,
-	0xf7, 0xa6, 0xd9, 0x42, 0x2d, 0x48, 0xd5, 0x2d, 0x53, 0xde, 0xcf, 0x16, 0x64, 0x00, 0x65, 0x3d,
-	0x99, 0xf5, 0x0c, 0xd6, 0x64, 0xf2, 0x35, 0x54, 0x5f, 0x64, 0x7e, 0x2c, 0xa8, 0x58, 0xac, 0xe9,
-	0x65, 0xce, 0x97, 0x2f, 0x4e, 0xc4, 0xf3, 0xd9, 0x56, 0xd5, 0x6c, 0x6b, 0x11, 0x5f, 0x8e, 0x94,
-	0x8c, 0x61, 0x93, 0x25, 0x18, 0x7b, 0x79, 0xbd, 0xda, 0x7a, 0x9b, 0x29, 0x45, 0xbe, 0x5d, 0xd5,
-	0x7c, 0x0e, 0x77, 0x52, 0x8c, 0x7c, 0x1a, 0x4b, 0x57, 0x43, 0x4c, 0x18, 0xa7, 0xc2, 0x84, 0xb5,
-	0x84, 0x9b, 0xb9, 0xd0, 0x40, 0xeb, 0x90, 0x2f, 0xa0, 0x1a, 0xa2, 0x1f, 0x1e, 0xd2, 0x18, 0xcd,
-	0x7a, 0xc7, 0xe8, 0xd5, 0xb7, 0x5b, 0xb6, 0x3e, 0x3c, 0xf6, 0xea, 0xf0, 0xd8, 0x93, 0xd5, 0xe1,
-	0xe9, 0x57, 0x65, 0xbd, 0xd3, 0x3f, 0xda, 0x86, 0x9b, 0xb3, 0xba, 0xbf, 0x14, 0xe1, 0xf6, 0xf8,
-	0xc8, 0x4f, 0x5c, 0x96, 0x09, 0x74, 0x91, 0x67, 0x87, 0xe2, 0x4d, 0xb3, 0x8d, 0x7f, 0x98, 0xfd,
-	0x1c, 0xee, 0xe0, 0x31, 0x06, 0x99, 0xc0, 0xf0, 0x6a, 0x50, 0xc5, 0xf5, 0xfa, 0x59, 0x09, 0xe5,
-	0xc3, 0xda, 0x81, 0x32, 0x8d, 0x93, 0x4c, 0xa8, 0xf5, 0xab, 0x6f, 0xbf, 0x6f, 0x6b, 0x9e, 0x2d,
-	0x8f, 0x5b, 0xbe, 0xb5, 0x03, 0x0c, 0x1e, 0x31, 0x1a, 0xf7, 0x4b, 0xb2, 0x9c, 0xab, 0x09, 0xe4,
-	0x73, 0xa8, 0xb0, 0x4c, 0x48, 0x6a, 0xe9, 0xc6, 0xd4, 0x25, 0x83, 0x3c, 0x84, 0x8d, 0x7d, 0xd4,
-	0xd7, 0xed, 0x66, 0x44, 0x09, 0xbf, 0xf7, 0x93, 0x01, 0xb5, 0xfc, 0x05, 0x22, 0x0f, 0xe1, 0xee,
-	0x53, 0x77, 0xb0, 0xeb, 0x7a, 0x93, 0xef, 0xf7, 0x76, 0xbd, 0x67, 0xdf, 0x8c, 0xf7, 0x76, 0x1f,
-	0x0d, 0x1f, 0x0f, 0x77, 0x07, 0xcd, 0x42, 0xcb, 0x3c, 0x39, 0xeb, 0x6c, 0xe5, 0xd0, 0x67, 0x31,
-	0x4f, 0x30, 0xa0, 0xfb, 0x14, 0x43, 0xd2, 0x83, 0xe6, 0x35, 0xd6, 0x93, 0xe1, 0x68, 0x38, 0x69,
-	0x1a, 0x2d, 0x72, 0x72, 0xd6, 0x69, 0xe4, 0xf8, 0x27, 0x34, 0xa2, 0x82, 0x74, 0x61, 0xf3, 0x1a,
-	0x72, 0x34, 0x6a, 0x16, 0x5b, 0xb7, 0x4f, 0xce, 0x3a, 0xf5, 0x1c, 0x36, 0x1a, 0xb5, 0x4a, 0x3f,
-	0xfe, 0x6c, 0x15, 0xfa, 0xdf, 0x9d, 0xff, 0x65, 0x15, 0xce, 0x2f, 0x2c, 0xe3, 0xd5, 0x85, 0x65,
-	0xfc, 0x79, 0x61, 0x19, 0xa7, 0x97, 0x56, 0xe1, 0xd5, 0xa5, 0x55, 0xf8, 0xed, 0xd2, 0x2a, 0xfc,
-	0xb0, 0x73, 0xdd, 0x9b, 0xe5, 0x61, 0xb8, 0x1f, 0xa3, 0x38, 0x62, 0xe9, 0x41, 0x1e, 0x70, 0x5e,
-	0x7e, 0xe6, 0x1c, 0x5f, 0xfd, 0x27, 0x2a, 0xc7, 0xa6, 0x15, 0xb5, 0x51, 0x9f, 0xfe, 0x1d, 0x00,
-	0x00, 0xff, 0xff, 0x79, 0xa8, 0xfe, 0xba, 0x35, 0x07, 0x00, 0x00,
+	// 2078 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x58, 0xcd, 0x73, 0xdb, 0xc6,
+	0x15, 0x17, 0x3f, 0xf4, 0xc1, 0xd5, 0x17, 0xbc, 0xfe, 0xa2, 0x61, 0x9b, 0x82, 0x99, 0x26, 0x51,
+	0x9d, 0x09, 0xd5, 0x38, 0xee, 0x8c, 0x53, 0x1f, 0x12, 0x7e, 0x40, 0x16, 0x6c, 0x92, 0x50, 0x40,
+	0xd8, 0x1e, 0x37, 0x9d, 0x62, 0x20, 0x60, 0x25, 0xed, 0x18, 0xc0, 0x32, 0xc0, 0x42, 0xb2, 0x72,
+	0xeb, 0xa1, 0x33, 0x1d, 0xf6, 0x92, 0x4b, 0x8f, 0x3c, 0xf5, 0xd6, 0xde, 0x7a, 0xe9, 0xad, 0xb7,
+	0xce, 0xf8, 0x98, 0x63, 0xa7, 0x87, 0xa4, 0xb5, 0x7b, 0xc9, 0xb9, 0xff, 0x40, 0x66, 0x77, 0x41,
+	0x10, 0x92, 0x69, 0xd9, 0xa6, 0x7d, 0x92, 0xb0, 0xfb, 0x7e, 0xbf, 0x7d, 0xbb, 0xef, 0xbd, 0xdf,
+	0xdb, 0x25, 0x58, 0x77, 0x42, 0x14, 0x39, 0x28, 0xa0, 0x1b, 0xe8, 0x89, 0xb3, 0x6f, 0x07, 0x7b,
+	0x68, 0xe3, 0xe0, 0x93, 0x1d, 0x44, 0xed, 0x4f, 0xd2, 0x81, 0x5a, 0x3f, 0x24, 0x94, 0xc0, 0x4b,
+	0x23, 0xcb, 0x5a, 0x3a, 0x91, 0x58, 0xca, 0xe7, 0xf6, 0xc8, 0x1e, 0xe1, 0x56, 0x1b, 0xec, 0x3f,
+	0x01, 0x90, 0xd7, 0xf6, 0x08, 0xd9, 0xf3, 0xd0, 0x06, 0xff, 0xda, 0x89, 0x77, 0x37, 0x28, 0xf6,
+	0x51, 0x44, 0x6d, 0xbf, 0x9f, 0x18, 0x54, 0x1c, 0x12, 0xf9, 0x24, 0xda, 0xd8, 0xb1, 0xa3, 0xf1,
+	0xaa, 0x0e, 0xc1, 0x81, 0x98, 0xaf, 0xfe, 0x75, 0x0e, 0xcc, 0x75, 0xec, 0xf0, 0x31, 0xa2, 0x70,
+	0x05, 0xe4, 0xb1, 0x5b, 0xce, 0x29, 0xb9, 0xf5, 0xa2, 0x91, 0xc7, 0x2e, 0xbc, 0x0a, 0x00, 0x43,
+	0x59, 0x2e, 0x0a, 0x88, 0x5f, 0xce, 0x2b, 0xb9, 0xf5, 0x92, 0x51, 0x62, 0x23, 0x2d, 0x36, 0x00,
+	0xd7, 0xc0, 0xe2, 0xd7, 0x31, 0xa1, 0xa3, 0xf9, 0x02, 0x9f, 0x07, 0x7c, 0x48, 0x18, 0xbc, 0x0f,
+	0x56, 0x50, 0xe4, 0x84, 0xe4, 0xd0, 0xb2, 0x5d, 0x37, 0x44, 0x51, 0x54, 0x2e, 0x72, 0x9b, 0x65,
+	0x31, 0x5a, 0x17, 0x83, 0xd0, 0x04, 0x2b, 0xbe, 0xfd, 0x18, 0x85, 0xd6, 0x2e, 0x42, 0x56, 0x68,
+	0x53, 0x54, 0x9e, 0x65, 0x66, 0x8d, 0xda, 0xd3, 0xef, 0xd7, 0x66, 0xfe, 0xfd, 0xfd, 0xda, 0x07,
+	0x7b, 0x98, 0xee, 0xc7, 0x3b, 0x35, 0x87, 0xf8, 0x1b, 0xc9, 0x66, 0xc4, 0x9f, 0x8f, 0x23, 0xf7,
+	0xf1, 0x06, 0x3d, 0xea, 0xa3, 0xa8, 0xd6, 0x42, 0x8e, 0xb1, 0xc4, 0x59, 0x36, 0x11, 0x32, 0x6c,
+	0x8a, 0x18, 0x2b, 0x3d, 0xce, 0x3a, 0x37, 0x1d, 0x2b, 0xcd, 0xb2, 0x3a, 0xe0, 0x02, 0x09, 0x5d,
+	0x14, 0x5a, 0x11, 0x89, 0x43, 0x07, 0x8d, 0xc8, 0x31, 0x29, 0xcf, 0x4f, 0xc5, 0x7e, 0x96, 0xb3,
+	0xf5, 0x38, 0x99, 0x58, 0x03, 0x13, 0xf8, 0x39, 0x98, 0x8b, 0xa8, 0x4d, 0xe3, 0xa8, 0xbc, 0xa0,
+	0xe4, 0xd6, 0x57, 0x6e, 0x7c, 0x58, 0x7b, 0x69, 0x56, 0xd4, 0x44, 0xe8, 0x7a, 0xdc, 0xdc, 0x48,
+	0x60, 0xf0, 0x1e, 0x28, 0x51, 0xec, 0x3c, 0xb6, 0x22, 0xfc, 0x0d, 0x2a, 0x97, 0xa6, 0x72, 0x6c,
+	0x81, 0x11, 0xf4, 0xf0, 0x37, 0x08, 0xfe, 0x06, 0x40, 0x1f, 0x07, 0x96, 0xd8, 0xf6, 0xd7, 0xb1,
+	0x1d, 0x50, 0x4c, 0x8f, 0xca, 0x60, 0x2a, 0x56, 0xc9, 0xc7, 0x81, 0xce, 0x88, 0xbe, 0x4c, 0x78,
+	0xa0, 0x06, 0x16, 0x3c, 0x42, 0x85, 0xa7, 0x8b, 0x53, 0x71, 0xce, 0x7b, 0x84, 0x72, 0x47, 0x77,
+	0xc0, 0xf9, 0x08, 0x79, 0xbb, 0x16, 0x0d, 0x6d, 0x17, 0x59, 0xfd, 0x10, 0x1d, 0xa0, 0x80, 0x62,
+	0x12, 0x94, 0x97, 0xf8, 0x29, 0xd6, 0x4e, 0x39, 0xc5, 0x1e, 0xf2, 0x76, 0x4d, 0x06, 0xdb, 0x4e,
+	0x51, 0xc6, 0xd9, 0xe8, 0xc5, 0xc1, 0xea, 0xef, 0x8a, 0x60, 0x71, 0x7c, 0xe4, 0x08, 0x6a, 0x00,
+	0x78, 0x76, 0x44, 0xad, 0x7e, 0x88, 0x1d, 0xc4, 0x4b, 0xa7, 0xd4, 0xb8, 0xfe, 0x06, 0xce, 0x97,
+	0x18, 0x7a, 0x9b, 0x81, 0xe1, 0x2f, 0xc0, 0x39, 0x4e, 0xe5, 0xdb, 0xd4, 0xd9, 0xc7, 0xc1, 0x9e,
+	0xb5, 0x8f, 0xf0, 0xde, 0x3e, 0xe5, 0x75, 0x57, 0x30, 0x20, 0x9b, 0xeb, 0x24, 0x53, 0x5b, 0x7c,
+	0x06, 0xde, 0x04, 0x17, 0x82, 0xd8, 0x17, 0x6b, 0x5b, 0x64, 0x27, 0x42, 0xe1, 0x01, 0xcb, 0x9f,
+	0x20, 0xe2, 0xb5, 0xb8, 0x6c, 0x9c, 0x0b, 0x62, 0x9f, 0x73, 0xeb, 0x99, 0x39, 0xf8, 0x39, 0xb8,
+	0x32, 0x76, 0x39, 0x0b, 0xb3, 0x70, 0xe0, 0xa2, 0x27, 0xbc, 0x46, 0x97, 0x8d, 0x4b, 0xa9, 0x63,
+	0x19, 0xb0, 0xc6, 0x0c, 0xe0, 0x6d, 0x20, 0x3b, 0x38, 0x74, 0x62, 0x4c, 0xad, 0x9d, 0x10, 0xf1,
+	0x1a, 0x43, 0x81, 0x3b, 0x72, 0x77, 0x96, 0xbb, 0x7b, 0x31, 0xb1, 0x68, 0x08, 0x03, 0x35, 0x70,
+	0x13, 0x9f, 0xeb, 0xe0, 0xea, 0x49, 0x70, 0x88, 0x48, 0x1f, 0x05, 0x23, 0xfc, 0x1c, 0xc7, 0xcb,
+	0xc7, 0xf1, 0x06, 0x37, 0x49, 0x28, 0x4c, 0xb0, 0xb2, 0x4f, 0xe2, 0xd0, 0x3b, 0xb2, 0x0e, 0x88,
+	0x17, 0xfb, 0x28, 0x2a, 0xcf, 0x2b, 0x85, 0xf5, 0xc5, 0xd7, 0x28, 0x93, 0x07, 0xdc, 0xbe, 0x51,
+	0x64, 0x19, 0x66, 0x2c, 0x0b, 0x12, 0x31, 0x16, 0xc1, 0x75, 0x20, 0xf1, 0x63, 0x11, 0x9c, 0x16,
+	0x9b, 0xe4, 0xe5, 0x57, 0x30, 0x56, 0xd8, 0xb8, 0x30, 0xdb, 0x22, 0x71, 0x58, 0xfd, 0x5b, 0x0e,
+	0x2c, 0x65, 0xf9, 0xa0, 0x0e, 0x16, 0xb9, 0x4e, 0x0a, 0x68, 0x39, 0x37, 0x55, 0x1a, 0x73, 0xa9,
+	0x4d, 0x08, 0xbf, 0x04, 0x4b, 0x42, 0x59, 0x13, 0xc6, 0xfc, 0x54, 0x8c, 0x42, 0x9d, 0x05, 0x65,
+	0xf5, 0xf7, 0x79, 0x20, 0x9d, 0x0c, 0x27, 0xbc, 0x05, 0x8a, 0x14, 0x27, 0x1e, 0x2f, 0xde, 0x90,
+	0x6b, 0xa2, 0x97, 0xd4, 0x46, 0xbd, 0xa4, 0x66, 0x8e, 0x7a, 0x49, 0x63, 0x81, 0xad, 0xfd, 0xed,
+	0x0f, 0x6b, 0x39, 0x83, 0x23, 0xe0, 0x23, 0x20, 0x39, 0xb1, 0x1f, 0x7b, 0x36, 0xc5, 0x07, 0x28,
+	0xc9, 0xfe, 0xe9, 0xbc, 0x5c, 0x1d, 0xf3, 0x88, 0x3a, 0x68, 0x81, 0x59, 0xc1, 0x57, 0x98, 0x8a,
+	0x4f, 0x80, 0xe1, 0x05, 0x30, 0x97, 0x24, 0x54, 0x91, 0x07, 0x31, 0xf9, 0xaa, 0xfe, 0x38, 0x0f,
+	0x66, 0xb9, 0x02, 0xbd, 0xd0, 0xed, 0xd8, 0x61, 0x1c, 0xf5, 0xc5, 0x36, 0x56, 0x6e, 0xfc, 0xec,
+	0x94, 0x64, 0xe2, 0x78, 0xf3, 0xa8, 0x8f, 0x0c, 0x8e, 0x80, 0x65, 0x30, 0xcf, 0xd5, 0x11, 0x85,
+	0x49, 0x13, 0x1c, 0x7d, 0xc2, 0xcb, 0xa0, 0xe4, 0xf3, 0x4c, 0xb1, 0xb0, 0xcb, 0x1d, 0x29, 0x1a,
+	0x0b, 0x62, 0x40, 0x73, 0xe1, 0x79, 0x30, 0x87, 0x23, 0x6b, 0x27, 0x3e, 0xe2, 0x35, 0xb3, 0x60,
+	0xcc, 0xe2, 0xa8, 0x11, 0x1f, 0x8d, 0xf7, 0x3f, 0xf7, 0x36, 0xfb, 0xbf, 0x0b, 0x16, 0x52, 0xad,
+	0x9e, 0xae, 0x35, 0xa5, 0x78, 0x76, 0x0f, 0xf0, 0xa3, 0x54, 0x8f, 0x44, 0x51, 0x94, 0xfc, 0x68,
+	0x24, 0x43, 0x3d, 0xb0, 0xcc, 0x0b, 0x38, 0x5d, 0x6f, 0xba, 0x8e, 0xb3, 0xc4, 0x48, 0xd2, 0xbe,
+	0xf0, 0x15, 0x38, 0x13, 0x22, 0xdf, 0xc6, 0x01, 0x53, 0x42, 0x17, 0xf5, 0x49, 0x84, 0xe9, 0xb4,
+	0x4d, 0x27, 0x25, 0x6a, 0x09, 0x1e, 0xf8, 0x05, 0x58, 0x70, 0x91, 0xed, 0x7a, 0x38, 0x10, 0x4d,
+	0xe7, 0x75, 0x73, 0x3f, 0x45, 0xc1, 0xbb, 0x60, 0x99, 0xd5, 0x81, 0x85, 0x03, 0x6b, 0x97, 0x84,
+	0x0e, 0x4a, 0x7a, 0xcc, 0x07, 0xa7, 0x64, 0x0d, 0x23, 0xd4, 0x82, 0x4d, 0x66, 0x6d, 0x2c, 0xd2,
+	0xf1, 0xc7, 0xcb, 0xfb, 0xd6, 0xf2, 0x3b, 0xeb, 0x5b, 0x50, 0x06, 0x0b, 0x21, 0xda, 0x45, 0x21,
+	0xcb, 0xd1, 0x15, 0x9e, 0xa3, 0xe9, 0x37, 0xbc, 0x0f, 0x24, 0x17, 0x47, 0x7d, 0xcf, 0x3e, 0x1a,
+	0x87, 0x70, 0xf5, 0x8d, 0x3b, 0xd9, 0x6a, 0xc2, 0x91, 0x46, 0xf0, 0x21, 0x58, 0xdd, 0xc7, 0xae,
+	0x9b, 0x4d, 0x0c, 0x69, 0xaa, 0xf8, 0xad, 0x08, 0x9a, 0x94, 0xf8, 0x43, 0xb0, 0xda, 0x0f, 0x31,
+	0x09, 0x31, 0x3d, 0x1a, 0xe5, 0xe4, 0x19, 0x21, 0xd4, 0xa3, 0x61, 0x91, 0x98, 0xd5, 0x7f, 0xe4,
+	0xc0, 0xb2, 0x91, 0xec, 0x92, 0xb5, 0xeb, 0x08, 0x6e, 0x82, 0xb9, 0xb7, 0x12, 0xe9, 0x04, 0x0d,
+	0x11, 0x28, 0xee, 0x22, 0x14, 0x95, 0xf3, 0xbc, 0xf1, 0x5c, 0xa9, 0x09, 0xe3, 0x1a, 0x93, 0xf0,
+	0x34, 0x36, 0x2d, 0xe4, 0x34, 0x09, 0x0e, 0x1a, 0x9f, 0xb2, 0x35, 0xfe, 0xf2, 0xc3, 0xda, 0x47,
+	0xaf, 0xb7, 0x06, 0xc3, 0x44, 0x06, 0xa7, 0xaf, 0xfe, 0x31, 0x07, 0x00, 0x17, 0xc5, 0x36, 0x3a,
+	0x40, 0x1e, 0xfc, 0x2d, 0x38, 0x4b, 0x09, 0xb5, 0x3d, 0xeb, 0x78, 0xb9, 0x4d, 0xb7, 0x95, 0x33,
+	0x9c, 0x4a, 0xcf, 0xd6, 0xdc, 0x55, 0x00, 0xd8, 0x7d, 0x82, 0x8b, 0x57, 0xc4, 0x75, 0xb0, 0x68,
+	0x94, 0x82, 0xd8, 0xe7, 0x7a, 0x17, 0x55, 0xff, 0x59, 0x04, 0x4b, 0x66, 0x88, 0xf7, 0xf6, 0x50,
+	0x38, 0x59, 0x41, 0x33, 0x3a, 0x98, 0x3f, 0x45, 0x07, 0x0b, 0x2f, 0xd5, 0xc1, 0x62, 0x56, 0x07,
+	0x35, 0x50, 0x72, 0x48, 0xe0, 0x62, 0x5e, 0x0a, 0xb3, 0xbc, 0x14, 0x3e, 0x3a, 0xad, 0xbc, 0x84,
+	0x67, 0xcd, 0x11, 0xc4, 0x18, 0xa3, 0x99, 0x42, 0x51, 0x31, 0x6d, 0xbd, 0x8d, 0xb4, 0x2e, 0x25,
+	0x24, 0xa2, 0x4f, 0x7d, 0x31, 0xd2, 0xe9, 0xf9, 0x37, 0xae, 0x95, 0x09, 0x1a, 0xbd, 0xf0, 0x4e,
+	0x35, 0xba, 0x74, 0x52, 0xa3, 0xb7, 0xc0, 0xfc, 0xdb, 0x89, 0xe8, 0xbc, 0xfb, 0xae, 0xb4, 0xb3,
+	0xfa, 0xf7, 0x3c, 0x58, 0xed, 0x1d, 0xda, 0x7d, 0x83, 0xc4, 0x14, 0x19, 0x28, 0x8a, 0x3d, 0x7a,
+	0x3c, 0x41, 0x72, 0x27, 0x12, 0xe4, 0x2b, 0x70, 0x06, 0x3d, 0x41, 0x4e, 0x4c, 0x91, 0x3b, 0xce,
+	0xfa, 0xe9, 0x6e, 0x1b, 0xd2, 0x88, 0x28, 0x4d, 0xfa, 0x5b, 0x60, 0x16, 0x07, 0xfd, 0x98, 0xf2,
+	0xb4, 0x7c, 0x55, 0x2d, 0x8b, 0x9b, 0xa3, 0x00, 0xc0, 0x5f, 0x81, 0x39, 0x12, 0x53, 0x06, 0x2d,
+	0xbe, 0x36, 0x34, 0x41, 0xc0, 0x9b, 0xa0, 0xb0, 0x8b, 0xc4, 0x43, 0xf7, 0xf5, 0x80, 0xcc, 0xbc,
+	0x1a, 0x81, 0x33, 0x0f, 0x79, 0x3c, 0x91, 0x9b, 0x1e, 0x20, 0xbb, 0xe9, 0x84, 0xec, 0x9f, 0xa8,
+	0x9c, 0x53, 0x0a, 0xeb, 0x45, 0x23, 0xf9, 0x62, 0x5a, 0x77, 0x38, 0x7e, 0x41, 0x4c, 0xa1, 0x75,
+	0x02, 0x5d, 0xfd, 0x7f, 0x0e, 0x5c, 0x7c, 0x61, 0xd5, 0x24, 0x6c, 0x2f, 0x5b, 0x3b, 0x3d, 0xd4,
+	0xfc, 0xf4, 0x87, 0x5a, 0x78, 0xe3, 0x43, 0xbd, 0x0b, 0xe6, 0x43, 0xee, 0x17, 0xfb, 0xa1, 0x81,
+	0x09, 0xf3, 0xf5, 0xd3, 0x5a, 0xe7, 0xf1, 0xad, 0x24, 0x54, 0x23, 0x82, 0xeb, 0x3f, 0xa6, 0x97,
+	0x7c, 0xf1, 0xb6, 0x66, 0xcf, 0xb3, 0x4e, 0xdd, 0xb8, 0xa7, 0x9a, 0x56, 0xcf, 0xac, 0x9b, 0xf7,
+	0x7b, 0x56, 0xbd, 0x69, 0x6a, 0x0f, 0x54, 0x69, 0x46, 0xbe, 0x30, 0x18, 0x2a, 0x30, 0x6b, 0x5b,
+	0x77, 0xd8, 0x6d, 0x16, 0x7e, 0x06, 0x2e, 0x1d, 0x47, 0x34, 0xeb, 0xdd, 0xa6, 0xda, 0xb6, 0xf4,
+	0x6e, 0xfb, 0x91, 0x94, 0x93, 0xe5, 0xc1, 0x50, 0xb9, 0x90, 0x85, 0x35, 0xed, 0xc0, 0x41, 0x9e,
+	0x1e, 0x78, 0x47, 0x2f, 0x2e, 0xb6, 0x55, 0x6f, 0x9b, 0x6a, 0x4b, 0xca, 0xbf, 0xb8, 0xd8, 0x96,
+	0xed, 0x51, 0xe4, 0xb2, 0xb7, 0xe0, 0x71, 0x44, 0x4b, 0x6d, 0x6b, 0x3d, 0x86, 0x29, 0xc8, 0xe5,
+	0xc1, 0x50, 0x39, 0x97, 0xc5, 0xb4, 0x90, 0x87, 0x23, 0x8a, 0x5c, 0xb9, 0xf8, 0x87, 0x3f, 0x57,
+	0x66, 0xae, 0xff, 0x29, 0x07, 0x4a, 0xe9, 0x9d, 0x96, 0x31, 0xe9, 0x46, 0x4b, 0x35, 0x2c, 0xf3,
+	0xd1, 0xb6, 0x6a, 0xdd, 0xef, 0xf6, 0xb6, 0xd5, 0xa6, 0xb6, 0xa9, 0xa9, 0x2d, 0x69, 0x46, 0x30,
+	0xa5, 0xa6, 0xf7, 0x83, 0xa8, 0x8f, 0x1c, 0xbc, 0x8b, 0x91, 0xcb, 0x9e, 0x4f, 0x19, 0x54, 0x5b,
+	0xeb, 0x68, 0xa6, 0x94, 0x93, 0xe1, 0x60, 0xa8, 0xac, 0xa4, 0xf6, 0x6d, 0xec, 0x63, 0x0a, 0xab,
+	0x60, 0x39, 0x63, 0xd9, 0xe9, 0x48, 0x79, 0x79, 0x75, 0x30, 0x54, 0x16, 0x53, 0xb3, 0x4e, 0x27,
+	0xf1, 0x6b, 0x90, 0x07, 0x8b, 0x99, 0x5b, 0x13, 0xbc, 0x0d, 0x2e, 0x9b, 0x5a, 0x47, 0xb5, 0xb4,
+	0xae, 0xb5, 0xa9, 0x1b, 0x4d, 0xd5, 0xba, 0xa3, 0xeb, 0x2d, 0xcb, 0xd4, 0xda, 0x16, 0x1b, 0x96,
+	0x66, 0xc4, 0x91, 0x66, 0x10, 0x77, 0x08, 0x71, 0x4d, 0xec, 0xb1, 0x11, 0x78, 0x13, 0x5c, 0x3c,
+	0x0e, 0xde, 0xd6, 0x7b, 0xe6, 0x28, 0x16, 0x17, 0x07, 0x43, 0xe5, 0x6c, 0x06, 0xb8, 0x4d, 0x22,
+	0xca, 0x03, 0x71, 0x07, 0x5c, 0x3b, 0x8e, 0xd2, 0x3a, 0x1d, 0xb5, 0xa5, 0xd5, 0x4d, 0xd5, 0xd2,
+	0x8d, 0x24, 0xa0, 0x52, 0x5e, 0x56, 0x06, 0x43, 0xe5, 0x4a, 0x06, 0xaf, 0xf9, 0x3e, 0x72, 0xb1,
+	0x4d, 0x91, 0x1e, 0x8a, 0xa8, 0xc2, 0xcf, 0x80, 0x7c, 0x9c, 0x68, 0x53, 0x6b, 0xb7, 0x19, 0xc7,
+	0x3d, 0xad, 0xdd, 0x96, 0x0a, 0xf2, 0xa5, 0xc1, 0x50, 0x39, 0x9f, 0x61, 0xd8, 0xc4, 0x9e, 0xa7,
+	0x87, 0xf7, 0xb0, 0xe7, 0x25, 0x87, 0xf1, 0xbf, 0x02, 0x38, 0x3b, 0xe1, 0xba, 0x07, 0x35, 0x70,
+	0xad, 0xa7, 0xb6, 0x37, 0x2d, 0xd3, 0xa8, 0xb7, 0x54, 0x6b, 0xdb, 0x50, 0x1f, 0xa8, 0x5d, 0x53,
+	0xd3, 0xbb, 0x27, 0x22, 0x57, 0x1d, 0x0c, 0x95, 0xca, 0x04, 0x7c, 0x36, 0x86, 0xb7, 0x81, 0x3c,
+	0x99, 0xaa, 0xab, 0x77, 0x55, 0x29, 0x27, 0x5f, 0x1e, 0x0c, 0x95, 0x8b, 0x13, 0x38, 0xba, 0x24,
+	0x40, 0xb0, 0x0d, 0xde, 0x9b, 0x0c, 0x4e, 0xb2, 0xbe, 0xab, 0x3e, 0x54, 0x7b, 0xa6, 0x94, 0x97,
+	0xdf, 0x1b, 0x0c, 0x95, 0xb5, 0x09, 0x2c, 0xe2, 0xa0, 0xba, 0xe8, 0x10, 0x45, 0xf4, 0x95, 0x6c,
+	0x7a, 0xbb, 0xc5, 0xd8, 0x0a, 0xaf, 0x60, 0xd3, 0x3d, 0x97, 0xb1, 0x6d, 0x81, 0x6b, 0xa7, 0xb2,
+	0x35, 0x74, 0x73, 0x4b, 0x2a, 0xca, 0xd7, 0x06, 0x43, 0xe5, 0xea, 0x4b, 0xb9, 0x1a, 0x84, 0xee,
+	0xc3, 0x47, 0xe0, 0xfa, 0x64, 0xa6, 0x96, 0xda, 0x34, 0xd4, 0x8e, 0xda, 0x35, 0xad, 0x7a, 0xb7,
+	0x35, 0x4a, 0x8c, 0x59, 0xf9, 0xe7, 0x83, 0xa1, 0xf2, 0xfe, 0x04, 0xca, 0x16, 0x72, 0x42, 0xe4,
+	0xa3, 0x80, 0xd6, 0x03, 0x57, 0xd0, 0x27, 0x61, 0x7e, 0x96, 0x03, 0xd2, 0xc9, 0xab, 0x0c, 0x6c,
+	0x80, 0xab, 0xa6, 0xa1, 0xdd, 0xb9, 0xa3, 0x1a, 0x56, 0x53, 0xef, 0xb6, 0xb4, 0x09, 0xf1, 0x5d,
+	0x1b, 0x0c, 0x95, 0xcb, 0x27, 0x81, 0xd9, 0xe0, 0xd6, 0x27, 0x71, 0x6c, 0x1b, 0x5a, 0x53, 0xb5,
+	0xea, 0x0d, 0xfd, 0x01, 0x8b, 0x6f, 0x65, 0x30, 0x54, 0xe4, 0x93, 0x1c, 0xfc, 0xb2, 0x53, 0xdf,
+	0x21, 0x07, 0xe8, 0x34, 0x8a, 0x86, 0xda, 0xd6, 0x1f, 0x4a, 0xf9, 0x53, 0x28, 0x1a, 0xc8, 0x23,
+	0x87, 0x62, 0x93, 0x8d, 0x07, 0x4f, 0xff, 0x5b, 0x99, 0x79, 0xfa, 0xac, 0x92, 0xfb, 0xee, 0x59,
+	0x25, 0xf7, 0x9f, 0x67, 0x95, 0xdc, 0xb7, 0xcf, 0x2b, 0x33, 0xdf, 0x3d, 0xaf, 0xcc, 0xfc, 0xeb,
+	0x79, 0x65, 0xe6, 0xd7, 0xb7, 0xb2, 0x0d, 0x2a, 0xd1, 0xef, 0x8f, 0x03, 0x44, 0x0f, 0x49, 0xf8,
+	0x38, 0x1d, 0xd8, 0x38, 0xf8, 0xe5, 0xc6, 0x93, 0xf1, 0xcf, 0xe9, 0xbc, 0x6d, 0xed, 0xcc, 0xf1,
+	0x1b, 0xc8, 0xa7, 0x3f, 0x0d, 0x00, 0x95, 0x57, 0x8c, 0xa0, 0x70, 0x17, 0x00, 0x00,
 }
 
 func (m *Market) Marshal() (dAtA []byte, err error) {
@@ -315,6 +904,46 @@ func (m *Market) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.SelfTradePrevention != 0 {
+		i = encodeVarintExchange(dAtA, i, uint64(m.SelfTradePrevention))
+		i--
+		dAtA[i] = 0x60
+	}
+	{
+		size := m.LotSize.Size()
+		i -= size
+		if _, err := m.LotSize.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintExchange(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x5a
+	{
+		size := m.MinOrderQuantity.Size()
+		i -= size
+		if _, err := m.MinOrderQuantity.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintExchange(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	{
+		size := m.TickSize.Size()
+		i -= size
+		if _, err := m.TickSize.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintExchange(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	if m.Status != 0 {
+		i = encodeVarintExchange(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x40
+	}
 	{
 		size := m.OrderSourceFeeRatio.Size()
 		i -= size
@@ -394,6 +1023,45 @@ func (m *MarketState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.LastVolumeHour != 0 {
+		i = encodeVarintExchange(dAtA, i, uint64(m.LastVolumeHour))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.HourlyVolumes) > 0 {
+		for iNdEx := len(m.HourlyVolumes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.HourlyVolumes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintExchange(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if m.CircuitBreakerReopenHeight != 0 {
+		i = encodeVarintExchange(dAtA, i, uint64(m.CircuitBreakerReopenHeight))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.CircuitBreakerEndHeight != 0 {
+		i = encodeVarintExchange(dAtA, i, uint64(m.CircuitBreakerEndHeight))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.LastPriceObservationIndex != 0 {
+		i = encodeVarintExchange(dAtA, i, uint64(m.LastPriceObservationIndex))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.NumPriceObservations != 0 {
+		i = encodeVarintExchange(dAtA, i, uint64(m.NumPriceObservations))
+		i--
+		dAtA[i] = 0x18
+	}
 	if m.LastMatchingHeight != 0 {
 		i = encodeVarintExchange(dAtA, i, uint64(m.LastMatchingHeight))
 		i--
@@ -414,7 +1082,7 @@ func (m *MarketState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *Order) Marshal() (dAtA []byte, err error) {
+func (m *MarketVolume) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -424,23 +1092,170 @@ func (m *Order) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Order) MarshalTo(dAtA []byte) (int, error) {
+func (m *MarketVolume) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Order) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MarketVolume) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Deadline, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Deadline):])
-	if err1 != nil {
-		return 0, err1
+	{
+		size := m.QuoteVolume.Size()
+		i -= size
+		if _, err := m.QuoteVolume.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintExchange(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.BaseVolume.Size()
+		i -= size
+		if _, err := m.BaseVolume.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintExchange(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *PriceObservation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PriceObservation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PriceObservation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintExchange(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size := m.Price.Size()
+		i -= size
+		if _, err := m.Price.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintExchange(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.CumulativePrice.Size()
+		i -= size
+		if _, err := m.CumulativePrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintExchange(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Time):])
+	if err1 != nil {
+		return 0, err1
 	}
 	i -= n1
 	i = encodeVarintExchange(dAtA, i, uint64(n1))
 	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *Order) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Order) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Order) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PriorityHeight != 0 {
+		i = encodeVarintExchange(dAtA, i, uint64(m.PriorityHeight))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x88
+	}
+	{
+		size := m.HiddenQuantity.Size()
+		i -= size
+		if _, err := m.HiddenQuantity.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintExchange(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x82
+	if m.DisplayQuantity != nil {
+		{
+			size := m.DisplayQuantity.Size()
+			i -= size
+			if _, err := m.DisplayQuantity.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintExchange(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x7a
+	}
+	if len(m.Referrer) > 0 {
+		i -= len(m.Referrer)
+		copy(dAtA[i:], m.Referrer)
+		i = encodeVarintExchange(dAtA, i, uint64(len(m.Referrer)))
+		i--
+		dAtA[i] = 0x72
+	}
+	if m.SelfTradePrevention != 0 {
+		i = encodeVarintExchange(dAtA, i, uint64(m.SelfTradePrevention))
+		i--
+		dAtA[i] = 0x68
+	}
+	if m.TimeInForce != 0 {
+		i = encodeVarintExchange(dAtA, i, uint64(m.TimeInForce))
+		i--
+		dAtA[i] = 0x60
+	}
+	n2, err2 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Deadline, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Deadline):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintExchange(dAtA, i, uint64(n2))
+	i--
 	dAtA[i] = 0x5a
 	{
 		size := m.RemainingDeposit.Size()
@@ -522,7 +1337,7 @@ func (m *Order) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *SwapRouteResult) Marshal() (dAtA []byte, err error) {
+func (m *ReferrerStats) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -532,84 +1347,405 @@ func (m *SwapRouteResult) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *SwapRouteResult) MarshalTo(dAtA []byte) (int, error) {
+func (m *ReferrerStats) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *SwapRouteResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ReferrerStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if len(m.Fees) > 0 {
+		for iNdEx := len(m.Fees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Fees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintExchange(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
 	{
-		size, err := m.Fee.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
+		size := m.Volume.Size()
+		i -= size
+		if _, err := m.Volume.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
-		i -= size
 		i = encodeVarintExchange(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x2a
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *PriceLevel) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PriceLevel) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PriceLevel) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.NumOrders != 0 {
+		i = encodeVarintExchange(dAtA, i, uint64(m.NumOrders))
+		i--
+		dAtA[i] = 0x10
+	}
 	{
-		size, err := m.Output.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
+		size := m.TotalOpenQuantity.Size()
+		i -= size
+		if _, err := m.TotalOpenQuantity.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
-		i -= size
 		i = encodeVarintExchange(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x22
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *TriggerOrder) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TriggerOrder) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TriggerOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n3, err3 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Deadline, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Deadline):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintExchange(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x5a
 	{
-		size, err := m.Input.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
+		size := m.Deposit.Size()
+		i -= size
+		if _, err := m.Deposit.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
+		i = encodeVarintExchange(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	if m.MsgHeight != 0 {
+		i = encodeVarintExchange(dAtA, i, uint64(m.MsgHeight))
+		i--
+		dAtA[i] = 0x48
+	}
+	{
+		size := m.Quantity.Size()
 		i -= size
+		if _, err := m.Quantity.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
 		i = encodeVarintExchange(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x1a
+	dAtA[i] = 0x42
+	if m.Price != nil {
+		{
+			size := m.Price.Size()
+			i -= size
+			if _, err := m.Price.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintExchange(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
 	{
-		size := m.ExecutedQuantity.Size()
+		size := m.TriggerPrice.Size()
 		i -= size
-		if _, err := m.ExecutedQuantity.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.TriggerPrice.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintExchange(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x12
+	dAtA[i] = 0x32
+	if m.Condition != 0 {
+		i = encodeVarintExchange(dAtA, i, uint64(m.Condition))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.IsBuy {
+		i--
+		if m.IsBuy {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
 	if m.MarketId != 0 {
 		i = encodeVarintExchange(dAtA, i, uint64(m.MarketId))
 		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Orderer) > 0 {
+		i -= len(m.Orderer)
+		copy(dAtA[i:], m.Orderer)
+		i = encodeVarintExchange(dAtA, i, uint64(len(m.Orderer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintExchange(dAtA, i, uint64(m.Id))
+		i--
 		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintExchange(dAtA []byte, offset int, v uint64) int {
-	offset -= sovExchange(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *SwapRouteResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *Market) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *SwapRouteResult) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SwapRouteResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Id != 0 {
-		n += 1 + sovExchange(uint64(m.Id))
-	}
+	{
+		size, err := m.Fee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintExchange(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size, err := m.Output.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintExchange(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.Input.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintExchange(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.ExecutedQuantity.Size()
+		i -= size
+		if _, err := m.ExecutedQuantity.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintExchange(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.MarketId != 0 {
+		i = encodeVarintExchange(dAtA, i, uint64(m.MarketId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *WeightedSwapRoute) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WeightedSwapRoute) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WeightedSwapRoute) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Weight.Size()
+		i -= size
+		if _, err := m.Weight.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintExchange(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Routes) > 0 {
+		dAtA8 := make([]byte, len(m.Routes)*10)
+		var j7 int
+		for _, num := range m.Routes {
+			for num >= 1<<7 {
+				dAtA8[j7] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j7++
+			}
+			dAtA8[j7] = uint8(num)
+			j7++
+		}
+		i -= j7
+		copy(dAtA[i:], dAtA8[:j7])
+		i = encodeVarintExchange(dAtA, i, uint64(j7))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *WeightedSwapRouteResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WeightedSwapRouteResult) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WeightedSwapRouteResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Results) > 0 {
+		for iNdEx := len(m.Results) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Results[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintExchange(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	{
+		size, err := m.Output.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintExchange(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.Input.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintExchange(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Routes) > 0 {
+		dAtA12 := make([]byte, len(m.Routes)*10)
+		var j11 int
+		for _, num := range m.Routes {
+			for num >= 1<<7 {
+				dAtA12[j11] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j11++
+			}
+			dAtA12[j11] = uint8(num)
+			j11++
+		}
+		i -= j11
+		copy(dAtA[i:], dAtA12[:j11])
+		i = encodeVarintExchange(dAtA, i, uint64(j11))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintExchange(dAtA []byte, offset int, v uint64) int {
+	offset -= sovExchange(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Market) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovExchange(uint64(m.Id))
+	}
 	l = len(m.BaseDenom)
 	if l > 0 {
 		n += 1 + l + sovExchange(uint64(l))
@@ -628,6 +1764,18 @@ func (m *Market) Size() (n int) {
 	n += 1 + l + sovExchange(uint64(l))
 	l = m.OrderSourceFeeRatio.Size()
 	n += 1 + l + sovExchange(uint64(l))
+	if m.Status != 0 {
+		n += 1 + sovExchange(uint64(m.Status))
+	}
+	l = m.TickSize.Size()
+	n += 1 + l + sovExchange(uint64(l))
+	l = m.MinOrderQuantity.Size()
+	n += 1 + l + sovExchange(uint64(l))
+	l = m.LotSize.Size()
+	n += 1 + l + sovExchange(uint64(l))
+	if m.SelfTradePrevention != 0 {
+		n += 1 + sovExchange(uint64(m.SelfTradePrevention))
+	}
 	return n
 }
 
@@ -644,6 +1792,58 @@ func (m *MarketState) Size() (n int) {
 	if m.LastMatchingHeight != 0 {
 		n += 1 + sovExchange(uint64(m.LastMatchingHeight))
 	}
+	if m.NumPriceObservations != 0 {
+		n += 1 + sovExchange(uint64(m.NumPriceObservations))
+	}
+	if m.LastPriceObservationIndex != 0 {
+		n += 1 + sovExchange(uint64(m.LastPriceObservationIndex))
+	}
+	if m.CircuitBreakerEndHeight != 0 {
+		n += 1 + sovExchange(uint64(m.CircuitBreakerEndHeight))
+	}
+	if m.CircuitBreakerReopenHeight != 0 {
+		n += 1 + sovExchange(uint64(m.CircuitBreakerReopenHeight))
+	}
+	if len(m.HourlyVolumes) > 0 {
+		for _, e := range m.HourlyVolumes {
+			l = e.Size()
+			n += 1 + l + sovExchange(uint64(l))
+		}
+	}
+	if m.LastVolumeHour != 0 {
+		n += 1 + sovExchange(uint64(m.LastVolumeHour))
+	}
+	return n
+}
+
+func (m *MarketVolume) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.BaseVolume.Size()
+	n += 1 + l + sovExchange(uint64(l))
+	l = m.QuoteVolume.Size()
+	n += 1 + l + sovExchange(uint64(l))
+	return n
+}
+
+func (m *PriceObservation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovExchange(uint64(l))
+	l = m.CumulativePrice.Size()
+	n += 1 + l + sovExchange(uint64(l))
+	l = m.Price.Size()
+	n += 1 + l + sovExchange(uint64(l))
+	if m.Height != 0 {
+		n += 1 + sovExchange(uint64(m.Height))
+	}
 	return n
 }
 
@@ -682,6 +1882,96 @@ func (m *Order) Size() (n int) {
 	n += 1 + l + sovExchange(uint64(l))
 	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Deadline)
 	n += 1 + l + sovExchange(uint64(l))
+	if m.TimeInForce != 0 {
+		n += 1 + sovExchange(uint64(m.TimeInForce))
+	}
+	if m.SelfTradePrevention != 0 {
+		n += 1 + sovExchange(uint64(m.SelfTradePrevention))
+	}
+	l = len(m.Referrer)
+	if l > 0 {
+		n += 1 + l + sovExchange(uint64(l))
+	}
+	if m.DisplayQuantity != nil {
+		l = m.DisplayQuantity.Size()
+		n += 1 + l + sovExchange(uint64(l))
+	}
+	l = m.HiddenQuantity.Size()
+	n += 2 + l + sovExchange(uint64(l))
+	if m.PriorityHeight != 0 {
+		n += 2 + sovExchange(uint64(m.PriorityHeight))
+	}
+	return n
+}
+
+func (m *ReferrerStats) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Volume.Size()
+	n += 1 + l + sovExchange(uint64(l))
+	if len(m.Fees) > 0 {
+		for _, e := range m.Fees {
+			l = e.Size()
+			n += 1 + l + sovExchange(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PriceLevel) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.TotalOpenQuantity.Size()
+	n += 1 + l + sovExchange(uint64(l))
+	if m.NumOrders != 0 {
+		n += 1 + sovExchange(uint64(m.NumOrders))
+	}
+	return n
+}
+
+func (m *TriggerOrder) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovExchange(uint64(m.Id))
+	}
+	l = len(m.Orderer)
+	if l > 0 {
+		n += 1 + l + sovExchange(uint64(l))
+	}
+	if m.MarketId != 0 {
+		n += 1 + sovExchange(uint64(m.MarketId))
+	}
+	if m.IsBuy {
+		n += 2
+	}
+	if m.Condition != 0 {
+		n += 1 + sovExchange(uint64(m.Condition))
+	}
+	l = m.TriggerPrice.Size()
+	n += 1 + l + sovExchange(uint64(l))
+	if m.Price != nil {
+		l = m.Price.Size()
+		n += 1 + l + sovExchange(uint64(l))
+	}
+	l = m.Quantity.Size()
+	n += 1 + l + sovExchange(uint64(l))
+	if m.MsgHeight != 0 {
+		n += 1 + sovExchange(uint64(m.MsgHeight))
+	}
+	l = m.Deposit.Size()
+	n += 1 + l + sovExchange(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Deadline)
+	n += 1 + l + sovExchange(uint64(l))
 	return n
 }
 
@@ -705,8 +1995,52 @@ func (m *SwapRouteResult) Size() (n int) {
 	return n
 }
 
-func sovExchange(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *WeightedSwapRoute) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Routes) > 0 {
+		l = 0
+		for _, e := range m.Routes {
+			l += sovExchange(uint64(e))
+		}
+		n += 1 + sovExchange(uint64(l)) + l
+	}
+	l = m.Weight.Size()
+	n += 1 + l + sovExchange(uint64(l))
+	return n
+}
+
+func (m *WeightedSwapRouteResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Routes) > 0 {
+		l = 0
+		for _, e := range m.Routes {
+			l += sovExchange(uint64(e))
+		}
+		n += 1 + sovExchange(uint64(l)) + l
+	}
+	l = m.Input.Size()
+	n += 1 + l + sovExchange(uint64(l))
+	l = m.Output.Size()
+	n += 1 + l + sovExchange(uint64(l))
+	if len(m.Results) > 0 {
+		for _, e := range m.Results {
+			l = e.Size()
+			n += 1 + l + sovExchange(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovExchange(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
 }
 func sozExchange(x uint64) (n int) {
 	return sovExchange(uint64((x << 1) ^ uint64((int64(x) >> 63))))
@@ -724,27 +2058,1253 @@ func (m *Market) Unmarshal(dAtA []byte) error {
 			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Market: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Market: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExchange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExchange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExchange
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExchange
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BaseDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuoteDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExchange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExchange
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExchange
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.QuoteDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EscrowAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExchange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExchange
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExchange
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EscrowAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MakerFeeRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExchange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExchange
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExchange
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MakerFeeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TakerFeeRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExchange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExchange
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExchange
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TakerFeeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderSourceFeeRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExchange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExchange
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExchange
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.OrderSourceFeeRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExchange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= MarketStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TickSize", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExchange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExchange
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExchange
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TickSize.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinOrderQuantity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExchange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExchange
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExchange
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinOrderQuantity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LotSize", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExchange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExchange
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExchange
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LotSize.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SelfTradePrevention", wireType)
+			}
+			m.SelfTradePrevention = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExchange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SelfTradePrevention |= SelfTradePrevention(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipExchange(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthExchange
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MarketState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowExchange
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MarketState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MarketState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExchange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExchange
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExchange
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Dec
+			m.LastPrice = &v
+			if err := m.LastPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastMatchingHeight", wireType)
+			}
+			m.LastMatchingHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExchange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastMatchingHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumPriceObservations", wireType)
+			}
+			m.NumPriceObservations = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExchange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumPriceObservations |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastPriceObservationIndex", wireType)
+			}
+			m.LastPriceObservationIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExchange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastPriceObservationIndex |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CircuitBreakerEndHeight", wireType)
+			}
+			m.CircuitBreakerEndHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExchange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CircuitBreakerEndHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CircuitBreakerReopenHeight", wireType)
+			}
+			m.CircuitBreakerReopenHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExchange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CircuitBreakerReopenHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HourlyVolumes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExchange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthExchange
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthExchange
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HourlyVolumes = append(m.HourlyVolumes, MarketVolume{})
+			if err := m.HourlyVolumes[len(m.HourlyVolumes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastVolumeHour", wireType)
+			}
+			m.LastVolumeHour = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExchange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastVolumeHour |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipExchange(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthExchange
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MarketVolume) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowExchange
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MarketVolume: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MarketVolume: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseVolume", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExchange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExchange
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExchange
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BaseVolume.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuoteVolume", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExchange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExchange
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExchange
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.QuoteVolume.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipExchange(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthExchange
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PriceObservation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowExchange
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PriceObservation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PriceObservation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExchange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthExchange
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthExchange
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CumulativePrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExchange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExchange
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExchange
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CumulativePrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExchange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExchange
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExchange
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExchange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipExchange(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthExchange
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Order) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowExchange
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Order: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Order: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExchange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExchange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= OrderType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Orderer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExchange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExchange
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExchange
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Orderer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+			}
+			m.MarketId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExchange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MarketId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsBuy", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExchange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsBuy = bool(v != 0)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExchange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExchange
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExchange
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quantity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExchange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExchange
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExchange
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Quantity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgHeight", wireType)
+			}
+			m.MsgHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExchange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MsgHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OpenQuantity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExchange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExchange
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExchange
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.OpenQuantity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemainingDeposit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExchange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExchange
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExchange
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RemainingDeposit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deadline", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExchange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthExchange
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthExchange
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Deadline, dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Market: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Market: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 12:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeInForce", wireType)
 			}
-			m.Id = 0
+			m.TimeInForce = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowExchange
@@ -754,14 +3314,33 @@ func (m *Market) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Id |= uint64(b&0x7F) << shift
+				m.TimeInForce |= TimeInForce(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SelfTradePrevention", wireType)
+			}
+			m.SelfTradePrevention = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExchange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SelfTradePrevention |= SelfTradePrevention(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BaseDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Referrer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -789,11 +3368,11 @@ func (m *Market) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BaseDenom = string(dAtA[iNdEx:postIndex])
+			m.Referrer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 15:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field QuoteDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DisplayQuantity", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -821,11 +3400,15 @@ func (m *Market) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.QuoteDenom = string(dAtA[iNdEx:postIndex])
+			var v github_com_cosmos_cosmos_sdk_types.Dec
+			m.DisplayQuantity = &v
+			if err := m.DisplayQuantity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 4:
+		case 16:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EscrowAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HiddenQuantity", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -853,13 +3436,15 @@ func (m *Market) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.EscrowAddress = string(dAtA[iNdEx:postIndex])
+			if err := m.HiddenQuantity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MakerFeeRate", wireType)
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriorityHeight", wireType)
 			}
-			var stringLen uint64
+			m.PriorityHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowExchange
@@ -869,29 +3454,64 @@ func (m *Market) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.PriorityHeight |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthExchange
+		default:
+			iNdEx = preIndex
+			skippy, err := skipExchange(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthExchange
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MakerFeeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ReferrerStats) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowExchange
 			}
-			iNdEx = postIndex
-		case 6:
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReferrerStats: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReferrerStats: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TakerFeeRate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Volume", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -919,15 +3539,15 @@ func (m *Market) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TakerFeeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Volume.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OrderSourceFeeRatio", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Fees", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowExchange
@@ -937,23 +3557,23 @@ func (m *Market) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthExchange
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthExchange
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.OrderSourceFeeRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Fees = append(m.Fees, types.DecCoin{})
+			if err := m.Fees[len(m.Fees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -978,7 +3598,7 @@ func (m *Market) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MarketState) Unmarshal(dAtA []byte) error {
+func (m *PriceLevel) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1001,15 +3621,15 @@ func (m *MarketState) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MarketState: wiretype end group for non-group")
+			return fmt.Errorf("proto: PriceLevel: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MarketState: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PriceLevel: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastPrice", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalOpenQuantity", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1037,17 +3657,15 @@ func (m *MarketState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v github_com_cosmos_cosmos_sdk_types.Dec
-			m.LastPrice = &v
-			if err := m.LastPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TotalOpenQuantity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastMatchingHeight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NumOrders", wireType)
 			}
-			m.LastMatchingHeight = 0
+			m.NumOrders = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowExchange
@@ -1057,7 +3675,7 @@ func (m *MarketState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.LastMatchingHeight |= int64(b&0x7F) << shift
+				m.NumOrders |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -1083,7 +3701,7 @@ func (m *MarketState) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Order) Unmarshal(dAtA []byte) error {
+func (m *TriggerOrder) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1106,10 +3724,10 @@ func (m *Order) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Order: wiretype end group for non-group")
+			return fmt.Errorf("proto: TriggerOrder: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Order: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TriggerOrder: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1132,10 +3750,10 @@ func (m *Order) Unmarshal(dAtA []byte) error {
 				}
 			}
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Orderer", wireType)
 			}
-			m.Type = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowExchange
@@ -1145,14 +3763,85 @@ func (m *Order) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Type |= OrderType(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExchange
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExchange
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Orderer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+			}
+			m.MarketId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExchange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MarketId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsBuy", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExchange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsBuy = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Condition", wireType)
+			}
+			m.Condition = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExchange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Condition |= TriggerCondition(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Orderer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TriggerPrice", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1180,13 +3869,51 @@ func (m *Order) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Orderer = string(dAtA[iNdEx:postIndex])
+			if err := m.TriggerPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExchange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExchange
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExchange
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Dec
+			m.Price = &v
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quantity", wireType)
 			}
-			m.MarketId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowExchange
@@ -1196,16 +3923,31 @@ func (m *Order) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MarketId |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExchange
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExchange
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Quantity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsBuy", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgHeight", wireType)
 			}
-			var v int
+			m.MsgHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowExchange
@@ -1215,15 +3957,14 @@ func (m *Order) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.MsgHeight |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.IsBuy = bool(v != 0)
-		case 6:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1251,15 +3992,15 @@ func (m *Order) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Deposit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Quantity", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Deadline", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowExchange
@@ -1269,31 +4010,80 @@ func (m *Order) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthExchange
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthExchange
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Quantity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Deadline, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 8:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipExchange(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthExchange
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SwapRouteResult) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowExchange
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SwapRouteResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SwapRouteResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MsgHeight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
 			}
-			m.MsgHeight = 0
+			m.MarketId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowExchange
@@ -1303,14 +4093,14 @@ func (m *Order) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MsgHeight |= int64(b&0x7F) << shift
+				m.MarketId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 9:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OpenQuantity", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutedQuantity", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1338,15 +4128,15 @@ func (m *Order) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.OpenQuantity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ExecutedQuantity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 10:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RemainingDeposit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Input", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowExchange
@@ -1356,29 +4146,28 @@ func (m *Order) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthExchange
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthExchange
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.RemainingDeposit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Input.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 11:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Deadline", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Output", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1405,7 +4194,40 @@ func (m *Order) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Deadline, dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Output.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExchange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthExchange
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthExchange
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Fee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1430,7 +4252,7 @@ func (m *Order) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SwapRouteResult) Unmarshal(dAtA []byte) error {
+func (m *WeightedSwapRoute) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1453,34 +4275,91 @@ func (m *SwapRouteResult) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SwapRouteResult: wiretype end group for non-group")
+			return fmt.Errorf("proto: WeightedSwapRoute: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SwapRouteResult: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: WeightedSwapRoute: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
-			}
-			m.MarketId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowExchange
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowExchange
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				if iNdEx >= l {
+				m.Routes = append(m.Routes, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowExchange
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthExchange
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthExchange
+				}
+				if postIndex > l {
 					return io.ErrUnexpectedEOF
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.MarketId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Routes) == 0 {
+					m.Routes = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowExchange
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Routes = append(m.Routes, v)
 				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Routes", wireType)
 			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExecutedQuantity", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1508,11 +4387,137 @@ func (m *SwapRouteResult) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ExecutedQuantity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Weight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipExchange(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthExchange
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WeightedSwapRouteResult) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowExchange
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WeightedSwapRouteResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WeightedSwapRouteResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowExchange
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Routes = append(m.Routes, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowExchange
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthExchange
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthExchange
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Routes) == 0 {
+					m.Routes = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowExchange
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Routes = append(m.Routes, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Routes", wireType)
+			}
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Input", wireType)
 			}
@@ -1545,7 +4550,7 @@ func (m *SwapRouteResult) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Output", wireType)
 			}
@@ -1578,9 +4583,9 @@ func (m *SwapRouteResult) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Results", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1607,7 +4612,8 @@ func (m *SwapRouteResult) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Fee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Results = append(m.Results, SwapRouteResult{})
+			if err := m.Results[len(m.Results)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
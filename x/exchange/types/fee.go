@@ -2,6 +2,7 @@ package types
 
 import (
 	"fmt"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
@@ -14,6 +15,7 @@ func NewFees(
 		DefaultMakerFeeRate:        defaultMakerFeeRate,
 		DefaultTakerFeeRate:        defaultTakerFeeRate,
 		DefaultOrderSourceFeeRatio: defaultOrderSourceFeeRatio,
+		ReferralFeeRatio:           utils.ZeroDec,
 	}
 }
 
@@ -32,9 +34,109 @@ func ValidateFees(makerFeeRate, takerFeeRate, orderSourceFeeRatio sdk.Dec) error
 	return nil
 }
 
+// ValidateOrderSourceFeeRatio returns an error if the order source fee ratio and
+// the referral fee ratio sum up to more than 1, since both are paid out of the
+// taker fee.
+func ValidateOrderSourceFeeRatio(orderSourceFeeRatio, referralFeeRatio sdk.Dec) error {
+	if orderSourceFeeRatio.Add(referralFeeRatio).GT(utils.OneDec) {
+		return fmt.Errorf(
+			"order source fee ratio and referral fee ratio must not sum up to more than 1: %s + %s",
+			orderSourceFeeRatio, referralFeeRatio)
+	}
+	return nil
+}
+
 // DeductFee returns coin amount after deducting fee along with the fee.
 func DeductFee(amt, feeRate sdk.Dec) (deducted, fee sdk.Dec) {
 	fee = feeRate.Mul(amt)
 	deducted = amt.Sub(fee)
 	return
 }
+
+// FeeTierVolumeWindowDays is the number of days over which accounts' volumes
+// are summed up for fee tiers.
+const FeeTierVolumeWindowDays = 30
+
+// Validate validates Fees.
+// Unlike market fee rates, fee tiers' maker fee rates can be negative to give
+// maker rebates. A maker rebate must not exceed the lowest taker fee rate so
+// that it is always covered by the fee paid by the taker, after the referral
+// fee is paid. Since markets can have lower taker fee rates than the defaults,
+// maker rebates are also capped by the taker fee rate actually charged when
+// orders are matched.
+func (fees Fees) Validate() error {
+	if err := ValidateFees(
+		fees.DefaultMakerFeeRate, fees.DefaultTakerFeeRate, fees.DefaultOrderSourceFeeRatio); err != nil {
+		return err
+	}
+	if fees.ReferralFeeRatio.IsNil() || fees.ReferralFeeRatio.GT(utils.OneDec) || fees.ReferralFeeRatio.IsNegative() {
+		return fmt.Errorf("referral fee ratio must be in range [0, 1]: %s", fees.ReferralFeeRatio)
+	}
+	if err := ValidateOrderSourceFeeRatio(fees.DefaultOrderSourceFeeRatio, fees.ReferralFeeRatio); err != nil {
+		return err
+	}
+	if fees.VolumeDenom != "" {
+		if err := sdk.ValidateDenom(fees.VolumeDenom); err != nil {
+			return fmt.Errorf("invalid volume denom: %w", err)
+		}
+	}
+	minTakerFeeRate := fees.DefaultTakerFeeRate
+	for i, tier := range fees.FeeTiers {
+		if err := tier.Validate(); err != nil {
+			return fmt.Errorf("invalid fee tier %d: %w", i, err)
+		}
+		if i > 0 && tier.MinVolume.LT(fees.FeeTiers[i-1].MinVolume) {
+			return fmt.Errorf("fee tiers must be sorted by min volume")
+		}
+		minTakerFeeRate = sdk.MinDec(minTakerFeeRate, tier.TakerFeeRate)
+	}
+	minTakerFeeRate = minTakerFeeRate.Sub(minTakerFeeRate.Mul(fees.ReferralFeeRatio))
+	for i, tier := range fees.FeeTiers {
+		if tier.MakerFeeRate.IsNegative() && tier.MakerFeeRate.Neg().GT(minTakerFeeRate) {
+			return fmt.Errorf(
+				"fee tier %d's maker rebate must not exceed the lowest taker fee rate: %s > %s",
+				i, tier.MakerFeeRate.Neg(), minTakerFeeRate)
+		}
+	}
+	return nil
+}
+
+func NewFeeTier(minVolume sdk.Dec, minHoldings sdk.Coins, makerFeeRate, takerFeeRate sdk.Dec) FeeTier {
+	return FeeTier{
+		MinVolume:    minVolume,
+		MinHoldings:  minHoldings,
+		MakerFeeRate: makerFeeRate,
+		TakerFeeRate: takerFeeRate,
+	}
+}
+
+// Validate validates FeeTier.
+func (tier FeeTier) Validate() error {
+	if tier.MinVolume.IsNil() || tier.MinVolume.IsNegative() {
+		return fmt.Errorf("min volume must not be negative: %s", tier.MinVolume)
+	}
+	if err := tier.MinHoldings.Validate(); err != nil {
+		return fmt.Errorf("invalid min holdings: %w", err)
+	}
+	if tier.MakerFeeRate.GT(utils.OneDec) || tier.MakerFeeRate.LT(utils.OneDec.Neg()) {
+		return fmt.Errorf("maker fee rate must be in range [-1, 1]: %s", tier.MakerFeeRate)
+	}
+	if tier.TakerFeeRate.GT(utils.OneDec) || tier.TakerFeeRate.IsNegative() {
+		return fmt.Errorf("taker fee rate must be in range [0, 1]: %s", tier.TakerFeeRate)
+	}
+	return nil
+}
+
+// IsEligible returns whether an account with the volume and balances belongs
+// to the fee tier.
+func (tier FeeTier) IsEligible(volume sdk.Dec, balances sdk.Coins) bool {
+	if volume.LT(tier.MinVolume) {
+		return false
+	}
+	return tier.MinHoldings.Empty() || balances.IsAnyGTE(tier.MinHoldings)
+}
+
+// FeeTierVolumeDay returns the day index used to track accounts' volumes.
+func FeeTierVolumeDay(t time.Time) uint64 {
+	return uint64(t.Unix() / 86400)
+}
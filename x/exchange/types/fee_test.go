@@ -81,6 +81,128 @@ func TestValidateFees(t *testing.T) {
 	}
 }
 
+func TestFees_Validate(t *testing.T) {
+	for _, tc := range []struct {
+		name        string
+		malleate    func(fees *types.Fees)
+		expectedErr string
+	}{
+		{
+			"happy case",
+			func(fees *types.Fees) {},
+			"",
+		},
+		{
+			"invalid default fee rates",
+			func(fees *types.Fees) {
+				fees.DefaultMakerFeeRate = utils.ParseDec("-0.001")
+			},
+			"maker fee rate must be in range [0, 1]: -0.001000000000000000",
+		},
+		{
+			"invalid referral fee ratio",
+			func(fees *types.Fees) {
+				fees.ReferralFeeRatio = utils.ParseDec("1.1")
+			},
+			"referral fee ratio must be in range [0, 1]: 1.100000000000000000",
+		},
+		{
+			"too high referral fee ratio",
+			func(fees *types.Fees) {
+				fees.ReferralFeeRatio = utils.ParseDec("0.6")
+			},
+			"order source fee ratio and referral fee ratio must not sum up to more than 1: 0.500000000000000000 + 0.600000000000000000",
+		},
+		{
+			"invalid volume denom",
+			func(fees *types.Fees) {
+				fees.VolumeDenom = "!"
+			},
+			"invalid volume denom: invalid denom: !",
+		},
+		{
+			"negative min volume",
+			func(fees *types.Fees) {
+				fees.FeeTiers[0].MinVolume = utils.ParseDec("-1")
+			},
+			"invalid fee tier 0: min volume must not be negative: -1.000000000000000000",
+		},
+		{
+			"invalid min holdings",
+			func(fees *types.Fees) {
+				fees.FeeTiers[1].MinHoldings = sdk.Coins{sdk.NewInt64Coin("ucre", 0)}
+			},
+			"invalid fee tier 1: invalid min holdings: coin 0ucre amount is not positive",
+		},
+		{
+			"too low maker fee rate",
+			func(fees *types.Fees) {
+				fees.FeeTiers[1].MakerFeeRate = utils.ParseDec("-1.01")
+			},
+			"invalid fee tier 1: maker fee rate must be in range [-1, 1]: -1.010000000000000000",
+		},
+		{
+			"negative taker fee rate",
+			func(fees *types.Fees) {
+				fees.FeeTiers[1].TakerFeeRate = utils.ParseDec("-0.001")
+			},
+			"invalid fee tier 1: taker fee rate must be in range [0, 1]: -0.001000000000000000",
+		},
+		{
+			"unsorted fee tiers",
+			func(fees *types.Fees) {
+				fees.FeeTiers[1].MinVolume = utils.ParseDec("100")
+			},
+			"fee tiers must be sorted by min volume",
+		},
+		{
+			"too high maker rebate",
+			func(fees *types.Fees) {
+				fees.FeeTiers[1].MakerFeeRate = utils.ParseDec("-0.0025")
+			},
+			"fee tier 1's maker rebate must not exceed the lowest taker fee rate: 0.002500000000000000 > 0.002000000000000000",
+		},
+		{
+			"too high maker rebate with referral fee",
+			func(fees *types.Fees) {
+				fees.ReferralFeeRatio = utils.ParseDec("0.5")
+				fees.FeeTiers[1].MakerFeeRate = utils.ParseDec("-0.0015")
+			},
+			"fee tier 1's maker rebate must not exceed the lowest taker fee rate: 0.001500000000000000 > 0.001000000000000000",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			fees := types.DefaultFees
+			fees.VolumeDenom = "uusd"
+			fees.FeeTiers = []types.FeeTier{
+				types.NewFeeTier(
+					utils.ParseDec("1000_000000"), nil, utils.ParseDec("0.001"), utils.ParseDec("0.0025")),
+				types.NewFeeTier(
+					utils.ParseDec("10000_000000"), utils.ParseCoins("1000_000000ucre"),
+					utils.ParseDec("-0.0005"), utils.ParseDec("0.002")),
+			}
+			tc.malleate(&fees)
+			err := fees.Validate()
+			if tc.expectedErr == "" {
+				require.NoError(t, err)
+			} else {
+				require.EqualError(t, err, tc.expectedErr)
+			}
+		})
+	}
+}
+
+func TestFeeTier_IsEligible(t *testing.T) {
+	tier := types.NewFeeTier(
+		utils.ParseDec("1000"), utils.ParseCoins("100ucre,100ubcre"), utils.ParseDec("0"), utils.ParseDec("0.001"))
+	require.False(t, tier.IsEligible(utils.ParseDec("999"), utils.ParseCoins("100ucre")))
+	require.False(t, tier.IsEligible(utils.ParseDec("1000"), utils.ParseCoins("99ucre,99ubcre")))
+	require.True(t, tier.IsEligible(utils.ParseDec("1000"), utils.ParseCoins("100ubcre")))
+	require.True(t, tier.IsEligible(utils.ParseDec("2000"), utils.ParseCoins("100ucre,10uusd")))
+	tier.MinHoldings = nil
+	require.True(t, tier.IsEligible(utils.ParseDec("1000"), nil))
+}
+
 func TestDeductFee(t *testing.T) {
 	for i, tc := range []struct {
 		amt, feeRate  sdk.Dec
@@ -8,20 +8,26 @@ import (
 
 func NewGenesisState(
 	params Params, lastMarketId, lastOrderId uint64,
-	marketRecords []MarketRecord, orders []Order, numMMOrdersRecords []NumMMOrdersRecord) *GenesisState {
+	marketRecords []MarketRecord, orders []Order, numMMOrdersRecords []NumMMOrdersRecord,
+	triggerOrders []TriggerOrder, accountVolumeRecords []AccountVolumeRecord,
+	cancelAfterRecords []CancelAfterRecord, referrerStatsRecords []ReferrerStatsRecord) *GenesisState {
 	return &GenesisState{
-		Params:             params,
-		LastMarketId:       lastMarketId,
-		LastOrderId:        lastOrderId,
-		MarketRecords:      marketRecords,
-		Orders:             orders,
-		NumMMOrdersRecords: numMMOrdersRecords,
+		Params:               params,
+		LastMarketId:         lastMarketId,
+		LastOrderId:          lastOrderId,
+		MarketRecords:        marketRecords,
+		Orders:               orders,
+		NumMMOrdersRecords:   numMMOrdersRecords,
+		TriggerOrders:        triggerOrders,
+		AccountVolumeRecords: accountVolumeRecords,
+		CancelAfterRecords:   cancelAfterRecords,
+		ReferrerStatsRecords: referrerStatsRecords,
 	}
 }
 
 // DefaultGenesis returns the default genesis state for the module.
 func DefaultGenesis() *GenesisState {
-	return NewGenesisState(DefaultParams(), 0, 0, nil, nil, nil)
+	return NewGenesisState(DefaultParams(), 0, 0, nil, nil, nil, nil, nil, nil, nil)
 }
 
 func (genState GenesisState) Validate() error {
@@ -29,7 +35,7 @@ func (genState GenesisState) Validate() error {
 		return err
 	}
 	for _, marketRecord := range genState.MarketRecords {
-		if err := marketRecord.Validate(); err != nil {
+		if err := marketRecord.Validate(genState.Params.Fees.ReferralFeeRatio); err != nil {
 			return fmt.Errorf("invalid market record: %w", err)
 		}
 	}
@@ -43,16 +49,46 @@ func (genState GenesisState) Validate() error {
 			return fmt.Errorf("invalid num mm orders record: %w", err)
 		}
 	}
+	for _, order := range genState.TriggerOrders {
+		if err := order.Validate(); err != nil {
+			return fmt.Errorf("invalid trigger order: %w", err)
+		}
+	}
+	for _, record := range genState.AccountVolumeRecords {
+		if err := record.Validate(); err != nil {
+			return fmt.Errorf("invalid account volume record: %w", err)
+		}
+	}
+	for _, record := range genState.CancelAfterRecords {
+		if err := record.Validate(); err != nil {
+			return fmt.Errorf("invalid cancel after record: %w", err)
+		}
+	}
+	for _, record := range genState.ReferrerStatsRecords {
+		if err := record.Validate(); err != nil {
+			return fmt.Errorf("invalid referrer stats record: %w", err)
+		}
+	}
 	return nil
 }
 
-func (record MarketRecord) Validate() error {
-	if err := record.Market.Validate(); err != nil {
+func (record MarketRecord) Validate(referralFeeRatio sdk.Dec) error {
+	if err := record.Market.Validate(referralFeeRatio); err != nil {
 		return fmt.Errorf("invalid market: %w", err)
 	}
 	if err := record.State.Validate(); err != nil {
 		return fmt.Errorf("invalid market state: %w", err)
 	}
+	if len(record.PriceObservations) != int(record.State.NumPriceObservations) {
+		return fmt.Errorf(
+			"number of price observations doesn't match the market state: %d != %d",
+			len(record.PriceObservations), record.State.NumPriceObservations)
+	}
+	for _, obs := range record.PriceObservations {
+		if err := obs.Validate(); err != nil {
+			return fmt.Errorf("invalid price observation: %w", err)
+		}
+	}
 	return nil
 }
 
@@ -68,3 +104,39 @@ func (record NumMMOrdersRecord) Validate() error {
 	}
 	return nil
 }
+
+func (record AccountVolumeRecord) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(record.Address); err != nil {
+		return fmt.Errorf("invalid address: %w", err)
+	}
+	if record.Volume.IsNil() || !record.Volume.IsPositive() {
+		return fmt.Errorf("volume must be positive: %s", record.Volume)
+	}
+	return nil
+}
+
+func (record CancelAfterRecord) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(record.Orderer); err != nil {
+		return fmt.Errorf("invalid orderer: %w", err)
+	}
+	if record.MarketId == 0 {
+		return fmt.Errorf("market id must not be 0")
+	}
+	return nil
+}
+
+func (record ReferrerStatsRecord) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(record.Referrer); err != nil {
+		return fmt.Errorf("invalid referrer: %w", err)
+	}
+	if record.MarketId == 0 {
+		return fmt.Errorf("market id must not be 0")
+	}
+	if record.Stats.Volume.IsNil() || record.Stats.Volume.IsNegative() {
+		return fmt.Errorf("volume must not be negative: %s", record.Stats.Volume)
+	}
+	if err := record.Stats.Fees.Validate(); err != nil {
+		return fmt.Errorf("invalid fees: %w", err)
+	}
+	return nil
+}
@@ -5,17 +5,22 @@ package types
 
 import (
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -24,12 +29,16 @@ var _ = math.Inf
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 type GenesisState struct {
-	Params             Params              `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
-	LastMarketId       uint64              `protobuf:"varint,2,opt,name=last_market_id,json=lastMarketId,proto3" json:"last_market_id,omitempty"`
-	LastOrderId        uint64              `protobuf:"varint,3,opt,name=last_order_id,json=lastOrderId,proto3" json:"last_order_id,omitempty"`
-	MarketRecords      []MarketRecord      `protobuf:"bytes,4,rep,name=market_records,json=marketRecords,proto3" json:"market_records"`
-	Orders             []Order             `protobuf:"bytes,5,rep,name=orders,proto3" json:"orders"`
-	NumMMOrdersRecords []NumMMOrdersRecord `protobuf:"bytes,6,rep,name=num_mm_orders_records,json=numMmOrdersRecords,proto3" json:"num_mm_orders_records"`
+	Params               Params                `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	LastMarketId         uint64                `protobuf:"varint,2,opt,name=last_market_id,json=lastMarketId,proto3" json:"last_market_id,omitempty"`
+	LastOrderId          uint64                `protobuf:"varint,3,opt,name=last_order_id,json=lastOrderId,proto3" json:"last_order_id,omitempty"`
+	MarketRecords        []MarketRecord        `protobuf:"bytes,4,rep,name=market_records,json=marketRecords,proto3" json:"market_records"`
+	Orders               []Order               `protobuf:"bytes,5,rep,name=orders,proto3" json:"orders"`
+	NumMMOrdersRecords   []NumMMOrdersRecord   `protobuf:"bytes,6,rep,name=num_mm_orders_records,json=numMmOrdersRecords,proto3" json:"num_mm_orders_records"`
+	TriggerOrders        []TriggerOrder        `protobuf:"bytes,7,rep,name=trigger_orders,json=triggerOrders,proto3" json:"trigger_orders"`
+	AccountVolumeRecords []AccountVolumeRecord `protobuf:"bytes,8,rep,name=account_volume_records,json=accountVolumeRecords,proto3" json:"account_volume_records"`
+	CancelAfterRecords   []CancelAfterRecord   `protobuf:"bytes,9,rep,name=cancel_after_records,json=cancelAfterRecords,proto3" json:"cancel_after_records"`
+	ReferrerStatsRecords []ReferrerStatsRecord `protobuf:"bytes,10,rep,name=referrer_stats_records,json=referrerStatsRecords,proto3" json:"referrer_stats_records"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -68,6 +77,9 @@ var xxx_messageInfo_GenesisState proto.InternalMessageInfo
 type MarketRecord struct {
 	Market Market      `protobuf:"bytes,1,opt,name=market,proto3" json:"market"`
 	State  MarketState `protobuf:"bytes,2,opt,name=state,proto3" json:"state"`
+	// price_observations is the market's ring of price observations, ordered by
+	// their ring indexes.
+	PriceObservations []PriceObservation `protobuf:"bytes,3,rep,name=price_observations,json=priceObservations,proto3" json:"price_observations"`
 }
 
 func (m *MarketRecord) Reset()         { *m = MarketRecord{} }
@@ -142,10 +154,131 @@ func (m *NumMMOrdersRecord) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_NumMMOrdersRecord proto.InternalMessageInfo
 
+type CancelAfterRecord struct {
+	Orderer     string    `protobuf:"bytes,1,opt,name=orderer,proto3" json:"orderer,omitempty"`
+	MarketId    uint64    `protobuf:"varint,2,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	CancelAfter time.Time `protobuf:"bytes,3,opt,name=cancel_after,json=cancelAfter,proto3,stdtime" json:"cancel_after"`
+}
+
+func (m *CancelAfterRecord) Reset()         { *m = CancelAfterRecord{} }
+func (m *CancelAfterRecord) String() string { return proto.CompactTextString(m) }
+func (*CancelAfterRecord) ProtoMessage()    {}
+func (*CancelAfterRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_53f395d5da469d2f, []int{3}
+}
+func (m *CancelAfterRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CancelAfterRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CancelAfterRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CancelAfterRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CancelAfterRecord.Merge(m, src)
+}
+func (m *CancelAfterRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *CancelAfterRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_CancelAfterRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CancelAfterRecord proto.InternalMessageInfo
+
+type AccountVolumeRecord struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// day is the number of days since the unix epoch.
+	Day    uint64                                 `protobuf:"varint,2,opt,name=day,proto3" json:"day,omitempty"`
+	Volume github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=volume,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"volume"`
+}
+
+func (m *AccountVolumeRecord) Reset()         { *m = AccountVolumeRecord{} }
+func (m *AccountVolumeRecord) String() string { return proto.CompactTextString(m) }
+func (*AccountVolumeRecord) ProtoMessage()    {}
+func (*AccountVolumeRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_53f395d5da469d2f, []int{4}
+}
+func (m *AccountVolumeRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AccountVolumeRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AccountVolumeRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AccountVolumeRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccountVolumeRecord.Merge(m, src)
+}
+func (m *AccountVolumeRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *AccountVolumeRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_AccountVolumeRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AccountVolumeRecord proto.InternalMessageInfo
+
+type ReferrerStatsRecord struct {
+	Referrer string        `protobuf:"bytes,1,opt,name=referrer,proto3" json:"referrer,omitempty"`
+	MarketId uint64        `protobuf:"varint,2,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	Stats    ReferrerStats `protobuf:"bytes,3,opt,name=stats,proto3" json:"stats"`
+}
+
+func (m *ReferrerStatsRecord) Reset()         { *m = ReferrerStatsRecord{} }
+func (m *ReferrerStatsRecord) String() string { return proto.CompactTextString(m) }
+func (*ReferrerStatsRecord) ProtoMessage()    {}
+func (*ReferrerStatsRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_53f395d5da469d2f, []int{5}
+}
+func (m *ReferrerStatsRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReferrerStatsRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ReferrerStatsRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ReferrerStatsRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReferrerStatsRecord.Merge(m, src)
+}
+func (m *ReferrerStatsRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReferrerStatsRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReferrerStatsRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReferrerStatsRecord proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "crescent.exchange.v1beta1.GenesisState")
 	proto.RegisterType((*MarketRecord)(nil), "crescent.exchange.v1beta1.MarketRecord")
 	proto.RegisterType((*NumMMOrdersRecord)(nil), "crescent.exchange.v1beta1.NumMMOrdersRecord")
+	proto.RegisterType((*CancelAfterRecord)(nil), "crescent.exchange.v1beta1.CancelAfterRecord")
+	proto.RegisterType((*AccountVolumeRecord)(nil), "crescent.exchange.v1beta1.AccountVolumeRecord")
+	proto.RegisterType((*ReferrerStatsRecord)(nil), "crescent.exchange.v1beta1.ReferrerStatsRecord")
 }
 
 func init() {
@@ -153,37 +286,56 @@ func init() {
 }
 
 var fileDescriptor_53f395d5da469d2f = []byte{
-	// 475 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x53, 0xcd, 0x6e, 0xd3, 0x40,
-	0x18, 0xb4, 0x49, 0x1a, 0xe8, 0x3a, 0x29, 0x62, 0x05, 0x92, 0x09, 0x92, 0x13, 0x22, 0xd4, 0xfa,
-	0x00, 0xb6, 0x9a, 0x0a, 0x89, 0x13, 0x48, 0xbe, 0xa0, 0x1e, 0x0c, 0xc8, 0x20, 0x0e, 0x5c, 0x22,
-	0xc7, 0x5e, 0xb9, 0x51, 0x59, 0x6f, 0xb4, 0xbb, 0x2e, 0x45, 0x1c, 0x78, 0x05, 0x24, 0x5e, 0x83,
-	0x07, 0xc9, 0xb1, 0x47, 0x4e, 0x11, 0x38, 0x2f, 0x82, 0xf6, 0x5b, 0x3b, 0xb8, 0x54, 0x09, 0xbd,
-	0x25, 0xe3, 0x99, 0xf9, 0xe6, 0xfb, 0x59, 0x74, 0x90, 0x70, 0x22, 0x12, 0x92, 0x4b, 0x9f, 0x9c,
-	0x27, 0x27, 0x71, 0x9e, 0x11, 0xff, 0xec, 0x70, 0x4a, 0x64, 0x7c, 0xe8, 0x67, 0x24, 0x27, 0x62,
-	0x26, 0xbc, 0x39, 0x67, 0x92, 0xe1, 0xfb, 0x35, 0xd1, 0xab, 0x89, 0x5e, 0x45, 0xec, 0xdf, 0xcd,
-	0x58, 0xc6, 0x80, 0xe5, 0xab, 0x5f, 0x5a, 0xd0, 0x77, 0x37, 0x3b, 0xaf, 0x1d, 0x34, 0x73, 0x7f,
-	0x33, 0x73, 0x1e, 0xf3, 0x98, 0x56, 0x11, 0x46, 0x3f, 0x5a, 0xa8, 0xfb, 0x52, 0x87, 0x7a, 0x2b,
-	0x63, 0x49, 0xf0, 0x0b, 0xd4, 0xd1, 0x04, 0xdb, 0x1c, 0x9a, 0xae, 0x35, 0x7e, 0xe8, 0x6d, 0x0c,
-	0xe9, 0xbd, 0x01, 0x62, 0xd0, 0x5e, 0x2c, 0x07, 0x46, 0x54, 0xc9, 0xf0, 0x23, 0xb4, 0xf7, 0x31,
-	0x16, 0x72, 0x42, 0x63, 0x7e, 0x4a, 0xe4, 0x64, 0x96, 0xda, 0x37, 0x86, 0xa6, 0xdb, 0x8e, 0xba,
-	0x0a, 0x0d, 0x01, 0x3c, 0x4e, 0xf1, 0x08, 0xf5, 0x80, 0xc5, 0x78, 0x4a, 0xb8, 0x22, 0xb5, 0x80,
-	0x64, 0x29, 0xf0, 0xb5, 0xc2, 0x8e, 0x53, 0xfc, 0x0e, 0xed, 0x55, 0x26, 0x9c, 0x24, 0x8c, 0xa7,
-	0xc2, 0x6e, 0x0f, 0x5b, 0xae, 0x35, 0x3e, 0xd8, 0x12, 0x49, 0x17, 0x88, 0x80, 0x5f, 0x05, 0xeb,
-	0xd1, 0x06, 0x26, 0xf0, 0x73, 0xd4, 0x81, 0xa2, 0xc2, 0xde, 0x01, 0xb7, 0xe1, 0x16, 0x37, 0x48,
-	0x52, 0xf7, 0xa7, 0x55, 0xf8, 0x0b, 0xba, 0x97, 0x17, 0x74, 0x42, 0xa9, 0xce, 0x2e, 0xd6, 0xe1,
-	0x3a, 0x60, 0xf7, 0x78, 0x8b, 0xdd, 0xab, 0x82, 0x86, 0x21, 0x78, 0x8a, 0x2a, 0x61, 0x5f, 0x59,
-	0x97, 0xcb, 0x01, 0xbe, 0xf2, 0x49, 0x44, 0x38, 0x2f, 0x68, 0x48, 0x2f, 0x61, 0xa3, 0xef, 0x26,
-	0xea, 0x36, 0x5b, 0x54, 0xeb, 0xd2, 0xed, 0x5d, 0x63, 0x5d, 0x5a, 0x58, 0xb7, 0xa3, 0x65, 0x38,
-	0x40, 0x3b, 0x42, 0x2d, 0x1e, 0xb6, 0x64, 0x8d, 0xf7, 0xff, 0xab, 0x87, 0x33, 0xa9, 0x4c, 0xb4,
-	0x74, 0xf4, 0x15, 0xdd, 0xb9, 0x92, 0x1f, 0xdb, 0xe8, 0x26, 0x0c, 0x88, 0x70, 0x88, 0xb6, 0x1b,
-	0xd5, 0x7f, 0xf1, 0x03, 0xb4, 0xfb, 0xef, 0x71, 0xdc, 0xa2, 0xf5, 0x61, 0x1c, 0xa1, 0xde, 0xa5,
-	0xf1, 0xc2, 0x61, 0xf4, 0x82, 0xdb, 0xe5, 0x72, 0x60, 0x35, 0x8b, 0x58, 0x8d, 0xe9, 0x04, 0xef,
-	0x17, 0xbf, 0x1d, 0x63, 0x51, 0x3a, 0xe6, 0x45, 0xe9, 0x98, 0xbf, 0x4a, 0xc7, 0xfc, 0xb6, 0x72,
-	0x8c, 0x8b, 0x95, 0x63, 0xfc, 0x5c, 0x39, 0xc6, 0x87, 0x67, 0xd9, 0x4c, 0x9e, 0x14, 0x53, 0x2f,
-	0x61, 0xd4, 0xaf, 0xbb, 0x7b, 0x92, 0x13, 0xf9, 0x89, 0xf1, 0xd3, 0x35, 0xe0, 0x9f, 0x3d, 0xf5,
-	0xcf, 0xff, 0x3e, 0x16, 0xf9, 0x79, 0x4e, 0xc4, 0xb4, 0x03, 0x8f, 0xe4, 0xe8, 0x4f, 0x00, 0x00,
-	0x00, 0xff, 0xff, 0xa6, 0x42, 0x9c, 0x89, 0xd2, 0x03, 0x00, 0x00,
+	// 783 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x55, 0xbf, 0x4f, 0x1b, 0x49,
+	0x14, 0xf6, 0x9e, 0xc1, 0xd8, 0xb3, 0x36, 0x77, 0x0c, 0xdc, 0xc9, 0xe7, 0x93, 0x6c, 0x9f, 0x15,
+	0x81, 0xa5, 0x84, 0x5d, 0x61, 0x14, 0x29, 0x55, 0x22, 0x1c, 0x14, 0x44, 0xe1, 0x10, 0x6d, 0x10,
+	0x45, 0x1a, 0x67, 0xbc, 0x3b, 0x2c, 0x0e, 0xde, 0x1d, 0x6b, 0x66, 0xec, 0x80, 0x52, 0xa4, 0x4e,
+	0x87, 0x94, 0xfc, 0x41, 0x29, 0x29, 0x29, 0xa3, 0x14, 0x4e, 0x62, 0xfe, 0x86, 0xf4, 0xd1, 0xfc,
+	0x58, 0xb3, 0xc6, 0x60, 0xa3, 0x54, 0x78, 0xde, 0x7c, 0xdf, 0x7b, 0xdf, 0x7b, 0xfb, 0xbd, 0x01,
+	0xac, 0xb9, 0x14, 0x33, 0x17, 0x87, 0xdc, 0xc6, 0x27, 0xee, 0x11, 0x0a, 0x7d, 0x6c, 0xf7, 0x37,
+	0x5a, 0x98, 0xa3, 0x0d, 0xdb, 0xc7, 0x21, 0x66, 0x6d, 0x66, 0x75, 0x29, 0xe1, 0x04, 0xfe, 0x1b,
+	0x01, 0xad, 0x08, 0x68, 0x69, 0x60, 0x61, 0xc5, 0x27, 0x3e, 0x91, 0x28, 0x5b, 0xfc, 0x52, 0x84,
+	0x42, 0xc9, 0x27, 0xc4, 0xef, 0x60, 0x5b, 0x9e, 0x5a, 0xbd, 0x43, 0x9b, 0xb7, 0x03, 0xcc, 0x38,
+	0x0a, 0xba, 0x1a, 0x50, 0xbd, 0xbd, 0xf4, 0xa8, 0x84, 0x42, 0xae, 0xde, 0x8e, 0xec, 0x22, 0x8a,
+	0x02, 0xad, 0xb1, 0xf2, 0x39, 0x05, 0xb2, 0x3b, 0x4a, 0xf5, 0x4b, 0x8e, 0x38, 0x86, 0x4f, 0x40,
+	0x4a, 0x01, 0xf2, 0x46, 0xd9, 0xa8, 0x9a, 0xb5, 0xff, 0xad, 0x5b, 0xbb, 0xb0, 0x5e, 0x48, 0x60,
+	0x7d, 0xee, 0x7c, 0x50, 0x4a, 0x38, 0x9a, 0x06, 0xef, 0x81, 0xc5, 0x0e, 0x62, 0xbc, 0x19, 0x20,
+	0x7a, 0x8c, 0x79, 0xb3, 0xed, 0xe5, 0xff, 0x28, 0x1b, 0xd5, 0x39, 0x27, 0x2b, 0xa2, 0x0d, 0x19,
+	0xdc, 0xf5, 0x60, 0x05, 0xe4, 0x24, 0x8a, 0x50, 0x0f, 0x53, 0x01, 0x4a, 0x4a, 0x90, 0x29, 0x82,
+	0x7b, 0x22, 0xb6, 0xeb, 0xc1, 0x7d, 0xb0, 0xa8, 0x93, 0x50, 0xec, 0x12, 0xea, 0xb1, 0xfc, 0x5c,
+	0x39, 0x59, 0x35, 0x6b, 0x6b, 0x53, 0x24, 0xa9, 0x02, 0x8e, 0xc4, 0x6b, 0x61, 0xb9, 0x20, 0x16,
+	0x63, 0xf0, 0x31, 0x48, 0xc9, 0xa2, 0x2c, 0x3f, 0x2f, 0xb3, 0x95, 0xa7, 0x64, 0x93, 0x4a, 0xa2,
+	0xfe, 0x14, 0x0b, 0xbe, 0x03, 0x7f, 0x87, 0xbd, 0xa0, 0x19, 0x04, 0x4a, 0x3b, 0x1b, 0x89, 0x4b,
+	0xc9, 0x74, 0x0f, 0xa6, 0xa4, 0x7b, 0xde, 0x0b, 0x1a, 0x0d, 0x99, 0x93, 0x69, 0x85, 0x05, 0x91,
+	0x7a, 0x38, 0x28, 0xc1, 0x89, 0x2b, 0xe6, 0xc0, 0xb0, 0x17, 0x34, 0x82, 0xb1, 0x98, 0x18, 0x09,
+	0xa7, 0x6d, 0xdf, 0xc7, 0x54, 0x57, 0xcf, 0x2f, 0xcc, 0x1c, 0xc9, 0xbe, 0x22, 0xc4, 0x7b, 0xc9,
+	0xf1, 0x58, 0x8c, 0xc1, 0x37, 0xe0, 0x1f, 0xe4, 0xba, 0xa4, 0x17, 0xf2, 0x66, 0x9f, 0x74, 0x7a,
+	0x01, 0x1e, 0xf5, 0x94, 0x96, 0xd9, 0xad, 0x29, 0xd9, 0xb7, 0x14, 0xf1, 0x40, 0xf2, 0xc6, 0xe6,
+	0xbe, 0x82, 0x26, 0xaf, 0x18, 0xf4, 0xc0, 0x8a, 0x8b, 0x42, 0x17, 0x77, 0x9a, 0xe8, 0x90, 0x63,
+	0x3a, 0xaa, 0x94, 0x99, 0x39, 0xbd, 0xa7, 0x92, 0xb6, 0x25, 0x58, 0x63, 0x75, 0xa0, 0x7b, 0xfd,
+	0x42, 0x76, 0x44, 0xf1, 0x21, 0xa6, 0x14, 0xd3, 0x26, 0xe3, 0x88, 0x5f, 0x7d, 0x25, 0x30, 0xb3,
+	0x23, 0x47, 0x13, 0xc5, 0x3e, 0xb0, 0xf1, 0x8e, 0xe8, 0xe4, 0x15, 0xab, 0xfc, 0x34, 0x40, 0x36,
+	0x6e, 0x3b, 0xb1, 0x42, 0xca, 0x72, 0x77, 0x58, 0x21, 0x45, 0x8c, 0x2c, 0xa6, 0x68, 0xb0, 0x0e,
+	0xe6, 0x85, 0x68, 0x2c, 0x37, 0xc7, 0xac, 0xad, 0xce, 0xe4, 0xcb, 0xd5, 0xd5, 0x49, 0x14, 0x15,
+	0xbe, 0x06, 0xb0, 0x4b, 0xdb, 0x2e, 0x6e, 0x92, 0x16, 0xc3, 0xb4, 0x8f, 0x78, 0x9b, 0x84, 0x2c,
+	0x9f, 0x94, 0xdd, 0xdf, 0x9f, 0xb6, 0xd3, 0x82, 0xb4, 0x77, 0xc5, 0xd1, 0x59, 0x97, 0xba, 0xd7,
+	0xe2, 0xac, 0xf2, 0x1e, 0x2c, 0x4d, 0xb8, 0x16, 0xe6, 0xc1, 0x82, 0x34, 0x26, 0xa6, 0xb2, 0xf9,
+	0x8c, 0x13, 0x1d, 0xe1, 0x7f, 0x20, 0x73, 0xfd, 0x49, 0x48, 0x07, 0xd1, 0x73, 0xb0, 0x09, 0x72,
+	0x63, 0x4b, 0x25, 0x9f, 0x83, 0x5c, 0xfd, 0xcf, 0xe1, 0xa0, 0x64, 0xc6, 0x8b, 0x98, 0xb1, 0x9d,
+	0xa8, 0x7c, 0x34, 0xc0, 0xd2, 0x84, 0x29, 0x7e, 0x57, 0xc1, 0x0e, 0xc8, 0xc6, 0x7d, 0x29, 0x05,
+	0x98, 0xb5, 0x82, 0xa5, 0x9e, 0x64, 0x2b, 0x7a, 0x92, 0xad, 0xfd, 0xe8, 0x49, 0xae, 0xa7, 0xc5,
+	0x60, 0xce, 0xbe, 0x95, 0x0c, 0xc7, 0x8c, 0x39, 0xb0, 0xf2, 0xc1, 0x00, 0xcb, 0x37, 0x2c, 0x85,
+	0xd0, 0x85, 0x3c, 0x8f, 0x62, 0xc6, 0x22, 0x5d, 0xfa, 0x08, 0xff, 0x02, 0x49, 0x0f, 0x9d, 0x6a,
+	0x45, 0xe2, 0x27, 0x7c, 0x06, 0x52, 0x6a, 0x11, 0xa5, 0x8c, 0x4c, 0xdd, 0x12, 0xa5, 0xbe, 0x0e,
+	0x4a, 0xab, 0x7e, 0x9b, 0x1f, 0xf5, 0x5a, 0x96, 0x4b, 0x02, 0xdb, 0x25, 0x2c, 0x20, 0x4c, 0xff,
+	0x59, 0x67, 0xde, 0xb1, 0xcd, 0x4f, 0xbb, 0x98, 0x59, 0xdb, 0xd8, 0x75, 0x34, 0xbb, 0xf2, 0xc9,
+	0x00, 0xcb, 0x37, 0xd8, 0x19, 0x16, 0x40, 0x3a, 0xb2, 0xb2, 0x16, 0x33, 0x3a, 0x4f, 0x9f, 0xd2,
+	0xb6, 0x72, 0x26, 0xd3, 0xe3, 0xa9, 0xde, 0x75, 0x8d, 0xe2, 0xde, 0x64, 0xf5, 0x83, 0xf3, 0x1f,
+	0xc5, 0xc4, 0xf9, 0xb0, 0x68, 0x5c, 0x0c, 0x8b, 0xc6, 0xf7, 0x61, 0xd1, 0x38, 0xbb, 0x2c, 0x26,
+	0x2e, 0x2e, 0x8b, 0x89, 0x2f, 0x97, 0xc5, 0xc4, 0xab, 0x47, 0xf1, 0x26, 0x75, 0xfa, 0xf5, 0x10,
+	0xf3, 0xb7, 0x84, 0x1e, 0x8f, 0x02, 0x76, 0xff, 0xa1, 0x7d, 0x72, 0xf5, 0xbf, 0x4d, 0xb6, 0xde,
+	0x4a, 0xc9, 0xaf, 0xb4, 0xf9, 0x6b, 0x00, 0xd7, 0x42, 0x4e, 0x20, 0xa2, 0x07, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -206,6 +358,62 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ReferrerStatsRecords) > 0 {
+		for iNdEx := len(m.ReferrerStatsRecords) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ReferrerStatsRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.CancelAfterRecords) > 0 {
+		for iNdEx := len(m.CancelAfterRecords) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CancelAfterRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.AccountVolumeRecords) > 0 {
+		for iNdEx := len(m.AccountVolumeRecords) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AccountVolumeRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.TriggerOrders) > 0 {
+		for iNdEx := len(m.TriggerOrders) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TriggerOrders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
 	if len(m.NumMMOrdersRecords) > 0 {
 		for iNdEx := len(m.NumMMOrdersRecords) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -291,6 +499,20 @@ func (m *MarketRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.PriceObservations) > 0 {
+		for iNdEx := len(m.PriceObservations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PriceObservations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	{
 		size, err := m.State.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -354,6 +576,139 @@ func (m *NumMMOrdersRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *CancelAfterRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CancelAfterRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CancelAfterRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n4, err4 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.CancelAfter, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.CancelAfter):])
+	if err4 != nil {
+		return 0, err4
+	}
+	i -= n4
+	i = encodeVarintGenesis(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0x1a
+	if m.MarketId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.MarketId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Orderer) > 0 {
+		i -= len(m.Orderer)
+		copy(dAtA[i:], m.Orderer)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Orderer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AccountVolumeRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AccountVolumeRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AccountVolumeRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Volume.Size()
+		i -= size
+		if _, err := m.Volume.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.Day != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Day))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ReferrerStatsRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReferrerStatsRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReferrerStatsRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Stats.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.MarketId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.MarketId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Referrer) > 0 {
+		i -= len(m.Referrer)
+		copy(dAtA[i:], m.Referrer)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Referrer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
 	offset -= sovGenesis(v)
 	base := offset
@@ -397,48 +752,641 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
-	return n
-}
-
-func (m *MarketRecord) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Market.Size()
-	n += 1 + l + sovGenesis(uint64(l))
-	l = m.State.Size()
-	n += 1 + l + sovGenesis(uint64(l))
-	return n
-}
+	if len(m.TriggerOrders) > 0 {
+		for _, e := range m.TriggerOrders {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.AccountVolumeRecords) > 0 {
+		for _, e := range m.AccountVolumeRecords {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.CancelAfterRecords) > 0 {
+		for _, e := range m.CancelAfterRecords {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ReferrerStatsRecords) > 0 {
+		for _, e := range m.ReferrerStatsRecords {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MarketRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Market.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.State.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.PriceObservations) > 0 {
+		for _, e := range m.PriceObservations {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *NumMMOrdersRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Orderer)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.MarketId != 0 {
+		n += 1 + sovGenesis(uint64(m.MarketId))
+	}
+	if m.NumMMOrders != 0 {
+		n += 1 + sovGenesis(uint64(m.NumMMOrders))
+	}
+	return n
+}
+
+func (m *CancelAfterRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Orderer)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.MarketId != 0 {
+		n += 1 + sovGenesis(uint64(m.MarketId))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.CancelAfter)
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *AccountVolumeRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.Day != 0 {
+		n += 1 + sovGenesis(uint64(m.Day))
+	}
+	l = m.Volume.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *ReferrerStatsRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Referrer)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.MarketId != 0 {
+		n += 1 + sovGenesis(uint64(m.MarketId))
+	}
+	l = m.Stats.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func sovGenesis(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGenesis(x uint64) (n int) {
+	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *GenesisState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastMarketId", wireType)
+			}
+			m.LastMarketId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastMarketId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastOrderId", wireType)
+			}
+			m.LastOrderId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastOrderId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketRecords", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MarketRecords = append(m.MarketRecords, MarketRecord{})
+			if err := m.MarketRecords[len(m.MarketRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Orders", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Orders = append(m.Orders, Order{})
+			if err := m.Orders[len(m.Orders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumMMOrdersRecords", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NumMMOrdersRecords = append(m.NumMMOrdersRecords, NumMMOrdersRecord{})
+			if err := m.NumMMOrdersRecords[len(m.NumMMOrdersRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TriggerOrders", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TriggerOrders = append(m.TriggerOrders, TriggerOrder{})
+			if err := m.TriggerOrders[len(m.TriggerOrders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountVolumeRecords", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AccountVolumeRecords = append(m.AccountVolumeRecords, AccountVolumeRecord{})
+			if err := m.AccountVolumeRecords[len(m.AccountVolumeRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CancelAfterRecords", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CancelAfterRecords = append(m.CancelAfterRecords, CancelAfterRecord{})
+			if err := m.CancelAfterRecords[len(m.CancelAfterRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReferrerStatsRecords", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReferrerStatsRecords = append(m.ReferrerStatsRecords, ReferrerStatsRecord{})
+			if err := m.ReferrerStatsRecords[len(m.ReferrerStatsRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MarketRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MarketRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MarketRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Market", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Market.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.State.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceObservations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PriceObservations = append(m.PriceObservations, PriceObservation{})
+			if err := m.PriceObservations[len(m.PriceObservations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *NumMMOrdersRecord) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Orderer)
-	if l > 0 {
-		n += 1 + l + sovGenesis(uint64(l))
-	}
-	if m.MarketId != 0 {
-		n += 1 + sovGenesis(uint64(m.MarketId))
-	}
-	if m.NumMMOrders != 0 {
-		n += 1 + sovGenesis(uint64(m.NumMMOrders))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovGenesis(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozGenesis(x uint64) (n int) {
-	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *GenesisState) Unmarshal(dAtA []byte) error {
+func (m *NumMMOrdersRecord) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -461,17 +1409,17 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+			return fmt.Errorf("proto: NumMMOrdersRecord: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: NumMMOrdersRecord: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Orderer", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -481,30 +1429,29 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Orderer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastMarketId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
 			}
-			m.LastMarketId = 0
+			m.MarketId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -514,16 +1461,16 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.LastMarketId |= uint64(b&0x7F) << shift
+				m.MarketId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastOrderId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NumMMOrders", wireType)
 			}
-			m.LastOrderId = 0
+			m.NumMMOrders = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -533,16 +1480,66 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.LastOrderId |= uint64(b&0x7F) << shift
+				m.NumMMOrders |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CancelAfterRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CancelAfterRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CancelAfterRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MarketRecords", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Orderer", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -552,31 +1549,29 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MarketRecords = append(m.MarketRecords, MarketRecord{})
-			if err := m.MarketRecords[len(m.MarketRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Orderer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Orders", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
 			}
-			var msglen int
+			m.MarketId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -586,29 +1581,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.MarketId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Orders = append(m.Orders, Order{})
-			if err := m.Orders[len(m.Orders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 6:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NumMMOrdersRecords", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CancelAfter", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -635,8 +1615,7 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NumMMOrdersRecords = append(m.NumMMOrdersRecords, NumMMOrdersRecord{})
-			if err := m.NumMMOrdersRecords[len(m.NumMMOrdersRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.CancelAfter, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -661,7 +1640,7 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MarketRecord) Unmarshal(dAtA []byte) error {
+func (m *AccountVolumeRecord) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -684,17 +1663,17 @@ func (m *MarketRecord) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MarketRecord: wiretype end group for non-group")
+			return fmt.Errorf("proto: AccountVolumeRecord: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MarketRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AccountVolumeRecord: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Market", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -704,30 +1683,48 @@ func (m *MarketRecord) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Market.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Day", wireType)
+			}
+			m.Day = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Day |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Volume", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -737,22 +1734,23 @@ func (m *MarketRecord) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.State.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Volume.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -777,7 +1775,7 @@ func (m *MarketRecord) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *NumMMOrdersRecord) Unmarshal(dAtA []byte) error {
+func (m *ReferrerStatsRecord) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -800,15 +1798,15 @@ func (m *NumMMOrdersRecord) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: NumMMOrdersRecord: wiretype end group for non-group")
+			return fmt.Errorf("proto: ReferrerStatsRecord: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: NumMMOrdersRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ReferrerStatsRecord: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Orderer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Referrer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -836,7 +1834,7 @@ func (m *NumMMOrdersRecord) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Orderer = string(dAtA[iNdEx:postIndex])
+			m.Referrer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
@@ -858,10 +1856,10 @@ func (m *NumMMOrdersRecord) Unmarshal(dAtA []byte) error {
 				}
 			}
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NumMMOrders", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stats", wireType)
 			}
-			m.NumMMOrders = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -871,11 +1869,25 @@ func (m *NumMMOrdersRecord) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.NumMMOrders |= uint32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Stats.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -5,6 +5,8 @@ import (
 
 	"github.com/stretchr/testify/require"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
 	utils "github.com/crescent-network/crescent/v5/types"
 	"github.com/crescent-network/crescent/v5/x/exchange/types"
 )
@@ -59,3 +61,65 @@ func TestNumMMOrdersRecord_Validate(t *testing.T) {
 		})
 	}
 }
+
+func TestMarketRecord_Validate(t *testing.T) {
+	for _, tc := range []struct {
+		name        string
+		malleate    func(record *types.MarketRecord)
+		expectedErr string
+	}{
+		{
+			"happy case",
+			func(record *types.MarketRecord) {},
+			"",
+		},
+		{
+			"mismatching number of price observations",
+			func(record *types.MarketRecord) {
+				record.State.NumPriceObservations = 3
+			},
+			"number of price observations doesn't match the market state: 2 != 3",
+		},
+		{
+			"invalid last price observation index",
+			func(record *types.MarketRecord) {
+				record.State.LastPriceObservationIndex = 2
+			},
+			"invalid market state: invalid last price observation index: 2",
+		},
+		{
+			"invalid price observation",
+			func(record *types.MarketRecord) {
+				record.PriceObservations[1].Price = sdk.ZeroDec()
+			},
+			"invalid price observation: price must be positive: 0.000000000000000000",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			lastPrice := utils.ParseDec("5.1")
+			record := types.MarketRecord{
+				Market: types.NewMarket(
+					1, "ucre", "uusd", utils.ParseDec("0.0015"), utils.ParseDec("0.003"), utils.ParseDec("0.5")),
+				State: types.MarketState{
+					LastPrice:                 &lastPrice,
+					LastMatchingHeight:        2,
+					NumPriceObservations:      2,
+					LastPriceObservationIndex: 1,
+				},
+				PriceObservations: []types.PriceObservation{
+					types.NewPriceObservation(
+						utils.ParseTime("2023-06-01T00:00:00Z"), utils.ParseDec("0"), utils.ParseDec("5"), 1),
+					types.NewPriceObservation(
+						utils.ParseTime("2023-06-01T00:00:05Z"), utils.ParseDec("25"), utils.ParseDec("5.1"), 2),
+				},
+			}
+			tc.malleate(&record)
+			err := record.Validate(utils.ZeroDec)
+			if tc.expectedErr == "" {
+				require.NoError(t, err)
+			} else {
+				require.EqualError(t, err, tc.expectedErr)
+			}
+		})
+	}
+}
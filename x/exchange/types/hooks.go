@@ -0,0 +1,96 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ ExchangeHooks = MultiExchangeHooks{}
+
+// ExchangeHooks defines the hooks called by the exchange module on market and
+// order lifecycle events.
+type ExchangeHooks interface {
+	// AfterMarketCreated is called after a new market is created.
+	AfterMarketCreated(ctx sdk.Context, market Market) error
+	// AfterOrderPlaced is called after an order is placed on the order book.
+	// Orders which are fully executed or rejected upon placement never rest
+	// on the order book, so this hook is not called for them.
+	AfterOrderPlaced(ctx sdk.Context, order Order) error
+	// AfterOrderFilled is called for each filled order, including orders
+	// from order sources and the taker side of an order execution.
+	AfterOrderFilled(ctx sdk.Context, market Market, fill OrderFill) error
+	// AfterOrderCanceled is called after an order is canceled by the orderer
+	// or removed from the order book due to its expiration.
+	AfterOrderCanceled(ctx sdk.Context, order Order) error
+	// AfterBatchMatched is called after a batch matching happened in a market.
+	AfterBatchMatched(ctx sdk.Context, market Market, lastPrice sdk.Dec) error
+}
+
+// OrderFill holds the result of an order fill passed to
+// ExchangeHooks.AfterOrderFilled.
+type OrderFill struct {
+	OrderId          uint64 // 0 for order source orders and swaps
+	SourceName       string // empty for user orders
+	Orderer          sdk.AccAddress
+	IsBuy            bool
+	IsMaker          bool
+	ExecutedQuantity sdk.Dec
+	ExecutedQuote    sdk.Dec
+	Paid             sdk.DecCoin
+	Received         sdk.DecCoin
+	// Fee is deducted from the received amount when positive. A negative fee
+	// means a fee rebate added to the paid amount.
+	Fee sdk.Dec
+}
+
+// MultiExchangeHooks combines multiple exchange hooks, all hook functions are
+// run in array sequence.
+type MultiExchangeHooks []ExchangeHooks
+
+func NewMultiExchangeHooks(hooks ...ExchangeHooks) MultiExchangeHooks {
+	return hooks
+}
+
+func (h MultiExchangeHooks) AfterMarketCreated(ctx sdk.Context, market Market) error {
+	for i := range h {
+		if err := h[i].AfterMarketCreated(ctx, market); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (h MultiExchangeHooks) AfterOrderPlaced(ctx sdk.Context, order Order) error {
+	for i := range h {
+		if err := h[i].AfterOrderPlaced(ctx, order); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (h MultiExchangeHooks) AfterOrderFilled(ctx sdk.Context, market Market, fill OrderFill) error {
+	for i := range h {
+		if err := h[i].AfterOrderFilled(ctx, market, fill); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (h MultiExchangeHooks) AfterOrderCanceled(ctx sdk.Context, order Order) error {
+	for i := range h {
+		if err := h[i].AfterOrderCanceled(ctx, order); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (h MultiExchangeHooks) AfterBatchMatched(ctx sdk.Context, market Market, lastPrice sdk.Dec) error {
+	for i := range h {
+		if err := h[i].AfterBatchMatched(ctx, market, lastPrice); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -1,6 +1,8 @@
 package types
 
 import (
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/address"
 
@@ -22,15 +24,25 @@ const (
 )
 
 var (
-	LastMarketIdKey               = []byte{0x60}
-	LastOrderIdKey                = []byte{0x61}
-	MarketKeyPrefix               = []byte{0x62}
-	MarketStateKeyPrefix          = []byte{0x63}
-	MarketByDenomsIndexKeyPrefix  = []byte{0x64}
-	OrderKeyPrefix                = []byte{0x65}
-	OrderBookOrderIndexKeyPrefix  = []byte{0x66}
-	OrdersByOrdererIndexKeyPrefix = []byte{0x67}
-	NumMMOrdersKeyPrefix          = []byte{0x68}
+	LastMarketIdKey                       = []byte{0x60}
+	LastOrderIdKey                        = []byte{0x61}
+	MarketKeyPrefix                       = []byte{0x62}
+	MarketStateKeyPrefix                  = []byte{0x63}
+	MarketByDenomsIndexKeyPrefix          = []byte{0x64}
+	OrderKeyPrefix                        = []byte{0x65}
+	OrderBookOrderIndexKeyPrefix          = []byte{0x66}
+	OrdersByOrdererIndexKeyPrefix         = []byte{0x67}
+	NumMMOrdersKeyPrefix                  = []byte{0x68}
+	TriggerOrderKeyPrefix                 = []byte{0x69}
+	TriggerOrderIndexKeyPrefix            = []byte{0x6a}
+	TriggerOrdersByOrdererIndexKeyPrefix  = []byte{0x6b}
+	PriceObservationKeyPrefix             = []byte{0x6c}
+	AccountVolumeKeyPrefix                = []byte{0x6d}
+	CancelAfterKeyPrefix                  = []byte{0x6e}
+	PriceLevelKeyPrefix                   = []byte{0x6f}
+	ActiveMarketsByDenomIndexKeyPrefix    = []byte{0x70}
+	ReferrerStatsKeyPrefix                = []byte{0x73}
+	TriggerOrdersByDeadlineIndexKeyPrefix = []byte{0x74}
 )
 
 func GetMarketKey(marketId uint64) []byte {
@@ -111,6 +123,141 @@ func GetNumMMOrdersKey(ordererAddr sdk.AccAddress, marketId uint64) []byte {
 		sdk.Uint64ToBigEndian(marketId))
 }
 
+func GetTriggerOrderKey(orderId uint64) []byte {
+	return utils.Key(TriggerOrderKeyPrefix, sdk.Uint64ToBigEndian(orderId))
+}
+
+func GetTriggerOrderIndexKey(marketId uint64, condition TriggerCondition, triggerPrice sdk.Dec, orderId uint64) []byte {
+	return utils.Key(
+		TriggerOrderIndexKeyPrefix,
+		sdk.Uint64ToBigEndian(marketId),
+		[]byte{byte(condition)},
+		PriceToBytes(triggerPrice),
+		sdk.Uint64ToBigEndian(orderId))
+}
+
+func GetTriggerOrdersByConditionIteratorPrefix(marketId uint64, condition TriggerCondition) []byte {
+	return utils.Key(
+		TriggerOrderIndexKeyPrefix,
+		sdk.Uint64ToBigEndian(marketId),
+		[]byte{byte(condition)})
+}
+
+func GetTriggerOrdersByTriggerPriceIteratorPrefix(marketId uint64, condition TriggerCondition, triggerPrice sdk.Dec) []byte {
+	return utils.Key(
+		TriggerOrderIndexKeyPrefix,
+		sdk.Uint64ToBigEndian(marketId),
+		[]byte{byte(condition)},
+		PriceToBytes(triggerPrice))
+}
+
+func GetTriggerOrdersByMarketIteratorPrefix(marketId uint64) []byte {
+	return utils.Key(TriggerOrderIndexKeyPrefix, sdk.Uint64ToBigEndian(marketId))
+}
+
+func GetTriggerOrdersByOrdererIndexKey(ordererAddr sdk.AccAddress, marketId, orderId uint64) []byte {
+	return utils.Key(
+		TriggerOrdersByOrdererIndexKeyPrefix,
+		address.MustLengthPrefix(ordererAddr),
+		sdk.Uint64ToBigEndian(marketId),
+		sdk.Uint64ToBigEndian(orderId))
+}
+
+func GetTriggerOrdersByOrdererIteratorPrefix(ordererAddr sdk.AccAddress) []byte {
+	return utils.Key(TriggerOrdersByOrdererIndexKeyPrefix, address.MustLengthPrefix(ordererAddr))
+}
+
+func GetTriggerOrdersByOrdererAndMarketIteratorPrefix(ordererAddr sdk.AccAddress, marketId uint64) []byte {
+	return utils.Key(
+		TriggerOrdersByOrdererIndexKeyPrefix,
+		address.MustLengthPrefix(ordererAddr),
+		sdk.Uint64ToBigEndian(marketId))
+}
+
+func GetTriggerOrdersByDeadlineIndexKey(deadline time.Time, orderId uint64) []byte {
+	return utils.Key(
+		TriggerOrdersByDeadlineIndexKeyPrefix,
+		sdk.FormatTimeBytes(deadline),
+		sdk.Uint64ToBigEndian(orderId))
+}
+
+// GetExpiredTriggerOrdersIteratorEndBytes returns the end bytes for iterating
+// through trigger orders whose deadline is not after blockTime.
+func GetExpiredTriggerOrdersIteratorEndBytes(blockTime time.Time) []byte {
+	return sdk.PrefixEndBytes(utils.Key(TriggerOrdersByDeadlineIndexKeyPrefix, sdk.FormatTimeBytes(blockTime)))
+}
+
+func GetPriceObservationKey(marketId uint64, index uint32) []byte {
+	return utils.Key(
+		PriceObservationKeyPrefix,
+		sdk.Uint64ToBigEndian(marketId),
+		utils.Uint32ToBigEndian(index))
+}
+
+func GetPriceObservationsByMarketIteratorPrefix(marketId uint64) []byte {
+	return utils.Key(PriceObservationKeyPrefix, sdk.Uint64ToBigEndian(marketId))
+}
+
+func GetAccountVolumeKey(addr sdk.AccAddress, day uint64) []byte {
+	return utils.Key(
+		AccountVolumeKeyPrefix,
+		address.MustLengthPrefix(addr),
+		sdk.Uint64ToBigEndian(day))
+}
+
+func GetAccountVolumesByAccountIteratorPrefix(addr sdk.AccAddress) []byte {
+	return utils.Key(AccountVolumeKeyPrefix, address.MustLengthPrefix(addr))
+}
+
+func GetCancelAfterKey(ordererAddr sdk.AccAddress, marketId uint64) []byte {
+	return utils.Key(
+		CancelAfterKeyPrefix,
+		address.MustLengthPrefix(ordererAddr),
+		sdk.Uint64ToBigEndian(marketId))
+}
+
+func GetCancelAftersByOrdererIteratorPrefix(ordererAddr sdk.AccAddress) []byte {
+	return utils.Key(CancelAfterKeyPrefix, address.MustLengthPrefix(ordererAddr))
+}
+
+func GetReferrerStatsKey(referrerAddr sdk.AccAddress, marketId uint64) []byte {
+	return utils.Key(
+		ReferrerStatsKeyPrefix,
+		address.MustLengthPrefix(referrerAddr),
+		sdk.Uint64ToBigEndian(marketId))
+}
+
+func GetReferrerStatsByReferrerIteratorPrefix(referrerAddr sdk.AccAddress) []byte {
+	return utils.Key(ReferrerStatsKeyPrefix, address.MustLengthPrefix(referrerAddr))
+}
+
+func GetPriceLevelKey(marketId uint64, isBuy bool, price sdk.Dec) []byte {
+	return utils.Key(
+		PriceLevelKeyPrefix,
+		sdk.Uint64ToBigEndian(marketId),
+		isBuyToBytes(isBuy),
+		PriceToBytes(price))
+}
+
+func GetPriceLevelsBySideIteratorPrefix(marketId uint64, isBuy bool) []byte {
+	return utils.Key(
+		PriceLevelKeyPrefix,
+		sdk.Uint64ToBigEndian(marketId),
+		isBuyToBytes(isBuy))
+}
+
+func GetActiveMarketsByDenomIndexKey(denom, counterDenom string, marketId uint64) []byte {
+	return utils.Key(
+		ActiveMarketsByDenomIndexKeyPrefix,
+		utils.LengthPrefixString(denom),
+		utils.LengthPrefixString(counterDenom),
+		sdk.Uint64ToBigEndian(marketId))
+}
+
+func GetActiveMarketsByDenomIteratorPrefix(denom string) []byte {
+	return utils.Key(ActiveMarketsByDenomIndexKeyPrefix, utils.LengthPrefixString(denom))
+}
+
 func ParseMarketByDenomsIndexKey(key []byte) (baseDenom, quoteDenom string) {
 	baseDenomLen := key[1]
 	baseDenom = string(key[2 : 2+baseDenomLen])
@@ -118,6 +265,15 @@ func ParseMarketByDenomsIndexKey(key []byte) (baseDenom, quoteDenom string) {
 	return
 }
 
+func ParseActiveMarketsByDenomIndexKey(key []byte) (denom, counterDenom string, marketId uint64) {
+	denomLen := key[1]
+	denom = string(key[2 : 2+denomLen])
+	counterDenomLen := key[2+denomLen]
+	counterDenom = string(key[3+denomLen : 3+denomLen+counterDenomLen])
+	marketId = sdk.BigEndianToUint64(key[3+denomLen+counterDenomLen:])
+	return
+}
+
 func ParseOrderIdFromOrderBookOrderIndexKey(key []byte) (orderId uint64) {
 	isBuy := key[1+8] == 0
 	orderId = sdk.BigEndianToUint64(key[1+8+1+32:])
@@ -132,12 +288,35 @@ func ParsePriceFromOrderBookOrderIndexKey(key []byte) (price sdk.Dec) {
 	return
 }
 
+func ParsePriceLevelKey(key []byte) (marketId uint64, isBuy bool, price sdk.Dec) {
+	marketId = sdk.BigEndianToUint64(key[1 : 1+8])
+	isBuy = key[1+8] == 0
+	price = BytesToPrice(key[1+8+1:])
+	return
+}
+
 func ParseOrderIdFromOrdersByOrdererIndexKey(key []byte) (orderId uint64) {
 	addrLen := key[1]
 	orderId = sdk.BigEndianToUint64(key[2+addrLen+8:])
 	return
 }
 
+func ParseOrderIdFromTriggerOrderIndexKey(key []byte) (orderId uint64) {
+	orderId = sdk.BigEndianToUint64(key[1+8+1+32:])
+	return
+}
+
+func ParseOrderIdFromTriggerOrdersByOrdererIndexKey(key []byte) (orderId uint64) {
+	addrLen := key[1]
+	orderId = sdk.BigEndianToUint64(key[2+addrLen+8:])
+	return
+}
+
+func ParseOrderIdFromTriggerOrdersByDeadlineIndexKey(key []byte) (orderId uint64) {
+	orderId = sdk.BigEndianToUint64(key[len(key)-8:])
+	return
+}
+
 func ParseNumMMOrdersKey(key []byte) (ordererAddr sdk.AccAddress, marketId uint64) {
 	addrLen := key[1]
 	ordererAddr = key[2 : 2+addrLen]
@@ -145,6 +324,27 @@ func ParseNumMMOrdersKey(key []byte) (ordererAddr sdk.AccAddress, marketId uint6
 	return
 }
 
+func ParseCancelAfterKey(key []byte) (ordererAddr sdk.AccAddress, marketId uint64) {
+	addrLen := key[1]
+	ordererAddr = key[2 : 2+addrLen]
+	marketId = sdk.BigEndianToUint64(key[2+addrLen:])
+	return
+}
+
+func ParseReferrerStatsKey(key []byte) (referrerAddr sdk.AccAddress, marketId uint64) {
+	addrLen := key[1]
+	referrerAddr = key[2 : 2+addrLen]
+	marketId = sdk.BigEndianToUint64(key[2+addrLen:])
+	return
+}
+
+func ParseAccountVolumeKey(key []byte) (addr sdk.AccAddress, day uint64) {
+	addrLen := key[1]
+	addr = key[2 : 2+addrLen]
+	day = sdk.BigEndianToUint64(key[2+addrLen:])
+	return
+}
+
 var (
 	buyBytes  = []byte{0}
 	sellBytes = []byte{1}
@@ -26,6 +26,19 @@ func TestMarketByDenomsIndexKey(t *testing.T) {
 	require.Equal(t, "uusd", quoteDenom)
 }
 
+func TestActiveMarketsByDenomIndexKey(t *testing.T) {
+	key := types.GetActiveMarketsByDenomIndexKey("ucre", "uusd", 1000000)
+	require.Equal(t, []byte{
+		0x70, 0x4, 0x75, 0x63, 0x72, 0x65, 0x4, 0x75, 0x75, 0x73, 0x64, 0x0, 0x0, 0x0, 0x0, 0x0, 0xf, 0x42, 0x40,
+	}, key)
+	denom, counterDenom, marketId := types.ParseActiveMarketsByDenomIndexKey(key)
+	require.Equal(t, "ucre", denom)
+	require.Equal(t, "uusd", counterDenom)
+	require.EqualValues(t, 1000000, marketId)
+	require.True(t, bytes.HasPrefix(key, types.GetActiveMarketsByDenomIteratorPrefix("ucre")))
+	require.False(t, bytes.HasPrefix(key, types.GetActiveMarketsByDenomIteratorPrefix("ucr")))
+}
+
 func TestOrderKey(t *testing.T) {
 	require.Equal(t, []byte{0x65, 0x0, 0x0, 0x0, 0x0, 0x0, 0xf, 0x42, 0x40}, types.GetOrderKey(1000000))
 }
@@ -45,6 +58,17 @@ func TestOrderBookOrderIndexKey(t *testing.T) {
 	require.True(t, bytes.HasPrefix(key, prefix))
 }
 
+func TestPriceLevelKey(t *testing.T) {
+	key := types.GetPriceLevelKey(1000000, false, utils.ParseDec("12.345"))
+	marketId, isBuy, price := types.ParsePriceLevelKey(key)
+	require.EqualValues(t, 1000000, marketId)
+	require.False(t, isBuy)
+	require.Equal(t, utils.ParseDec("12.345"), price)
+	prefix := types.GetPriceLevelsBySideIteratorPrefix(1000000, false)
+	require.True(t, bytes.HasPrefix(key, prefix))
+	require.False(t, bytes.HasPrefix(key, types.GetPriceLevelsBySideIteratorPrefix(1000000, true)))
+}
+
 func TestOrdersByOrdererIndexKey(t *testing.T) {
 	ordererAddr := utils.TestAddress(1000000)
 	key := types.GetOrdersByOrdererIndexKey(ordererAddr, 1000000, 10000000)
@@ -72,3 +96,11 @@ func TestNumMMOrdersKey(t *testing.T) {
 	require.Equal(t, ordererAddr, ordererAddr2)
 	require.EqualValues(t, 1000000, marketId)
 }
+
+func TestTriggerOrdersByDeadlineIndexKey(t *testing.T) {
+	deadline := utils.ParseTime("2023-06-01T00:00:00Z")
+	key := types.GetTriggerOrdersByDeadlineIndexKey(deadline, 1000000)
+	require.EqualValues(t, 1000000, types.ParseOrderIdFromTriggerOrdersByDeadlineIndexKey(key))
+	require.True(t, bytes.Compare(key, types.GetExpiredTriggerOrdersIteratorEndBytes(deadline)) < 0)
+	require.True(t, bytes.Compare(key, types.GetExpiredTriggerOrdersIteratorEndBytes(deadline.Add(-1))) >= 0)
+}
@@ -2,6 +2,8 @@ package types
 
 import (
 	"fmt"
+	"math/big"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/address"
@@ -23,10 +25,17 @@ func NewMarket(
 		MakerFeeRate:        makerFeeRate,
 		TakerFeeRate:        takerFeeRate,
 		OrderSourceFeeRatio: orderSourceFeeRatio,
+		Status:              MarketStatusActive,
+		TickSize:            utils.ZeroDec,
+		MinOrderQuantity:    utils.ZeroDec,
+		LotSize:             utils.ZeroDec,
 	}
 }
 
-func (market Market) Validate() error {
+// Validate validates the market. referralFeeRatio is the referral fee ratio
+// param, which must not sum up to more than 1 with the market's order source
+// fee ratio.
+func (market Market) Validate(referralFeeRatio sdk.Dec) error {
 	if market.Id == 0 {
 		return fmt.Errorf("id must not be 0")
 	}
@@ -46,6 +55,24 @@ func (market Market) Validate() error {
 		market.MakerFeeRate, market.TakerFeeRate, market.OrderSourceFeeRatio); err != nil {
 		return err
 	}
+	if err := ValidateOrderSourceFeeRatio(market.OrderSourceFeeRatio, referralFeeRatio); err != nil {
+		return err
+	}
+	if err := ValidateMarketStatus(market.Status); err != nil {
+		return err
+	}
+	if err := ValidateTickSize(market.TickSize); err != nil {
+		return err
+	}
+	if err := ValidateMinOrderQuantity(market.MinOrderQuantity); err != nil {
+		return err
+	}
+	if err := ValidateLotSize(market.LotSize); err != nil {
+		return err
+	}
+	if err := ValidateSelfTradePrevention(market.SelfTradePrevention); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -53,6 +80,105 @@ func (market Market) MustGetEscrowAddress() sdk.AccAddress {
 	return sdk.MustAccAddressFromBech32(market.EscrowAddress)
 }
 
+// IsActive returns whether orders can be placed and matched in the market.
+func (market Market) IsActive() bool {
+	return market.Status == MarketStatusActive
+}
+
+// CanCancelOrders returns whether orders in the market can be cancelled.
+func (market Market) CanCancelOrders() bool {
+	return market.Status == MarketStatusActive || market.Status == MarketStatusCancelOnly
+}
+
+// ValidateOrderPrice returns an error if the price is not a multiple of the
+// market's tick size.
+func (market Market) ValidateOrderPrice(price sdk.Dec) error {
+	if market.TickSize.IsPositive() && !isMultipleOf(price, market.TickSize) {
+		return fmt.Errorf("price must be a multiple of the tick size %s: %s", market.TickSize, price)
+	}
+	return nil
+}
+
+// ValidateOrderQuantity returns an error if the quantity is smaller than the
+// market's min order quantity or is not a multiple of the market's lot size.
+func (market Market) ValidateOrderQuantity(qty sdk.Dec) error {
+	if qty.LT(market.MinOrderQuantity) {
+		return fmt.Errorf("quantity is smaller than the min order quantity %s: %s", market.MinOrderQuantity, qty)
+	}
+	if market.LotSize.IsPositive() && !isMultipleOf(qty, market.LotSize) {
+		return fmt.Errorf("quantity must be a multiple of the lot size %s: %s", market.LotSize, qty)
+	}
+	return nil
+}
+
+func isMultipleOf(x, step sdk.Dec) bool {
+	return new(big.Int).Rem(x.BigInt(), step.BigInt()).Sign() == 0
+}
+
+// ValidateTickSize validates the tick size of a market.
+// Zero tick size means there's no restriction on order prices other than
+// the tick system.
+func ValidateTickSize(tickSize sdk.Dec) error {
+	if tickSize.IsNil() || tickSize.IsNegative() {
+		return fmt.Errorf("tick size must not be negative: %s", tickSize)
+	}
+	return nil
+}
+
+// ValidateMinOrderQuantity validates the min order quantity of a market.
+func ValidateMinOrderQuantity(minOrderQty sdk.Dec) error {
+	if minOrderQty.IsNil() || minOrderQty.IsNegative() {
+		return fmt.Errorf("min order quantity must not be negative: %s", minOrderQty)
+	}
+	if !minOrderQty.TruncateDec().Equal(minOrderQty) {
+		return fmt.Errorf("min order quantity must be an integer: %s", minOrderQty)
+	}
+	return nil
+}
+
+// ValidateLotSize validates the lot size of a market.
+// Zero lot size means any integer quantity is allowed.
+func ValidateLotSize(lotSize sdk.Dec) error {
+	if lotSize.IsNil() || lotSize.IsNegative() {
+		return fmt.Errorf("lot size must not be negative: %s", lotSize)
+	}
+	if !lotSize.TruncateDec().Equal(lotSize) {
+		return fmt.Errorf("lot size must be an integer: %s", lotSize)
+	}
+	return nil
+}
+
+// validateOptionalOrderSizes validates the tick size, min order quantity and
+// lot size which are not nil.
+func validateOptionalOrderSizes(tickSize, minOrderQty, lotSize *sdk.Dec) error {
+	if tickSize != nil {
+		if err := ValidateTickSize(*tickSize); err != nil {
+			return err
+		}
+	}
+	if minOrderQty != nil {
+		if err := ValidateMinOrderQuantity(*minOrderQty); err != nil {
+			return err
+		}
+	}
+	if lotSize != nil {
+		if err := ValidateLotSize(*lotSize); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ValidateMarketStatus validates the market status.
+func ValidateMarketStatus(status MarketStatus) error {
+	switch status {
+	case MarketStatusActive, MarketStatusCancelOnly, MarketStatusHalted, MarketStatusDelisted:
+		return nil
+	default:
+		return fmt.Errorf("invalid market status: %v", status)
+	}
+}
+
 func NewMarketState(lastPrice *sdk.Dec) MarketState {
 	return MarketState{
 		LastPrice:          lastPrice,
@@ -69,9 +195,33 @@ func (marketState MarketState) Validate() error {
 			return fmt.Errorf("invalid last price tick: %s", marketState.LastPrice)
 		}
 	}
+	if marketState.NumPriceObservations > MaxNumPriceObservations {
+		return fmt.Errorf(
+			"number of price observations must not exceed %d: %d",
+			MaxNumPriceObservations, marketState.NumPriceObservations)
+	}
+	if marketState.NumPriceObservations == 0 && marketState.LastPriceObservationIndex != 0 ||
+		marketState.NumPriceObservations > 0 && marketState.LastPriceObservationIndex >= marketState.NumPriceObservations {
+		return fmt.Errorf(
+			"invalid last price observation index: %d", marketState.LastPriceObservationIndex)
+	}
 	if marketState.LastMatchingHeight < -1 {
 		return fmt.Errorf("invalid last matching height: %d", marketState.LastMatchingHeight)
 	}
+	if marketState.CircuitBreakerEndHeight < 0 {
+		return fmt.Errorf("invalid circuit breaker end height: %d", marketState.CircuitBreakerEndHeight)
+	}
+	if marketState.CircuitBreakerReopenHeight < 0 {
+		return fmt.Errorf("invalid circuit breaker reopen height: %d", marketState.CircuitBreakerReopenHeight)
+	}
+	if n := len(marketState.HourlyVolumes); n != 0 && n != MarketVolumeWindowHours {
+		return fmt.Errorf("number of hourly volumes must be 0 or %d: %d", MarketVolumeWindowHours, n)
+	}
+	for _, volume := range marketState.HourlyVolumes {
+		if err := volume.Validate(); err != nil {
+			return fmt.Errorf("invalid hourly volume: %w", err)
+		}
+	}
 	if marketState.LastPrice != nil && marketState.LastMatchingHeight == -1 ||
 		marketState.LastPrice == nil && marketState.LastMatchingHeight >= 0 {
 		return fmt.Errorf(
@@ -81,6 +231,73 @@ func (marketState MarketState) Validate() error {
 	return nil
 }
 
+// MarketVolumeWindowHours is the number of hours over which markets' rolling
+// volumes are summed up.
+const MarketVolumeWindowHours = 24
+
+// AddVolume adds the volume traded at t to the market's rolling volume.
+func (marketState *MarketState) AddVolume(t time.Time, baseVolume, quoteVolume sdk.Dec) {
+	hour := volumeHour(t)
+	if len(marketState.HourlyVolumes) == 0 {
+		marketState.HourlyVolumes = make([]MarketVolume, MarketVolumeWindowHours)
+		for i := range marketState.HourlyVolumes {
+			marketState.HourlyVolumes[i] = NewMarketVolume(utils.ZeroDec, utils.ZeroDec)
+		}
+	} else if hour > marketState.LastVolumeHour {
+		// Reset the hours passed since the last update.
+		from := marketState.LastVolumeHour + 1
+		if hour-from >= MarketVolumeWindowHours {
+			from = hour - MarketVolumeWindowHours + 1
+		}
+		for h := from; h <= hour; h++ {
+			marketState.HourlyVolumes[h%MarketVolumeWindowHours] = NewMarketVolume(utils.ZeroDec, utils.ZeroDec)
+		}
+	}
+	if hour > marketState.LastVolumeHour {
+		marketState.LastVolumeHour = hour
+	}
+	volume := &marketState.HourlyVolumes[marketState.LastVolumeHour%MarketVolumeWindowHours]
+	volume.BaseVolume = volume.BaseVolume.Add(baseVolume)
+	volume.QuoteVolume = volume.QuoteVolume.Add(quoteVolume)
+}
+
+// RollingVolume returns the market's volume traded in the last 24 hours at t.
+func (marketState MarketState) RollingVolume(t time.Time) MarketVolume {
+	total := NewMarketVolume(utils.ZeroDec, utils.ZeroDec)
+	hour := volumeHour(t)
+	for h := hour - MarketVolumeWindowHours + 1; h <= hour; h++ {
+		if h > marketState.LastVolumeHour || h <= marketState.LastVolumeHour-MarketVolumeWindowHours ||
+			len(marketState.HourlyVolumes) == 0 {
+			continue
+		}
+		volume := marketState.HourlyVolumes[h%MarketVolumeWindowHours]
+		total.BaseVolume = total.BaseVolume.Add(volume.BaseVolume)
+		total.QuoteVolume = total.QuoteVolume.Add(volume.QuoteVolume)
+	}
+	return total
+}
+
+func volumeHour(t time.Time) int64 {
+	return t.Unix() / 3600
+}
+
+func NewMarketVolume(baseVolume, quoteVolume sdk.Dec) MarketVolume {
+	return MarketVolume{
+		BaseVolume:  baseVolume,
+		QuoteVolume: quoteVolume,
+	}
+}
+
+func (volume MarketVolume) Validate() error {
+	if volume.BaseVolume.IsNil() || volume.BaseVolume.IsNegative() {
+		return fmt.Errorf("base volume must not be negative: %s", volume.BaseVolume)
+	}
+	if volume.QuoteVolume.IsNil() || volume.QuoteVolume.IsNegative() {
+		return fmt.Errorf("quote volume must not be negative: %s", volume.QuoteVolume)
+	}
+	return nil
+}
+
 func OrderPriceLimit(basePrice, maxOrderPriceRatio sdk.Dec) (minPrice, maxPrice sdk.Dec) {
 	minPrice = basePrice.Mul(utils.OneDec.Sub(maxOrderPriceRatio))
 	maxPrice = basePrice.Mul(utils.OneDec.Add(maxOrderPriceRatio))
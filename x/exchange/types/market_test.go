@@ -3,6 +3,7 @@ package types_test
 import (
 	"fmt"
 	"testing"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
@@ -72,6 +73,13 @@ func TestMarket_Validate(t *testing.T) {
 			},
 			"maker fee rate must be in range [0, 1]: 1.100000000000000000",
 		},
+		{
+			"too high order source fee ratio with referral fee ratio",
+			func(market *types.Market) {
+				market.OrderSourceFeeRatio = utils.ParseDec("0.9")
+			},
+			"order source fee ratio and referral fee ratio must not sum up to more than 1: 0.900000000000000000 + 0.200000000000000000",
+		},
 		{
 			"too low taker fee rate",
 			func(market *types.Market) {
@@ -86,12 +94,40 @@ func TestMarket_Validate(t *testing.T) {
 			},
 			"taker fee rate must be in range [0, 1]: 1.100000000000000000",
 		},
+		{
+			"invalid status",
+			func(market *types.Market) {
+				market.Status = 10
+			},
+			"invalid market status: 10",
+		},
+		{
+			"negative tick size",
+			func(market *types.Market) {
+				market.TickSize = utils.ParseDec("-0.01")
+			},
+			"tick size must not be negative: -0.010000000000000000",
+		},
+		{
+			"fractional min order quantity",
+			func(market *types.Market) {
+				market.MinOrderQuantity = utils.ParseDec("0.5")
+			},
+			"min order quantity must be an integer: 0.500000000000000000",
+		},
+		{
+			"negative lot size",
+			func(market *types.Market) {
+				market.LotSize = utils.ParseDec("-1")
+			},
+			"lot size must not be negative: -1.000000000000000000",
+		},
 	} {
 		t.Run(tc.name, func(t *testing.T) {
 			market := types.NewMarket(
 				1, "ucre", "uusd", utils.ParseDec("0.0015"), utils.ParseDec("0.003"), utils.ParseDec("0.5"))
 			tc.malleate(&market)
-			err := market.Validate()
+			err := market.Validate(utils.ParseDec("0.2"))
 			if tc.expectedErr == "" {
 				require.NoError(t, err)
 			} else {
@@ -126,6 +162,13 @@ func TestMarketState_Validate(t *testing.T) {
 			},
 			"invalid last price tick: 12.345670000000000000",
 		},
+		{
+			"wrong number of hourly volumes",
+			func(marketState *types.MarketState) {
+				marketState.HourlyVolumes = []types.MarketVolume{types.NewMarketVolume(utils.ZeroDec, utils.ZeroDec)}
+			},
+			"number of hourly volumes must be 0 or 24: 1",
+		},
 	} {
 		t.Run(tc.name, func(t *testing.T) {
 			marketState := types.NewMarketState(nil)
@@ -140,6 +183,32 @@ func TestMarketState_Validate(t *testing.T) {
 	}
 }
 
+func TestMarketState_RollingVolume(t *testing.T) {
+	marketState := types.NewMarketState(nil)
+	now := utils.ParseTime("2023-06-01T00:30:00Z")
+	require.True(t, marketState.RollingVolume(now).BaseVolume.IsZero())
+
+	marketState.AddVolume(now, sdk.NewDec(100), sdk.NewDec(500))
+	marketState.AddVolume(now.Add(10*time.Minute), sdk.NewDec(100), sdk.NewDec(510))
+	marketState.AddVolume(now.Add(5*time.Hour), sdk.NewDec(200), sdk.NewDec(1040))
+	require.Len(t, marketState.HourlyVolumes, types.MarketVolumeWindowHours)
+
+	volume := marketState.RollingVolume(now.Add(5 * time.Hour))
+	require.Equal(t, "400.000000000000000000", volume.BaseVolume.String())
+	require.Equal(t, "2050.000000000000000000", volume.QuoteVolume.String())
+	// The volumes in the first hour are excluded after 24 hours.
+	volume = marketState.RollingVolume(now.Add(24 * time.Hour))
+	require.Equal(t, "200.000000000000000000", volume.BaseVolume.String())
+	volume = marketState.RollingVolume(now.Add(30 * time.Hour))
+	require.True(t, volume.BaseVolume.IsZero())
+
+	// Adding volume after a long time resets all the previous volumes.
+	marketState.AddVolume(now.Add(100*time.Hour), sdk.NewDec(10), sdk.NewDec(50))
+	volume = marketState.RollingVolume(now.Add(100 * time.Hour))
+	require.Equal(t, "10.000000000000000000", volume.BaseVolume.String())
+	require.Equal(t, "50.000000000000000000", volume.QuoteVolume.String())
+}
+
 func TestOrderPriceLimit(t *testing.T) {
 	for i, tc := range []struct {
 		lastPrice, maxOrderPriceRatio sdk.Dec
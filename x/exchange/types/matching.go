@@ -8,9 +8,18 @@ import (
 	utils "github.com/crescent-network/crescent/v5/types"
 )
 
+// FeeTierFunc returns the fee tier of an account.
+type FeeTierFunc func(addr sdk.AccAddress) (tier FeeTier, found bool)
+
 type MatchingContext struct {
+	marketId                                        uint64
 	baseDenom, quoteDenom                           string
 	makerFeeRate, takerFeeRate, orderSourceFeeRatio sdk.Dec
+	referralFeeRatio                                sdk.Dec
+	halveFees                                       bool
+	selfTradePrevention                             SelfTradePrevention
+	feeTierFunc                                     FeeTierFunc
+	preventedSelfTrades                             []EventSelfTradePrevented
 }
 
 func NewMatchingContext(market Market, halveFees bool) *MatchingContext {
@@ -21,40 +30,286 @@ func NewMatchingContext(market Market, halveFees bool) *MatchingContext {
 		takerFeeRate = takerFeeRate.QuoInt64(2)
 	}
 	return &MatchingContext{
+		marketId:            market.Id,
 		baseDenom:           market.BaseDenom,
 		quoteDenom:          market.QuoteDenom,
 		makerFeeRate:        makerFeeRate,
 		takerFeeRate:        takerFeeRate,
 		orderSourceFeeRatio: market.OrderSourceFeeRatio,
+		referralFeeRatio:    utils.ZeroDec,
+		halveFees:           halveFees,
+		selfTradePrevention: market.SelfTradePrevention,
+	}
+}
+
+// PreventedSelfTrades returns the matches prevented by self-trade prevention
+// under the matching context.
+func (ctx *MatchingContext) PreventedSelfTrades() []EventSelfTradePrevented {
+	return ctx.preventedSelfTrades
+}
+
+func (ctx *MatchingContext) recordPreventedSelfTrade(
+	ordererAddr sdk.AccAddress, mode SelfTradePrevention,
+	newestOrderId uint64, newestSourceName string, oldest *MemOrder, qty sdk.Dec) {
+	oldestOrderId, oldestSourceName := oldest.identifiers()
+	ctx.preventedSelfTrades = append(ctx.preventedSelfTrades, EventSelfTradePrevented{
+		MarketId:         ctx.marketId,
+		Orderer:          ordererAddr.String(),
+		Mode:             mode,
+		NewestOrderId:    newestOrderId,
+		NewestSourceName: newestSourceName,
+		OldestOrderId:    oldestOrderId,
+		OldestSourceName: oldestSourceName,
+		Quantity:         qty,
+	})
+}
+
+// isSelfTradePreventionEnabled returns whether the mode prevents self-trades.
+func isSelfTradePreventionEnabled(mode SelfTradePrevention) bool {
+	switch mode {
+	case SelfTradePreventionCancelNewest, SelfTradePreventionCancelOldest,
+		SelfTradePreventionCancelBoth, SelfTradePreventionDecrementAndCancel:
+		return true
+	default:
+		return false
+	}
+}
+
+// hasSelfTradePrevention returns whether any order in the order book side
+// prevents self-trades.
+func (ctx *MatchingContext) hasSelfTradePrevention(obs *MemOrderBookSide) bool {
+	for _, level := range obs.levels {
+		for _, order := range level.orders {
+			if isSelfTradePreventionEnabled(order.selfTradePrevention(ctx.selfTradePrevention)) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// PreventSelfTrades applies self-trade prevention on crossing orders from the
+// same orderer before batch matching.
+// The mode of the newest order of each pair of orders is applied, falling
+// back to the market's mode.
+func (ctx *MatchingContext) PreventSelfTrades(buyObs, sellObs *MemOrderBookSide) {
+	if !ctx.hasSelfTradePrevention(buyObs) && !ctx.hasSelfTradePrevention(sellObs) {
+		return
+	}
+	// Group sell orders by orderer, keeping the order of price levels, so that
+	// each buy order is only compared with the same orderer's sell orders.
+	sellOrdersByOrderer := map[string][]*MemOrder{}
+	for _, sellLevel := range sellObs.levels {
+		for _, sellOrder := range sellLevel.orders {
+			key := sellOrder.ordererAddr.String()
+			sellOrdersByOrderer[key] = append(sellOrdersByOrderer[key], sellOrder)
+		}
+	}
+	for _, buyLevel := range buyObs.levels {
+		for _, buyOrder := range buyLevel.orders {
+			for _, sellOrder := range sellOrdersByOrderer[buyOrder.ordererAddr.String()] {
+				if sellOrder.price.GT(buyLevel.price) || buyOrder.cancelled {
+					break
+				}
+				if sellOrder.cancelled {
+					continue
+				}
+				newest, oldest := sellOrder, buyOrder
+				if buyOrder.isNewerThan(sellOrder) {
+					newest, oldest = buyOrder, sellOrder
+				}
+				mode := newest.selfTradePrevention(ctx.selfTradePrevention)
+				qty := sdk.MinDec(buyOrder.ExecutableQuantity(), sellOrder.ExecutableQuantity())
+				switch mode {
+				case SelfTradePreventionCancelNewest:
+					newest.cancelled = true
+				case SelfTradePreventionCancelOldest:
+					oldest.cancelled = true
+				case SelfTradePreventionCancelBoth:
+					newest.cancelled = true
+					oldest.cancelled = true
+				case SelfTradePreventionDecrementAndCancel:
+					decrementOrder(newest, qty)
+					decrementOrder(oldest, qty)
+				default:
+					continue
+				}
+				newestOrderId, newestSourceName := newest.identifiers()
+				ctx.recordPreventedSelfTrade(
+					buyOrder.ordererAddr, mode, newestOrderId, newestSourceName, oldest, qty)
+			}
+		}
+	}
+	buyObs.removeCancelledOrders()
+	sellObs.removeCancelledOrders()
+}
+
+// decrementOrder decrements the order's quantity by qty and cancels the order
+// if there's nothing left to execute.
+func decrementOrder(order *MemOrder, qty sdk.Dec) {
+	order.decrementedQty = order.decrementedQty.Add(qty)
+	if !order.ExecutableQuantity().IsPositive() {
+		order.cancelled = true
 	}
 }
 
-func (ctx *MatchingContext) feeRate(isMaker bool) sdk.Dec {
+// preventTakerSelfTrades applies self-trade prevention between a taker order
+// and the taker's own orders in the level.
+// The taker is always the newest order.
+// It returns the quantity by which the taker has been decremented and whether
+// the rest of the taker should be cancelled.
+func (ctx *MatchingContext) preventTakerSelfTrades(
+	obs *MemOrderBookSide, level *MemOrderBookPriceLevel, ordererAddr sdk.AccAddress, orderId uint64,
+	mode SelfTradePrevention, takerQty sdk.Dec) (decrementedQty sdk.Dec, cancelled bool) {
+	decrementedQty = utils.ZeroDec
+	if mode == SelfTradePreventionUnspecified {
+		mode = ctx.selfTradePrevention
+	}
+	if !isSelfTradePreventionEnabled(mode) {
+		return decrementedQty, false
+	}
+	for _, order := range level.orders {
+		if !order.ordererAddr.Equals(ordererAddr) {
+			continue
+		}
+		remainingQty := takerQty.Sub(decrementedQty)
+		qty := sdk.MinDec(remainingQty, order.ExecutableQuantity())
+		ctx.recordPreventedSelfTrade(ordererAddr, mode, orderId, "", order, qty)
+		switch mode {
+		case SelfTradePreventionCancelNewest:
+			cancelled = true
+		case SelfTradePreventionCancelOldest:
+			order.cancelled = true
+		case SelfTradePreventionCancelBoth:
+			order.cancelled = true
+			cancelled = true
+		case SelfTradePreventionDecrementAndCancel:
+			decrementOrder(order, qty)
+			decrementedQty = decrementedQty.Add(qty)
+			cancelled = takerQty.Sub(decrementedQty).LT(utils.OneDec)
+		}
+		if cancelled {
+			break
+		}
+	}
+	obs.removedOrders = append(obs.removedOrders, level.removeCancelledOrders()...)
+	return decrementedQty, cancelled
+}
+
+// SetFeeTierFunc sets the function used to look up accounts' fee tiers.
+// Accounts in a fee tier pay the lower of the market's fee rate and the
+// tier's fee rate.
+func (ctx *MatchingContext) SetFeeTierFunc(f FeeTierFunc) {
+	ctx.feeTierFunc = f
+}
+
+// SetReferralFeeRatio sets the share of the taker fee paid to the referrer of
+// a taker order.
+func (ctx *MatchingContext) SetReferralFeeRatio(ratio sdk.Dec) {
+	ctx.referralFeeRatio = ratio
+}
+
+// referralFee returns the share of the fee paid to the referrer.
+// Only takers with a referrer pay the referral fee.
+func (ctx *MatchingContext) referralFee(referrerAddr sdk.AccAddress, isMaker bool, fee sdk.Dec) sdk.Dec {
+	if isMaker || referrerAddr.Empty() || !fee.IsPositive() {
+		return utils.ZeroDec
+	}
+	return fee.MulTruncate(ctx.referralFeeRatio)
+}
+
+// TakerFeeRate returns the taker fee rate applied to the account.
+func (ctx *MatchingContext) TakerFeeRate(ordererAddr sdk.AccAddress) sdk.Dec {
+	return ctx.feeRate(ordererAddr, false)
+}
+
+func (ctx *MatchingContext) feeRate(ordererAddr sdk.AccAddress, isMaker bool) sdk.Dec {
+	feeRate := ctx.takerFeeRate
 	if isMaker {
-		return ctx.makerFeeRate
+		feeRate = ctx.makerFeeRate
+	}
+	if ctx.feeTierFunc != nil && !ordererAddr.Empty() {
+		if tier, found := ctx.feeTierFunc(ordererAddr); found {
+			tierFeeRate := tier.TakerFeeRate
+			if isMaker {
+				tierFeeRate = tier.MakerFeeRate
+			}
+			if ctx.halveFees {
+				tierFeeRate = tierFeeRate.QuoInt64(2)
+			}
+			feeRate = sdk.MinDec(feeRate, tierFeeRate)
+		}
+	}
+	return feeRate
+}
+
+// chargedFeeRate returns the fee rate charged to the order when it is filled.
+func (ctx *MatchingContext) chargedFeeRate(order *MemOrder, isMaker bool) sdk.Dec {
+	if order.typ != UserMemOrder {
+		// Order sources pay no fees as a taker.
+		return utils.ZeroDec
 	}
-	return ctx.takerFeeRate
+	return ctx.feeRate(order.ordererAddr, isMaker || order.isPostOnly())
 }
 
-func (ctx *MatchingContext) FillOrder(order *MemOrder, qty, price sdk.Dec, isMaker bool) {
+// counterpartyFeeRate returns the lowest fee rate charged to the orders which
+// orders in the opposite level are matched against, floored at zero.
+// Maker rebates are capped by this rate so that they are always covered by the
+// fees actually paid by the counterparty orders.
+func (ctx *MatchingContext) counterpartyFeeRate(level *MemOrderBookPriceLevel, isMaker bool) sdk.Dec {
+	feeRate := utils.ZeroDec
+	found := false
+	for _, order := range level.orders {
+		if order.ExecutableQuantity().IsZero() {
+			continue
+		}
+		orderFeeRate := ctx.chargedFeeRate(order, isMaker)
+		if !found || orderFeeRate.LT(feeRate) {
+			feeRate = orderFeeRate
+			found = true
+		}
+	}
+	if feeRate.IsNegative() {
+		return utils.ZeroDec
+	}
+	return feeRate
+}
+
+// FillOrder fills the order with the quantity at the price.
+// takerFeeRate is the fee rate charged to the counterparty taker, which caps
+// the rebate given to the order when it is a maker.
+func (ctx *MatchingContext) FillOrder(order *MemOrder, qty, price sdk.Dec, isMaker bool, takerFeeRate sdk.Dec) {
 	executableQty := order.ExecutableQuantity()
 	if qty.GT(executableQty) { // sanity check
 		panic("open quantity is less than quantity")
 	}
+	if order.isPostOnly() {
+		// Post-only orders always pay maker fees, even when they're matched
+		// as a taker during the batch matching.
+		isMaker = true
+	}
 	if order.isMaker != nil && isMaker != *order.isMaker { // sanity check
 		panic("an order's isMaker must be consistent under one matching context")
 	}
-	_, pays, receives, fee := ctx.fillOrder(order.typ, order.isBuy, qty, price, isMaker)
+	executedQuote, pays, receives, fee := ctx.fillOrder(
+		order.typ, order.ordererAddr, order.isBuy, qty, price, isMaker, takerFeeRate)
+	order.executedQuote = order.executedQuote.Add(executedQuote)
 	order.paid = order.paid.Add(pays)
 	order.remainingDeposit = order.remainingDeposit.Sub(pays)
 	order.received = order.received.Add(receives)
 	order.fee = order.fee.Add(fee)
+	order.referralFee = order.referralFee.Add(ctx.referralFee(order.referrerAddr, isMaker, fee))
 	order.executedQty = order.executedQty.Add(qty)
 	order.isMatched = true
 	order.isMaker = &isMaker
 }
 
-func (ctx *MatchingContext) fillOrder(orderType MemOrderType, isBuy bool, qty, price sdk.Dec, isMaker bool) (executedQuote, pays, receives, fee sdk.Dec) {
+// fillOrder returns the result of filling an order.
+// A maker's rebate is derived from takerFeeRate, the fee rate actually charged
+// to the counterparty taker, rather than the market's taker fee rate.
+func (ctx *MatchingContext) fillOrder(
+	orderType MemOrderType, ordererAddr sdk.AccAddress, isBuy bool, qty, price sdk.Dec,
+	isMaker bool, takerFeeRate sdk.Dec) (executedQuote, pays, receives, fee sdk.Dec) {
 	executedQuote = QuoteAmount(isBuy, price, qty)
 	if isBuy {
 		pays = executedQuote
@@ -65,10 +320,16 @@ func (ctx *MatchingContext) fillOrder(orderType MemOrderType, isBuy bool, qty, p
 	}
 	var feeRate sdk.Dec
 	if orderType == UserMemOrder {
-		feeRate = ctx.feeRate(isMaker)
+		feeRate = ctx.feeRate(ordererAddr, isMaker)
+		if isMaker && feeRate.IsNegative() {
+			// The maker rebate must be covered by the taker fee after the
+			// referral fee is paid.
+			maxRebate := takerFeeRate.Sub(takerFeeRate.Mul(ctx.referralFeeRatio))
+			feeRate = sdk.MaxDec(feeRate, maxRebate.Neg())
+		}
 	} else {
 		if isMaker {
-			feeRate = ctx.takerFeeRate.Mul(ctx.orderSourceFeeRatio).Neg()
+			feeRate = takerFeeRate.Mul(ctx.orderSourceFeeRatio).Neg()
 		} else {
 			feeRate = utils.ZeroDec
 		}
@@ -84,13 +345,13 @@ func (ctx *MatchingContext) fillOrder(orderType MemOrderType, isBuy bool, qty, p
 	return
 }
 
-func (ctx *MatchingContext) FillOrders(orders []*MemOrder, qty, price sdk.Dec, isMaker bool) {
+func (ctx *MatchingContext) FillOrders(orders []*MemOrder, qty, price sdk.Dec, isMaker bool, takerFeeRate sdk.Dec) {
 	totalExecutableQty := TotalExecutableQuantity(orders)
 	if totalExecutableQty.LT(qty) { // sanity check
 		panic("executable quantity is less than quantity")
 	}
 	if len(orders) == 1 { // there's only one order
-		ctx.FillOrder(orders[0], qty, price, isMaker)
+		ctx.FillOrder(orders[0], qty, price, isMaker, takerFeeRate)
 		return
 	}
 	// First, distribute quantity evenly.
@@ -102,7 +363,7 @@ func (ctx *MatchingContext) FillOrders(orders []*MemOrder, qty, price sdk.Dec, i
 		}
 		executedQty := executableQty.MulTruncate(qty).QuoTruncate(totalExecutableQty)
 		if executedQty.IsPositive() {
-			ctx.FillOrder(order, executedQty, price, isMaker)
+			ctx.FillOrder(order, executedQty, price, isMaker, takerFeeRate)
 			remainingQty = remainingQty.Sub(executedQty)
 		}
 	}
@@ -117,21 +378,22 @@ func (ctx *MatchingContext) FillOrders(orders []*MemOrder, qty, price sdk.Dec, i
 			}
 			executedQty := sdk.MinDec(remainingQty, order.ExecutableQuantity())
 			if executedQty.IsPositive() {
-				ctx.FillOrder(order, executedQty, price, isMaker)
+				ctx.FillOrder(order, executedQty, price, isMaker, takerFeeRate)
 				remainingQty = remainingQty.Sub(executedQty)
 			}
 		}
 	}
 }
 
-func (ctx *MatchingContext) FillOrderBookPriceLevel(level *MemOrderBookPriceLevel, qty, price sdk.Dec, isMaker bool) {
+func (ctx *MatchingContext) FillOrderBookPriceLevel(
+	level *MemOrderBookPriceLevel, qty, price sdk.Dec, isMaker bool, takerFeeRate sdk.Dec) {
 	executableQty := TotalExecutableQuantity(level.orders)
 	if executableQty.LT(qty) { // sanity check
 		panic("executable quantity is less than quantity")
 	} else if executableQty.Equal(qty) { // full matches
-		ctx.FillOrders(level.orders, qty, price, isMaker)
+		ctx.FillOrders(level.orders, qty, price, isMaker, takerFeeRate)
 	} else {
-		groups := GroupMemOrdersByMsgHeight(level.orders)
+		groups := GroupMemOrdersByPriorityHeight(level.orders)
 		totalExecQty := utils.ZeroDec
 		for _, group := range groups {
 			remainingQty := qty.Sub(totalExecQty)
@@ -141,7 +403,7 @@ func (ctx *MatchingContext) FillOrderBookPriceLevel(level *MemOrderBookPriceLeve
 			// TODO: optimize duplicate TotalExecutableQuantity calls?
 			executableQty = TotalExecutableQuantity(group.orders)
 			executedQty := sdk.MinDec(remainingQty, executableQty)
-			ctx.FillOrders(group.orders, executedQty, price, isMaker)
+			ctx.FillOrders(group.orders, executedQty, price, isMaker, takerFeeRate)
 			totalExecQty = totalExecQty.Add(executedQty)
 		}
 	}
@@ -155,37 +417,44 @@ func (ctx *MatchingContext) MatchOrderBookPriceLevels(
 	executedQty = sdk.MinDec(executableQtyA, executableQtyB)
 	fullA = executedQty.Equal(executableQtyA)
 	fullB = executedQty.Equal(executableQtyB)
-	ctx.FillOrderBookPriceLevel(levelA, executedQty, price, isLevelAMaker)
-	ctx.FillOrderBookPriceLevel(levelB, executedQty, price, isLevelBMaker)
+	feeRateA := ctx.counterpartyFeeRate(levelA, isLevelAMaker)
+	feeRateB := ctx.counterpartyFeeRate(levelB, isLevelBMaker)
+	ctx.FillOrderBookPriceLevel(levelA, executedQty, price, isLevelAMaker, feeRateB)
+	ctx.FillOrderBookPriceLevel(levelB, executedQty, price, isLevelBMaker, feeRateA)
 	return
 }
 
 // ExecuteOrder simulates an order execution and returns the result.
 // obs should be a valid MemOrderBookSide which the order will be executed against.
 // The order will always be a taker.
+// orderId and selfTradePrevention are used for self-trade prevention against
+// the orderer's own orders in obs.
+// referrerAddr is the referrer of the order, which can be nil.
 func (ctx *MatchingContext) ExecuteOrder(
-	obs *MemOrderBookSide, qtyLimit, quoteLimit *sdk.Dec) (res ExecuteOrderResult) {
+	obs *MemOrderBookSide, ordererAddr sdk.AccAddress, orderId uint64,
+	selfTradePrevention SelfTradePrevention, referrerAddr sdk.AccAddress,
+	qtyLimit, quoteLimit *sdk.Dec) (res ExecuteOrderResult) {
 	if qtyLimit == nil && quoteLimit == nil { // sanity check
 		panic("quantity limit and quote limit cannot be set to nil at the same time")
 	}
 	isBuy := !obs.isBuy // the order's direction
 	res = NewExecuteOrderResult(PayReceiveDenoms(ctx.baseDenom, ctx.quoteDenom, isBuy))
+	decrementedQuote := utils.ZeroDec
 	for _, level := range obs.levels {
 		// Check limits
-		if qtyLimit != nil && !qtyLimit.Sub(res.ExecutedQuantity).IsPositive() {
+		if qtyLimit != nil && !qtyLimit.Sub(res.ExecutedQuantity).Sub(res.DecrementedQuantity).IsPositive() {
 			break
 		}
-		if quoteLimit != nil && !quoteLimit.Sub(res.ExecutedQuote).IsPositive() {
+		if quoteLimit != nil && !quoteLimit.Sub(res.ExecutedQuote).Sub(decrementedQuote).IsPositive() {
 			break
 		}
 
-		executableQty := TotalExecutableQuantity(level.orders)
 		var remainingQty sdk.Dec
 		if qtyLimit != nil {
-			remainingQty = qtyLimit.Sub(res.ExecutedQuantity)
+			remainingQty = qtyLimit.Sub(res.ExecutedQuantity).Sub(res.DecrementedQuantity)
 		}
 		if quoteLimit != nil {
-			remainingQuote := quoteLimit.Sub(res.ExecutedQuote)
+			remainingQuote := quoteLimit.Sub(res.ExecutedQuote).Sub(decrementedQuote)
 			if remainingQuote.LT(utils.OneDec) {
 				res.FullyExecuted = true
 				break
@@ -202,19 +471,38 @@ func (ctx *MatchingContext) ExecuteOrder(
 			break
 		}
 
+		decrementedQty, cancelled := ctx.preventTakerSelfTrades(
+			obs, level, ordererAddr, orderId, selfTradePrevention, remainingQty)
+		if decrementedQty.IsPositive() {
+			res.DecrementedQuantity = res.DecrementedQuantity.Add(decrementedQty)
+			decrementedQuote = decrementedQuote.Add(QuoteAmount(isBuy, level.price, decrementedQty))
+			remainingQty = remainingQty.Sub(decrementedQty)
+		}
+		if cancelled {
+			res.SelfTradePrevented = true
+			break
+		}
+		if len(level.orders) == 0 {
+			continue
+		}
+
+		executableQty := TotalExecutableQuantity(level.orders)
 		executedQty := sdk.MinDec(executableQty, remainingQty)
 		if executedQty.Equal(remainingQty) {
 			res.FullyExecuted = true // used in swap
 		}
 
 		matchPrice := level.price
-		ctx.FillOrderBookPriceLevel(level, executedQty, matchPrice, true)
-		executedQuote, pays, receives, fee := ctx.fillOrder(UserMemOrder, isBuy, executedQty, matchPrice, false)
+		takerFeeRate := ctx.feeRate(ordererAddr, false)
+		ctx.FillOrderBookPriceLevel(level, executedQty, matchPrice, true, takerFeeRate)
+		executedQuote, pays, receives, fee := ctx.fillOrder(
+			UserMemOrder, ordererAddr, isBuy, executedQty, matchPrice, false, utils.ZeroDec)
 		res.ExecutedQuantity = res.ExecutedQuantity.Add(executedQty)
 		res.ExecutedQuote = res.ExecutedQuote.Add(executedQuote)
 		res.Paid.Amount = res.Paid.Amount.Add(pays)
 		res.Received.Amount = res.Received.Amount.Add(receives)
 		res.Fee.Amount = res.Fee.Amount.Add(fee)
+		res.ReferralFee.Amount = res.ReferralFee.Amount.Add(ctx.referralFee(referrerAddr, false, fee))
 		res.LastPrice = matchPrice
 	}
 	return
@@ -27,7 +27,7 @@ func TestFillMemOrderBasic(t *testing.T) {
 	ctx := types.NewMatchingContext(market, false)
 
 	order := newUserMemOrder(1, true, utils.ParseDec("1.3"), sdk.NewDec(10_000000), sdk.NewDec(9_000000))
-	ctx.FillOrder(order, sdk.NewDec(5_000000), utils.ParseDec("1.25"), true)
+	ctx.FillOrder(order, sdk.NewDec(5_000000), utils.ParseDec("1.25"), true, utils.ParseDec("0.003"))
 
 	require.True(t, order.IsMatched())
 	testutil.AssertEqual(t, sdk.NewDec(6_240625), order.Paid())
@@ -35,9 +35,204 @@ func TestFillMemOrderBasic(t *testing.T) {
 	testutil.AssertEqual(t, sdk.NewDec(-9375), order.Fee())
 
 	order = newUserMemOrder(2, false, utils.ParseDec("1.2"), sdk.NewDec(10_000000), sdk.NewDec(9_000000))
-	ctx.FillOrder(order, sdk.NewDec(5_000000), utils.ParseDec("1.25"), false)
+	ctx.FillOrder(order, sdk.NewDec(5_000000), utils.ParseDec("1.25"), false, utils.ZeroDec)
 
 	testutil.AssertEqual(t, sdk.NewDec(5_000000), order.Paid())
 	testutil.AssertEqual(t, sdk.NewDec(6_231250), order.Received())
 	testutil.AssertEqual(t, sdk.NewDec(18750), order.Fee())
 }
+
+func TestFillMemOrder_MakerRebateCap(t *testing.T) {
+	market := types.NewMarket(
+		1, "ucre", "uusd", utils.ParseDec("0.001"), utils.ParseDec("0.003"), utils.ParseDec("0.5"))
+	ctx := types.NewMatchingContext(market, false)
+	ctx.SetReferralFeeRatio(utils.ParseDec("0.2"))
+	ctx.SetFeeTierFunc(func(addr sdk.AccAddress) (types.FeeTier, bool) {
+		return types.FeeTier{MakerFeeRate: utils.ParseDec("-0.002"), TakerFeeRate: utils.ParseDec("0.001")}, true
+	})
+
+	// The order source's rebate is derived from the taker fee rate actually
+	// charged, not the market's taker fee rate.
+	source := types.NewMockOrderSource("source")
+	order := newOrderSourceMemOrder(false, utils.ParseDec("1"), sdk.NewDec(10_000000), source)
+	ctx.FillOrder(order, sdk.NewDec(10_000000), utils.ParseDec("1"), true, utils.ParseDec("0.001"))
+	testutil.AssertEqual(t, sdk.NewDec(-5000), order.Fee())
+
+	// The tier maker rebate is capped by the taker fee after the referral fee.
+	order = newUserMemOrder(1, true, utils.ParseDec("1"), sdk.NewDec(10_000000), sdk.NewDec(10_000000))
+	ctx.FillOrder(order, sdk.NewDec(10_000000), utils.ParseDec("1"), true, utils.ParseDec("0.001"))
+	testutil.AssertEqual(t, sdk.NewDec(-8000), order.Fee())
+
+	// The rebate is not capped when the taker fee covers it.
+	order = newUserMemOrder(2, true, utils.ParseDec("1"), sdk.NewDec(10_000000), sdk.NewDec(10_000000))
+	ctx.FillOrder(order, sdk.NewDec(10_000000), utils.ParseDec("1"), true, utils.ParseDec("0.003"))
+	testutil.AssertEqual(t, sdk.NewDec(-20000), order.Fee())
+}
+
+func TestMatchingContext_MatchOrderBookPriceLevels_MakerRebateCap(t *testing.T) {
+	market := types.NewMarket(
+		1, "ucre", "uusd", utils.ParseDec("0.001"), utils.ParseDec("0.003"), utils.ParseDec("0.5"))
+	ctx := types.NewMatchingContext(market, false)
+	ctx.SetReferralFeeRatio(utils.ZeroDec)
+	ctx.SetFeeTierFunc(func(addr sdk.AccAddress) (types.FeeTier, bool) {
+		return types.FeeTier{MakerFeeRate: utils.ParseDec("-0.002"), TakerFeeRate: utils.ParseDec("0.001")}, true
+	})
+
+	sellOrder := newUserMemOrder(1, false, utils.ParseDec("1"), sdk.NewDec(10_000000), sdk.NewDec(10_000000))
+	sellLevel := types.NewMemOrderBookPriceLevel(sellOrder)
+	// The first order in the buy level is already fully executed.
+	buyOrder1 := newUserMemOrder(2, true, utils.ParseDec("1"), sdk.NewDec(10_000000), sdk.NewDec(10_000000))
+	ctx.FillOrder(buyOrder1, sdk.NewDec(10_000000), utils.ParseDec("1"), false, utils.ZeroDec)
+	require.True(t, buyOrder1.ExecutableQuantity().IsZero())
+	buyLevel := types.NewMemOrderBookPriceLevel(buyOrder1)
+	buyOrder2 := types.NewUserMemOrder(
+		types.NewOrder(3, types.OrderTypeLimit, utils.TestAddress(1), 1, true,
+			utils.ParseDec("1"), sdk.NewDec(20_000000), 2, sdk.NewDec(20_000000), sdk.NewDec(20_000000),
+			utils.ParseTime("2023-06-01T00:00:00Z"), types.TimeInForceGoodTilTime, types.SelfTradePreventionUnspecified))
+	buyLevel.AddOrder(buyOrder2)
+
+	executedQty, fullA, fullB := ctx.MatchOrderBookPriceLevels(
+		sellLevel, true, buyLevel, false, utils.ParseDec("1"))
+	testutil.AssertEqual(t, sdk.NewDec(10_000000), executedQty)
+	require.True(t, fullA)
+	require.False(t, fullB)
+	testutil.AssertEqual(t, sdk.NewDec(10000), buyOrder2.Fee())
+	// The maker rebate is capped by the fee charged to the second buy order,
+	// not zeroed out.
+	testutil.AssertEqual(t, sdk.NewDec(-10000), sellOrder.Fee())
+}
+
+func TestMatchingContext_ExecuteOrder_TieredTakerRebate(t *testing.T) {
+	market := types.NewMarket(
+		1, "ucre", "uusd", utils.ParseDec("0.001"), utils.ParseDec("0.003"), utils.ParseDec("0.5"))
+	ctx := types.NewMatchingContext(market, false)
+	ctx.SetFeeTierFunc(func(addr sdk.AccAddress) (types.FeeTier, bool) {
+		return types.FeeTier{MakerFeeRate: utils.ParseDec("0.001"), TakerFeeRate: utils.ParseDec("0.001")},
+			addr.Equals(utils.TestAddress(2))
+	})
+
+	source := types.NewMockOrderSource("source")
+	obs := types.NewMemOrderBookSide(false)
+	sourceOrder := newOrderSourceMemOrder(false, utils.ParseDec("1"), sdk.NewDec(10_000000), source)
+	obs.AddOrder(sourceOrder)
+
+	qtyLimit := sdk.NewDec(10_000000)
+	res := ctx.ExecuteOrder(obs, utils.TestAddress(2), 1, types.SelfTradePreventionNone, nil, &qtyLimit, nil)
+	testutil.AssertEqual(t, sdk.NewDec(10_000), res.Fee.Amount)
+	// The rebate never exceeds the taker fee collected.
+	testutil.AssertEqual(t, sdk.NewDec(-5000), sourceOrder.Fee())
+}
+
+func TestMatchingContext_PreventSelfTrades(t *testing.T) {
+	market := types.NewMarket(
+		1, "ucre", "uusd", utils.ZeroDec, utils.ZeroDec, utils.ZeroDec)
+	market.SelfTradePrevention = types.SelfTradePreventionCancelOldest
+	ctx := types.NewMatchingContext(market, false)
+
+	source := types.NewMockOrderSource("source")
+	buyObs := types.NewMemOrderBookSide(true)
+	buyOrder := newUserMemOrder(1, true, utils.ParseDec("1"), sdk.NewDec(10_000000), sdk.NewDec(10_000000))
+	buyObs.AddOrder(buyOrder)
+	sellObs := types.NewMemOrderBookSide(false)
+	// The order source order is from the same orderer.
+	sourceOrder := newOrderSourceMemOrder(false, utils.ParseDec("0.99"), sdk.NewDec(5_000000), source)
+	sellObs.AddOrder(sourceOrder)
+
+	ctx.PreventSelfTrades(buyObs, sellObs)
+	require.False(t, buyOrder.IsSelfTradeCancelled())
+	require.True(t, sourceOrder.IsSelfTradeCancelled())
+	require.Empty(t, sellObs.Levels())
+	// Cancelled orders are still returned to be settled.
+	require.Len(t, sellObs.Orders(), 1)
+
+	events := ctx.PreventedSelfTrades()
+	require.Len(t, events, 1)
+	require.Equal(t, types.SelfTradePreventionCancelOldest, events[0].Mode)
+	require.EqualValues(t, 1, events[0].NewestOrderId)
+	require.Equal(t, "source", events[0].OldestSourceName)
+	testutil.AssertEqual(t, sdk.NewDec(5_000000), events[0].Quantity)
+}
+
+func TestMatchingContext_PreventSelfTrades_Disabled(t *testing.T) {
+	market := types.NewMarket(
+		1, "ucre", "uusd", utils.ZeroDec, utils.ZeroDec, utils.ZeroDec)
+	ctx := types.NewMatchingContext(market, false)
+
+	buyObs := types.NewMemOrderBookSide(true)
+	buyOrder := newUserMemOrder(1, true, utils.ParseDec("1"), sdk.NewDec(10_000000), sdk.NewDec(10_000000))
+	buyObs.AddOrder(buyOrder)
+	sellObs := types.NewMemOrderBookSide(false)
+	sellOrder := newUserMemOrder(2, false, utils.ParseDec("0.99"), sdk.NewDec(5_000000), sdk.NewDec(5_000000))
+	sellObs.AddOrder(sellOrder)
+
+	// Neither the market nor the orders prevent self-trades.
+	ctx.PreventSelfTrades(buyObs, sellObs)
+	require.False(t, buyOrder.IsSelfTradeCancelled())
+	require.False(t, sellOrder.IsSelfTradeCancelled())
+	require.Empty(t, ctx.PreventedSelfTrades())
+}
+
+func TestMatchingContext_PreventSelfTrades_MultipleOrderers(t *testing.T) {
+	market := types.NewMarket(
+		1, "ucre", "uusd", utils.ZeroDec, utils.ZeroDec, utils.ZeroDec)
+	market.SelfTradePrevention = types.SelfTradePreventionCancelNewest
+	ctx := types.NewMatchingContext(market, false)
+
+	source := types.NewMockOrderSource("source")
+	buyObs := types.NewMemOrderBookSide(true)
+	buyObs.AddOrder(types.NewOrderSourceMemOrder(
+		utils.TestAddress(1), true, utils.ParseDec("1"), sdk.NewDec(10_000000), sdk.NewDec(10_000000), source))
+	buyObs.AddOrder(types.NewOrderSourceMemOrder(
+		utils.TestAddress(2), true, utils.ParseDec("0.98"), sdk.NewDec(10_000000), sdk.NewDec(10_000000), source))
+	sellObs := types.NewMemOrderBookSide(false)
+	sellObs.AddOrder(types.NewOrderSourceMemOrder(
+		utils.TestAddress(2), false, utils.ParseDec("0.99"), sdk.NewDec(5_000000), sdk.NewDec(5_000000), source))
+	sellObs.AddOrder(types.NewOrderSourceMemOrder(
+		utils.TestAddress(3), false, utils.ParseDec("0.99"), sdk.NewDec(5_000000), sdk.NewDec(5_000000), source))
+
+	// Orderer 2's orders don't cross each other, and no other orderer has
+	// orders on both sides.
+	ctx.PreventSelfTrades(buyObs, sellObs)
+	require.Empty(t, ctx.PreventedSelfTrades())
+	require.Len(t, buyObs.Orders(), 2)
+	require.Len(t, sellObs.Orders(), 2)
+}
+
+func TestMatchingContext_ExecuteOrder_SelfTradePrevention(t *testing.T) {
+	market := types.NewMarket(
+		1, "ucre", "uusd", utils.ZeroDec, utils.ZeroDec, utils.ZeroDec)
+	source := types.NewMockOrderSource("source")
+	newObs := func() *types.MemOrderBookSide {
+		obs := types.NewMemOrderBookSide(false)
+		obs.AddOrder(types.NewOrderSourceMemOrder(
+			utils.TestAddress(1), false, utils.ParseDec("1"), sdk.NewDec(5_000000), sdk.NewDec(5_000000), source))
+		obs.AddOrder(types.NewOrderSourceMemOrder(
+			utils.TestAddress(2), false, utils.ParseDec("1"), sdk.NewDec(3_000000), sdk.NewDec(3_000000), source))
+		return obs
+	}
+	qtyLimit := sdk.NewDec(10_000000)
+
+	for _, tc := range []struct {
+		name                string
+		mode                types.SelfTradePrevention
+		executedQty         sdk.Dec
+		decrementedQty      sdk.Dec
+		selfTradePrevented  bool
+		numPreventedMatches int
+	}{
+		{"none", types.SelfTradePreventionNone, sdk.NewDec(8_000000), utils.ZeroDec, false, 0},
+		{"cancel newest", types.SelfTradePreventionCancelNewest, utils.ZeroDec, utils.ZeroDec, true, 1},
+		{"cancel oldest", types.SelfTradePreventionCancelOldest, sdk.NewDec(3_000000), utils.ZeroDec, false, 1},
+		{"cancel both", types.SelfTradePreventionCancelBoth, utils.ZeroDec, utils.ZeroDec, true, 1},
+		{"decrement and cancel", types.SelfTradePreventionDecrementAndCancel, sdk.NewDec(3_000000), sdk.NewDec(5_000000), false, 1},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := types.NewMatchingContext(market, false)
+			res := ctx.ExecuteOrder(newObs(), utils.TestAddress(1), 1, tc.mode, nil, &qtyLimit, nil)
+			testutil.AssertEqual(t, tc.executedQty, res.ExecutedQuantity)
+			testutil.AssertEqual(t, tc.decrementedQty, res.DecrementedQuantity)
+			require.Equal(t, tc.selfTradePrevented, res.SelfTradePrevented)
+			require.Len(t, ctx.PreventedSelfTrades(), tc.numPreventedMatches)
+		})
+	}
+}
@@ -39,27 +39,45 @@ type MemOrder struct {
 	openQty          sdk.Dec
 	remainingDeposit sdk.Dec
 	executedQty      sdk.Dec
+	executedQuote    sdk.Dec
 	paid             sdk.Dec
 	received         sdk.Dec
 	fee              sdk.Dec
+	referrerAddr     sdk.AccAddress // nil if the order has no referrer
+	referralFee      sdk.Dec
 	isMatched        bool
 	isMaker          *bool
+	decrementedQty   sdk.Dec // decremented by self-trade prevention
+	cancelled        bool    // cancelled by self-trade prevention
 }
 
 func NewUserMemOrder(order Order) *MemOrder {
+	var referrerAddr sdk.AccAddress
+	if order.Referrer != "" {
+		referrerAddr = sdk.MustAccAddressFromBech32(order.Referrer)
+	}
+	// Only the displayed slice of an iceberg order is eligible for matching.
+	qty := order.Quantity
+	if order.IsIceberg() {
+		qty = *order.DisplayQuantity
+	}
 	return &MemOrder{
 		typ:              UserMemOrder,
 		order:            &order,
 		ordererAddr:      order.MustGetOrdererAddress(),
 		isBuy:            order.IsBuy,
 		price:            order.Price,
-		qty:              order.Quantity,
-		openQty:          order.OpenQuantity,
+		qty:              qty,
+		openQty:          order.VisibleQuantity(),
 		remainingDeposit: order.RemainingDeposit,
 		executedQty:      utils.ZeroDec,
+		executedQuote:    utils.ZeroDec,
 		paid:             utils.ZeroDec,
 		received:         utils.ZeroDec,
 		fee:              utils.ZeroDec,
+		referrerAddr:     referrerAddr,
+		referralFee:      utils.ZeroDec,
+		decrementedQty:   utils.ZeroDec,
 	}
 }
 
@@ -74,9 +92,12 @@ func NewOrderSourceMemOrder(
 		openQty:          openQty,
 		remainingDeposit: DepositAmount(isBuy, price, openQty),
 		executedQty:      utils.ZeroDec,
+		executedQuote:    utils.ZeroDec,
 		paid:             utils.ZeroDec,
 		received:         utils.ZeroDec,
 		fee:              utils.ZeroDec,
+		referralFee:      utils.ZeroDec,
+		decrementedQty:   utils.ZeroDec,
 		source:           source,
 	}
 }
@@ -131,6 +152,10 @@ func (order *MemOrder) ExecutedQuantity() sdk.Dec {
 	return order.executedQty
 }
 
+func (order *MemOrder) ExecutedQuote() sdk.Dec {
+	return order.executedQuote
+}
+
 func (order *MemOrder) Paid() sdk.Dec {
 	return order.paid
 }
@@ -150,18 +175,55 @@ func (order *MemOrder) Fee() sdk.Dec {
 	return order.fee
 }
 
+// Referrer returns the referrer of the order, which is nil if the order has
+// no referrer.
+func (order *MemOrder) Referrer() sdk.AccAddress {
+	return order.referrerAddr
+}
+
+// ReferralFee returns the share of the order's fee paid to the referrer.
+func (order *MemOrder) ReferralFee() sdk.Dec {
+	return order.referralFee
+}
+
 func (order *MemOrder) IsMatched() bool {
 	return order.isMatched
 }
 
+// IsMaker returns whether the order was matched as a maker.
+// It returns false if the order has not been matched.
+func (order *MemOrder) IsMaker() bool {
+	return order.isMaker != nil && *order.isMaker
+}
+
+// DecrementedQuantity returns the quantity by which the order has been
+// decremented by self-trade prevention.
+func (order *MemOrder) DecrementedQuantity() sdk.Dec {
+	return order.decrementedQty
+}
+
+// IsSelfTradeCancelled returns whether the order has been cancelled by
+// self-trade prevention.
+func (order *MemOrder) IsSelfTradeCancelled() bool {
+	return order.cancelled
+}
+
 func (order *MemOrder) ExecutableQuantity() sdk.Dec {
-	executableQty := order.openQty.Sub(order.executedQty)
+	if order.cancelled {
+		return utils.ZeroDec
+	}
+	executableQty := order.openQty.Sub(order.executedQty).Sub(order.decrementedQty)
 	if order.isBuy {
 		return sdk.MinDec(executableQty, order.remainingDeposit.QuoTruncate(order.price))
 	}
 	return sdk.MinDec(executableQty, order.remainingDeposit)
 }
 
+// isPostOnly returns whether the order is a post-only user order.
+func (order *MemOrder) isPostOnly() bool {
+	return order.typ == UserMemOrder && order.order.TimeInForce == TimeInForcePostOnly
+}
+
 func (order *MemOrder) HasPriorityOver(other *MemOrder) bool {
 	if !order.price.Equal(other.price) { // sanity check
 		panic(fmt.Sprintf("orders with different price: %s != %s", order.price, other.price))
@@ -181,6 +243,40 @@ func (order *MemOrder) HasPriorityOver(other *MemOrder) bool {
 	}
 }
 
+// isNewerThan returns whether the order has been placed after the other order.
+// User orders are always considered newer than order source orders.
+func (order *MemOrder) isNewerThan(other *MemOrder) bool {
+	switch {
+	case order.typ == UserMemOrder && other.typ == UserMemOrder:
+		if order.order.PriorityHeight != other.order.PriorityHeight {
+			return order.order.PriorityHeight > other.order.PriorityHeight
+		}
+		return order.order.Id > other.order.Id
+	case order.typ == UserMemOrder && other.typ == OrderSourceMemOrder:
+		return true
+	default:
+		return false
+	}
+}
+
+// selfTradePrevention returns the self-trade prevention mode of the order,
+// falling back to the market's mode.
+func (order *MemOrder) selfTradePrevention(marketMode SelfTradePrevention) SelfTradePrevention {
+	if order.typ == UserMemOrder && order.order.SelfTradePrevention != SelfTradePreventionUnspecified {
+		return order.order.SelfTradePrevention
+	}
+	return marketMode
+}
+
+// identifiers returns the order id for user orders and the source name for
+// order source orders.
+func (order *MemOrder) identifiers() (orderId uint64, sourceName string) {
+	if order.typ == UserMemOrder {
+		return order.order.Id, ""
+	}
+	return 0, order.source.Name()
+}
+
 type MemOrderBookPriceLevel struct {
 	isBuy  bool
 	price  sdk.Dec
@@ -206,6 +302,21 @@ func (level *MemOrderBookPriceLevel) AddOrder(order *MemOrder) {
 	level.orders = append(level.orders, order)
 }
 
+// removeCancelledOrders removes orders cancelled by self-trade prevention
+// from the level and returns them.
+func (level *MemOrderBookPriceLevel) removeCancelledOrders() (removed []*MemOrder) {
+	orders := level.orders[:0]
+	for _, order := range level.orders {
+		if order.cancelled {
+			removed = append(removed, order)
+		} else {
+			orders = append(orders, order)
+		}
+	}
+	level.orders = orders
+	return
+}
+
 // MemOrderBookSideOptions is options passed when constructing MemOrderBookSide.
 type MemOrderBookSideOptions struct {
 	IsBuy             bool
@@ -236,6 +347,9 @@ func (opts MemOrderBookSideOptions) ReachedLimit(price, accQty, accQuote sdk.Dec
 type MemOrderBookSide struct {
 	isBuy  bool
 	levels []*MemOrderBookPriceLevel
+	// removedOrders holds orders removed from the levels by self-trade
+	// prevention, which still have to be settled.
+	removedOrders []*MemOrder
 }
 
 func NewMemOrderBookSide(isBuy bool) *MemOrderBookSide {
@@ -251,7 +365,20 @@ func (obs *MemOrderBookSide) Orders() []*MemOrder {
 	for _, level := range obs.levels {
 		orders = append(orders, level.orders...)
 	}
-	return orders
+	return append(orders, obs.removedOrders...)
+}
+
+// removeCancelledOrders removes orders cancelled by self-trade prevention
+// from the order book side, along with the levels left empty.
+func (obs *MemOrderBookSide) removeCancelledOrders() {
+	levels := obs.levels[:0]
+	for _, level := range obs.levels {
+		obs.removedOrders = append(obs.removedOrders, level.removeCancelledOrders()...)
+		if len(level.orders) > 0 {
+			levels = append(levels, level)
+		}
+	}
+	obs.levels = levels
 }
 
 func (obs *MemOrderBookSide) Limit(n int) {
@@ -294,19 +421,19 @@ func (side *MemOrderBookSide) String() string {
 }
 
 type MemOrderGroup struct {
-	msgHeight int64
-	orders    []*MemOrder
+	priorityHeight int64
+	orders         []*MemOrder
 }
 
-func (group *MemOrderGroup) MsgHeight() int64 {
-	return group.msgHeight
+func (group *MemOrderGroup) PriorityHeight() int64 {
+	return group.priorityHeight
 }
 
 func (group *MemOrderGroup) Orders() []*MemOrder {
 	return group.orders
 }
 
-func GroupMemOrdersByMsgHeight(orders []*MemOrder) (groups []*MemOrderGroup) {
+func GroupMemOrdersByPriorityHeight(orders []*MemOrder) (groups []*MemOrderGroup) {
 	var orderSourceOrders, userOrders []*MemOrder
 	for _, order := range orders {
 		if order.typ == UserMemOrder {
@@ -316,17 +443,17 @@ func GroupMemOrdersByMsgHeight(orders []*MemOrder) (groups []*MemOrderGroup) {
 		}
 	}
 	if len(orderSourceOrders) > 0 {
-		groups = append(groups, &MemOrderGroup{msgHeight: -1, orders: orderSourceOrders})
+		groups = append(groups, &MemOrderGroup{priorityHeight: -1, orders: orderSourceOrders})
 	}
-	groupByMsgHeight := map[int64]*MemOrderGroup{}
+	groupByPriorityHeight := map[int64]*MemOrderGroup{}
 	for _, order := range userOrders {
-		group, ok := groupByMsgHeight[order.order.MsgHeight]
+		group, ok := groupByPriorityHeight[order.order.PriorityHeight]
 		if !ok {
 			i := sort.Search(len(groups), func(i int) bool {
-				return groups[i].msgHeight >= order.order.MsgHeight
+				return groups[i].priorityHeight >= order.order.PriorityHeight
 			})
-			group = &MemOrderGroup{msgHeight: order.order.MsgHeight}
-			groupByMsgHeight[order.order.MsgHeight] = group
+			group = &MemOrderGroup{priorityHeight: order.order.PriorityHeight}
+			groupByPriorityHeight[order.order.PriorityHeight] = group
 
 			newGroups := make([]*MemOrderGroup, len(groups)+1)
 			copy(newGroups[:i], groups[:i])
@@ -17,7 +17,7 @@ func newUserMemOrder(
 	return types.NewUserMemOrder(
 		types.NewOrder(orderId, types.OrderTypeLimit, utils.TestAddress(1), 1, isBuy,
 			price, qty, 1, openQty, types.DepositAmount(isBuy, price, openQty),
-			utils.ParseTime("2023-06-01T00:00:00Z")))
+			utils.ParseTime("2023-06-01T00:00:00Z"), types.TimeInForceGoodTilTime, types.SelfTradePreventionUnspecified))
 }
 
 func newOrderSourceMemOrder(
@@ -102,7 +102,7 @@ func TestMemOrder_HasPriorityOver(t *testing.T) {
 	require.True(t, order1.HasPriorityOver(order2))
 }
 
-func TestGroupMemOrdersByMsgHeight(t *testing.T) {
+func TestGroupMemOrdersByPriorityHeight(t *testing.T) {
 	r := rand.New(rand.NewSource(1))
 	market := types.NewMarket(
 		1, "ucre", "uusd",
@@ -125,24 +125,24 @@ func TestGroupMemOrdersByMsgHeight(t *testing.T) {
 				msgHeight := int64(r.Intn(20))
 				order := types.NewOrder(
 					uint64(j+1), types.OrderTypeLimit, utils.TestAddress(1), market.Id,
-					true, price, qty, msgHeight, qty, deposit, deadline)
+					true, price, qty, msgHeight, qty, deposit, deadline, types.TimeInForceGoodTilTime, types.SelfTradePreventionUnspecified)
 				orders = append(orders, types.NewUserMemOrder(order))
 			}
 		}
-		groups := types.GroupMemOrdersByMsgHeight(orders)
+		groups := types.GroupMemOrdersByPriorityHeight(orders)
 		require.NotEmpty(t, groups)
 		if hasOrderSourceOrders {
-			require.EqualValues(t, -1, groups[0].MsgHeight())
+			require.EqualValues(t, -1, groups[0].PriorityHeight())
 		}
 		for j := 0; j < len(groups); j++ {
 			if j+1 < len(groups) {
-				require.Less(t, groups[j].MsgHeight(), groups[j+1].MsgHeight())
+				require.Less(t, groups[j].PriorityHeight(), groups[j+1].PriorityHeight())
 			}
 			for _, order := range groups[j].Orders() {
 				if order.Type() == types.UserMemOrder {
-					require.EqualValues(t, groups[j].MsgHeight(), order.Order().MsgHeight)
+					require.EqualValues(t, groups[j].PriorityHeight(), order.Order().PriorityHeight)
 				} else {
-					require.EqualValues(t, -1, groups[j].MsgHeight())
+					require.EqualValues(t, -1, groups[j].PriorityHeight())
 				}
 			}
 		}
@@ -17,6 +17,11 @@ var (
 	_ sdk.Msg = (*MsgCancelOrder)(nil)
 	_ sdk.Msg = (*MsgCancelAllOrders)(nil)
 	_ sdk.Msg = (*MsgSwapExactAmountIn)(nil)
+	_ sdk.Msg = (*MsgSwapExactAmountOut)(nil)
+	_ sdk.Msg = (*MsgPlaceTriggerOrder)(nil)
+	_ sdk.Msg = (*MsgAmendOrder)(nil)
+	_ sdk.Msg = (*MsgSetCancelAfter)(nil)
+	_ sdk.Msg = (*MsgReplaceMMOrders)(nil)
 )
 
 // Message types for the module
@@ -30,14 +35,22 @@ const (
 	TypeMsgCancelOrder            = "cancel_order"
 	TypeMsgCancelAllOrders        = "cancel_all_orders"
 	TypeMsgSwapExactAmountIn      = "swap_exact_amount_in"
+	TypeMsgSwapExactAmountOut     = "swap_exact_amount_out"
+	TypeMsgPlaceTriggerOrder      = "place_trigger_order"
+	TypeMsgAmendOrder             = "amend_order"
+	TypeMsgSetCancelAfter         = "set_cancel_after"
+	TypeMsgReplaceMMOrders        = "replace_mm_orders"
 )
 
 func NewMsgCreateMarket(
-	senderAddr sdk.AccAddress, baseDenom, quoteDenom string) *MsgCreateMarket {
+	senderAddr sdk.AccAddress, baseDenom, quoteDenom string, tickSize, minOrderQty, lotSize *sdk.Dec) *MsgCreateMarket {
 	return &MsgCreateMarket{
-		Sender:     senderAddr.String(),
-		BaseDenom:  baseDenom,
-		QuoteDenom: quoteDenom,
+		Sender:           senderAddr.String(),
+		BaseDenom:        baseDenom,
+		QuoteDenom:       quoteDenom,
+		TickSize:         tickSize,
+		MinOrderQuantity: minOrderQty,
+		LotSize:          lotSize,
 	}
 }
 
@@ -69,19 +82,25 @@ func (msg MsgCreateMarket) ValidateBasic() error {
 	if msg.BaseDenom == msg.QuoteDenom {
 		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "base denom and quote denom must not be same: %s", msg.BaseDenom)
 	}
+	if err := validateOptionalOrderSizes(msg.TickSize, msg.MinOrderQuantity, msg.LotSize); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
 	return nil
 }
 
 func NewMsgPlaceLimitOrder(
 	senderAddr sdk.AccAddress, marketId uint64, isBuy bool,
-	price, qty sdk.Dec, lifespan time.Duration) *MsgPlaceLimitOrder {
+	price, qty sdk.Dec, lifespan time.Duration, timeInForce TimeInForce,
+	selfTradePrevention SelfTradePrevention) *MsgPlaceLimitOrder {
 	return &MsgPlaceLimitOrder{
-		Sender:   senderAddr.String(),
-		MarketId: marketId,
-		IsBuy:    isBuy,
-		Price:    price,
-		Quantity: qty,
-		Lifespan: lifespan,
+		Sender:              senderAddr.String(),
+		MarketId:            marketId,
+		IsBuy:               isBuy,
+		Price:               price,
+		Quantity:            qty,
+		Lifespan:            lifespan,
+		TimeInForce:         timeInForce,
+		SelfTradePrevention: selfTradePrevention,
 	}
 }
 
@@ -101,19 +120,42 @@ func (msg MsgPlaceLimitOrder) GetSigners() []sdk.AccAddress {
 }
 
 func (msg MsgPlaceLimitOrder) ValidateBasic() error {
-	return ValidateLimitOrderMsg(msg.Sender, msg.MarketId, msg.IsBuy, msg.Price, msg.Quantity, msg.Lifespan)
+	if err := ValidateLimitOrderMsg(msg.Sender, msg.MarketId, msg.IsBuy, msg.Price, msg.Quantity, msg.Lifespan); err != nil {
+		return err
+	}
+	if err := ValidateTimeInForce(msg.TimeInForce, false); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	if err := ValidateSelfTradePrevention(msg.SelfTradePrevention); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	if err := ValidateReferrer(msg.Sender, msg.Referrer); err != nil {
+		return err
+	}
+	if msg.DisplayQuantity != nil {
+		if msg.TimeInForce != TimeInForceGoodTilTime && msg.TimeInForce != TimeInForcePostOnly {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "iceberg order cannot be %s", msg.TimeInForce)
+		}
+		if err := ValidateDisplayQuantity(*msg.DisplayQuantity, msg.Quantity); err != nil {
+			return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+		}
+	}
+	return nil
 }
 
 func NewMsgPlaceBatchLimitOrder(
 	senderAddr sdk.AccAddress, marketId uint64, isBuy bool,
-	price, qty sdk.Dec, lifespan time.Duration) *MsgPlaceBatchLimitOrder {
+	price, qty sdk.Dec, lifespan time.Duration, timeInForce TimeInForce,
+	selfTradePrevention SelfTradePrevention) *MsgPlaceBatchLimitOrder {
 	return &MsgPlaceBatchLimitOrder{
-		Sender:   senderAddr.String(),
-		MarketId: marketId,
-		IsBuy:    isBuy,
-		Price:    price,
-		Quantity: qty,
-		Lifespan: lifespan,
+		Sender:              senderAddr.String(),
+		MarketId:            marketId,
+		IsBuy:               isBuy,
+		Price:               price,
+		Quantity:            qty,
+		Lifespan:            lifespan,
+		TimeInForce:         timeInForce,
+		SelfTradePrevention: selfTradePrevention,
 	}
 }
 
@@ -133,19 +175,31 @@ func (msg MsgPlaceBatchLimitOrder) GetSigners() []sdk.AccAddress {
 }
 
 func (msg MsgPlaceBatchLimitOrder) ValidateBasic() error {
-	return ValidateLimitOrderMsg(msg.Sender, msg.MarketId, msg.IsBuy, msg.Price, msg.Quantity, msg.Lifespan)
+	if err := ValidateLimitOrderMsg(msg.Sender, msg.MarketId, msg.IsBuy, msg.Price, msg.Quantity, msg.Lifespan); err != nil {
+		return err
+	}
+	if err := ValidateTimeInForce(msg.TimeInForce, true); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	if err := ValidateSelfTradePrevention(msg.SelfTradePrevention); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	return nil
 }
 
 func NewMsgPlaceMMLimitOrder(
 	senderAddr sdk.AccAddress, marketId uint64, isBuy bool,
-	price, qty sdk.Dec, lifespan time.Duration) *MsgPlaceMMLimitOrder {
+	price, qty sdk.Dec, lifespan time.Duration, timeInForce TimeInForce,
+	selfTradePrevention SelfTradePrevention) *MsgPlaceMMLimitOrder {
 	return &MsgPlaceMMLimitOrder{
-		Sender:   senderAddr.String(),
-		MarketId: marketId,
-		IsBuy:    isBuy,
-		Price:    price,
-		Quantity: qty,
-		Lifespan: lifespan,
+		Sender:              senderAddr.String(),
+		MarketId:            marketId,
+		IsBuy:               isBuy,
+		Price:               price,
+		Quantity:            qty,
+		Lifespan:            lifespan,
+		TimeInForce:         timeInForce,
+		SelfTradePrevention: selfTradePrevention,
 	}
 }
 
@@ -165,19 +219,31 @@ func (msg MsgPlaceMMLimitOrder) GetSigners() []sdk.AccAddress {
 }
 
 func (msg MsgPlaceMMLimitOrder) ValidateBasic() error {
-	return ValidateLimitOrderMsg(msg.Sender, msg.MarketId, msg.IsBuy, msg.Price, msg.Quantity, msg.Lifespan)
+	if err := ValidateLimitOrderMsg(msg.Sender, msg.MarketId, msg.IsBuy, msg.Price, msg.Quantity, msg.Lifespan); err != nil {
+		return err
+	}
+	if err := ValidateTimeInForce(msg.TimeInForce, false); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	if err := ValidateSelfTradePrevention(msg.SelfTradePrevention); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	return nil
 }
 
 func NewMsgPlaceMMBatchLimitOrder(
 	senderAddr sdk.AccAddress, marketId uint64, isBuy bool,
-	price, qty sdk.Dec, lifespan time.Duration) *MsgPlaceMMBatchLimitOrder {
+	price, qty sdk.Dec, lifespan time.Duration, timeInForce TimeInForce,
+	selfTradePrevention SelfTradePrevention) *MsgPlaceMMBatchLimitOrder {
 	return &MsgPlaceMMBatchLimitOrder{
-		Sender:   senderAddr.String(),
-		MarketId: marketId,
-		IsBuy:    isBuy,
-		Price:    price,
-		Quantity: qty,
-		Lifespan: lifespan,
+		Sender:              senderAddr.String(),
+		MarketId:            marketId,
+		IsBuy:               isBuy,
+		Price:               price,
+		Quantity:            qty,
+		Lifespan:            lifespan,
+		TimeInForce:         timeInForce,
+		SelfTradePrevention: selfTradePrevention,
 	}
 }
 
@@ -197,7 +263,16 @@ func (msg MsgPlaceMMBatchLimitOrder) GetSigners() []sdk.AccAddress {
 }
 
 func (msg MsgPlaceMMBatchLimitOrder) ValidateBasic() error {
-	return ValidateLimitOrderMsg(msg.Sender, msg.MarketId, msg.IsBuy, msg.Price, msg.Quantity, msg.Lifespan)
+	if err := ValidateLimitOrderMsg(msg.Sender, msg.MarketId, msg.IsBuy, msg.Price, msg.Quantity, msg.Lifespan); err != nil {
+		return err
+	}
+	if err := ValidateTimeInForce(msg.TimeInForce, true); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	if err := ValidateSelfTradePrevention(msg.SelfTradePrevention); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	return nil
 }
 
 func NewMsgPlaceMarketOrder(
@@ -239,6 +314,9 @@ func (msg MsgPlaceMarketOrder) ValidateBasic() error {
 	if !msg.Quantity.TruncateDec().Equal(msg.Quantity) {
 		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "quantity must be an integer: %s", msg.Quantity)
 	}
+	if err := ValidateReferrer(msg.Sender, msg.Referrer); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -316,6 +394,16 @@ func NewMsgSwapExactAmountIn(
 	}
 }
 
+func NewMsgSwapExactAmountInWeightedRoutes(
+	senderAddr sdk.AccAddress, weightedRoutes []WeightedSwapRoute, input, minOutput sdk.DecCoin) *MsgSwapExactAmountIn {
+	return &MsgSwapExactAmountIn{
+		Sender:         senderAddr.String(),
+		Input:          input,
+		MinOutput:      minOutput,
+		WeightedRoutes: weightedRoutes,
+	}
+}
+
 func (msg MsgSwapExactAmountIn) Route() string { return RouterKey }
 func (msg MsgSwapExactAmountIn) Type() string  { return TypeMsgSwapExactAmountIn }
 
@@ -335,12 +423,31 @@ func (msg MsgSwapExactAmountIn) ValidateBasic() error {
 	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
 		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address: %v", err)
 	}
-	if len(msg.Routes) == 0 {
-		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "routes must not be empty")
-	}
-	for _, marketId := range msg.Routes {
-		if marketId == 0 {
-			return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "market id must not be 0")
+	if len(msg.WeightedRoutes) > 0 {
+		if len(msg.Routes) > 0 {
+			return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "routes and weighted routes cannot be set at the same time")
+		}
+		for _, weightedRoute := range msg.WeightedRoutes {
+			if len(weightedRoute.Routes) == 0 {
+				return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "routes must not be empty")
+			}
+			for _, marketId := range weightedRoute.Routes {
+				if marketId == 0 {
+					return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "market id must not be 0")
+				}
+			}
+			if weightedRoute.Weight.IsNil() || !weightedRoute.Weight.IsPositive() {
+				return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "weight must be positive: %s", weightedRoute.Weight)
+			}
+		}
+	} else {
+		if len(msg.Routes) == 0 {
+			return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "routes must not be empty")
+		}
+		for _, marketId := range msg.Routes {
+			if marketId == 0 {
+				return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "market id must not be 0")
+			}
 		}
 	}
 	if err := msg.Input.Validate(); err != nil {
@@ -358,9 +465,298 @@ func (msg MsgSwapExactAmountIn) ValidateBasic() error {
 	if !msg.MinOutput.Amount.TruncateDec().Equal(msg.MinOutput.Amount) {
 		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "min output amount must be integer: %s", msg.MinOutput)
 	}
+	if err := ValidateReferrer(msg.Sender, msg.Referrer); err != nil {
+		return err
+	}
+	return nil
+}
+
+func NewMsgSwapExactAmountOut(
+	senderAddr sdk.AccAddress, routes []uint64, output, maxInput sdk.DecCoin) *MsgSwapExactAmountOut {
+	return &MsgSwapExactAmountOut{
+		Sender:   senderAddr.String(),
+		Routes:   routes,
+		Output:   output,
+		MaxInput: maxInput,
+	}
+}
+
+func (msg MsgSwapExactAmountOut) Route() string { return RouterKey }
+func (msg MsgSwapExactAmountOut) Type() string  { return TypeMsgSwapExactAmountOut }
+
+func (msg MsgSwapExactAmountOut) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgSwapExactAmountOut) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
+func (msg MsgSwapExactAmountOut) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address: %v", err)
+	}
+	if len(msg.Routes) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "routes must not be empty")
+	}
+	for _, marketId := range msg.Routes {
+		if marketId == 0 {
+			return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "market id must not be 0")
+		}
+	}
+	if err := msg.Output.Validate(); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "invalid output: %v", err)
+	}
+	if !msg.Output.IsPositive() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "output must be positive: %s", msg.Output)
+	}
+	if !msg.Output.Amount.TruncateDec().Equal(msg.Output.Amount) {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "output amount must be integer: %s", msg.Output)
+	}
+	if err := msg.MaxInput.Validate(); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "invalid max input: %v", err)
+	}
+	if !msg.MaxInput.IsPositive() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "max input must be positive: %s", msg.MaxInput)
+	}
+	if !msg.MaxInput.Amount.TruncateDec().Equal(msg.MaxInput.Amount) {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "max input amount must be integer: %s", msg.MaxInput)
+	}
 	return nil
 }
 
+func NewMsgPlaceTriggerOrder(
+	senderAddr sdk.AccAddress, marketId uint64, isBuy bool, condition TriggerCondition,
+	triggerPrice sdk.Dec, price *sdk.Dec, qty sdk.Dec, lifespan time.Duration) *MsgPlaceTriggerOrder {
+	return &MsgPlaceTriggerOrder{
+		Sender:       senderAddr.String(),
+		MarketId:     marketId,
+		IsBuy:        isBuy,
+		Condition:    condition,
+		TriggerPrice: triggerPrice,
+		Price:        price,
+		Quantity:     qty,
+		Lifespan:     lifespan,
+	}
+}
+
+func (msg MsgPlaceTriggerOrder) Route() string { return RouterKey }
+func (msg MsgPlaceTriggerOrder) Type() string  { return TypeMsgPlaceTriggerOrder }
+
+func (msg MsgPlaceTriggerOrder) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgPlaceTriggerOrder) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
+func (msg MsgPlaceTriggerOrder) ValidateBasic() error {
+	if msg.Price != nil {
+		if err := ValidateLimitOrderMsg(
+			msg.Sender, msg.MarketId, msg.IsBuy, *msg.Price, msg.Quantity, msg.Lifespan); err != nil {
+			return err
+		}
+	} else {
+		if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address: %v", err)
+		}
+		if msg.MarketId == 0 {
+			return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "market id must not be 0")
+		}
+		if !msg.Quantity.IsPositive() {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "quantity must be positive: %s", msg.Quantity)
+		}
+		if !msg.Quantity.TruncateDec().Equal(msg.Quantity) {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "quantity must be an integer: %s", msg.Quantity)
+		}
+		if msg.Lifespan < 0 {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "lifespan must not be negative: %v", msg.Lifespan)
+		}
+	}
+	if err := ValidateTriggerCondition(msg.Condition); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	if msg.TriggerPrice.LT(MinPrice) {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "trigger price is lower than the min price; %s < %s", msg.TriggerPrice, MinPrice)
+	}
+	if msg.TriggerPrice.GT(MaxPrice) {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "trigger price is higher than the max price; %s > %s", msg.TriggerPrice, MaxPrice)
+	}
+	if _, valid := ValidateTickPrice(msg.TriggerPrice); !valid {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid trigger price tick: %s", msg.TriggerPrice)
+	}
+	return nil
+}
+
+func NewMsgAmendOrder(
+	senderAddr sdk.AccAddress, orderId uint64, price, qty *sdk.Dec, lifespan *time.Duration) *MsgAmendOrder {
+	return &MsgAmendOrder{
+		Sender:   senderAddr.String(),
+		OrderId:  orderId,
+		Price:    price,
+		Quantity: qty,
+		Lifespan: lifespan,
+	}
+}
+
+func (msg MsgAmendOrder) Route() string { return RouterKey }
+func (msg MsgAmendOrder) Type() string  { return TypeMsgAmendOrder }
+
+func (msg MsgAmendOrder) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgAmendOrder) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
+func (msg MsgAmendOrder) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address: %v", err)
+	}
+	if msg.OrderId == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "order id must not be 0")
+	}
+	if msg.Price == nil && msg.Quantity == nil && msg.Lifespan == nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "at least one of price, quantity and lifespan must be set")
+	}
+	if msg.Price != nil {
+		if msg.Price.LT(MinPrice) {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "price is lower than the min price; %s < %s", msg.Price, MinPrice)
+		}
+		if msg.Price.GT(MaxPrice) {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "price is higher than the max price; %s > %s", msg.Price, MaxPrice)
+		}
+		if _, valid := ValidateTickPrice(*msg.Price); !valid {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid price tick: %s", msg.Price)
+		}
+	}
+	if msg.Quantity != nil {
+		if !msg.Quantity.IsPositive() {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "quantity must be positive: %s", msg.Quantity)
+		}
+		if !msg.Quantity.TruncateDec().Equal(*msg.Quantity) {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "quantity must be an integer: %s", msg.Quantity)
+		}
+	}
+	if msg.Lifespan != nil && *msg.Lifespan < 0 {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "lifespan must not be negative: %v", *msg.Lifespan)
+	}
+	return nil
+}
+
+func NewMsgSetCancelAfter(
+	senderAddr sdk.AccAddress, marketIds []uint64, cancelAfter *time.Time) *MsgSetCancelAfter {
+	return &MsgSetCancelAfter{
+		Sender:      senderAddr.String(),
+		MarketIds:   marketIds,
+		CancelAfter: cancelAfter,
+	}
+}
+
+func (msg MsgSetCancelAfter) Route() string { return RouterKey }
+func (msg MsgSetCancelAfter) Type() string  { return TypeMsgSetCancelAfter }
+
+func (msg MsgSetCancelAfter) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgSetCancelAfter) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
+func (msg MsgSetCancelAfter) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address: %v", err)
+	}
+	if len(msg.MarketIds) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "market ids must not be empty")
+	}
+	marketIdSet := map[uint64]struct{}{}
+	for _, marketId := range msg.MarketIds {
+		if marketId == 0 {
+			return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "market id must not be 0")
+		}
+		if _, ok := marketIdSet[marketId]; ok {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "duplicate market id: %d", marketId)
+		}
+		marketIdSet[marketId] = struct{}{}
+	}
+	return nil
+}
+
+func NewMsgReplaceMMOrders(
+	senderAddr sdk.AccAddress, marketId uint64, orders []MMOrderParameters) *MsgReplaceMMOrders {
+	return &MsgReplaceMMOrders{
+		Sender:   senderAddr.String(),
+		MarketId: marketId,
+		Orders:   orders,
+	}
+}
+
+func (msg MsgReplaceMMOrders) Route() string { return RouterKey }
+func (msg MsgReplaceMMOrders) Type() string  { return TypeMsgReplaceMMOrders }
+
+func (msg MsgReplaceMMOrders) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgReplaceMMOrders) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
+func (msg MsgReplaceMMOrders) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address: %v", err)
+	}
+	if msg.MarketId == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "market id must not be 0")
+	}
+	for i, order := range msg.Orders {
+		if err := ValidateLimitOrderMsg(
+			msg.Sender, msg.MarketId, order.IsBuy, order.Price, order.Quantity, order.Lifespan); err != nil {
+			return sdkerrors.Wrapf(err, "invalid order %d", i)
+		}
+		if err := ValidateSelfTradePrevention(order.SelfTradePrevention); err != nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid order %d: %v", i, err)
+		}
+	}
+	return nil
+}
+
+func NewMMOrderParameters(
+	isBuy bool, price, qty sdk.Dec, lifespan time.Duration,
+	selfTradePrevention SelfTradePrevention) MMOrderParameters {
+	return MMOrderParameters{
+		IsBuy:               isBuy,
+		Price:               price,
+		Quantity:            qty,
+		Lifespan:            lifespan,
+		SelfTradePrevention: selfTradePrevention,
+	}
+}
+
 func ValidateLimitOrderMsg(
 	sender string, marketId uint64, isBuy bool, price, qty sdk.Dec, lifespan time.Duration) error {
 	if _, err := sdk.AccAddressFromBech32(sender); err != nil {
@@ -389,3 +785,18 @@ func ValidateLimitOrderMsg(
 	}
 	return nil
 }
+
+// ValidateReferrer validates the optional referrer of an order.
+// Orderers cannot refer themselves.
+func ValidateReferrer(sender, referrer string) error {
+	if referrer == "" {
+		return nil
+	}
+	if _, err := sdk.AccAddressFromBech32(referrer); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid referrer address: %v", err)
+	}
+	if referrer == sender {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "referrer must not be the sender")
+	}
+	return nil
+}
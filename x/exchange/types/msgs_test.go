@@ -52,10 +52,33 @@ func TestMsgCreateMarket(t *testing.T) {
 			},
 			"base denom and quote denom must not be same: ucre: invalid request",
 		},
+		{
+			"valid order sizes",
+			func(msg *types.MsgCreateMarket) {
+				msg.TickSize = utils.ParseDecP("0.001")
+				msg.MinOrderQuantity = utils.ParseDecP("10000")
+				msg.LotSize = utils.ParseDecP("1000")
+			},
+			"",
+		},
+		{
+			"negative tick size",
+			func(msg *types.MsgCreateMarket) {
+				msg.TickSize = utils.ParseDecP("-0.001")
+			},
+			"tick size must not be negative: -0.001000000000000000: invalid request",
+		},
+		{
+			"fractional lot size",
+			func(msg *types.MsgCreateMarket) {
+				msg.LotSize = utils.ParseDecP("0.5")
+			},
+			"lot size must be an integer: 0.500000000000000000: invalid request",
+		},
 	} {
 		t.Run(tc.name, func(t *testing.T) {
 			senderAddr := utils.TestAddress(1)
-			msg := types.NewMsgCreateMarket(senderAddr, "ucre", "uusd")
+			msg := types.NewMsgCreateMarket(senderAddr, "ucre", "uusd", nil, nil, nil)
 			require.NoError(t, msg.ValidateBasic())
 			require.Equal(t, types.RouterKey, msg.Route())
 			require.Equal(t, []sdk.AccAddress{senderAddr}, msg.GetSigners())
@@ -159,11 +182,94 @@ func TestValidateLimitOrderMsg(t *testing.T) {
 			},
 			"lifespan must not be negative: -1h0m0s: invalid request",
 		},
+		{
+			"fill-or-kill",
+			func(msg *types.MsgPlaceLimitOrder) {
+				msg.TimeInForce = types.TimeInForceFillOrKill
+			},
+			"",
+		},
+		{
+			"invalid time in force",
+			func(msg *types.MsgPlaceLimitOrder) {
+				msg.TimeInForce = 10
+			},
+			"invalid time in force: 10: invalid request",
+		},
+		{
+			"cancel oldest",
+			func(msg *types.MsgPlaceLimitOrder) {
+				msg.SelfTradePrevention = types.SelfTradePreventionCancelOldest
+			},
+			"",
+		},
+		{
+			"invalid self-trade prevention",
+			func(msg *types.MsgPlaceLimitOrder) {
+				msg.SelfTradePrevention = 10
+			},
+			"invalid self-trade prevention: 10: invalid request",
+		},
+		{
+			"valid referrer",
+			func(msg *types.MsgPlaceLimitOrder) {
+				msg.Referrer = utils.TestAddress(2).String()
+			},
+			"",
+		},
+		{
+			"invalid referrer",
+			func(msg *types.MsgPlaceLimitOrder) {
+				msg.Referrer = "invalidaddr"
+			},
+			"invalid referrer address: decoding bech32 failed: invalid separator index -1: invalid address",
+		},
+		{
+			"self referral",
+			func(msg *types.MsgPlaceLimitOrder) {
+				msg.Referrer = msg.Sender
+			},
+			"referrer must not be the sender: invalid request",
+		},
+		{
+			"iceberg order",
+			func(msg *types.MsgPlaceLimitOrder) {
+				displayQty := sdk.NewDec(1_000000)
+				msg.DisplayQuantity = &displayQty
+			},
+			"",
+		},
+		{
+			"zero display quantity",
+			func(msg *types.MsgPlaceLimitOrder) {
+				displayQty := sdk.ZeroDec()
+				msg.DisplayQuantity = &displayQty
+			},
+			"display quantity must be positive: 0.000000000000000000: invalid request",
+		},
+		{
+			"too large display quantity",
+			func(msg *types.MsgPlaceLimitOrder) {
+				displayQty := msg.Quantity.Add(utils.OneDec)
+				msg.DisplayQuantity = &displayQty
+			},
+			"display quantity must not be greater than quantity: 1000001.000000000000000000 > 1000000.000000000000000000: invalid request",
+		},
+		{
+			"immediate-or-cancel iceberg order",
+			func(msg *types.MsgPlaceLimitOrder) {
+				displayQty := sdk.NewDec(1_000000)
+				msg.DisplayQuantity = &displayQty
+				msg.TimeInForce = types.TimeInForceImmediateOrCancel
+			},
+			"iceberg order cannot be TIME_IN_FORCE_IMMEDIATE_OR_CANCEL: invalid request",
+		},
 	} {
 		t.Run(tc.name, func(t *testing.T) {
 			senderAddr := utils.TestAddress(1)
 			msg := types.NewMsgPlaceLimitOrder(
-				senderAddr, 1, true, utils.ParseDec("12.345"), sdk.NewDec(1000000), time.Hour)
+				senderAddr, 1, true, utils.ParseDec("12.345"), sdk.NewDec(1000000), time.Hour,
+				types.TimeInForceGoodTilTime, types.SelfTradePreventionUnspecified)
 			require.NoError(t, msg.ValidateBasic())
 			require.Equal(t, types.RouterKey, msg.Route())
 			require.Equal(t, []sdk.AccAddress{senderAddr}, msg.GetSigners())
@@ -190,12 +296,27 @@ func TestMsgPlaceBatchLimitOrder(t *testing.T) {
 			func(msg *types.MsgPlaceBatchLimitOrder) {},
 			"",
 		},
+		{
+			"post-only",
+			func(msg *types.MsgPlaceBatchLimitOrder) {
+				msg.TimeInForce = types.TimeInForcePostOnly
+			},
+			"",
+		},
+		{
+			"immediate-or-cancel",
+			func(msg *types.MsgPlaceBatchLimitOrder) {
+				msg.TimeInForce = types.TimeInForceImmediateOrCancel
+			},
+			"TIME_IN_FORCE_IMMEDIATE_OR_CANCEL is not allowed for batch orders: invalid request",
+		},
 		// See testcases for MsgPlaceLimitOrder
 	} {
 		t.Run(tc.name, func(t *testing.T) {
 			senderAddr := utils.TestAddress(1)
 			msg := types.NewMsgPlaceBatchLimitOrder(
-				senderAddr, 1, true, utils.ParseDec("12.345"), sdk.NewDec(1000000), time.Hour)
+				senderAddr, 1, true, utils.ParseDec("12.345"), sdk.NewDec(1000000), time.Hour,
+				types.TimeInForceGoodTilTime, types.SelfTradePreventionUnspecified)
 			require.NoError(t, msg.ValidateBasic())
 			require.Equal(t, types.RouterKey, msg.Route())
 			require.Equal(t, []sdk.AccAddress{senderAddr}, msg.GetSigners())
@@ -227,7 +348,8 @@ func TestMsgPlaceMMLimitOrder(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			senderAddr := utils.TestAddress(1)
 			msg := types.NewMsgPlaceMMLimitOrder(
-				senderAddr, 1, true, utils.ParseDec("12.345"), sdk.NewDec(1000000), time.Hour)
+				senderAddr, 1, true, utils.ParseDec("12.345"), sdk.NewDec(1000000), time.Hour,
+				types.TimeInForceGoodTilTime, types.SelfTradePreventionUnspecified)
 			require.NoError(t, msg.ValidateBasic())
 			require.Equal(t, types.RouterKey, msg.Route())
 			require.Equal(t, []sdk.AccAddress{senderAddr}, msg.GetSigners())
@@ -259,7 +381,8 @@ func TestMsgPlaceMMBatchLimitOrder(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			senderAddr := utils.TestAddress(1)
 			msg := types.NewMsgPlaceMMBatchLimitOrder(
-				senderAddr, 1, true, utils.ParseDec("12.345"), sdk.NewDec(1000000), time.Hour)
+				senderAddr, 1, true, utils.ParseDec("12.345"), sdk.NewDec(1000000), time.Hour,
+				types.TimeInForceGoodTilTime, types.SelfTradePreventionUnspecified)
 			require.NoError(t, msg.ValidateBasic())
 			require.Equal(t, types.RouterKey, msg.Route())
 			require.Equal(t, []sdk.AccAddress{senderAddr}, msg.GetSigners())
@@ -321,6 +444,27 @@ func TestMsgPlaceMarketOrder(t *testing.T) {
 			},
 			"quantity must be an integer: 100000.010000000000000000: invalid request",
 		},
+		{
+			"valid referrer",
+			func(msg *types.MsgPlaceMarketOrder) {
+				msg.Referrer = utils.TestAddress(2).String()
+			},
+			"",
+		},
+		{
+			"invalid referrer",
+			func(msg *types.MsgPlaceMarketOrder) {
+				msg.Referrer = "invalidaddr"
+			},
+			"invalid referrer address: decoding bech32 failed: invalid separator index -1: invalid address",
+		},
+		{
+			"self referral",
+			func(msg *types.MsgPlaceMarketOrder) {
+				msg.Referrer = msg.Sender
+			},
+			"referrer must not be the sender: invalid request",
+		},
 	} {
 		t.Run(tc.name, func(t *testing.T) {
 			senderAddr := utils.TestAddress(1)
@@ -461,6 +605,57 @@ func TestMsgSwapExactAmountIn(t *testing.T) {
 			},
 			"market id must not be 0: invalid request",
 		},
+		{
+			"weighted routes",
+			func(msg *types.MsgSwapExactAmountIn) {
+				msg.Routes = nil
+				msg.WeightedRoutes = []types.WeightedSwapRoute{
+					types.NewWeightedSwapRoute([]uint64{1, 2}, utils.ParseDec("0.6")),
+					types.NewWeightedSwapRoute([]uint64{3}, utils.ParseDec("0.4")),
+				}
+			},
+			"",
+		},
+		{
+			"both routes and weighted routes",
+			func(msg *types.MsgSwapExactAmountIn) {
+				msg.WeightedRoutes = []types.WeightedSwapRoute{
+					types.NewWeightedSwapRoute([]uint64{3}, utils.ParseDec("1")),
+				}
+			},
+			"routes and weighted routes cannot be set at the same time: invalid request",
+		},
+		{
+			"empty weighted route",
+			func(msg *types.MsgSwapExactAmountIn) {
+				msg.Routes = nil
+				msg.WeightedRoutes = []types.WeightedSwapRoute{
+					types.NewWeightedSwapRoute([]uint64{}, utils.ParseDec("1")),
+				}
+			},
+			"routes must not be empty: invalid request",
+		},
+		{
+			"invalid market id in weighted route",
+			func(msg *types.MsgSwapExactAmountIn) {
+				msg.Routes = nil
+				msg.WeightedRoutes = []types.WeightedSwapRoute{
+					types.NewWeightedSwapRoute([]uint64{1, 0}, utils.ParseDec("1")),
+				}
+			},
+			"market id must not be 0: invalid request",
+		},
+		{
+			"zero weight",
+			func(msg *types.MsgSwapExactAmountIn) {
+				msg.Routes = nil
+				msg.WeightedRoutes = []types.WeightedSwapRoute{
+					types.NewWeightedSwapRoute([]uint64{1, 2}, utils.ParseDec("1")),
+					types.NewWeightedSwapRoute([]uint64{3}, utils.ParseDec("0")),
+				}
+			},
+			"weight must be positive: 0.000000000000000000: invalid request",
+		},
 		{
 			"zero input",
 			func(msg *types.MsgSwapExactAmountIn) {
@@ -503,6 +698,27 @@ func TestMsgSwapExactAmountIn(t *testing.T) {
 			},
 			"min output amount must be integer: 50000000.010000000000000000uusd: invalid coins",
 		},
+		{
+			"valid referrer",
+			func(msg *types.MsgSwapExactAmountIn) {
+				msg.Referrer = utils.TestAddress(2).String()
+			},
+			"",
+		},
+		{
+			"invalid referrer",
+			func(msg *types.MsgSwapExactAmountIn) {
+				msg.Referrer = "invalidaddr"
+			},
+			"invalid referrer address: decoding bech32 failed: invalid separator index -1: invalid address",
+		},
+		{
+			"self referral",
+			func(msg *types.MsgSwapExactAmountIn) {
+				msg.Referrer = msg.Sender
+			},
+			"referrer must not be the sender: invalid request",
+		},
 	} {
 		t.Run(tc.name, func(t *testing.T) {
 			senderAddr := utils.TestAddress(1)
@@ -523,3 +739,384 @@ func TestMsgSwapExactAmountIn(t *testing.T) {
 		})
 	}
 }
+
+func TestMsgSwapExactAmountOut(t *testing.T) {
+	for _, tc := range []struct {
+		name        string
+		malleate    func(msg *types.MsgSwapExactAmountOut)
+		expectedErr string
+	}{
+		{
+			"valid",
+			func(msg *types.MsgSwapExactAmountOut) {},
+			"",
+		},
+		{
+			"invalid sender",
+			func(msg *types.MsgSwapExactAmountOut) {
+				msg.Sender = "invalidaddr"
+			},
+			"invalid sender address: decoding bech32 failed: invalid separator index -1: invalid address",
+		},
+		{
+			"empty routes",
+			func(msg *types.MsgSwapExactAmountOut) {
+				msg.Routes = []uint64{}
+			},
+			"routes must not be empty: invalid request",
+		},
+		{
+			"invalid market id",
+			func(msg *types.MsgSwapExactAmountOut) {
+				msg.Routes = []uint64{0, 1}
+			},
+			"market id must not be 0: invalid request",
+		},
+		{
+			"zero output",
+			func(msg *types.MsgSwapExactAmountOut) {
+				msg.Output = utils.ParseDecCoin("0uusd")
+			},
+			"output must be positive: 0.000000000000000000uusd: invalid coins",
+		},
+		{
+			"non-integer output",
+			func(msg *types.MsgSwapExactAmountOut) {
+				msg.Output = utils.ParseDecCoin("5000000.1uusd")
+			},
+			"output amount must be integer: 5000000.100000000000000000uusd: invalid coins",
+		},
+		{
+			"zero max input",
+			func(msg *types.MsgSwapExactAmountOut) {
+				msg.MaxInput = utils.ParseDecCoin("0ucre")
+			},
+			"max input must be positive: 0.000000000000000000ucre: invalid coins",
+		},
+		{
+			"negative max input",
+			func(msg *types.MsgSwapExactAmountOut) {
+				msg.MaxInput = sdk.DecCoin{Denom: "ucre", Amount: sdk.NewDec(-1000000)}
+			},
+			"invalid max input: decimal coin -1000000.000000000000000000ucre amount cannot be negative: invalid coins",
+		},
+		{
+			"non-integer max input",
+			func(msg *types.MsgSwapExactAmountOut) {
+				msg.MaxInput = utils.ParseDecCoin("1000000.01ucre")
+			},
+			"max input amount must be integer: 1000000.010000000000000000ucre: invalid coins",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			senderAddr := utils.TestAddress(1)
+			msg := types.NewMsgSwapExactAmountOut(
+				senderAddr, []uint64{1, 2, 3},
+				utils.ParseDecCoin("5000000uusd"), utils.ParseDecCoin("1000000ucre"))
+			require.NoError(t, msg.ValidateBasic())
+			require.Equal(t, types.RouterKey, msg.Route())
+			require.Equal(t, []sdk.AccAddress{senderAddr}, msg.GetSigners())
+			require.Equal(t, types.TypeMsgSwapExactAmountOut, msg.Type())
+			tc.malleate(msg)
+			err := msg.ValidateBasic()
+			if tc.expectedErr == "" {
+				require.NoError(t, err)
+			} else {
+				require.EqualError(t, err, tc.expectedErr)
+			}
+		})
+	}
+}
+
+func TestMsgPlaceTriggerOrder(t *testing.T) {
+	for _, tc := range []struct {
+		name        string
+		malleate    func(msg *types.MsgPlaceTriggerOrder)
+		expectedErr string
+	}{
+		{
+			"valid",
+			func(msg *types.MsgPlaceTriggerOrder) {},
+			"",
+		},
+		{
+			"valid limit order",
+			func(msg *types.MsgPlaceTriggerOrder) {
+				price := utils.ParseDec("4.4")
+				msg.Price = &price
+			},
+			"",
+		},
+		{
+			"invalid condition",
+			func(msg *types.MsgPlaceTriggerOrder) {
+				msg.Condition = types.TriggerConditionUnspecified
+			},
+			"invalid trigger condition: TRIGGER_CONDITION_UNSPECIFIED: invalid request",
+		},
+		{
+			"invalid trigger price tick",
+			func(msg *types.MsgPlaceTriggerOrder) {
+				msg.TriggerPrice = utils.ParseDec("4.50001")
+			},
+			"invalid trigger price tick: 4.500010000000000000: invalid request",
+		},
+		{
+			"invalid price tick",
+			func(msg *types.MsgPlaceTriggerOrder) {
+				price := utils.ParseDec("4.40001")
+				msg.Price = &price
+			},
+			"invalid price tick: 4.400010000000000000: invalid request",
+		},
+		{
+			"invalid market id",
+			func(msg *types.MsgPlaceTriggerOrder) {
+				msg.MarketId = 0
+			},
+			"market id must not be 0: invalid request",
+		},
+		{
+			"non-integer quantity",
+			func(msg *types.MsgPlaceTriggerOrder) {
+				msg.Quantity = utils.ParseDec("100.5")
+			},
+			"quantity must be an integer: 100.500000000000000000: invalid request",
+		},
+		{
+			"negative lifespan",
+			func(msg *types.MsgPlaceTriggerOrder) {
+				msg.Lifespan = -time.Hour
+			},
+			"lifespan must not be negative: -1h0m0s: invalid request",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			senderAddr := utils.TestAddress(1)
+			msg := types.NewMsgPlaceTriggerOrder(
+				senderAddr, 1, false, types.TriggerConditionPriceBelow,
+				utils.ParseDec("4.5"), nil, sdk.NewDec(100_000000), time.Hour)
+			require.NoError(t, msg.ValidateBasic())
+			require.Equal(t, types.RouterKey, msg.Route())
+			require.Equal(t, []sdk.AccAddress{senderAddr}, msg.GetSigners())
+			require.Equal(t, types.TypeMsgPlaceTriggerOrder, msg.Type())
+			tc.malleate(msg)
+			err := msg.ValidateBasic()
+			if tc.expectedErr == "" {
+				require.NoError(t, err)
+			} else {
+				require.EqualError(t, err, tc.expectedErr)
+			}
+		})
+	}
+}
+
+func TestMsgAmendOrder(t *testing.T) {
+	for _, tc := range []struct {
+		name        string
+		malleate    func(msg *types.MsgAmendOrder)
+		expectedErr string
+	}{
+		{
+			"valid",
+			func(msg *types.MsgAmendOrder) {},
+			"",
+		},
+		{
+			"invalid order id",
+			func(msg *types.MsgAmendOrder) {
+				msg.OrderId = 0
+			},
+			"order id must not be 0: invalid request",
+		},
+		{
+			"nothing to amend",
+			func(msg *types.MsgAmendOrder) {
+				msg.Quantity = nil
+			},
+			"at least one of price, quantity and lifespan must be set: invalid request",
+		},
+		{
+			"invalid price tick",
+			func(msg *types.MsgAmendOrder) {
+				price := utils.ParseDec("12.3456")
+				msg.Price = &price
+			},
+			"invalid price tick: 12.345600000000000000: invalid request",
+		},
+		{
+			"non-integer quantity",
+			func(msg *types.MsgAmendOrder) {
+				qty := utils.ParseDec("100.5")
+				msg.Quantity = &qty
+			},
+			"quantity must be an integer: 100.500000000000000000: invalid request",
+		},
+		{
+			"negative lifespan",
+			func(msg *types.MsgAmendOrder) {
+				lifespan := -time.Hour
+				msg.Lifespan = &lifespan
+			},
+			"lifespan must not be negative: -1h0m0s: invalid request",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			senderAddr := utils.TestAddress(1)
+			qty := sdk.NewDec(1000000)
+			msg := types.NewMsgAmendOrder(senderAddr, 1, nil, &qty, nil)
+			require.NoError(t, msg.ValidateBasic())
+			require.Equal(t, types.RouterKey, msg.Route())
+			require.Equal(t, []sdk.AccAddress{senderAddr}, msg.GetSigners())
+			require.Equal(t, types.TypeMsgAmendOrder, msg.Type())
+			tc.malleate(msg)
+			err := msg.ValidateBasic()
+			if tc.expectedErr == "" {
+				require.NoError(t, err)
+			} else {
+				require.EqualError(t, err, tc.expectedErr)
+			}
+		})
+	}
+}
+
+func TestMsgSetCancelAfter(t *testing.T) {
+	for _, tc := range []struct {
+		name        string
+		malleate    func(msg *types.MsgSetCancelAfter)
+		expectedErr string
+	}{
+		{
+			"valid",
+			func(msg *types.MsgSetCancelAfter) {},
+			"",
+		},
+		{
+			"remove timers",
+			func(msg *types.MsgSetCancelAfter) {
+				msg.CancelAfter = nil
+			},
+			"",
+		},
+		{
+			"invalid sender",
+			func(msg *types.MsgSetCancelAfter) {
+				msg.Sender = "invalidaddr"
+			},
+			"invalid sender address: decoding bech32 failed: invalid separator index -1: invalid address",
+		},
+		{
+			"empty market ids",
+			func(msg *types.MsgSetCancelAfter) {
+				msg.MarketIds = nil
+			},
+			"market ids must not be empty: invalid request",
+		},
+		{
+			"invalid market id",
+			func(msg *types.MsgSetCancelAfter) {
+				msg.MarketIds = []uint64{1, 0}
+			},
+			"market id must not be 0: invalid request",
+		},
+		{
+			"duplicate market id",
+			func(msg *types.MsgSetCancelAfter) {
+				msg.MarketIds = []uint64{1, 2, 1}
+			},
+			"duplicate market id: 1: invalid request",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			senderAddr := utils.TestAddress(1)
+			cancelAfter := utils.ParseTime("2023-01-01T00:00:00Z")
+			msg := types.NewMsgSetCancelAfter(senderAddr, []uint64{1, 2}, &cancelAfter)
+			require.NoError(t, msg.ValidateBasic())
+			require.Equal(t, types.RouterKey, msg.Route())
+			require.Equal(t, []sdk.AccAddress{senderAddr}, msg.GetSigners())
+			require.Equal(t, types.TypeMsgSetCancelAfter, msg.Type())
+			tc.malleate(msg)
+			err := msg.ValidateBasic()
+			if tc.expectedErr == "" {
+				require.NoError(t, err)
+			} else {
+				require.EqualError(t, err, tc.expectedErr)
+			}
+		})
+	}
+}
+
+func TestMsgReplaceMMOrders(t *testing.T) {
+	for _, tc := range []struct {
+		name        string
+		malleate    func(msg *types.MsgReplaceMMOrders)
+		expectedErr string
+	}{
+		{
+			"valid",
+			func(msg *types.MsgReplaceMMOrders) {},
+			"",
+		},
+		{
+			"no orders",
+			func(msg *types.MsgReplaceMMOrders) {
+				msg.Orders = nil
+			},
+			"",
+		},
+		{
+			"invalid sender",
+			func(msg *types.MsgReplaceMMOrders) {
+				msg.Sender = "invalidaddr"
+			},
+			"invalid sender address: decoding bech32 failed: invalid separator index -1: invalid address",
+		},
+		{
+			"invalid market id",
+			func(msg *types.MsgReplaceMMOrders) {
+				msg.MarketId = 0
+			},
+			"market id must not be 0: invalid request",
+		},
+		{
+			"invalid price tick",
+			func(msg *types.MsgReplaceMMOrders) {
+				msg.Orders[1].Price = utils.ParseDec("12.3456")
+			},
+			"invalid order 1: invalid price tick: 12.345600000000000000: invalid request",
+		},
+		{
+			"non-positive quantity",
+			func(msg *types.MsgReplaceMMOrders) {
+				msg.Orders[0].Quantity = utils.ZeroDec
+			},
+			"invalid order 0: quantity must be positive: 0.000000000000000000: invalid request",
+		},
+		{
+			"invalid self-trade prevention",
+			func(msg *types.MsgReplaceMMOrders) {
+				msg.Orders[1].SelfTradePrevention = 10
+			},
+			"invalid order 1: invalid self-trade prevention: 10: invalid request",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			senderAddr := utils.TestAddress(1)
+			msg := types.NewMsgReplaceMMOrders(senderAddr, 1, []types.MMOrderParameters{
+				types.NewMMOrderParameters(true, utils.ParseDec("14.9"), sdk.NewDec(1000000), time.Hour, types.SelfTradePreventionUnspecified),
+				types.NewMMOrderParameters(false, utils.ParseDec("15.1"), sdk.NewDec(1000000), time.Hour, types.SelfTradePreventionUnspecified),
+			})
+			require.NoError(t, msg.ValidateBasic())
+			require.Equal(t, types.RouterKey, msg.Route())
+			require.Equal(t, []sdk.AccAddress{senderAddr}, msg.GetSigners())
+			require.Equal(t, types.TypeMsgReplaceMMOrders, msg.Type())
+			tc.malleate(msg)
+			err := msg.ValidateBasic()
+			if tc.expectedErr == "" {
+				require.NoError(t, err)
+			} else {
+				require.EqualError(t, err, tc.expectedErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,65 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MaxNumPriceObservations is the size of each market's ring of price
+// observations.
+// At most one observation is recorded per block, so the longest TWAP window
+// available is roughly MaxNumPriceObservations blocks.
+const MaxNumPriceObservations uint32 = 720
+
+func NewPriceObservation(t time.Time, cumulativePrice, price sdk.Dec, height int64) PriceObservation {
+	return PriceObservation{
+		Time:            t,
+		CumulativePrice: cumulativePrice,
+		Price:           price,
+		Height:          height,
+	}
+}
+
+// CumulativePriceAt returns the cumulative price at t, assuming that the price
+// has not changed since the observation.
+func (obs PriceObservation) CumulativePriceAt(t time.Time) sdk.Dec {
+	return obs.CumulativePrice.Add(obs.Price.Mul(DurationToSeconds(t.Sub(obs.Time))))
+}
+
+func (obs PriceObservation) Validate() error {
+	if obs.CumulativePrice.IsNil() || obs.CumulativePrice.IsNegative() {
+		return fmt.Errorf("cumulative price must not be negative: %s", obs.CumulativePrice)
+	}
+	if obs.Price.IsNil() || !obs.Price.IsPositive() {
+		return fmt.Errorf("price must be positive: %s", obs.Price)
+	}
+	if obs.Height < 0 {
+		return fmt.Errorf("height must not be negative: %d", obs.Height)
+	}
+	return nil
+}
+
+// NextPriceObservationIndex returns the ring index where the next price
+// observation should be stored.
+func NextPriceObservationIndex(marketState MarketState) uint32 {
+	if marketState.NumPriceObservations == 0 {
+		return 0
+	}
+	return (marketState.LastPriceObservationIndex + 1) % MaxNumPriceObservations
+}
+
+// PriceObservationIndex returns the ring index of the i-th oldest price
+// observation.
+func PriceObservationIndex(marketState MarketState, i uint32) uint32 {
+	if marketState.NumPriceObservations < MaxNumPriceObservations {
+		return i
+	}
+	return (marketState.LastPriceObservationIndex + 1 + i) % MaxNumPriceObservations
+}
+
+// DurationToSeconds returns the duration in seconds as sdk.Dec.
+func DurationToSeconds(d time.Duration) sdk.Dec {
+	return sdk.NewDecFromIntWithPrec(sdk.NewInt(d.Nanoseconds()), 9)
+}
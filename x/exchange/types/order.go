@@ -9,22 +9,32 @@ import (
 	utils "github.com/crescent-network/crescent/v5/types"
 )
 
+// MaxNumBatchMatchingRounds is the maximum number of matching rounds in a batch
+// matching. A new round runs only when iceberg orders have been replenished in
+// the previous round.
+const MaxNumBatchMatchingRounds = 10
+
 func NewOrder(
 	orderId uint64, typ OrderType, ordererAddr sdk.AccAddress, marketId uint64,
 	isBuy bool, price, qty sdk.Dec, msgHeight int64,
-	openQty, remainingDeposit sdk.Dec, deadline time.Time) Order {
+	openQty, remainingDeposit sdk.Dec, deadline time.Time, timeInForce TimeInForce,
+	selfTradePrevention SelfTradePrevention) Order {
 	return Order{
-		Id:               orderId,
-		Type:             typ,
-		Orderer:          ordererAddr.String(),
-		MarketId:         marketId,
-		IsBuy:            isBuy,
-		Price:            price,
-		Quantity:         qty,
-		MsgHeight:        msgHeight,
-		OpenQuantity:     openQty,
-		RemainingDeposit: remainingDeposit,
-		Deadline:         deadline,
+		Id:                  orderId,
+		Type:                typ,
+		Orderer:             ordererAddr.String(),
+		MarketId:            marketId,
+		IsBuy:               isBuy,
+		Price:               price,
+		Quantity:            qty,
+		MsgHeight:           msgHeight,
+		OpenQuantity:        openQty,
+		RemainingDeposit:    remainingDeposit,
+		Deadline:            deadline,
+		TimeInForce:         timeInForce,
+		SelfTradePrevention: selfTradePrevention,
+		HiddenQuantity:      utils.ZeroDec,
+		PriorityHeight:      msgHeight,
 	}
 }
 
@@ -59,9 +69,46 @@ func (order Order) Validate() error {
 	if !order.RemainingDeposit.IsPositive() {
 		return fmt.Errorf("remaining deposit must be positive: %s", order.RemainingDeposit)
 	}
+	// Immediate-or-cancel and fill-or-kill orders never rest on the order book.
+	if order.TimeInForce != TimeInForceGoodTilTime && order.TimeInForce != TimeInForcePostOnly {
+		return fmt.Errorf("invalid time in force: %v", order.TimeInForce)
+	}
+	if err := ValidateSelfTradePrevention(order.SelfTradePrevention); err != nil {
+		return err
+	}
+	if order.Referrer != "" {
+		if _, err := sdk.AccAddressFromBech32(order.Referrer); err != nil {
+			return fmt.Errorf("invalid referrer address: %w", err)
+		}
+	}
+	if order.HiddenQuantity.IsNil() || order.HiddenQuantity.IsNegative() {
+		return fmt.Errorf("hidden quantity must not be negative: %s", order.HiddenQuantity)
+	}
+	if order.HiddenQuantity.GT(order.OpenQuantity) {
+		return fmt.Errorf(
+			"hidden quantity must not be greater than open quantity: %s > %s", order.HiddenQuantity, order.OpenQuantity)
+	}
+	if order.DisplayQuantity == nil {
+		if !order.HiddenQuantity.IsZero() {
+			return fmt.Errorf("hidden quantity must be zero for non-iceberg orders: %s", order.HiddenQuantity)
+		}
+	} else if err := ValidateDisplayQuantity(*order.DisplayQuantity, order.Quantity); err != nil {
+		return err
+	}
 	return nil
 }
 
+// IsIceberg returns whether the order is an iceberg order.
+func (order Order) IsIceberg() bool {
+	return order.DisplayQuantity != nil
+}
+
+// VisibleQuantity returns the open quantity of the order displayed on the
+// order book, which excludes the hidden reserve of iceberg orders.
+func (order Order) VisibleQuantity() sdk.Dec {
+	return order.OpenQuantity.Sub(order.HiddenQuantity)
+}
+
 func (order Order) ExecutableQuantity() sdk.Dec {
 	if order.IsBuy {
 		return sdk.MinDec(
@@ -71,6 +118,14 @@ func (order Order) ExecutableQuantity() sdk.Dec {
 	return sdk.MinDec(order.OpenQuantity, order.RemainingDeposit)
 }
 
+// NewReferrerStats returns a new ReferrerStats.
+func NewReferrerStats(volume sdk.Dec, fees sdk.DecCoins) ReferrerStats {
+	return ReferrerStats{
+		Volume: volume,
+		Fees:   fees,
+	}
+}
+
 func (order Order) MustGetOrdererAddress() sdk.AccAddress {
 	return sdk.MustAccAddressFromBech32(order.Orderer)
 }
@@ -82,19 +137,81 @@ type ExecuteOrderResult struct {
 	Paid             sdk.DecCoin
 	Received         sdk.DecCoin
 	Fee              sdk.DecCoin
-	FullyExecuted    bool
+	// ReferralFee is the share of Fee paid to the referrer of the order.
+	ReferralFee   sdk.DecCoin
+	FullyExecuted bool
+	// SelfTradePrevented is true when the rest of the order has been canceled
+	// by self-trade prevention.
+	SelfTradePrevented bool
+	// DecrementedQuantity is the quantity of the order decremented by
+	// self-trade prevention.
+	DecrementedQuantity sdk.Dec
 }
 
 func NewExecuteOrderResult(payDenom, receiveDenom string) ExecuteOrderResult {
 	return ExecuteOrderResult{
-		ExecutedQuantity: utils.ZeroDec,
-		ExecutedQuote:    utils.ZeroDec,
-		Paid:             sdk.NewDecCoin(payDenom, utils.ZeroInt),
-		Received:         sdk.NewDecCoin(receiveDenom, utils.ZeroInt),
-		Fee:              sdk.NewDecCoin(receiveDenom, utils.ZeroInt), // always taker
+		ExecutedQuantity:    utils.ZeroDec,
+		ExecutedQuote:       utils.ZeroDec,
+		Paid:                sdk.NewDecCoin(payDenom, utils.ZeroInt),
+		Received:            sdk.NewDecCoin(receiveDenom, utils.ZeroInt),
+		Fee:                 sdk.NewDecCoin(receiveDenom, utils.ZeroInt), // always taker
+		ReferralFee:         sdk.NewDecCoin(receiveDenom, utils.ZeroInt),
+		DecrementedQuantity: utils.ZeroDec,
 	}
 }
 
 func (res ExecuteOrderResult) Executed() bool {
 	return !res.LastPrice.IsNil()
 }
+
+// Add accumulates the result of a subsequent execution of the same order.
+func (res *ExecuteOrderResult) Add(other ExecuteOrderResult) {
+	if other.Executed() {
+		res.LastPrice = other.LastPrice
+	}
+	res.ExecutedQuantity = res.ExecutedQuantity.Add(other.ExecutedQuantity)
+	res.ExecutedQuote = res.ExecutedQuote.Add(other.ExecutedQuote)
+	res.Paid = res.Paid.Add(other.Paid)
+	res.Received = res.Received.Add(other.Received)
+	res.Fee = res.Fee.Add(other.Fee)
+	res.ReferralFee = res.ReferralFee.Add(other.ReferralFee)
+	res.FullyExecuted = other.FullyExecuted
+	res.SelfTradePrevented = other.SelfTradePrevented
+	res.DecrementedQuantity = res.DecrementedQuantity.Add(other.DecrementedQuantity)
+}
+
+// ValidateTimeInForce validates the time in force of an order.
+// Batch orders are not executed on placement, so immediate-or-cancel and
+// fill-or-kill are not allowed for them.
+func ValidateTimeInForce(timeInForce TimeInForce, isBatch bool) error {
+	switch timeInForce {
+	case TimeInForceGoodTilTime, TimeInForcePostOnly:
+		return nil
+	case TimeInForceImmediateOrCancel, TimeInForceFillOrKill:
+		if isBatch {
+			return fmt.Errorf("%s is not allowed for batch orders", timeInForce)
+		}
+		return nil
+	default:
+		return fmt.Errorf("invalid time in force: %v", timeInForce)
+	}
+}
+
+// ValidateDisplayQuantity validates the display quantity of an iceberg order.
+func ValidateDisplayQuantity(displayQty, qty sdk.Dec) error {
+	if !displayQty.IsPositive() {
+		return fmt.Errorf("display quantity must be positive: %s", displayQty)
+	}
+	if displayQty.GT(qty) {
+		return fmt.Errorf("display quantity must not be greater than quantity: %s > %s", displayQty, qty)
+	}
+	return nil
+}
+
+// ValidateSelfTradePrevention validates the self-trade prevention mode.
+func ValidateSelfTradePrevention(stp SelfTradePrevention) error {
+	if _, ok := SelfTradePrevention_name[int32(stp)]; !ok {
+		return fmt.Errorf("invalid self-trade prevention: %v", stp)
+	}
+	return nil
+}
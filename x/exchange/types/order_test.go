@@ -122,13 +122,53 @@ func TestOrder_Validate(t *testing.T) {
 			},
 			"remaining deposit must be positive: -50000000.000000000000000000",
 		},
+		{
+			"immediate-or-cancel",
+			func(order *types.Order) {
+				order.TimeInForce = types.TimeInForceImmediateOrCancel
+			},
+			"invalid time in force: TIME_IN_FORCE_IMMEDIATE_OR_CANCEL",
+		},
+		{
+			"iceberg order",
+			func(order *types.Order) {
+				displayQty := sdk.NewDec(10_000000)
+				order.DisplayQuantity = &displayQty
+				order.HiddenQuantity = sdk.NewDec(40_000000)
+			},
+			"",
+		},
+		{
+			"hidden quantity without display quantity",
+			func(order *types.Order) {
+				order.HiddenQuantity = sdk.NewDec(40_000000)
+			},
+			"hidden quantity must be zero for non-iceberg orders: 40000000.000000000000000000",
+		},
+		{
+			"too large hidden quantity",
+			func(order *types.Order) {
+				displayQty := sdk.NewDec(10_000000)
+				order.DisplayQuantity = &displayQty
+				order.HiddenQuantity = sdk.NewDec(60_000000)
+			},
+			"hidden quantity must not be greater than open quantity: 60000000.000000000000000000 > 50000000.000000000000000000",
+		},
+		{
+			"too large display quantity",
+			func(order *types.Order) {
+				displayQty := sdk.NewDec(200_000000)
+				order.DisplayQuantity = &displayQty
+			},
+			"display quantity must not be greater than quantity: 200000000.000000000000000000 > 100000000.000000000000000000",
+		},
 	} {
 		t.Run(tc.name, func(t *testing.T) {
 			order := types.NewOrder(
 				1, types.OrderTypeLimit, utils.TestAddress(1), 1, false,
 				utils.ParseDec("2"), sdk.NewDec(100_000000), 100,
 				sdk.NewDec(50_000000), sdk.NewDec(50_000000),
-				utils.ParseTime("2023-06-01T00:00:00Z"))
+				utils.ParseTime("2023-06-01T00:00:00Z"), types.TimeInForceGoodTilTime, types.SelfTradePreventionUnspecified)
 			tc.malleate(&order)
 			err := order.Validate()
 			if tc.expectedErr == "" {
@@ -182,7 +222,8 @@ func TestOrder_ExecutableQuantity(t *testing.T) {
 			order := types.NewOrder(
 				1, types.OrderTypeLimit, utils.TestAddress(1), 1, tc.isBuy,
 				utils.ParseDec("1.2345"), tc.openQty, 100,
-				tc.openQty, tc.remainingDeposit, utils.ParseTime("2023-06-01T00:00:00Z"))
+				tc.openQty, tc.remainingDeposit, utils.ParseTime("2023-06-01T00:00:00Z"),
+				types.TimeInForceGoodTilTime, types.SelfTradePreventionUnspecified)
 			executableQty := order.ExecutableQuantity()
 			require.Equal(t, tc.executableQty, executableQty)
 		})
@@ -19,6 +19,7 @@ var (
 	KeyMaxOrderPriceRatio = []byte("MaxOrderPriceRatio")
 	KeyMaxSwapRoutesLen   = []byte("MaxSwapRoutesLen")
 	KeyMaxNumMMOrders     = []byte("MaxNumMMOrders")
+	KeyCircuitBreaker     = []byte("CircuitBreaker")
 )
 
 var (
@@ -27,11 +28,18 @@ var (
 		DefaultMakerFeeRate:        sdk.NewDecWithPrec(15, 4), // 0.15%
 		DefaultTakerFeeRate:        sdk.NewDecWithPrec(3, 3),  // 0.3%
 		DefaultOrderSourceFeeRatio: sdk.NewDecWithPrec(5, 1),  // 50%
+		ReferralFeeRatio:           utils.ZeroDec,
 	}
 	DefaultMaxOrderLifespan          = 7 * 24 * time.Hour
 	DefaultMaxOrderPriceRatio        = sdk.NewDecWithPrec(1, 1) // 10%
 	DefaultMaxSwapRoutesLen   uint32 = 3
 	DefaultMaxNumMMOrders     uint32 = 15
+	DefaultCircuitBreaker            = CircuitBreaker{
+		PriceBandRatio: utils.ZeroDec, // disabled
+		Window:         100,
+		Duration:       50,
+		Mode:           CircuitBreakerModeAuction,
+	}
 
 	MinPrice = sdk.NewDecWithPrec(1, 14)
 	MaxPrice = sdk.NewDecFromInt(sdk.NewIntWithDecimal(1, 40))
@@ -43,7 +51,7 @@ func ParamKeyTable() paramstypes.KeyTable {
 
 func NewParams(
 	marketCreationFee sdk.Coins, fees Fees, maxOrderLifespan time.Duration, maxOrderPriceRatio sdk.Dec,
-	maxSwapRoutesLen, maxNumMMOrders uint32) Params {
+	maxSwapRoutesLen, maxNumMMOrders uint32, circuitBreaker CircuitBreaker) Params {
 	return Params{
 		MarketCreationFee:  marketCreationFee,
 		Fees:               fees,
@@ -51,6 +59,7 @@ func NewParams(
 		MaxOrderPriceRatio: maxOrderPriceRatio,
 		MaxSwapRoutesLen:   maxSwapRoutesLen,
 		MaxNumMMOrders:     maxNumMMOrders,
+		CircuitBreaker:     circuitBreaker,
 	}
 }
 
@@ -58,7 +67,7 @@ func NewParams(
 func DefaultParams() Params {
 	return NewParams(
 		DefaultMarketCreationFee, DefaultFees, DefaultMaxOrderLifespan, DefaultMaxOrderPriceRatio,
-		DefaultMaxSwapRoutesLen, DefaultMaxNumMMOrders)
+		DefaultMaxSwapRoutesLen, DefaultMaxNumMMOrders, DefaultCircuitBreaker)
 }
 
 // ParamSetPairs implements ParamSet.
@@ -70,6 +79,7 @@ func (params *Params) ParamSetPairs() paramstypes.ParamSetPairs {
 		paramstypes.NewParamSetPair(KeyMaxOrderPriceRatio, &params.MaxOrderPriceRatio, validateMaxOrderPriceRatio),
 		paramstypes.NewParamSetPair(KeyMaxSwapRoutesLen, &params.MaxSwapRoutesLen, validateMaxSwapRoutesLen),
 		paramstypes.NewParamSetPair(KeyMaxNumMMOrders, &params.MaxNumMMOrders, validateMaxNumMMOrders),
+		paramstypes.NewParamSetPair(KeyCircuitBreaker, &params.CircuitBreaker, validateCircuitBreaker),
 	}
 }
 
@@ -85,6 +95,7 @@ func (params Params) Validate() error {
 		{params.MaxOrderPriceRatio, validateMaxOrderPriceRatio},
 		{params.MaxSwapRoutesLen, validateMaxSwapRoutesLen},
 		{params.MaxNumMMOrders, validateMaxNumMMOrders},
+		{params.CircuitBreaker, validateCircuitBreaker},
 	} {
 		if err := field.validateFunc(field.val); err != nil {
 			return err
@@ -109,7 +120,7 @@ func validateFees(i interface{}) error {
 	if !ok {
 		return fmt.Errorf("invalid parameter type: %T", i)
 	}
-	return ValidateFees(v.DefaultMakerFeeRate, v.DefaultTakerFeeRate, v.DefaultOrderSourceFeeRatio)
+	return v.Validate()
 }
 
 func validateMaxOrderLifespan(i interface{}) error {
@@ -151,3 +162,11 @@ func validateMaxNumMMOrders(i interface{}) error {
 	}
 	return nil
 }
+
+func validateCircuitBreaker(i interface{}) error {
+	v, ok := i.(CircuitBreaker)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return v.Validate()
+}
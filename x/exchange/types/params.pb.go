@@ -29,6 +29,36 @@ var _ = time.Kitchen
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// CircuitBreakerMode specifies which operations are allowed in a market while
+// its circuit breaker is tripped.
+type CircuitBreakerMode int32
+
+const (
+	// CIRCUIT_BREAKER_MODE_CANCEL_ONLY allows only cancelling orders.
+	CircuitBreakerModeCancelOnly CircuitBreakerMode = 0
+	// CIRCUIT_BREAKER_MODE_AUCTION accepts new limit orders without executing
+	// them, so they can be matched in the auction reopening the market.
+	CircuitBreakerModeAuction CircuitBreakerMode = 1
+)
+
+var CircuitBreakerMode_name = map[int32]string{
+	0: "CIRCUIT_BREAKER_MODE_CANCEL_ONLY",
+	1: "CIRCUIT_BREAKER_MODE_AUCTION",
+}
+
+var CircuitBreakerMode_value = map[string]int32{
+	"CIRCUIT_BREAKER_MODE_CANCEL_ONLY": 0,
+	"CIRCUIT_BREAKER_MODE_AUCTION":     1,
+}
+
+func (x CircuitBreakerMode) String() string {
+	return proto.EnumName(CircuitBreakerMode_name, int32(x))
+}
+
+func (CircuitBreakerMode) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_194713c21235dedc, []int{0}
+}
+
 type Params struct {
 	MarketCreationFee github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=market_creation_fee,json=marketCreationFee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"market_creation_fee"`
 	Fees              Fees                                     `protobuf:"bytes,2,opt,name=fees,proto3" json:"fees"`
@@ -37,6 +67,9 @@ type Params struct {
 	MaxOrderPriceRatio github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=max_order_price_ratio,json=maxOrderPriceRatio,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_order_price_ratio"`
 	MaxSwapRoutesLen   uint32                                 `protobuf:"varint,5,opt,name=max_swap_routes_len,json=maxSwapRoutesLen,proto3" json:"max_swap_routes_len,omitempty"`
 	MaxNumMMOrders     uint32                                 `protobuf:"varint,6,opt,name=max_num_mm_orders,json=maxNumMmOrders,proto3" json:"max_num_mm_orders,omitempty"`
+	// circuit_breaker defines the price-band circuit breaker applied to every
+	// market.
+	CircuitBreaker CircuitBreaker `protobuf:"bytes,7,opt,name=circuit_breaker,json=circuitBreaker,proto3" json:"circuit_breaker"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -76,6 +109,14 @@ type Fees struct {
 	DefaultMakerFeeRate        github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=default_maker_fee_rate,json=defaultMakerFeeRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"default_maker_fee_rate"`
 	DefaultTakerFeeRate        github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=default_taker_fee_rate,json=defaultTakerFeeRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"default_taker_fee_rate"`
 	DefaultOrderSourceFeeRatio github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=default_order_source_fee_ratio,json=defaultOrderSourceFeeRatio,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"default_order_source_fee_ratio"`
+	// volume_denom is the quote denom of markets whose trading volume is
+	// tracked per account for fee tiers. Volume is not tracked if empty.
+	VolumeDenom string `protobuf:"bytes,4,opt,name=volume_denom,json=volumeDenom,proto3" json:"volume_denom,omitempty"`
+	// fee_tiers is the list of fee tiers sorted by their minimum volume.
+	FeeTiers []FeeTier `protobuf:"bytes,5,rep,name=fee_tiers,json=feeTiers,proto3" json:"fee_tiers"`
+	// referral_fee_ratio is the share of the taker fee paid to the referrer of
+	// an order.
+	ReferralFeeRatio github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=referral_fee_ratio,json=referralFeeRatio,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"referral_fee_ratio"`
 }
 
 func (m *Fees) Reset()         { *m = Fees{} }
@@ -111,9 +152,105 @@ func (m *Fees) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Fees proto.InternalMessageInfo
 
+// FeeTier defines discounted fee rates applied to accounts whose trailing
+// 30-day volume and holdings satisfy the tier's conditions.
+type FeeTier struct {
+	// min_volume is the minimum trailing 30-day volume in the volume denom.
+	MinVolume github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=min_volume,json=minVolume,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"min_volume"`
+	// min_holdings, if not empty, requires an account to hold at least one of
+	// the coins, e.g. CRE or bCRE.
+	MinHoldings github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=min_holdings,json=minHoldings,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"min_holdings"`
+	// maker_fee_rate can be negative to give maker rebates.
+	MakerFeeRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=maker_fee_rate,json=makerFeeRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"maker_fee_rate"`
+	TakerFeeRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=taker_fee_rate,json=takerFeeRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"taker_fee_rate"`
+}
+
+func (m *FeeTier) Reset()         { *m = FeeTier{} }
+func (m *FeeTier) String() string { return proto.CompactTextString(m) }
+func (*FeeTier) ProtoMessage()    {}
+func (*FeeTier) Descriptor() ([]byte, []int) {
+	return fileDescriptor_194713c21235dedc, []int{2}
+}
+func (m *FeeTier) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FeeTier) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FeeTier.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FeeTier) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeeTier.Merge(m, src)
+}
+func (m *FeeTier) XXX_Size() int {
+	return m.Size()
+}
+func (m *FeeTier) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeeTier.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeeTier proto.InternalMessageInfo
+
+// CircuitBreaker defines when a market's circuit breaker trips and how the
+// market behaves while the circuit breaker is tripped.
+type CircuitBreaker struct {
+	// price_band_ratio is the maximum ratio the last price can move away from
+	// any price within the window. Zero disables the circuit breaker.
+	PriceBandRatio github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=price_band_ratio,json=priceBandRatio,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"price_band_ratio"`
+	// window is the number of recent blocks whose prices are compared against
+	// the new last price.
+	Window uint32 `protobuf:"varint,2,opt,name=window,proto3" json:"window,omitempty"`
+	// duration is the number of blocks the circuit breaker stays tripped.
+	Duration uint32             `protobuf:"varint,3,opt,name=duration,proto3" json:"duration,omitempty"`
+	Mode     CircuitBreakerMode `protobuf:"varint,4,opt,name=mode,proto3,enum=crescent.exchange.v1beta1.CircuitBreakerMode" json:"mode,omitempty"`
+}
+
+func (m *CircuitBreaker) Reset()         { *m = CircuitBreaker{} }
+func (m *CircuitBreaker) String() string { return proto.CompactTextString(m) }
+func (*CircuitBreaker) ProtoMessage()    {}
+func (*CircuitBreaker) Descriptor() ([]byte, []int) {
+	return fileDescriptor_194713c21235dedc, []int{3}
+}
+func (m *CircuitBreaker) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CircuitBreaker) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CircuitBreaker.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CircuitBreaker) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CircuitBreaker.Merge(m, src)
+}
+func (m *CircuitBreaker) XXX_Size() int {
+	return m.Size()
+}
+func (m *CircuitBreaker) XXX_DiscardUnknown() {
+	xxx_messageInfo_CircuitBreaker.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CircuitBreaker proto.InternalMessageInfo
+
 func init() {
+	proto.RegisterEnum("crescent.exchange.v1beta1.CircuitBreakerMode", CircuitBreakerMode_name, CircuitBreakerMode_value)
 	proto.RegisterType((*Params)(nil), "crescent.exchange.v1beta1.Params")
 	proto.RegisterType((*Fees)(nil), "crescent.exchange.v1beta1.Fees")
+	proto.RegisterType((*FeeTier)(nil), "crescent.exchange.v1beta1.FeeTier")
+	proto.RegisterType((*CircuitBreaker)(nil), "crescent.exchange.v1beta1.CircuitBreaker")
 }
 
 func init() {
@@ -121,43 +258,64 @@ func init() {
 }
 
 var fileDescriptor_194713c21235dedc = []byte{
-	// 561 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x94, 0xcf, 0x6e, 0xd3, 0x4c,
-	0x14, 0xc5, 0xe3, 0x24, 0x5f, 0xf4, 0xe1, 0x8a, 0x8a, 0xba, 0x80, 0x9c, 0x2c, 0xec, 0xa8, 0x8b,
-	0x2a, 0x9b, 0x8c, 0x69, 0x11, 0x12, 0x2c, 0xd8, 0xb8, 0x55, 0x56, 0x0d, 0x14, 0x17, 0xb1, 0x60,
-	0x63, 0x4d, 0x26, 0x37, 0xae, 0x95, 0x8c, 0xc7, 0x9a, 0x19, 0x37, 0x46, 0xbc, 0x04, 0x4b, 0x9e,
-	0x81, 0xe7, 0x60, 0x91, 0x65, 0x97, 0x88, 0x45, 0x0a, 0x09, 0x0f, 0x82, 0x66, 0x6c, 0x93, 0xb0,
-	0x40, 0xe2, 0xcf, 0xca, 0x1e, 0xcf, 0x3d, 0xe7, 0x77, 0xef, 0x99, 0x91, 0xcd, 0x43, 0xc2, 0x41,
-	0x10, 0x48, 0xa4, 0x07, 0x39, 0xb9, 0xc4, 0x49, 0x04, 0xde, 0xd5, 0xd1, 0x08, 0x24, 0x3e, 0xf2,
-	0x52, 0xcc, 0x31, 0x15, 0x28, 0xe5, 0x4c, 0x32, 0xab, 0x5d, 0xd5, 0xa1, 0xaa, 0x0e, 0x95, 0x75,
-	0x9d, 0xbb, 0x11, 0x8b, 0x98, 0xae, 0xf2, 0xd4, 0x5b, 0x21, 0xe8, 0x38, 0x11, 0x63, 0xd1, 0x0c,
-	0x3c, 0xbd, 0x1a, 0x65, 0x13, 0x6f, 0x9c, 0x71, 0x2c, 0x63, 0x96, 0x54, 0xfb, 0x84, 0x09, 0xca,
-	0x84, 0x37, 0xc2, 0x62, 0x83, 0x24, 0x2c, 0x2e, 0xf7, 0x0f, 0xbe, 0x35, 0xcc, 0xd6, 0xb9, 0xee,
-	0xc0, 0x7a, 0x6b, 0xee, 0x53, 0xcc, 0xa7, 0x20, 0x43, 0xc2, 0x41, 0x7b, 0x84, 0x13, 0x00, 0xdb,
-	0xe8, 0x36, 0x7a, 0x3b, 0xc7, 0x6d, 0x54, 0x18, 0x21, 0x65, 0x54, 0xf5, 0x84, 0x4e, 0x58, 0x9c,
-	0xf8, 0x0f, 0x16, 0x4b, 0xb7, 0xf6, 0xe1, 0xc6, 0xed, 0x45, 0xb1, 0xbc, 0xcc, 0x46, 0x88, 0x30,
-	0xea, 0x95, 0xd4, 0xe2, 0xd1, 0x17, 0xe3, 0xa9, 0x27, 0xdf, 0xa4, 0x20, 0xb4, 0x40, 0x04, 0x7b,
-	0x05, 0xe7, 0xa4, 0xc4, 0x0c, 0x00, 0xac, 0x27, 0x66, 0x73, 0x02, 0x20, 0xec, 0x7a, 0xd7, 0xe8,
-	0xed, 0x1c, 0xbb, 0xe8, 0x97, 0x39, 0xa0, 0x01, 0x80, 0xf0, 0x9b, 0x8a, 0x19, 0x68, 0x89, 0xf5,
-	0xc2, 0xb4, 0x28, 0xce, 0x43, 0xc6, 0xc7, 0xc0, 0xc3, 0x59, 0x3c, 0x01, 0x91, 0xe2, 0xc4, 0x6e,
-	0x68, 0xa3, 0x36, 0x2a, 0xf2, 0x41, 0x55, 0x3e, 0xe8, 0xb4, 0xcc, 0xc7, 0xff, 0x5f, 0x59, 0xbc,
-	0xbf, 0x71, 0x8d, 0xe0, 0x0e, 0xc5, 0xf9, 0x73, 0xa5, 0x3e, 0x2b, 0xc5, 0x16, 0x36, 0xef, 0x6d,
-	0x2c, 0x53, 0x1e, 0x13, 0x08, 0xb5, 0xca, 0x6e, 0x76, 0x8d, 0xde, 0x2d, 0x1f, 0x29, 0xe9, 0xe7,
-	0xa5, 0x7b, 0xf8, 0x1b, 0x13, 0x9f, 0x02, 0x09, 0xac, 0x0a, 0x70, 0xae, 0xac, 0x02, 0xe5, 0x64,
-	0xf5, 0x55, 0xda, 0x79, 0x28, 0xe6, 0x38, 0x0d, 0x39, 0xcb, 0x24, 0x88, 0x70, 0x06, 0x89, 0xfd,
-	0x5f, 0xd7, 0xe8, 0xdd, 0xd6, 0x1d, 0x5d, 0xcc, 0x71, 0x1a, 0xe8, 0x8d, 0x33, 0x48, 0xac, 0xa7,
-	0xe6, 0x9e, 0x2a, 0x4f, 0x32, 0x1a, 0x52, 0x5a, 0x34, 0x26, 0xec, 0x96, 0x2a, 0xf6, 0xad, 0xd5,
-	0xd2, 0xdd, 0x1d, 0xe2, 0xfc, 0x59, 0x46, 0x87, 0x43, 0x8d, 0x11, 0xc1, 0x2e, 0x2d, 0xd6, 0xb4,
-	0x58, 0x1f, 0x7c, 0xac, 0x9b, 0x4d, 0x15, 0x9c, 0x45, 0xcc, 0xfb, 0x63, 0x98, 0xe0, 0x6c, 0x26,
-	0x43, 0x8a, 0xa7, 0xc0, 0xd5, 0x11, 0xab, 0xd9, 0xd4, 0x39, 0xff, 0xcd, 0x68, 0xfb, 0xa5, 0xdb,
-	0x50, 0x99, 0x0d, 0x40, 0x0d, 0x07, 0xdb, 0x10, 0xf9, 0x33, 0xa4, 0xfe, 0x4f, 0x90, 0x97, 0xdb,
-	0x10, 0x6e, 0x3a, 0x15, 0xa4, 0x38, 0x27, 0xc1, 0x32, 0x4e, 0xa0, 0x62, 0xc5, 0x4c, 0x5f, 0x81,
-	0x3f, 0x87, 0x75, 0x4a, 0x57, 0x9d, 0xdc, 0x85, 0xf6, 0x2c, 0x90, 0x31, 0xf3, 0x5f, 0x2d, 0xbe,
-	0x3a, 0xb5, 0xc5, 0xca, 0x31, 0xae, 0x57, 0x8e, 0xf1, 0x65, 0xe5, 0x18, 0xef, 0xd6, 0x4e, 0xed,
-	0x7a, 0xed, 0xd4, 0x3e, 0xad, 0x9d, 0xda, 0xeb, 0xc7, 0xdb, 0x84, 0xf2, 0xfe, 0xf6, 0x13, 0x90,
-	0x73, 0xc6, 0xa7, 0x3f, 0x3e, 0x78, 0x57, 0x8f, 0xbc, 0x7c, 0xf3, 0x17, 0xd0, 0xdc, 0x51, 0x4b,
-	0x5f, 0xcf, 0x87, 0xdf, 0x03, 0x00, 0x00, 0xff, 0xff, 0xa5, 0x93, 0xdf, 0xf0, 0x27, 0x04, 0x00,
-	0x00,
+	// 904 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0x41, 0x6f, 0x1b, 0x45,
+	0x14, 0xf6, 0x36, 0xae, 0x9b, 0x4c, 0x12, 0xe3, 0x4e, 0xa1, 0x72, 0xac, 0xb2, 0x36, 0x3e, 0x54,
+	0x06, 0x29, 0xbb, 0x34, 0x08, 0x09, 0x0e, 0x08, 0xd9, 0x1b, 0x47, 0x44, 0xc4, 0x71, 0xd9, 0xba,
+	0x55, 0x41, 0x48, 0xab, 0xf1, 0xee, 0xb3, 0xb3, 0xca, 0xce, 0x8c, 0x35, 0xbb, 0x1b, 0xbb, 0xe2,
+	0x0f, 0xa0, 0x8a, 0x03, 0x47, 0x2e, 0x3d, 0xf5, 0xc6, 0x8f, 0xe0, 0x9c, 0x63, 0x8f, 0x88, 0x43,
+	0x4a, 0x93, 0xbf, 0xc0, 0x0f, 0x40, 0x33, 0xb3, 0x9b, 0x38, 0x0d, 0x54, 0x95, 0xe1, 0x94, 0xbc,
+	0x99, 0xf7, 0x7d, 0xdf, 0xbc, 0xef, 0xbd, 0x99, 0x35, 0xba, 0xeb, 0x0b, 0x88, 0x7d, 0x60, 0x89,
+	0x0d, 0x33, 0xff, 0x80, 0xb0, 0x31, 0xd8, 0x47, 0xf7, 0x86, 0x90, 0x90, 0x7b, 0xf6, 0x84, 0x08,
+	0x42, 0x63, 0x6b, 0x22, 0x78, 0xc2, 0xf1, 0x46, 0x9e, 0x67, 0xe5, 0x79, 0x56, 0x96, 0x57, 0x7b,
+	0x77, 0xcc, 0xc7, 0x5c, 0x65, 0xd9, 0xf2, 0x3f, 0x0d, 0xa8, 0x99, 0x63, 0xce, 0xc7, 0x11, 0xd8,
+	0x2a, 0x1a, 0xa6, 0x23, 0x3b, 0x48, 0x05, 0x49, 0x42, 0xce, 0xf2, 0x7d, 0x9f, 0xc7, 0x94, 0xc7,
+	0xf6, 0x90, 0xc4, 0x17, 0x92, 0x3e, 0x0f, 0xb3, 0xfd, 0xe6, 0x6f, 0x45, 0x54, 0xba, 0xaf, 0x4e,
+	0x80, 0x7f, 0x40, 0xb7, 0x28, 0x11, 0x87, 0x90, 0x78, 0xbe, 0x00, 0xc5, 0xe1, 0x8d, 0x00, 0xaa,
+	0x46, 0x63, 0xa9, 0xb5, 0xba, 0xb5, 0x61, 0x69, 0x22, 0x4b, 0x12, 0xe5, 0x67, 0xb2, 0x1c, 0x1e,
+	0xb2, 0xce, 0xc7, 0xc7, 0x27, 0xf5, 0xc2, 0xaf, 0x2f, 0xeb, 0xad, 0x71, 0x98, 0x1c, 0xa4, 0x43,
+	0xcb, 0xe7, 0xd4, 0xce, 0x54, 0xf5, 0x9f, 0xcd, 0x38, 0x38, 0xb4, 0x93, 0x27, 0x13, 0x88, 0x15,
+	0x20, 0x76, 0x6f, 0x6a, 0x1d, 0x27, 0x93, 0xd9, 0x01, 0xc0, 0x9f, 0xa3, 0xe2, 0x08, 0x20, 0xae,
+	0x5e, 0x6b, 0x18, 0xad, 0xd5, 0xad, 0xba, 0xf5, 0xaf, 0x3e, 0x58, 0x3b, 0x00, 0x71, 0xa7, 0x28,
+	0x35, 0x5d, 0x05, 0xc1, 0xdf, 0x20, 0x4c, 0xc9, 0xcc, 0xe3, 0x22, 0x00, 0xe1, 0x45, 0xe1, 0x08,
+	0xe2, 0x09, 0x61, 0xd5, 0x25, 0x45, 0xb4, 0x61, 0x69, 0x7f, 0xac, 0xdc, 0x1f, 0x6b, 0x3b, 0xf3,
+	0xa7, 0xb3, 0x2c, 0x29, 0x7e, 0x79, 0x59, 0x37, 0xdc, 0x0a, 0x25, 0xb3, 0xbe, 0x44, 0xef, 0x65,
+	0x60, 0x4c, 0xd0, 0x7b, 0x17, 0x94, 0x13, 0x11, 0xfa, 0xe0, 0x29, 0x54, 0xb5, 0xd8, 0x30, 0x5a,
+	0x2b, 0x1d, 0x4b, 0x42, 0xff, 0x38, 0xa9, 0xdf, 0x7d, 0x8b, 0x8a, 0xb7, 0xc1, 0x77, 0x71, 0x2e,
+	0x70, 0x5f, 0x52, 0xb9, 0x92, 0x09, 0x6f, 0x4a, 0xb7, 0x67, 0x5e, 0x3c, 0x25, 0x13, 0x4f, 0xf0,
+	0x34, 0x81, 0xd8, 0x8b, 0x80, 0x55, 0xaf, 0x37, 0x8c, 0xd6, 0xba, 0x3a, 0xd1, 0x83, 0x29, 0x99,
+	0xb8, 0x6a, 0x63, 0x0f, 0x18, 0xfe, 0x02, 0xdd, 0x94, 0xe9, 0x2c, 0xa5, 0x1e, 0xa5, 0xfa, 0x60,
+	0x71, 0xb5, 0x24, 0x93, 0x3b, 0xf8, 0xf4, 0xa4, 0x5e, 0xee, 0x91, 0xd9, 0x7e, 0x4a, 0x7b, 0x3d,
+	0x25, 0x13, 0xbb, 0x65, 0xaa, 0x63, 0xaa, 0x63, 0xfc, 0x18, 0xbd, 0xe3, 0x87, 0xc2, 0x4f, 0xc3,
+	0xc4, 0x1b, 0x0a, 0x20, 0x87, 0x20, 0xaa, 0x37, 0x94, 0x41, 0x1f, 0xbe, 0xc1, 0x69, 0x47, 0x23,
+	0x3a, 0x1a, 0x90, 0x79, 0x5e, 0xf6, 0x2f, 0xad, 0x36, 0x7f, 0x2a, 0xa2, 0xa2, 0x6c, 0x09, 0xf6,
+	0xd1, 0xed, 0x00, 0x46, 0x24, 0x8d, 0x12, 0x8f, 0xca, 0x1d, 0x39, 0x3c, 0xd2, 0x35, 0x39, 0x41,
+	0x8b, 0x98, 0x76, 0x2b, 0x63, 0xeb, 0x49, 0xb2, 0x1d, 0x90, 0xb6, 0xc1, 0xbc, 0x48, 0x72, 0x59,
+	0xe4, 0xda, 0x7f, 0x12, 0x19, 0xcc, 0x8b, 0x08, 0x64, 0xe6, 0x22, 0x7a, 0x02, 0x62, 0x9e, 0x0a,
+	0x1f, 0x72, 0xad, 0x90, 0x57, 0x97, 0x16, 0x12, 0xab, 0x65, 0xac, 0xaa, 0x27, 0x0f, 0x14, 0xa7,
+	0x96, 0x0c, 0x39, 0xfe, 0x00, 0xad, 0x1d, 0xf1, 0x28, 0xa5, 0xe0, 0x05, 0xc0, 0x38, 0xd5, 0x83,
+	0xe6, 0xae, 0xea, 0xb5, 0x6d, 0xb9, 0x84, 0xbb, 0x68, 0x45, 0x9e, 0x20, 0x09, 0x65, 0xeb, 0xaf,
+	0xab, 0x5b, 0xd9, 0x7c, 0xf3, 0x3d, 0x19, 0x84, 0xe7, 0x6d, 0x5b, 0x1e, 0xe9, 0x30, 0xc6, 0xdf,
+	0x23, 0x2c, 0x60, 0x04, 0x42, 0x90, 0x68, 0xae, 0xa2, 0xd2, 0x42, 0x15, 0x55, 0x72, 0xa6, 0xbc,
+	0x8e, 0xe6, 0x5f, 0xd7, 0xd0, 0x8d, 0x4c, 0x19, 0xf7, 0x10, 0xa2, 0x21, 0xf3, 0x74, 0x0d, 0x0b,
+	0x4e, 0xc1, 0x0a, 0x0d, 0xd9, 0x23, 0x45, 0x80, 0x19, 0x5a, 0x93, 0x74, 0x07, 0x3c, 0x0a, 0x42,
+	0x36, 0x96, 0x4f, 0xc5, 0xff, 0xfe, 0x30, 0xad, 0xd2, 0x90, 0x7d, 0x95, 0xf1, 0xe3, 0x01, 0x2a,
+	0xbf, 0x36, 0xc8, 0x8b, 0xb5, 0x7d, 0x8d, 0xce, 0x0f, 0xd7, 0x00, 0x95, 0x5f, 0x9b, 0xdc, 0xc5,
+	0xde, 0x94, 0xb5, 0x64, 0x8e, 0xb5, 0xf9, 0xca, 0x40, 0xe5, 0xcb, 0xd7, 0x15, 0x3f, 0x46, 0x15,
+	0xfd, 0x72, 0x0d, 0x09, 0x0b, 0xb2, 0x2e, 0x2f, 0xd6, 0x83, 0xb2, 0xe2, 0xe9, 0x10, 0x16, 0xe8,
+	0x59, 0xbd, 0x8d, 0x4a, 0xd3, 0x90, 0x05, 0x7c, 0xaa, 0x2e, 0xdd, 0xba, 0x9b, 0x45, 0xb8, 0x86,
+	0x96, 0xf3, 0xaf, 0x8f, 0xb2, 0x6a, 0xdd, 0x3d, 0x8f, 0x71, 0x1b, 0x15, 0x29, 0x0f, 0x74, 0xb1,
+	0xe5, 0xad, 0xcd, 0xb7, 0x7e, 0x75, 0x7a, 0x3c, 0x00, 0x57, 0x41, 0x3f, 0x7a, 0x6e, 0x20, 0x7c,
+	0x75, 0x13, 0xef, 0xa0, 0x86, 0xb3, 0xeb, 0x3a, 0x0f, 0x77, 0x07, 0x5e, 0xc7, 0xed, 0xb6, 0xbf,
+	0xee, 0xba, 0x5e, 0xaf, 0xbf, 0xdd, 0xf5, 0x9c, 0xf6, 0xbe, 0xd3, 0xdd, 0xf3, 0xfa, 0xfb, 0x7b,
+	0xdf, 0x56, 0x0a, 0xb5, 0xc6, 0xd3, 0x67, 0x8d, 0x3b, 0x57, 0xd1, 0x0e, 0x61, 0x3e, 0x44, 0x7d,
+	0x16, 0x3d, 0xc1, 0x5f, 0xa2, 0x3b, 0xff, 0xc8, 0xd3, 0x7e, 0xe8, 0x0c, 0x76, 0xfb, 0xfb, 0x15,
+	0xa3, 0xf6, 0xfe, 0xd3, 0x67, 0x8d, 0x8d, 0xab, 0x1c, 0xed, 0xd4, 0x97, 0x25, 0xd6, 0x8a, 0x3f,
+	0x3e, 0x37, 0x0b, 0x9d, 0x47, 0xc7, 0xaf, 0xcc, 0xc2, 0xf1, 0xa9, 0x69, 0xbc, 0x38, 0x35, 0x8d,
+	0x3f, 0x4f, 0x4d, 0xe3, 0xe7, 0x33, 0xb3, 0xf0, 0xe2, 0xcc, 0x2c, 0xfc, 0x7e, 0x66, 0x16, 0xbe,
+	0xfb, 0x6c, 0xde, 0xf2, 0xcc, 0x82, 0x4d, 0x06, 0xc9, 0x94, 0x8b, 0xc3, 0xf3, 0x05, 0xfb, 0xe8,
+	0x53, 0x7b, 0x76, 0xf1, 0x43, 0x41, 0x35, 0x62, 0x58, 0x52, 0x5f, 0xb0, 0x4f, 0xfe, 0x1e, 0x00,
+	0xa3, 0x1b, 0x33, 0xea, 0x4a, 0x08, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -180,6 +338,16 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size, err := m.CircuitBreaker.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
 	if m.MaxNumMMOrders != 0 {
 		i = encodeVarintParams(dAtA, i, uint64(m.MaxNumMMOrders))
 		i--
@@ -200,12 +368,12 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	}
 	i--
 	dAtA[i] = 0x22
-	n1, err1 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.MaxOrderLifespan, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.MaxOrderLifespan):])
-	if err1 != nil {
-		return 0, err1
+	n2, err2 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.MaxOrderLifespan, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.MaxOrderLifespan):])
+	if err2 != nil {
+		return 0, err2
 	}
-	i -= n1
-	i = encodeVarintParams(dAtA, i, uint64(n1))
+	i -= n2
+	i = encodeVarintParams(dAtA, i, uint64(n2))
 	i--
 	dAtA[i] = 0x1a
 	{
@@ -255,6 +423,37 @@ func (m *Fees) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.ReferralFeeRatio.Size()
+		i -= size
+		if _, err := m.ReferralFeeRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if len(m.FeeTiers) > 0 {
+		for iNdEx := len(m.FeeTiers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FeeTiers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.VolumeDenom) > 0 {
+		i -= len(m.VolumeDenom)
+		copy(dAtA[i:], m.VolumeDenom)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.VolumeDenom)))
+		i--
+		dAtA[i] = 0x22
+	}
 	{
 		size := m.DefaultOrderSourceFeeRatio.Size()
 		i -= size
@@ -288,6 +487,121 @@ func (m *Fees) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *FeeTier) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FeeTier) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeeTier) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.TakerFeeRate.Size()
+		i -= size
+		if _, err := m.TakerFeeRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.MakerFeeRate.Size()
+		i -= size
+		if _, err := m.MakerFeeRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.MinHoldings) > 0 {
+		for iNdEx := len(m.MinHoldings) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MinHoldings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size := m.MinVolume.Size()
+		i -= size
+		if _, err := m.MinVolume.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *CircuitBreaker) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CircuitBreaker) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CircuitBreaker) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Mode != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.Mode))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Duration != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.Duration))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Window != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.Window))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size := m.PriceBandRatio.Size()
+		i -= size
+		if _, err := m.PriceBandRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintParams(dAtA []byte, offset int, v uint64) int {
 	offset -= sovParams(v)
 	base := offset
@@ -323,6 +637,8 @@ func (m *Params) Size() (n int) {
 	if m.MaxNumMMOrders != 0 {
 		n += 1 + sovParams(uint64(m.MaxNumMMOrders))
 	}
+	l = m.CircuitBreaker.Size()
+	n += 1 + l + sovParams(uint64(l))
 	return n
 }
 
@@ -338,6 +654,59 @@ func (m *Fees) Size() (n int) {
 	n += 1 + l + sovParams(uint64(l))
 	l = m.DefaultOrderSourceFeeRatio.Size()
 	n += 1 + l + sovParams(uint64(l))
+	l = len(m.VolumeDenom)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	if len(m.FeeTiers) > 0 {
+		for _, e := range m.FeeTiers {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	l = m.ReferralFeeRatio.Size()
+	n += 1 + l + sovParams(uint64(l))
+	return n
+}
+
+func (m *FeeTier) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.MinVolume.Size()
+	n += 1 + l + sovParams(uint64(l))
+	if len(m.MinHoldings) > 0 {
+		for _, e := range m.MinHoldings {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	l = m.MakerFeeRate.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.TakerFeeRate.Size()
+	n += 1 + l + sovParams(uint64(l))
+	return n
+}
+
+func (m *CircuitBreaker) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.PriceBandRatio.Size()
+	n += 1 + l + sovParams(uint64(l))
+	if m.Window != 0 {
+		n += 1 + sovParams(uint64(m.Window))
+	}
+	if m.Duration != 0 {
+		n += 1 + sovParams(uint64(m.Duration))
+	}
+	if m.Mode != 0 {
+		n += 1 + sovParams(uint64(m.Mode))
+	}
 	return n
 }
 
@@ -548,6 +917,39 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CircuitBreaker", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CircuitBreaker.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
@@ -700,6 +1102,433 @@ func (m *Fees) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VolumeDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VolumeDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeTiers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeTiers = append(m.FeeTiers, FeeTier{})
+			if err := m.FeeTiers[len(m.FeeTiers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReferralFeeRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ReferralFeeRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FeeTier) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FeeTier: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FeeTier: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinVolume", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinVolume.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinHoldings", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MinHoldings = append(m.MinHoldings, types.Coin{})
+			if err := m.MinHoldings[len(m.MinHoldings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MakerFeeRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MakerFeeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TakerFeeRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TakerFeeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CircuitBreaker) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CircuitBreaker: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CircuitBreaker: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceBandRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PriceBandRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Window", wireType)
+			}
+			m.Window = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Window |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+			}
+			m.Duration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Duration |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mode", wireType)
+			}
+			m.Mode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Mode |= CircuitBreakerMode(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
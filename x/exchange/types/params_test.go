@@ -100,6 +100,41 @@ func TestParams_Validate(t *testing.T) {
 			},
 			"max swap routes len must not be 0",
 		},
+		{
+			"negative price band ratio",
+			func(params *types.Params) {
+				params.CircuitBreaker.PriceBandRatio = utils.ParseDec("-0.1")
+			},
+			"price band ratio must be in range [0.0, 1.0): -0.100000000000000000",
+		},
+		{
+			"too high price band ratio",
+			func(params *types.Params) {
+				params.CircuitBreaker.PriceBandRatio = utils.OneDec
+			},
+			"price band ratio must be in range [0.0, 1.0): 1.000000000000000000",
+		},
+		{
+			"zero circuit breaker window",
+			func(params *types.Params) {
+				params.CircuitBreaker.Window = 0
+			},
+			"window must not be 0",
+		},
+		{
+			"zero circuit breaker duration",
+			func(params *types.Params) {
+				params.CircuitBreaker.Duration = 0
+			},
+			"duration must not be 0",
+		},
+		{
+			"invalid circuit breaker mode",
+			func(params *types.Params) {
+				params.CircuitBreaker.Mode = 10
+			},
+			"invalid circuit breaker mode: 10",
+		},
 	} {
 		t.Run(tc.name, func(t *testing.T) {
 			params := types.DefaultParams()
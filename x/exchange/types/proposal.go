@@ -11,15 +11,19 @@ import (
 
 const (
 	ProposalTypeMarketParameterChange string = "MarketParameterChange"
+	ProposalTypeMarketStatusChange    string = "MarketStatusChange"
 )
 
 var (
 	_ gov.Content = &MarketParameterChangeProposal{}
+	_ gov.Content = &MarketStatusChangeProposal{}
 )
 
 func init() {
 	gov.RegisterProposalType(ProposalTypeMarketParameterChange)
 	gov.RegisterProposalTypeCodec(&MarketParameterChangeProposal{}, "crescent/MarketParameterChangeProposal")
+	gov.RegisterProposalType(ProposalTypeMarketStatusChange)
+	gov.RegisterProposalTypeCodec(&MarketStatusChangeProposal{}, "crescent/MarketStatusChangeProposal")
 }
 
 func NewMarketParameterChangeProposal(
@@ -67,6 +71,15 @@ func (p MarketParameterChangeProposal) String() string {
       Taker Fee Rate:         %s
       Order Source Fee Ratio: %s
 `, change.MarketId, change.MakerFeeRate, change.TakerFeeRate, change.OrderSourceFeeRatio))
+		if change.TickSize != nil {
+			b.WriteString(fmt.Sprintf("      Tick Size:              %s\n", change.TickSize))
+		}
+		if change.MinOrderQuantity != nil {
+			b.WriteString(fmt.Sprintf("      Min Order Quantity:     %s\n", change.MinOrderQuantity))
+		}
+		if change.LotSize != nil {
+			b.WriteString(fmt.Sprintf("      Lot Size:               %s\n", change.LotSize))
+		}
 	}
 	return b.String()
 }
@@ -89,5 +102,75 @@ func (change MarketParameterChange) Validate() error {
 		change.MakerFeeRate, change.TakerFeeRate, change.OrderSourceFeeRatio); err != nil {
 		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
 	}
+	if err := validateOptionalOrderSizes(change.TickSize, change.MinOrderQuantity, change.LotSize); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	if err := ValidateSelfTradePrevention(change.SelfTradePrevention); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	return nil
+}
+
+func NewMarketStatusChangeProposal(
+	title, description string, changes []MarketStatusChange) *MarketStatusChangeProposal {
+	return &MarketStatusChangeProposal{
+		Title:       title,
+		Description: description,
+		Changes:     changes,
+	}
+}
+
+func (p *MarketStatusChangeProposal) GetTitle() string       { return p.Title }
+func (p *MarketStatusChangeProposal) GetDescription() string { return p.Description }
+func (p *MarketStatusChangeProposal) ProposalRoute() string  { return RouterKey }
+func (p *MarketStatusChangeProposal) ProposalType() string {
+	return ProposalTypeMarketStatusChange
+}
+
+func (p *MarketStatusChangeProposal) ValidateBasic() error {
+	if err := gov.ValidateAbstract(p); err != nil {
+		return err
+	}
+	if len(p.Changes) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "changes must not be empty")
+	}
+	for _, change := range p.Changes {
+		if err := change.Validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (p MarketStatusChangeProposal) String() string {
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf(`Market Status Change Proposal:
+  Title:       %s
+  Description: %s
+  Changes:
+`, p.Title, p.Description))
+	for _, change := range p.Changes {
+		b.WriteString(fmt.Sprintf(`    Market Status Change:
+      Market Id: %d
+      Status:    %s
+`, change.MarketId, change.Status))
+	}
+	return b.String()
+}
+
+func NewMarketStatusChange(marketId uint64, status MarketStatus) MarketStatusChange {
+	return MarketStatusChange{
+		MarketId: marketId,
+		Status:   status,
+	}
+}
+
+func (change MarketStatusChange) Validate() error {
+	if change.MarketId == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "market id must not be 0")
+	}
+	if err := ValidateMarketStatus(change.Status); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
 	return nil
 }
@@ -62,18 +62,101 @@ func (m *MarketParameterChangeProposal) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MarketParameterChangeProposal proto.InternalMessageInfo
 
+type MarketStatusChangeProposal struct {
+	Title       string               `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description string               `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Changes     []MarketStatusChange `protobuf:"bytes,3,rep,name=changes,proto3" json:"changes"`
+}
+
+func (m *MarketStatusChangeProposal) Reset()      { *m = MarketStatusChangeProposal{} }
+func (*MarketStatusChangeProposal) ProtoMessage() {}
+func (*MarketStatusChangeProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f6f44678dc7e78b1, []int{1}
+}
+func (m *MarketStatusChangeProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MarketStatusChangeProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MarketStatusChangeProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MarketStatusChangeProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MarketStatusChangeProposal.Merge(m, src)
+}
+func (m *MarketStatusChangeProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *MarketStatusChangeProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_MarketStatusChangeProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MarketStatusChangeProposal proto.InternalMessageInfo
+
+type MarketStatusChange struct {
+	MarketId uint64       `protobuf:"varint,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	Status   MarketStatus `protobuf:"varint,2,opt,name=status,proto3,enum=crescent.exchange.v1beta1.MarketStatus" json:"status,omitempty"`
+}
+
+func (m *MarketStatusChange) Reset()         { *m = MarketStatusChange{} }
+func (m *MarketStatusChange) String() string { return proto.CompactTextString(m) }
+func (*MarketStatusChange) ProtoMessage()    {}
+func (*MarketStatusChange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f6f44678dc7e78b1, []int{2}
+}
+func (m *MarketStatusChange) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MarketStatusChange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MarketStatusChange.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MarketStatusChange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MarketStatusChange.Merge(m, src)
+}
+func (m *MarketStatusChange) XXX_Size() int {
+	return m.Size()
+}
+func (m *MarketStatusChange) XXX_DiscardUnknown() {
+	xxx_messageInfo_MarketStatusChange.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MarketStatusChange proto.InternalMessageInfo
+
 type MarketParameterChange struct {
 	MarketId            uint64                                 `protobuf:"varint,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
 	MakerFeeRate        github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=maker_fee_rate,json=makerFeeRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"maker_fee_rate"`
 	TakerFeeRate        github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=taker_fee_rate,json=takerFeeRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"taker_fee_rate"`
 	OrderSourceFeeRatio github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=order_source_fee_ratio,json=orderSourceFeeRatio,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"order_source_fee_ratio"`
+	// tick_size, min_order_quantity and lot_size are left unchanged if not
+	// specified.
+	TickSize         *github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=tick_size,json=tickSize,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"tick_size,omitempty"`
+	MinOrderQuantity *github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=min_order_quantity,json=minOrderQuantity,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"min_order_quantity,omitempty"`
+	LotSize          *github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,7,opt,name=lot_size,json=lotSize,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"lot_size,omitempty"`
+	// self_trade_prevention is left unchanged if unspecified.
+	SelfTradePrevention SelfTradePrevention `protobuf:"varint,8,opt,name=self_trade_prevention,json=selfTradePrevention,proto3,enum=crescent.exchange.v1beta1.SelfTradePrevention" json:"self_trade_prevention,omitempty"`
 }
 
 func (m *MarketParameterChange) Reset()         { *m = MarketParameterChange{} }
 func (m *MarketParameterChange) String() string { return proto.CompactTextString(m) }
 func (*MarketParameterChange) ProtoMessage()    {}
 func (*MarketParameterChange) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f6f44678dc7e78b1, []int{1}
+	return fileDescriptor_f6f44678dc7e78b1, []int{3}
 }
 func (m *MarketParameterChange) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -104,6 +187,8 @@ var xxx_messageInfo_MarketParameterChange proto.InternalMessageInfo
 
 func init() {
 	proto.RegisterType((*MarketParameterChangeProposal)(nil), "crescent.exchange.v1beta1.MarketParameterChangeProposal")
+	proto.RegisterType((*MarketStatusChangeProposal)(nil), "crescent.exchange.v1beta1.MarketStatusChangeProposal")
+	proto.RegisterType((*MarketStatusChange)(nil), "crescent.exchange.v1beta1.MarketStatusChange")
 	proto.RegisterType((*MarketParameterChange)(nil), "crescent.exchange.v1beta1.MarketParameterChange")
 }
 
@@ -112,32 +197,42 @@ func init() {
 }
 
 var fileDescriptor_f6f44678dc7e78b1 = []byte{
-	// 395 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x92, 0x3f, 0x6f, 0xda, 0x40,
-	0x18, 0xc6, 0x6d, 0x70, 0xff, 0x70, 0x54, 0x1d, 0x5c, 0x5a, 0xb9, 0xad, 0x6a, 0x10, 0x43, 0xc5,
-	0xc2, 0x5d, 0x69, 0x55, 0xa9, 0xea, 0x48, 0xab, 0x4a, 0x1d, 0x2a, 0x21, 0xb7, 0xea, 0x90, 0xc5,
-	0x3a, 0xce, 0x6f, 0x8c, 0x65, 0xec, 0xb3, 0xee, 0x0e, 0x42, 0xbe, 0x45, 0xc6, 0x8c, 0xf9, 0x06,
-	0xf9, 0x14, 0x91, 0x18, 0x19, 0xa3, 0x0c, 0x28, 0x81, 0x2f, 0x12, 0x71, 0xb6, 0x13, 0x22, 0x91,
-	0x85, 0xe9, 0xee, 0xde, 0xf7, 0x79, 0x7f, 0xcf, 0x23, 0xdd, 0x8b, 0x3a, 0x4c, 0x80, 0x64, 0x90,
-	0x2a, 0x02, 0x33, 0x36, 0xa2, 0x69, 0x08, 0x64, 0xda, 0x1b, 0x82, 0xa2, 0x3d, 0x92, 0x09, 0x9e,
-	0x71, 0x49, 0xc7, 0x38, 0x13, 0x5c, 0x71, 0xfb, 0x6d, 0xa9, 0xc4, 0xa5, 0x12, 0x17, 0xca, 0x77,
-	0x8d, 0x90, 0x87, 0x5c, 0xab, 0xc8, 0xe6, 0x96, 0x0f, 0xb4, 0xcf, 0x4d, 0xf4, 0xe1, 0x0f, 0x15,
-	0x31, 0xa8, 0x01, 0x15, 0x34, 0x01, 0x05, 0xe2, 0x87, 0x9e, 0x1b, 0x14, 0x60, 0xbb, 0x81, 0x9e,
-	0xa8, 0x48, 0x8d, 0xc1, 0x31, 0x5b, 0x66, 0xa7, 0xe6, 0xe5, 0x0f, 0xbb, 0x85, 0xea, 0x01, 0x48,
-	0x26, 0xa2, 0x4c, 0x45, 0x3c, 0x75, 0x2a, 0xba, 0xb7, 0x5d, 0xb2, 0x07, 0xe8, 0x59, 0x9e, 0x40,
-	0x3a, 0xd5, 0x56, 0xb5, 0x53, 0xff, 0xfc, 0x09, 0x3f, 0x1a, 0x0e, 0xef, 0x8c, 0xd0, 0xb7, 0xe6,
-	0xcb, 0xa6, 0xe1, 0x95, 0x98, 0xef, 0xd6, 0xe9, 0x59, 0xd3, 0x68, 0x5f, 0x54, 0xd0, 0xeb, 0x9d,
-	0x72, 0xfb, 0x3d, 0xaa, 0x25, 0xba, 0xe1, 0x47, 0x81, 0x4e, 0x6b, 0x79, 0xcf, 0xf3, 0xc2, 0xef,
-	0xc0, 0xfe, 0x87, 0x5e, 0x26, 0x34, 0x06, 0xe1, 0x1f, 0x02, 0xf8, 0x82, 0x2a, 0xc8, 0x33, 0xf7,
-	0xf1, 0xc6, 0xe3, 0x6a, 0xd9, 0xfc, 0x18, 0x46, 0x6a, 0x34, 0x19, 0x62, 0xc6, 0x13, 0xc2, 0xb8,
-	0x4c, 0xb8, 0x2c, 0x8e, 0xae, 0x0c, 0x62, 0xa2, 0x8e, 0x33, 0x90, 0xf8, 0x27, 0x30, 0xef, 0x85,
-	0xa6, 0xfc, 0x02, 0xf0, 0xa8, 0x82, 0x0d, 0x55, 0x3d, 0xa4, 0x56, 0xf7, 0xa3, 0xaa, 0x6d, 0x2a,
-	0x43, 0x6f, 0xb8, 0x08, 0x40, 0xf8, 0x92, 0x4f, 0x04, 0x83, 0x12, 0x1e, 0x71, 0xc7, 0xda, 0x8b,
-	0xfe, 0x4a, 0xd3, 0xfe, 0x6a, 0x58, 0xee, 0x11, 0xf1, 0xfe, 0xff, 0xf9, 0x8d, 0x6b, 0xcc, 0x57,
-	0xae, 0xb9, 0x58, 0xb9, 0xe6, 0xf5, 0xca, 0x35, 0x4f, 0xd6, 0xae, 0xb1, 0x58, 0xbb, 0xc6, 0xe5,
-	0xda, 0x35, 0x0e, 0xbe, 0x6d, 0xa3, 0x8b, 0x6f, 0xeb, 0xa6, 0xa0, 0x8e, 0xb8, 0x88, 0xef, 0x0a,
-	0x64, 0xfa, 0x95, 0xcc, 0xee, 0x77, 0x52, 0x1b, 0x0e, 0x9f, 0xea, 0xc5, 0xfa, 0x72, 0x1b, 0x00,
-	0x00, 0xff, 0xff, 0xd9, 0xd6, 0x6e, 0x8a, 0xb5, 0x02, 0x00, 0x00,
+	// 553 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x94, 0x4f, 0x6f, 0xd3, 0x4c,
+	0x10, 0x87, 0xed, 0xb7, 0x69, 0x9b, 0x6e, 0x5f, 0x55, 0xc8, 0x6d, 0x91, 0x29, 0xc2, 0x89, 0x72,
+	0x80, 0x08, 0x29, 0x36, 0x2d, 0x42, 0x42, 0x5c, 0x90, 0xc2, 0x3f, 0x71, 0xa8, 0x08, 0x4e, 0x85,
+	0x10, 0x17, 0x6b, 0x63, 0x4f, 0xd2, 0x55, 0x6c, 0xaf, 0xd9, 0x9d, 0x84, 0xb6, 0x9f, 0x02, 0x89,
+	0x0b, 0x47, 0xae, 0x9c, 0xf8, 0x1a, 0x39, 0xf6, 0x88, 0x38, 0x54, 0x90, 0x7c, 0x11, 0xe4, 0xb5,
+	0x13, 0x02, 0x0d, 0x81, 0x54, 0x9c, 0x92, 0xcc, 0xfe, 0xe6, 0x99, 0x67, 0x57, 0x9b, 0x25, 0x55,
+	0x5f, 0x80, 0xf4, 0x21, 0x46, 0x07, 0x8e, 0xfc, 0x43, 0x1a, 0x77, 0xc0, 0xe9, 0xef, 0xb6, 0x00,
+	0xe9, 0xae, 0x93, 0x08, 0x9e, 0x70, 0x49, 0x43, 0x3b, 0x11, 0x1c, 0xb9, 0x71, 0x65, 0x9c, 0xb4,
+	0xc7, 0x49, 0x3b, 0x4f, 0xee, 0x6c, 0x75, 0x78, 0x87, 0xab, 0x94, 0x93, 0x7e, 0xcb, 0x1a, 0x76,
+	0xe6, 0xa0, 0x27, 0x04, 0x95, 0xac, 0x7c, 0xd2, 0xc9, 0xb5, 0x7d, 0x2a, 0xba, 0x80, 0x0d, 0x2a,
+	0x68, 0x04, 0x08, 0xe2, 0x81, 0x5a, 0x6f, 0xe4, 0x0a, 0xc6, 0x16, 0x59, 0x46, 0x86, 0x21, 0x98,
+	0x7a, 0x59, 0xaf, 0xae, 0xb9, 0xd9, 0x0f, 0xa3, 0x4c, 0xd6, 0x03, 0x90, 0xbe, 0x60, 0x09, 0x32,
+	0x1e, 0x9b, 0xff, 0xa9, 0xb5, 0xe9, 0x92, 0xd1, 0x20, 0xab, 0xd9, 0x24, 0x69, 0x2e, 0x95, 0x97,
+	0xaa, 0xeb, 0x7b, 0xb7, 0xec, 0xdf, 0x6e, 0xc3, 0x9e, 0xa9, 0x50, 0x2f, 0x0c, 0xce, 0x4a, 0x9a,
+	0x3b, 0xc6, 0xdc, 0x2b, 0xbc, 0xff, 0x50, 0xd2, 0x2a, 0x1f, 0x75, 0xb2, 0x93, 0xc5, 0x9b, 0x48,
+	0xb1, 0x27, 0xff, 0x91, 0xee, 0xfe, 0xaf, 0xba, 0xb5, 0x3f, 0xea, 0x4e, 0xcf, 0x9f, 0xed, 0x2a,
+	0x88, 0x71, 0x3e, 0x6a, 0x5c, 0x25, 0x6b, 0x91, 0xaa, 0x7a, 0x2c, 0x50, 0x9a, 0x05, 0xb7, 0x98,
+	0x15, 0x9e, 0x06, 0xc6, 0x7d, 0xb2, 0x22, 0x55, 0x58, 0x49, 0x6e, 0xec, 0xdd, 0xf8, 0x4b, 0x0d,
+	0x37, 0x6f, 0xab, 0xbc, 0x5b, 0x26, 0xdb, 0x33, 0x8f, 0x73, 0xfe, 0xdc, 0x03, 0xb2, 0x11, 0xd1,
+	0x2e, 0x08, 0xaf, 0x0d, 0xe0, 0x09, 0x8a, 0x90, 0x1d, 0x52, 0xdd, 0x4e, 0xf7, 0xf5, 0xe5, 0xac,
+	0x74, 0xbd, 0xc3, 0xf0, 0xb0, 0xd7, 0xb2, 0x7d, 0x1e, 0x39, 0x3e, 0x97, 0x11, 0x97, 0xf9, 0x47,
+	0x4d, 0x06, 0x5d, 0x07, 0x8f, 0x13, 0x90, 0xf6, 0x43, 0xf0, 0xdd, 0xff, 0x15, 0xe5, 0x31, 0x80,
+	0x4b, 0x11, 0x52, 0x2a, 0xfe, 0x4c, 0x5d, 0xba, 0x18, 0x15, 0xa7, 0xa9, 0x3e, 0xb9, 0xcc, 0x45,
+	0x00, 0xc2, 0x93, 0xbc, 0x27, 0x7c, 0x18, 0xc3, 0x19, 0x37, 0x0b, 0x17, 0xa2, 0x6f, 0x2a, 0x5a,
+	0x53, 0xc1, 0xb2, 0x19, 0x8c, 0x1b, 0x4f, 0xc8, 0x1a, 0x32, 0xbf, 0xeb, 0x49, 0x76, 0x02, 0xe6,
+	0xb2, 0xe2, 0xde, 0x5c, 0x80, 0x59, 0x4c, 0x9b, 0x9b, 0xec, 0x04, 0x8c, 0x97, 0xc4, 0x88, 0x58,
+	0xec, 0x65, 0xc6, 0xaf, 0x7b, 0x34, 0x46, 0x86, 0xc7, 0xe6, 0xca, 0xc2, 0xc4, 0x4b, 0x11, 0x8b,
+	0x9f, 0xa5, 0x90, 0xe7, 0x39, 0xc3, 0x78, 0x44, 0x8a, 0x21, 0xc7, 0xcc, 0x70, 0x75, 0x61, 0xde,
+	0x6a, 0xc8, 0x51, 0x09, 0xb6, 0xc8, 0xb6, 0x84, 0xb0, 0xed, 0xa1, 0xa0, 0x01, 0x78, 0x89, 0x80,
+	0x3e, 0xc4, 0xea, 0x6f, 0x52, 0x54, 0x37, 0xd0, 0x9e, 0x73, 0x03, 0x9b, 0x10, 0xb6, 0x0f, 0xd2,
+	0xb6, 0xc6, 0xa4, 0xcb, 0xdd, 0x94, 0xe7, 0x8b, 0xf5, 0x17, 0x83, 0x6f, 0x96, 0x36, 0x18, 0x5a,
+	0xfa, 0xe9, 0xd0, 0xd2, 0xbf, 0x0e, 0x2d, 0xfd, 0xed, 0xc8, 0xd2, 0x4e, 0x47, 0x96, 0xf6, 0x79,
+	0x64, 0x69, 0xaf, 0xee, 0x4e, 0x2b, 0xe7, 0xc3, 0x6a, 0x31, 0xe0, 0x1b, 0x2e, 0xba, 0x93, 0x82,
+	0xd3, 0xbf, 0xe3, 0x1c, 0xfd, 0x78, 0xd0, 0xd4, 0x46, 0x5a, 0x2b, 0xea, 0x19, 0xbb, 0xfd, 0x7d,
+	0x00, 0xec, 0x41, 0x77, 0x3d, 0x4d, 0x05, 0x00, 0x00,
 }
 
 func (m *MarketParameterChangeProposal) Marshal() (dAtA []byte, err error) {
@@ -191,6 +286,90 @@ func (m *MarketParameterChangeProposal) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
+func (m *MarketStatusChangeProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MarketStatusChangeProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MarketStatusChangeProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Changes) > 0 {
+		for iNdEx := len(m.Changes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Changes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintProposal(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MarketStatusChange) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MarketStatusChange) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MarketStatusChange) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Status != 0 {
+		i = encodeVarintProposal(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.MarketId != 0 {
+		i = encodeVarintProposal(dAtA, i, uint64(m.MarketId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *MarketParameterChange) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -211,6 +390,47 @@ func (m *MarketParameterChange) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.SelfTradePrevention != 0 {
+		i = encodeVarintProposal(dAtA, i, uint64(m.SelfTradePrevention))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.LotSize != nil {
+		{
+			size := m.LotSize.Size()
+			i -= size
+			if _, err := m.LotSize.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintProposal(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.MinOrderQuantity != nil {
+		{
+			size := m.MinOrderQuantity.Size()
+			i -= size
+			if _, err := m.MinOrderQuantity.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintProposal(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.TickSize != nil {
+		{
+			size := m.TickSize.Size()
+			i -= size
+			if _, err := m.TickSize.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintProposal(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
 	{
 		size := m.OrderSourceFeeRatio.Size()
 		i -= size
@@ -283,6 +503,44 @@ func (m *MarketParameterChangeProposal) Size() (n int) {
 	return n
 }
 
+func (m *MarketStatusChangeProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	if len(m.Changes) > 0 {
+		for _, e := range m.Changes {
+			l = e.Size()
+			n += 1 + l + sovProposal(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MarketStatusChange) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MarketId != 0 {
+		n += 1 + sovProposal(uint64(m.MarketId))
+	}
+	if m.Status != 0 {
+		n += 1 + sovProposal(uint64(m.Status))
+	}
+	return n
+}
+
 func (m *MarketParameterChange) Size() (n int) {
 	if m == nil {
 		return 0
@@ -298,6 +556,21 @@ func (m *MarketParameterChange) Size() (n int) {
 	n += 1 + l + sovProposal(uint64(l))
 	l = m.OrderSourceFeeRatio.Size()
 	n += 1 + l + sovProposal(uint64(l))
+	if m.TickSize != nil {
+		l = m.TickSize.Size()
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	if m.MinOrderQuantity != nil {
+		l = m.MinOrderQuantity.Size()
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	if m.LotSize != nil {
+		l = m.LotSize.Size()
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	if m.SelfTradePrevention != 0 {
+		n += 1 + sovProposal(uint64(m.SelfTradePrevention))
+	}
 	return n
 }
 
@@ -455,6 +728,242 @@ func (m *MarketParameterChangeProposal) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MarketStatusChangeProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProposal
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MarketStatusChangeProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MarketStatusChangeProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Changes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Changes = append(m.Changes, MarketStatusChange{})
+			if err := m.Changes[len(m.Changes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProposal(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MarketStatusChange) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProposal
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MarketStatusChange: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MarketStatusChange: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+			}
+			m.MarketId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MarketId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= MarketStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProposal(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *MarketParameterChange) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -605,6 +1114,133 @@ func (m *MarketParameterChange) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TickSize", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Dec
+			m.TickSize = &v
+			if err := m.TickSize.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinOrderQuantity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Dec
+			m.MinOrderQuantity = &v
+			if err := m.MinOrderQuantity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LotSize", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Dec
+			m.LotSize = &v
+			if err := m.LotSize.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SelfTradePrevention", wireType)
+			}
+			m.SelfTradePrevention = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SelfTradePrevention |= SelfTradePrevention(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipProposal(dAtA[iNdEx:])
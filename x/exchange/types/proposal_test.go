@@ -150,3 +150,57 @@ func TestMarketParameterChange_Validate(t *testing.T) {
 		})
 	}
 }
+
+func ExampleMarketStatusChange_String() {
+	p := types.NewMarketStatusChangeProposal(
+		"Title", "Description", []types.MarketStatusChange{
+			types.NewMarketStatusChange(1, types.MarketStatusHalted),
+			types.NewMarketStatusChange(2, types.MarketStatusDelisted),
+		})
+	fmt.Println(p.String())
+
+	// Output:
+	// Market Status Change Proposal:
+	//   Title:       Title
+	//   Description: Description
+	//   Changes:
+	//     Market Status Change:
+	//       Market Id: 1
+	//       Status:    MARKET_STATUS_HALTED
+	//     Market Status Change:
+	//       Market Id: 2
+	//       Status:    MARKET_STATUS_DELISTED
+}
+
+func TestMarketStatusChange_Validate(t *testing.T) {
+	for _, tc := range []struct {
+		name        string
+		change      types.MarketStatusChange
+		expectedErr string
+	}{
+		{
+			"happy case",
+			types.NewMarketStatusChange(1, types.MarketStatusCancelOnly),
+			"",
+		},
+		{
+			"invalid market id",
+			types.NewMarketStatusChange(0, types.MarketStatusCancelOnly),
+			"market id must not be 0: invalid request",
+		},
+		{
+			"invalid status",
+			types.NewMarketStatusChange(1, 10),
+			"invalid market status: 10: invalid request",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.change.Validate()
+			if tc.expectedErr == "" {
+				require.NoError(t, err)
+			} else {
+				require.EqualError(t, err, tc.expectedErr)
+			}
+		})
+	}
+}
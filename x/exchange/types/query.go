@@ -1,15 +1,24 @@
 package types
 
-func NewMarketResponse(market Market, marketState MarketState) MarketResponse {
+import "time"
+
+func NewMarketResponse(market Market, marketState MarketState, blockTime time.Time) MarketResponse {
 	return MarketResponse{
-		Id:                  market.Id,
-		BaseDenom:           market.BaseDenom,
-		QuoteDenom:          market.QuoteDenom,
-		EscrowAddress:       market.EscrowAddress,
-		MakerFeeRate:        market.MakerFeeRate,
-		TakerFeeRate:        market.TakerFeeRate,
-		OrderSourceFeeRatio: market.OrderSourceFeeRatio,
-		LastPrice:           marketState.LastPrice,
-		LastMatchingHeight:  marketState.LastMatchingHeight,
+		Id:                      market.Id,
+		BaseDenom:               market.BaseDenom,
+		QuoteDenom:              market.QuoteDenom,
+		EscrowAddress:           market.EscrowAddress,
+		MakerFeeRate:            market.MakerFeeRate,
+		TakerFeeRate:            market.TakerFeeRate,
+		OrderSourceFeeRatio:     market.OrderSourceFeeRatio,
+		LastPrice:               marketState.LastPrice,
+		LastMatchingHeight:      marketState.LastMatchingHeight,
+		Status:                  market.Status,
+		TickSize:                market.TickSize,
+		MinOrderQuantity:        market.MinOrderQuantity,
+		LotSize:                 market.LotSize,
+		SelfTradePrevention:     market.SelfTradePrevention,
+		CircuitBreakerEndHeight: marketState.CircuitBreakerEndHeight,
+		Volume24h:               marketState.RollingVolume(blockTime),
 	}
 }
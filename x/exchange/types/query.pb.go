@@ -12,19 +12,23 @@ import (
 	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -405,9 +409,163 @@ func (m *QueryOrderResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_QueryOrderResponse proto.InternalMessageInfo
 
+type QueryAllTriggerOrdersRequest struct {
+	Orderer    string             `protobuf:"bytes,1,opt,name=orderer,proto3" json:"orderer,omitempty"`
+	MarketId   uint64             `protobuf:"varint,2,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAllTriggerOrdersRequest) Reset()         { *m = QueryAllTriggerOrdersRequest{} }
+func (m *QueryAllTriggerOrdersRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAllTriggerOrdersRequest) ProtoMessage()    {}
+func (*QueryAllTriggerOrdersRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1fee35d2c78eeddd, []int{10}
+}
+func (m *QueryAllTriggerOrdersRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllTriggerOrdersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllTriggerOrdersRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllTriggerOrdersRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllTriggerOrdersRequest.Merge(m, src)
+}
+func (m *QueryAllTriggerOrdersRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllTriggerOrdersRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllTriggerOrdersRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllTriggerOrdersRequest proto.InternalMessageInfo
+
+type QueryAllTriggerOrdersResponse struct {
+	TriggerOrders []TriggerOrder      `protobuf:"bytes,1,rep,name=trigger_orders,json=triggerOrders,proto3" json:"trigger_orders"`
+	Pagination    *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAllTriggerOrdersResponse) Reset()         { *m = QueryAllTriggerOrdersResponse{} }
+func (m *QueryAllTriggerOrdersResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAllTriggerOrdersResponse) ProtoMessage()    {}
+func (*QueryAllTriggerOrdersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1fee35d2c78eeddd, []int{11}
+}
+func (m *QueryAllTriggerOrdersResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllTriggerOrdersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllTriggerOrdersResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllTriggerOrdersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllTriggerOrdersResponse.Merge(m, src)
+}
+func (m *QueryAllTriggerOrdersResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllTriggerOrdersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllTriggerOrdersResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllTriggerOrdersResponse proto.InternalMessageInfo
+
+type QueryTriggerOrderRequest struct {
+	OrderId uint64 `protobuf:"varint,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+}
+
+func (m *QueryTriggerOrderRequest) Reset()         { *m = QueryTriggerOrderRequest{} }
+func (m *QueryTriggerOrderRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTriggerOrderRequest) ProtoMessage()    {}
+func (*QueryTriggerOrderRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1fee35d2c78eeddd, []int{12}
+}
+func (m *QueryTriggerOrderRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTriggerOrderRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTriggerOrderRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTriggerOrderRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTriggerOrderRequest.Merge(m, src)
+}
+func (m *QueryTriggerOrderRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTriggerOrderRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTriggerOrderRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTriggerOrderRequest proto.InternalMessageInfo
+
+type QueryTriggerOrderResponse struct {
+	TriggerOrder TriggerOrder `protobuf:"bytes,1,opt,name=trigger_order,json=triggerOrder,proto3" json:"trigger_order"`
+}
+
+func (m *QueryTriggerOrderResponse) Reset()         { *m = QueryTriggerOrderResponse{} }
+func (m *QueryTriggerOrderResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTriggerOrderResponse) ProtoMessage()    {}
+func (*QueryTriggerOrderResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1fee35d2c78eeddd, []int{13}
+}
+func (m *QueryTriggerOrderResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTriggerOrderResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTriggerOrderResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTriggerOrderResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTriggerOrderResponse.Merge(m, src)
+}
+func (m *QueryTriggerOrderResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTriggerOrderResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTriggerOrderResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTriggerOrderResponse proto.InternalMessageInfo
+
 type QueryBestSwapExactAmountInRoutesRequest struct {
 	Input       string `protobuf:"bytes,1,opt,name=input,proto3" json:"input,omitempty"`
 	OutputDenom string `protobuf:"bytes,2,opt,name=output_denom,json=outputDenom,proto3" json:"output_denom,omitempty"`
+	// max_splits is the maximum number of routes the input can be split across.
+	// The input is not split if max_splits is less than 2.
+	MaxSplits uint32 `protobuf:"varint,3,opt,name=max_splits,json=maxSplits,proto3" json:"max_splits,omitempty"`
 }
 
 func (m *QueryBestSwapExactAmountInRoutesRequest) Reset() {
@@ -416,7 +574,7 @@ func (m *QueryBestSwapExactAmountInRoutesRequest) Reset() {
 func (m *QueryBestSwapExactAmountInRoutesRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryBestSwapExactAmountInRoutesRequest) ProtoMessage()    {}
 func (*QueryBestSwapExactAmountInRoutesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1fee35d2c78eeddd, []int{10}
+	return fileDescriptor_1fee35d2c78eeddd, []int{14}
 }
 func (m *QueryBestSwapExactAmountInRoutesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -446,9 +604,14 @@ func (m *QueryBestSwapExactAmountInRoutesRequest) XXX_DiscardUnknown() {
 var xxx_messageInfo_QueryBestSwapExactAmountInRoutesRequest proto.InternalMessageInfo
 
 type QueryBestSwapExactAmountInRoutesResponse struct {
+	// routes and results are set only when the input is not split.
 	Routes  []uint64          `protobuf:"varint,1,rep,packed,name=routes,proto3" json:"routes,omitempty"`
 	Output  types.DecCoin     `protobuf:"bytes,2,opt,name=output,proto3" json:"output"`
 	Results []SwapRouteResult `protobuf:"bytes,3,rep,name=results,proto3" json:"results"`
+	// weighted_routes and weighted_results are set only when the input is split
+	// across multiple routes.
+	WeightedRoutes  []WeightedSwapRoute       `protobuf:"bytes,4,rep,name=weighted_routes,json=weightedRoutes,proto3" json:"weighted_routes"`
+	WeightedResults []WeightedSwapRouteResult `protobuf:"bytes,5,rep,name=weighted_results,json=weightedResults,proto3" json:"weighted_results"`
 }
 
 func (m *QueryBestSwapExactAmountInRoutesResponse) Reset() {
@@ -457,7 +620,7 @@ func (m *QueryBestSwapExactAmountInRoutesResponse) Reset() {
 func (m *QueryBestSwapExactAmountInRoutesResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryBestSwapExactAmountInRoutesResponse) ProtoMessage()    {}
 func (*QueryBestSwapExactAmountInRoutesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1fee35d2c78eeddd, []int{11}
+	return fileDescriptor_1fee35d2c78eeddd, []int{15}
 }
 func (m *QueryBestSwapExactAmountInRoutesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -486,6 +649,89 @@ func (m *QueryBestSwapExactAmountInRoutesResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_QueryBestSwapExactAmountInRoutesResponse proto.InternalMessageInfo
 
+type QueryBestSwapExactAmountOutRoutesRequest struct {
+	InputDenom string `protobuf:"bytes,1,opt,name=input_denom,json=inputDenom,proto3" json:"input_denom,omitempty"`
+	Output     string `protobuf:"bytes,2,opt,name=output,proto3" json:"output,omitempty"`
+}
+
+func (m *QueryBestSwapExactAmountOutRoutesRequest) Reset() {
+	*m = QueryBestSwapExactAmountOutRoutesRequest{}
+}
+func (m *QueryBestSwapExactAmountOutRoutesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBestSwapExactAmountOutRoutesRequest) ProtoMessage()    {}
+func (*QueryBestSwapExactAmountOutRoutesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1fee35d2c78eeddd, []int{16}
+}
+func (m *QueryBestSwapExactAmountOutRoutesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBestSwapExactAmountOutRoutesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBestSwapExactAmountOutRoutesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBestSwapExactAmountOutRoutesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBestSwapExactAmountOutRoutesRequest.Merge(m, src)
+}
+func (m *QueryBestSwapExactAmountOutRoutesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBestSwapExactAmountOutRoutesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBestSwapExactAmountOutRoutesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBestSwapExactAmountOutRoutesRequest proto.InternalMessageInfo
+
+type QueryBestSwapExactAmountOutRoutesResponse struct {
+	Routes  []uint64          `protobuf:"varint,1,rep,packed,name=routes,proto3" json:"routes,omitempty"`
+	Input   types.DecCoin     `protobuf:"bytes,2,opt,name=input,proto3" json:"input"`
+	Results []SwapRouteResult `protobuf:"bytes,3,rep,name=results,proto3" json:"results"`
+}
+
+func (m *QueryBestSwapExactAmountOutRoutesResponse) Reset() {
+	*m = QueryBestSwapExactAmountOutRoutesResponse{}
+}
+func (m *QueryBestSwapExactAmountOutRoutesResponse) String() string {
+	return proto.CompactTextString(m)
+}
+func (*QueryBestSwapExactAmountOutRoutesResponse) ProtoMessage() {}
+func (*QueryBestSwapExactAmountOutRoutesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1fee35d2c78eeddd, []int{17}
+}
+func (m *QueryBestSwapExactAmountOutRoutesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBestSwapExactAmountOutRoutesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBestSwapExactAmountOutRoutesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBestSwapExactAmountOutRoutesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBestSwapExactAmountOutRoutesResponse.Merge(m, src)
+}
+func (m *QueryBestSwapExactAmountOutRoutesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBestSwapExactAmountOutRoutesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBestSwapExactAmountOutRoutesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBestSwapExactAmountOutRoutesResponse proto.InternalMessageInfo
+
 type QueryOrderBookRequest struct {
 	MarketId uint64 `protobuf:"varint,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
 }
@@ -494,7 +740,7 @@ func (m *QueryOrderBookRequest) Reset()         { *m = QueryOrderBookRequest{} }
 func (m *QueryOrderBookRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryOrderBookRequest) ProtoMessage()    {}
 func (*QueryOrderBookRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1fee35d2c78eeddd, []int{12}
+	return fileDescriptor_1fee35d2c78eeddd, []int{18}
 }
 func (m *QueryOrderBookRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -531,7 +777,7 @@ func (m *QueryOrderBookResponse) Reset()         { *m = QueryOrderBookResponse{}
 func (m *QueryOrderBookResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryOrderBookResponse) ProtoMessage()    {}
 func (*QueryOrderBookResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1fee35d2c78eeddd, []int{13}
+	return fileDescriptor_1fee35d2c78eeddd, []int{19}
 }
 func (m *QueryOrderBookResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -560,30 +806,25 @@ func (m *QueryOrderBookResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_QueryOrderBookResponse proto.InternalMessageInfo
 
-type MarketResponse struct {
-	Id                  uint64                                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	BaseDenom           string                                  `protobuf:"bytes,2,opt,name=base_denom,json=baseDenom,proto3" json:"base_denom,omitempty"`
-	QuoteDenom          string                                  `protobuf:"bytes,3,opt,name=quote_denom,json=quoteDenom,proto3" json:"quote_denom,omitempty"`
-	EscrowAddress       string                                  `protobuf:"bytes,4,opt,name=escrow_address,json=escrowAddress,proto3" json:"escrow_address,omitempty"`
-	MakerFeeRate        github_com_cosmos_cosmos_sdk_types.Dec  `protobuf:"bytes,5,opt,name=maker_fee_rate,json=makerFeeRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"maker_fee_rate"`
-	TakerFeeRate        github_com_cosmos_cosmos_sdk_types.Dec  `protobuf:"bytes,6,opt,name=taker_fee_rate,json=takerFeeRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"taker_fee_rate"`
-	OrderSourceFeeRatio github_com_cosmos_cosmos_sdk_types.Dec  `protobuf:"bytes,7,opt,name=order_source_fee_ratio,json=orderSourceFeeRatio,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"order_source_fee_ratio"`
-	LastPrice           *github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,8,opt,name=last_price,json=lastPrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"last_price,omitempty"`
-	LastMatchingHeight  int64                                   `protobuf:"varint,9,opt,name=last_matching_height,json=lastMatchingHeight,proto3" json:"last_matching_height,omitempty"`
+type QueryTWAPRequest struct {
+	MarketId uint64 `protobuf:"varint,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	// window is the duration to calculate the time-weighted average price over,
+	// in Go's duration format such as "30m".
+	Window string `protobuf:"bytes,2,opt,name=window,proto3" json:"window,omitempty"`
 }
 
-func (m *MarketResponse) Reset()         { *m = MarketResponse{} }
-func (m *MarketResponse) String() string { return proto.CompactTextString(m) }
-func (*MarketResponse) ProtoMessage()    {}
-func (*MarketResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1fee35d2c78eeddd, []int{14}
+func (m *QueryTWAPRequest) Reset()         { *m = QueryTWAPRequest{} }
+func (m *QueryTWAPRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTWAPRequest) ProtoMessage()    {}
+func (*QueryTWAPRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1fee35d2c78eeddd, []int{20}
 }
-func (m *MarketResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryTWAPRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MarketResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryTWAPRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MarketResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryTWAPRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -593,739 +834,1166 @@ func (m *MarketResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, erro
 		return b[:n], nil
 	}
 }
-func (m *MarketResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MarketResponse.Merge(m, src)
+func (m *QueryTWAPRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTWAPRequest.Merge(m, src)
 }
-func (m *MarketResponse) XXX_Size() int {
+func (m *QueryTWAPRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *MarketResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MarketResponse.DiscardUnknown(m)
+func (m *QueryTWAPRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTWAPRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MarketResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryTWAPRequest proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*QueryParamsRequest)(nil), "crescent.exchange.v1beta1.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "crescent.exchange.v1beta1.QueryParamsResponse")
-	proto.RegisterType((*QueryAllMarketsRequest)(nil), "crescent.exchange.v1beta1.QueryAllMarketsRequest")
-	proto.RegisterType((*QueryAllMarketsResponse)(nil), "crescent.exchange.v1beta1.QueryAllMarketsResponse")
-	proto.RegisterType((*QueryMarketRequest)(nil), "crescent.exchange.v1beta1.QueryMarketRequest")
-	proto.RegisterType((*QueryMarketResponse)(nil), "crescent.exchange.v1beta1.QueryMarketResponse")
-	proto.RegisterType((*QueryAllOrdersRequest)(nil), "crescent.exchange.v1beta1.QueryAllOrdersRequest")
-	proto.RegisterType((*QueryAllOrdersResponse)(nil), "crescent.exchange.v1beta1.QueryAllOrdersResponse")
-	proto.RegisterType((*QueryOrderRequest)(nil), "crescent.exchange.v1beta1.QueryOrderRequest")
-	proto.RegisterType((*QueryOrderResponse)(nil), "crescent.exchange.v1beta1.QueryOrderResponse")
-	proto.RegisterType((*QueryBestSwapExactAmountInRoutesRequest)(nil), "crescent.exchange.v1beta1.QueryBestSwapExactAmountInRoutesRequest")
-	proto.RegisterType((*QueryBestSwapExactAmountInRoutesResponse)(nil), "crescent.exchange.v1beta1.QueryBestSwapExactAmountInRoutesResponse")
-	proto.RegisterType((*QueryOrderBookRequest)(nil), "crescent.exchange.v1beta1.QueryOrderBookRequest")
-	proto.RegisterType((*QueryOrderBookResponse)(nil), "crescent.exchange.v1beta1.QueryOrderBookResponse")
-	proto.RegisterType((*MarketResponse)(nil), "crescent.exchange.v1beta1.MarketResponse")
+type QueryTWAPResponse struct {
+	Twap github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=twap,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"twap"`
 }
 
-func init() {
-	proto.RegisterFile("crescent/exchange/v1beta1/query.proto", fileDescriptor_1fee35d2c78eeddd)
+func (m *QueryTWAPResponse) Reset()         { *m = QueryTWAPResponse{} }
+func (m *QueryTWAPResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTWAPResponse) ProtoMessage()    {}
+func (*QueryTWAPResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1fee35d2c78eeddd, []int{21}
 }
-
-var fileDescriptor_1fee35d2c78eeddd = []byte{
-	// 1157 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x57, 0x4f, 0x6f, 0xdc, 0x44,
-	0x14, 0x8f, 0xf3, 0x67, 0xd3, 0x7d, 0x29, 0x91, 0x98, 0x86, 0xb0, 0xdd, 0x96, 0x4d, 0x62, 0xda,
-	0x34, 0x8d, 0x1a, 0x3b, 0x09, 0x05, 0x01, 0xe5, 0x8f, 0x92, 0x94, 0x96, 0x80, 0x2a, 0x8a, 0x8b,
-	0x7a, 0xe0, 0x80, 0xf1, 0x7a, 0x87, 0x8d, 0xb5, 0x59, 0x8f, 0xe3, 0x19, 0x37, 0xa9, 0xaa, 0x5e,
-	0xf8, 0x04, 0x08, 0x04, 0x07, 0x38, 0x54, 0x7c, 0x00, 0x4e, 0x7c, 0x00, 0xae, 0x11, 0xa7, 0x4a,
-	0x5c, 0x10, 0x87, 0x0a, 0x12, 0x6e, 0x7c, 0x09, 0xe4, 0x37, 0x33, 0xde, 0x75, 0xa2, 0xac, 0x77,
-	0x03, 0xa7, 0x64, 0x67, 0xde, 0xef, 0xf7, 0x7e, 0xef, 0xfd, 0x9e, 0xfd, 0x76, 0xe1, 0xb2, 0x1f,
-	0x53, 0xee, 0xd3, 0x50, 0xd8, 0x74, 0xcf, 0xdf, 0xf2, 0xc2, 0x26, 0xb5, 0x1f, 0xac, 0xd4, 0xa9,
-	0xf0, 0x56, 0xec, 0x9d, 0x84, 0xc6, 0x0f, 0xad, 0x28, 0x66, 0x82, 0x91, 0xf3, 0x3a, 0xcc, 0xd2,
-	0x61, 0x96, 0x0a, 0xab, 0x4e, 0x35, 0x59, 0x93, 0x61, 0x94, 0x9d, 0xfe, 0x27, 0x01, 0xd5, 0x8b,
-	0x4d, 0xc6, 0x9a, 0xdb, 0xd4, 0xf6, 0xa2, 0xc0, 0xf6, 0xc2, 0x90, 0x09, 0x4f, 0x04, 0x2c, 0xe4,
-	0xea, 0xb6, 0xe6, 0x33, 0xde, 0x66, 0xdc, 0xae, 0x7b, 0xbc, 0x93, 0xcf, 0x67, 0x41, 0xa8, 0xee,
-	0x17, 0xbb, 0xef, 0x51, 0x47, 0x16, 0x15, 0x79, 0xcd, 0x20, 0x44, 0x32, 0x15, 0xbb, 0x70, 0x72,
-	0x05, 0x99, 0x56, 0x19, 0x39, 0x7f, 0x72, 0x64, 0xe4, 0xc5, 0x5e, 0x9b, 0x67, 0xd9, 0x4f, 0x8c,
-	0x63, 0x71, 0x83, 0xc6, 0x6e, 0x9d, 0xb1, 0x96, 0x8c, 0x35, 0xa7, 0x80, 0x7c, 0x9c, 0xea, 0xbb,
-	0x8b, 0x04, 0x0e, 0xdd, 0x49, 0x28, 0x17, 0xe6, 0x7d, 0x38, 0x97, 0x3b, 0xe5, 0x11, 0x0b, 0x39,
-	0x25, 0xef, 0x42, 0x49, 0x26, 0xaa, 0x18, 0xb3, 0xc6, 0xc2, 0xc4, 0xea, 0x9c, 0x75, 0x62, 0x5b,
-	0x2d, 0x09, 0x5d, 0x1f, 0xdd, 0x7f, 0x36, 0x33, 0xe4, 0x28, 0x98, 0xf9, 0x39, 0x4c, 0x23, 0xef,
-	0xda, 0xf6, 0xf6, 0x1d, 0x2f, 0x6e, 0x51, 0xa1, 0x33, 0x92, 0x5b, 0x00, 0x9d, 0xce, 0x28, 0xfa,
-	0x79, 0x4b, 0xb6, 0xd1, 0x4a, 0xdb, 0x68, 0x49, 0x3b, 0x3b, 0xf4, 0x4d, 0xaa, 0xb0, 0x4e, 0x17,
-	0xd2, 0xfc, 0xc9, 0x80, 0x17, 0x8f, 0xa5, 0x50, 0xf2, 0x37, 0x61, 0xbc, 0x2d, 0x8f, 0x2a, 0xc6,
-	0xec, 0xc8, 0xc2, 0xc4, 0xea, 0xd5, 0x1e, 0xfa, 0x25, 0x58, 0x63, 0x55, 0x1d, 0x1a, 0x4f, 0x6e,
-	0xe7, 0xe4, 0x0e, 0xa3, 0xdc, 0x2b, 0x85, 0x72, 0x25, 0x57, 0x4e, 0xef, 0x8a, 0xea, 0xbf, 0x4e,
-	0x27, 0xbb, 0x71, 0x01, 0xca, 0x32, 0x93, 0x1b, 0x34, 0xb0, 0x19, 0xa3, 0xce, 0x19, 0x79, 0xb0,
-	0xd9, 0x30, 0x3f, 0x53, 0xe6, 0xe4, 0x15, 0x92, 0xdb, 0x50, 0x92, 0x21, 0xaa, 0x7b, 0x03, 0x17,
-	0xa7, 0xe0, 0xe6, 0x77, 0x06, 0xbc, 0xa0, 0x5b, 0xf8, 0x51, 0x3a, 0x2f, 0x99, 0x49, 0x15, 0x18,
-	0xc7, 0x01, 0xa2, 0x31, 0xe6, 0x28, 0x3b, 0xfa, 0x63, 0x5e, 0xf0, 0x70, 0x5e, 0xf0, 0x11, 0x6f,
-	0x47, 0x4e, 0xed, 0xed, 0x8f, 0x46, 0x67, 0x7c, 0xb4, 0x30, 0x55, 0xfc, 0x3b, 0x50, 0x42, 0x29,
-	0xda, 0xd9, 0xd9, 0x1e, 0xc5, 0x23, 0x54, 0xd7, 0x2c, 0x51, 0xff, 0x9f, 0x9f, 0x16, 0x3c, 0x8f,
-	0x12, 0x31, 0x89, 0xee, 0xdb, 0x79, 0x38, 0x23, 0x1f, 0xbc, 0xcc, 0x4d, 0xd9, 0xb8, 0xcd, 0x86,
-	0xe9, 0x28, 0xff, 0x55, 0xbc, 0x2a, 0xe7, 0x2d, 0x18, 0xc3, 0x00, 0x65, 0x65, 0xbf, 0xd5, 0x48,
-	0x90, 0x59, 0x87, 0x2b, 0xc8, 0xb9, 0x4e, 0xb9, 0xb8, 0xb7, 0xeb, 0x45, 0xef, 0xed, 0x79, 0xbe,
-	0x58, 0x6b, 0xb3, 0x24, 0x14, 0x9b, 0xa1, 0xc3, 0x12, 0x41, 0x33, 0x47, 0xa7, 0x60, 0x2c, 0x08,
-	0xa3, 0x44, 0x28, 0x3f, 0xe5, 0x07, 0x32, 0x07, 0x67, 0x59, 0x22, 0xa2, 0x44, 0xb8, 0x0d, 0x1a,
-	0xb2, 0x36, 0xf6, 0xa3, 0xec, 0x4c, 0xc8, 0xb3, 0x9b, 0xe9, 0x91, 0xf9, 0xab, 0x01, 0x0b, 0xc5,
-	0x49, 0x54, 0x39, 0xd3, 0x50, 0x8a, 0xf1, 0x04, 0xdd, 0x19, 0x75, 0xd4, 0x27, 0xf2, 0x26, 0x94,
-	0x24, 0xa7, 0xea, 0xf8, 0xc5, 0x5c, 0xc7, 0x75, 0x85, 0x37, 0xa9, 0xbf, 0xc1, 0x82, 0x30, 0x73,
-	0x0c, 0x11, 0xe4, 0x03, 0x18, 0x8f, 0x29, 0x4f, 0xb6, 0x05, 0xaf, 0x8c, 0xa0, 0xe5, 0x8b, 0x3d,
-	0x9a, 0x94, 0x0a, 0x44, 0x4d, 0x0e, 0x42, 0xf4, 0xd3, 0xac, 0x08, 0xcc, 0xeb, 0x6a, 0xe0, 0x65,
-	0x2f, 0x19, 0x6b, 0xf5, 0xf5, 0x1c, 0x52, 0x35, 0x8d, 0x5d, 0x28, 0x55, 0xef, 0x87, 0x30, 0xd1,
-	0x79, 0xd1, 0xea, 0x91, 0xbc, 0x54, 0x68, 0x22, 0x63, 0x2d, 0xa5, 0x0c, 0x98, 0x3e, 0xe0, 0xe6,
-	0xb7, 0xa3, 0x30, 0x79, 0xe4, 0x51, 0x9f, 0x84, 0xe1, 0x4c, 0xcf, 0x70, 0xd0, 0x20, 0x2f, 0x01,
-	0xa4, 0x1d, 0xcb, 0xb9, 0x55, 0x4e, 0x4f, 0xd0, 0x2b, 0x32, 0x03, 0x13, 0x3b, 0x09, 0x13, 0xfa,
-	0x7e, 0x04, 0xef, 0x01, 0x8f, 0x64, 0xc0, 0x65, 0x98, 0xa4, 0xdc, 0x8f, 0xd9, 0xae, 0xeb, 0x35,
-	0x1a, 0x31, 0xe5, 0xbc, 0x32, 0x8a, 0x31, 0xcf, 0xc9, 0xd3, 0x35, 0x79, 0x48, 0x3e, 0x81, 0xc9,
-	0xb6, 0xd7, 0xa2, 0xb1, 0xfb, 0x05, 0xa5, 0x6e, 0xec, 0x09, 0x5a, 0x19, 0x4b, 0xc3, 0xd6, 0xad,
-	0x54, 0xf3, 0x1f, 0xcf, 0x66, 0xe6, 0x9b, 0x81, 0xd8, 0x4a, 0xea, 0x96, 0xcf, 0xda, 0xb6, 0x5a,
-	0x80, 0xf2, 0xcf, 0x12, 0x6f, 0xb4, 0x6c, 0xf1, 0x30, 0xa2, 0x3c, 0x35, 0xd3, 0x39, 0x8b, 0x2c,
-	0xb7, 0x28, 0x75, 0x3c, 0x41, 0x53, 0x56, 0x91, 0x67, 0x2d, 0x9d, 0x8e, 0x55, 0x74, 0xb3, 0xfa,
-	0x30, 0x2d, 0x2d, 0xe0, 0x2c, 0x89, 0x7d, 0xaa, 0xc9, 0x03, 0x56, 0x19, 0x3f, 0x15, 0xfb, 0x39,
-	0x64, 0xbb, 0x87, 0x64, 0x32, 0x47, 0xc0, 0xc8, 0x26, 0xc0, 0xb6, 0xc7, 0x85, 0x1b, 0xc5, 0x81,
-	0x4f, 0x2b, 0x67, 0x90, 0x78, 0x71, 0x00, 0xd2, 0x72, 0x8a, 0xbe, 0x9b, 0x82, 0xc9, 0x32, 0x4c,
-	0x21, 0x55, 0xdb, 0x13, 0xfe, 0x56, 0x10, 0x36, 0xdd, 0x2d, 0x1a, 0x34, 0xb7, 0x44, 0xa5, 0x3c,
-	0x6b, 0x2c, 0x8c, 0x38, 0x24, 0xbd, 0xbb, 0xa3, 0xae, 0xde, 0xc7, 0x9b, 0xd5, 0x5f, 0xca, 0x30,
-	0x86, 0xf3, 0x47, 0xbe, 0x36, 0xa0, 0x24, 0xd7, 0x2d, 0x59, 0xea, 0x31, 0x64, 0xc7, 0xf7, 0x7c,
-	0xd5, 0xea, 0x37, 0x5c, 0x0e, 0x9e, 0x79, 0xf5, 0xcb, 0xdf, 0xfe, 0xfe, 0x66, 0xf8, 0x65, 0x32,
-	0x67, 0x17, 0x7d, 0x15, 0x21, 0x4f, 0x0c, 0x80, 0xce, 0x0e, 0x26, 0x2b, 0x45, 0x99, 0x8e, 0x7d,
-	0x25, 0xa8, 0xae, 0x0e, 0x02, 0x51, 0x02, 0x17, 0x51, 0xe0, 0x25, 0x62, 0xf6, 0x10, 0xa8, 0x77,
-	0xf8, 0x13, 0x03, 0x4a, 0x12, 0x5f, 0xdc, 0xb6, 0xdc, 0x7a, 0x2e, 0x6e, 0x5b, 0xfe, 0x79, 0x35,
-	0x5f, 0x43, 0x55, 0xcb, 0xc4, 0x2a, 0x56, 0x65, 0x3f, 0xca, 0x5e, 0x38, 0x8f, 0xc9, 0x0f, 0x06,
-	0x94, 0xb3, 0x5d, 0x47, 0x96, 0xfb, 0xe8, 0x47, 0x6e, 0x5f, 0x57, 0x57, 0x06, 0x40, 0x0c, 0xe0,
-	0xb0, 0xda, 0x99, 0xdf, 0x1b, 0x30, 0x86, 0x68, 0x72, 0xad, 0x28, 0x4f, 0xf7, 0x36, 0xac, 0x2e,
-	0xf5, 0x19, 0xad, 0x14, 0x5d, 0x47, 0x45, 0x16, 0xb9, 0x56, 0xa8, 0xc8, 0x7e, 0xa4, 0xb7, 0xec,
-	0x63, 0xf2, 0x8f, 0x01, 0x17, 0x7a, 0xac, 0x26, 0xb2, 0x5e, 0x24, 0xa2, 0x78, 0x79, 0x56, 0x37,
-	0xfe, 0x13, 0x87, 0x2a, 0x6f, 0x03, 0xcb, 0x7b, 0x9b, 0xdc, 0xe8, 0x51, 0x5e, 0x9d, 0x72, 0xe1,
-	0xf2, 0x5d, 0x2f, 0x72, 0x69, 0xca, 0xe4, 0x7a, 0x48, 0xe5, 0x06, 0xa1, 0xab, 0x16, 0xe9, 0xcf,
-	0x06, 0x94, 0xb3, 0x1d, 0x52, 0x3c, 0x28, 0x47, 0xf7, 0x5c, 0xf1, 0xa0, 0x1c, 0xdb, 0x71, 0xe6,
-	0x1a, 0xea, 0xbe, 0x41, 0xde, 0x18, 0x6c, 0xa6, 0xbb, 0x7e, 0x81, 0xac, 0xdf, 0xdf, 0xff, 0xab,
-	0x36, 0xb4, 0x7f, 0x50, 0x33, 0x9e, 0x1e, 0xd4, 0x8c, 0x3f, 0x0f, 0x6a, 0xc6, 0x57, 0x87, 0xb5,
-	0xa1, 0xa7, 0x87, 0xb5, 0xa1, 0xdf, 0x0f, 0x6b, 0x43, 0x9f, 0xbe, 0xde, 0xfd, 0x12, 0x55, 0x29,
-	0x96, 0x42, 0x2a, 0x76, 0x59, 0xdc, 0xea, 0xe4, 0x7c, 0xf0, 0xaa, 0xbd, 0xd7, 0x49, 0x8c, 0xaf,
-	0xd6, 0x7a, 0x09, 0x7f, 0xda, 0xbc, 0xf2, 0x6f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x1b, 0x0d, 0xf8,
-	0x96, 0x1c, 0x0e, 0x00, 0x00,
+func (m *QueryTWAPResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTWAPResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTWAPResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTWAPResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTWAPResponse.Merge(m, src)
+}
+func (m *QueryTWAPResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTWAPResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTWAPResponse.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_QueryTWAPResponse proto.InternalMessageInfo
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
-	AllMarkets(ctx context.Context, in *QueryAllMarketsRequest, opts ...grpc.CallOption) (*QueryAllMarketsResponse, error)
-	Market(ctx context.Context, in *QueryMarketRequest, opts ...grpc.CallOption) (*QueryMarketResponse, error)
-	AllOrders(ctx context.Context, in *QueryAllOrdersRequest, opts ...grpc.CallOption) (*QueryAllOrdersResponse, error)
-	Order(ctx context.Context, in *QueryOrderRequest, opts ...grpc.CallOption) (*QueryOrderResponse, error)
-	BestSwapExactAmountInRoutes(ctx context.Context, in *QueryBestSwapExactAmountInRoutesRequest, opts ...grpc.CallOption) (*QueryBestSwapExactAmountInRoutesResponse, error)
-	OrderBook(ctx context.Context, in *QueryOrderBookRequest, opts ...grpc.CallOption) (*QueryOrderBookResponse, error)
+type QueryAccountFeeTierRequest struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+func (m *QueryAccountFeeTierRequest) Reset()         { *m = QueryAccountFeeTierRequest{} }
+func (m *QueryAccountFeeTierRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAccountFeeTierRequest) ProtoMessage()    {}
+func (*QueryAccountFeeTierRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1fee35d2c78eeddd, []int{22}
 }
-
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *QueryAccountFeeTierRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/crescent.exchange.v1beta1.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryAccountFeeTierRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAccountFeeTierRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryAccountFeeTierRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAccountFeeTierRequest.Merge(m, src)
+}
+func (m *QueryAccountFeeTierRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAccountFeeTierRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAccountFeeTierRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) AllMarkets(ctx context.Context, in *QueryAllMarketsRequest, opts ...grpc.CallOption) (*QueryAllMarketsResponse, error) {
-	out := new(QueryAllMarketsResponse)
-	err := c.cc.Invoke(ctx, "/crescent.exchange.v1beta1.Query/AllMarkets", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+var xxx_messageInfo_QueryAccountFeeTierRequest proto.InternalMessageInfo
+
+type QueryAccountFeeTierResponse struct {
+	// volume is the account's trailing 30-day volume.
+	Volume github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=volume,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"volume"`
+	// fee_tier is the account's current fee tier. It is null if the account
+	// doesn't belong to any fee tier.
+	FeeTier *FeeTier `protobuf:"bytes,2,opt,name=fee_tier,json=feeTier,proto3" json:"fee_tier,omitempty"`
 }
 
-func (c *queryClient) Market(ctx context.Context, in *QueryMarketRequest, opts ...grpc.CallOption) (*QueryMarketResponse, error) {
-	out := new(QueryMarketResponse)
-	err := c.cc.Invoke(ctx, "/crescent.exchange.v1beta1.Query/Market", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryAccountFeeTierResponse) Reset()         { *m = QueryAccountFeeTierResponse{} }
+func (m *QueryAccountFeeTierResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAccountFeeTierResponse) ProtoMessage()    {}
+func (*QueryAccountFeeTierResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1fee35d2c78eeddd, []int{23}
+}
+func (m *QueryAccountFeeTierResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAccountFeeTierResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAccountFeeTierResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryAccountFeeTierResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAccountFeeTierResponse.Merge(m, src)
+}
+func (m *QueryAccountFeeTierResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAccountFeeTierResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAccountFeeTierResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) AllOrders(ctx context.Context, in *QueryAllOrdersRequest, opts ...grpc.CallOption) (*QueryAllOrdersResponse, error) {
-	out := new(QueryAllOrdersResponse)
-	err := c.cc.Invoke(ctx, "/crescent.exchange.v1beta1.Query/AllOrders", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+var xxx_messageInfo_QueryAccountFeeTierResponse proto.InternalMessageInfo
+
+type QueryAccountCancelAftersRequest struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
 }
 
-func (c *queryClient) Order(ctx context.Context, in *QueryOrderRequest, opts ...grpc.CallOption) (*QueryOrderResponse, error) {
-	out := new(QueryOrderResponse)
-	err := c.cc.Invoke(ctx, "/crescent.exchange.v1beta1.Query/Order", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryAccountCancelAftersRequest) Reset()         { *m = QueryAccountCancelAftersRequest{} }
+func (m *QueryAccountCancelAftersRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAccountCancelAftersRequest) ProtoMessage()    {}
+func (*QueryAccountCancelAftersRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1fee35d2c78eeddd, []int{24}
+}
+func (m *QueryAccountCancelAftersRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAccountCancelAftersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAccountCancelAftersRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryAccountCancelAftersRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAccountCancelAftersRequest.Merge(m, src)
+}
+func (m *QueryAccountCancelAftersRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAccountCancelAftersRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAccountCancelAftersRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) BestSwapExactAmountInRoutes(ctx context.Context, in *QueryBestSwapExactAmountInRoutesRequest, opts ...grpc.CallOption) (*QueryBestSwapExactAmountInRoutesResponse, error) {
-	out := new(QueryBestSwapExactAmountInRoutesResponse)
-	err := c.cc.Invoke(ctx, "/crescent.exchange.v1beta1.Query/BestSwapExactAmountInRoutes", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+var xxx_messageInfo_QueryAccountCancelAftersRequest proto.InternalMessageInfo
+
+type QueryAccountCancelAftersResponse struct {
+	CancelAfters []CancelAfterResponse `protobuf:"bytes,1,rep,name=cancel_afters,json=cancelAfters,proto3" json:"cancel_afters"`
 }
 
-func (c *queryClient) OrderBook(ctx context.Context, in *QueryOrderBookRequest, opts ...grpc.CallOption) (*QueryOrderBookResponse, error) {
-	out := new(QueryOrderBookResponse)
-	err := c.cc.Invoke(ctx, "/crescent.exchange.v1beta1.Query/OrderBook", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryAccountCancelAftersResponse) Reset()         { *m = QueryAccountCancelAftersResponse{} }
+func (m *QueryAccountCancelAftersResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAccountCancelAftersResponse) ProtoMessage()    {}
+func (*QueryAccountCancelAftersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1fee35d2c78eeddd, []int{25}
+}
+func (m *QueryAccountCancelAftersResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAccountCancelAftersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAccountCancelAftersResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryAccountCancelAftersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAccountCancelAftersResponse.Merge(m, src)
+}
+func (m *QueryAccountCancelAftersResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAccountCancelAftersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAccountCancelAftersResponse.DiscardUnknown(m)
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
-	AllMarkets(context.Context, *QueryAllMarketsRequest) (*QueryAllMarketsResponse, error)
-	Market(context.Context, *QueryMarketRequest) (*QueryMarketResponse, error)
-	AllOrders(context.Context, *QueryAllOrdersRequest) (*QueryAllOrdersResponse, error)
-	Order(context.Context, *QueryOrderRequest) (*QueryOrderResponse, error)
-	BestSwapExactAmountInRoutes(context.Context, *QueryBestSwapExactAmountInRoutesRequest) (*QueryBestSwapExactAmountInRoutesResponse, error)
-	OrderBook(context.Context, *QueryOrderBookRequest) (*QueryOrderBookResponse, error)
+var xxx_messageInfo_QueryAccountCancelAftersResponse proto.InternalMessageInfo
+
+type QueryAccountReferrerStatsRequest struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// market_id, if set, filters the stats by the market.
+	MarketId uint64 `protobuf:"varint,2,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+func (m *QueryAccountReferrerStatsRequest) Reset()         { *m = QueryAccountReferrerStatsRequest{} }
+func (m *QueryAccountReferrerStatsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAccountReferrerStatsRequest) ProtoMessage()    {}
+func (*QueryAccountReferrerStatsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1fee35d2c78eeddd, []int{26}
+}
+func (m *QueryAccountReferrerStatsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAccountReferrerStatsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAccountReferrerStatsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAccountReferrerStatsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAccountReferrerStatsRequest.Merge(m, src)
+}
+func (m *QueryAccountReferrerStatsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAccountReferrerStatsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAccountReferrerStatsRequest.DiscardUnknown(m)
 }
 
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+var xxx_messageInfo_QueryAccountReferrerStatsRequest proto.InternalMessageInfo
+
+type QueryAccountReferrerStatsResponse struct {
+	ReferrerStats []ReferrerStatsResponse `protobuf:"bytes,1,rep,name=referrer_stats,json=referrerStats,proto3" json:"referrer_stats"`
 }
-func (*UnimplementedQueryServer) AllMarkets(ctx context.Context, req *QueryAllMarketsRequest) (*QueryAllMarketsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AllMarkets not implemented")
+
+func (m *QueryAccountReferrerStatsResponse) Reset()         { *m = QueryAccountReferrerStatsResponse{} }
+func (m *QueryAccountReferrerStatsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAccountReferrerStatsResponse) ProtoMessage()    {}
+func (*QueryAccountReferrerStatsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1fee35d2c78eeddd, []int{27}
 }
-func (*UnimplementedQueryServer) Market(ctx context.Context, req *QueryMarketRequest) (*QueryMarketResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Market not implemented")
+func (m *QueryAccountReferrerStatsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) AllOrders(ctx context.Context, req *QueryAllOrdersRequest) (*QueryAllOrdersResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AllOrders not implemented")
+func (m *QueryAccountReferrerStatsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAccountReferrerStatsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) Order(ctx context.Context, req *QueryOrderRequest) (*QueryOrderResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Order not implemented")
+func (m *QueryAccountReferrerStatsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAccountReferrerStatsResponse.Merge(m, src)
 }
-func (*UnimplementedQueryServer) BestSwapExactAmountInRoutes(ctx context.Context, req *QueryBestSwapExactAmountInRoutesRequest) (*QueryBestSwapExactAmountInRoutesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method BestSwapExactAmountInRoutes not implemented")
+func (m *QueryAccountReferrerStatsResponse) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedQueryServer) OrderBook(ctx context.Context, req *QueryOrderBookRequest) (*QueryOrderBookResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method OrderBook not implemented")
+func (m *QueryAccountReferrerStatsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAccountReferrerStatsResponse.DiscardUnknown(m)
 }
 
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+var xxx_messageInfo_QueryAccountReferrerStatsResponse proto.InternalMessageInfo
+
+type MarketResponse struct {
+	Id                  uint64                                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	BaseDenom           string                                  `protobuf:"bytes,2,opt,name=base_denom,json=baseDenom,proto3" json:"base_denom,omitempty"`
+	QuoteDenom          string                                  `protobuf:"bytes,3,opt,name=quote_denom,json=quoteDenom,proto3" json:"quote_denom,omitempty"`
+	EscrowAddress       string                                  `protobuf:"bytes,4,opt,name=escrow_address,json=escrowAddress,proto3" json:"escrow_address,omitempty"`
+	MakerFeeRate        github_com_cosmos_cosmos_sdk_types.Dec  `protobuf:"bytes,5,opt,name=maker_fee_rate,json=makerFeeRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"maker_fee_rate"`
+	TakerFeeRate        github_com_cosmos_cosmos_sdk_types.Dec  `protobuf:"bytes,6,opt,name=taker_fee_rate,json=takerFeeRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"taker_fee_rate"`
+	OrderSourceFeeRatio github_com_cosmos_cosmos_sdk_types.Dec  `protobuf:"bytes,7,opt,name=order_source_fee_ratio,json=orderSourceFeeRatio,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"order_source_fee_ratio"`
+	LastPrice           *github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,8,opt,name=last_price,json=lastPrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"last_price,omitempty"`
+	LastMatchingHeight  int64                                   `protobuf:"varint,9,opt,name=last_matching_height,json=lastMatchingHeight,proto3" json:"last_matching_height,omitempty"`
+	Status              MarketStatus                            `protobuf:"varint,10,opt,name=status,proto3,enum=crescent.exchange.v1beta1.MarketStatus" json:"status,omitempty"`
+	TickSize            github_com_cosmos_cosmos_sdk_types.Dec  `protobuf:"bytes,11,opt,name=tick_size,json=tickSize,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"tick_size"`
+	MinOrderQuantity    github_com_cosmos_cosmos_sdk_types.Dec  `protobuf:"bytes,12,opt,name=min_order_quantity,json=minOrderQuantity,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"min_order_quantity"`
+	LotSize             github_com_cosmos_cosmos_sdk_types.Dec  `protobuf:"bytes,13,opt,name=lot_size,json=lotSize,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"lot_size"`
+	SelfTradePrevention SelfTradePrevention                     `protobuf:"varint,14,opt,name=self_trade_prevention,json=selfTradePrevention,proto3,enum=crescent.exchange.v1beta1.SelfTradePrevention" json:"self_trade_prevention,omitempty"`
+	// circuit_breaker_end_height is the height at which the market reopens if
+	// its circuit breaker is tripped.
+	CircuitBreakerEndHeight int64 `protobuf:"varint,15,opt,name=circuit_breaker_end_height,json=circuitBreakerEndHeight,proto3" json:"circuit_breaker_end_height,omitempty"`
+	// min_band_price and max_band_price are the range the last price can move
+	// within without tripping the circuit breaker. They are empty if the
+	// circuit breaker is disabled or there's no price within the window.
+	MinBandPrice *github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,16,opt,name=min_band_price,json=minBandPrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"min_band_price,omitempty"`
+	MaxBandPrice *github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,17,opt,name=max_band_price,json=maxBandPrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_band_price,omitempty"`
+	// volume_24h is the market's trading volume in the last 24 hours.
+	Volume24h MarketVolume `protobuf:"bytes,18,opt,name=volume_24h,json=volume24h,proto3" json:"volume_24h"`
 }
 
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/crescent.exchange.v1beta1.Query/Params",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+func (m *MarketResponse) Reset()         { *m = MarketResponse{} }
+func (m *MarketResponse) String() string { return proto.CompactTextString(m) }
+func (*MarketResponse) ProtoMessage()    {}
+func (*MarketResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1fee35d2c78eeddd, []int{28}
+}
+func (m *MarketResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MarketResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MarketResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MarketResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MarketResponse.Merge(m, src)
+}
+func (m *MarketResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MarketResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MarketResponse.DiscardUnknown(m)
 }
 
-func _Query_AllMarkets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAllMarketsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).AllMarkets(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/crescent.exchange.v1beta1.Query/AllMarkets",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).AllMarkets(ctx, req.(*QueryAllMarketsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+var xxx_messageInfo_MarketResponse proto.InternalMessageInfo
+
+type CancelAfterResponse struct {
+	MarketId    uint64    `protobuf:"varint,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	CancelAfter time.Time `protobuf:"bytes,2,opt,name=cancel_after,json=cancelAfter,proto3,stdtime" json:"cancel_after"`
 }
 
-func _Query_Market_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryMarketRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Market(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/crescent.exchange.v1beta1.Query/Market",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Market(ctx, req.(*QueryMarketRequest))
+func (m *CancelAfterResponse) Reset()         { *m = CancelAfterResponse{} }
+func (m *CancelAfterResponse) String() string { return proto.CompactTextString(m) }
+func (*CancelAfterResponse) ProtoMessage()    {}
+func (*CancelAfterResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1fee35d2c78eeddd, []int{29}
+}
+func (m *CancelAfterResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CancelAfterResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CancelAfterResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *CancelAfterResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CancelAfterResponse.Merge(m, src)
+}
+func (m *CancelAfterResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *CancelAfterResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CancelAfterResponse.DiscardUnknown(m)
 }
 
-func _Query_AllOrders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAllOrdersRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).AllOrders(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/crescent.exchange.v1beta1.Query/AllOrders",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).AllOrders(ctx, req.(*QueryAllOrdersRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+var xxx_messageInfo_CancelAfterResponse proto.InternalMessageInfo
+
+type ReferrerStatsResponse struct {
+	MarketId uint64                                      `protobuf:"varint,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	Volume   github_com_cosmos_cosmos_sdk_types.Dec      `protobuf:"bytes,2,opt,name=volume,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"volume"`
+	Fees     github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,3,rep,name=fees,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"fees"`
 }
 
-func _Query_Order_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryOrderRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Order(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/crescent.exchange.v1beta1.Query/Order",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Order(ctx, req.(*QueryOrderRequest))
+func (m *ReferrerStatsResponse) Reset()         { *m = ReferrerStatsResponse{} }
+func (m *ReferrerStatsResponse) String() string { return proto.CompactTextString(m) }
+func (*ReferrerStatsResponse) ProtoMessage()    {}
+func (*ReferrerStatsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1fee35d2c78eeddd, []int{30}
+}
+func (m *ReferrerStatsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReferrerStatsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ReferrerStatsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *ReferrerStatsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReferrerStatsResponse.Merge(m, src)
+}
+func (m *ReferrerStatsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReferrerStatsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReferrerStatsResponse.DiscardUnknown(m)
 }
 
-func _Query_BestSwapExactAmountInRoutes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryBestSwapExactAmountInRoutesRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).BestSwapExactAmountInRoutes(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/crescent.exchange.v1beta1.Query/BestSwapExactAmountInRoutes",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).BestSwapExactAmountInRoutes(ctx, req.(*QueryBestSwapExactAmountInRoutesRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+var xxx_messageInfo_ReferrerStatsResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*QueryParamsRequest)(nil), "crescent.exchange.v1beta1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "crescent.exchange.v1beta1.QueryParamsResponse")
+	proto.RegisterType((*QueryAllMarketsRequest)(nil), "crescent.exchange.v1beta1.QueryAllMarketsRequest")
+	proto.RegisterType((*QueryAllMarketsResponse)(nil), "crescent.exchange.v1beta1.QueryAllMarketsResponse")
+	proto.RegisterType((*QueryMarketRequest)(nil), "crescent.exchange.v1beta1.QueryMarketRequest")
+	proto.RegisterType((*QueryMarketResponse)(nil), "crescent.exchange.v1beta1.QueryMarketResponse")
+	proto.RegisterType((*QueryAllOrdersRequest)(nil), "crescent.exchange.v1beta1.QueryAllOrdersRequest")
+	proto.RegisterType((*QueryAllOrdersResponse)(nil), "crescent.exchange.v1beta1.QueryAllOrdersResponse")
+	proto.RegisterType((*QueryOrderRequest)(nil), "crescent.exchange.v1beta1.QueryOrderRequest")
+	proto.RegisterType((*QueryOrderResponse)(nil), "crescent.exchange.v1beta1.QueryOrderResponse")
+	proto.RegisterType((*QueryAllTriggerOrdersRequest)(nil), "crescent.exchange.v1beta1.QueryAllTriggerOrdersRequest")
+	proto.RegisterType((*QueryAllTriggerOrdersResponse)(nil), "crescent.exchange.v1beta1.QueryAllTriggerOrdersResponse")
+	proto.RegisterType((*QueryTriggerOrderRequest)(nil), "crescent.exchange.v1beta1.QueryTriggerOrderRequest")
+	proto.RegisterType((*QueryTriggerOrderResponse)(nil), "crescent.exchange.v1beta1.QueryTriggerOrderResponse")
+	proto.RegisterType((*QueryBestSwapExactAmountInRoutesRequest)(nil), "crescent.exchange.v1beta1.QueryBestSwapExactAmountInRoutesRequest")
+	proto.RegisterType((*QueryBestSwapExactAmountInRoutesResponse)(nil), "crescent.exchange.v1beta1.QueryBestSwapExactAmountInRoutesResponse")
+	proto.RegisterType((*QueryBestSwapExactAmountOutRoutesRequest)(nil), "crescent.exchange.v1beta1.QueryBestSwapExactAmountOutRoutesRequest")
+	proto.RegisterType((*QueryBestSwapExactAmountOutRoutesResponse)(nil), "crescent.exchange.v1beta1.QueryBestSwapExactAmountOutRoutesResponse")
+	proto.RegisterType((*QueryOrderBookRequest)(nil), "crescent.exchange.v1beta1.QueryOrderBookRequest")
+	proto.RegisterType((*QueryOrderBookResponse)(nil), "crescent.exchange.v1beta1.QueryOrderBookResponse")
+	proto.RegisterType((*QueryTWAPRequest)(nil), "crescent.exchange.v1beta1.QueryTWAPRequest")
+	proto.RegisterType((*QueryTWAPResponse)(nil), "crescent.exchange.v1beta1.QueryTWAPResponse")
+	proto.RegisterType((*QueryAccountFeeTierRequest)(nil), "crescent.exchange.v1beta1.QueryAccountFeeTierRequest")
+	proto.RegisterType((*QueryAccountFeeTierResponse)(nil), "crescent.exchange.v1beta1.QueryAccountFeeTierResponse")
+	proto.RegisterType((*QueryAccountCancelAftersRequest)(nil), "crescent.exchange.v1beta1.QueryAccountCancelAftersRequest")
+	proto.RegisterType((*QueryAccountCancelAftersResponse)(nil), "crescent.exchange.v1beta1.QueryAccountCancelAftersResponse")
+	proto.RegisterType((*QueryAccountReferrerStatsRequest)(nil), "crescent.exchange.v1beta1.QueryAccountReferrerStatsRequest")
+	proto.RegisterType((*QueryAccountReferrerStatsResponse)(nil), "crescent.exchange.v1beta1.QueryAccountReferrerStatsResponse")
+	proto.RegisterType((*MarketResponse)(nil), "crescent.exchange.v1beta1.MarketResponse")
+	proto.RegisterType((*CancelAfterResponse)(nil), "crescent.exchange.v1beta1.CancelAfterResponse")
+	proto.RegisterType((*ReferrerStatsResponse)(nil), "crescent.exchange.v1beta1.ReferrerStatsResponse")
 }
 
-func _Query_OrderBook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryOrderBookRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).OrderBook(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/crescent.exchange.v1beta1.Query/OrderBook",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).OrderBook(ctx, req.(*QueryOrderBookRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+func init() {
+	proto.RegisterFile("crescent/exchange/v1beta1/query.proto", fileDescriptor_1fee35d2c78eeddd)
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "crescent.exchange.v1beta1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-		{
-			MethodName: "AllMarkets",
-			Handler:    _Query_AllMarkets_Handler,
-		},
-		{
-			MethodName: "Market",
-			Handler:    _Query_Market_Handler,
-		},
-		{
-			MethodName: "AllOrders",
-			Handler:    _Query_AllOrders_Handler,
-		},
-		{
-			MethodName: "Order",
-			Handler:    _Query_Order_Handler,
-		},
-		{
-			MethodName: "BestSwapExactAmountInRoutes",
-			Handler:    _Query_BestSwapExactAmountInRoutes_Handler,
-		},
-		{
-			MethodName: "OrderBook",
-			Handler:    _Query_OrderBook_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "crescent/exchange/v1beta1/query.proto",
+var fileDescriptor_1fee35d2c78eeddd = []byte{
+	// 2101 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x59, 0xcb, 0x6f, 0xdc, 0xc6,
+	0x19, 0x17, 0xf5, 0x58, 0x69, 0x3f, 0x49, 0x1b, 0x79, 0x24, 0x2b, 0x6b, 0xda, 0xd1, 0xca, 0x6c,
+	0x62, 0xcb, 0x2f, 0xae, 0x25, 0xcb, 0xaa, 0x1b, 0x39, 0x2e, 0xb4, 0x76, 0xec, 0xaa, 0x41, 0x10,
+	0x85, 0x12, 0x6c, 0xb8, 0x2f, 0x96, 0xcb, 0x1d, 0xad, 0x08, 0xed, 0x72, 0xd6, 0xe4, 0x50, 0x92,
+	0x63, 0xf8, 0xd0, 0x9c, 0x7b, 0x08, 0x5a, 0xa0, 0x87, 0x16, 0x6d, 0x50, 0xa0, 0xa7, 0x02, 0x3d,
+	0xf5, 0xd0, 0xf6, 0xd2, 0x5e, 0xdd, 0x5b, 0x80, 0x1c, 0x5a, 0xf4, 0x60, 0xb7, 0x76, 0x6f, 0x2d,
+	0xd0, 0x7f, 0xa1, 0xe0, 0x3c, 0x76, 0x49, 0x79, 0x45, 0x2e, 0xd7, 0x3e, 0xe4, 0xe4, 0xe5, 0xf0,
+	0xfb, 0xfd, 0xbe, 0xdf, 0xf7, 0xe0, 0xcc, 0xe8, 0x33, 0xbc, 0x63, 0x7b, 0xd8, 0xb7, 0xb1, 0x4b,
+	0xcb, 0xf8, 0xc0, 0xde, 0xb1, 0xdc, 0x3a, 0x2e, 0xef, 0x2d, 0x56, 0x31, 0xb5, 0x16, 0xcb, 0x0f,
+	0x02, 0xec, 0x3d, 0xd4, 0x5b, 0x1e, 0xa1, 0x04, 0x9d, 0x90, 0x66, 0xba, 0x34, 0xd3, 0x85, 0x99,
+	0x3a, 0x53, 0x27, 0x75, 0xc2, 0xac, 0xca, 0xe1, 0x2f, 0x0e, 0x50, 0x4f, 0xd5, 0x09, 0xa9, 0x37,
+	0x70, 0xd9, 0x6a, 0x39, 0x65, 0xcb, 0x75, 0x09, 0xb5, 0xa8, 0x43, 0x5c, 0x5f, 0xbc, 0x9d, 0xb3,
+	0x89, 0xdf, 0x24, 0x7e, 0xb9, 0x6a, 0xf9, 0x1d, 0x7f, 0x36, 0x71, 0x5c, 0xf1, 0xfe, 0x7c, 0xf4,
+	0x3d, 0xd3, 0xd1, 0xb6, 0x6a, 0x59, 0x75, 0xc7, 0x65, 0x64, 0xc2, 0x76, 0xe1, 0xe8, 0x08, 0xda,
+	0x5a, 0xb9, 0xe5, 0x99, 0xa3, 0x2d, 0x5b, 0x96, 0x67, 0x35, 0xfd, 0xb6, 0xf7, 0x23, 0xed, 0x88,
+	0x57, 0xc3, 0x9e, 0x59, 0x25, 0x64, 0x57, 0xd8, 0x96, 0x44, 0x9c, 0xec, 0xa9, 0x1a, 0x6c, 0x97,
+	0xa9, 0xd3, 0xc4, 0x3e, 0xb5, 0x9a, 0x2d, 0x6e, 0xa0, 0xcd, 0x00, 0xfa, 0x38, 0x0c, 0x60, 0x83,
+	0x79, 0x30, 0xf0, 0x83, 0x00, 0xfb, 0x54, 0xbb, 0x0b, 0xd3, 0xb1, 0x55, 0xbf, 0x45, 0x5c, 0x1f,
+	0xa3, 0x6f, 0x42, 0x8e, 0x2b, 0x29, 0x2a, 0xf3, 0xca, 0xc2, 0xf8, 0xd2, 0x69, 0xfd, 0xc8, 0xbc,
+	0xeb, 0x1c, 0x5a, 0x19, 0x7e, 0xf2, 0xb4, 0x34, 0x60, 0x08, 0x98, 0xf6, 0x43, 0x98, 0x65, 0xbc,
+	0x6b, 0x8d, 0xc6, 0x87, 0x96, 0xb7, 0x8b, 0xa9, 0xf4, 0x88, 0x6e, 0x03, 0x74, 0x52, 0x27, 0xe8,
+	0xcf, 0xe8, 0x3c, 0xcf, 0x7a, 0x98, 0x67, 0x9d, 0xd7, 0xbb, 0x43, 0x5f, 0xc7, 0x02, 0x6b, 0x44,
+	0x90, 0xda, 0xef, 0x14, 0x78, 0xf3, 0x25, 0x17, 0x42, 0xfe, 0x3a, 0x8c, 0x36, 0xf9, 0x52, 0x51,
+	0x99, 0x1f, 0x5a, 0x18, 0x5f, 0x3a, 0x97, 0xa0, 0x9f, 0x83, 0x25, 0x56, 0xc4, 0x21, 0xf1, 0xe8,
+	0x4e, 0x4c, 0xee, 0x20, 0x93, 0x7b, 0x36, 0x55, 0x2e, 0xe7, 0x8a, 0xe9, 0x5d, 0x14, 0xf9, 0x97,
+	0xee, 0x78, 0x36, 0x4e, 0x42, 0x9e, 0x7b, 0x32, 0x9d, 0x1a, 0x4b, 0xc6, 0xb0, 0x31, 0xc6, 0x17,
+	0xd6, 0x6b, 0xda, 0x0f, 0x60, 0x3a, 0x06, 0x11, 0xd1, 0xdd, 0x81, 0x1c, 0x37, 0x11, 0xd9, 0xcb,
+	0x1c, 0x9c, 0x80, 0x6b, 0x3f, 0x53, 0xe0, 0xb8, 0x4c, 0xe1, 0x47, 0x61, 0x43, 0xb5, 0x8b, 0x54,
+	0x84, 0x51, 0xd6, 0x61, 0xd8, 0x63, 0x3e, 0xf2, 0x86, 0x7c, 0x8c, 0x0b, 0x1e, 0x8c, 0x0b, 0x3e,
+	0x54, 0xdb, 0xa1, 0xbe, 0x6b, 0xfb, 0x6b, 0x05, 0x66, 0x0f, 0x0b, 0x13, 0xc1, 0xdf, 0x80, 0x1c,
+	0x93, 0x22, 0x2b, 0x3b, 0x9f, 0x10, 0x3c, 0x83, 0xca, 0x98, 0x39, 0xea, 0xf5, 0xd5, 0x53, 0x87,
+	0x63, 0x4c, 0x22, 0x73, 0x22, 0xf3, 0x76, 0x02, 0xc6, 0xf8, 0x97, 0xd9, 0xae, 0x26, 0x4f, 0xdc,
+	0x7a, 0x4d, 0x33, 0x00, 0x45, 0xed, 0x45, 0x38, 0xd7, 0x61, 0x84, 0x19, 0x88, 0x52, 0xf6, 0x1a,
+	0x0d, 0x07, 0x69, 0xbf, 0x54, 0xe0, 0x94, 0xcc, 0xd3, 0x96, 0xe7, 0xd4, 0xeb, 0xd8, 0xfb, 0x4a,
+	0xd5, 0xf1, 0xcf, 0x0a, 0xbc, 0x75, 0x84, 0x3e, 0x11, 0xff, 0x16, 0x14, 0x28, 0x7f, 0x61, 0xc6,
+	0xca, 0x7a, 0x36, 0x21, 0x11, 0x51, 0x26, 0x91, 0x8f, 0x49, 0x1a, 0x65, 0x7f, 0x7d, 0x45, 0xbe,
+	0x0a, 0x45, 0xa6, 0x3f, 0xea, 0xb2, 0x87, 0x5a, 0x13, 0x38, 0xd1, 0x05, 0x26, 0x42, 0x36, 0x60,
+	0x32, 0x16, 0xb2, 0x28, 0x7d, 0xc6, 0x88, 0x27, 0xa2, 0x11, 0x6b, 0x3f, 0x52, 0xe0, 0x2c, 0xf3,
+	0x58, 0xc1, 0x3e, 0xdd, 0xdc, 0xb7, 0x5a, 0xef, 0x1f, 0x58, 0x36, 0x5d, 0x6b, 0x92, 0xc0, 0xa5,
+	0xeb, 0xae, 0x41, 0x02, 0x8a, 0xdb, 0x3d, 0x31, 0x03, 0x23, 0x8e, 0xdb, 0x0a, 0xa8, 0xe8, 0x08,
+	0xfe, 0x80, 0x4e, 0xc3, 0x04, 0x09, 0x68, 0x2b, 0xa0, 0x66, 0x0d, 0xbb, 0xa4, 0xc9, 0x92, 0x96,
+	0x37, 0xc6, 0xf9, 0xda, 0xad, 0x70, 0x09, 0xbd, 0x05, 0xd0, 0xb4, 0x0e, 0x4c, 0xbf, 0xd5, 0x70,
+	0xa8, 0xcf, 0xba, 0x62, 0xd2, 0xc8, 0x37, 0xad, 0x83, 0x4d, 0xb6, 0xa0, 0xfd, 0x78, 0x08, 0x16,
+	0xd2, 0x35, 0x88, 0x24, 0xcc, 0x42, 0xce, 0x63, 0x2b, 0xac, 0xde, 0xc3, 0x86, 0x78, 0x42, 0xef,
+	0x42, 0x8e, 0xbb, 0x14, 0x55, 0x3b, 0x15, 0xab, 0x9a, 0xcc, 0xc7, 0x2d, 0x6c, 0xdf, 0x24, 0x8e,
+	0xdb, 0xfe, 0xb4, 0x19, 0x02, 0x7d, 0x1b, 0x46, 0x3d, 0xec, 0x07, 0x0d, 0x26, 0x2e, 0x6c, 0xa2,
+	0xf3, 0x09, 0x29, 0x0d, 0x05, 0x32, 0x4d, 0x06, 0x83, 0xc8, 0x6d, 0x5f, 0x10, 0xa0, 0xef, 0xc2,
+	0x1b, 0xfb, 0xd8, 0xa9, 0xef, 0x50, 0x5c, 0x33, 0x85, 0xd0, 0x61, 0xc6, 0x79, 0x31, 0x81, 0xf3,
+	0x9e, 0x40, 0xb4, 0xb9, 0x05, 0x6b, 0x41, 0x52, 0x19, 0x3c, 0x48, 0x1b, 0xa6, 0x3a, 0xe4, 0x42,
+	0xf1, 0x08, 0x63, 0x5f, 0xca, 0xc2, 0x1e, 0x53, 0xde, 0x96, 0xcb, 0x57, 0x7d, 0xcd, 0x3e, 0xba,
+	0x1a, 0x1f, 0x05, 0x34, 0xde, 0x12, 0x25, 0x18, 0x77, 0xdc, 0x4e, 0xed, 0x79, 0x63, 0x80, 0xe3,
+	0xb6, 0x4b, 0x3f, 0x1b, 0x2b, 0x4b, 0x5e, 0xa6, 0x5c, 0xfb, 0xab, 0x02, 0xe7, 0x7a, 0xf0, 0x92,
+	0x52, 0xf4, 0x6b, 0xb2, 0x23, 0x7b, 0xaf, 0xb9, 0xe8, 0xda, 0xd7, 0x58, 0x72, 0x6d, 0x59, 0x1c,
+	0x86, 0xfc, 0x2b, 0x23, 0x64, 0xb7, 0xa7, 0x33, 0x1a, 0xc3, 0xec, 0x61, 0x94, 0x88, 0xf6, 0x03,
+	0x18, 0xef, 0xdc, 0xd2, 0xe4, 0xbe, 0xf6, 0x76, 0xea, 0x06, 0x4f, 0xc8, 0xae, 0x50, 0x06, 0x44,
+	0x2e, 0xf8, 0xda, 0x1d, 0x98, 0xe2, 0x3b, 0xca, 0xbd, 0xb5, 0x8d, 0x5e, 0x74, 0x85, 0xb9, 0xde,
+	0x77, 0xdc, 0x1a, 0xd9, 0x97, 0x15, 0xe3, 0x4f, 0xda, 0x3d, 0x38, 0x16, 0x21, 0x12, 0x52, 0x2b,
+	0x30, 0x4c, 0xf7, 0xad, 0x16, 0x2f, 0x7c, 0x45, 0x0f, 0xbd, 0xff, 0xe3, 0x69, 0xe9, 0x4c, 0xdd,
+	0xa1, 0x3b, 0x41, 0x55, 0xb7, 0x49, 0xb3, 0x2c, 0xee, 0xc1, 0xfc, 0x9f, 0x4b, 0x7e, 0x6d, 0xb7,
+	0x4c, 0x1f, 0xb6, 0xb0, 0x1f, 0x56, 0xc5, 0x60, 0x58, 0x6d, 0x05, 0x54, 0xbe, 0xd5, 0xdb, 0x76,
+	0x58, 0xfd, 0xdb, 0x18, 0x6f, 0x39, 0x9d, 0xcd, 0xb2, 0x08, 0xa3, 0x56, 0xad, 0xe6, 0x61, 0xdf,
+	0x97, 0x07, 0x91, 0x78, 0xd4, 0x7e, 0xa3, 0xc0, 0xc9, 0xae, 0x40, 0xa1, 0xed, 0x36, 0xe4, 0xf6,
+	0x48, 0x23, 0x68, 0xe2, 0x3e, 0xd5, 0x09, 0x34, 0x7a, 0x0f, 0xc6, 0xb6, 0x31, 0x36, 0xa9, 0x83,
+	0x3d, 0xd1, 0x67, 0x5a, 0x42, 0x2d, 0xa4, 0x8a, 0xd1, 0x6d, 0xfe, 0x43, 0x5b, 0x85, 0x52, 0x54,
+	0xe5, 0x4d, 0xcb, 0xb5, 0x71, 0x63, 0x6d, 0x9b, 0xc6, 0x0f, 0xdb, 0x23, 0x62, 0x7c, 0x0c, 0xf3,
+	0x47, 0x83, 0x45, 0x9c, 0xf7, 0x61, 0xd2, 0x66, 0xeb, 0xa6, 0xc5, 0x5e, 0x88, 0x86, 0xd1, 0x13,
+	0x44, 0x46, 0x78, 0x0e, 0xdd, 0xf0, 0x26, 0xec, 0x88, 0x0b, 0xed, 0x7e, 0xdc, 0xbd, 0x81, 0xb7,
+	0xb1, 0xe7, 0x61, 0x6f, 0x93, 0x5a, 0x34, 0x5d, 0x7c, 0xe2, 0x4d, 0x41, 0xfb, 0x54, 0x81, 0xd3,
+	0x09, 0xdc, 0x22, 0xb6, 0xef, 0x43, 0xc1, 0x13, 0x2f, 0x4c, 0x3f, 0x7c, 0x23, 0x82, 0xbb, 0x9c,
+	0x10, 0x5c, 0x57, 0x26, 0x79, 0xdc, 0x7b, 0xd1, 0x97, 0xda, 0x7f, 0xf3, 0x50, 0x38, 0x74, 0x47,
+	0x2e, 0xc0, 0x60, 0xfb, 0xa3, 0x18, 0x74, 0x6a, 0xe1, 0xd9, 0x15, 0xee, 0x26, 0xb1, 0xc3, 0x2d,
+	0x1f, 0xae, 0xf0, 0xfd, 0xad, 0x04, 0xe3, 0x0f, 0x02, 0x42, 0xe5, 0xfb, 0x21, 0xbe, 0x01, 0xb2,
+	0x25, 0x6e, 0xf0, 0x0e, 0x14, 0xb0, 0x6f, 0x7b, 0x64, 0xdf, 0x94, 0x59, 0x1a, 0x66, 0x36, 0x93,
+	0x7c, 0x75, 0x4d, 0xe4, 0x6a, 0x0b, 0x0a, 0x4d, 0x6b, 0x17, 0x7b, 0x66, 0xd8, 0x6a, 0x9e, 0x45,
+	0x71, 0x71, 0xa4, 0xaf, 0xa6, 0x9d, 0x60, 0x2c, 0xb7, 0x31, 0x36, 0x2c, 0xca, 0x2f, 0x49, 0x71,
+	0xd6, 0x5c, 0x7f, 0xac, 0x34, 0xca, 0x6a, 0xc3, 0x2c, 0xdf, 0x9f, 0x7c, 0x12, 0x78, 0x36, 0x96,
+	0xe4, 0x0e, 0x29, 0x8e, 0xf6, 0xc5, 0x3e, 0xcd, 0xd8, 0x36, 0x19, 0x19, 0xf7, 0xe1, 0x10, 0xb4,
+	0x0e, 0xd0, 0xb0, 0x7c, 0x6a, 0xb6, 0x3c, 0xc7, 0xc6, 0xc5, 0x31, 0x46, 0x7c, 0x3e, 0x03, 0x69,
+	0x3e, 0x44, 0x6f, 0x84, 0x60, 0x74, 0x19, 0x66, 0x18, 0x55, 0xd3, 0xa2, 0xf6, 0x8e, 0xe3, 0xd6,
+	0xcd, 0x1d, 0x76, 0xe2, 0x15, 0xf3, 0xf3, 0xca, 0xc2, 0x90, 0x81, 0xc2, 0x77, 0x1f, 0x8a, 0x57,
+	0xdf, 0x62, 0x6f, 0xc2, 0xbf, 0x62, 0xc3, 0x6e, 0x0b, 0xfc, 0x22, 0xcc, 0x2b, 0x0b, 0x85, 0xc4,
+	0x2b, 0x16, 0xef, 0x9f, 0x4d, 0x66, 0x6e, 0x08, 0x18, 0xfa, 0x00, 0xf2, 0xd4, 0xb1, 0x77, 0x4d,
+	0xdf, 0xf9, 0x04, 0x17, 0xc7, 0xfb, 0xca, 0xca, 0x58, 0x48, 0xb0, 0xe9, 0x7c, 0x82, 0xd1, 0xf7,
+	0x00, 0x35, 0x1d, 0x97, 0xdf, 0xf9, 0xcc, 0x07, 0x81, 0xe5, 0x52, 0x87, 0x3e, 0x2c, 0x4e, 0xf4,
+	0xc5, 0x3a, 0xd5, 0x74, 0x5c, 0x76, 0x5c, 0x7c, 0x2c, 0x78, 0xd0, 0x3a, 0x8c, 0x35, 0x08, 0xe5,
+	0x4a, 0x27, 0xfb, 0xe2, 0x1c, 0x6d, 0x10, 0xca, 0x84, 0x56, 0xe1, 0xb8, 0x8f, 0x1b, 0xdb, 0x26,
+	0xf5, 0xac, 0x1a, 0x36, 0x5b, 0x1e, 0xde, 0xc3, 0x2e, 0xbb, 0x48, 0x17, 0x58, 0x16, 0x93, 0x76,
+	0xa4, 0x4d, 0xdc, 0xd8, 0xde, 0x0a, 0x61, 0x1b, 0x6d, 0x94, 0x31, 0xed, 0xbf, 0xbc, 0x88, 0x56,
+	0x41, 0xb5, 0x1d, 0xcf, 0x0e, 0x1c, 0x6a, 0x56, 0x3d, 0xcc, 0x9a, 0x1b, 0xbb, 0x35, 0x59, 0xd2,
+	0x37, 0x58, 0x49, 0xdf, 0x14, 0x16, 0x15, 0x6e, 0xf0, 0xbe, 0x5b, 0x13, 0x75, 0xdd, 0x80, 0x42,
+	0x98, 0xc9, 0xaa, 0xe5, 0xd6, 0x44, 0x63, 0x4d, 0x65, 0x6e, 0xac, 0x89, 0xa6, 0xe3, 0x56, 0x2c,
+	0xb7, 0xc6, 0x7b, 0x2b, 0x64, 0xb4, 0x0e, 0xa2, 0x8c, 0xc7, 0xfa, 0x60, 0xb4, 0x0e, 0x3a, 0x8c,
+	0xf7, 0x01, 0xf8, 0xc1, 0x63, 0x2e, 0x2d, 0xef, 0x14, 0x51, 0xea, 0x15, 0x9f, 0xf7, 0xdf, 0x5d,
+	0x06, 0xa9, 0x1c, 0x0b, 0x4b, 0xf7, 0xfc, 0x69, 0x29, 0xcf, 0x9f, 0x97, 0x96, 0x77, 0x8c, 0xfc,
+	0x9e, 0xfc, 0xa9, 0x3d, 0x82, 0xe9, 0x2e, 0x3b, 0x7f, 0xf2, 0x75, 0xe0, 0x0e, 0x4c, 0x44, 0x4f,
+	0x17, 0x71, 0x02, 0xaa, 0x3a, 0x9f, 0x1a, 0xe9, 0x72, 0x6a, 0xa4, 0x6f, 0xc9, 0xa9, 0x51, 0x65,
+	0x2c, 0xd4, 0xf0, 0xd9, 0xb3, 0x92, 0x62, 0x8c, 0x47, 0x0e, 0x13, 0xed, 0x85, 0x02, 0xc7, 0xbb,
+	0x6f, 0xf2, 0x89, 0xfe, 0x3b, 0xa7, 0xf8, 0xe0, 0x2b, 0x9d, 0xe2, 0x18, 0x86, 0xb7, 0x31, 0x96,
+	0xb7, 0xbd, 0xe4, 0x9b, 0xe2, 0x95, 0xd0, 0xc7, 0x6f, 0x9f, 0x95, 0x2e, 0xf4, 0xe6, 0x23, 0xc4,
+	0xf8, 0x06, 0xa3, 0x5f, 0xfa, 0xd3, 0x0c, 0x8c, 0xb0, 0x63, 0x0d, 0xfd, 0x44, 0x81, 0x1c, 0x9f,
+	0x70, 0xa1, 0x4b, 0x09, 0xe5, 0x7b, 0x79, 0xb4, 0xa6, 0xea, 0xbd, 0x9a, 0xf3, 0xfc, 0x69, 0xe7,
+	0x3e, 0xfd, 0xf2, 0xdf, 0x3f, 0x1d, 0xfc, 0x1a, 0x3a, 0x5d, 0x4e, 0x1b, 0x0f, 0xa2, 0xcf, 0x15,
+	0x80, 0xce, 0xd8, 0x0b, 0x2d, 0xa6, 0x79, 0x7a, 0x69, 0x0a, 0xa7, 0x2e, 0x65, 0x81, 0x08, 0x81,
+	0xe7, 0x99, 0xc0, 0xb7, 0x91, 0x96, 0x20, 0x50, 0x8e, 0xcd, 0x3e, 0x57, 0x20, 0xc7, 0xf1, 0xe9,
+	0x69, 0x8b, 0x4d, 0xc4, 0x54, 0xbd, 0x57, 0x73, 0xa1, 0x6a, 0x85, 0xa9, 0xba, 0x8c, 0xf4, 0x74,
+	0x55, 0xe5, 0x47, 0xed, 0x06, 0x7d, 0x8c, 0x7e, 0xa1, 0x40, 0xbe, 0x3d, 0x5e, 0x42, 0x97, 0x7b,
+	0xc8, 0x47, 0x6c, 0xb4, 0xa2, 0x2e, 0x66, 0x40, 0x64, 0xa8, 0xb0, 0x18, 0x53, 0xfd, 0x5c, 0x81,
+	0x11, 0x86, 0x46, 0x17, 0xd3, 0xfc, 0x44, 0x87, 0x12, 0xea, 0xa5, 0x1e, 0xad, 0x85, 0xa2, 0x65,
+	0xa6, 0x48, 0x47, 0x17, 0x53, 0x15, 0x95, 0x1f, 0xc9, 0x61, 0xc7, 0x63, 0xf4, 0x47, 0x05, 0xa6,
+	0x0e, 0x4f, 0x74, 0xd0, 0xd7, 0x7b, 0xc8, 0x47, 0xb7, 0x19, 0x95, 0x7a, 0x2d, 0x3b, 0x50, 0xa8,
+	0x5f, 0x64, 0xea, 0x2f, 0xa0, 0x73, 0x09, 0xea, 0xe3, 0xd3, 0x25, 0xf4, 0x07, 0x05, 0x26, 0xa2,
+	0x64, 0xe8, 0x4a, 0x9a, 0xf7, 0x2e, 0xa3, 0x1f, 0x75, 0x39, 0x1b, 0x48, 0xc8, 0xbd, 0xce, 0xe4,
+	0xae, 0xa0, 0xe5, 0x9e, 0xe5, 0x46, 0x93, 0xfe, 0x1f, 0x05, 0x4e, 0x26, 0x4c, 0x56, 0x50, 0x25,
+	0x4d, 0x53, 0xfa, 0x68, 0x48, 0xbd, 0xf9, 0x4a, 0x1c, 0x22, 0xcc, 0x9b, 0x2c, 0xcc, 0xf7, 0xd0,
+	0x6a, 0x42, 0x98, 0x55, 0xec, 0x53, 0xd3, 0xdf, 0xb7, 0x5a, 0x26, 0x0e, 0x99, 0x4c, 0x8b, 0x51,
+	0x99, 0x8e, 0x2b, 0x86, 0x2d, 0xe8, 0x7f, 0x0a, 0x9c, 0x4a, 0x9a, 0x29, 0xa0, 0x7e, 0xa4, 0x1e,
+	0x9e, 0x7b, 0xa8, 0xb7, 0x5e, 0x8d, 0x44, 0x04, 0x7c, 0x8b, 0x05, 0x7c, 0x03, 0x5d, 0xcf, 0x1e,
+	0x30, 0x09, 0xa8, 0x8c, 0xf8, 0xf7, 0x0a, 0xe4, 0xdb, 0x13, 0x80, 0xf4, 0xfd, 0xe8, 0xf0, 0x94,
+	0x42, 0x5d, 0xcc, 0x80, 0x10, 0xc2, 0xd7, 0x98, 0xf0, 0x55, 0xf4, 0x8d, 0x6c, 0x5b, 0x67, 0xe4,
+	0x3f, 0x9f, 0xd0, 0xaf, 0x14, 0x18, 0x0e, 0x47, 0x09, 0xe8, 0x42, 0xea, 0x27, 0xd1, 0x99, 0x5c,
+	0xa8, 0x17, 0x7b, 0x33, 0x16, 0x32, 0x57, 0x99, 0xcc, 0xab, 0xe8, 0x4a, 0x46, 0x99, 0xe1, 0x58,
+	0x02, 0xfd, 0x45, 0x81, 0x42, 0x7c, 0xb2, 0x80, 0xae, 0xa6, 0x6e, 0x38, 0xdd, 0x46, 0x18, 0xea,
+	0x4a, 0x56, 0x98, 0x90, 0x7f, 0x83, 0xc9, 0xbf, 0x86, 0x56, 0x12, 0xe4, 0x5b, 0x1c, 0xea, 0x97,
+	0x1f, 0x89, 0x3f, 0x2f, 0x1f, 0x97, 0xe5, 0xb0, 0x02, 0x7d, 0xa9, 0xc0, 0x74, 0x97, 0xc1, 0x01,
+	0x7a, 0xb7, 0x47, 0x3d, 0x5d, 0x46, 0x15, 0xea, 0x6a, 0x5f, 0xd8, 0x0c, 0x1f, 0x78, 0x97, 0x80,
+	0x62, 0xd3, 0x0d, 0xf4, 0x37, 0x05, 0x66, 0xba, 0xcd, 0x0c, 0x50, 0xaf, 0xd2, 0xba, 0x4d, 0x31,
+	0xd4, 0xeb, 0xfd, 0x81, 0x33, 0x7c, 0xc8, 0x5d, 0x02, 0x8b, 0x8f, 0x36, 0x2a, 0x77, 0x9f, 0xfc,
+	0x6b, 0x6e, 0xe0, 0xc9, 0xf3, 0x39, 0xe5, 0x8b, 0xe7, 0x73, 0xca, 0x3f, 0x9f, 0xcf, 0x29, 0x9f,
+	0xbd, 0x98, 0x1b, 0xf8, 0xe2, 0xc5, 0xdc, 0xc0, 0xdf, 0x5f, 0xcc, 0x0d, 0x7c, 0xe7, 0x5a, 0xf4,
+	0x32, 0x2a, 0xbc, 0x5c, 0x72, 0x31, 0xdd, 0x27, 0xde, 0x6e, 0xc7, 0xed, 0xde, 0xd5, 0xf2, 0x41,
+	0xc7, 0x37, 0xbb, 0xa2, 0x56, 0x73, 0xec, 0x92, 0x7e, 0xe5, 0xff, 0x03, 0x00, 0x75, 0xff, 0xf2,
+	0xe4, 0x2a, 0x1f, 0x00, 0x00,
 }
 
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	AllMarkets(ctx context.Context, in *QueryAllMarketsRequest, opts ...grpc.CallOption) (*QueryAllMarketsResponse, error)
+	Market(ctx context.Context, in *QueryMarketRequest, opts ...grpc.CallOption) (*QueryMarketResponse, error)
+	AllOrders(ctx context.Context, in *QueryAllOrdersRequest, opts ...grpc.CallOption) (*QueryAllOrdersResponse, error)
+	Order(ctx context.Context, in *QueryOrderRequest, opts ...grpc.CallOption) (*QueryOrderResponse, error)
+	AllTriggerOrders(ctx context.Context, in *QueryAllTriggerOrdersRequest, opts ...grpc.CallOption) (*QueryAllTriggerOrdersResponse, error)
+	TriggerOrder(ctx context.Context, in *QueryTriggerOrderRequest, opts ...grpc.CallOption) (*QueryTriggerOrderResponse, error)
+	BestSwapExactAmountInRoutes(ctx context.Context, in *QueryBestSwapExactAmountInRoutesRequest, opts ...grpc.CallOption) (*QueryBestSwapExactAmountInRoutesResponse, error)
+	BestSwapExactAmountOutRoutes(ctx context.Context, in *QueryBestSwapExactAmountOutRoutesRequest, opts ...grpc.CallOption) (*QueryBestSwapExactAmountOutRoutesResponse, error)
+	OrderBook(ctx context.Context, in *QueryOrderBookRequest, opts ...grpc.CallOption) (*QueryOrderBookResponse, error)
+	TWAP(ctx context.Context, in *QueryTWAPRequest, opts ...grpc.CallOption) (*QueryTWAPResponse, error)
+	AccountFeeTier(ctx context.Context, in *QueryAccountFeeTierRequest, opts ...grpc.CallOption) (*QueryAccountFeeTierResponse, error)
+	AccountCancelAfters(ctx context.Context, in *QueryAccountCancelAftersRequest, opts ...grpc.CallOption) (*QueryAccountCancelAftersResponse, error)
+	AccountReferrerStats(ctx context.Context, in *QueryAccountReferrerStatsRequest, opts ...grpc.CallOption) (*QueryAccountReferrerStatsResponse, error)
 }
 
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type queryClient struct {
+	cc grpc1.ClientConn
 }
 
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
 }
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/crescent.exchange.v1beta1.Query/Params", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) AllMarkets(ctx context.Context, in *QueryAllMarketsRequest, opts ...grpc.CallOption) (*QueryAllMarketsResponse, error) {
+	out := new(QueryAllMarketsResponse)
+	err := c.cc.Invoke(ctx, "/crescent.exchange.v1beta1.Query/AllMarkets", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+func (c *queryClient) Market(ctx context.Context, in *QueryMarketRequest, opts ...grpc.CallOption) (*QueryMarketResponse, error) {
+	out := new(QueryMarketResponse)
+	err := c.cc.Invoke(ctx, "/crescent.exchange.v1beta1.Query/Market", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryAllMarketsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) AllOrders(ctx context.Context, in *QueryAllOrdersRequest, opts ...grpc.CallOption) (*QueryAllOrdersResponse, error) {
+	out := new(QueryAllOrdersResponse)
+	err := c.cc.Invoke(ctx, "/crescent.exchange.v1beta1.Query/AllOrders", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryAllMarketsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) Order(ctx context.Context, in *QueryOrderRequest, opts ...grpc.CallOption) (*QueryOrderResponse, error) {
+	out := new(QueryOrderResponse)
+	err := c.cc.Invoke(ctx, "/crescent.exchange.v1beta1.Query/Order", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryAllMarketsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+func (c *queryClient) AllTriggerOrders(ctx context.Context, in *QueryAllTriggerOrdersRequest, opts ...grpc.CallOption) (*QueryAllTriggerOrdersResponse, error) {
+	out := new(QueryAllTriggerOrdersResponse)
+	err := c.cc.Invoke(ctx, "/crescent.exchange.v1beta1.Query/AllTriggerOrders", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryAllMarketsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) TriggerOrder(ctx context.Context, in *QueryTriggerOrderRequest, opts ...grpc.CallOption) (*QueryTriggerOrderResponse, error) {
+	out := new(QueryTriggerOrderResponse)
+	err := c.cc.Invoke(ctx, "/crescent.exchange.v1beta1.Query/TriggerOrder", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryAllMarketsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) BestSwapExactAmountInRoutes(ctx context.Context, in *QueryBestSwapExactAmountInRoutesRequest, opts ...grpc.CallOption) (*QueryBestSwapExactAmountInRoutesResponse, error) {
+	out := new(QueryBestSwapExactAmountInRoutesResponse)
+	err := c.cc.Invoke(ctx, "/crescent.exchange.v1beta1.Query/BestSwapExactAmountInRoutes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryAllMarketsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Markets) > 0 {
-		for iNdEx := len(m.Markets) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Markets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+func (c *queryClient) BestSwapExactAmountOutRoutes(ctx context.Context, in *QueryBestSwapExactAmountOutRoutesRequest, opts ...grpc.CallOption) (*QueryBestSwapExactAmountOutRoutesResponse, error) {
+	out := new(QueryBestSwapExactAmountOutRoutesResponse)
+	err := c.cc.Invoke(ctx, "/crescent.exchange.v1beta1.Query/BestSwapExactAmountOutRoutes", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryMarketRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) OrderBook(ctx context.Context, in *QueryOrderBookRequest, opts ...grpc.CallOption) (*QueryOrderBookResponse, error) {
+	out := new(QueryOrderBookResponse)
+	err := c.cc.Invoke(ctx, "/crescent.exchange.v1beta1.Query/OrderBook", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryMarketRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *QueryMarketRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.MarketId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.MarketId))
-		i--
-		dAtA[i] = 0x8
+func (c *queryClient) TWAP(ctx context.Context, in *QueryTWAPRequest, opts ...grpc.CallOption) (*QueryTWAPResponse, error) {
+	out := new(QueryTWAPResponse)
+	err := c.cc.Invoke(ctx, "/crescent.exchange.v1beta1.Query/TWAP", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryMarketResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) AccountFeeTier(ctx context.Context, in *QueryAccountFeeTierRequest, opts ...grpc.CallOption) (*QueryAccountFeeTierResponse, error) {
+	out := new(QueryAccountFeeTierResponse)
+	err := c.cc.Invoke(ctx, "/crescent.exchange.v1beta1.Query/AccountFeeTier", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryMarketResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *QueryMarketResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Market.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+func (c *queryClient) AccountCancelAfters(ctx context.Context, in *QueryAccountCancelAftersRequest, opts ...grpc.CallOption) (*QueryAccountCancelAftersResponse, error) {
+	out := new(QueryAccountCancelAftersResponse)
+	err := c.cc.Invoke(ctx, "/crescent.exchange.v1beta1.Query/AccountCancelAfters", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryAllOrdersRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) AccountReferrerStats(ctx context.Context, in *QueryAccountReferrerStatsRequest, opts ...grpc.CallOption) (*QueryAccountReferrerStatsResponse, error) {
+	out := new(QueryAccountReferrerStatsResponse)
+	err := c.cc.Invoke(ctx, "/crescent.exchange.v1beta1.Query/AccountReferrerStats", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryAllOrdersRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	AllMarkets(context.Context, *QueryAllMarketsRequest) (*QueryAllMarketsResponse, error)
+	Market(context.Context, *QueryMarketRequest) (*QueryMarketResponse, error)
+	AllOrders(context.Context, *QueryAllOrdersRequest) (*QueryAllOrdersResponse, error)
+	Order(context.Context, *QueryOrderRequest) (*QueryOrderResponse, error)
+	AllTriggerOrders(context.Context, *QueryAllTriggerOrdersRequest) (*QueryAllTriggerOrdersResponse, error)
+	TriggerOrder(context.Context, *QueryTriggerOrderRequest) (*QueryTriggerOrderResponse, error)
+	BestSwapExactAmountInRoutes(context.Context, *QueryBestSwapExactAmountInRoutesRequest) (*QueryBestSwapExactAmountInRoutesResponse, error)
+	BestSwapExactAmountOutRoutes(context.Context, *QueryBestSwapExactAmountOutRoutesRequest) (*QueryBestSwapExactAmountOutRoutesResponse, error)
+	OrderBook(context.Context, *QueryOrderBookRequest) (*QueryOrderBookResponse, error)
+	TWAP(context.Context, *QueryTWAPRequest) (*QueryTWAPResponse, error)
+	AccountFeeTier(context.Context, *QueryAccountFeeTierRequest) (*QueryAccountFeeTierResponse, error)
+	AccountCancelAfters(context.Context, *QueryAccountCancelAftersRequest) (*QueryAccountCancelAftersResponse, error)
+	AccountReferrerStats(context.Context, *QueryAccountReferrerStatsRequest) (*QueryAccountReferrerStatsResponse, error)
 }
 
-func (m *QueryAllOrdersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.MarketId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.MarketId))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Orderer) > 0 {
-		i -= len(m.Orderer)
-		copy(dAtA[i:], m.Orderer)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Orderer)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
 }
 
-func (m *QueryAllOrdersResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) AllMarkets(ctx context.Context, req *QueryAllMarketsRequest) (*QueryAllMarketsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AllMarkets not implemented")
+}
+func (*UnimplementedQueryServer) Market(ctx context.Context, req *QueryMarketRequest) (*QueryMarketResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Market not implemented")
+}
+func (*UnimplementedQueryServer) AllOrders(ctx context.Context, req *QueryAllOrdersRequest) (*QueryAllOrdersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AllOrders not implemented")
+}
+func (*UnimplementedQueryServer) Order(ctx context.Context, req *QueryOrderRequest) (*QueryOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Order not implemented")
+}
+func (*UnimplementedQueryServer) AllTriggerOrders(ctx context.Context, req *QueryAllTriggerOrdersRequest) (*QueryAllTriggerOrdersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AllTriggerOrders not implemented")
+}
+func (*UnimplementedQueryServer) TriggerOrder(ctx context.Context, req *QueryTriggerOrderRequest) (*QueryTriggerOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TriggerOrder not implemented")
+}
+func (*UnimplementedQueryServer) BestSwapExactAmountInRoutes(ctx context.Context, req *QueryBestSwapExactAmountInRoutesRequest) (*QueryBestSwapExactAmountInRoutesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BestSwapExactAmountInRoutes not implemented")
+}
+func (*UnimplementedQueryServer) BestSwapExactAmountOutRoutes(ctx context.Context, req *QueryBestSwapExactAmountOutRoutesRequest) (*QueryBestSwapExactAmountOutRoutesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BestSwapExactAmountOutRoutes not implemented")
+}
+func (*UnimplementedQueryServer) OrderBook(ctx context.Context, req *QueryOrderBookRequest) (*QueryOrderBookResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OrderBook not implemented")
+}
+func (*UnimplementedQueryServer) TWAP(ctx context.Context, req *QueryTWAPRequest) (*QueryTWAPResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TWAP not implemented")
+}
+func (*UnimplementedQueryServer) AccountFeeTier(ctx context.Context, req *QueryAccountFeeTierRequest) (*QueryAccountFeeTierResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AccountFeeTier not implemented")
+}
+func (*UnimplementedQueryServer) AccountCancelAfters(ctx context.Context, req *QueryAccountCancelAftersRequest) (*QueryAccountCancelAftersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AccountCancelAfters not implemented")
+}
+func (*UnimplementedQueryServer) AccountReferrerStats(ctx context.Context, req *QueryAccountReferrerStatsRequest) (*QueryAccountReferrerStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AccountReferrerStats not implemented")
 }
 
-func (m *QueryAllOrdersResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
 }
 
-func (m *QueryAllOrdersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.Orders) > 0 {
-		for iNdEx := len(m.Orders) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Orders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/crescent.exchange.v1beta1.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryOrderRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_AllMarkets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllMarketsRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).AllMarkets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/crescent.exchange.v1beta1.Query/AllMarkets",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AllMarkets(ctx, req.(*QueryAllMarketsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryOrderRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_Market_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMarketRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Market(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/crescent.exchange.v1beta1.Query/Market",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Market(ctx, req.(*QueryMarketRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryOrderRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func _Query_AllOrders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllOrdersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AllOrders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/crescent.exchange.v1beta1.Query/AllOrders",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AllOrders(ctx, req.(*QueryAllOrdersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Order_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Order(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/crescent.exchange.v1beta1.Query/Order",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Order(ctx, req.(*QueryOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AllTriggerOrders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllTriggerOrdersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AllTriggerOrders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/crescent.exchange.v1beta1.Query/AllTriggerOrders",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AllTriggerOrders(ctx, req.(*QueryAllTriggerOrdersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TriggerOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTriggerOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TriggerOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/crescent.exchange.v1beta1.Query/TriggerOrder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TriggerOrder(ctx, req.(*QueryTriggerOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_BestSwapExactAmountInRoutes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBestSwapExactAmountInRoutesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BestSwapExactAmountInRoutes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/crescent.exchange.v1beta1.Query/BestSwapExactAmountInRoutes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BestSwapExactAmountInRoutes(ctx, req.(*QueryBestSwapExactAmountInRoutesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_BestSwapExactAmountOutRoutes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBestSwapExactAmountOutRoutesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BestSwapExactAmountOutRoutes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/crescent.exchange.v1beta1.Query/BestSwapExactAmountOutRoutes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BestSwapExactAmountOutRoutes(ctx, req.(*QueryBestSwapExactAmountOutRoutesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_OrderBook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryOrderBookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).OrderBook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/crescent.exchange.v1beta1.Query/OrderBook",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).OrderBook(ctx, req.(*QueryOrderBookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TWAP_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTWAPRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TWAP(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/crescent.exchange.v1beta1.Query/TWAP",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TWAP(ctx, req.(*QueryTWAPRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AccountFeeTier_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAccountFeeTierRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AccountFeeTier(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/crescent.exchange.v1beta1.Query/AccountFeeTier",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AccountFeeTier(ctx, req.(*QueryAccountFeeTierRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AccountCancelAfters_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAccountCancelAftersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AccountCancelAfters(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/crescent.exchange.v1beta1.Query/AccountCancelAfters",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AccountCancelAfters(ctx, req.(*QueryAccountCancelAftersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AccountReferrerStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAccountReferrerStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AccountReferrerStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/crescent.exchange.v1beta1.Query/AccountReferrerStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AccountReferrerStats(ctx, req.(*QueryAccountReferrerStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "crescent.exchange.v1beta1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "AllMarkets",
+			Handler:    _Query_AllMarkets_Handler,
+		},
+		{
+			MethodName: "Market",
+			Handler:    _Query_Market_Handler,
+		},
+		{
+			MethodName: "AllOrders",
+			Handler:    _Query_AllOrders_Handler,
+		},
+		{
+			MethodName: "Order",
+			Handler:    _Query_Order_Handler,
+		},
+		{
+			MethodName: "AllTriggerOrders",
+			Handler:    _Query_AllTriggerOrders_Handler,
+		},
+		{
+			MethodName: "TriggerOrder",
+			Handler:    _Query_TriggerOrder_Handler,
+		},
+		{
+			MethodName: "BestSwapExactAmountInRoutes",
+			Handler:    _Query_BestSwapExactAmountInRoutes_Handler,
+		},
+		{
+			MethodName: "BestSwapExactAmountOutRoutes",
+			Handler:    _Query_BestSwapExactAmountOutRoutes_Handler,
+		},
+		{
+			MethodName: "OrderBook",
+			Handler:    _Query_OrderBook_Handler,
+		},
+		{
+			MethodName: "TWAP",
+			Handler:    _Query_TWAP_Handler,
+		},
+		{
+			MethodName: "AccountFeeTier",
+			Handler:    _Query_AccountFeeTier_Handler,
+		},
+		{
+			MethodName: "AccountCancelAfters",
+			Handler:    _Query_AccountCancelAfters_Handler,
+		},
+		{
+			MethodName: "AccountReferrerStats",
+			Handler:    _Query_AccountReferrerStats_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "crescent/exchange/v1beta1/query.proto",
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.OrderId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.OrderId))
-		i--
-		dAtA[i] = 0x8
-	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryOrderResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1335,18 +2003,18 @@ func (m *QueryOrderResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryOrderResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryOrderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
 	{
-		size, err := m.Order.MarshalToSizedBuffer(dAtA[:i])
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
@@ -1358,7 +2026,7 @@ func (m *QueryOrderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryBestSwapExactAmountInRoutesRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryAllMarketsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1368,34 +2036,32 @@ func (m *QueryBestSwapExactAmountInRoutesRequest) Marshal() (dAtA []byte, err er
 	return dAtA[:n], nil
 }
 
-func (m *QueryBestSwapExactAmountInRoutesRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryAllMarketsRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryBestSwapExactAmountInRoutesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryAllMarketsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.OutputDenom) > 0 {
-		i -= len(m.OutputDenom)
-		copy(dAtA[i:], m.OutputDenom)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.OutputDenom)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Input) > 0 {
-		i -= len(m.Input)
-		copy(dAtA[i:], m.Input)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Input)))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryBestSwapExactAmountInRoutesResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryAllMarketsResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1405,20 +2071,32 @@ func (m *QueryBestSwapExactAmountInRoutesResponse) Marshal() (dAtA []byte, err e
 	return dAtA[:n], nil
 }
 
-func (m *QueryBestSwapExactAmountInRoutesResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryAllMarketsResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryBestSwapExactAmountInRoutesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryAllMarketsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Results) > 0 {
-		for iNdEx := len(m.Results) - 1; iNdEx >= 0; iNdEx-- {
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Markets) > 0 {
+		for iNdEx := len(m.Markets) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Results[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.Markets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -1426,11 +2104,62 @@ func (m *QueryBestSwapExactAmountInRoutesResponse) MarshalToSizedBuffer(dAtA []b
 				i = encodeVarintQuery(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x1a
+			dAtA[i] = 0xa
 		}
 	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMarketRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMarketRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMarketRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MarketId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MarketId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMarketResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMarketResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMarketResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
 	{
-		size, err := m.Output.MarshalToSizedBuffer(dAtA[:i])
+		size, err := m.Market.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
@@ -1438,29 +2167,11 @@ func (m *QueryBestSwapExactAmountInRoutesResponse) MarshalToSizedBuffer(dAtA []b
 		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x12
-	if len(m.Routes) > 0 {
-		dAtA10 := make([]byte, len(m.Routes)*10)
-		var j9 int
-		for _, num := range m.Routes {
-			for num >= 1<<7 {
-				dAtA10[j9] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j9++
-			}
-			dAtA10[j9] = uint8(num)
-			j9++
-		}
-		i -= j9
-		copy(dAtA[i:], dAtA10[:j9])
-		i = encodeVarintQuery(dAtA, i, uint64(j9))
-		i--
-		dAtA[i] = 0xa
-	}
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryOrderBookRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryAllOrdersRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1470,25 +2181,44 @@ func (m *QueryOrderBookRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryOrderBookRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryAllOrdersRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryOrderBookRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryAllOrdersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
 	if m.MarketId != 0 {
 		i = encodeVarintQuery(dAtA, i, uint64(m.MarketId))
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0x10
+	}
+	if len(m.Orderer) > 0 {
+		i -= len(m.Orderer)
+		copy(dAtA[i:], m.Orderer)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Orderer)))
+		i--
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryOrderBookResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryAllOrdersResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1498,20 +2228,32 @@ func (m *QueryOrderBookResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryOrderBookResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryAllOrdersResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryOrderBookResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryAllOrdersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.OrderBooks) > 0 {
-		for iNdEx := len(m.OrderBooks) - 1; iNdEx >= 0; iNdEx-- {
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Orders) > 0 {
+		for iNdEx := len(m.Orders) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.OrderBooks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.Orders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -1525,7 +2267,7 @@ func (m *QueryOrderBookResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func (m *MarketResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryOrderRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1535,380 +2277,3606 @@ func (m *MarketResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MarketResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryOrderRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MarketResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryOrderRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.LastMatchingHeight != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.LastMatchingHeight))
-		i--
-		dAtA[i] = 0x48
-	}
-	if m.LastPrice != nil {
-		{
-			size := m.LastPrice.Size()
-			i -= size
-			if _, err := m.LastPrice.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
+	if m.OrderId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.OrderId))
 		i--
-		dAtA[i] = 0x42
+		dAtA[i] = 0x8
 	}
-	{
-		size := m.OrderSourceFeeRatio.Size()
-		i -= size
-		if _, err := m.OrderSourceFeeRatio.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryOrderResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0x3a
+	return dAtA[:n], nil
+}
+
+func (m *QueryOrderResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOrderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
 	{
-		size := m.TakerFeeRate.Size()
-		i -= size
-		if _, err := m.TakerFeeRate.MarshalTo(dAtA[i:]); err != nil {
+		size, err := m.Order.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
 			return 0, err
 		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x32
-	{
-		size := m.MakerFeeRate.Size()
 		i -= size
-		if _, err := m.MakerFeeRate.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
 		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x2a
-	if len(m.EscrowAddress) > 0 {
-		i -= len(m.EscrowAddress)
-		copy(dAtA[i:], m.EscrowAddress)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.EscrowAddress)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.QuoteDenom) > 0 {
-		i -= len(m.QuoteDenom)
-		copy(dAtA[i:], m.QuoteDenom)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.QuoteDenom)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.BaseDenom) > 0 {
-		i -= len(m.BaseDenom)
-		copy(dAtA[i:], m.BaseDenom)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.BaseDenom)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.Id != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x8
-	}
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryAllTriggerOrdersRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+func (m *QueryAllTriggerOrdersRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryAllMarketsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryAllTriggerOrdersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
 	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
 	}
-	return n
+	if m.MarketId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MarketId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Orderer) > 0 {
+		i -= len(m.Orderer)
+		copy(dAtA[i:], m.Orderer)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Orderer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryAllMarketsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryAllTriggerOrdersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllTriggerOrdersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllTriggerOrdersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Markets) > 0 {
-		for _, e := range m.Markets {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.TriggerOrders) > 0 {
+		for iNdEx := len(m.TriggerOrders) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TriggerOrders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryMarketRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.MarketId != 0 {
-		n += 1 + sovQuery(uint64(m.MarketId))
+func (m *QueryTriggerOrderRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryMarketResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Market.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+func (m *QueryTriggerOrderRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryAllOrdersRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryTriggerOrderRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Orderer)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.MarketId != 0 {
-		n += 1 + sovQuery(uint64(m.MarketId))
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if m.OrderId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.OrderId))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryAllOrdersResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryTriggerOrderResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTriggerOrderResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTriggerOrderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Orders) > 0 {
-		for _, e := range m.Orders {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	{
+		size, err := m.TriggerOrder.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryOrderRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.OrderId != 0 {
-		n += 1 + sovQuery(uint64(m.OrderId))
+func (m *QueryBestSwapExactAmountInRoutesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryOrderResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Order.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+func (m *QueryBestSwapExactAmountInRoutesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryBestSwapExactAmountInRoutesRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryBestSwapExactAmountInRoutesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Input)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if m.MaxSplits != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MaxSplits))
+		i--
+		dAtA[i] = 0x18
 	}
-	l = len(m.OutputDenom)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.OutputDenom) > 0 {
+		i -= len(m.OutputDenom)
+		copy(dAtA[i:], m.OutputDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.OutputDenom)))
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if len(m.Input) > 0 {
+		i -= len(m.Input)
+		copy(dAtA[i:], m.Input)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Input)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryBestSwapExactAmountInRoutesResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryBestSwapExactAmountInRoutesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBestSwapExactAmountInRoutesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBestSwapExactAmountInRoutesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Routes) > 0 {
-		l = 0
-		for _, e := range m.Routes {
-			l += sovQuery(uint64(e))
+	if len(m.WeightedResults) > 0 {
+		for iNdEx := len(m.WeightedResults) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.WeightedResults[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.WeightedRoutes) > 0 {
+		for iNdEx := len(m.WeightedRoutes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.WeightedRoutes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
 		}
-		n += 1 + sovQuery(uint64(l)) + l
 	}
-	l = m.Output.Size()
-	n += 1 + l + sovQuery(uint64(l))
 	if len(m.Results) > 0 {
-		for _, e := range m.Results {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+		for iNdEx := len(m.Results) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Results[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
 		}
 	}
-	return n
+	{
+		size, err := m.Output.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Routes) > 0 {
+		dAtA13 := make([]byte, len(m.Routes)*10)
+		var j12 int
+		for _, num := range m.Routes {
+			for num >= 1<<7 {
+				dAtA13[j12] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j12++
+			}
+			dAtA13[j12] = uint8(num)
+			j12++
+		}
+		i -= j12
+		copy(dAtA[i:], dAtA13[:j12])
+		i = encodeVarintQuery(dAtA, i, uint64(j12))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryOrderBookRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryBestSwapExactAmountOutRoutesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBestSwapExactAmountOutRoutesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBestSwapExactAmountOutRoutesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.MarketId != 0 {
-		n += 1 + sovQuery(uint64(m.MarketId))
+	if len(m.Output) > 0 {
+		i -= len(m.Output)
+		copy(dAtA[i:], m.Output)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Output)))
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if len(m.InputDenom) > 0 {
+		i -= len(m.InputDenom)
+		copy(dAtA[i:], m.InputDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.InputDenom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryOrderBookResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryBestSwapExactAmountOutRoutesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBestSwapExactAmountOutRoutesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBestSwapExactAmountOutRoutesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.OrderBooks) > 0 {
-		for _, e := range m.OrderBooks {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if len(m.Results) > 0 {
+		for iNdEx := len(m.Results) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Results[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
 		}
 	}
-	return n
+	{
+		size, err := m.Input.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Routes) > 0 {
+		dAtA16 := make([]byte, len(m.Routes)*10)
+		var j15 int
+		for _, num := range m.Routes {
+			for num >= 1<<7 {
+				dAtA16[j15] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j15++
+			}
+			dAtA16[j15] = uint8(num)
+			j15++
+		}
+		i -= j15
+		copy(dAtA[i:], dAtA16[:j15])
+		i = encodeVarintQuery(dAtA, i, uint64(j15))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *MarketResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryOrderBookRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOrderBookRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOrderBookRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Id != 0 {
-		n += 1 + sovQuery(uint64(m.Id))
-	}
-	l = len(m.BaseDenom)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.QuoteDenom)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.EscrowAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if m.MarketId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MarketId))
+		i--
+		dAtA[i] = 0x8
 	}
-	l = m.MakerFeeRate.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	l = m.TakerFeeRate.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	l = m.OrderSourceFeeRatio.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	if m.LastPrice != nil {
-		l = m.LastPrice.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryOrderBookResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if m.LastMatchingHeight != 0 {
-		n += 1 + sovQuery(uint64(m.LastMatchingHeight))
+	return dAtA[:n], nil
+}
+
+func (m *QueryOrderBookResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOrderBookResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.OrderBooks) > 0 {
+		for iNdEx := len(m.OrderBooks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.OrderBooks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *QueryTWAPRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
 }
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *QueryTWAPRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
+
+func (m *QueryTWAPRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Window) > 0 {
+		i -= len(m.Window)
+		copy(dAtA[i:], m.Window)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Window)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.MarketId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MarketId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTWAPResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTWAPResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTWAPResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Twap.Size()
+		i -= size
+		if _, err := m.Twap.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAccountFeeTierRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAccountFeeTierRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAccountFeeTierRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAccountFeeTierResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAccountFeeTierResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAccountFeeTierResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.FeeTier != nil {
+		{
+			size, err := m.FeeTier.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size := m.Volume.Size()
+		i -= size
+		if _, err := m.Volume.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAccountCancelAftersRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAccountCancelAftersRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAccountCancelAftersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAccountCancelAftersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAccountCancelAftersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAccountCancelAftersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CancelAfters) > 0 {
+		for iNdEx := len(m.CancelAfters) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CancelAfters[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAccountReferrerStatsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAccountReferrerStatsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAccountReferrerStatsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MarketId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MarketId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAccountReferrerStatsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAccountReferrerStatsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAccountReferrerStatsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ReferrerStats) > 0 {
+		for iNdEx := len(m.ReferrerStats) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ReferrerStats[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MarketResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MarketResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MarketResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Volume24h.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x92
+	if m.MaxBandPrice != nil {
+		{
+			size := m.MaxBandPrice.Size()
+			i -= size
+			if _, err := m.MaxBandPrice.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x8a
+	}
+	if m.MinBandPrice != nil {
+		{
+			size := m.MinBandPrice.Size()
+			i -= size
+			if _, err := m.MinBandPrice.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x82
+	}
+	if m.CircuitBreakerEndHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.CircuitBreakerEndHeight))
+		i--
+		dAtA[i] = 0x78
+	}
+	if m.SelfTradePrevention != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.SelfTradePrevention))
+		i--
+		dAtA[i] = 0x70
+	}
+	{
+		size := m.LotSize.Size()
+		i -= size
+		if _, err := m.LotSize.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x6a
+	{
+		size := m.MinOrderQuantity.Size()
+		i -= size
+		if _, err := m.MinOrderQuantity.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x62
+	{
+		size := m.TickSize.Size()
+		i -= size
+		if _, err := m.TickSize.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x5a
+	if m.Status != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.LastMatchingHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.LastMatchingHeight))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.LastPrice != nil {
+		{
+			size := m.LastPrice.Size()
+			i -= size
+			if _, err := m.LastPrice.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
+	{
+		size := m.OrderSourceFeeRatio.Size()
+		i -= size
+		if _, err := m.OrderSourceFeeRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.TakerFeeRate.Size()
+		i -= size
+		if _, err := m.TakerFeeRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.MakerFeeRate.Size()
+		i -= size
+		if _, err := m.MakerFeeRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if len(m.EscrowAddress) > 0 {
+		i -= len(m.EscrowAddress)
+		copy(dAtA[i:], m.EscrowAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.EscrowAddress)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.QuoteDenom) > 0 {
+		i -= len(m.QuoteDenom)
+		copy(dAtA[i:], m.QuoteDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.QuoteDenom)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.BaseDenom) > 0 {
+		i -= len(m.BaseDenom)
+		copy(dAtA[i:], m.BaseDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.BaseDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CancelAfterResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CancelAfterResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CancelAfterResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n19, err19 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.CancelAfter, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.CancelAfter):])
+	if err19 != nil {
+		return 0, err19
+	}
+	i -= n19
+	i = encodeVarintQuery(dAtA, i, uint64(n19))
+	i--
+	dAtA[i] = 0x12
+	if m.MarketId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MarketId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ReferrerStatsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReferrerStatsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReferrerStatsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Fees) > 0 {
+		for iNdEx := len(m.Fees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Fees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	{
+		size := m.Volume.Size()
+		i -= size
+		if _, err := m.Volume.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.MarketId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MarketId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllMarketsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllMarketsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Markets) > 0 {
+		for _, e := range m.Markets {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryMarketRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MarketId != 0 {
+		n += 1 + sovQuery(uint64(m.MarketId))
+	}
+	return n
+}
+
+func (m *QueryMarketResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Market.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllOrdersRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Orderer)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.MarketId != 0 {
+		n += 1 + sovQuery(uint64(m.MarketId))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllOrdersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Orders) > 0 {
+		for _, e := range m.Orders {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryOrderRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.OrderId != 0 {
+		n += 1 + sovQuery(uint64(m.OrderId))
+	}
+	return n
+}
+
+func (m *QueryOrderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Order.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllTriggerOrdersRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Orderer)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.MarketId != 0 {
+		n += 1 + sovQuery(uint64(m.MarketId))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllTriggerOrdersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.TriggerOrders) > 0 {
+		for _, e := range m.TriggerOrders {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTriggerOrderRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.OrderId != 0 {
+		n += 1 + sovQuery(uint64(m.OrderId))
+	}
+	return n
+}
+
+func (m *QueryTriggerOrderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.TriggerOrder.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryBestSwapExactAmountInRoutesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Input)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.OutputDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.MaxSplits != 0 {
+		n += 1 + sovQuery(uint64(m.MaxSplits))
+	}
+	return n
+}
+
+func (m *QueryBestSwapExactAmountInRoutesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Routes) > 0 {
+		l = 0
+		for _, e := range m.Routes {
+			l += sovQuery(uint64(e))
+		}
+		n += 1 + sovQuery(uint64(l)) + l
+	}
+	l = m.Output.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.Results) > 0 {
+		for _, e := range m.Results {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.WeightedRoutes) > 0 {
+		for _, e := range m.WeightedRoutes {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.WeightedResults) > 0 {
+		for _, e := range m.WeightedResults {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryBestSwapExactAmountOutRoutesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.InputDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Output)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBestSwapExactAmountOutRoutesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Routes) > 0 {
+		l = 0
+		for _, e := range m.Routes {
+			l += sovQuery(uint64(e))
+		}
+		n += 1 + sovQuery(uint64(l)) + l
+	}
+	l = m.Input.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.Results) > 0 {
+		for _, e := range m.Results {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryOrderBookRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MarketId != 0 {
+		n += 1 + sovQuery(uint64(m.MarketId))
+	}
+	return n
+}
+
+func (m *QueryOrderBookResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.OrderBooks) > 0 {
+		for _, e := range m.OrderBooks {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryTWAPRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MarketId != 0 {
+		n += 1 + sovQuery(uint64(m.MarketId))
+	}
+	l = len(m.Window)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTWAPResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Twap.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAccountFeeTierRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAccountFeeTierResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Volume.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.FeeTier != nil {
+		l = m.FeeTier.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAccountCancelAftersRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAccountCancelAftersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.CancelAfters) > 0 {
+		for _, e := range m.CancelAfters {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryAccountReferrerStatsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.MarketId != 0 {
+		n += 1 + sovQuery(uint64(m.MarketId))
+	}
+	return n
+}
+
+func (m *QueryAccountReferrerStatsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ReferrerStats) > 0 {
+		for _, e := range m.ReferrerStats {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MarketResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	l = len(m.BaseDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.QuoteDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.EscrowAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.MakerFeeRate.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.TakerFeeRate.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.OrderSourceFeeRatio.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.LastPrice != nil {
+		l = m.LastPrice.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.LastMatchingHeight != 0 {
+		n += 1 + sovQuery(uint64(m.LastMatchingHeight))
+	}
+	if m.Status != 0 {
+		n += 1 + sovQuery(uint64(m.Status))
+	}
+	l = m.TickSize.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.MinOrderQuantity.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.LotSize.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.SelfTradePrevention != 0 {
+		n += 1 + sovQuery(uint64(m.SelfTradePrevention))
+	}
+	if m.CircuitBreakerEndHeight != 0 {
+		n += 1 + sovQuery(uint64(m.CircuitBreakerEndHeight))
+	}
+	if m.MinBandPrice != nil {
+		l = m.MinBandPrice.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	if m.MaxBandPrice != nil {
+		l = m.MaxBandPrice.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	l = m.Volume24h.Size()
+	n += 2 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *CancelAfterResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MarketId != 0 {
+		n += 1 + sovQuery(uint64(m.MarketId))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.CancelAfter)
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *ReferrerStatsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MarketId != 0 {
+		n += 1 + sovQuery(uint64(m.MarketId))
+	}
+	l = m.Volume.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.Fees) > 0 {
+		for _, e := range m.Fees {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllMarketsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllMarketsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllMarketsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllMarketsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllMarketsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllMarketsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Markets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Markets = append(m.Markets, MarketResponse{})
+			if err := m.Markets[len(m.Markets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMarketRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMarketRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMarketRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+			}
+			m.MarketId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MarketId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMarketResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMarketResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMarketResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Market", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Market.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllOrdersRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllOrdersRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllOrdersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Orderer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Orderer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+			}
+			m.MarketId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MarketId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllOrdersResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllOrdersResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllOrdersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Orders", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Orders = append(m.Orders, Order{})
+			if err := m.Orders[len(m.Orders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryOrderRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryOrderRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryOrderRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderId", wireType)
+			}
+			m.OrderId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OrderId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryOrderResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryOrderResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryOrderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Order", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Order.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllTriggerOrdersRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllTriggerOrdersRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllTriggerOrdersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Orderer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Orderer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+			}
+			m.MarketId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MarketId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllTriggerOrdersResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllTriggerOrdersResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllTriggerOrdersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TriggerOrders", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TriggerOrders = append(m.TriggerOrders, TriggerOrder{})
+			if err := m.TriggerOrders[len(m.TriggerOrders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTriggerOrderRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTriggerOrderRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTriggerOrderRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderId", wireType)
+			}
+			m.OrderId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OrderId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTriggerOrderResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTriggerOrderResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTriggerOrderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TriggerOrder", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TriggerOrder.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBestSwapExactAmountInRoutesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBestSwapExactAmountInRoutesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBestSwapExactAmountInRoutesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Input", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Input = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutputDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OutputDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSplits", wireType)
+			}
+			m.MaxSplits = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxSplits |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBestSwapExactAmountInRoutesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBestSwapExactAmountInRoutesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBestSwapExactAmountInRoutesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Routes = append(m.Routes, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthQuery
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthQuery
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Routes) == 0 {
+					m.Routes = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowQuery
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Routes = append(m.Routes, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Routes", wireType)
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Output", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Output.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Results", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Results = append(m.Results, SwapRouteResult{})
+			if err := m.Results[len(m.Results)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WeightedRoutes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WeightedRoutes = append(m.WeightedRoutes, WeightedSwapRoute{})
+			if err := m.WeightedRoutes[len(m.WeightedRoutes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WeightedResults", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WeightedResults = append(m.WeightedResults, WeightedSwapRouteResult{})
+			if err := m.WeightedResults[len(m.WeightedResults)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBestSwapExactAmountOutRoutesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBestSwapExactAmountOutRoutesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBestSwapExactAmountOutRoutesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InputDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InputDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Output", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Output = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBestSwapExactAmountOutRoutesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
 		for shift := uint(0); ; shift += 7 {
 			if shift >= 64 {
 				return ErrIntOverflowQuery
 			}
-			if iNdEx >= l {
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBestSwapExactAmountOutRoutesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBestSwapExactAmountOutRoutesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Routes = append(m.Routes, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthQuery
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthQuery
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Routes) == 0 {
+					m.Routes = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowQuery
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Routes = append(m.Routes, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Routes", wireType)
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Input", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Input.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Results", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Results = append(m.Results, SwapRouteResult{})
+			if err := m.Results[len(m.Results)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1930,7 +5898,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryOrderBookRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1953,17 +5921,17 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryOrderBookRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryOrderBookRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
 			}
-			var msglen int
+			m.MarketId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1973,25 +5941,11 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.MarketId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2013,7 +5967,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllMarketsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryOrderBookResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2036,15 +5990,15 @@ func (m *QueryAllMarketsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllMarketsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryOrderBookResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllMarketsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryOrderBookResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderBooks", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2071,10 +6025,8 @@ func (m *QueryAllMarketsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.OrderBooks = append(m.OrderBooks, OrderBook{})
+			if err := m.OrderBooks[len(m.OrderBooks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2099,7 +6051,7 @@ func (m *QueryAllMarketsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllMarketsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryTWAPRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2122,17 +6074,17 @@ func (m *QueryAllMarketsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllMarketsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTWAPRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllMarketsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTWAPRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Markets", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
 			}
-			var msglen int
+			m.MarketId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2142,31 +6094,16 @@ func (m *QueryAllMarketsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.MarketId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Markets = append(m.Markets, MarketResponse{})
-			if err := m.Markets[len(m.Markets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Window", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2176,27 +6113,23 @@ func (m *QueryAllMarketsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Window = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2219,7 +6152,7 @@ func (m *QueryAllMarketsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryMarketRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryTWAPResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2242,17 +6175,17 @@ func (m *QueryMarketRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryMarketRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTWAPResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryMarketRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTWAPResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Twap", wireType)
 			}
-			m.MarketId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2262,11 +6195,26 @@ func (m *QueryMarketRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MarketId |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Twap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2288,7 +6236,7 @@ func (m *QueryMarketRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryMarketResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAccountFeeTierRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2311,17 +6259,17 @@ func (m *QueryMarketResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryMarketResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAccountFeeTierRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryMarketResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAccountFeeTierRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Market", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2331,24 +6279,23 @@ func (m *QueryMarketResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Market.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2371,7 +6318,7 @@ func (m *QueryMarketResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllOrdersRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAccountFeeTierResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2394,15 +6341,15 @@ func (m *QueryAllOrdersRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllOrdersRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAccountFeeTierResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllOrdersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAccountFeeTierResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Orderer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Volume", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2430,13 +6377,15 @@ func (m *QueryAllOrdersRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Orderer = string(dAtA[iNdEx:postIndex])
+			if err := m.Volume.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeTier", wireType)
 			}
-			m.MarketId = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2446,16 +6395,83 @@ func (m *QueryAllOrdersRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MarketId |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.FeeTier == nil {
+				m.FeeTier = &FeeTier{}
+			}
+			if err := m.FeeTier.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAccountCancelAftersRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAccountCancelAftersRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAccountCancelAftersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2465,27 +6481,23 @@ func (m *QueryAllOrdersRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2508,7 +6520,7 @@ func (m *QueryAllOrdersRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllOrdersResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAccountCancelAftersResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2531,49 +6543,15 @@ func (m *QueryAllOrdersResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllOrdersResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAccountCancelAftersResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllOrdersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAccountCancelAftersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Orders", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Orders = append(m.Orders, Order{})
-			if err := m.Orders[len(m.Orders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CancelAfters", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2600,10 +6578,8 @@ func (m *QueryAllOrdersResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.CancelAfters = append(m.CancelAfters, CancelAfterResponse{})
+			if err := m.CancelAfters[len(m.CancelAfters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2628,7 +6604,7 @@ func (m *QueryAllOrdersResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryOrderRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAccountReferrerStatsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2651,17 +6627,49 @@ func (m *QueryOrderRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryOrderRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAccountReferrerStatsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryOrderRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAccountReferrerStatsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OrderId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
 			}
-			m.OrderId = 0
+			m.MarketId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2671,7 +6679,7 @@ func (m *QueryOrderRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.OrderId |= uint64(b&0x7F) << shift
+				m.MarketId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -2697,7 +6705,7 @@ func (m *QueryOrderRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryOrderResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAccountReferrerStatsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2720,15 +6728,15 @@ func (m *QueryOrderResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryOrderResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAccountReferrerStatsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryOrderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAccountReferrerStatsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Order", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ReferrerStats", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2755,7 +6763,8 @@ func (m *QueryOrderResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Order.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ReferrerStats = append(m.ReferrerStats, ReferrerStatsResponse{})
+			if err := m.ReferrerStats[len(m.ReferrerStats)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2780,7 +6789,7 @@ func (m *QueryOrderResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryBestSwapExactAmountInRoutesRequest) Unmarshal(dAtA []byte) error {
+func (m *MarketResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2803,15 +6812,34 @@ func (m *QueryBestSwapExactAmountInRoutesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBestSwapExactAmountInRoutesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MarketResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBestSwapExactAmountInRoutesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MarketResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Input", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseDenom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2839,11 +6867,11 @@ func (m *QueryBestSwapExactAmountInRoutesRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Input = string(dAtA[iNdEx:postIndex])
+			m.BaseDenom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OutputDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field QuoteDenom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2871,139 +6899,45 @@ func (m *QueryBestSwapExactAmountInRoutesRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OutputDenom = string(dAtA[iNdEx:postIndex])
+			m.QuoteDenom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryBestSwapExactAmountInRoutesResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EscrowAddress", wireType)
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBestSwapExactAmountInRoutesResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBestSwapExactAmountInRoutesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType == 0 {
-				var v uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowQuery
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.Routes = append(m.Routes, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowQuery
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthQuery
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthQuery
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
 				}
-				if postIndex > l {
+				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
 				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
-				}
-				elementCount = count
-				if elementCount != 0 && len(m.Routes) == 0 {
-					m.Routes = make([]uint64, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowQuery
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.Routes = append(m.Routes, v)
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field Routes", wireType)
 			}
-		case 2:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EscrowAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Output", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MakerFeeRate", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3013,30 +6947,31 @@ func (m *QueryBestSwapExactAmountInRoutesResponse) Unmarshal(dAtA []byte) error
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Output.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.MakerFeeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Results", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TakerFeeRate", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3046,81 +6981,31 @@ func (m *QueryBestSwapExactAmountInRoutesResponse) Unmarshal(dAtA []byte) error
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Results = append(m.Results, SwapRouteResult{})
-			if err := m.Results[len(m.Results)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TakerFeeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryOrderBookRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryOrderBookRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryOrderBookRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderSourceFeeRatio", wireType)
 			}
-			m.MarketId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3130,66 +7015,31 @@ func (m *QueryOrderBookRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MarketId |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryOrderBookResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.OrderSourceFeeRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryOrderBookResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryOrderBookResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OrderBooks", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastPrice", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3199,81 +7049,71 @@ func (m *QueryOrderBookResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OrderBooks = append(m.OrderBooks, OrderBook{})
-			if err := m.OrderBooks[len(m.OrderBooks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			var v github_com_cosmos_cosmos_sdk_types.Dec
+			m.LastPrice = &v
+			if err := m.LastPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MarketResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastMatchingHeight", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.LastMatchingHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastMatchingHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MarketResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MarketResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 10:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= MarketStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.Id = 0
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TickSize", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3283,14 +7123,29 @@ func (m *MarketResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Id |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TickSize.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BaseDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MinOrderQuantity", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3318,11 +7173,13 @@ func (m *MarketResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BaseDenom = string(dAtA[iNdEx:postIndex])
+			if err := m.MinOrderQuantity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		case 13:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field QuoteDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LotSize", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3350,11 +7207,51 @@ func (m *MarketResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.QuoteDenom = string(dAtA[iNdEx:postIndex])
+			if err := m.LotSize.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 4:
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SelfTradePrevention", wireType)
+			}
+			m.SelfTradePrevention = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SelfTradePrevention |= SelfTradePrevention(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CircuitBreakerEndHeight", wireType)
+			}
+			m.CircuitBreakerEndHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CircuitBreakerEndHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 16:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EscrowAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MinBandPrice", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3382,11 +7279,15 @@ func (m *MarketResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.EscrowAddress = string(dAtA[iNdEx:postIndex])
+			var v github_com_cosmos_cosmos_sdk_types.Dec
+			m.MinBandPrice = &v
+			if err := m.MinBandPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 5:
+		case 17:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MakerFeeRate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBandPrice", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3414,15 +7315,17 @@ func (m *MarketResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MakerFeeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			var v github_com_cosmos_cosmos_sdk_types.Dec
+			m.MaxBandPrice = &v
+			if err := m.MaxBandPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
+		case 18:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TakerFeeRate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Volume24h", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3432,31 +7335,99 @@ func (m *MarketResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TakerFeeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Volume24h.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CancelAfterResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CancelAfterResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CancelAfterResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+			}
+			m.MarketId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MarketId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OrderSourceFeeRatio", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CancelAfter", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3466,29 +7437,97 @@ func (m *MarketResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.OrderSourceFeeRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.CancelAfter, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 8:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ReferrerStatsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReferrerStatsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReferrerStatsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+			}
+			m.MarketId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MarketId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastPrice", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Volume", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3516,17 +7555,15 @@ func (m *MarketResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v github_com_cosmos_cosmos_sdk_types.Dec
-			m.LastPrice = &v
-			if err := m.LastPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Volume.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 9:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastMatchingHeight", wireType)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fees", wireType)
 			}
-			m.LastMatchingHeight = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3536,11 +7573,26 @@ func (m *MarketResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.LastMatchingHeight |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fees = append(m.Fees, types.DecCoin{})
+			if err := m.Fees[len(m.Fees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
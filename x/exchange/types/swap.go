@@ -0,0 +1,44 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	utils "github.com/crescent-network/crescent/v5/types"
+)
+
+const (
+	// MaxNumSwapRoutes is the maximum number of routes found between two
+	// denoms.
+	MaxNumSwapRoutes = 100
+	// MaxNumSwapRouteCandidates is the maximum number of the most liquid routes
+	// simulated when finding the best swap routes.
+	MaxNumSwapRouteCandidates = 10
+)
+
+func NewWeightedSwapRoute(routes []uint64, weight sdk.Dec) WeightedSwapRoute {
+	return WeightedSwapRoute{
+		Routes: routes,
+		Weight: weight,
+	}
+}
+
+// SplitSwapInput distributes the input across the weighted routes in
+// proportion to their weights.
+// Each split is truncated to an integer and the last route takes the remainder.
+func SplitSwapInput(input sdk.DecCoin, weightedRoutes []WeightedSwapRoute) []sdk.DecCoin {
+	totalWeight := utils.ZeroDec
+	for _, weightedRoute := range weightedRoutes {
+		totalWeight = totalWeight.Add(weightedRoute.Weight)
+	}
+	inputs := make([]sdk.DecCoin, len(weightedRoutes))
+	remaining := input.Amount
+	for i, weightedRoute := range weightedRoutes {
+		amt := remaining
+		if i < len(weightedRoutes)-1 {
+			amt = input.Amount.Mul(weightedRoute.Weight).Quo(totalWeight).TruncateDec()
+		}
+		inputs[i] = sdk.NewDecCoinFromDec(input.Denom, amt)
+		remaining = remaining.Sub(amt)
+	}
+	return inputs
+}
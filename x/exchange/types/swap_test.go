@@ -0,0 +1,61 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	utils "github.com/crescent-network/crescent/v5/types"
+	"github.com/crescent-network/crescent/v5/x/exchange/types"
+)
+
+func TestSplitSwapInput(t *testing.T) {
+	for _, tc := range []struct {
+		name     string
+		input    sdk.DecCoin
+		weights  []sdk.Dec
+		expected []string
+	}{
+		{
+			"single route",
+			utils.ParseDecCoin("1000000ucre"),
+			[]sdk.Dec{utils.ParseDec("1")},
+			[]string{"1000000.000000000000000000ucre"},
+		},
+		{
+			"even split",
+			utils.ParseDecCoin("1000000ucre"),
+			[]sdk.Dec{utils.ParseDec("1"), utils.ParseDec("1")},
+			[]string{"500000.000000000000000000ucre", "500000.000000000000000000ucre"},
+		},
+		{
+			"remainder goes to the last route",
+			utils.ParseDecCoin("1000000ucre"),
+			[]sdk.Dec{utils.ParseDec("1"), utils.ParseDec("1"), utils.ParseDec("1")},
+			[]string{
+				"333333.000000000000000000ucre", "333333.000000000000000000ucre", "333334.000000000000000000ucre",
+			},
+		},
+		{
+			"amounts as weights",
+			utils.ParseDecCoin("1000000ucre"),
+			[]sdk.Dec{sdk.NewDec(700000), sdk.NewDec(300000)},
+			[]string{"700000.000000000000000000ucre", "300000.000000000000000000ucre"},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			var weightedRoutes []types.WeightedSwapRoute
+			for i, weight := range tc.weights {
+				weightedRoutes = append(weightedRoutes, types.NewWeightedSwapRoute([]uint64{uint64(i + 1)}, weight))
+			}
+			inputs := types.SplitSwapInput(tc.input, weightedRoutes)
+			var strs []string
+			for _, input := range inputs {
+				strs = append(strs, input.String())
+			}
+			require.Equal(t, tc.expected, strs)
+		})
+	}
+}
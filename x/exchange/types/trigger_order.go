@@ -0,0 +1,98 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MaxNumTriggeredOrders is the maximum number of trigger orders triggered after
+// an order, a swap or a batch matching. The rest of triggered orders are
+// triggered after the next one.
+const MaxNumTriggeredOrders = 100
+
+func NewTriggerOrder(
+	orderId uint64, ordererAddr sdk.AccAddress, marketId uint64, isBuy bool,
+	condition TriggerCondition, triggerPrice sdk.Dec, price *sdk.Dec, qty sdk.Dec,
+	msgHeight int64, deposit sdk.Dec, deadline time.Time) TriggerOrder {
+	return TriggerOrder{
+		Id:           orderId,
+		Orderer:      ordererAddr.String(),
+		MarketId:     marketId,
+		IsBuy:        isBuy,
+		Condition:    condition,
+		TriggerPrice: triggerPrice,
+		Price:        price,
+		Quantity:     qty,
+		MsgHeight:    msgHeight,
+		Deposit:      deposit,
+		Deadline:     deadline,
+	}
+}
+
+func (order TriggerOrder) Validate() error {
+	if order.Id == 0 {
+		return fmt.Errorf("id must not be 0")
+	}
+	if _, err := sdk.AccAddressFromBech32(order.Orderer); err != nil {
+		return fmt.Errorf("invalid orderer address: %w", err)
+	}
+	if order.MarketId == 0 {
+		return fmt.Errorf("market id must not be 0")
+	}
+	if err := ValidateTriggerCondition(order.Condition); err != nil {
+		return err
+	}
+	if !order.TriggerPrice.IsPositive() {
+		return fmt.Errorf("trigger price must be positive: %s", order.TriggerPrice)
+	}
+	if _, valid := ValidateTickPrice(order.TriggerPrice); !valid {
+		return fmt.Errorf("invalid trigger tick price: %s", order.TriggerPrice)
+	}
+	if order.Price != nil {
+		if !order.Price.IsPositive() {
+			return fmt.Errorf("price must be positive: %s", order.Price)
+		}
+		if _, valid := ValidateTickPrice(*order.Price); !valid {
+			return fmt.Errorf("invalid tick price: %s", order.Price)
+		}
+	}
+	if !order.Quantity.IsPositive() {
+		return fmt.Errorf("quantity must be positive: %s", order.Quantity)
+	}
+	if !order.Deposit.IsPositive() {
+		return fmt.Errorf("deposit must be positive: %s", order.Deposit)
+	}
+	return nil
+}
+
+// IsTriggered returns whether the order should be triggered at the given
+// last price.
+func (order TriggerOrder) IsTriggered(lastPrice sdk.Dec) bool {
+	return IsTriggerConditionMet(order.Condition, order.TriggerPrice, lastPrice)
+}
+
+func (order TriggerOrder) MustGetOrdererAddress() sdk.AccAddress {
+	return sdk.MustAccAddressFromBech32(order.Orderer)
+}
+
+// IsTriggerConditionMet returns whether the trigger condition is met at the
+// given last price.
+func IsTriggerConditionMet(condition TriggerCondition, triggerPrice, lastPrice sdk.Dec) bool {
+	switch condition {
+	case TriggerConditionPriceAbove:
+		return lastPrice.GTE(triggerPrice)
+	case TriggerConditionPriceBelow:
+		return lastPrice.LTE(triggerPrice)
+	default:
+		panic(fmt.Sprintf("invalid trigger condition: %v", condition))
+	}
+}
+
+func ValidateTriggerCondition(condition TriggerCondition) error {
+	if condition != TriggerConditionPriceAbove && condition != TriggerConditionPriceBelow {
+		return fmt.Errorf("invalid trigger condition: %v", condition)
+	}
+	return nil
+}
@@ -349,10 +349,11 @@ func (s *KeeperTestSuite) createMarketAndPool(baseDenom, quoteDenom string, pric
 	creatorAddr := utils.TestAddress(100000)
 	s.fundAddr(creatorAddr, s.app.ExchangeKeeper.GetMarketCreationFee(s.ctx))
 	var err error
-	market, err = s.app.ExchangeKeeper.CreateMarket(s.ctx, creatorAddr, baseDenom, quoteDenom)
+	market, err = s.app.ExchangeKeeper.CreateMarket(
+		s.ctx, creatorAddr, baseDenom, quoteDenom, utils.ZeroDec, utils.ZeroDec, utils.ZeroDec)
 	s.Require().NoError(err)
 	s.fundAddr(creatorAddr, s.app.AMMKeeper.GetPoolCreationFee(s.ctx))
-	pool, err = s.app.AMMKeeper.CreatePool(s.ctx, creatorAddr, market.Id, price)
+	pool, err = s.app.AMMKeeper.CreatePool(s.ctx, creatorAddr, market.Id, price, 0)
 	s.Require().NoError(err)
 	return
 }
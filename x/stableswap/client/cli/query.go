@@ -0,0 +1,148 @@
+package cli
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/version"
+
+	"github.com/crescent-network/crescent/v5/x/stableswap/types"
+)
+
+// GetQueryCmd returns the cli query commands for this module
+func GetQueryCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      fmt.Sprintf("Querying commands for the %s module", types.ModuleName),
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		NewQueryParamsCmd(),
+		NewQueryAllPoolsCmd(),
+		NewQueryPoolCmd(),
+	)
+
+	return cmd
+}
+
+// NewQueryParamsCmd implements the params query command.
+func NewQueryParamsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "params",
+		Args:  cobra.NoArgs,
+		Short: "Query the current stableswap parameters",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the current stableswap parameters.
+
+Example:
+$ %s query %s params
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			resp, err := queryClient.Params(cmd.Context(), &types.QueryParamsRequest{})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(&resp.Params)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func NewQueryAllPoolsCmd() *cobra.Command {
+	const flagMarketId = "market-id"
+	cmd := &cobra.Command{
+		Use:   "pools",
+		Args:  cobra.NoArgs,
+		Short: "Query all pools",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query all pools.
+
+Example:
+$ %s query %s pools
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			marketId, err := cmd.Flags().GetUint64(flagMarketId)
+			if err != nil {
+				return fmt.Errorf("invalid market id: %w", err)
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			res, err := queryClient.AllPools(cmd.Context(), &types.QueryAllPoolsRequest{
+				MarketId:   marketId,
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	cmd.Flags().Uint64(flagMarketId, 0, "Query pool by market ID")
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "pools")
+	return cmd
+}
+
+func NewQueryPoolCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pool [pool-id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query a specific pool",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query a specific pool by its ID.
+
+Example:
+$ %s query %s pool 1
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			poolId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid pool id: %w", err)
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.Pool(cmd.Context(), &types.QueryPoolRequest{
+				PoolId: poolId,
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
@@ -0,0 +1,218 @@
+package cli
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/cosmos/cosmos-sdk/x/gov/client/cli"
+	gov "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/crescent-network/crescent/v5/x/stableswap/types"
+)
+
+// GetTxCmd returns the transaction commands for the module
+func GetTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      fmt.Sprintf("%s transactions subcommands", types.ModuleName),
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		NewCreatePoolCmd(),
+		NewDepositCmd(),
+		NewWithdrawCmd(),
+	)
+
+	return cmd
+}
+
+func NewCreatePoolCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-pool [market-id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Create a pool",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Create a stableswap pool for a market.
+
+Example:
+$ %s tx %s create-pool 1 --from mykey
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			marketId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid market id: %w", err)
+			}
+			msg := types.NewMsgCreatePool(clientCtx.GetFromAddress(), marketId)
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func NewDepositCmd() *cobra.Command {
+	const flagMinShare = "min-share"
+	cmd := &cobra.Command{
+		Use:   "deposit [pool-id] [amount]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Deposit coins to a pool",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Deposit coins to a pool and receive the pool share.
+Only the amount in proportion to the pool's reserves is deposited.
+
+Example:
+$ %s tx %s deposit 1 1000000uusdc,1000000uusdt --min-share=1000000 --from mykey
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			poolId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid pool id: %w", err)
+			}
+			amt, err := sdk.ParseCoinsNormalized(args[1])
+			if err != nil {
+				return fmt.Errorf("invalid amount: %w", err)
+			}
+			minShareStr, _ := cmd.Flags().GetString(flagMinShare)
+			minShare, ok := sdk.NewIntFromString(minShareStr)
+			if !ok {
+				return fmt.Errorf("invalid min share: %s", minShareStr)
+			}
+			msg := types.NewMsgDeposit(clientCtx.GetFromAddress(), poolId, amt, minShare)
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	cmd.Flags().String(flagMinShare, "0", "Minimum amount of pool share to receive")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func NewWithdrawCmd() *cobra.Command {
+	const flagMinAmount = "min-amount"
+	cmd := &cobra.Command{
+		Use:   "withdraw [pool-id] [share]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Withdraw coins from a pool",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Burn the pool share and withdraw coins from a pool.
+
+Example:
+$ %s tx %s withdraw 1 1000000sspool1 --min-amount=490000uusdc,490000uusdt --from mykey
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			poolId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid pool id: %w", err)
+			}
+			share, err := sdk.ParseCoinNormalized(args[1])
+			if err != nil {
+				return fmt.Errorf("invalid share: %w", err)
+			}
+			minAmtStr, _ := cmd.Flags().GetString(flagMinAmount)
+			minAmt, err := sdk.ParseCoinsNormalized(minAmtStr)
+			if err != nil {
+				return fmt.Errorf("invalid min amount: %w", err)
+			}
+			msg := types.NewMsgWithdraw(clientCtx.GetFromAddress(), poolId, share, minAmt)
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	cmd.Flags().String(flagMinAmount, "", "Minimum amount of coins to receive")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func NewCmdSubmitPoolParameterChangeProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "stableswap-pool-parameter-change [proposal-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a stableswap pool parameter change proposal",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a stableswap pool parameter change proposal along with an initial deposit.
+The proposal details must be supplied via a JSON file.
+
+Example:
+$ %s tx gov submit-proposal stableswap-pool-parameter-change <path/to/proposal.json> --from=<key_or_address> --deposit=<deposit_amount>
+
+Where proposal.json contains:
+
+{
+  "title": "Pool parameter change",
+  "description": "Change pool parameters",
+  "changes": [
+    {
+      "pool_id": "1",
+      "amplification": "200",
+      "tick_spacing": 5
+    },
+    {
+      "pool_id": "2",
+      "min_order_quantity": "10000",
+      "min_order_quote": "10000"
+    }
+  ]
+}
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			depositStr, _ := cmd.Flags().GetString(cli.FlagDeposit)
+			deposit, err := sdk.ParseCoinsNormalized(depositStr)
+			if err != nil {
+				return fmt.Errorf("invalid deposit: %w", err)
+			}
+			var proposal types.PoolParameterChangeProposal
+			bz, err := os.ReadFile(args[0])
+			if err != nil {
+				return fmt.Errorf("read proposal: %w", err)
+			}
+			if err = clientCtx.Codec.UnmarshalJSON(bz, &proposal); err != nil {
+				return fmt.Errorf("unmarshal proposal: %w", err)
+			}
+			msg, err := gov.NewMsgSubmitProposal(&proposal, deposit, clientCtx.GetFromAddress())
+			if err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	cmd.Flags().String(cli.FlagDeposit, "", "deposit of proposal")
+	return cmd
+}
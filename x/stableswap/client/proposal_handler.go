@@ -0,0 +1,22 @@
+package client
+
+import (
+	"net/http"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	govclient "github.com/cosmos/cosmos-sdk/x/gov/client"
+	"github.com/cosmos/cosmos-sdk/x/gov/client/rest"
+
+	"github.com/crescent-network/crescent/v5/x/stableswap/client/cli"
+)
+
+func dummyRESTHandler(client.Context) rest.ProposalRESTHandler {
+	return rest.ProposalRESTHandler{
+		SubRoute: "dummy_stableswap",
+		Handler:  func(http.ResponseWriter, *http.Request) {},
+	}
+}
+
+var (
+	PoolParameterChangeProposalHandler = govclient.NewProposalHandler(cli.NewCmdSubmitPoolParameterChangeProposal, dummyRESTHandler)
+)
@@ -0,0 +1,34 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/crescent-network/crescent/v5/x/stableswap/types"
+)
+
+// InitGenesis initializes the module's state from a provided genesis state.
+func (k Keeper) InitGenesis(ctx sdk.Context, genState types.GenesisState) {
+	k.SetParams(ctx, genState.Params)
+	if genState.LastPoolId > 0 {
+		k.SetLastPoolId(ctx, genState.LastPoolId)
+	}
+	for _, poolRecord := range genState.PoolRecords {
+		k.SetPool(ctx, poolRecord.Pool)
+		k.SetPoolByReserveAddressIndex(ctx, poolRecord.Pool)
+		k.SetPoolByMarketIndex(ctx, poolRecord.Pool)
+		k.SetPoolState(ctx, poolRecord.Pool.Id, poolRecord.State)
+	}
+}
+
+// ExportGenesis returns the module's exported genesis.
+func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
+	poolRecords := []types.PoolRecord{}
+	k.IterateAllPools(ctx, func(pool types.Pool) (stop bool) {
+		poolRecords = append(poolRecords, types.PoolRecord{
+			Pool:  pool,
+			State: k.MustGetPoolState(ctx, pool.Id),
+		})
+		return false
+	})
+	return types.NewGenesisState(k.GetParams(ctx), k.GetLastPoolId(ctx), poolRecords)
+}
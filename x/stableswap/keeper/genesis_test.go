@@ -0,0 +1,43 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	utils "github.com/crescent-network/crescent/v5/types"
+	"github.com/crescent-network/crescent/v5/x/stableswap/types"
+)
+
+func (s *KeeperTestSuite) TestImportExportGenesis() {
+	market, pool := s.CreateMarketAndPool("uusdc", "uusdt")
+	lpAddr := s.FundedAccount(1, enoughCoins)
+	s.Deposit(lpAddr, pool.Id, utils.ParseCoins("1000_000000uusdc,1000_000000uusdt"))
+	ordererAddr := s.FundedAccount(2, enoughCoins)
+	s.PlaceLimitOrder(market.Id, ordererAddr, true, utils.ParseDec("1.001"), sdk.NewDec(10_000000), time.Hour)
+
+	s.NextBlock()
+
+	genState := s.keeper.ExportGenesis(s.Ctx)
+	bz := s.App.AppCodec().MustMarshalJSON(genState)
+
+	s.SetupTest()
+	var genState2 types.GenesisState
+	s.App.AppCodec().MustUnmarshalJSON(bz, &genState2)
+	s.keeper.InitGenesis(s.Ctx, genState2)
+	genState3 := s.keeper.ExportGenesis(s.Ctx)
+	s.Require().Equal(*genState, *genState3)
+}
+
+func (s *KeeperTestSuite) TestImportExportGenesisEmpty() {
+	genState := s.keeper.ExportGenesis(s.Ctx)
+
+	var genState2 types.GenesisState
+	bz := s.App.AppCodec().MustMarshalJSON(genState)
+	s.App.AppCodec().MustUnmarshalJSON(bz, &genState2)
+	s.keeper.InitGenesis(s.Ctx, genState2)
+
+	genState3 := s.keeper.ExportGenesis(s.Ctx)
+	s.Require().Equal(*genState, genState2)
+	s.Require().Equal(genState2, *genState3)
+}
@@ -0,0 +1,86 @@
+package keeper
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/crescent-network/crescent/v5/x/stableswap/types"
+)
+
+// Querier is used as Keeper will have duplicate methods if used directly,
+// and gRPC names take precedence over keeper.
+type Querier struct {
+	Keeper
+}
+
+var _ types.QueryServer = Querier{}
+
+// Params queries the parameters of the module.
+func (k Querier) Params(c context.Context, _ *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	var params types.Params
+	k.Keeper.paramSpace.GetParamSet(ctx, &params)
+	return &types.QueryParamsResponse{Params: params}, nil
+}
+
+func (k Querier) AllPools(c context.Context, req *types.QueryAllPoolsRequest) (*types.QueryAllPoolsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	store := ctx.KVStore(k.storeKey)
+	var (
+		keyPrefix  []byte
+		poolGetter func(key, value []byte) types.Pool
+	)
+	if req.MarketId > 0 {
+		if _, found := k.exchangeKeeper.GetMarket(ctx, req.MarketId); !found {
+			return nil, status.Error(codes.NotFound, "market not found")
+		}
+		keyPrefix = types.GetPoolByMarketIndexKey(req.MarketId)
+		poolGetter = func(_, value []byte) types.Pool {
+			return k.MustGetPool(ctx, sdk.BigEndianToUint64(value))
+		}
+	} else {
+		keyPrefix = types.PoolKeyPrefix
+		poolGetter = func(_, value []byte) types.Pool {
+			var pool types.Pool
+			k.cdc.MustUnmarshal(value, &pool)
+			return pool
+		}
+	}
+	poolStore := prefix.NewStore(store, keyPrefix)
+	var poolResps []types.PoolResponse
+	pageRes, err := query.Paginate(poolStore, req.Pagination, func(key, value []byte) error {
+		pool := poolGetter(key, value)
+		poolResps = append(poolResps, types.NewPoolResponse(pool, k.MustGetPoolState(ctx, pool.Id)))
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &types.QueryAllPoolsResponse{
+		Pools:      poolResps,
+		Pagination: pageRes,
+	}, nil
+}
+
+func (k Querier) Pool(c context.Context, req *types.QueryPoolRequest) (*types.QueryPoolResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	pool, found := k.GetPool(ctx, req.PoolId)
+	if !found {
+		return nil, status.Error(codes.NotFound, "pool not found")
+	}
+	return &types.QueryPoolResponse{
+		Pool: types.NewPoolResponse(pool, k.MustGetPoolState(ctx, pool.Id)),
+	}, nil
+}
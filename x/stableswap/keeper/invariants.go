@@ -0,0 +1,45 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/crescent-network/crescent/v5/x/stableswap/types"
+)
+
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(types.ModuleName, "pool-reserve", PoolReserveInvariant(k))
+}
+
+func AllInvariants(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (res string, broken bool) {
+		return PoolReserveInvariant(k)(ctx)
+	}
+}
+
+// PoolReserveInvariant checks that the reserve address of each pool holds
+// at least the pool's reserves.
+func PoolReserveInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		msg := ""
+		cnt := 0
+		k.IterateAllPools(ctx, func(pool types.Pool) (stop bool) {
+			poolState := k.MustGetPoolState(ctx, pool.Id)
+			reserves := sdk.NewCoins(
+				sdk.NewCoin(pool.Denom0, poolState.Reserve0), sdk.NewCoin(pool.Denom1, poolState.Reserve1))
+			balances := k.bankKeeper.SpendableCoins(ctx, pool.MustGetReserveAddress())
+			if !balances.IsAllGTE(reserves) {
+				msg += fmt.Sprintf(
+					"\tpool %d has insufficient balances: %s < %s\n", pool.Id, balances, reserves)
+				cnt++
+			}
+			return false
+		})
+		broken := cnt != 0
+		return sdk.FormatInvariant(
+			types.ModuleName, "pool reserve",
+			fmt.Sprintf("found %d pool(s) with insufficient balances\n%s", cnt, msg),
+		), broken
+	}
+}
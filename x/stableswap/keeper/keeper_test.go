@@ -0,0 +1,81 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/crescent-network/crescent/v5/app/testutil"
+	utils "github.com/crescent-network/crescent/v5/types"
+	exchangetypes "github.com/crescent-network/crescent/v5/x/exchange/types"
+	"github.com/crescent-network/crescent/v5/x/stableswap/keeper"
+	"github.com/crescent-network/crescent/v5/x/stableswap/types"
+)
+
+var enoughCoins = utils.ParseCoins(
+	"1000000_000000000000000000uusdc,1000000_000000000000000000uusdt,1000000_000000000000000000stake")
+
+type KeeperTestSuite struct {
+	testutil.TestSuite
+	keeper    keeper.Keeper
+	msgServer types.MsgServer
+	querier   keeper.Querier
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
+
+func (s *KeeperTestSuite) SetupTest() {
+	s.TestSuite.SetupTest()
+	s.keeper = s.App.StableSwapKeeper
+	s.msgServer = keeper.NewMsgServerImpl(s.keeper)
+	s.querier = keeper.Querier{Keeper: s.keeper}
+	s.FundAccount(utils.TestAddress(0), utils.ParseCoins("1uusdc,1uusdt")) // make positive supplies
+}
+
+func (s *KeeperTestSuite) CreateStableSwapPool(marketId uint64) types.Pool {
+	s.T().Helper()
+	creatorAddr := utils.TestAddress(1000001)
+	creationFee := s.keeper.GetPoolCreationFee(s.Ctx)
+	if !s.GetAllBalances(creatorAddr).IsAllGTE(creationFee) {
+		s.FundAccount(creatorAddr, creationFee)
+	}
+	pool, err := s.keeper.CreatePool(s.Ctx, creatorAddr, marketId)
+	s.Require().NoError(err)
+	return pool
+}
+
+func (s *KeeperTestSuite) CreateMarketAndPool(baseDenom, quoteDenom string) (market exchangetypes.Market, pool types.Pool) {
+	market = s.CreateMarket(baseDenom, quoteDenom)
+	pool = s.CreateStableSwapPool(market.Id)
+	return market, pool
+}
+
+func (s *KeeperTestSuite) Deposit(depositorAddr sdk.AccAddress, poolId uint64, amt sdk.Coins) (share sdk.Coin, depositedAmt sdk.Coins) {
+	s.T().Helper()
+	var err error
+	share, depositedAmt, err = s.keeper.Deposit(s.Ctx, depositorAddr, poolId, amt, utils.ZeroInt)
+	s.Require().NoError(err)
+	return
+}
+
+func (s *KeeperTestSuite) Withdraw(withdrawerAddr sdk.AccAddress, poolId uint64, share sdk.Coin) (amt sdk.Coins) {
+	s.T().Helper()
+	var err error
+	amt, err = s.keeper.Withdraw(s.Ctx, withdrawerAddr, poolId, share, nil)
+	s.Require().NoError(err)
+	return
+}
+
+// AssertReserves asserts that the pool's reserves in the pool state are equal
+// to the coins held by the pool's reserve address.
+func (s *KeeperTestSuite) AssertReserves(pool types.Pool) {
+	s.T().Helper()
+	poolState := s.keeper.MustGetPoolState(s.Ctx, pool.Id)
+	reserveAddr := pool.MustGetReserveAddress()
+	s.AssertEqual(s.GetBalance(reserveAddr, pool.Denom0).Amount, poolState.Reserve0)
+	s.AssertEqual(s.GetBalance(reserveAddr, pool.Denom1).Amount, poolState.Reserve1)
+}
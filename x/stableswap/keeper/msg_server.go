@@ -0,0 +1,50 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/crescent-network/crescent/v5/x/stableswap/types"
+)
+
+var _ types.MsgServer = msgServer{}
+
+type msgServer struct {
+	Keeper
+}
+
+// NewMsgServerImpl returns an implementation of the MsgServer interface
+// for the provided Keeper.
+func NewMsgServerImpl(keeper Keeper) types.MsgServer {
+	return &msgServer{Keeper: keeper}
+}
+
+func (k msgServer) CreatePool(goCtx context.Context, msg *types.MsgCreatePool) (*types.MsgCreatePoolResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	pool, err := k.Keeper.CreatePool(ctx, sdk.MustAccAddressFromBech32(msg.Sender), msg.MarketId)
+	if err != nil {
+		return nil, err
+	}
+	return &types.MsgCreatePoolResponse{PoolId: pool.Id}, nil
+}
+
+func (k msgServer) Deposit(goCtx context.Context, msg *types.MsgDeposit) (*types.MsgDepositResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	share, _, err := k.Keeper.Deposit(
+		ctx, sdk.MustAccAddressFromBech32(msg.Sender), msg.PoolId, msg.Amount, msg.MinShare)
+	if err != nil {
+		return nil, err
+	}
+	return &types.MsgDepositResponse{Share: share}, nil
+}
+
+func (k msgServer) Withdraw(goCtx context.Context, msg *types.MsgWithdraw) (*types.MsgWithdrawResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	amt, err := k.Keeper.Withdraw(
+		ctx, sdk.MustAccAddressFromBech32(msg.Sender), msg.PoolId, msg.Share, msg.MinAmount)
+	if err != nil {
+		return nil, err
+	}
+	return &types.MsgWithdrawResponse{Amount: amt}, nil
+}
@@ -0,0 +1,63 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/crescent-network/crescent/v5/x/stableswap/types"
+)
+
+// GetParams returns the parameters for the module.
+func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
+	k.paramSpace.GetParamSet(ctx, &params)
+	return
+}
+
+// SetParams sets the parameters for the module.
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
+	k.paramSpace.SetParamSet(ctx, &params)
+}
+
+func (k Keeper) GetPoolCreationFee(ctx sdk.Context) (fee sdk.Coins) {
+	k.paramSpace.Get(ctx, types.KeyPoolCreationFee, &fee)
+	return
+}
+
+func (k Keeper) SetPoolCreationFee(ctx sdk.Context, fee sdk.Coins) {
+	k.paramSpace.Set(ctx, types.KeyPoolCreationFee, fee)
+}
+
+func (k Keeper) GetDefaultAmplification(ctx sdk.Context) (amp uint64) {
+	k.paramSpace.Get(ctx, types.KeyDefaultAmplification, &amp)
+	return
+}
+
+func (k Keeper) SetDefaultAmplification(ctx sdk.Context, amp uint64) {
+	k.paramSpace.Set(ctx, types.KeyDefaultAmplification, amp)
+}
+
+func (k Keeper) GetDefaultTickSpacing(ctx sdk.Context) (tickSpacing uint32) {
+	k.paramSpace.Get(ctx, types.KeyDefaultTickSpacing, &tickSpacing)
+	return
+}
+
+func (k Keeper) SetDefaultTickSpacing(ctx sdk.Context, tickSpacing uint32) {
+	k.paramSpace.Set(ctx, types.KeyDefaultTickSpacing, tickSpacing)
+}
+
+func (k Keeper) GetDefaultMinOrderQuantity(ctx sdk.Context) (minOrderQty sdk.Dec) {
+	k.paramSpace.Get(ctx, types.KeyDefaultMinOrderQuantity, &minOrderQty)
+	return
+}
+
+func (k Keeper) SetDefaultMinOrderQuantity(ctx sdk.Context, minOrderQty sdk.Dec) {
+	k.paramSpace.Set(ctx, types.KeyDefaultMinOrderQuantity, minOrderQty)
+}
+
+func (k Keeper) GetDefaultMinOrderQuote(ctx sdk.Context) (minOrderQuote sdk.Dec) {
+	k.paramSpace.Get(ctx, types.KeyDefaultMinOrderQuote, &minOrderQuote)
+	return
+}
+
+func (k Keeper) SetDefaultMinOrderQuote(ctx sdk.Context, minOrderQuote sdk.Dec) {
+	k.paramSpace.Set(ctx, types.KeyDefaultMinOrderQuote, minOrderQuote)
+}
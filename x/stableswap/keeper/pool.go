@@ -0,0 +1,283 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	utils "github.com/crescent-network/crescent/v5/types"
+	exchangetypes "github.com/crescent-network/crescent/v5/x/exchange/types"
+	"github.com/crescent-network/crescent/v5/x/stableswap/types"
+)
+
+func (k Keeper) CreatePool(ctx sdk.Context, creatorAddr sdk.AccAddress, marketId uint64) (pool types.Pool, err error) {
+	market, found := k.exchangeKeeper.GetMarket(ctx, marketId)
+	if !found {
+		err = sdkerrors.Wrap(sdkerrors.ErrNotFound, "market not found")
+		return
+	}
+	if found := k.LookupPoolByMarket(ctx, market.Id); found {
+		err = sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "cannot create more than one pool per market")
+		return
+	}
+
+	creationFee := k.GetPoolCreationFee(ctx)
+	if creationFee.IsAllPositive() {
+		if err = k.bankKeeper.SendCoinsFromAccountToModule(ctx, creatorAddr, types.ModuleName, creationFee); err != nil {
+			err = sdkerrors.Wrap(err, "insufficient pool creation fee")
+			return
+		}
+	}
+
+	poolId := k.GetNextPoolIdWithUpdate(ctx)
+	pool = types.NewPool(
+		poolId, marketId, market.BaseDenom, market.QuoteDenom,
+		k.GetDefaultAmplification(ctx), k.GetDefaultTickSpacing(ctx),
+		k.GetDefaultMinOrderQuantity(ctx), k.GetDefaultMinOrderQuote(ctx))
+	k.SetPool(ctx, pool)
+	k.SetPoolByMarketIndex(ctx, pool)
+	k.SetPoolByReserveAddressIndex(ctx, pool)
+	k.SetPoolState(ctx, pool.Id, types.NewPoolState())
+
+	if err = ctx.EventManager().EmitTypedEvent(&types.EventCreatePool{
+		Creator:  creatorAddr.String(),
+		MarketId: marketId,
+		PoolId:   poolId,
+	}); err != nil {
+		return
+	}
+
+	return pool, nil
+}
+
+// Deposit adds liquidity to the pool and mints pool share to the depositor.
+// The first deposit to an empty pool sets the pool's price and mints as much
+// pool share as the invariant of the pool.
+// Later deposits are made in proportion to the pool's reserves, and the
+// remaining amount is not taken from the depositor.
+func (k Keeper) Deposit(
+	ctx sdk.Context, depositorAddr sdk.AccAddress, poolId uint64,
+	amt sdk.Coins, minShare sdk.Int) (share sdk.Coin, depositedAmt sdk.Coins, err error) {
+	pool, found := k.GetPool(ctx, poolId)
+	if !found {
+		err = sdkerrors.Wrapf(sdkerrors.ErrNotFound, "pool %d not found", poolId)
+		return
+	}
+	for _, coin := range amt {
+		if coin.Denom != pool.Denom0 && coin.Denom != pool.Denom1 {
+			err = sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "pool doesn't have denom %s", coin.Denom)
+			return
+		}
+	}
+	poolState := k.MustGetPoolState(ctx, pool.Id)
+	amt0, amt1 := amt.AmountOf(pool.Denom0), amt.AmountOf(pool.Denom1)
+
+	var shareAmt sdk.Int
+	if poolState.TotalShare.IsZero() {
+		if !amt0.IsPositive() || !amt1.IsPositive() {
+			err = sdkerrors.Wrap(
+				sdkerrors.ErrInvalidRequest, "the first deposit must contain both coins of the pool")
+			return
+		}
+		shareAmt = types.Invariant(amt0.ToDec(), amt1.ToDec(), pool.Amplification).TruncateInt()
+	} else {
+		shareAmt = shareForDeposit(poolState, amt0, amt1)
+		// Take only the amount matching the pool share to be minted.
+		amt0 = shareAmt.Mul(poolState.Reserve0).ToDec().QuoInt(poolState.TotalShare).Ceil().TruncateInt()
+		amt1 = shareAmt.Mul(poolState.Reserve1).ToDec().QuoInt(poolState.TotalShare).Ceil().TruncateInt()
+	}
+	if !shareAmt.IsPositive() {
+		err = sdkerrors.Wrap(types.ErrInsufficientAmount, "too small amount to deposit")
+		return
+	}
+	if shareAmt.LT(minShare) {
+		err = sdkerrors.Wrapf(types.ErrInsufficientShare, "%s is smaller than %s", shareAmt, minShare)
+		return
+	}
+
+	depositedAmt = sdk.NewCoins(sdk.NewCoin(pool.Denom0, amt0), sdk.NewCoin(pool.Denom1, amt1))
+	if err = k.bankKeeper.SendCoins(ctx, depositorAddr, pool.MustGetReserveAddress(), depositedAmt); err != nil {
+		return
+	}
+	share = sdk.NewCoin(pool.ShareDenom, shareAmt)
+	if err = k.bankKeeper.MintCoins(ctx, types.ModuleName, sdk.NewCoins(share)); err != nil {
+		return
+	}
+	if err = k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, depositorAddr, sdk.NewCoins(share)); err != nil {
+		return
+	}
+
+	poolState.Reserve0 = poolState.Reserve0.Add(amt0)
+	poolState.Reserve1 = poolState.Reserve1.Add(amt1)
+	poolState.TotalShare = poolState.TotalShare.Add(shareAmt)
+	k.SetPoolState(ctx, pool.Id, poolState)
+
+	if err = ctx.EventManager().EmitTypedEvent(&types.EventDeposit{
+		Depositor: depositorAddr.String(),
+		PoolId:    pool.Id,
+		Amount:    depositedAmt,
+		Share:     share,
+	}); err != nil {
+		return
+	}
+
+	return share, depositedAmt, nil
+}
+
+// shareForDeposit returns the pool share to be minted for the deposit amounts.
+// Reserves which are zero are ignored.
+func shareForDeposit(poolState types.PoolState, amt0, amt1 sdk.Int) sdk.Int {
+	var shareAmt *sdk.Int
+	for _, pair := range []struct{ amt, reserve sdk.Int }{
+		{amt0, poolState.Reserve0},
+		{amt1, poolState.Reserve1},
+	} {
+		if !pair.reserve.IsPositive() {
+			continue
+		}
+		s := pair.amt.Mul(poolState.TotalShare).Quo(pair.reserve)
+		if shareAmt == nil || s.LT(*shareAmt) {
+			shareAmt = &s
+		}
+	}
+	if shareAmt == nil {
+		return utils.ZeroInt
+	}
+	return *shareAmt
+}
+
+// Withdraw burns the pool share and withdraws the pool's reserves in
+// proportion to the share.
+func (k Keeper) Withdraw(
+	ctx sdk.Context, withdrawerAddr sdk.AccAddress, poolId uint64,
+	share sdk.Coin, minAmt sdk.Coins) (amt sdk.Coins, err error) {
+	pool, found := k.GetPool(ctx, poolId)
+	if !found {
+		err = sdkerrors.Wrapf(sdkerrors.ErrNotFound, "pool %d not found", poolId)
+		return
+	}
+	if share.Denom != pool.ShareDenom {
+		err = sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "share denom must be %s", pool.ShareDenom)
+		return
+	}
+	poolState := k.MustGetPoolState(ctx, pool.Id)
+	if share.Amount.GT(poolState.TotalShare) {
+		err = sdkerrors.Wrapf(
+			types.ErrInsufficientShare, "share %s is greater than the total share %s", share.Amount, poolState.TotalShare)
+		return
+	}
+
+	amt0 := poolState.Reserve0.Mul(share.Amount).Quo(poolState.TotalShare)
+	amt1 := poolState.Reserve1.Mul(share.Amount).Quo(poolState.TotalShare)
+	amt = sdk.NewCoins(sdk.NewCoin(pool.Denom0, amt0), sdk.NewCoin(pool.Denom1, amt1))
+	if !amt.IsAllGTE(minAmt) {
+		err = sdkerrors.Wrapf(types.ErrInsufficientAmount, "%s is smaller than %s", amt, minAmt)
+		return
+	}
+
+	if err = k.bankKeeper.SendCoinsFromAccountToModule(
+		ctx, withdrawerAddr, types.ModuleName, sdk.NewCoins(share)); err != nil {
+		return
+	}
+	if err = k.bankKeeper.BurnCoins(ctx, types.ModuleName, sdk.NewCoins(share)); err != nil {
+		return
+	}
+	if amt.IsAllPositive() {
+		if err = k.bankKeeper.SendCoins(ctx, pool.MustGetReserveAddress(), withdrawerAddr, amt); err != nil {
+			return
+		}
+	}
+
+	poolState.Reserve0 = poolState.Reserve0.Sub(amt0)
+	poolState.Reserve1 = poolState.Reserve1.Sub(amt1)
+	poolState.TotalShare = poolState.TotalShare.Sub(share.Amount)
+	k.SetPoolState(ctx, pool.Id, poolState)
+
+	if err = ctx.EventManager().EmitTypedEvent(&types.EventWithdraw{
+		Withdrawer: withdrawerAddr.String(),
+		PoolId:     pool.Id,
+		Share:      share,
+		Amount:     amt,
+	}); err != nil {
+		return
+	}
+
+	return amt, nil
+}
+
+// IteratePoolOrders iterates through the orders of the pool on the side.
+// An order is placed at each tick, which is a multiple of the pool's tick
+// spacing, with the quantity that moves the pool's marginal price from the
+// previous tick to the order's tick along the curve.
+// So the pool always trades at a price equal to or better than the curve.
+// Orders are not placed beyond the max order price ratio from the pool's
+// current price.
+func (k Keeper) IteratePoolOrders(
+	ctx sdk.Context, pool types.Pool, isBuy bool, cb func(price, qty, openQty sdk.Dec) (stop bool)) {
+	poolState := k.MustGetPoolState(ctx, pool.Id)
+	if poolState.IsEmpty() {
+		return
+	}
+	x, y := poolState.Reserve0.ToDec(), poolState.Reserve1.ToDec()
+	d := types.Invariant(x, y, pool.Amplification)
+	currentPrice := types.Price(x, y, d, pool.Amplification)
+	minPrice, maxPrice := exchangetypes.OrderPriceLimit(currentPrice, k.exchangeKeeper.GetMaxOrderPriceRatio(ctx))
+
+	// reserveOut is the remaining reserve the pool pays with.
+	var reserveOut sdk.Dec
+	if isBuy {
+		reserveOut = y
+	} else {
+		reserveOut = x
+	}
+	ts := int32(pool.TickSpacing)
+	q, _ := utils.DivMod(exchangetypes.TickAtPrice(currentPrice), ts)
+	tick := q * ts
+	if isBuy {
+		if exchangetypes.PriceAtTick(tick).GTE(currentPrice) {
+			tick -= ts
+		}
+	} else {
+		for exchangetypes.PriceAtTick(tick).LTE(currentPrice) {
+			tick += ts
+		}
+	}
+
+	qty := utils.ZeroDec // quantity accumulated but not yet placed
+	for {
+		price := exchangetypes.PriceAtTick(tick)
+		if (isBuy && price.LT(minPrice)) || (!isBuy && price.GT(maxPrice)) {
+			return
+		}
+		nextX := types.ReserveAtPrice(x, d, pool.Amplification, price)
+		if isBuy {
+			qty = qty.Add(nextX.Sub(x))
+		} else {
+			qty = qty.Add(x.Sub(nextX))
+		}
+		x = nextX
+		if qty.GTE(pool.MinOrderQuantity) && price.Mul(qty).GTE(pool.MinOrderQuote) {
+			var openQty sdk.Dec
+			if isBuy {
+				openQty = sdk.MinDec(qty, reserveOut.QuoTruncate(price))
+			} else {
+				openQty = sdk.MinDec(qty, reserveOut)
+			}
+			if !openQty.IsPositive() {
+				return
+			}
+			if cb(price, qty, openQty) {
+				return
+			}
+			reserveOut = reserveOut.Sub(exchangetypes.DepositAmount(isBuy, price, openQty))
+			if !reserveOut.IsPositive() {
+				return
+			}
+			qty = utils.ZeroDec
+		}
+		if isBuy {
+			tick -= ts
+		} else {
+			tick += ts
+		}
+	}
+}
@@ -0,0 +1,146 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	utils "github.com/crescent-network/crescent/v5/types"
+	"github.com/crescent-network/crescent/v5/x/stableswap/types"
+)
+
+func (s *KeeperTestSuite) TestCreatePool_WithoutMarket() {
+	creatorAddr := s.FundedAccount(1, enoughCoins)
+	_, err := s.keeper.CreatePool(s.Ctx, creatorAddr, 1)
+	s.Require().EqualError(err, "market not found: not found")
+}
+
+func (s *KeeperTestSuite) TestCreatePool_MultiplePoolsPerMarket() {
+	creatorAddr := s.FundedAccount(1, enoughCoins)
+	market, _ := s.CreateMarketAndPool("uusdc", "uusdt")
+	_, err := s.keeper.CreatePool(s.Ctx, creatorAddr, market.Id)
+	s.Require().EqualError(err, "cannot create more than one pool per market: invalid request")
+}
+
+func (s *KeeperTestSuite) TestCreatePool() {
+	market, pool := s.CreateMarketAndPool("uusdc", "uusdt")
+	s.Require().Equal("sspool1", pool.ShareDenom)
+	s.Require().EqualValues(100, pool.Amplification)
+	pool2, found := s.keeper.GetPoolByMarket(s.Ctx, market.Id)
+	s.Require().True(found)
+	s.Require().Equal(pool, pool2)
+	pool2, found = s.keeper.GetPoolByReserveAddress(s.Ctx, pool.MustGetReserveAddress())
+	s.Require().True(found)
+	s.Require().Equal(pool, pool2)
+	poolState, found := s.keeper.GetPoolState(s.Ctx, pool.Id)
+	s.Require().True(found)
+	s.Require().True(poolState.IsEmpty())
+	s.Require().True(poolState.TotalShare.IsZero())
+}
+
+func (s *KeeperTestSuite) TestDeposit_FirstDeposit() {
+	_, pool := s.CreateMarketAndPool("uusdc", "uusdt")
+	lpAddr := s.FundedAccount(1, enoughCoins)
+
+	_, _, err := s.keeper.Deposit(s.Ctx, lpAddr, pool.Id, utils.ParseCoins("1000_000000uusdc"), utils.ZeroInt)
+	s.Require().EqualError(err, "the first deposit must contain both coins of the pool: invalid request")
+
+	share, depositedAmt := s.Deposit(lpAddr, pool.Id, utils.ParseCoins("1000_000000uusdc,1000_000000uusdt"))
+	s.AssertEqual(utils.ParseCoin("2000_000000sspool1"), share)
+	s.AssertEqual(utils.ParseCoins("1000_000000uusdc,1000_000000uusdt"), depositedAmt)
+	s.AssertEqual(share, s.GetBalance(lpAddr, pool.ShareDenom))
+	s.AssertReserves(pool)
+}
+
+func (s *KeeperTestSuite) TestDeposit_Proportional() {
+	_, pool := s.CreateMarketAndPool("uusdc", "uusdt")
+	lpAddr1 := s.FundedAccount(1, enoughCoins)
+	lpAddr2 := s.FundedAccount(2, enoughCoins)
+
+	s.Deposit(lpAddr1, pool.Id, utils.ParseCoins("1000_000000uusdc,1000_000000uusdt"))
+	// Only the amount in proportion to the pool's reserves is taken.
+	share, depositedAmt := s.Deposit(lpAddr2, pool.Id, utils.ParseCoins("100_000000uusdc,300_000000uusdt"))
+	s.AssertEqual(utils.ParseCoin("200_000000sspool1"), share)
+	s.AssertEqual(utils.ParseCoins("100_000000uusdc,100_000000uusdt"), depositedAmt)
+	s.AssertReserves(pool)
+
+	poolState := s.keeper.MustGetPoolState(s.Ctx, pool.Id)
+	s.AssertEqual(sdk.NewInt(2200_000000), poolState.TotalShare)
+
+	_, _, err := s.keeper.Deposit(
+		s.Ctx, lpAddr2, pool.Id, utils.ParseCoins("100_000000uusdc,100_000000uusdt"), sdk.NewInt(200_000001))
+	s.Require().EqualError(err, "200000000 is smaller than 200000001: insufficient pool share")
+
+	_, _, err = s.keeper.Deposit(s.Ctx, lpAddr2, pool.Id, utils.ParseCoins("100_000000uatom"), utils.ZeroInt)
+	s.Require().EqualError(err, "pool doesn't have denom uatom: invalid request")
+}
+
+func (s *KeeperTestSuite) TestWithdraw() {
+	_, pool := s.CreateMarketAndPool("uusdc", "uusdt")
+	lpAddr := s.FundedAccount(1, enoughCoins)
+
+	share, _ := s.Deposit(lpAddr, pool.Id, utils.ParseCoins("1000_000000uusdc,1000_000000uusdt"))
+
+	_, err := s.keeper.Withdraw(
+		s.Ctx, lpAddr, pool.Id, utils.ParseCoin("500_000000sspool1"), utils.ParseCoins("250_000001uusdc"))
+	s.Require().EqualError(
+		err, "250000000uusdc,250000000uusdt is smaller than 250000001uusdc: insufficient amount")
+
+	amt := s.Withdraw(lpAddr, pool.Id, utils.ParseCoin("500_000000sspool1"))
+	s.AssertEqual(utils.ParseCoins("250_000000uusdc,250_000000uusdt"), amt)
+	s.AssertReserves(pool)
+
+	amt = s.Withdraw(lpAddr, pool.Id, share.SubAmount(sdk.NewInt(500_000000)))
+	s.AssertEqual(utils.ParseCoins("750_000000uusdc,750_000000uusdt"), amt)
+	s.AssertReserves(pool)
+	poolState := s.keeper.MustGetPoolState(s.Ctx, pool.Id)
+	s.Require().True(poolState.IsEmpty())
+	s.Require().True(poolState.TotalShare.IsZero())
+	s.Require().True(s.GetBalance(lpAddr, pool.ShareDenom).IsZero())
+}
+
+func (s *KeeperTestSuite) TestPoolOrders() {
+	_, pool := s.CreateMarketAndPool("uusdc", "uusdt")
+	lpAddr := s.FundedAccount(1, enoughCoins)
+	s.Deposit(lpAddr, pool.Id, utils.ParseCoins("1000_000000uusdc,1000_000000uusdt"))
+
+	for _, isBuy := range []bool{true, false} {
+		var prevPrice *sdk.Dec
+		numOrders := 0
+		s.keeper.IteratePoolOrders(s.Ctx, pool, isBuy, func(price, qty, openQty sdk.Dec) (stop bool) {
+			if isBuy {
+				s.Require().True(price.LT(utils.OneDec))
+			} else {
+				s.Require().True(price.GT(utils.OneDec))
+			}
+			if prevPrice != nil {
+				if isBuy {
+					s.Require().True(price.LT(*prevPrice))
+				} else {
+					s.Require().True(price.GT(*prevPrice))
+				}
+			}
+			s.Require().True(qty.GTE(pool.MinOrderQuantity))
+			s.Require().True(openQty.LTE(qty))
+			prevPrice = &price
+			numOrders++
+			return numOrders >= 3
+		})
+		s.Require().Equal(3, numOrders)
+	}
+
+	// The pool places its best orders right next to the current price, and
+	// the amplified curve puts most of the liquidity there.
+	var bestBuyPrice, bestBuyQty sdk.Dec
+	s.keeper.IteratePoolOrders(s.Ctx, pool, true, func(price, qty, openQty sdk.Dec) (stop bool) {
+		bestBuyPrice, bestBuyQty = price, qty
+		return true
+	})
+	s.AssertEqual(utils.ParseDec("0.99999"), bestBuyPrice)
+	s.Require().True(bestBuyQty.GT(sdk.NewDec(1_000000)))
+}
+
+func (s *KeeperTestSuite) TestPoolShareDenom() {
+	_, pool := s.CreateMarketAndPool("uusdc", "uusdt")
+	poolId, err := types.ParseShareDenom(pool.ShareDenom)
+	s.Require().NoError(err)
+	s.Require().Equal(pool.Id, poolId)
+}
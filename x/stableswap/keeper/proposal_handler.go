@@ -0,0 +1,40 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/crescent-network/crescent/v5/x/stableswap/types"
+)
+
+func HandlePoolParameterChangeProposal(ctx sdk.Context, k Keeper, p *types.PoolParameterChangeProposal) error {
+	for _, change := range p.Changes {
+		pool, found := k.GetPool(ctx, change.PoolId)
+		if !found {
+			return sdkerrors.Wrapf(sdkerrors.ErrNotFound, "pool %d not found", change.PoolId)
+		}
+		if change.Amplification != 0 {
+			pool.Amplification = change.Amplification
+		}
+		if change.TickSpacing != 0 {
+			pool.TickSpacing = change.TickSpacing
+		}
+		if change.MinOrderQuantity != nil {
+			pool.MinOrderQuantity = *change.MinOrderQuantity
+		}
+		if change.MinOrderQuote != nil {
+			pool.MinOrderQuote = *change.MinOrderQuote
+		}
+		k.SetPool(ctx, pool)
+		if err := ctx.EventManager().EmitTypedEvent(&types.EventPoolParameterChanged{
+			PoolId:           change.PoolId,
+			Amplification:    change.Amplification,
+			TickSpacing:      change.TickSpacing,
+			MinOrderQuantity: change.MinOrderQuantity,
+			MinOrderQuote:    change.MinOrderQuote,
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,30 @@
+package keeper_test
+
+import (
+	utils "github.com/crescent-network/crescent/v5/types"
+	"github.com/crescent-network/crescent/v5/x/stableswap/keeper"
+	"github.com/crescent-network/crescent/v5/x/stableswap/types"
+)
+
+func (s *KeeperTestSuite) TestPoolParameterChangeProposal() {
+	_, pool := s.CreateMarketAndPool("uusdc", "uusdt")
+
+	minOrderQty := utils.ParseDec("100")
+	proposal := types.NewPoolParameterChangeProposal("Title", "Description", []types.PoolParameterChange{
+		types.NewPoolParameterChange(pool.Id, 500, 5, &minOrderQty, nil),
+	})
+	s.Require().NoError(proposal.ValidateBasic())
+	s.Require().NoError(keeper.HandlePoolParameterChangeProposal(s.Ctx, s.keeper, proposal))
+
+	pool = s.keeper.MustGetPool(s.Ctx, pool.Id)
+	s.Require().EqualValues(500, pool.Amplification)
+	s.Require().EqualValues(5, pool.TickSpacing)
+	s.AssertEqual(minOrderQty, pool.MinOrderQuantity)
+	s.AssertEqual(s.keeper.GetDefaultMinOrderQuote(s.Ctx), pool.MinOrderQuote)
+
+	proposal = types.NewPoolParameterChangeProposal("Title", "Description", []types.PoolParameterChange{
+		types.NewPoolParameterChange(2, 500, 0, nil, nil),
+	})
+	s.Require().EqualError(
+		keeper.HandlePoolParameterChangeProposal(s.Ctx, s.keeper, proposal), "pool 2 not found: not found")
+}
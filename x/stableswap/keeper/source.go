@@ -0,0 +1,104 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	utils "github.com/crescent-network/crescent/v5/types"
+	exchangetypes "github.com/crescent-network/crescent/v5/x/exchange/types"
+	"github.com/crescent-network/crescent/v5/x/stableswap/types"
+)
+
+var _ exchangetypes.OrderSource = OrderSource{}
+
+type OrderSource struct {
+	Keeper
+}
+
+func NewOrderSource(k Keeper) OrderSource {
+	return OrderSource{k}
+}
+
+func (k OrderSource) Name() string {
+	return types.ModuleName
+}
+
+func (k OrderSource) ConstructMemOrderBookSide(
+	ctx sdk.Context, market exchangetypes.Market,
+	createOrder exchangetypes.CreateOrderFunc,
+	opts exchangetypes.MemOrderBookSideOptions) error {
+	if !market.IsActive() {
+		return nil // pools don't provide liquidity to inactive markets
+	}
+	pool, found := k.GetPoolByMarket(ctx, market.Id)
+	if !found {
+		return nil // no pool found
+	}
+
+	reserveAddr := pool.MustGetReserveAddress()
+	accQty := utils.ZeroDec
+	accQuote := utils.ZeroDec
+	numPriceLevels := 0
+	k.IteratePoolOrders(ctx, pool, opts.IsBuy, func(price, qty, openQty sdk.Dec) (stop bool) {
+		if opts.ReachedLimit(price, accQty, accQuote, numPriceLevels) {
+			return true
+		}
+		createOrder(reserveAddr, price, qty, openQty)
+		accQty = accQty.Add(qty)
+		accQuote = accQuote.Add(exchangetypes.QuoteAmount(!opts.IsBuy, price, qty))
+		numPriceLevels++
+		return false
+	})
+	return nil
+}
+
+func (k OrderSource) AfterOrdersExecuted(
+	ctx sdk.Context, _ exchangetypes.Market, ordererAddr sdk.AccAddress, results []*exchangetypes.MemOrder) error {
+	pool := k.MustGetPoolByReserveAddress(ctx, ordererAddr)
+	return k.AfterPoolOrdersExecuted(ctx, pool, results)
+}
+
+// AfterPoolOrdersExecuted applies the pool orders' execution results to the
+// pool's reserves.
+// The coins have already been transferred from and to the pool's reserve
+// address through the exchange's escrow, which nets the amounts of all orders
+// of an orderer and rounds the amount to pay up and the amount to receive
+// down.
+// The same rounding is applied here so that the reserves in the pool state
+// always match the coins actually held by the pool.
+func (k Keeper) AfterPoolOrdersExecuted(ctx sdk.Context, pool types.Pool, results []*exchangetypes.MemOrder) error {
+	reserveAddr := pool.MustGetReserveAddress()
+	delta0, delta1 := utils.ZeroDec, utils.ZeroDec
+	for _, result := range results {
+		if !result.OrdererAddress().Equals(reserveAddr) {
+			continue
+		}
+		// The deposit of an order source order is locked in full and the
+		// remaining deposit is refunded after matching.
+		paid := exchangetypes.DepositAmount(result.IsBuy(), result.Price(), result.OpenQuantity()).
+			Sub(result.RemainingDeposit())
+		if result.IsBuy() {
+			delta0 = delta0.Add(result.Received())
+			delta1 = delta1.Sub(paid)
+		} else {
+			delta0 = delta0.Sub(paid)
+			delta1 = delta1.Add(result.Received())
+		}
+	}
+	poolState := k.MustGetPoolState(ctx, pool.Id)
+	poolState.Reserve0 = poolState.Reserve0.Add(roundReserveDelta(delta0))
+	poolState.Reserve1 = poolState.Reserve1.Add(roundReserveDelta(delta1))
+	if poolState.Reserve0.IsNegative() || poolState.Reserve1.IsNegative() { // sanity check
+		panic("negative pool reserve")
+	}
+	k.SetPoolState(ctx, pool.Id, poolState)
+	return nil
+}
+
+// roundReserveDelta rounds the reserve delta in the same way as the
+// exchange's escrow.
+func roundReserveDelta(delta sdk.Dec) sdk.Int {
+	if delta.IsNegative() {
+		return delta.Neg().Ceil().TruncateInt().Neg()
+	}
+	return delta.TruncateInt()
+}
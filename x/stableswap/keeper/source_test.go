@@ -0,0 +1,104 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	utils "github.com/crescent-network/crescent/v5/types"
+	exchangetypes "github.com/crescent-network/crescent/v5/x/exchange/types"
+	"github.com/crescent-network/crescent/v5/x/stableswap/keeper"
+	"github.com/crescent-network/crescent/v5/x/stableswap/types"
+)
+
+func (s *KeeperTestSuite) poolInvariant(pool types.Pool) sdk.Dec {
+	poolState := s.keeper.MustGetPoolState(s.Ctx, pool.Id)
+	return poolState.Invariant(pool.Amplification)
+}
+
+func (s *KeeperTestSuite) TestOrderSource_LimitOrder() {
+	market, pool := s.CreateMarketAndPool("uusdc", "uusdt")
+	lpAddr := s.FundedAccount(1, enoughCoins)
+	s.Deposit(lpAddr, pool.Id, utils.ParseCoins("1000000_000000uusdc,1000000_000000uusdt"))
+	invariantBefore := s.poolInvariant(pool)
+
+	ordererAddr := s.FundedAccount(2, enoughCoins)
+	_, _, res := s.PlaceLimitOrder(
+		market.Id, ordererAddr, false, utils.ParseDec("0.998"), sdk.NewDec(10000_000000), time.Hour)
+	s.AssertEqual(sdk.NewDec(10000_000000), res.ExecutedQuantity)
+	// The amplified curve keeps the price close to 1.
+	s.Require().True(res.ExecutedQuote.GT(sdk.NewDec(9990_000000)))
+	s.Require().True(res.ExecutedQuote.LT(sdk.NewDec(10000_000000)))
+
+	s.AssertReserves(pool)
+	poolState := s.keeper.MustGetPoolState(s.Ctx, pool.Id)
+	s.AssertEqual(sdk.NewInt(1010000_000000), poolState.Reserve0)
+	// The pool never trades at a price worse than the curve.
+	s.Require().True(s.poolInvariant(pool).GTE(invariantBefore))
+
+	price := poolState.Price(pool.Amplification)
+	s.Require().True(price.LT(utils.OneDec))
+	s.Require().True(price.GT(utils.ParseDec("0.999")))
+}
+
+func (s *KeeperTestSuite) TestOrderSource_BatchMatching() {
+	market, pool := s.CreateMarketAndPool("uusdc", "uusdt")
+
+	ordererAddr := s.FundedAccount(1, enoughCoins)
+	// The order rests in the order book since the pool has no liquidity yet.
+	_, order, res := s.PlaceLimitOrder(
+		market.Id, ordererAddr, true, utils.ParseDec("1.0005"), sdk.NewDec(1000_000000), time.Hour)
+	s.AssertEqual(utils.ZeroDec, res.ExecutedQuantity)
+
+	lpAddr := s.FundedAccount(2, enoughCoins)
+	s.Deposit(lpAddr, pool.Id, utils.ParseCoins("100000_000000uusdc,100000_000000uusdt"))
+	invariantBefore := s.poolInvariant(pool)
+
+	s.NextBlock() // Run batch matching
+
+	// The order has been fully executed and deleted.
+	_, found := s.App.ExchangeKeeper.GetOrder(s.Ctx, order.Id)
+	s.Require().False(found)
+	s.AssertReserves(pool)
+	s.Require().True(s.poolInvariant(pool).GTE(invariantBefore))
+
+	// LPs receive more than they deposited in value.
+	share := s.GetBalance(lpAddr, pool.ShareDenom)
+	amt := s.Withdraw(lpAddr, pool.Id, share)
+	s.Require().True(amt.AmountOf("uusdc").Add(amt.AmountOf("uusdt")).GTE(sdk.NewInt(200000_000000)))
+	s.AssertReserves(pool)
+}
+
+func (s *KeeperTestSuite) TestConstructMemOrderBookSide() {
+	market, pool := s.CreateMarketAndPool("uusdc", "uusdt")
+	lpAddr := s.FundedAccount(1, enoughCoins)
+	s.Deposit(lpAddr, pool.Id, utils.ParseCoins("1000_000000uusdc,1000_000000uusdt"))
+
+	source := keeper.NewOrderSource(s.keeper)
+	s.Require().Equal(types.ModuleName, source.Name())
+
+	construct := func(market exchangetypes.Market, opts exchangetypes.MemOrderBookSideOptions) (numOrders int) {
+		s.Require().NoError(source.ConstructMemOrderBookSide(
+			s.Ctx, market, func(ordererAddr sdk.AccAddress, price, qty, openQty sdk.Dec) {
+				s.Require().Equal(pool.MustGetReserveAddress(), ordererAddr)
+				numOrders++
+			}, opts))
+		return numOrders
+	}
+
+	s.Require().Equal(5, construct(market, exchangetypes.MemOrderBookSideOptions{
+		IsBuy: true, MaxNumPriceLevels: 5,
+	}))
+	priceLimit := utils.ParseDec("1.0001")
+	numOrders := construct(market, exchangetypes.MemOrderBookSideOptions{
+		IsBuy: false, PriceLimit: &priceLimit,
+	})
+	s.Require().Positive(numOrders)
+	s.Require().LessOrEqual(numOrders, 10)
+
+	// Pools don't provide liquidity to inactive markets.
+	market.Status = exchangetypes.MarketStatusHalted
+	s.Require().Zero(construct(market, exchangetypes.MemOrderBookSideOptions{
+		IsBuy: true, MaxNumPriceLevels: 5,
+	}))
+}
@@ -0,0 +1,158 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/crescent-network/crescent/v5/x/stableswap/types"
+)
+
+func (k Keeper) GetLastPoolId(ctx sdk.Context) (poolId uint64) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.LastPoolIdKey)
+	if bz == nil {
+		return 0
+	}
+	return sdk.BigEndianToUint64(bz)
+}
+
+func (k Keeper) SetLastPoolId(ctx sdk.Context, poolId uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.LastPoolIdKey, sdk.Uint64ToBigEndian(poolId))
+}
+
+func (k Keeper) GetNextPoolIdWithUpdate(ctx sdk.Context) (poolId uint64) {
+	poolId = k.GetLastPoolId(ctx)
+	poolId++
+	k.SetLastPoolId(ctx, poolId)
+	return poolId
+}
+
+func (k Keeper) DeleteLastPoolId(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.LastPoolIdKey)
+}
+
+func (k Keeper) GetPool(ctx sdk.Context, poolId uint64) (pool types.Pool, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetPoolKey(poolId))
+	if bz == nil {
+		return
+	}
+	k.cdc.MustUnmarshal(bz, &pool)
+	return pool, true
+}
+
+func (k Keeper) MustGetPool(ctx sdk.Context, poolId uint64) (pool types.Pool) {
+	pool, found := k.GetPool(ctx, poolId)
+	if !found {
+		panic("pool not found")
+	}
+	return pool
+}
+
+func (k Keeper) LookupPool(ctx sdk.Context, poolId uint64) (found bool) {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.GetPoolKey(poolId))
+}
+
+func (k Keeper) SetPool(ctx sdk.Context, pool types.Pool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&pool)
+	store.Set(types.GetPoolKey(pool.Id), bz)
+}
+
+func (k Keeper) GetPoolByMarket(ctx sdk.Context, marketId uint64) (pool types.Pool, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetPoolByMarketIndexKey(marketId))
+	if bz == nil {
+		return
+	}
+	return k.GetPool(ctx, sdk.BigEndianToUint64(bz))
+}
+
+func (k Keeper) LookupPoolByMarket(ctx sdk.Context, marketId uint64) (found bool) {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.GetPoolByMarketIndexKey(marketId))
+}
+
+func (k Keeper) SetPoolByMarketIndex(ctx sdk.Context, pool types.Pool) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetPoolByMarketIndexKey(pool.MarketId), sdk.Uint64ToBigEndian(pool.Id))
+}
+
+func (k Keeper) GetPoolByReserveAddress(ctx sdk.Context, reserveAddr sdk.AccAddress) (pool types.Pool, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetPoolByReserveAddressIndexKey(reserveAddr))
+	if bz == nil {
+		return
+	}
+	return k.GetPool(ctx, sdk.BigEndianToUint64(bz))
+}
+
+func (k Keeper) MustGetPoolByReserveAddress(ctx sdk.Context, reserveAddr sdk.AccAddress) (pool types.Pool) {
+	pool, found := k.GetPoolByReserveAddress(ctx, reserveAddr)
+	if !found {
+		panic("pool not found")
+	}
+	return pool
+}
+
+func (k Keeper) DeletePool(ctx sdk.Context, pool types.Pool) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetPoolKey(pool.Id))
+}
+
+func (k Keeper) SetPoolByReserveAddressIndex(ctx sdk.Context, pool types.Pool) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(
+		types.GetPoolByReserveAddressIndexKey(pool.MustGetReserveAddress()),
+		sdk.Uint64ToBigEndian(pool.Id))
+}
+
+func (k Keeper) DeletePoolByReserveAddressIndex(ctx sdk.Context, pool types.Pool) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(
+		types.GetPoolByReserveAddressIndexKey(pool.MustGetReserveAddress()))
+}
+
+func (k Keeper) IterateAllPools(ctx sdk.Context, cb func(pool types.Pool) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.PoolKeyPrefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var pool types.Pool
+		k.cdc.MustUnmarshal(iter.Value(), &pool)
+		if cb(pool) {
+			break
+		}
+	}
+}
+
+func (k Keeper) GetPoolState(ctx sdk.Context, poolId uint64) (state types.PoolState, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetPoolStateKey(poolId))
+	if bz == nil {
+		return
+	}
+	k.cdc.MustUnmarshal(bz, &state)
+	return state, true
+}
+
+func (k Keeper) MustGetPoolState(ctx sdk.Context, poolId uint64) types.PoolState {
+	state, found := k.GetPoolState(ctx, poolId)
+	if !found {
+		panic("pool state not found")
+	}
+	return state
+}
+
+func (k Keeper) SetPoolState(ctx sdk.Context, poolId uint64, state types.PoolState) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&state)
+	store.Set(types.GetPoolStateKey(poolId), bz)
+}
+
+func (k Keeper) DeletePoolState(ctx sdk.Context, poolId uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetPoolStateKey(poolId))
+}
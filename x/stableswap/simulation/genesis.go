@@ -0,0 +1,34 @@
+package simulation
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/rand"
+
+	"github.com/cosmos/cosmos-sdk/types/module"
+
+	"github.com/crescent-network/crescent/v5/x/stableswap/types"
+)
+
+// Simulation parameter constants
+const (
+	DefaultAmplification = "default_amplification"
+)
+
+func GenDefaultAmplification(r *rand.Rand) uint64 {
+	return 1 + uint64(r.Intn(1000))
+}
+
+// RandomizedGenState generates a random GenesisState for the module.
+func RandomizedGenState(simState *module.SimulationState) {
+	genesis := types.DefaultGenesis()
+
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, DefaultAmplification, &genesis.Params.DefaultAmplification, simState.Rand,
+		func(r *rand.Rand) { genesis.Params.DefaultAmplification = GenDefaultAmplification(r) },
+	)
+
+	bz, _ := json.MarshalIndent(genesis, "", " ")
+	fmt.Printf("Selected randomly generated %s parameters:\n%s\n", types.ModuleName, bz)
+	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(genesis)
+}
@@ -0,0 +1,48 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
+	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/msgservice"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+// RegisterLegacyAminoCodec registers the necessary x/stableswap interfaces and concrete types
+// on the provided LegacyAmino codec. These types are used for Amino JSON serialization.
+func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
+	cdc.RegisterConcrete(&MsgCreatePool{}, "stableswap/MsgCreatePool", nil)
+	cdc.RegisterConcrete(&MsgDeposit{}, "stableswap/MsgDeposit", nil)
+	cdc.RegisterConcrete(&MsgWithdraw{}, "stableswap/MsgWithdraw", nil)
+	cdc.RegisterConcrete(&PoolParameterChangeProposal{}, "stableswap/PoolParameterChangeProposal", nil)
+}
+
+// RegisterInterfaces registers the x/stableswap interfaces types with the
+// interface registry.
+func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
+	registry.RegisterImplementations(
+		(*sdk.Msg)(nil),
+		&MsgCreatePool{},
+		&MsgDeposit{},
+		&MsgWithdraw{},
+	)
+	registry.RegisterImplementations(
+		(*govtypes.Content)(nil),
+		&PoolParameterChangeProposal{},
+	)
+
+	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
+}
+
+var (
+	amino = codec.NewLegacyAmino()
+
+	ModuleCdc = codec.NewAminoCodec(amino)
+)
+
+func init() {
+	RegisterLegacyAminoCodec(amino)
+	cryptocodec.RegisterCrypto(amino)
+	amino.Seal()
+}
@@ -0,0 +1,10 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var (
+	ErrInsufficientShare  = sdkerrors.Register(ModuleName, 2, "insufficient pool share")
+	ErrInsufficientAmount = sdkerrors.Register(ModuleName, 3, "insufficient amount")
+)
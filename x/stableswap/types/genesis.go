@@ -0,0 +1,53 @@
+package types
+
+import (
+	"fmt"
+)
+
+func NewGenesisState(params Params, lastPoolId uint64, poolRecords []PoolRecord) *GenesisState {
+	return &GenesisState{
+		Params:      params,
+		LastPoolId:  lastPoolId,
+		PoolRecords: poolRecords,
+	}
+}
+
+// DefaultGenesis returns the default genesis state for the module.
+func DefaultGenesis() *GenesisState {
+	return NewGenesisState(DefaultParams(), 0, nil)
+}
+
+func (genState GenesisState) Validate() error {
+	if err := genState.Params.Validate(); err != nil {
+		return err
+	}
+	poolIdSet := map[uint64]struct{}{}
+	marketIdSet := map[uint64]struct{}{}
+	for _, poolRecord := range genState.PoolRecords {
+		if err := poolRecord.Validate(); err != nil {
+			return fmt.Errorf("invalid pool record: %w", err)
+		}
+		if poolRecord.Pool.Id > genState.LastPoolId {
+			return fmt.Errorf("pool id is greater than the last pool id: %d", poolRecord.Pool.Id)
+		}
+		if _, ok := poolIdSet[poolRecord.Pool.Id]; ok {
+			return fmt.Errorf("duplicate pool id: %d", poolRecord.Pool.Id)
+		}
+		poolIdSet[poolRecord.Pool.Id] = struct{}{}
+		if _, ok := marketIdSet[poolRecord.Pool.MarketId]; ok {
+			return fmt.Errorf("multiple pools for market %d", poolRecord.Pool.MarketId)
+		}
+		marketIdSet[poolRecord.Pool.MarketId] = struct{}{}
+	}
+	return nil
+}
+
+func (record PoolRecord) Validate() error {
+	if err := record.Pool.Validate(); err != nil {
+		return fmt.Errorf("invalid pool: %w", err)
+	}
+	if err := record.State.Validate(); err != nil {
+		return fmt.Errorf("invalid pool state: %w", err)
+	}
+	return nil
+}
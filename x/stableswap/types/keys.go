@@ -0,0 +1,45 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	utils "github.com/crescent-network/crescent/v5/types"
+)
+
+const (
+	// ModuleName defines the module name
+	ModuleName = "stableswap"
+
+	// StoreKey defines the primary module store key
+	StoreKey = ModuleName
+
+	// RouterKey is the message route for slashing
+	RouterKey = ModuleName
+
+	// QuerierRoute defines the module's query routing key
+	QuerierRoute = ModuleName
+)
+
+var (
+	LastPoolIdKey                      = []byte{0x50}
+	PoolKeyPrefix                      = []byte{0x51} // poolId => Pool
+	PoolStateKeyPrefix                 = []byte{0x52} // poolId => PoolState
+	PoolByReserveAddressIndexKeyPrefix = []byte{0x53} // reserveAddress => poolId
+	PoolByMarketIndexKeyPrefix         = []byte{0x54} // marketId => poolId
+)
+
+func GetPoolKey(poolId uint64) []byte {
+	return utils.Key(PoolKeyPrefix, sdk.Uint64ToBigEndian(poolId))
+}
+
+func GetPoolStateKey(poolId uint64) []byte {
+	return utils.Key(PoolStateKeyPrefix, sdk.Uint64ToBigEndian(poolId))
+}
+
+func GetPoolByReserveAddressIndexKey(reserveAddr sdk.AccAddress) []byte {
+	return utils.Key(PoolByReserveAddressIndexKeyPrefix, reserveAddr)
+}
+
+func GetPoolByMarketIndexKey(marketId uint64) []byte {
+	return utils.Key(PoolByMarketIndexKeyPrefix, sdk.Uint64ToBigEndian(marketId))
+}
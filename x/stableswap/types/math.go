@@ -0,0 +1,129 @@
+package types
+
+import (
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	utils "github.com/crescent-network/crescent/v5/types"
+)
+
+// The pool follows the StableSwap invariant for two coins:
+//
+//	4A(x + y) + D = 4AD + D^3/(4xy)
+//
+// where x and y are the reserves of denom 0 and denom 1 and A is the
+// amplification coefficient of the pool.
+
+const maxNumIterations = 255
+
+var (
+	// invariantTolerance is the maximum difference between two consecutive
+	// approximations of the invariant for the calculation to be considered as
+	// converged.
+	invariantTolerance = sdk.NewDecWithPrec(1, 12)
+	// reserveTolerance is the maximum relative error of the reserve found by
+	// ReserveAtPrice.
+	reserveTolerance = sdk.NewDecWithPrec(1, 9)
+	// precisionMultiplier is used to calculate the square root of sdk.Dec.
+	precisionMultiplier = new(big.Int).Exp(big.NewInt(10), big.NewInt(sdk.Precision), nil)
+)
+
+func ann(amp uint64) sdk.Dec {
+	return sdk.NewDecFromInt(sdk.NewIntFromUint64(amp).MulRaw(4))
+}
+
+// decSqrt returns the square root of x truncated to sdk.Precision.
+func decSqrt(x sdk.Dec) sdk.Dec {
+	i := new(big.Int).Mul(x.BigInt(), precisionMultiplier)
+	return sdk.NewDecFromBigIntWithPrec(i.Sqrt(i), sdk.Precision)
+}
+
+// Invariant returns the invariant D of the pool with reserves x and y.
+// x and y must be positive.
+func Invariant(x, y sdk.Dec, amp uint64) sdk.Dec {
+	a := ann(amp)
+	s := x.Add(y)
+	d := s
+	for i := 0; i < maxNumIterations; i++ {
+		// dP = D^3/(4xy)
+		dP := d.Quo(x.MulInt64(2)).Mul(d).Quo(y.MulInt64(2)).Mul(d)
+		prevD := d
+		// D = (4A*S + 2*dP)*D / ((4A - 1)*D + 3*dP)
+		d = a.Mul(s).Add(dP.MulInt64(2)).Mul(d).Quo(a.Sub(utils.OneDec).Mul(d).Add(dP.MulInt64(3)))
+		if d.Sub(prevD).Abs().LTE(invariantTolerance) {
+			break
+		}
+	}
+	return d
+}
+
+// ReserveOut returns the other reserve of the pool when one reserve is x and
+// the invariant is d.
+// It solves y^2 + (x + D/4A - D)y - D^3/(16Ax) = 0 for y.
+// x must be positive.
+func ReserveOut(x, d sdk.Dec, amp uint64) sdk.Dec {
+	a := ann(amp)
+	b := x.Add(d.Quo(a)).Sub(d)
+	c := d.Quo(x.MulInt64(4)).Mul(d).Quo(a).Mul(d)
+	disc := decSqrt(b.Mul(b).Add(c.MulInt64(4)))
+	if b.IsNegative() {
+		return disc.Sub(b).QuoInt64(2)
+	}
+	// Avoid catastrophic cancellation when b is positive.
+	return c.MulInt64(2).Quo(b.Add(disc))
+}
+
+// Price returns the marginal price of denom 0 in denom 1 of the pool with
+// reserves x and y and the invariant d.
+func Price(x, y, d sdk.Dec, amp uint64) sdk.Dec {
+	a := ann(amp)
+	// k = D^3/(4xy)
+	k := d.Quo(x.MulInt64(2)).Mul(d).Quo(y.MulInt64(2)).Mul(d)
+	return a.Add(k.Quo(x)).Quo(a.Add(k.Quo(y)))
+}
+
+// ReserveAtPrice returns the reserve of denom 0 at which the marginal price
+// of the pool with the invariant d becomes price, starting from the reserve x.
+// The result is always on the pool's side of the price: the marginal price
+// at the returned reserve is equal or lower than price when price is higher
+// than the current price, and is equal or higher than price otherwise.
+func ReserveAtPrice(x, d sdk.Dec, amp uint64, price sdk.Dec) sdk.Dec {
+	priceAt := func(x sdk.Dec) sdk.Dec {
+		return Price(x, ReserveOut(x, d, amp), d, amp)
+	}
+	// The marginal price decreases as x increases.
+	// Find lo and hi so that priceAt(lo) > price >= priceAt(hi).
+	isBuy := priceAt(x).GT(price)
+	lo, hi := x, x
+	for i := 0; i < maxNumIterations; i++ {
+		if isBuy {
+			hi = hi.MulInt64(2)
+			if priceAt(hi).LTE(price) {
+				break
+			}
+			lo = hi
+		} else {
+			lo = lo.QuoInt64(2)
+			if priceAt(lo).GT(price) {
+				break
+			}
+			hi = lo
+		}
+	}
+	for i := 0; i < maxNumIterations; i++ {
+		if hi.Sub(lo).LTE(hi.Mul(reserveTolerance)) {
+			break
+		}
+		mid := lo.Add(hi).QuoInt64(2)
+		if priceAt(mid).GT(price) {
+			lo = mid
+		} else {
+			hi = mid
+		}
+	}
+	if isBuy {
+		return lo
+	}
+	return hi
+}
@@ -0,0 +1,87 @@
+package types_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	utils "github.com/crescent-network/crescent/v5/types"
+	"github.com/crescent-network/crescent/v5/x/stableswap/types"
+)
+
+func TestInvariant(t *testing.T) {
+	for i, tc := range []struct {
+		x, y sdk.Dec
+		amp  uint64
+	}{
+		{sdk.NewDec(1000_000000), sdk.NewDec(1000_000000), 100},
+		{sdk.NewDec(1500_000000), sdk.NewDec(500_000000), 100},
+		{sdk.NewDec(1_000000), sdk.NewDec(999_000000), 10},
+		{sdk.NewDec(1000_000000), sdk.NewDec(1_000000), 1000},
+		{sdk.NewDec(123456789_000000), sdk.NewDec(987654321_000000), 2000},
+	} {
+		t.Run(fmt.Sprint(i), func(t *testing.T) {
+			d := types.Invariant(tc.x, tc.y, tc.amp)
+			// The invariant of a balanced pool equals to the sum of reserves.
+			if tc.x.Equal(tc.y) {
+				require.Equal(t, tc.x.Add(tc.y), d)
+			}
+			require.True(t, d.LTE(tc.x.Add(tc.y)))
+			// The other reserve can be recovered from the invariant.
+			require.True(t, utils.DecApproxEqual(tc.y, types.ReserveOut(tc.x, d, tc.amp)))
+			require.True(t, utils.DecApproxEqual(tc.x, types.ReserveOut(tc.y, d, tc.amp)))
+		})
+	}
+}
+
+func TestPrice(t *testing.T) {
+	x, y := sdk.NewDec(1000_000000), sdk.NewDec(1000_000000)
+	d := types.Invariant(x, y, 100)
+	require.Equal(t, utils.OneDec, types.Price(x, y, d, 100))
+
+	// The price of denom 0 rises as the reserve of denom 0 decreases.
+	x2 := sdk.NewDec(500_000000)
+	y2 := types.ReserveOut(x2, d, 100)
+	p := types.Price(x2, y2, d, 100)
+	require.True(t, p.GT(utils.OneDec))
+	// With a higher amplification, the price moves less.
+	d1000 := types.Invariant(x, y, 1000)
+	p1000 := types.Price(x2, types.ReserveOut(x2, d1000, 1000), d1000, 1000)
+	require.True(t, p1000.GT(utils.OneDec))
+	require.True(t, p1000.LT(p))
+}
+
+func TestReserveAtPrice(t *testing.T) {
+	x, y := sdk.NewDec(1000_000000), sdk.NewDec(1000_000000)
+	amp := uint64(100)
+	d := types.Invariant(x, y, amp)
+	priceAt := func(x sdk.Dec) sdk.Dec {
+		return types.Price(x, types.ReserveOut(x, d, amp), d, amp)
+	}
+	for i, tc := range []struct {
+		price sdk.Dec
+	}{
+		{utils.ParseDec("1.0001")},
+		{utils.ParseDec("1.01")},
+		{utils.ParseDec("1.5")},
+		{utils.ParseDec("0.9999")},
+		{utils.ParseDec("0.99")},
+		{utils.ParseDec("0.5")},
+	} {
+		t.Run(fmt.Sprint(i), func(t *testing.T) {
+			x2 := types.ReserveAtPrice(x, d, amp, tc.price)
+			p := priceAt(x2)
+			if tc.price.GT(utils.OneDec) { // the pool sells denom 0
+				require.True(t, x2.LT(x))
+				require.True(t, p.LTE(tc.price))
+			} else { // the pool buys denom 0
+				require.True(t, x2.GT(x))
+				require.True(t, p.GTE(tc.price))
+			}
+			require.True(t, utils.DecApproxEqual(tc.price, p))
+		})
+	}
+}
@@ -0,0 +1,143 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var (
+	_ sdk.Msg = (*MsgCreatePool)(nil)
+	_ sdk.Msg = (*MsgDeposit)(nil)
+	_ sdk.Msg = (*MsgWithdraw)(nil)
+)
+
+// Message types for the module
+const (
+	TypeMsgCreatePool = "create_pool"
+	TypeMsgDeposit    = "deposit"
+	TypeMsgWithdraw   = "withdraw"
+)
+
+func NewMsgCreatePool(senderAddr sdk.AccAddress, marketId uint64) *MsgCreatePool {
+	return &MsgCreatePool{
+		Sender:   senderAddr.String(),
+		MarketId: marketId,
+	}
+}
+
+func (msg MsgCreatePool) Route() string { return RouterKey }
+func (msg MsgCreatePool) Type() string  { return TypeMsgCreatePool }
+
+func (msg MsgCreatePool) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgCreatePool) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
+func (msg MsgCreatePool) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address: %v", err)
+	}
+	if msg.MarketId == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "market id must not be 0")
+	}
+	return nil
+}
+
+func NewMsgDeposit(senderAddr sdk.AccAddress, poolId uint64, amt sdk.Coins, minShare sdk.Int) *MsgDeposit {
+	return &MsgDeposit{
+		Sender:   senderAddr.String(),
+		PoolId:   poolId,
+		Amount:   amt,
+		MinShare: minShare,
+	}
+}
+
+func (msg MsgDeposit) Route() string { return RouterKey }
+func (msg MsgDeposit) Type() string  { return TypeMsgDeposit }
+
+func (msg MsgDeposit) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgDeposit) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
+func (msg MsgDeposit) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address: %v", err)
+	}
+	if msg.PoolId == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "pool id must not be 0")
+	}
+	if err := msg.Amount.Validate(); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid amount: %v", err)
+	}
+	if msg.Amount.IsZero() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "amount must not be empty")
+	}
+	if len(msg.Amount) > 2 {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "number of coins in amount must not be higher than 2: %d", len(msg.Amount))
+	}
+	if msg.MinShare.IsNil() || msg.MinShare.IsNegative() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "min share must not be negative: %s", msg.MinShare)
+	}
+	return nil
+}
+
+func NewMsgWithdraw(senderAddr sdk.AccAddress, poolId uint64, share sdk.Coin, minAmt sdk.Coins) *MsgWithdraw {
+	return &MsgWithdraw{
+		Sender:    senderAddr.String(),
+		PoolId:    poolId,
+		Share:     share,
+		MinAmount: minAmt,
+	}
+}
+
+func (msg MsgWithdraw) Route() string { return RouterKey }
+func (msg MsgWithdraw) Type() string  { return TypeMsgWithdraw }
+
+func (msg MsgWithdraw) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgWithdraw) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
+func (msg MsgWithdraw) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address: %v", err)
+	}
+	if msg.PoolId == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "pool id must not be 0")
+	}
+	if err := msg.Share.Validate(); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid share: %v", err)
+	}
+	if !msg.Share.IsPositive() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "share must be positive: %s", msg.Share)
+	}
+	if msg.Share.Denom != ShareDenom(msg.PoolId) {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "share denom must be %s: %s", ShareDenom(msg.PoolId), msg.Share.Denom)
+	}
+	if err := msg.MinAmount.Validate(); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid min amount: %v", err)
+	}
+	return nil
+}
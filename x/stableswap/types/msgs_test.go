@@ -0,0 +1,144 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	utils "github.com/crescent-network/crescent/v5/types"
+	"github.com/crescent-network/crescent/v5/x/stableswap/types"
+)
+
+func TestMsgCreatePool_ValidateBasic(t *testing.T) {
+	for _, tc := range []struct {
+		name        string
+		malleate    func(msg *types.MsgCreatePool)
+		expectedErr string
+	}{
+		{
+			"valid",
+			func(msg *types.MsgCreatePool) {},
+			"",
+		},
+		{
+			"invalid sender",
+			func(msg *types.MsgCreatePool) {
+				msg.Sender = "invalidaddr"
+			},
+			"invalid sender address: decoding bech32 failed: invalid separator index -1: invalid address",
+		},
+		{
+			"invalid market id",
+			func(msg *types.MsgCreatePool) {
+				msg.MarketId = 0
+			},
+			"market id must not be 0: invalid request",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			msg := types.NewMsgCreatePool(utils.TestAddress(1), 1)
+			tc.malleate(msg)
+			err := msg.ValidateBasic()
+			if tc.expectedErr == "" {
+				require.NoError(t, err)
+			} else {
+				require.EqualError(t, err, tc.expectedErr)
+			}
+		})
+	}
+}
+
+func TestMsgDeposit_ValidateBasic(t *testing.T) {
+	for _, tc := range []struct {
+		name        string
+		malleate    func(msg *types.MsgDeposit)
+		expectedErr string
+	}{
+		{
+			"valid",
+			func(msg *types.MsgDeposit) {},
+			"",
+		},
+		{
+			"invalid pool id",
+			func(msg *types.MsgDeposit) {
+				msg.PoolId = 0
+			},
+			"pool id must not be 0: invalid request",
+		},
+		{
+			"empty amount",
+			func(msg *types.MsgDeposit) {
+				msg.Amount = sdk.Coins{}
+			},
+			"amount must not be empty: invalid request",
+		},
+		{
+			"too many coins",
+			func(msg *types.MsgDeposit) {
+				msg.Amount = utils.ParseCoins("1000000uatom,1000000uusdc,1000000uusdt")
+			},
+			"number of coins in amount must not be higher than 2: 3: invalid request",
+		},
+		{
+			"negative min share",
+			func(msg *types.MsgDeposit) {
+				msg.MinShare = sdk.NewInt(-1)
+			},
+			"min share must not be negative: -1: invalid request",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			msg := types.NewMsgDeposit(
+				utils.TestAddress(1), 1, utils.ParseCoins("1000000uusdc,1000000uusdt"), sdk.ZeroInt())
+			tc.malleate(msg)
+			err := msg.ValidateBasic()
+			if tc.expectedErr == "" {
+				require.NoError(t, err)
+			} else {
+				require.EqualError(t, err, tc.expectedErr)
+			}
+		})
+	}
+}
+
+func TestMsgWithdraw_ValidateBasic(t *testing.T) {
+	for _, tc := range []struct {
+		name        string
+		malleate    func(msg *types.MsgWithdraw)
+		expectedErr string
+	}{
+		{
+			"valid",
+			func(msg *types.MsgWithdraw) {},
+			"",
+		},
+		{
+			"invalid pool id",
+			func(msg *types.MsgWithdraw) {
+				msg.PoolId = 0
+			},
+			"pool id must not be 0: invalid request",
+		},
+		{
+			"wrong share denom",
+			func(msg *types.MsgWithdraw) {
+				msg.Share = utils.ParseCoin("1000000sspool2")
+			},
+			"share denom must be sspool1: sspool2: invalid request",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			msg := types.NewMsgWithdraw(utils.TestAddress(1), 1, utils.ParseCoin("1000000sspool1"), nil)
+			tc.malleate(msg)
+			err := msg.ValidateBasic()
+			if tc.expectedErr == "" {
+				require.NoError(t, err)
+			} else {
+				require.EqualError(t, err, tc.expectedErr)
+			}
+		})
+	}
+}
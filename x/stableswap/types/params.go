@@ -0,0 +1,147 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+var _ paramstypes.ParamSet = (*Params)(nil)
+
+const (
+	MinAmplification = 1
+	MaxAmplification = 10000
+)
+
+var (
+	KeyPoolCreationFee         = []byte("PoolCreationFee")
+	KeyDefaultAmplification    = []byte("DefaultAmplification")
+	KeyDefaultTickSpacing      = []byte("DefaultTickSpacing")
+	KeyDefaultMinOrderQuantity = []byte("DefaultMinOrderQuantity")
+	KeyDefaultMinOrderQuote    = []byte("DefaultMinOrderQuote")
+)
+
+var (
+	DefaultPoolCreationFee         = sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000000))
+	DefaultDefaultAmplification    = uint64(100)
+	DefaultDefaultTickSpacing      = uint32(1)
+	DefaultDefaultMinOrderQuantity = sdk.NewDec(1)
+	DefaultDefaultMinOrderQuote    = sdk.NewDec(1)
+
+	AllowedTickSpacings = []uint32{1, 5, 10, 50}
+)
+
+func IsAllowedTickSpacing(tickSpacing uint32) bool {
+	for _, ts := range AllowedTickSpacings {
+		if tickSpacing == ts {
+			return true
+		}
+	}
+	return false
+}
+
+func ValidateAmplification(amp uint64) error {
+	if amp < MinAmplification || amp > MaxAmplification {
+		return fmt.Errorf(
+			"amplification must be in range [%d, %d]: %d", MinAmplification, MaxAmplification, amp)
+	}
+	return nil
+}
+
+func ParamKeyTable() paramstypes.KeyTable {
+	return paramstypes.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// DefaultParams returns a default params for the module.
+func DefaultParams() Params {
+	return Params{
+		PoolCreationFee:         DefaultPoolCreationFee,
+		DefaultAmplification:    DefaultDefaultAmplification,
+		DefaultTickSpacing:      DefaultDefaultTickSpacing,
+		DefaultMinOrderQuantity: DefaultDefaultMinOrderQuantity,
+		DefaultMinOrderQuote:    DefaultDefaultMinOrderQuote,
+	}
+}
+
+// ParamSetPairs implements ParamSet.
+func (params *Params) ParamSetPairs() paramstypes.ParamSetPairs {
+	return paramstypes.ParamSetPairs{
+		paramstypes.NewParamSetPair(KeyPoolCreationFee, &params.PoolCreationFee, validatePoolCreationFee),
+		paramstypes.NewParamSetPair(KeyDefaultAmplification, &params.DefaultAmplification, validateDefaultAmplification),
+		paramstypes.NewParamSetPair(KeyDefaultTickSpacing, &params.DefaultTickSpacing, validateDefaultTickSpacing),
+		paramstypes.NewParamSetPair(KeyDefaultMinOrderQuantity, &params.DefaultMinOrderQuantity, validateDefaultMinOrderQuantity),
+		paramstypes.NewParamSetPair(KeyDefaultMinOrderQuote, &params.DefaultMinOrderQuote, validateDefaultMinOrderQuote),
+	}
+}
+
+// Validate validates Params.
+func (params Params) Validate() error {
+	for _, field := range []struct {
+		val          interface{}
+		validateFunc func(i interface{}) error
+	}{
+		{params.PoolCreationFee, validatePoolCreationFee},
+		{params.DefaultAmplification, validateDefaultAmplification},
+		{params.DefaultTickSpacing, validateDefaultTickSpacing},
+		{params.DefaultMinOrderQuantity, validateDefaultMinOrderQuantity},
+		{params.DefaultMinOrderQuote, validateDefaultMinOrderQuote},
+	} {
+		if err := field.validateFunc(field.val); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func validatePoolCreationFee(i interface{}) error {
+	v, ok := i.(sdk.Coins)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if err := v.Validate(); err != nil {
+		return fmt.Errorf("invalid pool creation fee: %w", err)
+	}
+	return nil
+}
+
+func validateDefaultAmplification(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return ValidateAmplification(v)
+}
+
+func validateDefaultTickSpacing(i interface{}) error {
+	v, ok := i.(uint32)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if !IsAllowedTickSpacing(v) {
+		return fmt.Errorf("tick spacing %d is not allowed", v)
+	}
+	return nil
+}
+
+func validateDefaultMinOrderQuantity(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v.IsNegative() {
+		return fmt.Errorf("default min order quantity must not be negative: %s", v)
+	}
+	return nil
+}
+
+func validateDefaultMinOrderQuote(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v.IsNegative() {
+		return fmt.Errorf("default min order quote must not be negative: %s", v)
+	}
+	return nil
+}
@@ -0,0 +1,489 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: crescent/stableswap/v1beta1/params.proto
+
+package types
+
+import (
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type Params struct {
+	PoolCreationFee         github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=pool_creation_fee,json=poolCreationFee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"pool_creation_fee"`
+	DefaultAmplification    uint64                                   `protobuf:"varint,2,opt,name=default_amplification,json=defaultAmplification,proto3" json:"default_amplification,omitempty"`
+	DefaultTickSpacing      uint32                                   `protobuf:"varint,3,opt,name=default_tick_spacing,json=defaultTickSpacing,proto3" json:"default_tick_spacing,omitempty"`
+	DefaultMinOrderQuantity github_com_cosmos_cosmos_sdk_types.Dec   `protobuf:"bytes,4,opt,name=default_min_order_quantity,json=defaultMinOrderQuantity,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"default_min_order_quantity"`
+	DefaultMinOrderQuote    github_com_cosmos_cosmos_sdk_types.Dec   `protobuf:"bytes,5,opt,name=default_min_order_quote,json=defaultMinOrderQuote,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"default_min_order_quote"`
+}
+
+func (m *Params) Reset()         { *m = Params{} }
+func (m *Params) String() string { return proto.CompactTextString(m) }
+func (*Params) ProtoMessage()    {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0e46f476bca140b, []int{0}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*Params)(nil), "crescent.stableswap.v1beta1.Params")
+}
+
+func init() {
+	proto.RegisterFile("crescent/stableswap/v1beta1/params.proto", fileDescriptor_e0e46f476bca140b)
+}
+
+var fileDescriptor_e0e46f476bca140b = []byte{
+	// 402 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x92, 0xc1, 0x8a, 0xd3, 0x40,
+	0x18, 0xc7, 0x13, 0xbb, 0x2e, 0x18, 0x11, 0x31, 0x54, 0x36, 0x56, 0x98, 0x06, 0x0f, 0x92, 0xcb,
+	0xce, 0xec, 0xba, 0x78, 0xf1, 0x66, 0x57, 0xbc, 0x89, 0x1a, 0x3d, 0x88, 0x97, 0x30, 0x99, 0x7e,
+	0x8d, 0x43, 0x92, 0xf9, 0x62, 0x66, 0xba, 0x75, 0xdf, 0xc2, 0xe7, 0xf0, 0x19, 0x7c, 0x80, 0x1e,
+	0x7b, 0x14, 0x0f, 0x55, 0xdb, 0x17, 0x91, 0x24, 0x93, 0x5a, 0xd0, 0x83, 0xec, 0x29, 0x61, 0xfe,
+	0xff, 0xef, 0xf7, 0xe3, 0x83, 0xcf, 0x8b, 0x44, 0x0d, 0x5a, 0x80, 0x32, 0x4c, 0x1b, 0x9e, 0x16,
+	0xa0, 0x17, 0xbc, 0x62, 0x17, 0xa7, 0x29, 0x18, 0x7e, 0xca, 0x2a, 0x5e, 0xf3, 0x52, 0xd3, 0xaa,
+	0x46, 0x83, 0xfe, 0xfd, 0xbe, 0x49, 0xff, 0x34, 0xa9, 0x6d, 0x8e, 0x86, 0x19, 0x66, 0xd8, 0xf6,
+	0x58, 0xf3, 0xd7, 0x8d, 0x8c, 0x88, 0x40, 0x5d, 0xa2, 0x66, 0x29, 0xd7, 0xb0, 0x83, 0x0a, 0x94,
+	0xaa, 0xcb, 0x1f, 0x7c, 0x1d, 0x78, 0x87, 0xaf, 0x5a, 0x87, 0xbf, 0xf0, 0xee, 0x54, 0x88, 0x45,
+	0x22, 0x6a, 0xe0, 0x46, 0xa2, 0x4a, 0x66, 0x00, 0x81, 0x1b, 0x0e, 0xa2, 0x9b, 0x8f, 0xee, 0xd1,
+	0x0e, 0x43, 0x1b, 0x4c, 0x6f, 0xa4, 0xe7, 0x28, 0xd5, 0xe4, 0x64, 0xb9, 0x1e, 0x3b, 0x5f, 0x7e,
+	0x8c, 0xa3, 0x4c, 0x9a, 0x0f, 0xf3, 0x94, 0x0a, 0x2c, 0x99, 0x75, 0x76, 0x9f, 0x63, 0x3d, 0xcd,
+	0x99, 0xb9, 0xac, 0x40, 0xb7, 0x03, 0x3a, 0xbe, 0xdd, 0x58, 0xce, 0xad, 0xe4, 0x39, 0x80, 0x7f,
+	0xe6, 0xdd, 0x9d, 0xc2, 0x8c, 0xcf, 0x0b, 0x93, 0xf0, 0xb2, 0x2a, 0xe4, 0x4c, 0x8a, 0x36, 0x0b,
+	0xae, 0x85, 0x6e, 0x74, 0x10, 0x0f, 0x6d, 0xf8, 0x74, 0x3f, 0xf3, 0x4f, 0xbc, 0xfe, 0x3d, 0x31,
+	0x52, 0xe4, 0x89, 0xae, 0xb8, 0x90, 0x2a, 0x0b, 0x06, 0xa1, 0x1b, 0xdd, 0x8a, 0x7d, 0x9b, 0xbd,
+	0x95, 0x22, 0x7f, 0xd3, 0x25, 0x7e, 0xee, 0x8d, 0xfa, 0x89, 0x52, 0xaa, 0x04, 0xeb, 0x29, 0xd4,
+	0xc9, 0xc7, 0x39, 0x57, 0x46, 0x9a, 0xcb, 0xe0, 0x20, 0x74, 0xa3, 0x1b, 0x13, 0xda, 0x6c, 0xf3,
+	0x7d, 0x3d, 0x7e, 0xf8, 0x1f, 0xdb, 0x3c, 0x03, 0x11, 0x1f, 0x59, 0xe2, 0x0b, 0xa9, 0x5e, 0x36,
+	0xbc, 0xd7, 0x16, 0xe7, 0x83, 0x77, 0xf4, 0x2f, 0x19, 0x1a, 0x08, 0xae, 0x5f, 0xc9, 0x34, 0xfc,
+	0xcb, 0x84, 0x06, 0x26, 0xef, 0x96, 0xbf, 0x88, 0xb3, 0xdc, 0x10, 0x77, 0xb5, 0x21, 0xee, 0xcf,
+	0x0d, 0x71, 0x3f, 0x6f, 0x89, 0xb3, 0xda, 0x12, 0xe7, 0xdb, 0x96, 0x38, 0xef, 0x9f, 0xec, 0xb3,
+	0xed, 0xe9, 0x1c, 0x2b, 0x30, 0x0b, 0xac, 0xf3, 0xdd, 0x03, 0xbb, 0x78, 0xcc, 0x3e, 0xed, 0x9f,
+	0x5e, 0xeb, 0x4c, 0x0f, 0xdb, 0xfb, 0x38, 0xfb, 0x3d, 0x00, 0x50, 0x50, 0xfc, 0x37, 0x9e, 0x02,
+	0x00, 0x00,
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.DefaultMinOrderQuote.Size()
+		i -= size
+		if _, err := m.DefaultMinOrderQuote.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.DefaultMinOrderQuantity.Size()
+		i -= size
+		if _, err := m.DefaultMinOrderQuantity.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.DefaultTickSpacing != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.DefaultTickSpacing))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.DefaultAmplification != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.DefaultAmplification))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.PoolCreationFee) > 0 {
+		for iNdEx := len(m.PoolCreationFee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PoolCreationFee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintParams(dAtA []byte, offset int, v uint64) int {
+	offset -= sovParams(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.PoolCreationFee) > 0 {
+		for _, e := range m.PoolCreationFee {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	if m.DefaultAmplification != 0 {
+		n += 1 + sovParams(uint64(m.DefaultAmplification))
+	}
+	if m.DefaultTickSpacing != 0 {
+		n += 1 + sovParams(uint64(m.DefaultTickSpacing))
+	}
+	l = m.DefaultMinOrderQuantity.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.DefaultMinOrderQuote.Size()
+	n += 1 + l + sovParams(uint64(l))
+	return n
+}
+
+func sovParams(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozParams(x uint64) (n int) {
+	return sovParams(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolCreationFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PoolCreationFee = append(m.PoolCreationFee, types.Coin{})
+			if err := m.PoolCreationFee[len(m.PoolCreationFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DefaultAmplification", wireType)
+			}
+			m.DefaultAmplification = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DefaultAmplification |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DefaultTickSpacing", wireType)
+			}
+			m.DefaultTickSpacing = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DefaultTickSpacing |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DefaultMinOrderQuantity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DefaultMinOrderQuantity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DefaultMinOrderQuote", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DefaultMinOrderQuote.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipParams(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthParams
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupParams
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthParams
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthParams        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowParams          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupParams = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,135 @@
+package types
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+
+	utils "github.com/crescent-network/crescent/v5/types"
+)
+
+const shareDenomPrefix = "sspool"
+
+func DerivePoolReserveAddress(poolId uint64) sdk.AccAddress {
+	return address.Module(ModuleName, []byte(fmt.Sprintf("PoolReserveAddress/%d", poolId)))
+}
+
+// ShareDenom returns the denom of the pool share of the pool.
+func ShareDenom(poolId uint64) string {
+	return fmt.Sprintf("%s%d", shareDenomPrefix, poolId)
+}
+
+// ParseShareDenom parses the pool id from the pool share denom.
+func ParseShareDenom(denom string) (poolId uint64, err error) {
+	if !strings.HasPrefix(denom, shareDenomPrefix) {
+		return 0, fmt.Errorf("invalid share denom: %s", denom)
+	}
+	poolId, err = strconv.ParseUint(strings.TrimPrefix(denom, shareDenomPrefix), 10, 64)
+	if err != nil || poolId == 0 {
+		return 0, fmt.Errorf("invalid share denom: %s", denom)
+	}
+	return poolId, nil
+}
+
+func NewPool(
+	id uint64, marketId uint64, denom0, denom1 string, amp uint64, tickSpacing uint32,
+	minOrderQty, minOrderQuote sdk.Dec) Pool {
+	return Pool{
+		Id:               id,
+		MarketId:         marketId,
+		Denom0:           denom0,
+		Denom1:           denom1,
+		ReserveAddress:   DerivePoolReserveAddress(id).String(),
+		ShareDenom:       ShareDenom(id),
+		Amplification:    amp,
+		TickSpacing:      tickSpacing,
+		MinOrderQuantity: minOrderQty,
+		MinOrderQuote:    minOrderQuote,
+	}
+}
+
+func (pool Pool) MustGetReserveAddress() sdk.AccAddress {
+	return sdk.MustAccAddressFromBech32(pool.ReserveAddress)
+}
+
+func (pool Pool) Validate() error {
+	if pool.Id == 0 {
+		return fmt.Errorf("id must not be 0")
+	}
+	if pool.MarketId == 0 {
+		return fmt.Errorf("market id must not be 0")
+	}
+	if err := sdk.ValidateDenom(pool.Denom0); err != nil {
+		return fmt.Errorf("invalid denom 0: %w", err)
+	}
+	if err := sdk.ValidateDenom(pool.Denom1); err != nil {
+		return fmt.Errorf("invalid denom 1: %w", err)
+	}
+	if pool.Denom0 == pool.Denom1 {
+		return fmt.Errorf("denom 0 and denom 1 must not be same: %s", pool.Denom0)
+	}
+	if _, err := sdk.AccAddressFromBech32(pool.ReserveAddress); err != nil {
+		return fmt.Errorf("invalid reserve address: %w", err)
+	}
+	if pool.ShareDenom != ShareDenom(pool.Id) {
+		return fmt.Errorf("share denom must be %s: %s", ShareDenom(pool.Id), pool.ShareDenom)
+	}
+	if err := ValidateAmplification(pool.Amplification); err != nil {
+		return err
+	}
+	if !IsAllowedTickSpacing(pool.TickSpacing) {
+		return fmt.Errorf("tick spacing %d is not allowed", pool.TickSpacing)
+	}
+	if pool.MinOrderQuantity.IsNegative() {
+		return fmt.Errorf("min order quantity must not be negative: %s", pool.MinOrderQuantity)
+	}
+	if pool.MinOrderQuote.IsNegative() {
+		return fmt.Errorf("min order quote must not be negative: %s", pool.MinOrderQuote)
+	}
+	return nil
+}
+
+func NewPoolState() PoolState {
+	return PoolState{
+		Reserve0:   utils.ZeroInt,
+		Reserve1:   utils.ZeroInt,
+		TotalShare: utils.ZeroInt,
+	}
+}
+
+// IsEmpty returns whether the pool has no liquidity.
+func (poolState PoolState) IsEmpty() bool {
+	return !poolState.Reserve0.IsPositive() || !poolState.Reserve1.IsPositive()
+}
+
+func (poolState PoolState) Validate() error {
+	if poolState.Reserve0.IsNegative() {
+		return fmt.Errorf("reserve 0 must not be negative: %s", poolState.Reserve0)
+	}
+	if poolState.Reserve1.IsNegative() {
+		return fmt.Errorf("reserve 1 must not be negative: %s", poolState.Reserve1)
+	}
+	if poolState.TotalShare.IsNegative() {
+		return fmt.Errorf("total share must not be negative: %s", poolState.TotalShare)
+	}
+	if poolState.TotalShare.IsZero() != (poolState.Reserve0.IsZero() && poolState.Reserve1.IsZero()) {
+		return fmt.Errorf("total share and reserves must be zero at the same time")
+	}
+	return nil
+}
+
+// Invariant returns the invariant of the pool.
+// It must be called only when the pool is not empty.
+func (poolState PoolState) Invariant(amp uint64) sdk.Dec {
+	return Invariant(poolState.Reserve0.ToDec(), poolState.Reserve1.ToDec(), amp)
+}
+
+// Price returns the marginal price of the pool.
+// It must be called only when the pool is not empty.
+func (poolState PoolState) Price(amp uint64) sdk.Dec {
+	x, y := poolState.Reserve0.ToDec(), poolState.Reserve1.ToDec()
+	return Price(x, y, Invariant(x, y, amp), amp)
+}
@@ -0,0 +1,116 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	gov "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+const (
+	ProposalTypePoolParameterChange string = "StableSwapPoolParameterChange"
+)
+
+var (
+	_ gov.Content = &PoolParameterChangeProposal{}
+)
+
+func init() {
+	gov.RegisterProposalType(ProposalTypePoolParameterChange)
+	gov.RegisterProposalTypeCodec(&PoolParameterChangeProposal{}, "crescent/StableSwapPoolParameterChangeProposal")
+}
+
+func NewPoolParameterChangeProposal(title, description string, changes []PoolParameterChange) *PoolParameterChangeProposal {
+	return &PoolParameterChangeProposal{
+		Title:       title,
+		Description: description,
+		Changes:     changes,
+	}
+}
+
+func (p *PoolParameterChangeProposal) GetTitle() string       { return p.Title }
+func (p *PoolParameterChangeProposal) GetDescription() string { return p.Description }
+func (p *PoolParameterChangeProposal) ProposalRoute() string  { return RouterKey }
+func (p *PoolParameterChangeProposal) ProposalType() string {
+	return ProposalTypePoolParameterChange
+}
+
+func (p *PoolParameterChangeProposal) ValidateBasic() error {
+	if err := gov.ValidateAbstract(p); err != nil {
+		return err
+	}
+	if len(p.Changes) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "changes must not be empty")
+	}
+	for _, change := range p.Changes {
+		if err := change.Validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (p PoolParameterChangeProposal) String() string {
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf(`StableSwap Pool Parameter Change Proposal:
+  Title:       %s
+  Description: %s
+  Changes:
+`, p.Title, p.Description))
+	for _, change := range p.Changes {
+		b.WriteString(fmt.Sprintf(`    Pool Parameter Change:
+      Pool Id:            %d
+      Amplification:      %d
+      Tick Spacing:       %d
+      Min Order Quantity: %s
+      Min Order Quote:    %s
+`, change.PoolId, change.Amplification, change.TickSpacing, change.MinOrderQuantity, change.MinOrderQuote))
+	}
+	return b.String()
+}
+
+func NewPoolParameterChange(
+	poolId uint64, amp uint64, tickSpacing uint32, minOrderQty, minOrderQuote *sdk.Dec) PoolParameterChange {
+	return PoolParameterChange{
+		PoolId:           poolId,
+		Amplification:    amp,
+		TickSpacing:      tickSpacing,
+		MinOrderQuantity: minOrderQty,
+		MinOrderQuote:    minOrderQuote,
+	}
+}
+
+func (change PoolParameterChange) Validate() error {
+	if change.PoolId == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "pool id must not be 0")
+	}
+	if change.Amplification == 0 && change.TickSpacing == 0 &&
+		change.MinOrderQuantity == nil && change.MinOrderQuote == nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "no changes")
+	}
+	if change.Amplification != 0 {
+		if err := ValidateAmplification(change.Amplification); err != nil {
+			return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+		}
+	}
+	if change.TickSpacing != 0 {
+		if !IsAllowedTickSpacing(change.TickSpacing) {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "tick spacing %d is not allowed", change.TickSpacing)
+		}
+	}
+	if change.MinOrderQuantity != nil {
+		if change.MinOrderQuantity.IsNegative() {
+			return sdkerrors.Wrapf(
+				sdkerrors.ErrInvalidRequest, "min order quantity must not be negative: %s", change.MinOrderQuantity)
+		}
+	}
+	if change.MinOrderQuote != nil {
+		if change.MinOrderQuote.IsNegative() {
+			return sdkerrors.Wrapf(
+				sdkerrors.ErrInvalidRequest, "min order quote must not be negative: %s", change.MinOrderQuote)
+		}
+	}
+	return nil
+}
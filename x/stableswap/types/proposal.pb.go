@@ -0,0 +1,734 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: crescent/stableswap/v1beta1/proposal.proto
+
+package types
+
+import (
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type PoolParameterChangeProposal struct {
+	Title       string                `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description string                `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Changes     []PoolParameterChange `protobuf:"bytes,3,rep,name=changes,proto3" json:"changes"`
+}
+
+func (m *PoolParameterChangeProposal) Reset()      { *m = PoolParameterChangeProposal{} }
+func (*PoolParameterChangeProposal) ProtoMessage() {}
+func (*PoolParameterChangeProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b79d8ef4237d7c0e, []int{0}
+}
+func (m *PoolParameterChangeProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PoolParameterChangeProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PoolParameterChangeProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PoolParameterChangeProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PoolParameterChangeProposal.Merge(m, src)
+}
+func (m *PoolParameterChangeProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *PoolParameterChangeProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_PoolParameterChangeProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PoolParameterChangeProposal proto.InternalMessageInfo
+
+type PoolParameterChange struct {
+	PoolId           uint64                                  `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	Amplification    uint64                                  `protobuf:"varint,2,opt,name=amplification,proto3" json:"amplification,omitempty"`
+	TickSpacing      uint32                                  `protobuf:"varint,3,opt,name=tick_spacing,json=tickSpacing,proto3" json:"tick_spacing,omitempty"`
+	MinOrderQuantity *github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=min_order_quantity,json=minOrderQuantity,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"min_order_quantity,omitempty"`
+	MinOrderQuote    *github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=min_order_quote,json=minOrderQuote,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"min_order_quote,omitempty"`
+}
+
+func (m *PoolParameterChange) Reset()         { *m = PoolParameterChange{} }
+func (m *PoolParameterChange) String() string { return proto.CompactTextString(m) }
+func (*PoolParameterChange) ProtoMessage()    {}
+func (*PoolParameterChange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b79d8ef4237d7c0e, []int{1}
+}
+func (m *PoolParameterChange) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PoolParameterChange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PoolParameterChange.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PoolParameterChange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PoolParameterChange.Merge(m, src)
+}
+func (m *PoolParameterChange) XXX_Size() int {
+	return m.Size()
+}
+func (m *PoolParameterChange) XXX_DiscardUnknown() {
+	xxx_messageInfo_PoolParameterChange.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PoolParameterChange proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*PoolParameterChangeProposal)(nil), "crescent.stableswap.v1beta1.PoolParameterChangeProposal")
+	proto.RegisterType((*PoolParameterChange)(nil), "crescent.stableswap.v1beta1.PoolParameterChange")
+}
+
+func init() {
+	proto.RegisterFile("crescent/stableswap/v1beta1/proposal.proto", fileDescriptor_b79d8ef4237d7c0e)
+}
+
+var fileDescriptor_b79d8ef4237d7c0e = []byte{
+	// 415 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x92, 0x31, 0x6f, 0xd4, 0x30,
+	0x14, 0xc7, 0x93, 0x5e, 0xda, 0x0a, 0x1f, 0x27, 0x90, 0xa9, 0x44, 0x44, 0xa5, 0xdc, 0x51, 0x21,
+	0x74, 0xaa, 0xd4, 0x98, 0x82, 0x58, 0x3a, 0x1e, 0x2c, 0x4c, 0x1c, 0x61, 0xa9, 0x58, 0x22, 0xc7,
+	0x31, 0xa9, 0x75, 0x89, 0x9f, 0xb1, 0x5f, 0x5b, 0xfa, 0x2d, 0x18, 0x19, 0x59, 0x18, 0xf9, 0x1e,
+	0x37, 0x76, 0x44, 0x0c, 0x15, 0xdc, 0x7d, 0x11, 0x94, 0x5c, 0xda, 0x06, 0xa9, 0x42, 0xea, 0x64,
+	0xbf, 0xbf, 0xff, 0xfe, 0xf9, 0x6f, 0xbd, 0x47, 0x76, 0x85, 0x95, 0x4e, 0x48, 0x8d, 0xcc, 0x21,
+	0xcf, 0x4a, 0xe9, 0x4e, 0xb9, 0x61, 0x27, 0xfb, 0x99, 0x44, 0xbe, 0xcf, 0x8c, 0x05, 0x03, 0x8e,
+	0x97, 0xb1, 0xb1, 0x80, 0x40, 0xb7, 0x2f, 0xbd, 0xf1, 0xb5, 0x37, 0x6e, 0xbd, 0x8f, 0xb6, 0x0a,
+	0x28, 0xa0, 0xf1, 0xb1, 0x7a, 0xb7, 0xba, 0xb2, 0xf3, 0xc3, 0x27, 0xdb, 0x53, 0x80, 0x72, 0xca,
+	0x2d, 0xaf, 0x24, 0x4a, 0xfb, 0xea, 0x88, 0xeb, 0x42, 0x4e, 0x5b, 0x30, 0xdd, 0x22, 0xeb, 0xa8,
+	0xb0, 0x94, 0xa1, 0x3f, 0xf2, 0xc7, 0x77, 0x92, 0x55, 0x41, 0x47, 0xa4, 0x9f, 0x4b, 0x27, 0xac,
+	0x32, 0xa8, 0x40, 0x87, 0x6b, 0xcd, 0x59, 0x57, 0xa2, 0x53, 0xb2, 0x29, 0x1a, 0x92, 0x0b, 0x7b,
+	0xa3, 0xde, 0xb8, 0xff, 0xfc, 0x59, 0xfc, 0x9f, 0x70, 0xf1, 0x0d, 0x11, 0x26, 0xc1, 0xfc, 0x62,
+	0xe8, 0x25, 0x97, 0x98, 0x83, 0xe0, 0xeb, 0xb7, 0xa1, 0xb7, 0xf3, 0x7d, 0x8d, 0x3c, 0xb8, 0xc1,
+	0x4c, 0x1f, 0x92, 0x4d, 0x03, 0x50, 0xa6, 0x2a, 0x6f, 0x92, 0x06, 0xc9, 0x46, 0x5d, 0xbe, 0xc9,
+	0xe9, 0x13, 0x32, 0xe0, 0x95, 0x29, 0xd5, 0x47, 0x25, 0xf8, 0x55, 0xd8, 0x20, 0xf9, 0x57, 0xa4,
+	0x8f, 0xc9, 0x5d, 0x54, 0x62, 0x96, 0x3a, 0xc3, 0x85, 0xd2, 0x45, 0xd8, 0x1b, 0xf9, 0xe3, 0x41,
+	0xd2, 0xaf, 0xb5, 0xf7, 0x2b, 0x89, 0x1e, 0x12, 0x5a, 0x29, 0x9d, 0x82, 0xcd, 0xa5, 0x4d, 0x3f,
+	0x1d, 0x73, 0x8d, 0x0a, 0xcf, 0xc2, 0xa0, 0xfe, 0xfa, 0x64, 0xf7, 0xd7, 0xc5, 0xf0, 0x69, 0xa1,
+	0xf0, 0xe8, 0x38, 0x8b, 0x05, 0x54, 0x4c, 0x80, 0xab, 0xc0, 0xb5, 0xcb, 0x9e, 0xcb, 0x67, 0x0c,
+	0xcf, 0x8c, 0x74, 0xf1, 0x6b, 0x29, 0x92, 0xfb, 0x95, 0xd2, 0x6f, 0x6b, 0xc8, 0xbb, 0x96, 0x41,
+	0x13, 0x72, 0xaf, 0x4b, 0x06, 0x94, 0xe1, 0xfa, 0xad, 0xb1, 0x83, 0x6b, 0x2c, 0xa0, 0x9c, 0x1c,
+	0xce, 0xff, 0x44, 0xde, 0x7c, 0x11, 0xf9, 0xe7, 0x8b, 0xc8, 0xff, 0xbd, 0x88, 0xfc, 0x2f, 0xcb,
+	0xc8, 0x3b, 0x5f, 0x46, 0xde, 0xcf, 0x65, 0xe4, 0x7d, 0x38, 0xe8, 0x42, 0xdb, 0xb6, 0xec, 0x69,
+	0x89, 0xa7, 0x60, 0x67, 0x57, 0x02, 0x3b, 0x79, 0xc9, 0x3e, 0x77, 0xa7, 0xae, 0x79, 0x2c, 0xdb,
+	0x68, 0x06, 0xe7, 0xc5, 0xdf, 0x01, 0x00, 0x59, 0x45, 0xbd, 0x71, 0x99, 0x02, 0x00, 0x00,
+}
+
+func (m *PoolParameterChangeProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PoolParameterChangeProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PoolParameterChangeProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Changes) > 0 {
+		for iNdEx := len(m.Changes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Changes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintProposal(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PoolParameterChange) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PoolParameterChange) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PoolParameterChange) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MinOrderQuote != nil {
+		{
+			size := m.MinOrderQuote.Size()
+			i -= size
+			if _, err := m.MinOrderQuote.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintProposal(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.MinOrderQuantity != nil {
+		{
+			size := m.MinOrderQuantity.Size()
+			i -= size
+			if _, err := m.MinOrderQuantity.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintProposal(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.TickSpacing != 0 {
+		i = encodeVarintProposal(dAtA, i, uint64(m.TickSpacing))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Amplification != 0 {
+		i = encodeVarintProposal(dAtA, i, uint64(m.Amplification))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintProposal(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintProposal(dAtA []byte, offset int, v uint64) int {
+	offset -= sovProposal(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *PoolParameterChangeProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	if len(m.Changes) > 0 {
+		for _, e := range m.Changes {
+			l = e.Size()
+			n += 1 + l + sovProposal(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PoolParameterChange) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovProposal(uint64(m.PoolId))
+	}
+	if m.Amplification != 0 {
+		n += 1 + sovProposal(uint64(m.Amplification))
+	}
+	if m.TickSpacing != 0 {
+		n += 1 + sovProposal(uint64(m.TickSpacing))
+	}
+	if m.MinOrderQuantity != nil {
+		l = m.MinOrderQuantity.Size()
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	if m.MinOrderQuote != nil {
+		l = m.MinOrderQuote.Size()
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	return n
+}
+
+func sovProposal(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozProposal(x uint64) (n int) {
+	return sovProposal(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *PoolParameterChangeProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProposal
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PoolParameterChangeProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PoolParameterChangeProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Changes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Changes = append(m.Changes, PoolParameterChange{})
+			if err := m.Changes[len(m.Changes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProposal(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PoolParameterChange) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProposal
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PoolParameterChange: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PoolParameterChange: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amplification", wireType)
+			}
+			m.Amplification = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Amplification |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TickSpacing", wireType)
+			}
+			m.TickSpacing = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TickSpacing |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinOrderQuantity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Dec
+			m.MinOrderQuantity = &v
+			if err := m.MinOrderQuantity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinOrderQuote", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Dec
+			m.MinOrderQuote = &v
+			if err := m.MinOrderQuote.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProposal(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipProposal(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowProposal
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthProposal
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupProposal
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthProposal
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthProposal        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowProposal          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupProposal = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,26 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func NewPoolResponse(pool Pool, poolState PoolState) PoolResponse {
+	var price *sdk.Dec
+	if !poolState.IsEmpty() {
+		p := poolState.Price(pool.Amplification)
+		price = &p
+	}
+	return PoolResponse{
+		Id:               pool.Id,
+		MarketId:         pool.MarketId,
+		Reserve0:         sdk.NewCoin(pool.Denom0, poolState.Reserve0),
+		Reserve1:         sdk.NewCoin(pool.Denom1, poolState.Reserve1),
+		ReserveAddress:   pool.ReserveAddress,
+		TotalShare:       sdk.NewCoin(pool.ShareDenom, poolState.TotalShare),
+		Amplification:    pool.Amplification,
+		TickSpacing:      pool.TickSpacing,
+		MinOrderQuantity: pool.MinOrderQuantity,
+		MinOrderQuote:    pool.MinOrderQuote,
+		Price:            price,
+	}
+}
@@ -0,0 +1,928 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: crescent/stableswap/v1beta1/stableswap.proto
+
+package types
+
+import (
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type Pool struct {
+	Id               uint64                                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	MarketId         uint64                                 `protobuf:"varint,2,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	Denom0           string                                 `protobuf:"bytes,3,opt,name=denom0,proto3" json:"denom0,omitempty"`
+	Denom1           string                                 `protobuf:"bytes,4,opt,name=denom1,proto3" json:"denom1,omitempty"`
+	ReserveAddress   string                                 `protobuf:"bytes,5,opt,name=reserve_address,json=reserveAddress,proto3" json:"reserve_address,omitempty"`
+	ShareDenom       string                                 `protobuf:"bytes,6,opt,name=share_denom,json=shareDenom,proto3" json:"share_denom,omitempty"`
+	Amplification    uint64                                 `protobuf:"varint,7,opt,name=amplification,proto3" json:"amplification,omitempty"`
+	TickSpacing      uint32                                 `protobuf:"varint,8,opt,name=tick_spacing,json=tickSpacing,proto3" json:"tick_spacing,omitempty"`
+	MinOrderQuantity github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,9,opt,name=min_order_quantity,json=minOrderQuantity,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"min_order_quantity"`
+	MinOrderQuote    github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,10,opt,name=min_order_quote,json=minOrderQuote,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"min_order_quote"`
+}
+
+func (m *Pool) Reset()         { *m = Pool{} }
+func (m *Pool) String() string { return proto.CompactTextString(m) }
+func (*Pool) ProtoMessage()    {}
+func (*Pool) Descriptor() ([]byte, []int) {
+	return fileDescriptor_46555b237790f8d4, []int{0}
+}
+func (m *Pool) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Pool) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Pool.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Pool) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Pool.Merge(m, src)
+}
+func (m *Pool) XXX_Size() int {
+	return m.Size()
+}
+func (m *Pool) XXX_DiscardUnknown() {
+	xxx_messageInfo_Pool.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Pool proto.InternalMessageInfo
+
+type PoolState struct {
+	Reserve0   github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,1,opt,name=reserve0,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"reserve0"`
+	Reserve1   github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=reserve1,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"reserve1"`
+	TotalShare github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=total_share,json=totalShare,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"total_share"`
+}
+
+func (m *PoolState) Reset()         { *m = PoolState{} }
+func (m *PoolState) String() string { return proto.CompactTextString(m) }
+func (*PoolState) ProtoMessage()    {}
+func (*PoolState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_46555b237790f8d4, []int{1}
+}
+func (m *PoolState) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PoolState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PoolState.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PoolState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PoolState.Merge(m, src)
+}
+func (m *PoolState) XXX_Size() int {
+	return m.Size()
+}
+func (m *PoolState) XXX_DiscardUnknown() {
+	xxx_messageInfo_PoolState.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PoolState proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*Pool)(nil), "crescent.stableswap.v1beta1.Pool")
+	proto.RegisterType((*PoolState)(nil), "crescent.stableswap.v1beta1.PoolState")
+}
+
+func init() {
+	proto.RegisterFile("crescent/stableswap/v1beta1/stableswap.proto", fileDescriptor_46555b237790f8d4)
+}
+
+var fileDescriptor_46555b237790f8d4 = []byte{
+	// 456 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x93, 0xc1, 0x6e, 0xd3, 0x4c,
+	0x10, 0xc7, 0xed, 0x24, 0x5f, 0xbe, 0x64, 0x43, 0x5a, 0xb4, 0x42, 0x68, 0x45, 0x25, 0x27, 0x54,
+	0x08, 0x72, 0xa0, 0x76, 0x2d, 0xc4, 0x85, 0x1b, 0x55, 0x2f, 0xe5, 0x52, 0x70, 0x24, 0x84, 0x10,
+	0x92, 0xb5, 0xf1, 0x0e, 0xe9, 0x2a, 0xf1, 0xae, 0xd9, 0x9d, 0xa6, 0xf4, 0x2d, 0x78, 0x10, 0x1e,
+	0x24, 0xc7, 0x1e, 0x11, 0x87, 0x0a, 0x92, 0x57, 0xe0, 0x01, 0x90, 0x37, 0x26, 0x98, 0x23, 0xe5,
+	0x64, 0xfb, 0xe7, 0xff, 0xfc, 0x66, 0xb5, 0xa3, 0x21, 0x8f, 0x33, 0x03, 0x36, 0x03, 0x85, 0x91,
+	0x45, 0x3e, 0x99, 0x83, 0xbd, 0xe0, 0x45, 0xb4, 0x88, 0x27, 0x80, 0x3c, 0xae, 0xa1, 0xb0, 0x30,
+	0x1a, 0x35, 0xdd, 0xfb, 0x95, 0x0e, 0x6b, 0xbf, 0xaa, 0xf4, 0xbd, 0x3b, 0x53, 0x3d, 0xd5, 0x2e,
+	0x17, 0x95, 0x6f, 0x9b, 0x92, 0xfd, 0xcf, 0x4d, 0xd2, 0x7a, 0xa9, 0xf5, 0x9c, 0xee, 0x90, 0x86,
+	0x14, 0xcc, 0x1f, 0xfa, 0xa3, 0x56, 0xd2, 0x90, 0x82, 0xee, 0x91, 0x6e, 0xce, 0xcd, 0x0c, 0x30,
+	0x95, 0x82, 0x35, 0x1c, 0xee, 0x6c, 0xc0, 0x89, 0xa0, 0x77, 0x49, 0x5b, 0x80, 0xd2, 0xf9, 0x21,
+	0x6b, 0x0e, 0xfd, 0x51, 0x37, 0xa9, 0xbe, 0xb6, 0x3c, 0x66, 0xad, 0x1a, 0x8f, 0xe9, 0x23, 0xb2,
+	0x6b, 0xc0, 0x82, 0x59, 0x40, 0xca, 0x85, 0x30, 0x60, 0x2d, 0xfb, 0xcf, 0x05, 0x76, 0x2a, 0xfc,
+	0x7c, 0x43, 0xe9, 0x80, 0xf4, 0xec, 0x19, 0x37, 0x90, 0xba, 0x42, 0xd6, 0x76, 0x21, 0xe2, 0xd0,
+	0x71, 0x49, 0xe8, 0x03, 0xd2, 0xe7, 0x79, 0x31, 0x97, 0xef, 0x65, 0xc6, 0x51, 0x6a, 0xc5, 0xfe,
+	0x77, 0x47, 0xfb, 0x13, 0xd2, 0xfb, 0xe4, 0x16, 0xca, 0x6c, 0x96, 0xda, 0x82, 0x67, 0x52, 0x4d,
+	0x59, 0x67, 0xe8, 0x8f, 0xfa, 0x49, 0xaf, 0x64, 0xe3, 0x0d, 0xa2, 0xef, 0x08, 0xcd, 0xa5, 0x4a,
+	0xb5, 0x11, 0x60, 0xd2, 0x0f, 0xe7, 0x5c, 0xa1, 0xc4, 0x4b, 0xd6, 0x2d, 0x1b, 0x1e, 0x85, 0xcb,
+	0xeb, 0x81, 0xf7, 0xf5, 0x7a, 0xf0, 0x70, 0x2a, 0xf1, 0xec, 0x7c, 0x12, 0x66, 0x3a, 0x8f, 0x32,
+	0x6d, 0x73, 0x6d, 0xab, 0xc7, 0x81, 0x15, 0xb3, 0x08, 0x2f, 0x0b, 0xb0, 0xe1, 0x31, 0x64, 0xc9,
+	0xed, 0x5c, 0xaa, 0xd3, 0x52, 0xf4, 0xaa, 0xf2, 0xd0, 0xd7, 0x64, 0xb7, 0x6e, 0xd7, 0x08, 0x8c,
+	0xdc, 0x48, 0xdd, 0xff, 0xad, 0xd6, 0x08, 0xfb, 0x3f, 0x7c, 0xd2, 0x2d, 0xc7, 0x35, 0x46, 0x8e,
+	0x40, 0x5f, 0x90, 0x4e, 0x75, 0x7f, 0x87, 0xcc, 0xff, 0x6b, 0xfd, 0x89, 0xc2, 0x64, 0x5b, 0x5f,
+	0x73, 0xc5, 0xac, 0xf1, 0x4f, 0xae, 0x98, 0x9e, 0x92, 0x1e, 0x6a, 0xe4, 0xf3, 0xd4, 0x0d, 0x8e,
+	0x35, 0x6f, 0xa4, 0x23, 0x4e, 0x31, 0x2e, 0x0d, 0x47, 0x6f, 0x96, 0xdf, 0x03, 0x6f, 0xb9, 0x0a,
+	0xfc, 0xab, 0x55, 0xe0, 0x7f, 0x5b, 0x05, 0xfe, 0xa7, 0x75, 0xe0, 0x5d, 0xad, 0x03, 0xef, 0xcb,
+	0x3a, 0xf0, 0xde, 0x3e, 0xab, 0x1b, 0xab, 0x0d, 0x38, 0x50, 0x80, 0x17, 0xda, 0xcc, 0xb6, 0x20,
+	0x5a, 0x3c, 0x8d, 0x3e, 0xd6, 0xb7, 0xc8, 0x75, 0x9a, 0xb4, 0xdd, 0x1a, 0x3c, 0xf9, 0x39, 0x00,
+	0x1c, 0xad, 0x32, 0x78, 0x69, 0x03, 0x00, 0x00,
+}
+
+func (m *Pool) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Pool) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Pool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.MinOrderQuote.Size()
+		i -= size
+		if _, err := m.MinOrderQuote.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStableswap(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	{
+		size := m.MinOrderQuantity.Size()
+		i -= size
+		if _, err := m.MinOrderQuantity.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStableswap(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	if m.TickSpacing != 0 {
+		i = encodeVarintStableswap(dAtA, i, uint64(m.TickSpacing))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.Amplification != 0 {
+		i = encodeVarintStableswap(dAtA, i, uint64(m.Amplification))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.ShareDenom) > 0 {
+		i -= len(m.ShareDenom)
+		copy(dAtA[i:], m.ShareDenom)
+		i = encodeVarintStableswap(dAtA, i, uint64(len(m.ShareDenom)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.ReserveAddress) > 0 {
+		i -= len(m.ReserveAddress)
+		copy(dAtA[i:], m.ReserveAddress)
+		i = encodeVarintStableswap(dAtA, i, uint64(len(m.ReserveAddress)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Denom1) > 0 {
+		i -= len(m.Denom1)
+		copy(dAtA[i:], m.Denom1)
+		i = encodeVarintStableswap(dAtA, i, uint64(len(m.Denom1)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Denom0) > 0 {
+		i -= len(m.Denom0)
+		copy(dAtA[i:], m.Denom0)
+		i = encodeVarintStableswap(dAtA, i, uint64(len(m.Denom0)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.MarketId != 0 {
+		i = encodeVarintStableswap(dAtA, i, uint64(m.MarketId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Id != 0 {
+		i = encodeVarintStableswap(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PoolState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PoolState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PoolState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.TotalShare.Size()
+		i -= size
+		if _, err := m.TotalShare.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStableswap(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Reserve1.Size()
+		i -= size
+		if _, err := m.Reserve1.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStableswap(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.Reserve0.Size()
+		i -= size
+		if _, err := m.Reserve0.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStableswap(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintStableswap(dAtA []byte, offset int, v uint64) int {
+	offset -= sovStableswap(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Pool) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovStableswap(uint64(m.Id))
+	}
+	if m.MarketId != 0 {
+		n += 1 + sovStableswap(uint64(m.MarketId))
+	}
+	l = len(m.Denom0)
+	if l > 0 {
+		n += 1 + l + sovStableswap(uint64(l))
+	}
+	l = len(m.Denom1)
+	if l > 0 {
+		n += 1 + l + sovStableswap(uint64(l))
+	}
+	l = len(m.ReserveAddress)
+	if l > 0 {
+		n += 1 + l + sovStableswap(uint64(l))
+	}
+	l = len(m.ShareDenom)
+	if l > 0 {
+		n += 1 + l + sovStableswap(uint64(l))
+	}
+	if m.Amplification != 0 {
+		n += 1 + sovStableswap(uint64(m.Amplification))
+	}
+	if m.TickSpacing != 0 {
+		n += 1 + sovStableswap(uint64(m.TickSpacing))
+	}
+	l = m.MinOrderQuantity.Size()
+	n += 1 + l + sovStableswap(uint64(l))
+	l = m.MinOrderQuote.Size()
+	n += 1 + l + sovStableswap(uint64(l))
+	return n
+}
+
+func (m *PoolState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Reserve0.Size()
+	n += 1 + l + sovStableswap(uint64(l))
+	l = m.Reserve1.Size()
+	n += 1 + l + sovStableswap(uint64(l))
+	l = m.TotalShare.Size()
+	n += 1 + l + sovStableswap(uint64(l))
+	return n
+}
+
+func sovStableswap(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozStableswap(x uint64) (n int) {
+	return sovStableswap(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Pool) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStableswap
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Pool: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Pool: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStableswap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+			}
+			m.MarketId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStableswap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MarketId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom0", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStableswap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStableswap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStableswap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom0 = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom1", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStableswap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStableswap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStableswap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom1 = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReserveAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStableswap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStableswap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStableswap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReserveAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShareDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStableswap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStableswap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStableswap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ShareDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amplification", wireType)
+			}
+			m.Amplification = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStableswap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Amplification |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TickSpacing", wireType)
+			}
+			m.TickSpacing = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStableswap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TickSpacing |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinOrderQuantity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStableswap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStableswap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStableswap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinOrderQuantity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinOrderQuote", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStableswap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStableswap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStableswap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinOrderQuote.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStableswap(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStableswap
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PoolState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStableswap
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PoolState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PoolState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reserve0", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStableswap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStableswap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStableswap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Reserve0.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reserve1", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStableswap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStableswap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStableswap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Reserve1.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalShare", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStableswap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStableswap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStableswap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalShare.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStableswap(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStableswap
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipStableswap(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowStableswap
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowStableswap
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowStableswap
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthStableswap
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupStableswap
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthStableswap
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthStableswap        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowStableswap          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupStableswap = fmt.Errorf("proto: unexpected end of group")
+)